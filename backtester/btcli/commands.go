@@ -622,3 +622,109 @@ func executeStrategyFromConfig(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+var executeParameterSweepCommand = &cli.Command{
+	Name:      "executeparametersweep",
+	Usage:     "runs a strategy config file once for every combination of custom setting values and returns the results ranked",
+	ArgsUsage: "<path>",
+	Action:    executeParameterSweep,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "path",
+			Aliases: []string{"p"},
+			Usage:   "the filepath to a strategy to sweep",
+		},
+		&cli.StringSliceFlag{
+			Name:    "range",
+			Aliases: []string{"r"},
+			Usage:   "a custom setting to sweep from start to end inclusive in increments of step, can be set multiple times. eg 'rsi-period=10:20:2'",
+		},
+		&cli.StringSliceFlag{
+			Name:    "values",
+			Aliases: []string{"v"},
+			Usage:   "a custom setting to sweep over a grid of values, can be set multiple times. eg 'rsi-high=65:70:75'",
+		},
+		&cli.StringFlag{
+			Name:  "rankby",
+			Usage: "the statistic to rank results by: sharpe, sortino or max-drawdown",
+		},
+		&cli.Int64Flag{
+			Name:  "maxconcurrenttasks",
+			Usage: "the maximum number of combinations to run at once, defaults to the number of CPUs",
+		},
+		&cli.DurationFlag{
+			Name:  "insample",
+			Usage: "enables walk-forward with rolling in-sample windows of this duration. eg '720h'",
+		},
+		&cli.DurationFlag{
+			Name:  "outofsample",
+			Usage: "the out-of-sample window duration of a walk-forward. eg '168h'",
+		},
+	},
+}
+
+func executeParameterSweep(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var path string
+	if c.IsSet("path") {
+		path = c.String("path")
+	} else {
+		path = c.Args().First()
+	}
+
+	var parameters []*btrpc.SweepParameter
+	for _, r := range c.StringSlice("range") {
+		p, err := parseSweepParameter(r, true)
+		if err != nil {
+			return err
+		}
+		parameters = append(parameters, p)
+	}
+	for _, v := range c.StringSlice("values") {
+		p, err := parseSweepParameter(v, false)
+		if err != nil {
+			return err
+		}
+		parameters = append(parameters, p)
+	}
+
+	var walkForward *btrpc.WalkForward
+	if c.IsSet("insample") || c.IsSet("outofsample") {
+		inSample := c.Duration("insample")
+		outOfSample := c.Duration("outofsample")
+		if inSample <= 0 || outOfSample <= 0 {
+			return errors.New("walk-forward in-sample and out-of-sample durations must be greater than 0")
+		}
+		walkForward = &btrpc.WalkForward{
+			InSample:    durationpb.New(inSample),
+			OutOfSample: durationpb.New(outOfSample),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ExecuteParameterSweep(
+		c.Context,
+		&btrpc.ExecuteParameterSweepRequest{
+			StrategyFilePath:   path,
+			Parameters:         parameters,
+			RankBy:             c.String("rankby"),
+			MaxConcurrentTasks: c.Int64("maxconcurrenttasks"),
+			WalkForward:        walkForward,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
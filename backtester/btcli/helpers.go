@@ -2,11 +2,17 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"google.golang.org/grpc"
 )
 
+var errInvalidSweepParameter = errors.New("invalid sweep parameter")
+
 func closeConn(conn *grpc.ClientConn, cancel context.CancelFunc) {
 	if err := conn.Close(); err != nil {
 		fmt.Println(err)
@@ -15,3 +21,28 @@ func closeConn(conn *grpc.ClientConn, cancel context.CancelFunc) {
 		cancel()
 	}
 }
+
+// parseSweepParameter parses a sweep parameter in the format of
+// 'key=start:end:step' for a range or 'key=value:value' for a grid of values
+func parseSweepParameter(s string, isRange bool) (*btrpc.SweepParameter, error) {
+	key, rawValues, ok := strings.Cut(s, "=")
+	if !ok || key == "" || rawValues == "" {
+		return nil, fmt.Errorf("%w %q", errInvalidSweepParameter, s)
+	}
+	split := strings.Split(rawValues, ":")
+	values := make([]float64, len(split))
+	for i := range split {
+		v, err := strconv.ParseFloat(split[i], 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w %q: %w", errInvalidSweepParameter, s, err)
+		}
+		values[i] = v
+	}
+	if !isRange {
+		return &btrpc.SweepParameter{Key: key, Values: values}, nil
+	}
+	if len(values) != 3 {
+		return nil, fmt.Errorf("%w %q range must be in the format of start:end:step", errInvalidSweepParameter, s)
+	}
+	return &btrpc.SweepParameter{Key: key, Start: values[0], End: values[1], Step: values[2]}, nil
+}
@@ -120,6 +120,7 @@ func main() {
 		stopAllTasksCommand,
 		clearTaskCommand,
 		clearAllTasksCommand,
+		executeParameterSweepCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.4.0
+// - protoc-gen-go-grpc v1.3.0
 // - protoc             (unknown)
 // source: btrpc.proto
 
@@ -15,8 +15,8 @@ import (
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the grpc package it is being compiled against.
-// Requires gRPC-Go v1.62.0 or later.
-const _ = grpc.SupportPackageIsVersion8
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
 
 const (
 	BacktesterService_ExecuteStrategyFromFile_FullMethodName   = "/btrpc.BacktesterService/ExecuteStrategyFromFile"
@@ -28,6 +28,7 @@ const (
 	BacktesterService_StopAllTasks_FullMethodName              = "/btrpc.BacktesterService/StopAllTasks"
 	BacktesterService_ClearTask_FullMethodName                 = "/btrpc.BacktesterService/ClearTask"
 	BacktesterService_ClearAllTasks_FullMethodName             = "/btrpc.BacktesterService/ClearAllTasks"
+	BacktesterService_ExecuteParameterSweep_FullMethodName     = "/btrpc.BacktesterService/ExecuteParameterSweep"
 )
 
 // BacktesterServiceClient is the client API for BacktesterService service.
@@ -43,6 +44,7 @@ type BacktesterServiceClient interface {
 	StopAllTasks(ctx context.Context, in *StopAllTasksRequest, opts ...grpc.CallOption) (*StopAllTasksResponse, error)
 	ClearTask(ctx context.Context, in *ClearTaskRequest, opts ...grpc.CallOption) (*ClearTaskResponse, error)
 	ClearAllTasks(ctx context.Context, in *ClearAllTasksRequest, opts ...grpc.CallOption) (*ClearAllTasksResponse, error)
+	ExecuteParameterSweep(ctx context.Context, in *ExecuteParameterSweepRequest, opts ...grpc.CallOption) (*ExecuteParameterSweepResponse, error)
 }
 
 type backtesterServiceClient struct {
@@ -54,9 +56,8 @@ func NewBacktesterServiceClient(cc grpc.ClientConnInterface) BacktesterServiceCl
 }
 
 func (c *backtesterServiceClient) ExecuteStrategyFromFile(ctx context.Context, in *ExecuteStrategyFromFileRequest, opts ...grpc.CallOption) (*ExecuteStrategyResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteStrategyResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_ExecuteStrategyFromFile_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_ExecuteStrategyFromFile_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -64,9 +65,8 @@ func (c *backtesterServiceClient) ExecuteStrategyFromFile(ctx context.Context, i
 }
 
 func (c *backtesterServiceClient) ExecuteStrategyFromConfig(ctx context.Context, in *ExecuteStrategyFromConfigRequest, opts ...grpc.CallOption) (*ExecuteStrategyResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ExecuteStrategyResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_ExecuteStrategyFromConfig_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_ExecuteStrategyFromConfig_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -74,9 +74,8 @@ func (c *backtesterServiceClient) ExecuteStrategyFromConfig(ctx context.Context,
 }
 
 func (c *backtesterServiceClient) ListAllTasks(ctx context.Context, in *ListAllTasksRequest, opts ...grpc.CallOption) (*ListAllTasksResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListAllTasksResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_ListAllTasks_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_ListAllTasks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,9 +83,8 @@ func (c *backtesterServiceClient) ListAllTasks(ctx context.Context, in *ListAllT
 }
 
 func (c *backtesterServiceClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StartTaskResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_StartTask_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_StartTask_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -94,9 +92,8 @@ func (c *backtesterServiceClient) StartTask(ctx context.Context, in *StartTaskRe
 }
 
 func (c *backtesterServiceClient) StartAllTasks(ctx context.Context, in *StartAllTasksRequest, opts ...grpc.CallOption) (*StartAllTasksResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StartAllTasksResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_StartAllTasks_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_StartAllTasks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,9 +101,8 @@ func (c *backtesterServiceClient) StartAllTasks(ctx context.Context, in *StartAl
 }
 
 func (c *backtesterServiceClient) StopTask(ctx context.Context, in *StopTaskRequest, opts ...grpc.CallOption) (*StopTaskResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StopTaskResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_StopTask_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_StopTask_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -114,9 +110,8 @@ func (c *backtesterServiceClient) StopTask(ctx context.Context, in *StopTaskRequ
 }
 
 func (c *backtesterServiceClient) StopAllTasks(ctx context.Context, in *StopAllTasksRequest, opts ...grpc.CallOption) (*StopAllTasksResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StopAllTasksResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_StopAllTasks_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_StopAllTasks_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -124,9 +119,8 @@ func (c *backtesterServiceClient) StopAllTasks(ctx context.Context, in *StopAllT
 }
 
 func (c *backtesterServiceClient) ClearTask(ctx context.Context, in *ClearTaskRequest, opts ...grpc.CallOption) (*ClearTaskResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ClearTaskResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_ClearTask_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_ClearTask_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -134,9 +128,17 @@ func (c *backtesterServiceClient) ClearTask(ctx context.Context, in *ClearTaskRe
 }
 
 func (c *backtesterServiceClient) ClearAllTasks(ctx context.Context, in *ClearAllTasksRequest, opts ...grpc.CallOption) (*ClearAllTasksResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ClearAllTasksResponse)
-	err := c.cc.Invoke(ctx, BacktesterService_ClearAllTasks_FullMethodName, in, out, cOpts...)
+	err := c.cc.Invoke(ctx, BacktesterService_ClearAllTasks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) ExecuteParameterSweep(ctx context.Context, in *ExecuteParameterSweepRequest, opts ...grpc.CallOption) (*ExecuteParameterSweepResponse, error) {
+	out := new(ExecuteParameterSweepResponse)
+	err := c.cc.Invoke(ctx, BacktesterService_ExecuteParameterSweep_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -156,6 +158,7 @@ type BacktesterServiceServer interface {
 	StopAllTasks(context.Context, *StopAllTasksRequest) (*StopAllTasksResponse, error)
 	ClearTask(context.Context, *ClearTaskRequest) (*ClearTaskResponse, error)
 	ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error)
+	ExecuteParameterSweep(context.Context, *ExecuteParameterSweepRequest) (*ExecuteParameterSweepResponse, error)
 	mustEmbedUnimplementedBacktesterServiceServer()
 }
 
@@ -190,6 +193,9 @@ func (UnimplementedBacktesterServiceServer) ClearTask(context.Context, *ClearTas
 func (UnimplementedBacktesterServiceServer) ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAllTasks not implemented")
 }
+func (UnimplementedBacktesterServiceServer) ExecuteParameterSweep(context.Context, *ExecuteParameterSweepRequest) (*ExecuteParameterSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteParameterSweep not implemented")
+}
 func (UnimplementedBacktesterServiceServer) mustEmbedUnimplementedBacktesterServiceServer() {}
 
 // UnsafeBacktesterServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -365,6 +371,24 @@ func _BacktesterService_ClearAllTasks_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BacktesterService_ExecuteParameterSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteParameterSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ExecuteParameterSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BacktesterService_ExecuteParameterSweep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ExecuteParameterSweep(ctx, req.(*ExecuteParameterSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BacktesterService_ServiceDesc is the grpc.ServiceDesc for BacktesterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -408,6 +432,10 @@ var BacktesterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAllTasks",
 			Handler:    _BacktesterService_ClearAllTasks_Handler,
 		},
+		{
+			MethodName: "ExecuteParameterSweep",
+			Handler:    _BacktesterService_ExecuteParameterSweep_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "btrpc.proto",
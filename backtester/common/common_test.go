@@ -116,6 +116,11 @@ func TestDataTypeConversion(t *testing.T) {
 			dataType: TradeStr,
 			want:     DataTrade,
 		},
+		{
+			title:    "Orderbook data type",
+			dataType: OrderbookStr,
+			want:     DataOrderbook,
+		},
 		{
 			title:     "Unknown data type",
 			dataType:  "unknown",
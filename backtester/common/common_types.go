@@ -18,11 +18,15 @@ const (
 	CandleStr = "candle"
 	// TradeStr is a config readable data type to tell the backtester to retrieve trade data
 	TradeStr = "trade"
+	// OrderbookStr is a config readable data type to tell the backtester to replay recorded orderbook data
+	OrderbookStr = "orderbook"
 
 	// DataCandle is an int64 representation of a candle data type
 	DataCandle int64 = iota
 	// DataTrade is an int64 representation of a trade data type
 	DataTrade
+	// DataOrderbook is an int64 representation of an orderbook data type
+	DataOrderbook
 )
 
 var (
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"strings"
 	"time"
@@ -42,6 +43,10 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateDataSettings()
+	if err != nil {
+		return err
+	}
 	err = c.validateStrategySettings()
 	if err != nil {
 		return err
@@ -123,6 +128,11 @@ func (c *Config) validateStrategySettings() error {
 			}
 		}
 	}
+	if c.StrategySettings.ParameterSweep != nil {
+		if err := c.validateParameterSweep(); err != nil {
+			return err
+		}
+	}
 	strats := strategies.GetSupportedStrategies()
 	for i := range strats {
 		if strings.EqualFold(strats[i].Name(), c.StrategySettings.Name) {
@@ -133,6 +143,96 @@ func (c *Config) validateStrategySettings() error {
 	return fmt.Errorf("strategty %v %w", c.StrategySettings.Name, base.ErrStrategyNotFound)
 }
 
+// validateParameterSweep ensures a parameter sweep can be run against the config
+func (c *Config) validateParameterSweep() error {
+	p := c.StrategySettings.ParameterSweep
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w parameter sweeps cannot be run against live data", errFeatureIncompatible)
+	}
+	switch p.RankBy {
+	case "", RankBySharpeRatio, RankBySortinoRatio, RankByMaxDrawdown:
+	default:
+		return fmt.Errorf("%w %q", errInvalidSweepRanking, p.RankBy)
+	}
+	if p.MaxConcurrentTasks < 0 {
+		return fmt.Errorf("%w max concurrent tasks %v", errInvalidSweepParameter, p.MaxConcurrentTasks)
+	}
+	if p.WalkForward != nil {
+		if p.WalkForward.InSample <= 0 || p.WalkForward.OutOfSample <= 0 {
+			return fmt.Errorf("%w in-sample and out-of-sample durations must be greater than zero", errInvalidWalkForward)
+		}
+		if c.DataSettings.APIData == nil && c.DataSettings.DatabaseData == nil {
+			return fmt.Errorf("%w walk-forward requires api or database data", errFeatureIncompatible)
+		}
+		if interval := c.DataSettings.Interval.Duration(); interval > 0 &&
+			(p.WalkForward.InSample%interval != 0 || p.WalkForward.OutOfSample%interval != 0) {
+			return fmt.Errorf("%w durations must be a multiple of the %v interval", errInvalidWalkForward, c.DataSettings.Interval)
+		}
+	}
+	_, err := p.Combinations()
+	return err
+}
+
+// GetValues returns every value a sweep parameter will be run with
+func (s *SweepParameter) GetValues() ([]float64, error) {
+	if s.Key == "" {
+		return nil, fmt.Errorf("%w key unset", errInvalidSweepParameter)
+	}
+	if len(s.Values) > 0 {
+		if s.Start != 0 || s.End != 0 || s.Step != 0 {
+			return nil, fmt.Errorf("%w %v cannot set both values and a range", errInvalidSweepParameter, s.Key)
+		}
+		return s.Values, nil
+	}
+	if s.Step <= 0 || s.End < s.Start {
+		return nil, fmt.Errorf("%w %v range start %v end %v step %v", errInvalidSweepParameter, s.Key, s.Start, s.End, s.Step)
+	}
+	start := decimal.NewFromFloat(s.Start)
+	end := decimal.NewFromFloat(s.End)
+	step := decimal.NewFromFloat(s.Step)
+	var values []float64
+	for v := start; v.LessThanOrEqual(end); v = v.Add(step) {
+		if len(values) == MaxSweepCombinations {
+			return nil, fmt.Errorf("%w %v", errTooManySweepCombinations, s.Key)
+		}
+		values = append(values, v.InexactFloat64())
+	}
+	return values, nil
+}
+
+// Combinations returns every combination of sweep parameter values
+func (p *ParameterSweep) Combinations() ([]map[string]float64, error) {
+	if p == nil {
+		return nil, fmt.Errorf("%w parameter sweep", gctcommon.ErrNilPointer)
+	}
+	if len(p.Parameters) == 0 {
+		return nil, errNoSweepParameters
+	}
+	combinations := []map[string]float64{{}}
+	for i := range p.Parameters {
+		values, err := p.Parameters[i].GetValues()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := combinations[0][p.Parameters[i].Key]; ok {
+			return nil, fmt.Errorf("%w %v set more than once", errInvalidSweepParameter, p.Parameters[i].Key)
+		}
+		if len(combinations)*len(values) > MaxSweepCombinations {
+			return nil, fmt.Errorf("%w maximum %v", errTooManySweepCombinations, MaxSweepCombinations)
+		}
+		next := make([]map[string]float64, 0, len(combinations)*len(values))
+		for j := range combinations {
+			for k := range values {
+				combination := maps.Clone(combinations[j])
+				combination[p.Parameters[i].Key] = values[k]
+				next = append(next, combination)
+			}
+		}
+		combinations = next
+	}
+	return combinations, nil
+}
+
 // validateDate checks whether someone has set a date poorly in their config
 func (c *Config) validateDate() error {
 	if c.DataSettings.DatabaseData != nil {
@@ -148,6 +248,15 @@ func (c *Config) validateDate() error {
 	return nil
 }
 
+// validateDataSettings ensures the data type can be retrieved from the data source
+func (c *Config) validateDataSettings() error {
+	if c.DataSettings.DataType == common.OrderbookStr &&
+		(c.DataSettings.APIData != nil || c.DataSettings.LiveData != nil) {
+		return fmt.Errorf("%w %v data can only be loaded from CSV or database data sources", errFeatureIncompatible, common.OrderbookStr)
+	}
+	return nil
+}
+
 // validateCurrencySettings checks whether someone has set invalid currency setting data in their config
 func (c *Config) validateCurrencySettings() error {
 	if len(c.CurrencySettings) == 0 {
@@ -155,15 +264,13 @@ func (c *Config) validateCurrencySettings() error {
 	}
 	var hasFutures, hasSlippage bool
 	for i := range c.CurrencySettings {
-		if c.CurrencySettings[i].Asset == asset.PerpetualSwap ||
-			c.CurrencySettings[i].Asset == asset.PerpetualContract {
-			return errPerpetualsUnsupported
-		}
 		if c.CurrencySettings[i].Asset.IsFutures() {
 			hasFutures = true
-			if c.CurrencySettings[i].Quote.String() == "PERP" || c.CurrencySettings[i].Base.String() == "PI" {
-				return errPerpetualsUnsupported
-			}
+		}
+		if c.CurrencySettings[i].FuturesDetails != nil &&
+			c.CurrencySettings[i].FuturesDetails.FundingRatesPath != "" &&
+			!c.CurrencySettings[i].Asset.IsFutures() {
+			return fmt.Errorf("%v %w", c.CurrencySettings[i].Asset, errFundingRatesRequireFutures)
 		}
 		if c.CurrencySettings[i].SpotDetails != nil {
 			if c.FundingSettings.UseExchangeLevelFunding {
@@ -63,6 +63,34 @@ func TestMain(m *testing.M) {
 	os.Exit(m.Run())
 }
 
+func TestValidateDataSettings(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateDataSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings = DataSettings{
+		DataType: common.OrderbookStr,
+		CSVData:  &CSVData{},
+	}
+	err = c.validateDataSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings.APIData = &APIData{}
+	err = c.validateDataSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received: %v, expected: %v", err, errFeatureIncompatible)
+	}
+	c.DataSettings.APIData = nil
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateDataSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received: %v, expected: %v", err, errFeatureIncompatible)
+	}
+}
+
 func TestValidateDate(t *testing.T) {
 	t.Parallel()
 	c := Config{}
@@ -140,18 +168,25 @@ func TestValidateCurrencySettings(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
 
+	c.CurrencySettings[0].FuturesDetails = &FuturesDetails{FundingRatesPath: "funding.csv"}
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, errFundingRatesRequireFutures) {
+		t.Errorf("received: %v, expected: %v", err, errFundingRatesRequireFutures)
+	}
+
 	c.CurrencySettings[0].Asset = asset.PerpetualSwap
 	err = c.validateCurrencySettings()
-	if !errors.Is(err, errPerpetualsUnsupported) {
-		t.Errorf("received: %v, expected: %v", err, errPerpetualsUnsupported)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
 	}
 
 	c.CurrencySettings[0].Asset = asset.USDTMarginedFutures
 	c.CurrencySettings[0].Quote = currency.NewCode("PERP")
 	err = c.validateCurrencySettings()
-	if !errors.Is(err, errPerpetualsUnsupported) {
-		t.Errorf("received: %v, expected: %v", err, errPerpetualsUnsupported)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
 	}
+	c.CurrencySettings[0].FuturesDetails = nil
 
 	c.CurrencySettings[0].MinimumSlippagePercent = decimal.NewFromInt(2)
 	c.CurrencySettings[0].MaximumSlippagePercent = decimal.NewFromInt(3)
@@ -355,6 +390,149 @@ func TestValidateStrategySettings(t *testing.T) {
 	}
 }
 
+func TestValidateParameterSweep(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		StrategySettings: StrategySettings{
+			Name:           dca,
+			ParameterSweep: &ParameterSweep{},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneHour,
+			LiveData: &LiveData{},
+		},
+	}
+	err := c.validateStrategySettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+	c.DataSettings.LiveData = nil
+	c.DataSettings.CSVData = &CSVData{}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errNoSweepParameters) {
+		t.Errorf("received %v expected %v", err, errNoSweepParameters)
+	}
+	c.StrategySettings.ParameterSweep.RankBy = "profit"
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errInvalidSweepRanking) {
+		t.Errorf("received %v expected %v", err, errInvalidSweepRanking)
+	}
+	c.StrategySettings.ParameterSweep.RankBy = RankBySortinoRatio
+	c.StrategySettings.ParameterSweep.Parameters = []SweepParameter{{Key: "period", Values: []float64{1, 2}}}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.StrategySettings.ParameterSweep.WalkForward = &WalkForward{}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errInvalidWalkForward) {
+		t.Errorf("received %v expected %v", err, errInvalidWalkForward)
+	}
+	c.StrategySettings.ParameterSweep.WalkForward = &WalkForward{InSample: time.Hour * 24, OutOfSample: time.Minute * 90}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+	c.DataSettings.CSVData = nil
+	c.DataSettings.APIData = &APIData{}
+	err = c.validateStrategySettings()
+	if !errors.Is(err, errInvalidWalkForward) {
+		t.Errorf("received %v expected %v", err, errInvalidWalkForward)
+	}
+	c.StrategySettings.ParameterSweep.WalkForward.OutOfSample = time.Hour * 2
+	err = c.validateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestSweepParameterGetValues(t *testing.T) {
+	t.Parallel()
+	p := &SweepParameter{}
+	_, err := p.GetValues()
+	if !errors.Is(err, errInvalidSweepParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidSweepParameter)
+	}
+	p.Key = "rsi-high"
+	_, err = p.GetValues()
+	if !errors.Is(err, errInvalidSweepParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidSweepParameter)
+	}
+	p.Values = []float64{65, 70}
+	p.Step = 1
+	_, err = p.GetValues()
+	if !errors.Is(err, errInvalidSweepParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidSweepParameter)
+	}
+	p.Step = 0
+	values, err := p.GetValues()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if len(values) != 2 {
+		t.Errorf("received %v expected %v", len(values), 2)
+	}
+
+	p = &SweepParameter{Key: "rsi-low", Start: 0.1, End: 0.3, Step: 0.1}
+	values, err = p.GetValues()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if len(values) != 3 || values[2] != 0.3 {
+		t.Errorf("received %v expected %v", values, []float64{0.1, 0.2, 0.3})
+	}
+
+	p = &SweepParameter{Key: "rsi-low", Start: 0, End: MaxSweepCombinations, Step: 1}
+	_, err = p.GetValues()
+	if !errors.Is(err, errTooManySweepCombinations) {
+		t.Errorf("received %v expected %v", err, errTooManySweepCombinations)
+	}
+}
+
+func TestParameterSweepCombinations(t *testing.T) {
+	t.Parallel()
+	var p *ParameterSweep
+	_, err := p.Combinations()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received %v expected %v", err, gctcommon.ErrNilPointer)
+	}
+	p = &ParameterSweep{}
+	_, err = p.Combinations()
+	if !errors.Is(err, errNoSweepParameters) {
+		t.Errorf("received %v expected %v", err, errNoSweepParameters)
+	}
+	p.Parameters = []SweepParameter{
+		{Key: "rsi-period", Start: 10, End: 20, Step: 5},
+		{Key: "rsi-high", Values: []float64{70, 80}},
+	}
+	combinations, err := p.Combinations()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(combinations) != 6 {
+		t.Fatalf("received %v expected %v", len(combinations), 6)
+	}
+	if combinations[5]["rsi-period"] != 20 || combinations[5]["rsi-high"] != 80 {
+		t.Errorf("received %v expected %v", combinations[5], map[string]float64{"rsi-period": 20, "rsi-high": 80})
+	}
+
+	p.Parameters = append(p.Parameters, SweepParameter{Key: "rsi-high", Values: []float64{1}})
+	_, err = p.Combinations()
+	if !errors.Is(err, errInvalidSweepParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidSweepParameter)
+	}
+
+	p.Parameters = []SweepParameter{
+		{Key: "a", Start: 1, End: 1000, Step: 1},
+		{Key: "b", Start: 1, End: 1000, Step: 1},
+	}
+	_, err = p.Combinations()
+	if !errors.Is(err, errTooManySweepCombinations) {
+		t.Errorf("received %v expected %v", err, errTooManySweepCombinations)
+	}
+}
+
 func TestPrintSettings(t *testing.T) {
 	t.Parallel()
 	cfg := Config{
@@ -24,10 +24,26 @@ var (
 	errSizeLessThanZero                 = errors.New("size less than zero")
 	errMaxSizeMinSizeMismatch           = errors.New("maximum size must be greater to minimum size")
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
-	errPerpetualsUnsupported            = errors.New("perpetual futures not yet supported")
+	errFundingRatesRequireFutures       = errors.New("funding rates can only be set for futures")
 	errFeatureIncompatible              = errors.New("feature is not compatible")
+	errNoSweepParameters                = errors.New("no parameter sweep parameters set")
+	errInvalidSweepParameter            = errors.New("invalid parameter sweep parameter")
+	errTooManySweepCombinations         = errors.New("too many parameter sweep combinations")
+	errInvalidSweepRanking              = errors.New("invalid parameter sweep ranking")
+	errInvalidWalkForward               = errors.New("invalid walk-forward window")
 )
 
+// Parameter sweep rankings
+const (
+	RankBySharpeRatio  = "sharpe"
+	RankBySortinoRatio = "sortino"
+	RankByMaxDrawdown  = "max-drawdown"
+)
+
+// MaxSweepCombinations is the maximum number of custom setting combinations
+// a parameter sweep can run
+const MaxSweepCombinations = 10000
+
 // Config defines what is in an individual strategy config
 type Config struct {
 	Nickname          string             `json:"nickname"`
@@ -69,6 +85,38 @@ type StrategySettings struct {
 	// bool language is opposite to encourage use by default
 	DisableUSDTracking bool           `json:"disable-usd-tracking"`
 	CustomSettings     map[string]any `json:"custom-settings,omitempty"`
+	// ParameterSweep is only used when running the strategy as a parameter sweep
+	ParameterSweep *ParameterSweep `json:"parameter-sweep,omitempty"`
+}
+
+// ParameterSweep defines custom setting values to run a strategy against.
+// Every combination of values is run and the results are ranked
+type ParameterSweep struct {
+	Parameters []SweepParameter `json:"parameters"`
+	// RankBy is one of sharpe, sortino or max-drawdown. Defaults to sharpe
+	RankBy string `json:"rank-by,omitempty"`
+	// MaxConcurrentTasks limits how many combinations run at once.
+	// Defaults to the number of CPUs
+	MaxConcurrentTasks int          `json:"max-concurrent-tasks,omitempty"`
+	WalkForward        *WalkForward `json:"walk-forward,omitempty"`
+}
+
+// SweepParameter is a custom setting to sweep. Either a grid of Values
+// is used, or a range from Start to End inclusive in increments of Step
+type SweepParameter struct {
+	Key    string    `json:"key"`
+	Values []float64 `json:"values,omitempty"`
+	Start  float64   `json:"start,omitempty"`
+	End    float64   `json:"end,omitempty"`
+	Step   float64   `json:"step,omitempty"`
+}
+
+// WalkForward splits the data range into rolling windows. Each combination is
+// run over the in-sample window and the best performing combination is then
+// run over the following out-of-sample window
+type WalkForward struct {
+	InSample    time.Duration `json:"in-sample"`
+	OutOfSample time.Duration `json:"out-of-sample"`
 }
 
 // ExchangeLevelFunding allows the portfolio manager to access
@@ -165,6 +213,11 @@ type SpotDetails struct {
 // FuturesDetails contains data relevant to futures currency pairs
 type FuturesDetails struct {
 	Leverage Leverage `json:"leverage"`
+	// FundingRatesPath is an optional path to a CSV file of funding rates
+	// for perpetual futures. Each row contains a unix timestamp and a rate.
+	// When unset, funding rates are retrieved from the exchange API when
+	// using API data
+	FundingRatesPath string `json:"funding-rates-path,omitempty"`
 }
 
 // APIData defines all fields to configure API based data
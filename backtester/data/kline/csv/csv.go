@@ -6,21 +6,28 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-var errNoUSDData = errors.New("could not retrieve USD CSV candle data")
+var (
+	errNoUSDData       = errors.New("could not retrieve USD CSV candle data")
+	errNoFundingRates  = errors.New("no funding rates found in CSV")
+	errInvalidRowWidth = errors.New("invalid CSV row width")
+)
 
 // LoadData is a basic csv reader which converts the found CSV file into a kline item
 func LoadData(dataType int64, filepath, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
@@ -163,3 +170,59 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 
 	return resp, nil
 }
+
+// LoadFundingRates is a basic csv reader which converts the found CSV file into
+// historical funding rates. Each row contains a unix timestamp and a rate
+func LoadFundingRates(filepath, exchangeName string, fPair currency.Pair, a asset.Item) (*fundingrate.HistoricalRates, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = csvFile.Close()
+		if err != nil {
+			log.Errorln(common.Data, err)
+		}
+	}()
+
+	resp := &fundingrate.HistoricalRates{
+		Exchange: strings.ToLower(exchangeName),
+		Asset:    a,
+		Pair:     fPair,
+	}
+	csvData := csv.NewReader(csvFile)
+	for {
+		row, errCSV := csvData.Read()
+		if errCSV != nil {
+			if errCSV == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("could not read csv funding rates for %v %v %v, %v", exchangeName, a, fPair, errCSV)
+		}
+		if len(row) < 2 {
+			return nil, fmt.Errorf("%w %v for %v %v %v", errInvalidRowWidth, row, exchangeName, a, fPair)
+		}
+		v, errParse := strconv.ParseInt(row[0], 10, 64)
+		if errParse != nil {
+			return nil, fmt.Errorf("invalid timestamp received on row %v %v", row[0], errParse)
+		}
+		rate, errParse := decimal.NewFromString(row[1])
+		if errParse != nil {
+			return nil, fmt.Errorf("could not process funding rate %v %v", row[1], errParse)
+		}
+		resp.FundingRates = append(resp.FundingRates, fundingrate.Rate{
+			Time: time.Unix(v, 0).UTC(),
+			Rate: rate,
+		})
+	}
+	if len(resp.FundingRates) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoFundingRates, exchangeName, a, fPair)
+	}
+	sort.Slice(resp.FundingRates, func(i, j int) bool {
+		return resp.FundingRates[i].Time.Before(resp.FundingRates[j].Time)
+	})
+	resp.StartDate = resp.FundingRates[0].Time
+	resp.EndDate = resp.FundingRates[len(resp.FundingRates)-1].Time
+	resp.LatestRate = resp.FundingRates[len(resp.FundingRates)-1]
+	return resp, nil
+}
@@ -2,9 +2,12 @@ package csv
 
 import (
 	"errors"
+	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -75,3 +78,47 @@ func TestLoadDataInvalid(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, errNoUSDData)
 	}
 }
+
+func TestLoadFundingRates(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	dir := t.TempDir()
+	_, err := LoadFundingRates(filepath.Join(dir, "missing.csv"), testExchange, p, asset.USDTMarginedFutures)
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received: %v, expected: %v", err, os.ErrNotExist)
+	}
+
+	path := filepath.Join(dir, "funding.csv")
+	if err = os.WriteFile(path, nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadFundingRates(path, testExchange, p, asset.USDTMarginedFutures)
+	if !errors.Is(err, errNoFundingRates) {
+		t.Errorf("received: %v, expected: %v", err, errNoFundingRates)
+	}
+
+	if err = os.WriteFile(path, []byte("1577865600\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadFundingRates(path, testExchange, p, asset.USDTMarginedFutures)
+	if !errors.Is(err, errInvalidRowWidth) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidRowWidth)
+	}
+
+	if err = os.WriteFile(path, []byte("1577894400,-0.0002\n1577865600,0.0001\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	rates, err := LoadFundingRates(path, testExchange, p, asset.USDTMarginedFutures)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(rates.FundingRates) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(rates.FundingRates), 2)
+	}
+	if !rates.StartDate.Equal(time.Unix(1577865600, 0)) {
+		t.Errorf("received: %v, expected: %v", rates.StartDate, time.Unix(1577865600, 0))
+	}
+	if !rates.FundingRates[1].Rate.Equal(decimal.NewFromFloat(-0.0002)) {
+		t.Errorf("received: %v, expected: %v", rates.FundingRates[1].Rate, -0.0002)
+	}
+}
@@ -0,0 +1,403 @@
+package orderbook
+
+import (
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderbookdb "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// NewReplay sorts recorded orderbook data and prepares it for replaying.
+// The earliest record must be a snapshot so that the book has a starting state
+func NewReplay(exchangeName string, cp currency.Pair, a asset.Item, records []Record) (*Replay, error) {
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w", exchangeName, a, cp, ErrNoRecords)
+	}
+	sorted := slices.Clone(records)
+	slices.SortStableFunc(sorted, func(a, b Record) int {
+		return a.Time.Compare(b.Time)
+	})
+	if !sorted[0].IsSnapshot {
+		return nil, fmt.Errorf("%v %v %v %w", exchangeName, a, cp, errFirstRecordNotSnapshot)
+	}
+	for i := range sorted {
+		if !sorted[i].IsSnapshot {
+			continue
+		}
+		// snapshots are loaded as is, so levels must be in book order
+		sorted[i].Bids = slices.Clone(sorted[i].Bids)
+		sorted[i].Asks = slices.Clone(sorted[i].Asks)
+		slices.SortFunc(sorted[i].Bids, func(a, b gctorderbook.Tranche) int {
+			return compareFloat(b.Price, a.Price)
+		})
+		slices.SortFunc(sorted[i].Asks, func(a, b gctorderbook.Tranche) int {
+			return compareFloat(a.Price, b.Price)
+		})
+	}
+	r := &Replay{
+		exchange: strings.ToLower(exchangeName),
+		pair:     cp,
+		asset:    a,
+		records:  sorted,
+	}
+	var err error
+	r.depth, err = r.newDepth()
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reset returns the replay to its first record, discarding any liquidity
+// consumed by previous fills
+func (r *Replay) Reset() error {
+	if r == nil {
+		return gctcommon.ErrNilPointer
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	var err error
+	r.depth, err = r.newDepth()
+	if err != nil {
+		return err
+	}
+	r.offset = 0
+	r.lastUpdated = time.Time{}
+	return nil
+}
+
+// Advance applies all recorded snapshots and updates which occurred before
+// the supplied time
+func (r *Replay) Advance(t time.Time) error {
+	if r == nil {
+		return gctcommon.ErrNilPointer
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.advance(t)
+}
+
+// Fill walks the replayed orderbook at the supplied time for a base amount,
+// lifting the asks for buys and hitting the bids for sells. The liquidity
+// consumed is removed from the book so subsequent orders cannot fill against
+// it until the level is amended by a recorded snapshot or update
+func (r *Replay) Fill(t time.Time, side gctorder.Side, amount float64) (*gctorderbook.Movement, error) {
+	if r == nil {
+		return nil, gctcommon.ErrNilPointer
+	}
+	if amount <= 0 {
+		return nil, errInvalidAmount
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	err := r.advance(t)
+	if err != nil {
+		return nil, err
+	}
+	if r.offset == 0 {
+		return nil, fmt.Errorf("%v %v %v %w before %v", r.exchange, r.asset, r.pair, ErrNoRecords, t)
+	}
+	book, err := r.depth.Retrieve()
+	if err != nil {
+		return nil, err
+	}
+	var m *gctorderbook.Movement
+	update := &gctorderbook.Update{
+		UpdateTime: r.lastUpdated,
+		Pair:       r.pair,
+		Asset:      r.asset,
+	}
+	switch {
+	case side.IsLong():
+		m, err = r.depth.LiftTheAsksFromBest(amount, true)
+		if err != nil {
+			return nil, err
+		}
+		update.Asks = consumeLiquidity(book.Asks, m.Purchased)
+	case side.IsShort():
+		m, err = r.depth.HitTheBidsFromBest(amount, false)
+		if err != nil {
+			return nil, err
+		}
+		update.Bids = consumeLiquidity(book.Bids, m.Sold)
+	default:
+		return nil, fmt.Errorf("%w %v", errInvalidSide, side)
+	}
+	return m, r.depth.UpdateBidAskByPrice(update)
+}
+
+// BestBidAsk returns the best bid and ask of the replayed orderbook at the
+// supplied time, including any liquidity consumed by previous fills
+func (r *Replay) BestBidAsk(t time.Time) (bid, ask gctorderbook.Tranche, err error) {
+	if r == nil {
+		return bid, ask, gctcommon.ErrNilPointer
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	err = r.advance(t)
+	if err != nil {
+		return bid, ask, err
+	}
+	if r.offset == 0 {
+		return bid, ask, fmt.Errorf("%v %v %v %w before %v", r.exchange, r.asset, r.pair, ErrNoRecords, t)
+	}
+	asks, bids, err := r.depth.GetTranches(1)
+	if err != nil {
+		return bid, ask, err
+	}
+	if len(bids) == 0 || len(asks) == 0 {
+		return bid, ask, fmt.Errorf("%v %v %v %w at %v", r.exchange, r.asset, r.pair, errOneSidedBook, t)
+	}
+	return bids[0], asks[0], nil
+}
+
+// Candles derives candles from the replayed mid price so the backtester can
+// step through the data. Intervals without any recorded changes carry the
+// previous close forward. Candles have no volume as orderbooks do not
+// contain trade information
+func (r *Replay) Candles(interval gctkline.Interval) (*gctkline.Item, error) {
+	if r == nil {
+		return nil, gctcommon.ErrNilPointer
+	}
+	if interval <= 0 {
+		return nil, gctkline.ErrInvalidInterval
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	d, err := r.newDepth()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctkline.Item{
+		Exchange: r.exchange,
+		Pair:     r.pair,
+		Asset:    r.asset,
+		Interval: interval,
+	}
+	for i := range r.records {
+		err = applyRecord(d, &r.records[i])
+		if err != nil {
+			return nil, err
+		}
+		mid, err := d.GetMidPrice()
+		if err != nil {
+			// one side of the book is empty, there is no price to record
+			continue
+		}
+		candleTime := r.records[i].Time.Truncate(interval.Duration())
+		if len(resp.Candles) == 0 {
+			resp.Candles = append(resp.Candles, gctkline.Candle{Time: candleTime, Open: mid, High: mid, Low: mid, Close: mid})
+			continue
+		}
+		last := &resp.Candles[len(resp.Candles)-1]
+		if last.Time.Equal(candleTime) {
+			last.High = max(last.High, mid)
+			last.Low = min(last.Low, mid)
+			last.Close = mid
+			continue
+		}
+		prevClose := last.Close
+		for t := last.Time.Add(interval.Duration()); t.Before(candleTime); t = t.Add(interval.Duration()) {
+			resp.Candles = append(resp.Candles, gctkline.Candle{Time: t, Open: prevClose, High: prevClose, Low: prevClose, Close: prevClose})
+		}
+		resp.Candles = append(resp.Candles, gctkline.Candle{
+			Time:  candleTime,
+			Open:  prevClose,
+			High:  max(prevClose, mid),
+			Low:   min(prevClose, mid),
+			Close: mid,
+		})
+	}
+	if len(resp.Candles) == 0 {
+		return nil, fmt.Errorf("%v %v %v %w with two sided liquidity", r.exchange, r.asset, r.pair, ErrNoRecords)
+	}
+	return resp, nil
+}
+
+func (r *Replay) advance(t time.Time) error {
+	for ; r.offset < len(r.records); r.offset++ {
+		if !r.records[r.offset].Time.Before(t) {
+			break
+		}
+		err := applyRecord(r.depth, &r.records[r.offset])
+		if err != nil {
+			return err
+		}
+		r.lastUpdated = r.records[r.offset].Time
+	}
+	return nil
+}
+
+func (r *Replay) newDepth() (*gctorderbook.Depth, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	d := gctorderbook.NewDepth(id)
+	d.AssignOptions(&gctorderbook.Base{
+		Exchange: r.exchange,
+		Pair:     r.pair,
+		Asset:    r.asset,
+	})
+	return d, nil
+}
+
+func applyRecord(d *gctorderbook.Depth, rec *Record) error {
+	if rec.IsSnapshot {
+		return d.LoadSnapshot(rec.Bids, rec.Asks, 0, rec.Time, rec.Time, false)
+	}
+	return d.UpdateBidAskByPrice(&gctorderbook.Update{
+		UpdateTime: rec.Time,
+		Bids:       rec.Bids,
+		Asks:       rec.Asks,
+	})
+}
+
+// consumeLiquidity returns price level updates which remove the base amount
+// from the best levels of a book side
+func consumeLiquidity(levels gctorderbook.Tranches, amount float64) []gctorderbook.Tranche {
+	var resp []gctorderbook.Tranche
+	for i := range levels {
+		if amount <= 0 {
+			break
+		}
+		taken := min(levels[i].Amount, amount)
+		resp = append(resp, gctorderbook.Tranche{Price: levels[i].Price, Amount: levels[i].Amount - taken})
+		amount -= taken
+	}
+	return resp
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// LoadCSV loads recorded orderbook data from a CSV file. Each row is
+// formatted as `unix milliseconds,snapshot|update,bid|ask,price,amount`.
+// Consecutive rows sharing a timestamp and record type form a single record
+func LoadCSV(filePath, exchangeName string, cp currency.Pair, a asset.Item) (*Replay, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	var records []Record
+	for row := 1; ; row++ {
+		line, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(line) != 5 {
+			return nil, fmt.Errorf("%w row %v expected 5 columns, received %v", errInvalidRowWidth, row, len(line))
+		}
+		ms, err := strconv.ParseInt(line[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %v could not parse timestamp %w", row, err)
+		}
+		var isSnapshot bool
+		switch strings.ToLower(line[1]) {
+		case snapshotStr:
+			isSnapshot = true
+		case updateStr:
+		default:
+			return nil, fmt.Errorf("%w row %v '%v'", errInvalidRecordType, row, line[1])
+		}
+		price, err := strconv.ParseFloat(line[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %v could not parse price %w", row, err)
+		}
+		amount, err := strconv.ParseFloat(line[4], 64)
+		if err != nil {
+			return nil, fmt.Errorf("row %v could not parse amount %w", row, err)
+		}
+		tm := time.UnixMilli(ms).UTC()
+		if len(records) == 0 ||
+			!records[len(records)-1].Time.Equal(tm) ||
+			records[len(records)-1].IsSnapshot != isSnapshot {
+			records = append(records, Record{Time: tm, IsSnapshot: isSnapshot})
+		}
+		rec := &records[len(records)-1]
+		tranche := gctorderbook.Tranche{Price: price, Amount: amount}
+		switch strings.ToLower(line[2]) {
+		case bidStr:
+			rec.Bids = append(rec.Bids, tranche)
+		case askStr:
+			rec.Asks = append(rec.Asks, tranche)
+		default:
+			return nil, fmt.Errorf("%w row %v '%v'", errInvalidSide, row, line[2])
+		}
+	}
+	return NewReplay(exchangeName, cp, a, records)
+}
+
+// LoadDatabase loads recorded orderbook data from the database between the
+// start and end dates. The latest snapshot before the start date is included
+// so the book is complete from the first candle
+func LoadDatabase(db orderbookdb.IDBService, startDate, endDate time.Time, exchangeName string, cp currency.Pair, a asset.Item) (*Replay, error) {
+	if db == nil {
+		return nil, fmt.Errorf("%w orderbook database service", gctcommon.ErrNilPointer)
+	}
+	var records []Record
+	snapshot, err := db.GetLatestSnapshot(exchangeName, a.String(), cp.Base.String(), cp.Quote.String(), startDate)
+	switch {
+	case err == nil:
+		records = append(records, convertDBRecord(snapshot))
+		startDate = snapshot.Timestamp.Add(time.Nanosecond)
+	case !errors.Is(err, sql.ErrNoRows):
+		return nil, err
+	}
+	if endDate.After(startDate) {
+		dbRecords, err := db.GetInRange(exchangeName, a.String(), cp.Base.String(), cp.Quote.String(), startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		for i := range dbRecords {
+			records = append(records, convertDBRecord(&dbRecords[i]))
+		}
+	}
+	return NewReplay(exchangeName, cp, a, records)
+}
+
+func convertDBRecord(rec *orderbookdb.Record) Record {
+	resp := Record{
+		Time:       rec.Timestamp,
+		IsSnapshot: rec.IsSnapshot,
+		Bids:       make([]gctorderbook.Tranche, len(rec.Bids)),
+		Asks:       make([]gctorderbook.Tranche, len(rec.Asks)),
+	}
+	for i := range rec.Bids {
+		resp.Bids[i] = gctorderbook.Tranche{Price: rec.Bids[i].Price, Amount: rec.Bids[i].Amount}
+	}
+	for i := range rec.Asks {
+		resp.Asks[i] = gctorderbook.Tranche{Price: rec.Asks[i].Price, Amount: rec.Asks[i].Amount}
+	}
+	return resp
+}
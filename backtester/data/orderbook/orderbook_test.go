@@ -0,0 +1,396 @@
+package orderbook
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderbookdb "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const testExchange = "binance"
+
+var (
+	cp     = currency.NewPair(currency.BTC, currency.USDT)
+	tt     = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	errDB  = errors.New("database says no")
+	testOB = []Record{
+		{
+			Time: tt.Add(time.Second),
+			Asks: []gctorderbook.Tranche{{Price: 101, Amount: 0}},
+		},
+		{
+			Time:       tt,
+			IsSnapshot: true,
+			Bids:       []gctorderbook.Tranche{{Price: 98, Amount: 2}, {Price: 99, Amount: 1}},
+			Asks:       []gctorderbook.Tranche{{Price: 102, Amount: 2}, {Price: 101, Amount: 1}},
+		},
+	}
+)
+
+func TestNewReplay(t *testing.T) {
+	t.Parallel()
+	_, err := NewReplay(testExchange, cp, asset.Spot, nil)
+	if !errors.Is(err, ErrNoRecords) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoRecords)
+	}
+
+	_, err = NewReplay(testExchange, cp, asset.Spot, testOB[:1])
+	if !errors.Is(err, errFirstRecordNotSnapshot) {
+		t.Errorf("received '%v' expected '%v'", err, errFirstRecordNotSnapshot)
+	}
+
+	r, err := NewReplay(testExchange, cp, asset.Spot, testOB)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !r.records[0].IsSnapshot {
+		t.Error("expected records to be sorted by time")
+	}
+	if r.records[0].Bids[0].Price != 99 {
+		t.Errorf("received '%v' expected '%v'", r.records[0].Bids[0].Price, 99)
+	}
+	if r.records[0].Asks[0].Price != 101 {
+		t.Errorf("received '%v' expected '%v'", r.records[0].Asks[0].Price, 101)
+	}
+	if testOB[1].Bids[0].Price != 98 {
+		t.Error("expected original records to be unmodified")
+	}
+}
+
+func TestAdvance(t *testing.T) {
+	t.Parallel()
+	var r *Replay
+	err := r.Advance(tt)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	r, err = NewReplay(testExchange, cp, asset.Spot, testOB)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = r.Advance(tt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if r.offset != 0 {
+		t.Errorf("received '%v' expected '%v'", r.offset, 0)
+	}
+
+	err = r.Advance(tt.Add(time.Second))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	ask, err := r.depth.GetBestAsk()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ask != 101 {
+		t.Errorf("received '%v' expected '%v'", ask, 101)
+	}
+
+	err = r.Advance(tt.Add(time.Minute))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	ask, err = r.depth.GetBestAsk()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ask != 102 {
+		t.Errorf("received '%v' expected '%v'", ask, 102)
+	}
+}
+
+func TestFill(t *testing.T) {
+	t.Parallel()
+	var r *Replay
+	_, err := r.Fill(tt, gctorder.Buy, 1)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	r, err = NewReplay(testExchange, cp, asset.Spot, testOB)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = r.Fill(tt, gctorder.Buy, 0)
+	if !errors.Is(err, errInvalidAmount) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAmount)
+	}
+	_, err = r.Fill(tt, gctorder.Buy, 1)
+	if !errors.Is(err, ErrNoRecords) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoRecords)
+	}
+	_, err = r.Fill(tt.Add(time.Millisecond), gctorder.UnknownSide, 1)
+	if !errors.Is(err, errInvalidSide) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidSide)
+	}
+
+	m, err := r.Fill(tt.Add(time.Millisecond), gctorder.Buy, 2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if m.Purchased != 2 {
+		t.Errorf("received '%v' expected '%v'", m.Purchased, 2)
+	}
+	if m.AverageOrderCost != 101.5 {
+		t.Errorf("received '%v' expected '%v'", m.AverageOrderCost, 101.5)
+	}
+	ask, err := r.depth.GetBestAsk()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ask != 102 {
+		t.Errorf("received '%v' expected '%v'", ask, 102)
+	}
+
+	// consumed liquidity is not available to the next order
+	m, err = r.Fill(tt.Add(time.Millisecond), gctorder.Buy, 2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if m.Purchased != 1 || !m.FullBookSideConsumed {
+		t.Errorf("received '%v' expected '%v' with full book side consumed", m.Purchased, 1)
+	}
+
+	m, err = r.Fill(tt.Add(time.Millisecond), gctorder.Sell, 1.5)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if m.Sold != 1.5 {
+		t.Errorf("received '%v' expected '%v'", m.Sold, 1.5)
+	}
+	bid, err := r.depth.GetBestBid()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if bid != 98 {
+		t.Errorf("received '%v' expected '%v'", bid, 98)
+	}
+
+	err = r.Reset()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	m, err = r.Fill(tt.Add(time.Millisecond), gctorder.Buy, 1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if m.AverageOrderCost != 101 {
+		t.Errorf("received '%v' expected '%v'", m.AverageOrderCost, 101)
+	}
+}
+
+func TestBestBidAsk(t *testing.T) {
+	t.Parallel()
+	var r *Replay
+	_, _, err := r.BestBidAsk(tt)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	r, err = NewReplay(testExchange, cp, asset.Spot, testOB)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, _, err = r.BestBidAsk(tt)
+	if !errors.Is(err, ErrNoRecords) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNoRecords)
+	}
+	bid, ask, err := r.BestBidAsk(tt.Add(time.Millisecond))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if bid.Price != 99 || bid.Amount != 1 {
+		t.Errorf("received '%v' expected '%v'", bid, gctorderbook.Tranche{Price: 99, Amount: 1})
+	}
+	if ask.Price != 101 || ask.Amount != 1 {
+		t.Errorf("received '%v' expected '%v'", ask, gctorderbook.Tranche{Price: 101, Amount: 1})
+	}
+	_, ask, err = r.BestBidAsk(tt.Add(time.Minute))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if ask.Price != 102 {
+		t.Errorf("received '%v' expected '%v'", ask.Price, 102)
+	}
+
+	_, err = r.Fill(tt.Add(time.Minute), gctorder.Buy, 2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, _, err = r.BestBidAsk(tt.Add(time.Minute))
+	if !errors.Is(err, errOneSidedBook) {
+		t.Errorf("received '%v' expected '%v'", err, errOneSidedBook)
+	}
+}
+
+func TestCandles(t *testing.T) {
+	t.Parallel()
+	var r *Replay
+	_, err := r.Candles(gctkline.OneMin)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	records := append([]Record{}, testOB...)
+	records = append(records, Record{
+		Time: tt.Add(time.Minute * 3),
+		Bids: []gctorderbook.Tranche{{Price: 110, Amount: 1}},
+		Asks: []gctorderbook.Tranche{{Price: 112, Amount: 1}},
+	})
+	r, err = NewReplay(testExchange, cp, asset.Spot, records)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = r.Candles(0)
+	if !errors.Is(err, gctkline.ErrInvalidInterval) {
+		t.Errorf("received '%v' expected '%v'", err, gctkline.ErrInvalidInterval)
+	}
+
+	k, err := r.Candles(gctkline.OneMin)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(k.Candles) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(k.Candles), 4)
+	}
+	if k.Candles[0].Open != 100 || k.Candles[0].Close != 100.5 || k.Candles[0].High != 100.5 {
+		t.Errorf("unexpected first candle %+v", k.Candles[0])
+	}
+	if k.Candles[1].Close != 100.5 || !k.Candles[1].Time.Equal(tt.Add(time.Minute)) {
+		t.Errorf("expected close to carry forward, received %+v", k.Candles[1])
+	}
+	if k.Candles[3].Close != 106 || k.Candles[3].Open != 100.5 {
+		t.Errorf("unexpected last candle %+v", k.Candles[3])
+	}
+	if r.offset != 0 {
+		t.Error("expected candle generation to not advance the replay")
+	}
+}
+
+func TestLoadCSV(t *testing.T) {
+	t.Parallel()
+	_, err := LoadCSV("not-a-real-file.csv", testExchange, cp, asset.Spot)
+	if err == nil {
+		t.Error("expected error")
+	}
+
+	dir := t.TempDir()
+	for _, tc := range []struct {
+		name     string
+		contents string
+		err      error
+	}{
+		{name: "width", contents: "1704067200000,snapshot,bid,99\n", err: errInvalidRowWidth},
+		{name: "type", contents: "1704067200000,delta,bid,99,1\n", err: errInvalidRecordType},
+		{name: "side", contents: "1704067200000,snapshot,middle,99,1\n", err: errInvalidSide},
+		{name: "empty", contents: "", err: ErrNoRecords},
+	} {
+		path := filepath.Join(dir, tc.name+".csv")
+		err = os.WriteFile(path, []byte(tc.contents), 0o600)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		_, err = LoadCSV(path, testExchange, cp, asset.Spot)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%v received '%v' expected '%v'", tc.name, err, tc.err)
+		}
+	}
+
+	path := filepath.Join(dir, "valid.csv")
+	err = os.WriteFile(path, []byte(
+		"1704067200000,snapshot,bid,99,1\n"+
+			"1704067200000,snapshot,ask,101,1\n"+
+			"1704067200500,update,ask,101,0\n"+
+			"1704067200500,update,ask,103,2\n"+
+			"1704067201000,snapshot,bid,100,1\n"+
+			"1704067201000,snapshot,ask,102,1\n"), 0o600)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	r, err := LoadCSV(path, testExchange, cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(r.records) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(r.records), 3)
+	}
+	if len(r.records[1].Asks) != 2 || r.records[1].IsSnapshot {
+		t.Errorf("unexpected update record %+v", r.records[1])
+	}
+	if !r.records[2].Time.Equal(tt.Add(time.Second)) {
+		t.Errorf("received '%v' expected '%v'", r.records[2].Time, tt.Add(time.Second))
+	}
+}
+
+type fakeDB struct {
+	snapshot    *orderbookdb.Record
+	snapshotErr error
+	records     []orderbookdb.Record
+	rangeStart  time.Time
+}
+
+func (f *fakeDB) Insert(...*orderbookdb.Record) error {
+	return nil
+}
+
+func (f *fakeDB) GetInRange(_, _, _, _ string, startDate, _ time.Time) ([]orderbookdb.Record, error) {
+	f.rangeStart = startDate
+	return f.records, nil
+}
+
+func (f *fakeDB) GetLatestSnapshot(_, _, _, _ string, _ time.Time) (*orderbookdb.Record, error) {
+	return f.snapshot, f.snapshotErr
+}
+
+func TestLoadDatabase(t *testing.T) {
+	t.Parallel()
+	_, err := LoadDatabase(nil, tt, tt.Add(time.Hour), testExchange, cp, asset.Spot)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	db := &fakeDB{snapshotErr: errDB}
+	_, err = LoadDatabase(db, tt, tt.Add(time.Hour), testExchange, cp, asset.Spot)
+	if !errors.Is(err, errDB) {
+		t.Errorf("received '%v' expected '%v'", err, errDB)
+	}
+
+	db.snapshot = &orderbookdb.Record{
+		IsSnapshot: true,
+		Bids:       []orderbookdb.Level{{Price: 99, Amount: 1}},
+		Asks:       []orderbookdb.Level{{Price: 101, Amount: 1}},
+		Timestamp:  tt.Add(-time.Minute),
+	}
+	db.snapshotErr = nil
+	db.records = []orderbookdb.Record{
+		{
+			Asks:      []orderbookdb.Level{{Price: 100, Amount: 1}},
+			Timestamp: tt.Add(time.Minute),
+		},
+	}
+	r, err := LoadDatabase(db, tt, tt.Add(time.Hour), testExchange, cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(r.records) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(r.records), 2)
+	}
+	if !r.records[0].IsSnapshot || r.records[1].Asks[0].Price != 100 {
+		t.Errorf("unexpected records %+v", r.records)
+	}
+	if !db.rangeStart.After(db.snapshot.Timestamp) {
+		t.Error("expected range to start after the latest snapshot")
+	}
+}
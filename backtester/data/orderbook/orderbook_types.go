@@ -0,0 +1,53 @@
+package orderbook
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	snapshotStr = "snapshot"
+	updateStr   = "update"
+	bidStr      = "bid"
+	askStr      = "ask"
+)
+
+var (
+	// ErrNoRecords is returned when there is no orderbook data to replay
+	ErrNoRecords = errors.New("no orderbook records")
+
+	errFirstRecordNotSnapshot = errors.New("first orderbook record must be a snapshot")
+	errInvalidRowWidth        = errors.New("invalid orderbook row width")
+	errInvalidRecordType      = errors.New("invalid orderbook record type")
+	errInvalidSide            = errors.New("invalid orderbook side")
+	errInvalidAmount          = errors.New("amount must be greater than zero")
+	errOneSidedBook           = errors.New("orderbook does not have both bids and asks")
+)
+
+// Record is a recorded orderbook snapshot or incremental update.
+// Snapshots replace the entire book, updates amend individual
+// price levels where an amount of zero removes the level
+type Record struct {
+	Time       time.Time
+	IsSnapshot bool
+	Bids       []gctorderbook.Tranche
+	Asks       []gctorderbook.Tranche
+}
+
+// Replay steps through recorded orderbook data, maintaining an
+// orderbook depth which orders can be filled against
+type Replay struct {
+	m           sync.Mutex
+	exchange    string
+	pair        currency.Pair
+	asset       asset.Item
+	records     []Record
+	offset      int
+	lastUpdated time.Time
+	depth       *gctorderbook.Depth
+}
@@ -32,7 +32,7 @@ func (bt *BackTest) Reset() error {
 		return gctcommon.ErrNilPointer
 	}
 	var err error
-	if bt.orderManager != nil {
+	if bt.orderManager != nil && !bt.hosted {
 		err = bt.orderManager.Stop()
 		if err != nil {
 			return err
@@ -419,6 +419,11 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 			return err
 		}
 
+		err = bt.Portfolio.ApplyFundingRates(ev, funds)
+		if err != nil {
+			return fmt.Errorf("ApplyFundingRates %v", err)
+		}
+
 		err = bt.Portfolio.UpdatePNL(ev, ev.GetClosePrice())
 		if err != nil {
 			if errors.Is(err, futures.ErrPositionNotFound) {
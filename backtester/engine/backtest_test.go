@@ -2,7 +2,9 @@ package engine
 
 import (
 	"errors"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"testing"
@@ -318,6 +320,62 @@ func TestLoadDataDatabase(t *testing.T) {
 	}
 }
 
+func TestLoadOrderbookData(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			DataType: common.OrderbookStr,
+			Interval: gctkline.OneMin,
+		},
+	}
+	_, _, err := bt.loadOrderbookData(cfg, nil, cp, asset.Spot)
+	if !errors.Is(err, engine.ErrExchangeNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, engine.ErrExchangeNotFound)
+	}
+
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	_, _, err = bt.loadOrderbookData(cfg, exch, cp, asset.Spot)
+	if !errors.Is(err, errNoDataSource) {
+		t.Errorf("received '%v' expected '%v'", err, errNoDataSource)
+	}
+
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := []string{
+		strconv.FormatInt(tt.UnixMilli(), 10) + ",snapshot,bid,99,1",
+		strconv.FormatInt(tt.UnixMilli(), 10) + ",snapshot,ask,101,1",
+		strconv.FormatInt(tt.Add(time.Minute*2).UnixMilli(), 10) + ",update,ask,101,0",
+		strconv.FormatInt(tt.Add(time.Minute*2).UnixMilli(), 10) + ",update,ask,103,2",
+	}
+	fp := filepath.Join(t.TempDir(), "orderbook.csv")
+	err = os.WriteFile(fp, []byte(strings.Join(rows, "\n")), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.DataSettings.CSVData = &config.CSVData{FullPath: fp}
+	klineData, replay, err := bt.loadOrderbookData(cfg, exch, cp, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replay == nil {
+		t.Fatal("expected orderbook replay")
+	}
+	if len(klineData.Item.Candles) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(klineData.Item.Candles), 3)
+	}
+	if klineData.Item.Candles[2].Close != 101 {
+		t.Errorf("received '%v' expected '%v'", klineData.Item.Candles[2].Close, 101)
+	}
+}
+
 func TestLoadDataLive(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -35,6 +35,8 @@ type BackTest struct {
 	verbose                  bool
 	hasProcessedAnEvent      bool
 	hasShutdown              bool
+	hosted                   bool
+	sharedDatabase           bool
 	shutdown                 chan struct{}
 	MetaData                 TaskMetaData
 	DataHolder               data.Holder
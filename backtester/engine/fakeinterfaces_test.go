@@ -80,6 +80,10 @@ func (f fakeFolio) TrackFuturesOrder(fill.Event, funding.IFundReleaser) (*portfo
 	return &portfolio.PNLSummary{}, nil
 }
 
+func (f fakeFolio) ApplyFundingRates(data.Event, funding.IFundReleaser) error {
+	return nil
+}
+
 func (f fakeFolio) UpdatePNL(common.Event, decimal.Decimal) error {
 	return nil
 }
@@ -32,6 +32,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -804,3 +805,86 @@ func (s *GRPCServer) ClearAllTasks(_ context.Context, _ *btrpc.ClearAllTasksRequ
 		RemainingTasks: remainingResponse,
 	}, nil
 }
+
+// ExecuteParameterSweep runs a strategy config file once for every combination
+// of its parameter sweep custom settings and returns the results ranked.
+// Sweep settings in the request override those of the strategy config file
+func (s *GRPCServer) ExecuteParameterSweep(_ context.Context, request *btrpc.ExecuteParameterSweepRequest) (*btrpc.ExecuteParameterSweepResponse, error) {
+	if s.config == nil {
+		return nil, fmt.Errorf("%w server config", gctcommon.ErrNilPointer)
+	}
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if request == nil {
+		return nil, fmt.Errorf("%w request", gctcommon.ErrNilPointer)
+	}
+	cfg, err := config.ReadStrategyConfigFromFile(request.StrategyFilePath)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.StrategySettings.ParameterSweep == nil {
+		cfg.StrategySettings.ParameterSweep = &config.ParameterSweep{}
+	}
+	sweep := cfg.StrategySettings.ParameterSweep
+	if len(request.Parameters) > 0 {
+		sweep.Parameters = make([]config.SweepParameter, len(request.Parameters))
+		for i := range request.Parameters {
+			sweep.Parameters[i] = config.SweepParameter{
+				Key:    request.Parameters[i].Key,
+				Values: request.Parameters[i].Values,
+				Start:  request.Parameters[i].Start,
+				End:    request.Parameters[i].End,
+				Step:   request.Parameters[i].Step,
+			}
+		}
+	}
+	if request.RankBy != "" {
+		sweep.RankBy = request.RankBy
+	}
+	if request.MaxConcurrentTasks > 0 {
+		sweep.MaxConcurrentTasks = int(request.MaxConcurrentTasks)
+	}
+	if request.WalkForward != nil {
+		sweep.WalkForward = &config.WalkForward{
+			InSample:    request.WalkForward.InSample.AsDuration(),
+			OutOfSample: request.WalkForward.OutOfSample.AsDuration(),
+		}
+	}
+
+	summary, err := s.manager.ExecuteParameterSweep(cfg, s.config)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]*btrpc.SweepResult, len(summary.Results))
+	for i := range summary.Results {
+		results[i] = &btrpc.SweepResult{
+			Rank:       int64(summary.Results[i].Rank),
+			Parameters: summary.Results[i].Parameters,
+			StartDate:  timestamppb.New(summary.Results[i].StartDate),
+			EndDate:    timestamppb.New(summary.Results[i].EndDate),
+			Statistics: convertSweepStatistics(&summary.Results[i].Statistics),
+		}
+		if summary.Results[i].InSample != nil {
+			results[i].InSample = convertSweepStatistics(summary.Results[i].InSample)
+		}
+	}
+	return &btrpc.ExecuteParameterSweepResponse{
+		StrategyName: summary.Strategy,
+		RankBy:       summary.RankBy,
+		Combinations: int64(summary.Combinations),
+		WalkForward:  summary.WalkForward,
+		Results:      results,
+	}, nil
+}
+
+// convertSweepStatistics converts sweep statistics into a RPC format
+func convertSweepStatistics(s *SweepStatistics) *btrpc.SweepStatistics {
+	return &btrpc.SweepStatistics{
+		SharpeRatio:      s.SharpeRatio.String(),
+		SortinoRatio:     s.SortinoRatio.String(),
+		MaxDrawdown:      s.MaxDrawdown.String(),
+		StrategyMovement: s.StrategyMovement.String(),
+		TotalOrders:      s.TotalOrders,
+	}
+}
@@ -544,3 +544,37 @@ func TestGRPCClearAllTasks(t *testing.T) {
 	assert.NoError(t, err, "ClearAllTasks should not error")
 	assert.Empty(t, s.manager.tasks, "tasks should be empty")
 }
+
+func TestGRPCExecuteParameterSweep(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.ExecuteParameterSweep(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer, "ExecuteParameterSweep should error correctly with a nil config")
+
+	s.config, err = config.GenerateDefaultConfig()
+	require.NoError(t, err, "GenerateDefaultConfig must not error")
+	_, err = s.ExecuteParameterSweep(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer, "ExecuteParameterSweep should error correctly with a nil task manager")
+
+	s.manager = NewTaskManager()
+	_, err = s.ExecuteParameterSweep(t.Context(), nil)
+	assert.ErrorIs(t, err, gctcommon.ErrNilPointer, "ExecuteParameterSweep should error correctly with a nil request")
+
+	_, err = s.ExecuteParameterSweep(t.Context(), &btrpc.ExecuteParameterSweepRequest{})
+	assert.ErrorIs(t, err, common.ErrFileNotFound)
+
+	_, err = s.ExecuteParameterSweep(t.Context(), &btrpc.ExecuteParameterSweepRequest{
+		StrategyFilePath: dcaConfigPath,
+	})
+	assert.Error(t, err, "ExecuteParameterSweep should error without sweep parameters")
+
+	_, err = s.ExecuteParameterSweep(t.Context(), &btrpc.ExecuteParameterSweepRequest{
+		StrategyFilePath: dcaConfigPath,
+		Parameters:       []*btrpc.SweepParameter{{Key: "period", Values: []float64{1}}},
+		WalkForward: &btrpc.WalkForward{
+			InSample:    durationpb.New(time.Hour * 24 * 365),
+			OutOfSample: durationpb.New(time.Hour * 24 * 365),
+		},
+	})
+	assert.ErrorIs(t, err, errNoWalkForwardWindows)
+}
@@ -0,0 +1,109 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/plugins/strategies"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// NewHostedBacktester creates a live strategy task which trades through a
+// running GoCryptoTrader engine's exchanges and order manager. Rather than
+// polling the exchange API for candles, closed candles are appended to the
+// task as they are built by the engine
+func NewHostedBacktester(strategyCfg *config.Config, em *engine.ExchangeManager, om *engine.OrderManager, verbose bool) (*BackTest, error) {
+	if strategyCfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if em == nil {
+		return nil, fmt.Errorf("%w exchange manager", gctcommon.ErrNilPointer)
+	}
+	if om == nil {
+		return nil, fmt.Errorf("%w order manager", gctcommon.ErrNilPointer)
+	}
+	if strategyCfg.DataSettings.LiveData == nil {
+		return nil, errHostedLiveOnly
+	}
+	if err := strategyCfg.Validate(); err != nil {
+		return nil, err
+	}
+	bt, err := NewBacktester()
+	if err != nil {
+		return nil, err
+	}
+	bt.hosted = true
+	bt.exchangeManager = em
+	bt.orderManager = om
+	err = bt.SetupFromConfig(strategyCfg, "", "", verbose)
+	if err != nil {
+		return nil, err
+	}
+	err = bt.SetupMetaData()
+	if err != nil {
+		return nil, err
+	}
+	return bt, nil
+}
+
+// LoadStrategyPlugin loads custom strategies from a Go plugin so they can be
+// referenced by hosted strategy configs
+func (StrategyHost) LoadStrategyPlugin(path string) error {
+	return strategies.LoadCustomStrategies(path)
+}
+
+// LoadStrategy reads a strategy config and creates a hosted task from it
+func (StrategyHost) LoadStrategy(configPath string, em *engine.ExchangeManager, om *engine.OrderManager, verbose bool) (engine.HostedStrategy, error) {
+	cfg, err := config.ReadStrategyConfigFromFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+	bt, err := NewHostedBacktester(cfg, em, om, verbose)
+	if err != nil {
+		return nil, err
+	}
+	return &hostedStrategy{bt: bt}, nil
+}
+
+// Start begins processing appended candles
+func (h *hostedStrategy) Start() error {
+	return h.bt.ExecuteStrategy(false)
+}
+
+// Stop ceases processing appended candles
+func (h *hostedStrategy) Stop() error {
+	return h.bt.Stop()
+}
+
+// GetCandleSubscriptions returns the candles the hosted task requires
+func (h *hostedStrategy) GetCandleSubscriptions() []engine.CandleSubscription {
+	d, ok := h.bt.LiveDataHandler.(*dataChecker)
+	if !ok {
+		return nil
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	resp := make([]engine.CandleSubscription, len(d.sourcesToCheck))
+	for i := range d.sourcesToCheck {
+		resp[i] = engine.CandleSubscription{
+			Exchange: d.sourcesToCheck[i].exchangeName,
+			Asset:    d.sourcesToCheck[i].asset,
+			Pair:     d.sourcesToCheck[i].pair,
+			Interval: d.sourcesToCheck[i].pairCandles.Item.Interval,
+		}
+	}
+	return resp
+}
+
+// AppendCandle appends a closed candle to be processed on the next data check
+func (h *hostedStrategy) AppendCandle(exchangeName string, a asset.Item, cp currency.Pair, c *gctkline.Candle) error {
+	d, ok := h.bt.LiveDataHandler.(*dataChecker)
+	if !ok {
+		return fmt.Errorf("%w live data handler", gctcommon.ErrNilPointer)
+	}
+	return d.AppendStreamedCandle(exchangeName, a, cp, c)
+}
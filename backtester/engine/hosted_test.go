@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func hostedTestConfig() *config.Config {
+	return &config.Config{
+		Nickname: "hosted",
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName: testExchange,
+				Asset:        asset.Spot,
+				Base:         currency.BTC,
+				Quote:        currency.USDT,
+				SpotDetails: &config.SpotDetails{
+					InitialQuoteFunds: &leet,
+				},
+				MakerFee: &decimal.Zero,
+				TakerFee: &decimal.Zero,
+				BuySide: config.MinMax{
+					MinimumSize:  decimal.NewFromFloat(0.01),
+					MaximumSize:  decimal.NewFromInt(1),
+					MaximumTotal: decimal.NewFromInt(1000),
+				},
+				SellSide: config.MinMax{
+					MinimumSize:  decimal.NewFromFloat(0.01),
+					MaximumSize:  decimal.NewFromInt(1),
+					MaximumTotal: decimal.NewFromInt(1000),
+				},
+			},
+		},
+		DataSettings: config.DataSettings{
+			DataType: common.CandleStr,
+			Interval: gctkline.OneMin,
+			LiveData: &config.LiveData{
+				NewEventTimeout: time.Minute,
+				DataCheckTimer:  time.Second,
+			},
+		},
+		StrategySettings: config.StrategySettings{
+			Name:               dollarcostaverage.Name,
+			DisableUSDTracking: true,
+		},
+		PortfolioSettings: config.PortfolioSettings{
+			BuySide: config.MinMax{
+				MinimumSize:  decimal.NewFromFloat(0.01),
+				MaximumSize:  decimal.NewFromInt(1),
+				MaximumTotal: decimal.NewFromInt(1000),
+			},
+			SellSide: config.MinMax{
+				MinimumSize:  decimal.NewFromFloat(0.01),
+				MaximumSize:  decimal.NewFromInt(1),
+				MaximumTotal: decimal.NewFromInt(1000),
+			},
+		},
+	}
+}
+
+func hostedTestManagers(t *testing.T) (*engine.ExchangeManager, *engine.OrderManager) {
+	t.Helper()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  true,
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	err = em.Add(exch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	om, err := engine.SetupOrderManager(em, &engine.CommunicationManager{}, &sync.WaitGroup{}, &gctconfig.OrderManager{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return em, om
+}
+
+func TestNewHostedBacktester(t *testing.T) {
+	t.Parallel()
+	_, err := NewHostedBacktester(nil, nil, nil, false)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	cfg := hostedTestConfig()
+	_, err = NewHostedBacktester(cfg, nil, nil, false)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	em, om := hostedTestManagers(t)
+	_, err = NewHostedBacktester(cfg, em, nil, false)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	cfg.DataSettings.LiveData = nil
+	_, err = NewHostedBacktester(cfg, em, om, false)
+	if !errors.Is(err, errHostedLiveOnly) {
+		t.Errorf("received '%v' expected '%v'", err, errHostedLiveOnly)
+	}
+
+	cfg = hostedTestConfig()
+	bt, err := NewHostedBacktester(cfg, em, om, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.hosted {
+		t.Error("expected hosted backtester")
+	}
+	if bt.orderManager != om {
+		t.Error("expected the provided order manager to be used")
+	}
+	if !bt.MetaData.LiveTesting {
+		t.Error("expected live testing")
+	}
+	err = bt.Reset()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestHostedStrategy(t *testing.T) {
+	t.Parallel()
+	em, om := hostedTestManagers(t)
+	bt, err := NewHostedBacktester(hostedTestConfig(), em, om, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	h := &hostedStrategy{bt: bt}
+	subs := h.GetCandleSubscriptions()
+	if len(subs) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(subs), 1)
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	if subs[0].Exchange != testExchange || subs[0].Asset != asset.Spot || !subs[0].Pair.Equal(cp) || subs[0].Interval != gctkline.OneMin {
+		t.Errorf("received '%+v' unexpected subscription", subs[0])
+	}
+
+	err = h.AppendCandle(testExchange, asset.Spot, cp, &gctkline.Candle{
+		Time:   time.Now().Truncate(time.Minute).Add(-time.Minute),
+		Open:   100,
+		High:   110,
+		Low:    90,
+		Close:  105,
+		Volume: 10,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	dc, ok := bt.LiveDataHandler.(*dataChecker)
+	if !ok {
+		t.Fatal("expected dataChecker")
+	}
+	atomic.StoreUint32(&dc.started, 1)
+	updated, err := dc.FetchLatestData()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !updated {
+		t.Fatal("expected appended candle to update data")
+	}
+	err = bt.Run()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.hasProcessedAnEvent {
+		t.Error("expected appended candle to be processed by the strategy")
+	}
+
+	h.bt = &BackTest{}
+	err = h.AppendCandle(testExchange, asset.Spot, cp, &gctkline.Candle{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	if subs = h.GetCandleSubscriptions(); len(subs) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(subs), 0)
+	}
+}
+
+func TestStrategyHostLoadStrategy(t *testing.T) {
+	t.Parallel()
+	em, om := hostedTestManagers(t)
+	var sh StrategyHost
+	_, err := sh.LoadStrategy(filepath.Join(t.TempDir(), "missing.strat"), em, om, false)
+	if !errors.Is(err, common.ErrFileNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrFileNotFound)
+	}
+
+	cfgData, err := json.Marshal(hostedTestConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "hosted.strat")
+	err = os.WriteFile(path, cfgData, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := sh.LoadStrategy(path, em, om, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(s.GetCandleSubscriptions()) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(s.GetCandleSubscriptions()), 1)
+	}
+
+	err = sh.LoadStrategyPlugin(filepath.Join(t.TempDir(), "missing.so"))
+	if err == nil {
+		t.Error("expected error loading missing plugin")
+	}
+}
@@ -0,0 +1,15 @@
+package engine
+
+import "errors"
+
+var errHostedLiveOnly = errors.New("hosted strategies require live data settings")
+
+// StrategyHost loads strategy configs as live tasks for the GoCryptoTrader
+// engine's strategy runner, implementing its StrategyLoader interface
+type StrategyHost struct{}
+
+// hostedStrategy runs a live task within the GoCryptoTrader engine,
+// implementing its HostedStrategy interface
+type hostedStrategy struct {
+	bt *BackTest
+}
@@ -316,11 +316,11 @@ func (d *dataChecker) AppendDataSource(dataSource *liveDataSourceSetup) error {
 	if err != nil {
 		return err
 	}
-	if dataSource.dataRequestRetryTolerance <= 0 {
+	if dataSource.dataRequestRetryTolerance <= 0 && !dataSource.streamed {
 		log.Warnf(common.LiveStrategy, "Invalid data retry tolerance, setting %v to %v", dataSource.dataRequestRetryTolerance, defaultDataRetryAttempts)
 		dataSource.dataRequestRetryTolerance = defaultDataRetryAttempts
 	}
-	if dataSource.dataRequestRetryWaitTime <= 0 {
+	if dataSource.dataRequestRetryWaitTime <= 0 && !dataSource.streamed {
 		log.Warnf(common.LiveStrategy, "Invalid data request wait time, setting %v to %v", dataSource.dataRequestRetryWaitTime, defaultDataRequestWaitTime)
 		dataSource.dataRequestRetryWaitTime = defaultDataRequestWaitTime
 	}
@@ -336,11 +336,38 @@ func (d *dataChecker) AppendDataSource(dataSource *liveDataSourceSetup) error {
 		dataRequestRetryTolerance: dataSource.dataRequestRetryTolerance,
 		dataRequestRetryWaitTime:  dataSource.dataRequestRetryWaitTime,
 		verboseExchangeRequest:    dataSource.verboseExchangeRequest,
+		streamed:                  dataSource.streamed,
 	})
 
 	return nil
 }
 
+// AppendStreamedCandle stores a closed candle for a streamed data source
+// to be appended on the next data check
+func (d *dataChecker) AppendStreamedCandle(exchangeName string, a asset.Item, cp currency.Pair, candle *gctkline.Candle) error {
+	if d == nil {
+		return fmt.Errorf("%w dataChecker", gctcommon.ErrNilPointer)
+	}
+	if candle == nil {
+		return fmt.Errorf("%w candle", gctcommon.ErrNilPointer)
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	for i := range d.sourcesToCheck {
+		if !strings.EqualFold(d.sourcesToCheck[i].exchangeName, exchangeName) ||
+			d.sourcesToCheck[i].asset != a ||
+			!d.sourcesToCheck[i].pair.Equal(cp) {
+			continue
+		}
+		if !d.sourcesToCheck[i].streamed {
+			return fmt.Errorf("%w %v %v %v", errDataSourceNotStreamed, exchangeName, a, cp)
+		}
+		d.sourcesToCheck[i].streamedCandles = append(d.sourcesToCheck[i].streamedCandles, *candle)
+		return nil
+	}
+	return fmt.Errorf("%w %v %v %v", errDataSourceNotFound, exchangeName, a, cp)
+}
+
 // FetchLatestData loads the latest data for all stored data sources
 func (d *dataChecker) FetchLatestData() (bool, error) {
 	if d == nil {
@@ -477,6 +504,9 @@ func (c *liveDataSourceDataHandler) loadCandleData(timeToRetrieve time.Time) (bo
 	if c.pairCandles == nil {
 		return false, fmt.Errorf("%w pair candles", gctcommon.ErrNilPointer)
 	}
+	if c.streamed {
+		return c.loadStreamedCandles(), nil
+	}
 	var candles *gctkline.Item
 	var err error
 	for i := int64(1); i <= c.dataRequestRetryTolerance; i++ {
@@ -521,3 +551,27 @@ func (c *liveDataSourceDataHandler) loadCandleData(timeToRetrieve time.Time) (bo
 	}
 	return false, nil
 }
+
+// loadStreamedCandles moves pushed candles to the candles to be added
+// to the backtester event queue. Candles remain pending until every data
+// source has new data, so this reports whether any are pending
+func (c *liveDataSourceDataHandler) loadStreamedCandles() bool {
+	if c.candlesToAppend == nil {
+		c.candlesToAppend = &gctkline.Item{
+			Exchange:       c.pairCandles.Item.Exchange,
+			Pair:           c.pairCandles.Item.Pair,
+			UnderlyingPair: c.pairCandles.Item.UnderlyingPair,
+			Asset:          c.pairCandles.Item.Asset,
+			Interval:       c.pairCandles.Item.Interval,
+		}
+	}
+	for i := range c.streamedCandles {
+		if _, ok := c.processedData[c.streamedCandles[i].Time.UnixNano()]; ok {
+			continue
+		}
+		c.processedData[c.streamedCandles[i].Time.UnixNano()] = struct{}{}
+		c.candlesToAppend.Candles = append(c.candlesToAppend.Candles, c.streamedCandles[i])
+	}
+	c.streamedCandles = nil
+	return len(c.candlesToAppend.Candles) > 0
+}
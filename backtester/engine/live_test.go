@@ -2,6 +2,7 @@ package engine
 
 import (
 	"errors"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -309,6 +310,102 @@ func TestAppendDataSource(t *testing.T) {
 	}
 }
 
+func TestAppendStreamedCandle(t *testing.T) {
+	t.Parallel()
+	var dataHandler *dataChecker
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	err := dataHandler.AppendStreamedCandle(testExchange, asset.Spot, cp, &kline.Candle{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	dataHandler = &dataChecker{}
+	err = dataHandler.AppendStreamedCandle(testExchange, asset.Spot, cp, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	err = dataHandler.AppendStreamedCandle(testExchange, asset.Spot, cp, &kline.Candle{})
+	if !errors.Is(err, errDataSourceNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errDataSourceNotFound)
+	}
+
+	setup := &liveDataSourceSetup{
+		exchange: &binance.Binance{},
+		dataType: common.DataCandle,
+		asset:    asset.Spot,
+		pair:     cp,
+		interval: kline.OneMin,
+	}
+	err = dataHandler.AppendDataSource(setup)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = dataHandler.AppendStreamedCandle(dataHandler.sourcesToCheck[0].exchangeName, asset.Spot, cp, &kline.Candle{})
+	if !errors.Is(err, errDataSourceNotStreamed) {
+		t.Errorf("received '%v' expected '%v'", err, errDataSourceNotStreamed)
+	}
+
+	dataHandler.sourcesToCheck[0].streamed = true
+	err = dataHandler.AppendStreamedCandle(strings.ToUpper(dataHandler.sourcesToCheck[0].exchangeName), asset.Spot, cp.Format(currency.PairFormat{Delimiter: "-"}), &kline.Candle{Close: 1337})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(dataHandler.sourcesToCheck[0].streamedCandles) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(dataHandler.sourcesToCheck[0].streamedCandles), 1)
+	}
+}
+
+func TestLoadStreamedCandles(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	tt := time.Now().Truncate(time.Minute)
+	l := &liveDataSourceDataHandler{
+		streamed:      true,
+		processedData: make(map[int64]struct{}),
+		pairCandles: &datakline.DataFromKline{
+			Base: &data.Base{},
+			Item: &kline.Item{
+				Exchange: testExchange,
+				Asset:    asset.Spot,
+				Pair:     cp,
+				Interval: kline.OneMin,
+			},
+		},
+	}
+	updated, err := l.loadCandleData(tt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if updated {
+		t.Errorf("received '%v' expected '%v'", updated, false)
+	}
+
+	l.streamedCandles = []kline.Candle{{Time: tt}, {Time: tt}, {Time: tt.Add(time.Minute)}}
+	updated, err = l.loadCandleData(tt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !updated {
+		t.Errorf("received '%v' expected '%v'", updated, true)
+	}
+	if len(l.candlesToAppend.Candles) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(l.candlesToAppend.Candles), 2)
+	}
+	if l.candlesToAppend.Interval != kline.OneMin {
+		t.Errorf("received '%v' expected '%v'", l.candlesToAppend.Interval, kline.OneMin)
+	}
+	if len(l.streamedCandles) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(l.streamedCandles), 0)
+	}
+
+	// pending candles continue to report updates until they are appended
+	updated = l.loadStreamedCandles()
+	if !updated {
+		t.Errorf("received '%v' expected '%v'", updated, true)
+	}
+}
+
 func TestFetchLatestData(t *testing.T) {
 	t.Parallel()
 	dataHandler := &dataChecker{
@@ -25,6 +25,8 @@ var (
 	errNoCredsNoLive                = errors.New("cannot use real orders without credentials to fulfil those real orders")
 	errNoDataSetForClosingPositions = errors.New("no data was set for closing positions")
 	errNilError                     = errors.New("nil error received when expecting an error")
+	errDataSourceNotFound           = errors.New("data source not found")
+	errDataSourceNotStreamed        = errors.New("data source is not streamed")
 )
 
 var (
@@ -86,6 +88,7 @@ type liveDataSourceSetup struct {
 	dataRequestRetryTolerance int64
 	dataRequestRetryWaitTime  time.Duration
 	verboseExchangeRequest    bool
+	streamed                  bool
 }
 
 // liveDataSourceDataHandler is used to collect
@@ -103,4 +106,8 @@ type liveDataSourceDataHandler struct {
 	dataRequestRetryTolerance int64
 	dataRequestRetryWaitTime  time.Duration
 	verboseExchangeRequest    bool
+	// streamed sources have candles pushed via AppendStreamedCandle
+	// rather than fetched from the exchange API
+	streamed        bool
+	streamedCandles []gctkline.Candle
 }
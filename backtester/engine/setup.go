@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
@@ -17,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/api"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/database"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
@@ -35,10 +37,12 @@ import (
 	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	gctdatabase "github.com/thrasher-corp/gocryptotrader/database"
+	orderbookdb "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -76,7 +80,7 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 	if cfg.DataSettings.Interval < gctkline.FifteenSecond {
 		return fmt.Errorf("%w %v min interval size of %v", gctkline.ErrInvalidInterval, cfg.DataSettings.Interval, gctkline.FifteenSecond)
 	}
-	if cfg.DataSettings.DatabaseData != nil {
+	if cfg.DataSettings.DatabaseData != nil && !bt.sharedDatabase {
 		bt.databaseManager, err = engine.SetupDatabaseConnectionManager(&cfg.DataSettings.DatabaseData.Config)
 		if err != nil {
 			return err
@@ -189,7 +193,9 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		cp := currency.NewPair(cfg.CurrencySettings[i].Base, cfg.CurrencySettings[i].Quote).Format(*exchangeAsset.RequestFormat)
 		exchangeAsset.Available = exchangeAsset.Available.Add(cp)
 		exchangeAsset.Enabled = exchangeAsset.Enabled.Add(cp)
-		exchBase.Verbose = verbose
+		if !bt.hosted {
+			exchBase.Verbose = verbose
+		}
 		exchBase.CurrencyPairs.Pairs[cfg.CurrencySettings[i].Asset] = exchangeAsset
 	}
 
@@ -207,18 +213,20 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		}
 	}
 
-	bt.orderManager, err = engine.SetupOrderManager(bt.exchangeManager, &engine.CommunicationManager{}, &sync.WaitGroup{}, &gctconfig.OrderManager{
-		Verbose:                       verbose,
-		ActivelyTrackFuturesPositions: trackFuturesPositions,
-		RespectOrderHistoryLimits:     true,
-	})
-	if err != nil {
-		return err
-	}
+	if !bt.hosted {
+		bt.orderManager, err = engine.SetupOrderManager(bt.exchangeManager, &engine.CommunicationManager{}, &sync.WaitGroup{}, &gctconfig.OrderManager{
+			Verbose:                       verbose,
+			ActivelyTrackFuturesPositions: trackFuturesPositions,
+			RespectOrderHistoryLimits:     true,
+		})
+		if err != nil {
+			return err
+		}
 
-	err = bt.orderManager.Start()
-	if err != nil {
-		return err
+		err = bt.orderManager.Start()
+		if err != nil {
+			return err
+		}
 	}
 
 	for i := range cfg.CurrencySettings {
@@ -247,7 +255,8 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		if err != nil {
 			return err
 		}
-		if cfg.DataSettings.LiveData != nil && cfg.DataSettings.LiveData.RealOrders {
+		if cfg.DataSettings.LiveData != nil && cfg.DataSettings.LiveData.RealOrders && !bt.hosted {
+			// hosted exchanges trade with the engine's configured credentials
 			exchBase := exch.GetBase()
 			err = setExchangeCredentials(cfg, exchBase)
 			if err != nil {
@@ -461,6 +470,17 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 			return err
 		}
 	}
+	if replayer, ok := bt.Strategy.(strategies.OrderbookReplayHandler); ok {
+		for i := range e.CurrencySettings {
+			if e.CurrencySettings[i].OrderbookReplay == nil {
+				continue
+			}
+			err = replayer.SetOrderbookReplay(e.CurrencySettings[i].Exchange.GetName(), e.CurrencySettings[i].Asset, e.CurrencySettings[i].Pair, e.CurrencySettings[i].OrderbookReplay)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	bt.Portfolio = p
 	hasFunding := false
 	fundingItems, err := funds.GetAllFunding()
@@ -497,7 +517,13 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 		}
 
 		exchangeName := strings.ToLower(exch.GetName())
-		klineData, err := bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
+		var klineData *kline.DataFromKline
+		var replay *orderbook.Replay
+		if cfg.DataSettings.DataType == common.OrderbookStr {
+			klineData, replay, err = bt.loadOrderbookData(cfg, exch, pair, a)
+		} else {
+			klineData, err = bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -614,6 +640,13 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 				MaximumOrdersWithLeverageRatio: cfg.CurrencySettings[i].FuturesDetails.Leverage.MaximumOrdersWithLeverageRatio,
 			}
 		}
+		var fundingRates *fundingrate.HistoricalRates
+		if a.IsFutures() && cfg.DataSettings.LiveData == nil {
+			fundingRates, err = loadFundingRates(cfg, &cfg.CurrencySettings[i], exch, pair, a)
+			if err != nil {
+				return resp, err
+			}
+		}
 		resp.CurrencySettings = append(resp.CurrencySettings, exchange.Settings{
 			Exchange:                  exch,
 			MinimumSlippageRate:       cfg.CurrencySettings[i].MinimumSlippagePercent,
@@ -630,6 +663,8 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			SkipCandleVolumeFitting:   cfg.CurrencySettings[i].SkipCandleVolumeFitting,
 			CanUseExchangeLimits:      cfg.CurrencySettings[i].CanUseExchangeLimits,
 			UseExchangePNLCalculation: cfg.CurrencySettings[i].UseExchangePNLCalculation,
+			FundingRates:              fundingRates,
+			OrderbookReplay:           replay,
 		})
 	}
 
@@ -717,20 +752,9 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 
 	log.Infof(common.Setup, "Loading data for %v %v %v...\n", exch.GetName(), a, fPair)
 	resp := kline.NewDataFromKline()
-	underlyingPair := currency.EMPTYPAIR
-	if a.IsFutures() {
-		// returning the collateral currency along with using the
-		// fPair base creates a pair that links the futures contract to
-		// its underlyingPair pair
-		// eg BTCUSDT-PERP on Binance has a collateral currency of USDT
-		// taking the BTC base and USDT as quote, allows linking
-		// BTC-USDT and BTCUSDT-PERP
-		var curr currency.Code
-		curr, _, err = exch.GetCollateralCurrencyForContract(a, fPair)
-		if err != nil {
-			return resp, err
-		}
-		underlyingPair = currency.NewPair(fPair.Base, curr)
+	underlyingPair, err := getUnderlyingPair(exch, fPair, a)
+	if err != nil {
+		return resp, err
 	}
 
 	switch {
@@ -772,24 +796,19 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if cfg.DataSettings.DatabaseData.InclusiveEndDate {
 			cfg.DataSettings.DatabaseData.EndDate = cfg.DataSettings.DatabaseData.EndDate.Add(cfg.DataSettings.Interval.Duration())
 		}
-		if cfg.DataSettings.DatabaseData.Path == "" {
-			cfg.DataSettings.DatabaseData.Path = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
-		}
-		gctdatabase.DB.DataPath = cfg.DataSettings.DatabaseData.Path
-		err = gctdatabase.DB.SetConfig(&cfg.DataSettings.DatabaseData.Config)
-		if err != nil {
-			return nil, err
-		}
-		err = bt.databaseManager.Start(&sync.WaitGroup{})
-		if err != nil {
-			return nil, err
-		}
-		defer func() {
-			stopErr := bt.databaseManager.Stop()
-			if stopErr != nil {
-				log.Errorln(common.Setup, stopErr)
+		// shared database connections are managed by the caller
+		if bt.databaseManager != nil {
+			err = startDatabase(bt.databaseManager, cfg.DataSettings.DatabaseData)
+			if err != nil {
+				return nil, err
 			}
-		}()
+			defer func() {
+				stopErr := bt.databaseManager.Stop()
+				if stopErr != nil {
+					log.Errorln(common.Setup, stopErr)
+				}
+			}()
+		}
 		resp, err = loadDatabaseData(cfg, exch.GetName(), fPair, a, dataType, isUSDTrackingPair)
 		if err != nil {
 			return nil, fmt.Errorf("unable to retrieve data from GoCryptoTrader database. Error: %v. Please ensure the database is setup correctly and has data before use", err)
@@ -830,7 +849,8 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 			return resp, err
 		}
 	case cfg.DataSettings.LiveData != nil:
-		if !b.Features.Enabled.Kline.Intervals.ExchangeSupported(cfg.DataSettings.Interval) {
+		// hosted candles are built from trades, so any interval can be used
+		if !bt.hosted && !b.Features.Enabled.Kline.Intervals.ExchangeSupported(cfg.DataSettings.Interval) {
 			return nil, fmt.Errorf("%w don't trade live on custom candle interval of %v",
 				gctkline.ErrCannotConstructInterval,
 				cfg.DataSettings.Interval)
@@ -849,6 +869,7 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 			dataRequestRetryTolerance: cfg.DataSettings.LiveData.DataRequestRetryTolerance,
 			dataRequestRetryWaitTime:  cfg.DataSettings.LiveData.DataRequestRetryWaitTime,
 			verboseExchangeRequest:    cfg.DataSettings.VerboseExchangeRequests,
+			streamed:                  bt.hosted,
 		})
 		return nil, err
 	}
@@ -868,6 +889,142 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	return resp, nil
 }
 
+// getUnderlyingPair returns the pair a futures contract is based on
+func getUnderlyingPair(exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (currency.Pair, error) {
+	if !a.IsFutures() {
+		return currency.EMPTYPAIR, nil
+	}
+	// returning the collateral currency along with using the
+	// fPair base creates a pair that links the futures contract to
+	// its underlyingPair pair
+	// eg BTCUSDT-PERP on Binance has a collateral currency of USDT
+	// taking the BTC base and USDT as quote, allows linking
+	// BTC-USDT and BTCUSDT-PERP
+	curr, _, err := exch.GetCollateralCurrencyForContract(a, fPair)
+	if err != nil {
+		return currency.EMPTYPAIR, err
+	}
+	return currency.NewPair(fPair.Base, curr), nil
+}
+
+// startDatabase connects to the database defined in the config data settings
+func startDatabase(dbm *engine.DatabaseConnectionManager, dbData *config.DatabaseData) error {
+	if dbData.Path == "" {
+		dbData.Path = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
+	}
+	gctdatabase.DB.DataPath = dbData.Path
+	err := gctdatabase.DB.SetConfig(&dbData.Config)
+	if err != nil {
+		return err
+	}
+	return dbm.Start(&sync.WaitGroup{})
+}
+
+// loadOrderbookData loads recorded orderbook data from CSV or database sources.
+// Candles are derived from the replayed mid price to drive the backtest while
+// orders are filled against the replayed orderbook
+func (bt *BackTest) loadOrderbookData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (*kline.DataFromKline, *orderbook.Replay, error) {
+	if exch == nil {
+		return nil, nil, engine.ErrExchangeNotFound
+	}
+	if cfg.DataSettings.Interval <= 0 {
+		return nil, nil, errIntervalUnset
+	}
+	if cfg.DataSettings.CSVData != nil && cfg.DataSettings.DatabaseData != nil {
+		return nil, nil, errAmbiguousDataSource
+	}
+	underlyingPair, err := getUnderlyingPair(exch, fPair, a)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.Infof(common.Setup, "Loading orderbook data for %v %v %v...\n", exch.GetName(), a, fPair)
+	var replay *orderbook.Replay
+	var start, end time.Time
+	switch {
+	case cfg.DataSettings.CSVData != nil:
+		replay, err = orderbook.LoadCSV(cfg.DataSettings.CSVData.FullPath, exch.GetName(), fPair, a)
+		if err != nil {
+			return nil, nil, err
+		}
+	case cfg.DataSettings.DatabaseData != nil:
+		start, end = cfg.DataSettings.DatabaseData.StartDate, cfg.DataSettings.DatabaseData.EndDate
+		if cfg.DataSettings.DatabaseData.InclusiveEndDate {
+			end = end.Add(cfg.DataSettings.Interval.Duration())
+		}
+		// shared database connections are managed by the caller
+		if bt.databaseManager != nil {
+			err = startDatabase(bt.databaseManager, cfg.DataSettings.DatabaseData)
+			if err != nil {
+				return nil, nil, err
+			}
+			defer func() {
+				stopErr := bt.databaseManager.Stop()
+				if stopErr != nil {
+					log.Errorln(common.Setup, stopErr)
+				}
+			}()
+		}
+		var db *orderbookdb.DBService
+		db, err = orderbookdb.Setup(gctdatabase.DB)
+		if err != nil {
+			return nil, nil, err
+		}
+		replay, err = orderbook.LoadDatabase(db, start, end, exch.GetName(), fPair, a)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to retrieve orderbook data from GoCryptoTrader database. Error: %w. Please ensure the database is setup correctly and has data before use", err)
+		}
+	default:
+		return nil, nil, fmt.Errorf("%w for orderbook data, only CSV and database sources are supported", errNoDataSource)
+	}
+
+	item, err := replay.Candles(cfg.DataSettings.Interval)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !start.IsZero() {
+		// the replay includes the latest snapshot before the start date,
+		// only candles within the requested range are processed
+		candles := make([]gctkline.Candle, 0, len(item.Candles))
+		for i := range item.Candles {
+			if item.Candles[i].Time.Before(start) || !item.Candles[i].Time.Before(end) {
+				continue
+			}
+			candles = append(candles, item.Candles[i])
+		}
+		item.Candles = candles
+	}
+	if len(item.Candles) == 0 {
+		return nil, nil, fmt.Errorf("%w for %v %v %v", orderbook.ErrNoRecords, exch.GetName(), a, fPair)
+	}
+
+	resp := kline.NewDataFromKline()
+	resp.Item = item
+	resp.RangeHolder, err = gctkline.CalculateCandleDateRanges(
+		item.Candles[0].Time,
+		item.Candles[len(item.Candles)-1].Time.Add(cfg.DataSettings.Interval.Duration()),
+		cfg.DataSettings.Interval,
+		0,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = resp.RangeHolder.SetHasDataFromCandles(resp.Item.Candles)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Item.UnderlyingPair = underlyingPair
+	err = resp.Load()
+	if err != nil {
+		return nil, nil, err
+	}
+	err = bt.Reports.SetKlineData(resp.Item)
+	if err != nil {
+		return nil, nil, err
+	}
+	return resp, replay, nil
+}
+
 func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a asset.Item, dataType int64, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
 	if cfg == nil || cfg.DataSettings.DatabaseData == nil {
 		return nil, errors.New("nil config data received")
@@ -929,6 +1086,45 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 	}, nil
 }
 
+// loadFundingRates loads historical funding rates for perpetual futures from a
+// CSV file when set, otherwise from the exchange API when using API data
+func loadFundingRates(cfg *config.Config, cs *config.CurrencySettings, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (*fundingrate.HistoricalRates, error) {
+	if cs.FuturesDetails != nil && cs.FuturesDetails.FundingRatesPath != "" {
+		return csv.LoadFundingRates(cs.FuturesDetails.FundingRatesPath, exch.GetName(), fPair, a)
+	}
+	isPerpetual := a == asset.PerpetualSwap || a == asset.PerpetualContract
+	if !isPerpetual {
+		var err error
+		isPerpetual, err = exch.IsPerpetualFutureCurrency(a, fPair)
+		if err != nil &&
+			!errors.Is(err, gctcommon.ErrNotYetImplemented) &&
+			!errors.Is(err, gctcommon.ErrFunctionNotSupported) {
+			return nil, err
+		}
+	}
+	if !isPerpetual {
+		return nil, nil
+	}
+	if cfg.DataSettings.APIData == nil {
+		log.Warnf(common.Setup, "No funding rates set for perpetual %v %v %v, funding payments will not be applied. Set `funding-rates-path` to apply funding rates", exch.GetName(), a, fPair)
+		return nil, nil
+	}
+	rates, err := exch.GetHistoricalFundingRates(context.TODO(), &fundingrate.HistoricalRatesRequest{
+		Asset:                a,
+		Pair:                 fPair,
+		StartDate:            cfg.DataSettings.APIData.StartDate,
+		EndDate:              cfg.DataSettings.APIData.EndDate,
+		RespectHistoryLimits: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve funding rates for %v %v %v: %w", exch.GetName(), a, fPair, err)
+	}
+	rates.Exchange = strings.ToLower(exch.GetName())
+	rates.Asset = a
+	rates.Pair = fPair
+	return rates, nil
+}
+
 func setExchangeCredentials(cfg *config.Config, base *gctexchange.Base) error {
 	if cfg == nil || base == nil || cfg.DataSettings.LiveData == nil {
 		return gctcommon.ErrNilPointer
@@ -0,0 +1,423 @@
+package engine
+
+import (
+	"fmt"
+	"maps"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// ExecuteParameterSweep runs the strategy config once for every combination
+// of its parameter sweep values and returns the results ranked.
+// When a walk-forward is set, every combination is run over each rolling
+// in-sample window and the best combination is then run over the
+// following out-of-sample window
+func (t *TaskManager) ExecuteParameterSweep(strategyCfg *config.Config, btCfg *config.BacktesterConfig) (*SweepSummary, error) {
+	return t.executeParameterSweep(strategyCfg, btCfg, engine.NewExchangeManager)
+}
+
+// executeParameterSweep runs a parameter sweep, creating an exchange manager
+// for each combination with newExchangeManager
+func (t *TaskManager) executeParameterSweep(strategyCfg *config.Config, btCfg *config.BacktesterConfig, newExchangeManager func() *engine.ExchangeManager) (*SweepSummary, error) {
+	if t == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	if strategyCfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if btCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	sweep := strategyCfg.StrategySettings.ParameterSweep
+	if sweep == nil {
+		return nil, errNoParameterSweep
+	}
+	// validation normalises the config, so the caller's config is left as is
+	cfg := copyConfig(strategyCfg)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	combinations, err := sweep.Combinations()
+	if err != nil {
+		return nil, err
+	}
+	rankBy := sweep.RankBy
+	if rankBy == "" {
+		rankBy = config.RankBySharpeRatio
+	}
+	maxConcurrent := sweep.MaxConcurrentTasks
+	if maxConcurrent <= 0 {
+		maxConcurrent = runtime.NumCPU()
+	}
+	// reports are not generated for individual sweep runs
+	runCfg := *btCfg
+	runCfg.Report.GenerateReport = false
+	runCfg.Report.OutputPath = ""
+
+	if cfg.DataSettings.DatabaseData != nil {
+		// the database connection is process wide, so it is shared by every
+		// combination rather than started and stopped by each of them
+		dbm, err := startSweepDatabase(cfg)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := dbm.Stop(); err != nil {
+				log.Errorln(common.Backtester, err)
+			}
+		}()
+	}
+	runner := &sweepRunner{
+		tasks:              t,
+		cfg:                cfg,
+		btCfg:              &runCfg,
+		newExchangeManager: newExchangeManager,
+		maxConcurrent:      maxConcurrent,
+	}
+
+	summary := &SweepSummary{
+		Strategy:     cfg.StrategySettings.Name,
+		RankBy:       rankBy,
+		Combinations: len(combinations),
+		WalkForward:  sweep.WalkForward != nil,
+	}
+	if sweep.WalkForward == nil {
+		summary.Results, err = runner.run(combinations, time.Time{}, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		rankSweepResults(summary.Results, rankBy)
+		return summary, nil
+	}
+
+	start, end := getDataRange(cfg)
+	windows, err := getWalkForwardWindows(start, end, sweep.WalkForward)
+	if err != nil {
+		return nil, err
+	}
+	summary.Results = make([]SweepResult, len(windows))
+	for i := range windows {
+		inSample, err := runner.run(combinations, windows[i].inSampleStart, windows[i].inSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		rankSweepResults(inSample, rankBy)
+		outOfSample, err := runner.run([]map[string]float64{inSample[0].Parameters}, windows[i].inSampleEnd, windows[i].outSampleEnd)
+		if err != nil {
+			return nil, err
+		}
+		outOfSample[0].InSample = &inSample[0].Statistics
+		summary.Results[i] = outOfSample[0]
+	}
+	rankSweepResults(summary.Results, rankBy)
+	return summary, nil
+}
+
+// startSweepDatabase connects to the database defined in the config data
+// settings for the duration of a sweep
+func startSweepDatabase(cfg *config.Config) (*engine.DatabaseConnectionManager, error) {
+	dbm, err := engine.SetupDatabaseConnectionManager(&cfg.DataSettings.DatabaseData.Config)
+	if err != nil {
+		return nil, err
+	}
+	err = startDatabase(dbm, cfg.DataSettings.DatabaseData)
+	if err != nil {
+		return nil, err
+	}
+	return dbm, nil
+}
+
+// run runs every combination over the same date range as tasks of the task
+// manager, limiting how many backtests run at once. Tasks are set up in
+// order and cleared from the task manager once their results are collected
+func (s *sweepRunner) run(combinations []map[string]float64, start, end time.Time) ([]SweepResult, error) {
+	results := make([]SweepResult, len(combinations))
+	errs := make([]error, len(combinations))
+	tasks := make([]*BackTest, len(combinations))
+	finished := make(chan int, len(combinations))
+	var next, running int
+	for next < len(combinations) || running > 0 {
+		for running < s.maxConcurrent && next < len(combinations) {
+			i := next
+			next++
+			bt, err := s.startTask(combinations[i], start, end)
+			if err != nil {
+				errs[i] = fmt.Errorf("%v: %w", combinations[i], err)
+				continue
+			}
+			tasks[i] = bt
+			running++
+			go func() {
+				<-bt.shutdown
+				finished <- i
+			}()
+		}
+		if running == 0 {
+			continue
+		}
+		i := <-finished
+		running--
+		result, err := s.finishTask(tasks[i], combinations[i], start, end)
+		tasks[i] = nil
+		if err != nil {
+			errs[i] = fmt.Errorf("%v: %w", combinations[i], err)
+			continue
+		}
+		results[i] = *result
+	}
+	var err error
+	for i := range errs {
+		err = gctcommon.AppendError(err, errs[i])
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// startTask sets up a combination's backtest, adds it to the task manager
+// and starts it
+func (s *sweepRunner) startTask(parameters map[string]float64, start, end time.Time) (*BackTest, error) {
+	cfg := sweepConfig(s.cfg, parameters, start, end)
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	bt, err := NewBacktester()
+	if err != nil {
+		return nil, err
+	}
+	bt.exchangeManager = s.newExchangeManager()
+	bt.sharedDatabase = true
+	err = bt.SetupFromConfig(cfg, s.btCfg.Report.TemplatePath, s.btCfg.Report.OutputPath, s.btCfg.Verbose)
+	if err != nil {
+		return nil, err
+	}
+	err = s.tasks.AddTask(bt)
+	if err != nil {
+		return nil, err
+	}
+	err = s.tasks.StartTask(bt.MetaData.ID)
+	if err != nil {
+		if clearErr := s.tasks.ClearTask(bt.MetaData.ID); clearErr != nil {
+			log.Errorln(common.Backtester, clearErr)
+		}
+		return nil, err
+	}
+	return bt, nil
+}
+
+// finishTask collects the results of a finished combination and clears its
+// task from the task manager
+func (s *sweepRunner) finishTask(bt *BackTest, parameters map[string]float64, start, end time.Time) (*SweepResult, error) {
+	// shutdown is closed as the task begins stopping, HasRan waits until
+	// the results have been calculated
+	bt.HasRan()
+	if err := s.tasks.ClearTask(bt.MetaData.ID); err != nil {
+		log.Errorln(common.Backtester, err)
+	}
+	stats, ok := bt.Statistic.(*statistics.Statistic)
+	if !ok {
+		return nil, fmt.Errorf("%w %T", errUnhandledSweepStatistics, bt.Statistic)
+	}
+	result := &SweepResult{
+		Parameters: parameters,
+		StartDate:  start,
+		EndDate:    end,
+		Statistics: getSweepStatistics(stats),
+	}
+	if result.StartDate.IsZero() {
+		result.StartDate = stats.StartDate
+		result.EndDate = stats.EndDate
+	}
+	return result, nil
+}
+
+// sweepConfig copies a strategy config with the combination's custom settings
+// applied and the data range replaced when a start and end date are provided
+func sweepConfig(cfg *config.Config, parameters map[string]float64, start, end time.Time) *config.Config {
+	c := copyConfig(cfg)
+	c.StrategySettings.ParameterSweep = nil
+	if c.StrategySettings.CustomSettings == nil {
+		c.StrategySettings.CustomSettings = make(map[string]any, len(parameters))
+	}
+	for k, v := range parameters {
+		c.StrategySettings.CustomSettings[k] = v
+	}
+	if start.IsZero() || end.IsZero() {
+		return c
+	}
+	if c.DataSettings.APIData != nil {
+		c.DataSettings.APIData.StartDate = start
+		c.DataSettings.APIData.EndDate = end
+		c.DataSettings.APIData.InclusiveEndDate = false
+	}
+	if c.DataSettings.DatabaseData != nil {
+		c.DataSettings.DatabaseData.StartDate = start
+		c.DataSettings.DatabaseData.EndDate = end
+		c.DataSettings.DatabaseData.InclusiveEndDate = false
+	}
+	return c
+}
+
+// copyConfig deep copies a strategy config so that concurrent runs never
+// share slices, maps or pointers which are modified during setup
+func copyConfig(cfg *config.Config) *config.Config {
+	c := *cfg
+	c.StrategySettings.CustomSettings = maps.Clone(cfg.StrategySettings.CustomSettings)
+	if cfg.StrategySettings.ParameterSweep != nil {
+		sweep := *cfg.StrategySettings.ParameterSweep
+		sweep.Parameters = slices.Clone(sweep.Parameters)
+		for i := range sweep.Parameters {
+			sweep.Parameters[i].Values = slices.Clone(sweep.Parameters[i].Values)
+		}
+		if sweep.WalkForward != nil {
+			wf := *sweep.WalkForward
+			sweep.WalkForward = &wf
+		}
+		c.StrategySettings.ParameterSweep = &sweep
+	}
+	c.FundingSettings.ExchangeLevelFunding = slices.Clone(cfg.FundingSettings.ExchangeLevelFunding)
+	c.CurrencySettings = slices.Clone(cfg.CurrencySettings)
+	for i := range c.CurrencySettings {
+		cs := &c.CurrencySettings[i]
+		if cs.SpotDetails != nil {
+			spot := *cs.SpotDetails
+			spot.InitialBaseFunds = copyDecimal(spot.InitialBaseFunds)
+			spot.InitialQuoteFunds = copyDecimal(spot.InitialQuoteFunds)
+			cs.SpotDetails = &spot
+		}
+		if cs.FuturesDetails != nil {
+			futures := *cs.FuturesDetails
+			cs.FuturesDetails = &futures
+		}
+		cs.MakerFee = copyDecimal(cs.MakerFee)
+		cs.TakerFee = copyDecimal(cs.TakerFee)
+	}
+	if cfg.DataSettings.APIData != nil {
+		apiData := *cfg.DataSettings.APIData
+		c.DataSettings.APIData = &apiData
+	}
+	if cfg.DataSettings.DatabaseData != nil {
+		dbData := *cfg.DataSettings.DatabaseData
+		c.DataSettings.DatabaseData = &dbData
+	}
+	if cfg.DataSettings.CSVData != nil {
+		csvData := *cfg.DataSettings.CSVData
+		c.DataSettings.CSVData = &csvData
+	}
+	if cfg.DataSettings.LiveData != nil {
+		liveData := *cfg.DataSettings.LiveData
+		liveData.ExchangeCredentials = slices.Clone(liveData.ExchangeCredentials)
+		c.DataSettings.LiveData = &liveData
+	}
+	return &c
+}
+
+// copyDecimal returns a copy of a decimal pointer
+func copyDecimal(d *decimal.Decimal) *decimal.Decimal {
+	if d == nil {
+		return nil
+	}
+	resp := *d
+	return &resp
+}
+
+// getDataRange returns the start and end dates of a config's data source
+func getDataRange(cfg *config.Config) (start, end time.Time) {
+	switch {
+	case cfg.DataSettings.APIData != nil:
+		return cfg.DataSettings.APIData.StartDate, cfg.DataSettings.APIData.EndDate
+	case cfg.DataSettings.DatabaseData != nil:
+		return cfg.DataSettings.DatabaseData.StartDate, cfg.DataSettings.DatabaseData.EndDate
+	}
+	return time.Time{}, time.Time{}
+}
+
+// getWalkForwardWindows splits a date range into in-sample windows each
+// followed by an out-of-sample window, rolling forward by the out-of-sample
+// duration
+func getWalkForwardWindows(start, end time.Time, wf *config.WalkForward) ([]walkForwardWindow, error) {
+	if wf == nil {
+		return nil, fmt.Errorf("%w walk-forward", gctcommon.ErrNilPointer)
+	}
+	if wf.InSample <= 0 || wf.OutOfSample <= 0 {
+		return nil, fmt.Errorf("%w in-sample %v out-of-sample %v", errNoWalkForwardWindows, wf.InSample, wf.OutOfSample)
+	}
+	var windows []walkForwardWindow
+	for s := start; !s.Add(wf.InSample + wf.OutOfSample).After(end); s = s.Add(wf.OutOfSample) {
+		windows = append(windows, walkForwardWindow{
+			inSampleStart: s,
+			inSampleEnd:   s.Add(wf.InSample),
+			outSampleEnd:  s.Add(wf.InSample + wf.OutOfSample),
+		})
+	}
+	if len(windows) == 0 {
+		return nil, fmt.Errorf("%w %v to %v", errNoWalkForwardWindows, start, end)
+	}
+	return windows, nil
+}
+
+// getSweepStatistics uses the USD funding statistics when available,
+// otherwise the ratios of each currency are averaged and the worst
+// drawdown is used
+func getSweepStatistics(s *statistics.Statistic) SweepStatistics {
+	resp := SweepStatistics{TotalOrders: s.TotalOrders}
+	if s.FundingStatistics != nil && s.FundingStatistics.TotalUSDStatistics != nil {
+		usd := s.FundingStatistics.TotalUSDStatistics
+		if usd.ArithmeticRatios != nil {
+			resp.SharpeRatio = usd.ArithmeticRatios.SharpeRatio
+			resp.SortinoRatio = usd.ArithmeticRatios.SortinoRatio
+		}
+		resp.MaxDrawdown = usd.MaxDrawdown.DrawdownPercent
+		resp.StrategyMovement = usd.HoldingValueDifference
+		return resp
+	}
+	var ratios int64
+	for i := range s.CurrencyStatistics {
+		if s.CurrencyStatistics[i].ArithmeticRatios != nil {
+			resp.SharpeRatio = resp.SharpeRatio.Add(s.CurrencyStatistics[i].ArithmeticRatios.SharpeRatio)
+			resp.SortinoRatio = resp.SortinoRatio.Add(s.CurrencyStatistics[i].ArithmeticRatios.SortinoRatio)
+			ratios++
+		}
+		resp.StrategyMovement = resp.StrategyMovement.Add(s.CurrencyStatistics[i].StrategyMovement)
+		// drawdowns are negative percentages
+		if s.CurrencyStatistics[i].MaxDrawdown.DrawdownPercent.LessThan(resp.MaxDrawdown) {
+			resp.MaxDrawdown = s.CurrencyStatistics[i].MaxDrawdown.DrawdownPercent
+		}
+	}
+	if ratios > 0 {
+		resp.SharpeRatio = resp.SharpeRatio.Div(decimal.NewFromInt(ratios))
+		resp.SortinoRatio = resp.SortinoRatio.Div(decimal.NewFromInt(ratios))
+	}
+	if len(s.CurrencyStatistics) > 0 {
+		resp.StrategyMovement = resp.StrategyMovement.Div(decimal.NewFromInt(int64(len(s.CurrencyStatistics))))
+	}
+	return resp
+}
+
+// rankSweepResults sorts results from best to worst and sets their rank
+func rankSweepResults(results []SweepResult, rankBy string) {
+	slices.SortStableFunc(results, func(a, b SweepResult) int {
+		switch rankBy {
+		case config.RankBySortinoRatio:
+			return b.Statistics.SortinoRatio.Cmp(a.Statistics.SortinoRatio)
+		case config.RankByMaxDrawdown:
+			// the smallest drawdown is closest to zero
+			return b.Statistics.MaxDrawdown.Cmp(a.Statistics.MaxDrawdown)
+		default:
+			return b.Statistics.SharpeRatio.Cmp(a.Statistics.SharpeRatio)
+		}
+	})
+	for i := range results {
+		results[i].Rank = i + 1
+	}
+}
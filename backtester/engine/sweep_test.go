@@ -0,0 +1,285 @@
+package engine
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	testexch "github.com/thrasher-corp/gocryptotrader/internal/testing/exchange"
+)
+
+func sweepTestConfig() *config.Config {
+	minMax := config.MinMax{
+		MinimumSize:  decimal.NewFromFloat(0.005),
+		MaximumSize:  decimal.NewFromInt(2),
+		MaximumTotal: decimal.NewFromInt(40000),
+	}
+	funds := decimal.NewFromInt(100000)
+	return &config.Config{
+		StrategySettings: config.StrategySettings{
+			Name:               rsi.Name,
+			DisableUSDTracking: true,
+			ParameterSweep: &config.ParameterSweep{
+				Parameters: []config.SweepParameter{
+					{Key: "rsi-period", Start: 10, End: 14, Step: 2},
+					{Key: "rsi-high", Values: []float64{70}},
+					{Key: "rsi-low", Values: []float64{30}},
+				},
+				MaxConcurrentTasks: 2,
+			},
+		},
+		CurrencySettings: []config.CurrencySettings{
+			{
+				ExchangeName: testExchange,
+				Asset:        asset.Spot,
+				Base:         currency.BTC,
+				Quote:        currency.USDT,
+				SpotDetails: &config.SpotDetails{
+					InitialQuoteFunds: &funds,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &decimal.Zero,
+				TakerFee: &decimal.Zero,
+			},
+		},
+		DataSettings: config.DataSettings{
+			Interval: gctkline.OneDay,
+			DataType: common.CandleStr,
+			CSVData: &config.CSVData{
+				FullPath: filepath.Join("..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv"),
+			},
+		},
+		PortfolioSettings: config.PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: config.StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+}
+
+func TestExecuteParameterSweep(t *testing.T) {
+	t.Parallel()
+	var tm *TaskManager
+	_, err := tm.ExecuteParameterSweep(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	tm = NewTaskManager()
+	_, err = tm.ExecuteParameterSweep(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	btCfg := &config.BacktesterConfig{}
+	_, err = tm.ExecuteParameterSweep(&config.Config{}, btCfg)
+	if !errors.Is(err, errNoParameterSweep) {
+		t.Errorf("received '%v' expected '%v'", err, errNoParameterSweep)
+	}
+
+	// exchanges are loaded from test config so the sweep runs offline
+	newExchangeManager := func() *engine.ExchangeManager {
+		em := engine.NewExchangeManager()
+		exch := new(binanceus.Binanceus)
+		require.NoError(t, testexch.Setup(exch), "Test exchange Setup must not error")
+		require.NoError(t, em.Add(exch), "Add must not error")
+		return em
+	}
+	cfg := sweepTestConfig()
+	summary, err := tm.executeParameterSweep(cfg, btCfg, newExchangeManager)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if summary.Combinations != 3 {
+		t.Errorf("received '%v' expected '%v'", summary.Combinations, 3)
+	}
+	if summary.RankBy != config.RankBySharpeRatio {
+		t.Errorf("received '%v' expected '%v'", summary.RankBy, config.RankBySharpeRatio)
+	}
+	if len(summary.Results) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(summary.Results), 3)
+	}
+	for i := range summary.Results {
+		if summary.Results[i].Rank != i+1 {
+			t.Errorf("received '%v' expected '%v'", summary.Results[i].Rank, i+1)
+		}
+		if i > 0 && summary.Results[i].Statistics.SharpeRatio.GreaterThan(summary.Results[i-1].Statistics.SharpeRatio) {
+			t.Error("expected results to be ranked by sharpe ratio")
+		}
+		if summary.Results[i].Statistics.TotalOrders == 0 {
+			t.Error("expected orders to be placed")
+		}
+	}
+	if cfg.StrategySettings.CustomSettings != nil {
+		t.Error("expected the sweep config to be unchanged")
+	}
+	tasks, err := tm.List()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(tasks) != 0 {
+		t.Errorf("received '%v' expected finished sweep tasks to be cleared", len(tasks))
+	}
+
+	cfg.StrategySettings.ParameterSweep.WalkForward = &config.WalkForward{
+		InSample:    gctkline.OneDay.Duration(),
+		OutOfSample: gctkline.OneDay.Duration(),
+	}
+	_, err = tm.executeParameterSweep(cfg, btCfg, newExchangeManager)
+	if err == nil {
+		t.Error("expected walk-forward to be rejected for csv data")
+	}
+}
+
+func TestGetWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	_, err := getWalkForwardWindows(time.Time{}, time.Time{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	wf := &config.WalkForward{
+		InSample:    time.Hour * 72,
+		OutOfSample: time.Hour * 24,
+	}
+	_, err = getWalkForwardWindows(start, start.Add(time.Hour*48), wf)
+	if !errors.Is(err, errNoWalkForwardWindows) {
+		t.Errorf("received '%v' expected '%v'", err, errNoWalkForwardWindows)
+	}
+	windows, err := getWalkForwardWindows(start, start.Add(time.Hour*24*6), wf)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(windows) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(windows), 3)
+	}
+	if !windows[2].inSampleStart.Equal(start.Add(time.Hour * 48)) {
+		t.Errorf("received '%v' expected '%v'", windows[2].inSampleStart, start.Add(time.Hour*48))
+	}
+	if !windows[2].outSampleEnd.Equal(start.Add(time.Hour * 24 * 6)) {
+		t.Errorf("received '%v' expected '%v'", windows[2].outSampleEnd, start.Add(time.Hour*24*6))
+	}
+}
+
+func TestSweepConfig(t *testing.T) {
+	t.Parallel()
+	cfg := sweepTestConfig()
+	cfg.StrategySettings.CustomSettings = map[string]any{"rsi-low": 25.0}
+	cfg.DataSettings.CSVData = nil
+	cfg.DataSettings.APIData = &config.APIData{InclusiveEndDate: true}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := sweepConfig(cfg, map[string]float64{"rsi-period": 10}, start, start.AddDate(0, 0, 1))
+	if c.StrategySettings.ParameterSweep != nil {
+		t.Error("expected parameter sweep to be removed")
+	}
+	if c.StrategySettings.CustomSettings["rsi-period"] != 10.0 || c.StrategySettings.CustomSettings["rsi-low"] != 25.0 {
+		t.Errorf("received '%v' expected custom settings to be merged", c.StrategySettings.CustomSettings)
+	}
+	if len(cfg.StrategySettings.CustomSettings) != 1 {
+		t.Error("expected original custom settings to be unchanged")
+	}
+	if !c.DataSettings.APIData.StartDate.Equal(start) || c.DataSettings.APIData.InclusiveEndDate {
+		t.Errorf("received '%v' expected '%v'", c.DataSettings.APIData.StartDate, start)
+	}
+	if !cfg.DataSettings.APIData.StartDate.IsZero() {
+		t.Error("expected original data settings to be unchanged")
+	}
+}
+
+func TestCopyConfig(t *testing.T) {
+	t.Parallel()
+	cfg := sweepTestConfig()
+	cfg.StrategySettings.CustomSettings = map[string]any{"rsi-low": 25.0}
+	cfg.DataSettings.DatabaseData = &config.DatabaseData{}
+	c := copyConfig(cfg)
+	c.StrategySettings.CustomSettings["rsi-low"] = 20.0
+	c.StrategySettings.ParameterSweep.Parameters[1].Values[0] = 80
+	c.CurrencySettings[0].ExchangeName = "changed"
+	*c.CurrencySettings[0].SpotDetails.InitialQuoteFunds = decimal.NewFromInt(1)
+	*c.CurrencySettings[0].MakerFee = decimal.NewFromInt(1)
+	c.DataSettings.CSVData.FullPath = "changed"
+	c.DataSettings.DatabaseData.EndDate = time.Now()
+	if cfg.StrategySettings.CustomSettings["rsi-low"] != 25.0 {
+		t.Error("expected original custom settings to be unchanged")
+	}
+	if cfg.StrategySettings.ParameterSweep.Parameters[1].Values[0] != 70 {
+		t.Error("expected original parameter sweep to be unchanged")
+	}
+	if cfg.CurrencySettings[0].ExchangeName != testExchange ||
+		!cfg.CurrencySettings[0].SpotDetails.InitialQuoteFunds.Equal(decimal.NewFromInt(100000)) ||
+		!cfg.CurrencySettings[0].MakerFee.IsZero() {
+		t.Error("expected original currency settings to be unchanged")
+	}
+	if cfg.DataSettings.CSVData.FullPath == "changed" || !cfg.DataSettings.DatabaseData.EndDate.IsZero() {
+		t.Error("expected original data settings to be unchanged")
+	}
+}
+
+func TestGetSweepStatistics(t *testing.T) {
+	t.Parallel()
+	s := getSweepStatistics(&statistics.Statistic{
+		TotalOrders: 3,
+		CurrencyStatistics: []*statistics.CurrencyPairStatistic{
+			{
+				ArithmeticRatios: &statistics.Ratios{SharpeRatio: decimal.NewFromInt(1), SortinoRatio: decimal.NewFromInt(2)},
+				MaxDrawdown:      statistics.Swing{DrawdownPercent: decimal.NewFromInt(-5)},
+				StrategyMovement: decimal.NewFromInt(10),
+			},
+			{
+				ArithmeticRatios: &statistics.Ratios{SharpeRatio: decimal.NewFromInt(3), SortinoRatio: decimal.NewFromInt(4)},
+				MaxDrawdown:      statistics.Swing{DrawdownPercent: decimal.NewFromInt(-10)},
+				StrategyMovement: decimal.NewFromInt(20),
+			},
+		},
+	})
+	if !s.SharpeRatio.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", s.SharpeRatio, 2)
+	}
+	if !s.SortinoRatio.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received '%v' expected '%v'", s.SortinoRatio, 3)
+	}
+	if !s.MaxDrawdown.Equal(decimal.NewFromInt(-10)) {
+		t.Errorf("received '%v' expected '%v'", s.MaxDrawdown, -10)
+	}
+	if !s.StrategyMovement.Equal(decimal.NewFromInt(15)) {
+		t.Errorf("received '%v' expected '%v'", s.StrategyMovement, 15)
+	}
+	if s.TotalOrders != 3 {
+		t.Errorf("received '%v' expected '%v'", s.TotalOrders, 3)
+	}
+}
+
+func TestRankSweepResults(t *testing.T) {
+	t.Parallel()
+	results := []SweepResult{
+		{Statistics: SweepStatistics{SharpeRatio: decimal.NewFromInt(1), SortinoRatio: decimal.NewFromInt(3), MaxDrawdown: decimal.NewFromInt(-20)}},
+		{Statistics: SweepStatistics{SharpeRatio: decimal.NewFromInt(2), SortinoRatio: decimal.NewFromInt(1), MaxDrawdown: decimal.NewFromInt(-5)}},
+		{Statistics: SweepStatistics{SharpeRatio: decimal.NewFromInt(3), SortinoRatio: decimal.NewFromInt(2), MaxDrawdown: decimal.NewFromInt(-10)}},
+	}
+	rankSweepResults(results, config.RankBySharpeRatio)
+	if !results[0].Statistics.SharpeRatio.Equal(decimal.NewFromInt(3)) || results[0].Rank != 1 || results[2].Rank != 3 {
+		t.Errorf("received '%v' expected highest sharpe ratio first", results[0])
+	}
+	rankSweepResults(results, config.RankBySortinoRatio)
+	if !results[0].Statistics.SortinoRatio.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received '%v' expected highest sortino ratio first", results[0])
+	}
+	rankSweepResults(results, config.RankByMaxDrawdown)
+	if !results[0].Statistics.MaxDrawdown.Equal(decimal.NewFromInt(-5)) {
+		t.Errorf("received '%v' expected smallest drawdown first", results[0])
+	}
+}
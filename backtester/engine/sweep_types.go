@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+)
+
+var (
+	errNoParameterSweep         = errors.New("strategy config has no parameter sweep")
+	errNoWalkForwardWindows     = errors.New("data range is too short for a single walk-forward window")
+	errUnhandledSweepStatistics = errors.New("unhandled sweep statistics")
+)
+
+// SweepSummary holds the ranked results of a parameter sweep
+type SweepSummary struct {
+	Strategy     string
+	RankBy       string
+	Combinations int
+	WalkForward  bool
+	Results      []SweepResult
+}
+
+// SweepResult holds the statistics of a single parameter sweep run.
+// For a walk-forward, each result is the out-of-sample run of the best
+// in-sample combination for a window
+type SweepResult struct {
+	Rank       int
+	Parameters map[string]float64
+	StartDate  time.Time
+	EndDate    time.Time
+	Statistics SweepStatistics
+	// InSample holds the statistics the parameters were selected with
+	// during a walk-forward
+	InSample *SweepStatistics
+}
+
+// SweepStatistics is the subset of backtest statistics used to rank
+// parameter sweep results
+type SweepStatistics struct {
+	SharpeRatio      decimal.Decimal
+	SortinoRatio     decimal.Decimal
+	MaxDrawdown      decimal.Decimal
+	StrategyMovement decimal.Decimal
+	TotalOrders      int64
+}
+
+// sweepRunner runs the combinations of a parameter sweep as tasks of the task
+// manager
+type sweepRunner struct {
+	tasks              *TaskManager
+	cfg                *config.Config
+	btCfg              *config.BacktesterConfig
+	newExchangeManager func() *engine.ExchangeManager
+	maxConcurrent      int
+}
+
+// walkForwardWindow is a single rolling window of a walk-forward
+type walkForwardWindow struct {
+	inSampleStart time.Time
+	inSampleEnd   time.Time
+	outSampleEnd  time.Time
+}
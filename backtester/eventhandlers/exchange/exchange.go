@@ -10,6 +10,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
@@ -79,6 +80,12 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			}
 			return f, nil
 		}
+	} else if cs.OrderbookReplay != nil && o.GetDirection() != gctorder.ClosePosition {
+		price, amount, err = fillFromOrderbook(f, cs.OrderbookReplay, o.GetTime().Add(o.GetInterval().Duration()), amount)
+		if err != nil {
+			return f, err
+		}
+		adjustedPrice = price
 	} else {
 		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
 		if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
@@ -171,6 +178,30 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 	return f, nil
 }
 
+// fillFromOrderbook walks the replayed orderbook as it was at the close of
+// the event's candle, returning the average fill price and the amount
+// the book could fill
+func fillFromOrderbook(f *fill.Fill, replay *orderbook.Replay, t time.Time, amount decimal.Decimal) (price, filled decimal.Decimal, err error) {
+	m, err := replay.Fill(t, f.GetDirection(), amount.InexactFloat64())
+	if err != nil {
+		return decimal.Zero, decimal.Zero, err
+	}
+	filled = decimal.NewFromFloat(m.Sold)
+	if f.GetDirection().IsLong() {
+		filled = decimal.NewFromFloat(m.Purchased)
+	}
+	if !filled.Equal(amount) {
+		f.AppendReasonf("Order size shrunk from %v to %v to fit orderbook liquidity", amount, filled)
+	}
+	price = decimal.NewFromFloat(m.AverageOrderCost)
+	if !price.Equal(f.ClosePrice) {
+		f.AppendReasonf("Price filled against orderbook from %v to %v", f.ClosePrice, price)
+	}
+	f.VolumeAdjustedPrice = price
+	f.Slippage = decimal.NewFromFloat(-m.NominalPercentage)
+	return price, filled, nil
+}
+
 func allocateFundsPostOrder(f *fill.Fill, funds funding.IFundReleaser, orderError error, orderAmount, allocatedFunds, limitReducedAmount, adjustedPrice, fee decimal.Decimal) error {
 	if f == nil {
 		return fmt.Errorf("%w: fill event", common.ErrNilEvent)
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
@@ -27,6 +28,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binance"
@@ -525,6 +527,42 @@ func TestApplySlippageToPrice(t *testing.T) {
 	}
 }
 
+func TestFillFromOrderbook(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	replay, err := orderbook.NewReplay(testExchange, currency.NewPair(currency.BTC, currency.USDT), asset.Spot, []orderbook.Record{
+		{
+			Time:       tt,
+			IsSnapshot: true,
+			Bids:       []gctorderbook.Tranche{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+			Asks:       []gctorderbook.Tranche{{Price: 101, Amount: 1}, {Price: 103, Amount: 1}},
+		},
+	})
+	require.NoError(t, err, "NewReplay must not error")
+
+	f := &fill.Fill{
+		Base:       &event.Base{},
+		Direction:  gctorder.Buy,
+		ClosePrice: decimal.NewFromInt(100),
+	}
+	price, amount, err := fillFromOrderbook(f, replay, tt.Add(time.Minute), decimal.NewFromInt(2))
+	require.NoError(t, err, "fillFromOrderbook must not error")
+	assert.Equal(t, "102", price.String(), "price should be the average cost of walking the asks")
+	assert.Equal(t, "2", amount.String(), "amount should be fully filled")
+	assert.Equal(t, price, f.VolumeAdjustedPrice, "VolumeAdjustedPrice should be set to the fill price")
+	assert.True(t, f.Slippage.IsNegative(), "Slippage should be recorded")
+
+	f.Direction = gctorder.Sell
+	price, amount, err = fillFromOrderbook(f, replay, tt.Add(time.Minute), decimal.NewFromInt(3))
+	require.NoError(t, err, "fillFromOrderbook must not error")
+	assert.Equal(t, "98.5", price.String(), "price should be the average cost of hitting the bids")
+	assert.Equal(t, "2", amount.String(), "amount should be reduced to the available liquidity")
+
+	f.Direction = gctorder.Buy
+	_, _, err = fillFromOrderbook(f, replay, tt.Add(time.Minute), decimal.NewFromInt(1))
+	assert.Error(t, err, "fillFromOrderbook should error when the asks have been consumed")
+}
+
 func TestReduceAmountToFitPortfolioLimit(t *testing.T) {
 	t.Parallel()
 	initialPrice := decimal.NewFromInt(100)
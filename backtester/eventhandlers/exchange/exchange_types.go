@@ -5,6 +5,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
@@ -12,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -62,6 +64,14 @@ type Settings struct {
 	SkipCandleVolumeFitting bool
 
 	UseExchangePNLCalculation bool
+
+	// FundingRates are the historical funding rates applied to
+	// perpetual futures positions
+	FundingRates *fundingrate.HistoricalRates
+
+	// OrderbookReplay fills orders against recorded orderbook data
+	// instead of estimating slippage from candles
+	OrderbookReplay *orderbook.Replay
 }
 
 // MinMax are the rules which limit the placement of orders.
@@ -21,6 +21,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -396,6 +397,73 @@ func (p *Portfolio) UpdatePNL(e common.Event, closePrice decimal.Decimal) error
 	return nil
 }
 
+// ApplyFundingRates applies all funding rates which have occurred since the
+// previous event to an open perpetual futures position. Payments are
+// calculated against the position's notional value at the event's close price,
+// are applied to collateral and are tracked against the position
+func (p *Portfolio) ApplyFundingRates(ev data.Event, funds funding.IFundReleaser) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	if funds == nil {
+		return fmt.Errorf("%w missing funding", gctcommon.ErrNilPointer)
+	}
+	settings, err := p.getFuturesSettingsFromEvent(ev)
+	if err != nil {
+		return err
+	}
+	var due []fundingrate.Rate
+	for settings.nextFundingRate < len(settings.fundingRates) &&
+		!settings.fundingRates[settings.nextFundingRate].Time.After(ev.GetTime()) {
+		due = append(due, settings.fundingRates[settings.nextFundingRate])
+		settings.nextFundingRate++
+	}
+	if len(due) == 0 {
+		return nil
+	}
+	positions := settings.FuturesTracker.GetPositions()
+	if len(positions) == 0 {
+		return nil
+	}
+	pos := positions[len(positions)-1]
+	if pos.Status.IsInactive() || !pos.LatestSize.IsPositive() {
+		return nil
+	}
+	collateralReleaser, err := funds.CollateralReleaser()
+	if err != nil {
+		return fmt.Errorf("%v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	notional := pos.LatestSize.Mul(ev.GetClosePrice())
+	applied := make([]fundingrate.Rate, 0, len(due))
+	for i := range due {
+		if due[i].Time.Before(pos.OpeningDate) {
+			continue
+		}
+		// longs pay shorts when the funding rate is positive
+		due[i].Payment = due[i].Rate.Mul(notional)
+		if pos.LatestDirection.IsLong() {
+			due[i].Payment = due[i].Payment.Neg()
+		}
+		err = collateralReleaser.ApplyFundingPayment(due[i].Payment)
+		if err != nil {
+			return err
+		}
+		applied = append(applied, due[i])
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+	return settings.FuturesTracker.TrackFundingDetails(&fundingrate.HistoricalRates{
+		Exchange:        settings.exchangeName,
+		Asset:           settings.assetType,
+		Pair:            settings.pair,
+		StartDate:       applied[0].Time,
+		EndDate:         applied[len(applied)-1].Time,
+		FundingRates:    applied,
+		PaymentCurrency: collateralReleaser.CollateralCurrency(),
+	})
+}
+
 // TrackFuturesOrder updates the futures tracker with a new order
 // from a fill event
 func (p *Portfolio) TrackFuturesOrder(ev fill.Event, fund funding.IFundReleaser) (*PNLSummary, error) {
@@ -23,6 +23,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -1716,3 +1717,123 @@ func TestSetHoldingsForEvent(t *testing.T) {
 		t.Errorf("received '%v', expected '%v'", err, nil)
 	}
 }
+
+// fakeFuturesExchange returns a collateral currency for futures contracts
+type fakeFuturesExchange struct {
+	*binance.Binance
+}
+
+func (f *fakeFuturesExchange) GetCollateralCurrencyForContract(a asset.Item, _ currency.Pair) (currency.Code, asset.Item, error) {
+	return currency.USDT, a, nil
+}
+
+func TestApplyFundingRates(t *testing.T) {
+	t.Parallel()
+	p := &Portfolio{}
+	err := p.ApplyFundingRates(nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v', expected '%v'", err, common.ErrNilEvent)
+	}
+
+	tt := time.Now().Truncate(time.Hour)
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	ev := &kline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			AssetType:    asset.PerpetualSwap,
+			CurrencyPair: pair,
+			Time:         tt.Add(time.Hour),
+		},
+		Close: decimal.NewFromInt(100),
+	}
+	err = p.ApplyFundingRates(ev, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v', expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	contract, err := funding.CreateItem(testExchange, asset.PerpetualSwap, pair.Base, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v", err, nil)
+	}
+	collateral, err := funding.CreateItem(testExchange, asset.PerpetualSwap, pair.Quote, decimal.NewFromInt(1000), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v", err, nil)
+	}
+	collat, err := funding.CreateCollateral(contract, collateral)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v", err, nil)
+	}
+	err = p.ApplyFundingRates(ev, collat)
+	if !errors.Is(err, errNoPortfolioSettings) {
+		t.Errorf("received '%v', expected '%v'", err, errNoPortfolioSettings)
+	}
+
+	exch := &fakeFuturesExchange{Binance: &binance.Binance{}}
+	exch.Name = testExchange
+	rates := &fundingrate.HistoricalRates{
+		Exchange: testExchange,
+		Asset:    asset.PerpetualSwap,
+		Pair:     currency.NewPair(currency.ETH, currency.USDT),
+		FundingRates: []fundingrate.Rate{
+			{Time: tt.Add(2 * time.Hour), Rate: decimal.NewFromFloat(0.002)},
+			{Time: tt.Add(time.Hour), Rate: decimal.NewFromFloat(0.001)},
+			{Time: tt.Add(-time.Hour), Rate: decimal.NewFromFloat(0.1)},
+		},
+	}
+	err = p.SetCurrencySettingsMap(&exchange.Settings{Exchange: exch, Asset: asset.PerpetualSwap, Pair: pair, FundingRates: rates})
+	if !errors.Is(err, errFundingRatesMismatch) {
+		t.Errorf("received '%v', expected '%v'", err, errFundingRatesMismatch)
+	}
+	rates.Pair = pair
+	err = p.SetCurrencySettingsMap(&exchange.Settings{Exchange: exch, Asset: asset.PerpetualSwap, Pair: pair, FundingRates: rates})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	settings, err := p.getSettings(testExchange, asset.PerpetualSwap, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	err = settings.FuturesTracker.TrackNewOrder(&gctorder.Detail{
+		Price:     100,
+		Amount:    2,
+		Exchange:  testExchange,
+		Side:      gctorder.Long,
+		AssetType: asset.PerpetualSwap,
+		Date:      tt,
+		Pair:      pair,
+		OrderID:   "lol",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+
+	err = p.ApplyFundingRates(ev, collat)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v', expected '%v'", err, nil)
+	}
+	// rates prior to the position opening are skipped
+	expectedPayment := decimal.NewFromFloat(-0.2)
+	if !collat.AvailableFunds().Equal(decimal.NewFromInt(1000).Add(expectedPayment)) {
+		t.Errorf("received '%v', expected '%v'", collat.AvailableFunds(), decimal.NewFromInt(1000).Add(expectedPayment))
+	}
+	positions := settings.FuturesTracker.GetPositions()
+	if len(positions[0].FundingRates.FundingRates) != 1 {
+		t.Fatalf("received '%v', expected '%v'", len(positions[0].FundingRates.FundingRates), 1)
+	}
+	if !positions[0].FundingRates.PaymentSum.Equal(expectedPayment) {
+		t.Errorf("received '%v', expected '%v'", positions[0].FundingRates.PaymentSum, expectedPayment)
+	}
+
+	// rates are only applied once
+	err = p.ApplyFundingRates(ev, collat)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v', expected '%v'", err, nil)
+	}
+	if !collat.AvailableFunds().Equal(decimal.NewFromInt(1000).Add(expectedPayment)) {
+		t.Errorf("received '%v', expected '%v'", collat.AvailableFunds(), decimal.NewFromInt(1000).Add(expectedPayment))
+	}
+	if settings.nextFundingRate != 2 {
+		t.Errorf("received '%v', expected '%v'", settings.nextFundingRate, 2)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -37,6 +38,7 @@ var (
 	errNoHoldings           = errors.New("no holdings found")
 	errHoldingsNoTimestamp  = errors.New("holding with unset timestamp received")
 	errUnsetFuturesTracker  = errors.New("portfolio settings futures tracker unset")
+	errFundingRatesMismatch = errors.New("funding rates do not match currency settings")
 )
 
 // Portfolio stores all holdings and rules to assess orders, allowing the portfolio manager to
@@ -60,6 +62,7 @@ type Handler interface {
 	GetPositions(common.Event) ([]futures.Position, error)
 	TrackFuturesOrder(fill.Event, funding.IFundReleaser) (*PNLSummary, error)
 	UpdatePNL(common.Event, decimal.Decimal) error
+	ApplyFundingRates(data.Event, funding.IFundReleaser) error
 	GetLatestPNLForEvent(common.Event) (*PNLSummary, error)
 	CheckLiquidationStatus(data.Event, funding.ICollateralReader, *PNLSummary) error
 	CreateLiquidationOrdersForExchange(data.Event, funding.IFundingManager) ([]order.Event, error)
@@ -88,6 +91,9 @@ type Settings struct {
 	ComplianceManager compliance.Manager
 	Exchange          gctexchange.IBotExchange
 	FuturesTracker    *futures.MultiPositionTracker
+
+	fundingRates    []fundingrate.Rate
+	nextFundingRate int
 }
 
 // PNLSummary holds a PNL result along with
@@ -1,6 +1,8 @@
 package portfolio
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/shopspring/decimal"
@@ -10,6 +12,7 @@ import (
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 )
 
@@ -97,6 +100,21 @@ func (p *Portfolio) SetCurrencySettingsMap(setup *exchange.Settings) error {
 			return err
 		}
 		settings.FuturesTracker = tracker
+		if setup.FundingRates != nil {
+			if !strings.EqualFold(setup.FundingRates.Exchange, name) ||
+				setup.FundingRates.Asset != setup.Asset ||
+				!setup.FundingRates.Pair.Equal(setup.Pair) {
+				return fmt.Errorf("%w %v %v %v received %v %v %v",
+					errFundingRatesMismatch,
+					name, setup.Asset, setup.Pair,
+					setup.FundingRates.Exchange, setup.FundingRates.Asset, setup.FundingRates.Pair)
+			}
+			settings.fundingRates = make([]fundingrate.Rate, len(setup.FundingRates.FundingRates))
+			copy(settings.fundingRates, setup.FundingRates.FundingRates)
+			sort.Slice(settings.fundingRates, func(i, j int) bool {
+				return settings.fundingRates[i].Time.Before(settings.fundingRates[j].Time)
+			})
+		}
 	}
 	p.exchangeAssetPairPortfolioSettings[key.ExchangePairAsset{
 		Exchange: name,
@@ -162,7 +162,8 @@ func CalculateIndividualFundingStatistics(disableUSDTracking bool, reportItem *f
 	}
 
 	item := &FundingItemStatistics{
-		ReportItem: reportItem,
+		ReportItem:      reportItem,
+		FundingPayments: reportItem.FundingPayments,
 	}
 	if disableUSDTracking || reportItem.AppendedViaAPI {
 		return item, nil
@@ -136,10 +136,13 @@ func TestCalculateIndividualFundingStatistics(t *testing.T) {
 		t.Errorf("received %v expected %v", err, common.ErrNilPointer)
 	}
 
-	_, err = CalculateIndividualFundingStatistics(true, &funding.ReportItem{}, nil)
+	stats, err := CalculateIndividualFundingStatistics(true, &funding.ReportItem{FundingPayments: decimal.NewFromInt(-1)}, nil)
 	if !errors.Is(err, nil) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
+	if !stats.FundingPayments.Equal(decimal.NewFromInt(-1)) {
+		t.Errorf("received %v expected %v", stats.FundingPayments, -1)
+	}
 
 	_, err = CalculateIndividualFundingStatistics(false, &funding.ReportItem{}, nil)
 	if !errors.Is(err, errMissingSnapshots) {
@@ -364,6 +364,7 @@ func (f *FundingStatistics) PrintResults(wasAnyDataMissing bool) error {
 				log.Infof(common.FundingStatistics, "%s Final Collateral: %v %v at %v", sep, futuresResults[i].FinalCollateral.Value, futuresResults[i].ReportItem.Currency, futuresResults[i].FinalCollateral.Time)
 				log.Infof(common.FundingStatistics, "%s Lowest Collateral: %v %v at %v", sep, futuresResults[i].LowestCollateral.Value, futuresResults[i].ReportItem.Currency, futuresResults[i].LowestCollateral.Time)
 				log.Infof(common.FundingStatistics, "%s Highest Collateral: %v %v at %v", sep, futuresResults[i].HighestCollateral.Value, futuresResults[i].ReportItem.Currency, futuresResults[i].HighestCollateral.Time)
+				log.Infof(common.FundingStatistics, "%s Funding Payments: %v %v", sep, futuresResults[i].FundingPayments, futuresResults[i].ReportItem.Currency)
 			} else {
 				if !futuresResults[i].ReportItem.PairedWith.IsEmpty() {
 					log.Infof(common.FundingStatistics, "%s Collateral currency: %v", sep, futuresResults[i].ReportItem.PairedWith)
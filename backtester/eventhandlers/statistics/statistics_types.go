@@ -241,6 +241,9 @@ type FundingItemStatistics struct {
 	FinalCollateral   ValueAtTime `json:"final-collateral"`
 	HighestCollateral ValueAtTime `json:"highest-collateral"`
 	LowestCollateral  ValueAtTime `json:"lowest-collateral"`
+	// FundingPayments is the sum of perpetual futures funding rate
+	// payments applied to collateral
+	FundingPayments decimal.Decimal `json:"funding-payments"`
 	// Contracts
 	LowestHoldings  ValueAtTime `json:"lowest-holdings"`
 	HighestHoldings ValueAtTime `json:"highest-holdings"`
@@ -0,0 +1,267 @@
+package marketmaking
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/marketmaker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// SetOrderbookReplay sets the replayed orderbook quotes are placed and filled
+// against for an exchange asset pair
+func (s *Strategy) SetOrderbookReplay(exchangeName string, a asset.Item, cp currency.Pair, r *orderbook.Replay) error {
+	if r == nil {
+		return fmt.Errorf("%w orderbook replay", gctcommon.ErrNilPointer)
+	}
+	if a != asset.Spot {
+		return fmt.Errorf("%v %v %v %w", exchangeName, a, cp, errSpotOnly)
+	}
+	if s.pairs == nil {
+		s.pairs = make(map[key.ExchangePairAsset]*pairQuotes)
+	}
+	s.pairs[pairKey(exchangeName, a, cp)] = &pairQuotes{replay: r}
+	return nil
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// For market making, this means buying or selling when the recorded orderbook
+// trades through the quotes placed at the previous event, then placing a new
+// ladder of quotes around the orderbook's reference price
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundingTransferer, _ portfolio.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%w funding transferer", gctcommon.ErrNilPointer)
+	}
+	err := s.config.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", base.ErrInvalidCustomSettings, err)
+	}
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, err
+	}
+
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, err
+	}
+	hasDataAtTime, err := d.HasDataAtTime(latest.GetTime())
+	if err != nil {
+		return nil, err
+	}
+	if !hasDataAtTime {
+		es.SetDirection(order.MissingData)
+		es.AppendReasonf("missing data at %v, cannot perform any actions", latest.GetTime())
+		return &es, nil
+	}
+
+	es.SetPrice(latest.GetClosePrice())
+	es.SetDirection(order.DoNothing)
+	pq, ok := s.pairs[pairKey(latest.GetExchange(), latest.GetAssetType(), latest.Pair())]
+	if !ok {
+		return nil, fmt.Errorf("%v %v %v %w", latest.GetExchange(), latest.GetAssetType(), latest.Pair(), errNoOrderbookReplay)
+	}
+	if pq.halted {
+		es.AppendReason("Quoting halted at loss limit")
+		return &es, nil
+	}
+
+	funds, err := f.GetFundingForEvent(&es)
+	if err != nil {
+		return nil, err
+	}
+	pr, err := funds.FundReader().GetPairReader()
+	if err != nil {
+		return nil, err
+	}
+	start := marketmaker.Inventory{
+		Base:  pr.BaseInitialFunds().InexactFloat64(),
+		Quote: pr.QuoteInitialFunds().InexactFloat64(),
+	}
+	current := marketmaker.Inventory{
+		Base:  pr.BaseAvailable().InexactFloat64(),
+		Quote: pr.QuoteAvailable().InexactFloat64(),
+	}
+
+	// orders are filled against the orderbook as it was at the close of the
+	// candle, so quotes are checked against the same orderbook
+	bid, ask, err := pq.replay.BestBidAsk(latest.GetTime().Add(latest.GetInterval().Duration()))
+	if err != nil {
+		pq.quotes = nil
+		es.AppendReasonf("Cannot quote: %v", err)
+		return &es, nil
+	}
+	reference, err := marketmaker.GetReferencePrice(bid, ask, s.config.PriceSource)
+	if err != nil {
+		return nil, err
+	}
+	err = s.config.CheckLoss(marketmaker.GetPNL(start, current, reference))
+	if err != nil {
+		pq.halted = true
+		pq.quotes = nil
+		es.AppendReason(err.Error())
+		return &es, nil
+	}
+
+	side, amount := getCrossedQuotes(pq.quotes, bid, ask)
+	inventory := current.Base
+	if amount > 0 {
+		amt := decimal.NewFromFloat(amount)
+		es.SetDirection(side)
+		es.SetAmount(amt)
+		if side == order.Buy {
+			es.BuyLimit = amt
+			inventory += amount
+		} else {
+			es.SellLimit = amt
+			inventory -= amount
+		}
+		es.AppendReasonf("Orderbook traded through %v quotes for %v", side, amount)
+	}
+	pq.quotes, err = marketmaker.GetQuotes(&s.config, reference, inventory, nil)
+	if err != nil {
+		return nil, err
+	}
+	es.AppendReasonf("Quoting %v levels around %v", len(pq.quotes), reference)
+	return &es, nil
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals analyses multiple data points simultaneously, allowing flexibility
+// in allowing a strategy to only place an order for X currency if Y currency's price is Z
+// For market making, each exchange asset pair is quoted independently, so it uses the OnSignal function
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	var resp []signal.Event
+	var errs error
+	for i := range d {
+		sigEvent, err := s.OnSignal(d[i], f, p)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+		} else {
+			resp = append(resp, sigEvent)
+		}
+	}
+	return resp, errs
+}
+
+// SetCustomSettings allows a user to modify the market making quote settings
+func (s *Strategy) SetCustomSettings(customSettings map[string]any) error {
+	for k, v := range customSettings {
+		if k == priceSourceKey {
+			source, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%w provided %s value could not be parsed: %v", base.ErrInvalidCustomSettings, k, v)
+			}
+			s.config.PriceSource = marketmaker.PriceSource(strings.ToLower(source))
+			continue
+		}
+		value, ok := v.(float64)
+		if !ok {
+			return fmt.Errorf("%w provided %s value could not be parsed: %v", base.ErrInvalidCustomSettings, k, v)
+		}
+		switch k {
+		case levelsKey:
+			s.config.Levels = int(value)
+		case spreadKey:
+			s.config.Spread = value
+		case levelSpacingKey:
+			s.config.LevelSpacing = value
+		case orderAmountKey:
+			s.config.OrderAmount = value
+		case amountMultiplierKey:
+			s.config.AmountMultiplier = value
+		case inventoryTargetKey:
+			s.config.InventoryTarget = value
+		case maxInventoryKey:
+			s.config.MaxInventory = value
+		case inventorySkewKey:
+			s.config.InventorySkew = value
+		case maxLossKey:
+			s.config.MaxLoss = value
+		default:
+			return fmt.Errorf("%w unrecognised custom setting key %v with value %v. Cannot apply", base.ErrInvalidCustomSettings, k, v)
+		}
+	}
+	err := s.config.Validate()
+	if err != nil {
+		return fmt.Errorf("%w: %w", base.ErrInvalidCustomSettings, err)
+	}
+	return nil
+}
+
+// SetDefaults sets the custom settings to their default values. The order
+// amount and max inventory depend on the pair and must be set via custom
+// settings
+func (s *Strategy) SetDefaults() {
+	s.config = marketmaker.Config{
+		PriceSource:      marketmaker.MidPrice,
+		Levels:           1,
+		Spread:           0.001,
+		LevelSpacing:     0.001,
+		AmountMultiplier: 1,
+		InventorySkew:    0.001,
+	}
+}
+
+// getCrossedQuotes returns the side and total amount of the quotes the
+// orderbook has traded through. Bids are filled when the best ask is at or
+// below their price and asks when the best bid is at or above theirs
+func getCrossedQuotes(quotes []marketmaker.Quote, bid, ask gctorderbook.Tranche) (order.Side, float64) {
+	var buy, sell float64
+	for i := range quotes {
+		switch quotes[i].Side {
+		case order.Buy:
+			if ask.Price <= quotes[i].Price {
+				buy += quotes[i].Amount
+			}
+		case order.Sell:
+			if bid.Price >= quotes[i].Price {
+				sell += quotes[i].Amount
+			}
+		}
+	}
+	if buy > 0 {
+		return order.Buy, buy
+	}
+	return order.Sell, sell
+}
+
+func pairKey(exchangeName string, a asset.Item, cp currency.Pair) key.ExchangePairAsset {
+	return key.ExchangePairAsset{
+		Exchange: strings.ToLower(exchangeName),
+		Base:     cp.Base.Item,
+		Quote:    cp.Quote.Item,
+		Asset:    a,
+	}
+}
@@ -0,0 +1,419 @@
+package marketmaking
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/marketmaker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const testExchange = "binance"
+
+var (
+	cp = currency.NewPair(currency.BTC, currency.USDT)
+	tt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// fakeFunds returns the same spot pair for every event
+type fakeFunds struct {
+	funding.FundManager
+	pair *funding.SpotPair
+}
+
+// GetFundingForEvent overrides default implementation
+func (f *fakeFunds) GetFundingForEvent(common.Event) (funding.IFundingPair, error) {
+	return f.pair, nil
+}
+
+func newFakeFunds(t *testing.T) *fakeFunds {
+	t.Helper()
+	b, err := funding.CreateItem(testExchange, asset.Spot, currency.BTC, decimal.NewFromInt(5), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	q, err := funding.CreateItem(testExchange, asset.Spot, currency.USDT, decimal.NewFromInt(1000), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	p, err := funding.CreatePair(b, q)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return &fakeFunds{pair: p}
+}
+
+// newTestData returns minute candles starting at tt, with the first candle
+// set as the latest event
+func newTestData(t *testing.T, candles int) *kline.DataFromKline {
+	t.Helper()
+	d := &kline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     cp,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneMin,
+		},
+	}
+	events := make([]data.Event, candles)
+	for i := range events {
+		ct := tt.Add(time.Duration(i) * time.Minute)
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{Time: ct, Open: 100, High: 100, Low: 100, Close: 100})
+		events[i] = &eventkline.Kline{
+			Base: &event.Base{
+				Exchange:     testExchange,
+				Time:         ct,
+				Interval:     gctkline.OneMin,
+				CurrencyPair: cp,
+				AssetType:    asset.Spot,
+			},
+			Open:  decimal.NewFromInt(100),
+			Close: decimal.NewFromInt(100),
+			Low:   decimal.NewFromInt(100),
+			High:  decimal.NewFromInt(100),
+		}
+	}
+	err := d.SetStream(events)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(tt, tt.Add(time.Duration(candles)*time.Minute), gctkline.OneMin, 100000)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return d
+}
+
+func newTestStrategy(t *testing.T, records []orderbook.Record) *Strategy {
+	t.Helper()
+	s := &Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(map[string]any{
+		spreadKey:          0.01,
+		orderAmountKey:     float64(1),
+		inventoryTargetKey: float64(5),
+		maxInventoryKey:    float64(4),
+		inventorySkewKey:   float64(0),
+		maxLossKey:         float64(50),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	r, err := orderbook.NewReplay(testExchange, cp, asset.Spot, records)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.SetOrderbookReplay(testExchange, asset.Spot, cp, r)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return s
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("received '%v' expected '%v'", n, Name)
+	}
+	if s.Description() == "" {
+		t.Error("expected a description")
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	err := s.SetCustomSettings(nil)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	settings := map[string]any{
+		priceSourceKey:      "MICRO",
+		levelsKey:           float64(3),
+		spreadKey:           0.002,
+		levelSpacingKey:     0.001,
+		orderAmountKey:      0.5,
+		amountMultiplierKey: float64(2),
+		inventoryTargetKey:  float64(1),
+		maxInventoryKey:     float64(1),
+		inventorySkewKey:    0.001,
+		maxLossKey:          float64(100),
+	}
+	err = s.SetCustomSettings(settings)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expected := marketmaker.Config{
+		PriceSource:      marketmaker.MicroPrice,
+		Levels:           3,
+		Spread:           0.002,
+		LevelSpacing:     0.001,
+		OrderAmount:      0.5,
+		AmountMultiplier: 2,
+		InventoryTarget:  1,
+		MaxInventory:     1,
+		InventorySkew:    0.001,
+		MaxLoss:          100,
+	}
+	if s.config != expected {
+		t.Errorf("received '%+v' expected '%+v'", s.config, expected)
+	}
+
+	settings[priceSourceKey] = 1337
+	err = s.SetCustomSettings(settings)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	settings[priceSourceKey] = "mid"
+	settings[levelsKey] = "3"
+	err = s.SetCustomSettings(settings)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	settings[levelsKey] = float64(3)
+	settings[spreadKey] = float64(2)
+	err = s.SetCustomSettings(settings)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	settings[spreadKey] = 0.002
+	settings["lol"] = float64(1)
+	err = s.SetCustomSettings(settings)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+}
+
+func TestSetOrderbookReplay(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	err := s.SetOrderbookReplay(testExchange, asset.Spot, cp, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	r, err := orderbook.NewReplay(testExchange, cp, asset.Spot, []orderbook.Record{{Time: tt, IsSnapshot: true}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.SetOrderbookReplay(testExchange, asset.Futures, cp, r)
+	if !errors.Is(err, errSpotOnly) {
+		t.Errorf("received '%v' expected '%v'", err, errSpotOnly)
+	}
+	err = s.SetOrderbookReplay("BINANCE", asset.Spot, currency.NewPairWithDelimiter("BTC", "USDT", "-"), r)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if _, ok := s.pairs[pairKey(testExchange, asset.Spot, cp)]; !ok {
+		t.Error("expected replay to be keyed regardless of exchange name casing and pair formatting")
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	_, err := s.OnSignal(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	d := newTestData(t, 3)
+	f := newFakeFunds(t)
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.OnSignal(d, f, nil)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	s = newTestStrategy(t, []orderbook.Record{
+		{
+			Time:       tt.Add(30 * time.Second),
+			IsSnapshot: true,
+			Bids:       []gctorderbook.Tranche{{Price: 99.5, Amount: 1}},
+			Asks:       []gctorderbook.Tranche{{Price: 100.5, Amount: 1}},
+		},
+		{
+			Time:       tt.Add(90 * time.Second),
+			IsSnapshot: true,
+			Bids:       []gctorderbook.Tranche{{Price: 97.5, Amount: 1}},
+			Asks:       []gctorderbook.Tranche{{Price: 98.5, Amount: 2}},
+		},
+	})
+	_, err = s.OnSignal(newTestData(t, 1), f, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	delete(s.pairs, pairKey(testExchange, asset.Spot, cp))
+	_, err = s.OnSignal(d, f, nil)
+	if !errors.Is(err, errNoOrderbookReplay) {
+		t.Errorf("received '%v' expected '%v'", err, errNoOrderbookReplay)
+	}
+}
+
+func TestOnSignalQuoting(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, []orderbook.Record{
+		{
+			Time:       tt.Add(30 * time.Second),
+			IsSnapshot: true,
+			Bids:       []gctorderbook.Tranche{{Price: 99.5, Amount: 1}},
+			Asks:       []gctorderbook.Tranche{{Price: 100.5, Amount: 1}},
+		},
+		{
+			Time:       tt.Add(90 * time.Second),
+			IsSnapshot: true,
+			Bids:       []gctorderbook.Tranche{{Price: 97.5, Amount: 1}},
+			Asks:       []gctorderbook.Tranche{{Price: 98.5, Amount: 2}},
+		},
+	})
+	d := newTestData(t, 3)
+	f := newFakeFunds(t)
+	k := pairKey(testExchange, asset.Spot, cp)
+
+	resp, err := s.OnSignal(d, f, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.DoNothing)
+	}
+	expected := []marketmaker.Quote{
+		{Side: order.Buy, Level: 0, Price: 99, Amount: 1},
+		{Side: order.Sell, Level: 0, Price: 101, Amount: 1},
+	}
+	if len(s.pairs[k].quotes) != len(expected) || s.pairs[k].quotes[0] != expected[0] || s.pairs[k].quotes[1] != expected[1] {
+		t.Errorf("received '%v' expected '%v'", s.pairs[k].quotes, expected)
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err = s.OnSignal(d, f, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.Buy {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.Buy)
+	}
+	if !resp.GetAmount().Equal(decimal.NewFromInt(1)) || !resp.GetBuyLimit().Equal(decimal.NewFromInt(1)) {
+		t.Errorf("received '%v' expected '%v'", resp.GetAmount(), 1)
+	}
+
+	// the buy filled at 98.5 against a mid price of 98, leaving a small loss
+	// within the loss limit
+	err = f.pair.Reserve(decimal.NewFromFloat(98.5), order.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.pair.IncreaseAvailable(decimal.NewFromInt(1), order.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err = s.OnSignal(d, f, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.DoNothing)
+	}
+	if s.pairs[k].halted {
+		t.Error("expected quoting to continue")
+	}
+
+	// a large loss halts quoting
+	err = f.pair.Reserve(decimal.NewFromInt(100), order.Buy)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	resp, err = s.OnSignal(d, f, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != order.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), order.DoNothing)
+	}
+	if !s.pairs[k].halted || len(s.pairs[k].quotes) != 0 {
+		t.Error("expected quoting to be halted and quotes cleared")
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, []orderbook.Record{{
+		Time:       tt,
+		IsSnapshot: true,
+		Bids:       []gctorderbook.Tranche{{Price: 99.5, Amount: 1}},
+		Asks:       []gctorderbook.Tranche{{Price: 100.5, Amount: 1}},
+	}})
+	resp, err := s.OnSimultaneousSignals([]data.Handler{newTestData(t, 1), nil}, newFakeFunds(t), nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	if len(resp) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(resp), 1)
+	}
+}
+
+func TestGetCrossedQuotes(t *testing.T) {
+	t.Parallel()
+	quotes := []marketmaker.Quote{
+		{Side: order.Buy, Level: 0, Price: 99, Amount: 1},
+		{Side: order.Buy, Level: 1, Price: 98, Amount: 2},
+		{Side: order.Sell, Level: 0, Price: 101, Amount: 1},
+		{Side: order.Sell, Level: 1, Price: 102, Amount: 2},
+	}
+	_, amount := getCrossedQuotes(quotes, gctorderbook.Tranche{Price: 99.5}, gctorderbook.Tranche{Price: 100.5})
+	if amount != 0 {
+		t.Errorf("received '%v' expected '%v'", amount, 0)
+	}
+	side, amount := getCrossedQuotes(quotes, gctorderbook.Tranche{Price: 97}, gctorderbook.Tranche{Price: 98})
+	if side != order.Buy || amount != 3 {
+		t.Errorf("received '%v %v' expected '%v %v'", side, amount, order.Buy, 3)
+	}
+	side, amount = getCrossedQuotes(quotes, gctorderbook.Tranche{Price: 101}, gctorderbook.Tranche{Price: 101.5})
+	if side != order.Sell || amount != 1 {
+		t.Errorf("received '%v %v' expected '%v %v'", side, amount, order.Sell, 1)
+	}
+}
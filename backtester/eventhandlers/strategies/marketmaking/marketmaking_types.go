@@ -0,0 +1,46 @@
+package marketmaking
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/marketmaker"
+)
+
+const (
+	// Name is the strategy name
+	Name                = "marketmaking"
+	priceSourceKey      = "price-source"
+	levelsKey           = "levels"
+	spreadKey           = "spread"
+	levelSpacingKey     = "level-spacing"
+	orderAmountKey      = "order-amount"
+	amountMultiplierKey = "amount-multiplier"
+	inventoryTargetKey  = "inventory-target"
+	maxInventoryKey     = "max-inventory"
+	inventorySkewKey    = "inventory-skew"
+	maxLossKey          = "max-loss"
+	description         = `Market making keeps a ladder of bids and asks around the orderbook mid or microprice, earning the spread as both sides are traded through. Quotes are skewed away from the side the inventory is heavy on and quoting stops once the loss limit is reached. This strategy requires orderbook data so quotes can be placed and filled against the recorded orderbook`
+)
+
+var (
+	errNoOrderbookReplay = errors.New("no orderbook data loaded, market making requires orderbook data")
+	errSpotOnly          = errors.New("market making only supports spot assets")
+)
+
+// Strategy is an implementation of the Handler interface
+type Strategy struct {
+	base.Strategy
+	config marketmaker.Config
+	pairs  map[key.ExchangePairAsset]*pairQuotes
+}
+
+// pairQuotes holds the replayed orderbook of an exchange asset pair and the
+// quotes resting against it since the previous event
+type pairQuotes struct {
+	replay *orderbook.Replay
+	quotes []marketmaker.Quote
+	halted bool
+}
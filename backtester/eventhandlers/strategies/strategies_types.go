@@ -4,10 +4,13 @@ import (
 	"errors"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
 
 // ErrStrategyAlreadyExists returned when a strategy matches the same name
@@ -29,3 +32,10 @@ type Handler interface {
 	SetDefaults()
 	CloseAllPositions([]holdings.Holding, []data.Event) ([]signal.Event, error)
 }
+
+// OrderbookReplayHandler is an optional interface for strategies which need
+// to see the recorded orderbook that orders are filled against. The replay
+// of each exchange asset pair loaded from orderbook data is set during setup
+type OrderbookReplayHandler interface {
+	SetOrderbookReplay(exchangeName string, a asset.Item, cp currency.Pair, r *orderbook.Replay) error
+}
@@ -80,6 +80,12 @@ func (c *CollateralPair) ReleaseContracts(amount decimal.Decimal) error {
 	return nil
 }
 
+// ApplyFundingPayment adds a perpetual futures funding payment to collateral.
+// Negative payments reduce collateral
+func (c *CollateralPair) ApplyFundingPayment(payment decimal.Decimal) error {
+	return c.collateral.ApplyFundingPayment(payment)
+}
+
 // Reserve reserves or releases collateral based on order side
 func (c *CollateralPair) Reserve(amount decimal.Decimal, side gctorder.Side) error {
 	switch side {
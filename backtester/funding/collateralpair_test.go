@@ -39,6 +39,36 @@ func TestCollateralTakeProfit(t *testing.T) {
 	}
 }
 
+func TestCollateralApplyFundingPayment(t *testing.T) {
+	t.Parallel()
+	c := &CollateralPair{
+		collateral: &Item{
+			asset:     asset.Futures,
+			available: decimal.NewFromInt(10),
+		},
+	}
+	err := c.ApplyFundingPayment(decimal.NewFromInt(-1))
+	if !errors.Is(err, ErrNotCollateral) {
+		t.Errorf("received '%v' expected '%v'", err, ErrNotCollateral)
+	}
+
+	c.collateral.isCollateral = true
+	err = c.ApplyFundingPayment(decimal.NewFromInt(-3))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = c.ApplyFundingPayment(decimal.NewFromInt(1))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !c.AvailableFunds().Equal(decimal.NewFromInt(8)) {
+		t.Errorf("received '%v' expected '%v'", c.AvailableFunds(), 8)
+	}
+	if !c.collateral.fundingPayments.Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("received '%v' expected '%v'", c.collateral.fundingPayments, -2)
+	}
+}
+
 func TestCollateralCollateralCurrency(t *testing.T) {
 	t.Parallel()
 	c := &CollateralPair{
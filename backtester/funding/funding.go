@@ -298,14 +298,15 @@ func (f *FundManager) GenerateReport() (*Report, error) {
 	items := make([]ReportItem, len(f.items))
 	for x := range f.items {
 		item := ReportItem{
-			Exchange:       f.items[x].exchange,
-			Asset:          f.items[x].asset,
-			Currency:       f.items[x].currency,
-			InitialFunds:   f.items[x].initialFunds,
-			TransferFee:    f.items[x].transferFee,
-			FinalFunds:     f.items[x].available,
-			IsCollateral:   f.items[x].isCollateral,
-			AppendedViaAPI: f.items[x].appendedViaAPI,
+			Exchange:        f.items[x].exchange,
+			Asset:           f.items[x].asset,
+			Currency:        f.items[x].currency,
+			InitialFunds:    f.items[x].initialFunds,
+			TransferFee:     f.items[x].transferFee,
+			FinalFunds:      f.items[x].available,
+			IsCollateral:    f.items[x].isCollateral,
+			AppendedViaAPI:  f.items[x].appendedViaAPI,
+			FundingPayments: f.items[x].fundingPayments,
 		}
 
 		if !f.disableUSDTracking &&
@@ -134,6 +134,7 @@ type ICollateralReleaser interface {
 	UpdateContracts(order.Side, decimal.Decimal) error
 	TakeProfit(contracts, positionReturns decimal.Decimal) error
 	ReleaseContracts(decimal.Decimal) error
+	ApplyFundingPayment(decimal.Decimal) error
 	Liquidate()
 }
 
@@ -163,6 +164,7 @@ type Item struct {
 	isLiquidated      bool
 	appendedViaAPI    bool
 	collateralCandles map[currency.Code]kline.DataFromKline
+	fundingPayments   decimal.Decimal
 }
 
 // SpotPair holds two currencies that are associated with each other
@@ -219,6 +221,7 @@ type ReportItem struct {
 	IsCollateral         bool
 	AppendedViaAPI       bool
 	PairedWith           currency.Code
+	FundingPayments      decimal.Decimal
 }
 
 // ItemSnapshot holds USD values to allow for tracking
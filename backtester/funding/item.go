@@ -125,6 +125,17 @@ func (i *Item) TakeProfit(amount decimal.Decimal) error {
 	return nil
 }
 
+// ApplyFundingPayment adds a funding payment to collateral and tracks the
+// sum of all funding payments received
+func (i *Item) ApplyFundingPayment(payment decimal.Decimal) error {
+	if !i.isCollateral {
+		return fmt.Errorf("%v %v %v %w cannot apply funding payment", i.exchange, i.asset, i.currency, ErrNotCollateral)
+	}
+	i.available = i.available.Add(payment)
+	i.fundingPayments = i.fundingPayments.Add(payment)
+	return nil
+}
+
 // AddContracts allocates an amount of funds to be used at a later time
 // it prevents multiple events from claiming the same resource
 func (i *Item) AddContracts(amount decimal.Decimal) error {
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// klineWindow is the number of daily candles requested
+const klineWindow = 7
+
+// unsupportedInterval cannot be constructed from any exchange interval
+const unsupportedInterval = kline.Interval(time.Millisecond * 1337)
+
+// target is the exchange, asset and pair a check runs against
+type target struct {
+	exch  exchange.IBotExchange
+	asset asset.Item
+	pair  currency.Pair
+}
+
+type check struct {
+	name string
+	run  func(context.Context, *target) error
+	// offline checks should not make requests, so requests without a
+	// recorded response are failures rather than missing fixtures
+	offline bool
+}
+
+// checks are run in order against each enabled asset of an exchange
+var checks = []check{
+	{"pair formatting", checkPairFormatting, true},
+	{"ticker", checkTicker, false},
+	{"orderbook sort order", checkOrderbook, false},
+	{"kline intervals", checkKlines, false},
+	{"order details", checkOrderDetails, false},
+	{"error wrapping", checkErrorWrapping, true},
+}
+
+// checkPairFormatting checks that a pair formatted for requests can be
+// matched back to the same available pair
+func checkPairFormatting(_ context.Context, t *target) error {
+	pFmt, err := t.exch.GetPairFormat(t.asset, true)
+	if err != nil {
+		return err
+	}
+	formatted, err := t.exch.GetBase().FormatExchangeCurrency(t.pair, t.asset)
+	if err != nil {
+		return err
+	}
+	if want := pFmt.Format(t.pair); formatted.String() != want {
+		return fmt.Errorf("%w: FormatExchangeCurrency returned %q, request format is %q", errUnexpectedValue, formatted, want)
+	}
+	matched, err := t.exch.MatchSymbolWithAvailablePairs(formatted.String(), t.asset, pFmt.Delimiter != "")
+	if err != nil {
+		return err
+	}
+	if !matched.Equal(t.pair) {
+		return fmt.Errorf("%w: MatchSymbolWithAvailablePairs returned %s for %s", errUnexpectedValue, matched, t.pair)
+	}
+	return nil
+}
+
+// checkTicker checks that tickers are returned for the requested exchange,
+// pair and asset
+func checkTicker(ctx context.Context, t *target) error {
+	tick, err := t.exch.UpdateTicker(ctx, t.pair, t.asset)
+	if err != nil {
+		return err
+	}
+	if err := checkIdentity(t, tick.ExchangeName, tick.Pair, tick.AssetType); err != nil {
+		return err
+	}
+	if tick.Last <= 0 && tick.Bid <= 0 && tick.Ask <= 0 && tick.Close <= 0 {
+		return fmt.Errorf("%w: ticker has no prices", errNoData)
+	}
+	return nil
+}
+
+// checkOrderbook checks that bids are sorted descending and asks ascending
+// without crossing
+func checkOrderbook(ctx context.Context, t *target) error {
+	ob, err := t.exch.UpdateOrderbook(ctx, t.pair, t.asset)
+	if err != nil {
+		return err
+	}
+	if err := checkIdentity(t, ob.Exchange, ob.Pair, ob.Asset); err != nil {
+		return err
+	}
+	if len(ob.Bids) == 0 && len(ob.Asks) == 0 {
+		return fmt.Errorf("%w: orderbook is empty", errNoData)
+	}
+	if err := checkSorted("bids", ob.Bids, func(a, b float64) bool { return a > b }); err != nil {
+		return err
+	}
+	if err := checkSorted("asks", ob.Asks, func(a, b float64) bool { return a < b }); err != nil {
+		return err
+	}
+	if len(ob.Bids) > 0 && len(ob.Asks) > 0 && ob.Bids[0].Price >= ob.Asks[0].Price {
+		return fmt.Errorf("%w: best bid %v crosses best ask %v", errUnexpectedValue, ob.Bids[0].Price, ob.Asks[0].Price)
+	}
+	return nil
+}
+
+func checkSorted(side string, tranches orderbook.Tranches, ordered func(a, b float64) bool) error {
+	for i := 1; i < len(tranches); i++ {
+		if !ordered(tranches[i-1].Price, tranches[i].Price) {
+			return fmt.Errorf("%w: %s out of order at level %d: %v then %v", errUnexpectedValue, side, i, tranches[i-1].Price, tranches[i].Price)
+		}
+	}
+	return nil
+}
+
+// checkKlines checks that unsupported intervals are rejected before any
+// request is made, and that candles are returned in ascending order aligned to
+// the requested interval
+func checkKlines(ctx context.Context, t *target) error {
+	end := time.Now().UTC().Truncate(kline.OneDay.Duration())
+	start := end.AddDate(0, 0, -klineWindow)
+	_, err := t.exch.GetHistoricCandles(ctx, t.pair, t.asset, unsupportedInterval, start, end)
+	switch {
+	case errors.Is(err, kline.ErrCannotConstructInterval), errors.Is(err, kline.ErrUnsupportedInterval):
+	case err == nil:
+		return fmt.Errorf("%w: unsupported interval %s returned no error", errUnexpectedValue, unsupportedInterval)
+	case errors.Is(err, asset.ErrNotSupported):
+		return err
+	default:
+		return fmt.Errorf("unsupported interval %s returned an unexpected error: %w", unsupportedInterval, err)
+	}
+
+	item, err := t.exch.GetHistoricCandles(ctx, t.pair, t.asset, kline.OneDay, start, end)
+	if err != nil {
+		return err
+	}
+	if err := checkIdentity(t, item.Exchange, item.Pair, item.Asset); err != nil {
+		return err
+	}
+	if item.Interval != kline.OneDay {
+		return fmt.Errorf("%w: interval %s, requested %s", errUnexpectedValue, item.Interval, kline.OneDay)
+	}
+	if len(item.Candles) == 0 {
+		return fmt.Errorf("%w: no candles", errNoData)
+	}
+	for i := range item.Candles {
+		ts := item.Candles[i].Time
+		if !ts.Truncate(kline.OneDay.Duration()).Equal(ts) {
+			return fmt.Errorf("%w: candle %d time %s is not aligned to %s", errUnexpectedValue, i, ts, kline.OneDay)
+		}
+		if i > 0 && !item.Candles[i-1].Time.Before(ts) {
+			return fmt.Errorf("%w: candle %d time %s is not after %s", errUnexpectedValue, i, ts, item.Candles[i-1].Time)
+		}
+	}
+	return nil
+}
+
+// checkOrderDetails checks that active orders populate the fields the order
+// manager relies on
+func checkOrderDetails(ctx context.Context, t *target) error {
+	orders, err := t.exch.GetActiveOrders(ctx, &order.MultiOrderRequest{
+		Pairs:     currency.Pairs{t.pair},
+		AssetType: t.asset,
+		Type:      order.AnyType,
+		Side:      order.AnySide,
+	})
+	if err != nil {
+		return err
+	}
+	if len(orders) == 0 {
+		return fmt.Errorf("%w: no active orders", errNoData)
+	}
+	for i := range orders {
+		d := &orders[i]
+		switch {
+		case d.Exchange != t.exch.GetName():
+			return fmt.Errorf("%w: order %d exchange %q, expected %q", errUnexpectedValue, i, d.Exchange, t.exch.GetName())
+		case d.OrderID == "":
+			return fmt.Errorf("%w: order %d has no order ID", errUnexpectedValue, i)
+		case d.Pair.IsEmpty():
+			return fmt.Errorf("%w: order %s has no pair", errUnexpectedValue, d.OrderID)
+		case d.AssetType != t.asset:
+			return fmt.Errorf("%w: order %s asset %s, expected %s", errUnexpectedValue, d.OrderID, d.AssetType, t.asset)
+		case d.Side == order.UnknownSide:
+			return fmt.Errorf("%w: order %s has no side", errUnexpectedValue, d.OrderID)
+		case d.Type == order.UnknownType:
+			return fmt.Errorf("%w: order %s has no type", errUnexpectedValue, d.OrderID)
+		case d.Status == order.UnknownStatus:
+			return fmt.Errorf("%w: order %s has no status", errUnexpectedValue, d.OrderID)
+		}
+	}
+	return nil
+}
+
+// checkErrorWrapping checks that invalid parameters are rejected with the
+// errors callers match against using errors.Is
+func checkErrorWrapping(ctx context.Context, t *target) error {
+	_, err := t.exch.UpdateTicker(ctx, currency.EMPTYPAIR, t.asset)
+	if err := expectError("UpdateTicker with an empty pair", err, currency.ErrCurrencyPairEmpty); err != nil {
+		return err
+	}
+	_, err = t.exch.UpdateOrderbook(ctx, currency.EMPTYPAIR, t.asset)
+	if err := expectError("UpdateOrderbook with an empty pair", err, currency.ErrCurrencyPairEmpty); err != nil {
+		return err
+	}
+	_, err = t.exch.UpdateOrderbook(ctx, t.pair, asset.Empty)
+	return expectError("UpdateOrderbook with an empty asset", err, asset.ErrNotSupported)
+}
+
+// expectError returns an error unless err wraps want. Unsupported functions
+// are ignored
+func expectError(call string, err, want error) error {
+	if errors.Is(err, want) || errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s returned %v, expected %v", errUnexpectedValue, call, err, want)
+}
+
+// checkIdentity checks that returned data is for the requested exchange, pair
+// and asset
+func checkIdentity(t *target, exch string, p currency.Pair, a asset.Item) error {
+	switch {
+	case exch != t.exch.GetName():
+		return fmt.Errorf("%w: exchange %q, expected %q", errUnexpectedValue, exch, t.exch.GetName())
+	case !p.Equal(t.pair):
+		return fmt.Errorf("%w: pair %s, expected %s", errUnexpectedValue, p, t.pair)
+	case a != t.asset:
+		return fmt.Errorf("%w: asset %s, expected %s", errUnexpectedValue, a, t.asset)
+	}
+	return nil
+}
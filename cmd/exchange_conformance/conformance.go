@@ -0,0 +1,315 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+)
+
+// Check results
+const (
+	StatusPass        = "pass"
+	StatusFail        = "fail"
+	StatusUnsupported = "unsupported"
+	StatusNoFixture   = "no fixture"
+)
+
+// checkTimeout limits how long a single check may take so exchanges which
+// bypass the mock server cannot stall the suite
+const checkTimeout = time.Second * 30
+
+var (
+	errNoData          = errors.New("no data returned")
+	errUnexpectedValue = errors.New("unexpected value")
+	errLiveRequest     = errors.New("request bypassed the mock server")
+)
+
+// Result holds the result of a check against a single asset
+type Result struct {
+	Check  string
+	Asset  asset.Item
+	Status string
+	Err    error
+}
+
+// ExchangeReport holds the results of all checks against an exchange
+type ExchangeReport struct {
+	Exchange string
+	Fixture  string
+	Results  []Result
+}
+
+// Matrix maps exchange names to the overall status of each check
+type Matrix map[string]map[string]string
+
+// Suite runs conformance checks against exchange wrappers using recorded HTTP
+// fixtures served by the mock server
+type Suite struct {
+	// Config is the exchange configuration used to set up each exchange
+	Config *config.Config
+	// Root is the repository root used to find exchange fixtures
+	Root string
+}
+
+// Run runs all checks against the named exchanges in parallel, returning
+// reports sorted by exchange name
+func (s *Suite) Run(ctx context.Context, names []string) []ExchangeReport {
+	reports := make([]ExchangeReport, len(names))
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			reports[i] = s.RunExchange(ctx, names[i])
+		}(i)
+	}
+	wg.Wait()
+	sort.Slice(reports, func(i, j int) bool { return reports[i].Exchange < reports[j].Exchange })
+	return reports
+}
+
+// RunExchange runs all checks against a single exchange
+func (s *Suite) RunExchange(ctx context.Context, name string) ExchangeReport {
+	name = strings.ToLower(name)
+	report := ExchangeReport{Exchange: name, Fixture: s.fixturePath(name)}
+
+	var misses atomic.Int64
+	exch, err := s.setupExchange(name, report.Fixture, func(*http.Request, error) { misses.Add(1) })
+	if err != nil {
+		report.Results = append(report.Results, Result{Check: "setup", Status: StatusFail, Err: err})
+		return report
+	}
+
+	b := exch.GetBase()
+	for _, a := range b.CurrencyPairs.GetAssetTypes(true) {
+		pairs, err := b.CurrencyPairs.GetPairs(a, true)
+		if err != nil || len(pairs) == 0 {
+			continue
+		}
+		t := &target{exch: exch, asset: a, pair: pairs[0]}
+		for _, c := range checks {
+			before := misses.Load()
+			checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+			err := c.run(checkCtx, t)
+			cancel()
+			report.Results = append(report.Results, Result{
+				Check:  c.name,
+				Asset:  a,
+				Status: classify(err, !c.offline && misses.Load() > before),
+				Err:    err,
+			})
+		}
+	}
+	return report
+}
+
+// classify converts a check error to a result status
+func classify(err error, missed bool) string {
+	switch {
+	case err == nil:
+		return StatusPass
+	case errors.Is(err, common.ErrFunctionNotSupported), errors.Is(err, common.ErrNotYetImplemented), errors.Is(err, asset.ErrNotSupported):
+		return StatusUnsupported
+	case missed, errors.Is(err, errNoData):
+		return StatusNoFixture
+	}
+	return StatusFail
+}
+
+// fixturePath returns the path of the recorded HTTP fixtures for an exchange,
+// or an empty string if none exist
+func (s *Suite) fixturePath(name string) string {
+	for _, path := range []string{
+		filepath.Join(s.Root, "exchanges", name, "testdata", "http.json"),
+		filepath.Join(s.Root, "testdata", "http_mock", name, name+".json"),
+	} {
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return ""
+}
+
+// setupExchange sets up an exchange with its REST endpoints pointed at a mock
+// server, which serves no responses when the exchange has no fixtures so the
+// suite never reaches live endpoints
+func (s *Suite) setupExchange(name, fixture string, onMiss func(*http.Request, error)) (exchange.IBotExchange, error) {
+	exch, err := engine.NewExchangeManager().NewExchangeByName(name)
+	if err != nil {
+		return nil, err
+	}
+	exchCfg, err := s.Config.GetExchangeConfig(name)
+	if err != nil {
+		return nil, err
+	}
+	exchCfg.Enabled = true
+	exchCfg.Features.Enabled.Websocket = false
+	exchCfg.API.AuthenticatedSupport = true
+	exchCfg.API.Credentials = config.APICredentialsConfig{
+		Key:        "test",
+		Secret:     "test",
+		ClientID:   "test",
+		PEMKey:     "test",
+		Subaccount: "test",
+	}
+	exch.SetDefaults()
+	if err := exch.Setup(exchCfg); err != nil {
+		return nil, err
+	}
+
+	var m *mock.VCRMock
+	if fixture != "" {
+		if m, err = mock.LoadVCRMock(fixture); err != nil {
+			return nil, err
+		}
+	}
+	serverURL, client := mock.NewLenientVCRServer(m, onMiss)
+	u, err := url.Parse(serverURL)
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = &offlineTransport{host: u.Host, next: client.Transport}
+
+	b := exch.GetBase()
+	b.SkipAuthCheck = true
+	b.Verbose = false
+	if err := b.SetHTTPClient(client); err != nil {
+		return nil, err
+	}
+	for k, v := range b.API.Endpoints.GetURLMap() {
+		// Preserve endpoint paths as fixtures are recorded against them
+		var path string
+		if u, err := url.Parse(v); err == nil {
+			path = u.Path
+		}
+		if err := b.API.Endpoints.SetRunning(k, serverURL+path); err != nil {
+			return nil, err
+		}
+	}
+	return exch, nil
+}
+
+// offlineTransport rejects requests which bypass the mock server, such as
+// those made to hard coded URLs
+type offlineTransport struct {
+	host string
+	next http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (o *offlineTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	if r.URL.Host != o.host {
+		return nil, fmt.Errorf("%w: %s", errLiveRequest, r.URL.Redacted())
+	}
+	return o.next.RoundTrip(r)
+}
+
+// Matrix returns the overall status of each check for each exchange. A check
+// fails if it fails for any asset and otherwise passes if it passes for any
+func (r *ExchangeReport) Matrix() map[string]string {
+	statuses := make(map[string]string)
+	for _, res := range r.Results {
+		statuses[res.Check] = worseStatus(statuses[res.Check], res.Status)
+	}
+	return statuses
+}
+
+// statusOrder ranks statuses from least to most significant when combining
+// results across assets
+var statusOrder = []string{"", StatusUnsupported, StatusNoFixture, StatusPass, StatusFail}
+
+func worseStatus(a, b string) string {
+	if slices.Index(statusOrder, b) > slices.Index(statusOrder, a) {
+		return b
+	}
+	return a
+}
+
+// BuildMatrix returns the pass/fail matrix for a set of reports
+func BuildMatrix(reports []ExchangeReport) Matrix {
+	m := make(Matrix, len(reports))
+	for i := range reports {
+		m[reports[i].Exchange] = reports[i].Matrix()
+	}
+	return m
+}
+
+// Regressions returns the checks which passed in the baseline but no longer
+// pass
+func (m Matrix) Regressions(baseline Matrix) []string {
+	var regressions []string
+	for exch, statuses := range baseline {
+		for check, status := range statuses {
+			if status != StatusPass || m[exch][check] == StatusPass {
+				continue
+			}
+			current := m[exch][check]
+			if current == "" {
+				current = "missing"
+			}
+			regressions = append(regressions, fmt.Sprintf("%s %s: %s", exch, check, current))
+		}
+	}
+	sort.Strings(regressions)
+	return regressions
+}
+
+// Markdown renders the matrix as a markdown table
+func (m Matrix) Markdown() string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString("| Exchange |")
+	for _, c := range checks {
+		sb.WriteString(" " + c.name + " |")
+	}
+	sb.WriteString("\n|---|" + strings.Repeat("---|", len(checks)) + "\n")
+	for _, name := range names {
+		sb.WriteString("| " + name + " |")
+		if status, ok := m[name]["setup"]; ok {
+			sb.WriteString(" setup " + status + " |" + strings.Repeat(" |", len(checks)-1) + "\n")
+			continue
+		}
+		for _, c := range checks {
+			status := m[name][c.name]
+			if status == "" {
+				status = "-"
+			}
+			sb.WriteString(" " + status + " |")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// failures returns the errors of failed checks in a report
+func (r *ExchangeReport) failures() []string {
+	var out []string
+	for _, res := range r.Results {
+		if res.Status == StatusFail {
+			out = append(out, fmt.Sprintf("%s %s %s: %v", r.Exchange, res.Asset, res.Check, res.Err))
+		}
+	}
+	return out
+}
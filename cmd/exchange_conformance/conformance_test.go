@@ -0,0 +1,126 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	testutils "github.com/thrasher-corp/gocryptotrader/internal/testing/utils"
+)
+
+// TestConformance runs the suite against every exchange and fails if a check
+// which passes in the tracked matrix no longer passes
+func TestConformance(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.Skip("skipping exchange conformance suite in short mode")
+	}
+	root, err := testutils.RootPathFromCWD()
+	require.NoError(t, err, "RootPathFromCWD must not error")
+	s, err := newSuite(root)
+	require.NoError(t, err, "newSuite must not error")
+	baseline, err := loadBaseline(root)
+	require.NoError(t, err, "loadBaseline must not error")
+
+	reports := s.Run(t.Context(), exchange.Exchanges)
+	m := BuildMatrix(reports)
+	for i := range reports {
+		for _, f := range reports[i].failures() {
+			t.Log(f)
+		}
+	}
+	assert.Empty(t, m.Regressions(baseline), "Checks passing in %s should not regress", baselineFile)
+	for name, statuses := range m {
+		for check, status := range statuses {
+			if status == StatusPass && baseline[name][check] != StatusPass {
+				t.Logf("%s %s now passes; run `go run ./cmd/exchange_conformance -update` to track it", name, check)
+			}
+		}
+	}
+}
+
+func TestRunExchange(t *testing.T) {
+	t.Parallel()
+	root, err := testutils.RootPathFromCWD()
+	require.NoError(t, err, "RootPathFromCWD must not error")
+	s, err := newSuite(root)
+	require.NoError(t, err, "newSuite must not error")
+
+	r := s.RunExchange(t.Context(), "Gemini")
+	assert.Equal(t, "gemini", r.Exchange, "Exchange should be lower case")
+	assert.NotEmpty(t, r.Fixture, "Fixture should be found for gemini")
+	require.NotEmpty(t, r.Results, "Results must not be empty")
+	assert.Equal(t, StatusPass, r.Matrix()["pair formatting"], "Pair formatting should pass")
+
+	r = s.RunExchange(t.Context(), "unknown")
+	require.Len(t, r.Results, 1, "Results must contain the setup failure")
+	assert.Equal(t, "setup", r.Results[0].Check, "Check should be setup")
+	assert.Equal(t, StatusFail, r.Results[0].Status, "Setup should fail for an unknown exchange")
+}
+
+func TestClassify(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, StatusPass, classify(nil, true), "Should pass without an error")
+	assert.Equal(t, StatusUnsupported, classify(fmt.Errorf("wrapped: %w", common.ErrFunctionNotSupported), true), "Should be unsupported")
+	assert.Equal(t, StatusUnsupported, classify(asset.ErrNotSupported, false), "Should be unsupported")
+	assert.Equal(t, StatusNoFixture, classify(errUnexpectedValue, true), "Should have no fixture when a request was not matched")
+	assert.Equal(t, StatusNoFixture, classify(errNoData, false), "Should have no fixture when no data was returned")
+	assert.Equal(t, StatusFail, classify(errUnexpectedValue, false), "Should fail")
+}
+
+func TestMatrix(t *testing.T) {
+	t.Parallel()
+	r := ExchangeReport{Exchange: "test", Results: []Result{
+		{Check: "ticker", Asset: asset.Spot, Status: StatusPass},
+		{Check: "ticker", Asset: asset.Futures, Status: StatusNoFixture},
+		{Check: "error wrapping", Asset: asset.Spot, Status: StatusPass},
+		{Check: "error wrapping", Asset: asset.Futures, Status: StatusFail, Err: errUnexpectedValue},
+		{Check: "kline intervals", Asset: asset.Spot, Status: StatusUnsupported},
+	}}
+	m := BuildMatrix([]ExchangeReport{r})
+	assert.Equal(t, Matrix{"test": {"ticker": StatusPass, "error wrapping": StatusFail, "kline intervals": StatusUnsupported}}, m, "BuildMatrix should combine asset results")
+	assert.Equal(t, []string{"test futures error wrapping: unexpected value"}, r.failures(), "failures should return failed checks")
+
+	baseline := Matrix{"test": {"ticker": StatusPass, "error wrapping": StatusPass}, "other": {"ticker": StatusPass}}
+	assert.Equal(t, []string{"other ticker: missing", "test error wrapping: fail"}, m.Regressions(baseline), "Regressions should return checks which no longer pass")
+
+	md := m.Markdown()
+	assert.Contains(t, md, "| Exchange | pair formatting | ticker |", "Markdown should contain a header")
+	assert.Contains(t, md, "| test | - | pass | - | unsupported | - | fail |", "Markdown should contain a row per exchange")
+	assert.Contains(t, Matrix{"broken": {"setup": StatusFail}}.Markdown(), "| broken | setup fail |", "Markdown should show setup failures")
+}
+
+func TestCheckSorted(t *testing.T) {
+	t.Parallel()
+	desc := func(a, b float64) bool { return a > b }
+	assert.NoError(t, checkSorted("bids", orderbook.Tranches{{Price: 3}, {Price: 2}, {Price: 1}}, desc), "checkSorted should not error for sorted levels")
+	assert.ErrorIs(t, checkSorted("bids", orderbook.Tranches{{Price: 3}, {Price: 3}}, desc), errUnexpectedValue, "checkSorted should error for duplicate levels")
+	assert.ErrorIs(t, checkSorted("bids", orderbook.Tranches{{Price: 1}, {Price: 2}}, desc), errUnexpectedValue, "checkSorted should error for unsorted levels")
+}
+
+func TestExpectError(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, expectError("call", fmt.Errorf("wrapped: %w", currency.ErrCurrencyPairEmpty), currency.ErrCurrencyPairEmpty), "expectError should not error for a wrapped error")
+	assert.NoError(t, expectError("call", common.ErrFunctionNotSupported, currency.ErrCurrencyPairEmpty), "expectError should ignore unsupported functions")
+	err := expectError("call", errors.New("bad"), currency.ErrCurrencyPairEmpty)
+	assert.ErrorIs(t, err, errUnexpectedValue, "expectError should error for other errors")
+	assert.NotErrorIs(t, err, currency.ErrCurrencyPairEmpty, "expectError should not wrap the expected error")
+	assert.ErrorIs(t, expectError("call", nil, currency.ErrCurrencyPairEmpty), errUnexpectedValue, "expectError should error without an error")
+}
+
+func TestOfflineTransport(t *testing.T) {
+	t.Parallel()
+	c := &http.Client{Transport: &offlineTransport{host: "127.0.0.1:1337", next: http.DefaultTransport}}
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, "https://api.exchange.test/ticker", http.NoBody)
+	require.NoError(t, err, "NewRequestWithContext must not error")
+	_, err = c.Do(req) //nolint:bodyclose // request is rejected before a response is returned
+	assert.ErrorIs(t, err, errLiveRequest, "Requests bypassing the mock server should error")
+}
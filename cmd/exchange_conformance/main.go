@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	testutils "github.com/thrasher-corp/gocryptotrader/internal/testing/utils"
+)
+
+// baselineFile is the conformance matrix tracked in the repository, relative
+// to the repository root
+var baselineFile = filepath.Join("cmd", "exchange_conformance", "conformance.json")
+
+var (
+	exchangesOverride string
+	outputFile        string
+	updateBaseline    bool
+	verbose           bool
+)
+
+func main() {
+	flag.StringVar(&exchangesOverride, "exchanges", "", "a + delimited list of exchange names to run checks against eg -exchanges=bitstamp+gemini")
+	flag.StringVar(&outputFile, "output", "", "write the markdown matrix to a file instead of stdout")
+	flag.BoolVar(&updateBaseline, "update", false, "update the tracked conformance matrix with the results")
+	flag.BoolVar(&verbose, "verbose", false, "log the errors of failed checks")
+	flag.Parse()
+
+	root, err := testutils.RootPathFromCWD()
+	if err != nil {
+		log.Fatalf("Failed to find repository root: %v", err)
+	}
+	s, err := newSuite(root)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	names := exchange.Exchanges
+	if exchangesOverride != "" {
+		names = strings.Split(exchangesOverride, "+")
+	}
+	reports := s.Run(context.Background(), names)
+	if verbose {
+		for i := range reports {
+			for _, f := range reports[i].failures() {
+				log.Println(f)
+			}
+		}
+	}
+
+	m := BuildMatrix(reports)
+	if outputFile == "" {
+		fmt.Print(m.Markdown())
+	} else if err := file.Write(outputFile, []byte(m.Markdown())); err != nil {
+		log.Fatalf("Failed to write matrix: %v", err)
+	}
+
+	if updateBaseline {
+		if exchangesOverride != "" {
+			baseline, err := loadBaseline(root)
+			if err != nil {
+				log.Fatal(err)
+			}
+			for name, statuses := range m {
+				baseline[name] = statuses
+			}
+			m = baseline
+		}
+		if err := saveBaseline(root, m); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("Updated %s", baselineFile)
+	}
+}
+
+// newSuite loads the test configuration and returns a suite for the
+// repository
+func newSuite(root string) (*Suite, error) {
+	cfg := &config.Config{}
+	if err := cfg.LoadConfig(filepath.Join(root, "testdata", "configtest.json"), true); err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	return &Suite{Config: cfg, Root: root}, nil
+}
+
+// loadBaseline loads the tracked conformance matrix
+func loadBaseline(root string) (Matrix, error) {
+	contents, err := os.ReadFile(filepath.Join(root, baselineFile))
+	if err != nil {
+		return nil, err
+	}
+	var m Matrix
+	return m, json.Unmarshal(contents, &m)
+}
+
+// saveBaseline writes the tracked conformance matrix
+func saveBaseline(root string, m Matrix) error {
+	data, err := json.MarshalIndent(m, "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(filepath.Join(root, baselineFile), data)
+}
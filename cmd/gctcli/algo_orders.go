@@ -0,0 +1,290 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var algoOrderCommand = &cli.Command{
+	Name:      "algoorder",
+	Aliases:   []string{"ao"},
+	Usage:     "manages TWAP, VWAP, iceberg and chase orders executed by the algo execution manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "submit",
+			Usage:     "submits a parent order which is executed as a series of child orders",
+			ArgsUsage: "<exchange> <pair> <asset> <side> <algo> <amount> <price>",
+			Action:    submitAlgoOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange to submit the order to",
+				},
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the currency pair",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.StringFlag{
+					Name:  "algo",
+					Usage: "the execution algorithm (TWAP, VWAP, ICEBERG OR CHASE)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the total amount of the order",
+				},
+				&cli.Float64Flag{
+					Name:  "price",
+					Usage: "the limit price of child orders, or the worst price a CHASE order is re-priced to",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "the TWAP and VWAP child order type (MARKET OR LIMIT)",
+					Value: "LIMIT",
+				},
+				&cli.DurationFlag{
+					Name:  "duration",
+					Usage: "the period TWAP and VWAP orders are executed over e.g. 1h30m",
+				},
+				&cli.Int64Flag{
+					Name:  "slices",
+					Usage: "the number of child orders a TWAP order is split into",
+				},
+				&cli.Int64Flag{
+					Name:  "interval",
+					Usage: "the VWAP volume profile candle interval in seconds",
+					Value: 300,
+				},
+				&cli.Float64Flag{
+					Name:    "visibleamount",
+					Aliases: []string{"visible"},
+					Usage:   "the amount of each ICEBERG child order",
+				},
+				&cli.StringFlag{
+					Name:  "client_id",
+					Usage: "the optional client order ID, suffixed with the child order number",
+				},
+			},
+		},
+		{
+			Name:      "get",
+			Aliases:   []string{"list"},
+			Usage:     "returns algo orders with their progress and child orders",
+			ArgsUsage: "<exchange> <includeinactive>",
+			Action:    getAlgoOrders,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the optional exchange to filter algo orders by",
+				},
+				&cli.BoolFlag{
+					Name:    "includeinactive",
+					Aliases: []string{"all"},
+					Usage:   "includes completed, cancelled and failed algo orders",
+				},
+			},
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a running algo order and its open child orders",
+			ArgsUsage: "<id>",
+			Action:    cancelAlgoOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the algo order ID",
+				},
+			},
+		},
+	},
+}
+
+func submitAlgoOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(3)
+	}
+
+	var algo string
+	if c.IsSet("algo") {
+		algo = c.String("algo")
+	} else {
+		algo = c.Args().Get(4)
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var price float64
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(6) != "" {
+		price, err = strconv.ParseFloat(c.Args().Get(6), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SubmitAlgoOrder(c.Context, &gctrpc.SubmitAlgoOrderRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Asset:         assetType,
+		Side:          orderSide,
+		AlgoType:      algo,
+		OrderType:     c.String("type"),
+		Amount:        amount,
+		Price:         price,
+		Duration:      int64(c.Duration("duration")),
+		Slices:        c.Int64("slices"),
+		Interval:      int64(time.Duration(c.Int64("interval")) * time.Second),
+		VisibleAmount: c.Float64("visibleamount"),
+		ClientId:      c.String("client_id"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getAlgoOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var includeInactive bool
+	if c.IsSet("includeinactive") {
+		includeInactive = c.Bool("includeinactive")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		includeInactive, err = strconv.ParseBool(c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetAlgoOrders(c.Context, &gctrpc.GetAlgoOrdersRequest{
+		Exchange:        exchangeName,
+		IncludeInactive: includeInactive,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelAlgoOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CancelAlgoOrder(c.Context, &gctrpc.CancelAlgoOrderRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -12,9 +12,12 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	gctfile "github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
 	"github.com/urfave/cli/v2"
 )
 
@@ -836,6 +839,220 @@ func getPortfolioSummary(c *cli.Context) error {
 	return nil
 }
 
+var getPortfolioValuationCommand = &cli.Command{
+	Name:   "getportfoliovaluation",
+	Usage:  "gets the value of all portfolio holdings in the fiat display currency",
+	Action: getPortfolioValuation,
+}
+
+func getPortfolioValuation(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioValuation(c.Context, &gctrpc.GetPortfolioValuationRequest{})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getPortfolioValuationHistoryCommand = &cli.Command{
+	Name:      "getportfoliovaluationhistory",
+	Usage:     "gets saved portfolio valuation snapshots with the change in value and max drawdown over the period",
+	ArgsUsage: "<start> <end>",
+	Action:    getPortfolioValuationHistory,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "start",
+			Usage:       "the date to begin retrieving valuation snapshots",
+			Value:       time.Now().AddDate(0, -1, 0).Format(time.DateTime),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Usage:       "the date to end retrieving valuation snapshots",
+			Value:       time.Now().Format(time.DateTime),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getPortfolioValuationHistory(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(1) != "" {
+			endTime = c.Args().Get(1)
+		}
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return common.ErrStartAfterEnd
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPortfolioValuationHistory(c.Context,
+		&gctrpc.GetPortfolioValuationHistoryRequest{
+			Start: s.Format(common.SimpleTimeFormatWithTimezone),
+			End:   e.Format(common.SimpleTimeFormatWithTimezone),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getCostBasisReportCommand = &cli.Command{
+	Name:      "getcostbasisreport",
+	Usage:     "builds tax lots from exchange trades and transfers and gets the realised gains over the period",
+	ArgsUsage: "<method> <start> <end>",
+	Action:    getCostBasisReport,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "a comma delimited list of exchanges to include, defaults to all exchanges with authenticated support",
+		},
+		&cli.StringFlag{
+			Name:  "method",
+			Usage: "the cost basis method: fifo, lifo, hifo or average",
+			Value: "fifo",
+		},
+		&cli.StringFlag{
+			Name:        "start",
+			Usage:       "the date to begin retrieving trades and transfers. Holdings acquired before this date have a zero cost basis",
+			Value:       time.Now().AddDate(-1, 0, 0).Format(time.DateTime),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Usage:       "the date to end retrieving trades and transfers",
+			Value:       time.Now().Format(time.DateTime),
+			Destination: &endTime,
+		},
+		&cli.StringFlag{
+			Name:  "output",
+			Usage: "a file path to export the realised gains to as CSV",
+		},
+	},
+}
+
+func getCostBasisReport(c *cli.Context) error {
+	method := c.String("method")
+	if !c.IsSet("method") && c.Args().Get(0) != "" {
+		method = c.Args().Get(0)
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	s, err := time.ParseInLocation(time.DateTime, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.ParseInLocation(time.DateTime, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return common.ErrStartAfterEnd
+	}
+
+	var exchanges []string
+	if c.String("exchanges") != "" {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetCostBasisReport(c.Context,
+		&gctrpc.GetCostBasisReportRequest{
+			Exchanges: exchanges,
+			Method:    method,
+			Start:     s.Format(common.SimpleTimeFormatWithTimezone),
+			End:       e.Format(common.SimpleTimeFormatWithTimezone),
+		})
+	if err != nil {
+		return err
+	}
+
+	output := c.String("output")
+	if output == "" {
+		jsonOutput(result)
+		return nil
+	}
+	gains := make([]costbasis.Gain, len(result.Gains))
+	for i := range result.Gains {
+		gains[i] = costbasis.Gain{
+			Exchange:  result.Gains[i].Exchange,
+			Currency:  currency.NewCode(result.Gains[i].Currency),
+			Amount:    result.Gains[i].Amount,
+			Proceeds:  result.Gains[i].Proceeds,
+			CostBasis: result.Gains[i].CostBasis,
+			Gain:      result.Gains[i].Gain,
+			LotID:     result.Gains[i].LotId,
+			Unmatched: result.Gains[i].Unmatched,
+			Reference: result.Gains[i].Reference,
+		}
+		if result.Gains[i].Acquired != "" {
+			gains[i].Acquired, err = time.Parse(common.SimpleTimeFormatWithTimezone, result.Gains[i].Acquired)
+			if err != nil {
+				return err
+			}
+		}
+		gains[i].Disposed, err = time.Parse(common.SimpleTimeFormatWithTimezone, result.Gains[i].Disposed)
+		if err != nil {
+			return err
+		}
+	}
+	if err := gctfile.WriteAsCSV(output, costbasis.GainRecords(gains)); err != nil {
+		return err
+	}
+	fmt.Printf("Exported %d realised gains to %s. Total %s gain: %v\n", len(gains), output, result.FiatCurrency, result.TotalGain)
+	return nil
+}
+
 var addPortfolioAddressCommand = &cli.Command{
 	Name:      "addportfolioaddress",
 	Usage:     "adds an address to the portfolio",
@@ -1659,6 +1876,148 @@ func simulateOrder(c *cli.Context) error {
 	return nil
 }
 
+var simulateSmartOrderCommand = &cli.Command{
+	Name:      "simulatesmartorder",
+	Usage:     "previews how an order would be split across all enabled exchanges by best effective price",
+	ArgsUsage: "<pair> <side> <type> <amount> <price> <asset>",
+	Action:    simulateSmartOrder,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		&cli.StringFlag{
+			Name:  "side",
+			Usage: "the order side to use (BUY OR SELL)",
+		},
+		&cli.StringFlag{
+			Name:  "type",
+			Usage: "the order type (MARKET OR LIMIT)",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount for the order",
+		},
+		&cli.Float64Flag{
+			Name:  "price",
+			Usage: "the limit price for the order, levels worse than the price are not routed to",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "required asset type",
+		},
+	},
+}
+
+func simulateSmartOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(1)
+	}
+
+	if orderSide == "" {
+		return errors.New("order side must be set")
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(2)
+	}
+
+	if orderType == "" {
+		return errors.New("order type must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if amount == 0 {
+		return errors.New("amount must be set")
+	}
+
+	// price is optional for market orders
+	var price float64
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(4) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(5)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SimulateSmartOrder(c.Context, &gctrpc.SimulateSmartOrderRequest{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType: assetType,
+		Side:      orderSide,
+		OrderType: orderType,
+		Amount:    amount,
+		Price:     price,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var cancelOrderCommand = &cli.Command{
 	Name:      "cancelorder",
 	Usage:     "cancel order cancels an exchange order",
@@ -2115,7 +2474,7 @@ var addEventCommand = &cli.Command{
 		},
 		&cli.StringFlag{
 			Name:  "item",
-			Usage: "the item to trigger the event",
+			Usage: "the item to trigger the event: PRICE, ORDERBOOK, RSI, BOLLINGER, MACD, FUNDING_RATE, SPREAD or BALANCE_CHANGE",
 		},
 		&cli.StringFlag{
 			Name:  "condition",
@@ -2137,6 +2496,42 @@ var addEventCommand = &cli.Command{
 			Name:  "orderbook_amount",
 			Usage: "the orderbook amount to trigger the event",
 		},
+		&cli.Float64Flag{
+			Name:  "value",
+			Usage: "the indicator, funding rate, spread percentage or balance change to trigger the event",
+		},
+		&cli.DurationFlag{
+			Name:  "interval",
+			Usage: "the candle interval indicators are calculated on, defaults to 1h",
+		},
+		&cli.Int64Flag{
+			Name:  "period",
+			Usage: "the RSI or Bollinger band period",
+		},
+		&cli.Int64Flag{
+			Name:  "fast_period",
+			Usage: "the MACD fast period",
+		},
+		&cli.Int64Flag{
+			Name:  "slow_period",
+			Usage: "the MACD slow period",
+		},
+		&cli.Int64Flag{
+			Name:  "signal_period",
+			Usage: "the MACD signal period",
+		},
+		&cli.Float64Flag{
+			Name:  "std_dev",
+			Usage: "the Bollinger band standard deviation multiplier",
+		},
+		&cli.StringFlag{
+			Name:  "currency",
+			Usage: "the currency of a balance change item",
+		},
+		&cli.StringFlag{
+			Name:  "rule",
+			Usage: "a JSON composite rule which replaces the item and condition flags e.g. '{\"operator\":\"AND\",\"rules\":[{\"item\":\"PRICE\",\"condition_params\":{\"condition\":\">\",\"price\":100}},{\"item\":\"SPREAD\",\"condition_params\":{\"condition\":\"<\",\"value\":0.1}}]}'",
+		},
 		&cli.StringFlag{
 			Name:  "pair",
 			Usage: "the currency pair",
@@ -2149,6 +2544,18 @@ var addEventCommand = &cli.Command{
 			Name:  "action",
 			Usage: "the action for the event to perform upon trigger",
 		},
+		&cli.StringFlag{
+			Name:  "actions",
+			Usage: "a JSON list of SUBMIT_ORDER, CANCEL_ORDER, RUN_SCRIPT or COMMS actions e.g. '[{\"type\":\"SUBMIT_ORDER\",\"side\":\"buy\",\"order_type\":\"market\",\"amount\":1}]'",
+		},
+		&cli.BoolFlag{
+			Name:  "repeat",
+			Usage: "whether the event can trigger more than once",
+		},
+		&cli.DurationFlag{
+			Name:  "cooldown",
+			Usage: "the minimum time between triggers of a repeating event",
+		},
 	},
 }
 
@@ -2158,15 +2565,10 @@ func addEvent(c *cli.Context) error {
 	}
 
 	var exchangeName string
-	var item string
-	var condition string
-	var price float64
-	var checkBids bool
-	var checkAsks bool
-	var orderbookAmount float64
 	var currencyPair string
 	var assetType string
-	var action string
+	var rule *gctrpc.EventRule
+	var actions []*gctrpc.EventAction
 
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
@@ -2174,32 +2576,36 @@ func addEvent(c *cli.Context) error {
 		return errors.New("exchange name is required")
 	}
 
-	if c.IsSet("item") {
-		item = c.String("item")
-	} else {
-		return errors.New("item is required")
-	}
-
-	if c.IsSet("condition") {
-		condition = c.String("condition")
+	if c.IsSet("rule") {
+		rule = &gctrpc.EventRule{}
+		if err := json.Unmarshal([]byte(c.String("rule")), rule); err != nil {
+			return fmt.Errorf("invalid rule: %w", err)
+		}
 	} else {
-		return errors.New("condition is required")
-	}
-
-	if c.IsSet("price") {
-		price = c.Float64("price")
-	}
-
-	if c.IsSet("check_bids") {
-		checkBids = c.Bool("check_bids")
-	}
-
-	if c.IsSet("check_asks") {
-		checkAsks = c.Bool("check_asks")
-	}
-
-	if c.IsSet("orderbook_amount") {
-		orderbookAmount = c.Float64("orderbook_amount")
+		if !c.IsSet("item") {
+			return errors.New("item is required")
+		}
+		if !c.IsSet("condition") {
+			return errors.New("condition is required")
+		}
+		rule = &gctrpc.EventRule{
+			Item: c.String("item"),
+			ConditionParams: &gctrpc.ConditionParams{
+				Condition:       c.String("condition"),
+				Price:           c.Float64("price"),
+				CheckBids:       c.Bool("check_bids"),
+				CheckAsks:       c.Bool("check_asks"),
+				OrderbookAmount: c.Float64("orderbook_amount"),
+				Value:           c.Float64("value"),
+				Interval:        int64(c.Duration("interval")),
+				Period:          c.Int64("period"),
+				FastPeriod:      c.Int64("fast_period"),
+				SlowPeriod:      c.Int64("slow_period"),
+				SignalPeriod:    c.Int64("signal_period"),
+				StdDev:          c.Float64("std_dev"),
+				Currency:        c.String("currency"),
+			},
+		}
 	}
 
 	if c.IsSet("pair") {
@@ -2221,10 +2627,14 @@ func addEvent(c *cli.Context) error {
 		return errInvalidAsset
 	}
 
-	if c.IsSet("action") {
-		action = c.String("action")
-	} else {
-		return errors.New("action is required")
+	if c.IsSet("actions") {
+		if err := json.Unmarshal([]byte(c.String("actions")), &actions); err != nil {
+			return fmt.Errorf("invalid actions: %w", err)
+		}
+	}
+
+	if !c.IsSet("action") && len(actions) == 0 {
+		return errors.New("action or actions is required")
 	}
 
 	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
@@ -2241,21 +2651,17 @@ func addEvent(c *cli.Context) error {
 	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
 	result, err := client.AddEvent(c.Context, &gctrpc.AddEventRequest{
 		Exchange: exchangeName,
-		Item:     item,
-		ConditionParams: &gctrpc.ConditionParams{
-			Condition:       condition,
-			Price:           price,
-			CheckBids:       checkBids,
-			CheckAsks:       checkAsks,
-			OrderbookAmount: orderbookAmount,
-		},
+		Rule:     rule,
 		Pair: &gctrpc.CurrencyPair{
 			Delimiter: p.Delimiter,
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
 		AssetType: assetType,
-		Action:    action,
+		Action:    c.String("action"),
+		Actions:   actions,
+		Repeat:    c.Bool("repeat"),
+		Cooldown:  int64(c.Duration("cooldown")),
 	})
 	if err != nil {
 		return err
@@ -3007,6 +3413,128 @@ func withdrawlRequestByDate(c *cli.Context) error {
 	return nil
 }
 
+var getPendingWithdrawalsCommand = &cli.Command{
+	Name:   "getpendingwithdrawals",
+	Usage:  "gets withdrawal requests awaiting approval",
+	Action: getPendingWithdrawals,
+}
+
+func getPendingWithdrawals(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetPendingWithdrawals(c.Context, &gctrpc.GetPendingWithdrawalsRequest{})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var approveWithdrawalCommand = &cli.Command{
+	Name:      "approvewithdrawal",
+	Usage:     "approves a pending withdrawal request, submitting it to its exchange",
+	ArgsUsage: "<id> <code>",
+	Action:    approveWithdrawal,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the pending withdrawal id",
+		},
+		&cli.StringFlag{
+			Name:  "code",
+			Usage: "the TOTP code when the withdrawal policy requires one, which can be generated by cmd/gen_otp",
+		},
+	},
+}
+
+func approveWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	var code string
+	if c.IsSet("code") {
+		code = c.String("code")
+	} else {
+		code = c.Args().Get(1)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.ApproveWithdrawal(c.Context, &gctrpc.ApproveWithdrawalRequest{
+		Id:   id,
+		Code: code,
+	})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var rejectWithdrawalCommand = &cli.Command{
+	Name:      "rejectwithdrawal",
+	Usage:     "rejects a pending withdrawal request",
+	ArgsUsage: "<id>",
+	Action:    rejectWithdrawal,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the pending withdrawal id",
+		},
+	},
+}
+
+func rejectWithdrawal(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	if id == "" {
+		return errors.New("an ID must be specified")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.RejectWithdrawal(c.Context, &gctrpc.RejectWithdrawalRequest{Id: id})
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
 var getLoggerDetailsCommand = &cli.Command{
 	Name:      "getloggerdetails",
 	Usage:     "gets an individual loggers details",
@@ -3960,6 +4488,109 @@ func getHistoricCandles(c *cli.Context) error {
 	return nil
 }
 
+var getCandleStreamCommand = &cli.Command{
+	Name:      "getcandlestream",
+	Usage:     "streams the candles built from websocket trades by the candle aggregator as they close",
+	ArgsUsage: "<exchange> <pair> <asset> <granularity>",
+	Action:    getCandleStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to stream the candles from",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to stream the candles for",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		&cli.Int64Flag{
+			Name:    "granularity",
+			Aliases: []string{"g"},
+			Usage:   klineMessage,
+			Value:   60,
+		},
+	},
+}
+
+func getCandleStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	granularity := c.Int64("granularity")
+	if !c.IsSet("granularity") && c.Args().Get(3) != "" {
+		granularity, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetCandleStream(c.Context,
+		&gctrpc.GetCandleStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:    assetType,
+			TimeInterval: int64(time.Duration(granularity) * time.Second),
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
 var getHistoricCandlesExtendedCommand = &cli.Command{
 	Name:      "gethistoriccandlesextended",
 	Usage:     "gets historical candles for the specified pair, asset, interval & date range",
@@ -0,0 +1,300 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var conditionalOrderCommand = &cli.Command{
+	Name:      "conditionalorder",
+	Aliases:   []string{"co"},
+	Usage:     "manages stop, take profit, trailing stop and OCO orders held locally by the order manager",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "submit",
+			Usage:     "submits a conditional order which is sent to the exchange as a market or limit order once triggered",
+			ArgsUsage: "<exchange> <pair> <asset> <side> <type> <amount> <triggerprice>",
+			Action:    submitConditionalOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange to submit the order to",
+				},
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the currency pair",
+				},
+				&cli.StringFlag{
+					Name:  "side",
+					Usage: "the order side to use (BUY OR SELL)",
+				},
+				&cli.StringFlag{
+					Name:  "type",
+					Usage: "the conditional order type (STOP, STOP_LIMIT, TAKE_PROFIT, TAKE_PROFIT_MARKET, TRAILING_STOP OR OCO)",
+				},
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount for the order",
+				},
+				&cli.Float64Flag{
+					Name:    "triggerprice",
+					Aliases: []string{"trigger"},
+					Usage:   "the price which triggers order submission for STOP and TAKE_PROFIT types",
+				},
+				&cli.Float64Flag{
+					Name:    "limitprice",
+					Aliases: []string{"price"},
+					Usage:   "the limit price used for STOP_LIMIT and TAKE_PROFIT types",
+				},
+				&cli.StringFlag{
+					Name:  "trackingmode",
+					Usage: "how a TRAILING_STOP follows the market (distance OR percentage)",
+				},
+				&cli.Float64Flag{
+					Name:  "trackingvalue",
+					Usage: "the distance or percentage a TRAILING_STOP trails the best price",
+				},
+				&cli.Float64Flag{
+					Name:    "takeprofitprice",
+					Aliases: []string{"tp"},
+					Usage:   "the take profit trigger price for OCO orders",
+				},
+				&cli.Float64Flag{
+					Name:  "takeprofitlimitprice",
+					Usage: "the optional take profit limit price for OCO orders, market if unset",
+				},
+				&cli.Float64Flag{
+					Name:    "stoplossprice",
+					Aliases: []string{"sl"},
+					Usage:   "the stop loss trigger price for OCO orders",
+				},
+				&cli.Float64Flag{
+					Name:  "stoplosslimitprice",
+					Usage: "the optional stop loss limit price for OCO orders, market if unset",
+				},
+				&cli.StringFlag{
+					Name:  "client_id",
+					Usage: "the optional client order ID",
+				},
+			},
+		},
+		{
+			Name:      "get",
+			Aliases:   []string{"list"},
+			Usage:     "returns conditional orders held by the order manager",
+			ArgsUsage: "<exchange> <includeinactive>",
+			Action:    getConditionalOrders,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the optional exchange to filter conditional orders by",
+				},
+				&cli.BoolFlag{
+					Name:    "includeinactive",
+					Aliases: []string{"all"},
+					Usage:   "includes triggered, cancelled and failed conditional orders",
+				},
+			},
+		},
+		{
+			Name:      "cancel",
+			Usage:     "cancels a pending conditional order and its linked OCO leg",
+			ArgsUsage: "<id>",
+			Action:    cancelConditionalOrder,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the conditional order ID",
+				},
+			},
+		},
+	},
+}
+
+func submitConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var orderSide string
+	if c.IsSet("side") {
+		orderSide = c.String("side")
+	} else {
+		orderSide = c.Args().Get(3)
+	}
+
+	var orderType string
+	if c.IsSet("type") {
+		orderType = c.String("type")
+	} else {
+		orderType = c.Args().Get(4)
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(5) != "" {
+		amount, err = strconv.ParseFloat(c.Args().Get(5), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	var triggerPrice float64
+	if c.IsSet("triggerprice") {
+		triggerPrice = c.Float64("triggerprice")
+	} else if c.Args().Get(6) != "" {
+		triggerPrice, err = strconv.ParseFloat(c.Args().Get(6), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SubmitConditionalOrder(c.Context, &gctrpc.SubmitConditionalOrderRequest{
+		Exchange: exchangeName,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		Asset:                assetType,
+		Side:                 orderSide,
+		OrderType:            orderType,
+		Amount:               amount,
+		TriggerPrice:         triggerPrice,
+		LimitPrice:           c.Float64("limitprice"),
+		TrackingMode:         c.String("trackingmode"),
+		TrackingValue:        c.Float64("trackingvalue"),
+		TakeProfitPrice:      c.Float64("takeprofitprice"),
+		TakeProfitLimitPrice: c.Float64("takeprofitlimitprice"),
+		StopLossPrice:        c.Float64("stoplossprice"),
+		StopLossLimitPrice:   c.Float64("stoplosslimitprice"),
+		ClientId:             c.String("client_id"),
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConditionalOrders(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var includeInactive bool
+	if c.IsSet("includeinactive") {
+		includeInactive = c.Bool("includeinactive")
+	} else if c.Args().Get(1) != "" {
+		var err error
+		includeInactive, err = strconv.ParseBool(c.Args().Get(1))
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetConditionalOrders(c.Context, &gctrpc.GetConditionalOrdersRequest{
+		Exchange:        exchangeName,
+		IncludeInactive: includeInactive,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelConditionalOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.CancelConditionalOrder(c.Context, &gctrpc.CancelConditionalOrderRequest{
+		Id: id,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
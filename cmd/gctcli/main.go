@@ -178,6 +178,9 @@ func main() {
 		getConfigCommand,
 		getPortfolioCommand,
 		getPortfolioSummaryCommand,
+		getPortfolioValuationCommand,
+		getPortfolioValuationHistoryCommand,
+		getCostBasisReportCommand,
 		addPortfolioAddressCommand,
 		removePortfolioAddressCommand,
 		getForexProvidersCommand,
@@ -187,6 +190,7 @@ func main() {
 		getOrderCommand,
 		submitOrderCommand,
 		simulateOrderCommand,
+		simulateSmartOrderCommand,
 		cancelOrderCommand,
 		cancelBatchOrdersCommand,
 		cancelAllOrdersCommand,
@@ -200,6 +204,9 @@ func main() {
 		withdrawCryptocurrencyFundsCommand,
 		withdrawFiatFundsCommand,
 		withdrawalRequestCommand,
+		getPendingWithdrawalsCommand,
+		approveWithdrawalCommand,
+		rejectWithdrawalCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
 		exchangePairManagerCommand,
@@ -208,6 +215,7 @@ func main() {
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
 		getHistoricCandlesExtendedCommand,
+		getCandleStreamCommand,
 		findMissingSavedCandleIntervalsCommand,
 		gctScriptCommand,
 		websocketManagerCommand,
@@ -220,6 +228,9 @@ func main() {
 		getMarginRatesHistoryCommand,
 		orderbookCommand,
 		getCurrencyTradeURLCommand,
+		conditionalOrderCommand,
+		algoOrderCommand,
+		riskKillSwitchCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
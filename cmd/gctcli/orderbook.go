@@ -47,6 +47,7 @@ var orderbookCommand = &cli.Command{
 			Subcommands: []*cli.Command{nominal, impact, quote, baseRequired},
 		},
 		getOrderbookCommand,
+		getRecordedOrderbookCommand,
 		getOrderbooksCommand,
 		getOrderbookStreamCommand,
 		getExchangeOrderbookStreamCommand,
@@ -461,6 +462,93 @@ func getOrderbook(c *cli.Context) error {
 	return nil
 }
 
+var getRecordedOrderbookCommand = &cli.Command{
+	Name:      "getrecordedorderbook",
+	Usage:     "reconstructs an orderbook recorded by the orderbook recorder at a point in time",
+	ArgsUsage: "<exchange> <pair> <asset> <timestamp>",
+	Action:    getRecordedOrderbook,
+	Flags: append(orderbookCommonFlags,
+		&cli.StringFlag{
+			Name:  "timestamp",
+			Usage: "the time to reconstruct the orderbook at, formatted as " + time.DateTime,
+			Value: time.Now().Format(time.DateTime),
+		}),
+}
+
+func getRecordedOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName, pair, assetType string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	timestamp := c.String("timestamp")
+	if !c.IsSet("timestamp") && c.Args().Get(3) != "" {
+		timestamp = c.Args().Get(3)
+	}
+	at, err := time.ParseInLocation(time.DateTime, timestamp, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for timestamp: %v", err)
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.GetRecordedOrderbook(c.Context,
+		&gctrpc.GetRecordedOrderbookRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Timestamp: at.Format(common.SimpleTimeFormatWithTimezone),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getOrderbooksCommand = &cli.Command{
 	Name:   "getorderbooks",
 	Usage:  "gets all orderbooks for all enabled exchanges and currency pairs",
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var riskKillSwitchCommand = &cli.Command{
+	Name:      "killswitch",
+	Usage:     "manages the order manager pre-trade risk kill switch",
+	ArgsUsage: "<command>",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "engage",
+			Usage:  "rejects all new orders and modifications, then cancels all orders on every exchange",
+			Action: engageRiskKillSwitch,
+		},
+		{
+			Name:   "release",
+			Usage:  "allows orders to be submitted and modified again",
+			Action: releaseRiskKillSwitch,
+		},
+	},
+}
+
+func engageRiskKillSwitch(c *cli.Context) error {
+	return setRiskKillSwitch(c, true)
+}
+
+func releaseRiskKillSwitch(c *cli.Context) error {
+	return setRiskKillSwitch(c, false)
+}
+
+func setRiskKillSwitch(c *cli.Context, engaged bool) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderServiceClient(conn)
+	result, err := client.SetRiskKillSwitch(c.Context, &gctrpc.SetRiskKillSwitchRequest{
+		Engaged: engaged,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
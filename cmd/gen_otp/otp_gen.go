@@ -14,6 +14,9 @@ import (
 const defaultSleepTime = time.Second * 30
 
 func containsOTP(cfg *config.Config) bool {
+	if cfg.WithdrawalPolicy.Approval.TOTPSecret != "" {
+		return true
+	}
 	for x := range cfg.Exchanges {
 		if cfg.Exchanges[x].API.Credentials.OTPSecret != "" {
 			return true
@@ -67,7 +70,7 @@ func main() {
 	log.Println("Loaded config file.")
 
 	if !containsOTP(&cfg) {
-		log.Fatal("No exchanges with OTP code stored. Exiting.")
+		log.Fatal("No exchanges or withdrawal approvals with OTP code stored. Exiting.")
 	}
 
 	for {
@@ -81,6 +84,14 @@ func main() {
 				log.Printf("%s: %s\n", cfg.Exchanges[x].Name, code)
 			}
 		}
+		if secret := cfg.WithdrawalPolicy.Approval.TOTPSecret; secret != "" {
+			code, err = totp.GenerateCode(secret, time.Now())
+			if err != nil {
+				log.Printf("Withdrawal approval: Failed to generate OTP code. Err: %s\n", err)
+			} else {
+				log.Printf("Withdrawal approval: %s\n", code)
+			}
+		}
 		time.Sleep(defaultSleepTime)
 	}
 }
@@ -1,9 +1,22 @@
 package base
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 )
 
+// ErrUnknownCommand is returned by a CommandHandler when it does not handle
+// the command, allowing the relayer to fall back to its default reply
+var ErrUnknownCommand = errors.New("unknown command")
+
+// CommandHandler handles a command received by the named relayer from a user,
+// returning the reply to send back to the user
+type CommandHandler func(relayer, user, text string) (string, error)
+
 // Base enforces standard variables across communication packages
 type Base struct {
 	Name           string
@@ -11,12 +24,18 @@ type Base struct {
 	Verbose        bool
 	Connected      bool
 	ServiceStarted time.Time
+
+	commandHandler CommandHandler
+	commandMtx     sync.RWMutex
 }
 
 // Event is a generalise event type
 type Event struct {
 	Type    string
 	Message string
+	// Relayers restricts the event to the named comms relayers, an empty
+	// list pushes to all relayers
+	Relayers []string
 }
 
 // CommsStatus stores the status of a comms relayer
@@ -53,6 +72,33 @@ func (b *Base) SetServiceStarted(t time.Time) {
 	b.ServiceStarted = t
 }
 
+// SetCommandHandler sets the handler for commands the relayer does not
+// handle itself
+func (b *Base) SetCommandHandler(h CommandHandler) {
+	b.commandMtx.Lock()
+	b.commandHandler = h
+	b.commandMtx.Unlock()
+}
+
+// HandleCommand passes a command from a user to the command handler, returning
+// the reply and whether the command was handled
+func (b *Base) HandleCommand(user, text string) (string, bool) {
+	b.commandMtx.RLock()
+	h := b.commandHandler
+	b.commandMtx.RUnlock()
+	if h == nil {
+		return "", false
+	}
+	reply, err := h(b.Name, user, text)
+	switch {
+	case errors.Is(err, ErrUnknownCommand):
+		return "", false
+	case err != nil:
+		return fmt.Sprintf("Command %s failed: %s", text, err), true
+	}
+	return reply, true
+}
+
 // CommunicationsConfig holds all the information needed for each
 // enabled communication package
 type CommunicationsConfig struct {
@@ -60,6 +106,7 @@ type CommunicationsConfig struct {
 	SMSGlobalConfig SMSGlobalConfig `json:"smsGlobal"`
 	SMTPConfig      SMTPConfig      `json:"smtp"`
 	TelegramConfig  TelegramConfig  `json:"telegram"`
+	ChatOps         ChatOpsConfig   `json:"chatOps"`
 }
 
 // IsAnyEnabled returns whether any comms relayers
@@ -74,6 +121,40 @@ func (c *CommunicationsConfig) IsAnyEnabled() bool {
 	return false
 }
 
+// ChatOpsConfig holds the settings for commands sent to the comms relayers
+type ChatOpsConfig struct {
+	// Enabled registers the chat-ops commands for querying and controlling
+	// the engine
+	Enabled bool `json:"enabled"`
+	// AuthorisedUsers lists the usernames allowed to send commands, keyed by
+	// relayer name
+	AuthorisedUsers map[string][]string `json:"authorisedUsers"`
+	// ConfirmationTimeout is how long a destructive command awaits
+	// confirmation before it is discarded
+	ConfirmationTimeout time.Duration `json:"confirmationTimeout"`
+}
+
+// IsAuthorised returns whether the user may send commands to the relayer
+func (c *ChatOpsConfig) IsAuthorised(relayer, user string) bool {
+	return IsAuthorisedUser(c.AuthorisedUsers, relayer, user)
+}
+
+// IsAuthorisedUser returns whether the user is listed for the relayer in the
+// authorised usernames, keyed by relayer name. Names are case insensitive
+func IsAuthorisedUser(authorised map[string][]string, relayer, user string) bool {
+	if user == "" {
+		return false
+	}
+	for name, users := range authorised {
+		if strings.EqualFold(name, relayer) && slices.ContainsFunc(users, func(u string) bool {
+			return strings.EqualFold(u, user)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
 // SlackConfig holds all variables to start and run the Slack package
 type SlackConfig struct {
 	Name              string `json:"name"`
@@ -2,6 +2,8 @@ package base
 
 import (
 	"errors"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -19,6 +21,7 @@ type ICommunicate interface {
 	IsConnected() bool
 	GetName() string
 	SetServiceStarted(time.Time)
+	SetCommandHandler(CommandHandler)
 }
 
 // Setup sets up communication variables and initiates a connection to the
@@ -40,6 +43,11 @@ func (c IComm) Setup() {
 // PushEvent pushes triggered events to all enabled communication links
 func (c IComm) PushEvent(event Event) {
 	for i := range c {
+		if len(event.Relayers) > 0 && !slices.ContainsFunc(event.Relayers, func(name string) bool {
+			return strings.EqualFold(name, c[i].GetName())
+		}) {
+			continue
+		}
 		if c[i].IsEnabled() && c[i].IsConnected() {
 			err := c[i].PushEvent(event)
 			if err != nil {
@@ -50,6 +58,13 @@ func (c IComm) PushEvent(event Event) {
 	}
 }
 
+// SetCommandHandler sets the command handler across all communication links
+func (c IComm) SetCommandHandler(h CommandHandler) {
+	for i := range c {
+		c[i].SetCommandHandler(h)
+	}
+}
+
 // GetStatus returns the status of the comms relayers
 func (c IComm) GetStatus() map[string]CommsStatus {
 	result := make(map[string]CommsStatus)
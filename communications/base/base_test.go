@@ -1,6 +1,7 @@
 package base
 
 import (
+	"errors"
 	"testing"
 	"time"
 )
@@ -54,6 +55,7 @@ type CommunicationProvider struct {
 	ConnectCalled    bool
 	PushEventCalled  bool
 	ServiceStartTime time.Time
+	CommandHandler   CommandHandler
 }
 
 func (p *CommunicationProvider) IsEnabled() bool {
@@ -82,6 +84,10 @@ func (p *CommunicationProvider) SetServiceStarted(t time.Time) {
 	p.ServiceStartTime = t
 }
 
+func (p *CommunicationProvider) SetCommandHandler(h CommandHandler) {
+	p.CommandHandler = h
+}
+
 func TestSetup(t *testing.T) {
 	var ic IComm
 	testConfigs := []struct {
@@ -151,3 +157,86 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestPushEventRelayers(t *testing.T) {
+	t.Parallel()
+	p := &CommunicationProvider{isEnabled: true, isConnected: true}
+	ic := IComm{p}
+
+	ic.PushEvent(Event{Relayers: []string{"slack"}})
+	if p.PushEventCalled {
+		t.Error("event should not be pushed to an unlisted relayer")
+	}
+
+	ic.PushEvent(Event{Relayers: []string{"slack", "SOMETESTPROVIDER"}})
+	if !p.PushEventCalled {
+		t.Error("event should be pushed to a listed relayer")
+	}
+}
+
+func TestHandleCommand(t *testing.T) {
+	t.Parallel()
+	c := &Base{Name: "test"}
+	if _, ok := c.HandleCommand("user", "/cmd"); ok {
+		t.Error("command should not be handled without a handler")
+	}
+
+	var relayer, user string
+	c.SetCommandHandler(func(r, u, text string) (string, error) {
+		relayer, user = r, u
+		switch text {
+		case "/cmd":
+			return "reply", nil
+		case "/fail":
+			return "", errors.New("bad")
+		}
+		return "", ErrUnknownCommand
+	})
+	reply, ok := c.HandleCommand("user", "/cmd")
+	if !ok || reply != "reply" {
+		t.Errorf("expected handled reply 'reply', received %v '%s'", ok, reply)
+	}
+	if relayer != "test" || user != "user" {
+		t.Errorf("expected relayer 'test' and user 'user', received '%s' '%s'", relayer, user)
+	}
+	if _, ok = c.HandleCommand("user", "/unknown"); ok {
+		t.Error("unknown commands should not be handled")
+	}
+	reply, ok = c.HandleCommand("user", "/fail")
+	if !ok || reply != "Command /fail failed: bad" {
+		t.Errorf("expected handled error reply, received %v '%s'", ok, reply)
+	}
+}
+
+func TestSetCommandHandler(t *testing.T) {
+	t.Parallel()
+	p := &CommunicationProvider{}
+	IComm{p}.SetCommandHandler(func(string, string, string) (string, error) { return "", nil })
+	if p.CommandHandler == nil {
+		t.Error("command handler should be set on all relayers")
+	}
+}
+
+func TestChatOpsIsAuthorised(t *testing.T) {
+	t.Parallel()
+	c := ChatOpsConfig{AuthorisedUsers: map[string][]string{"Telegram": {"Satoshi"}}}
+	if !c.IsAuthorised("telegram", "satoshi") {
+		t.Error("relayer and user names should be case insensitive")
+	}
+	if c.IsAuthorised("slack", "satoshi") {
+		t.Error("users should only be authorised for their relayer")
+	}
+	if c.IsAuthorised("telegram", "") {
+		t.Error("unknown users should not be authorised")
+	}
+}
+
+func TestIsAuthorisedUser(t *testing.T) {
+	t.Parallel()
+	if IsAuthorisedUser(nil, "telegram", "satoshi") {
+		t.Error("users should not be authorised without an authorised user list")
+	}
+	if !IsAuthorisedUser(map[string][]string{"slack": {"satoshi"}}, "Slack", "Satoshi") {
+		t.Error("relayer and user names should be case insensitive")
+	}
+}
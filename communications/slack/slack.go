@@ -30,7 +30,8 @@ const (
 	getHelp = `GoCryptoTrader SlackBot, thank you for using this service!
 	Current commands are:
 	!status 		- Displays current working status of bot
-	!help 			- Displays help text`
+	!help 			- Displays help text
+	!commands 		- Displays engine commands available to authorised users`
 )
 
 // Slack starts a websocket connection and uses https://api.slack.com/rtm real
@@ -385,6 +386,7 @@ func (s *Slack) HandleMessage(msg *Message) error {
 		return errors.New("slack msg is nil")
 	}
 
+	text := msg.Text
 	msg.Text = strings.ToLower(msg.Text)
 	switch {
 	case strings.Contains(msg.Text, cmdStatus):
@@ -394,6 +396,9 @@ func (s *Slack) HandleMessage(msg *Message) error {
 		return s.WebsocketSend("message", getHelp)
 
 	default:
+		if reply, ok := s.HandleCommand(s.GetUsernameByID(msg.User), text); ok {
+			return s.WebsocketSend("message", reply)
+		}
 		return s.WebsocketSend("message", "GoCryptoTrader SlackBot - Command Unknown!")
 	}
 }
@@ -35,7 +35,8 @@ const (
 	Current commands are:
 	/start  		- Will authenticate your ID
 	/status 		- Displays the status of the bot
-	/help 			- Displays current command list`
+	/help 			- Displays current command list
+	/commands 		- Displays engine commands available to authorised users`
 
 	talkRoot = "GoCryptoTrader bot"
 )
@@ -209,10 +210,23 @@ func (t *Telegram) HandleMessages(text string, chatID int64) error {
 		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, t.GetStatus()), chatID)
 
 	default:
+		if reply, ok := t.HandleCommand(t.getUsernameByID(chatID), text); ok {
+			return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, reply), chatID)
+		}
 		return t.SendMessage(fmt.Sprintf("Command %s not recognized", text), chatID)
 	}
 }
 
+// getUsernameByID returns the authorised client username for a chat ID
+func (t *Telegram) getUsernameByID(chatID int64) string {
+	for username, id := range t.AuthorisedClients {
+		if id == chatID {
+			return username
+		}
+	}
+	return ""
+}
+
 // GetUpdates gets new updates via a long poll connection
 func (t *Telegram) GetUpdates() (GetUpdateResponse, error) {
 	var newUpdates GetUpdateResponse
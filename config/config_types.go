@@ -8,6 +8,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/marketmaker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/subscription"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
@@ -100,6 +103,14 @@ type Config struct {
 	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
 	OrderManager         OrderManager              `json:"orderManager"`
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
+	OrderbookRecorder    OrderbookRecorder         `json:"orderbookRecorder"`
+	AlgoExecutionManager AlgoExecutionManager      `json:"algoExecutionManager"`
+	MarketMakingManager  MarketMakingManager       `json:"marketMakingManager"`
+	StrategyRunner       StrategyRunner            `json:"strategyRunner"`
+	CandleAggregator     CandleAggregator          `json:"candleAggregator"`
+	MetricsManager       MetricsManager            `json:"metricsManager"`
+	PortfolioValuation   PortfolioValuation        `json:"portfolioValuation"`
+	WithdrawalPolicy     WithdrawalPolicy          `json:"withdrawalPolicy"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
 	Profiler             Profiler                  `json:"profiler"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
@@ -134,6 +145,34 @@ type OrderManager struct {
 	FuturesTrackingSeekDuration   time.Duration `json:"futuresTrackingSeekDuration"`
 	RespectOrderHistoryLimits     bool          `json:"respectOrderHistoryLimits"`
 	CancelOrdersOnShutdown        bool          `json:"cancelOrdersOnShutdown"`
+	PersistOrders                 bool          `json:"persistOrders"`
+	Risk                          PreTradeRisk  `json:"risk"`
+}
+
+// PreTradeRisk holds settings for the order manager pre-trade risk checks which
+// run before every order submission and modification
+type PreTradeRisk struct {
+	Enabled bool        `json:"enabled"`
+	Limits  []RiskLimit `json:"limits"`
+}
+
+// RiskLimit defines pre-trade risk limits. An empty exchange, asset or pair
+// matches all orders, allowing global and specific limits to be combined. A
+// zero value disables the individual check
+type RiskLimit struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	// MaxNotional is the maximum value of a single order in quote currency
+	MaxNotional float64 `json:"maxNotional"`
+	// MaxPositionSize is the maximum base amount of the current position plus
+	// all open orders and the new order
+	MaxPositionSize float64 `json:"maxPositionSize"`
+	MaxOpenOrders   int64   `json:"maxOpenOrders"`
+	// PriceBandPercentage is the maximum deviation of an order price from the
+	// orderbook mid price
+	PriceBandPercentage float64 `json:"priceBandPercentage"`
+	MaxOrdersPerMinute  int64   `json:"maxOrdersPerMinute"`
 }
 
 // DataHistoryManager holds all information required for the data history manager
@@ -145,6 +184,150 @@ type DataHistoryManager struct {
 	Verbose             bool          `json:"verbose"`
 }
 
+// OrderbookRecorder holds all information required for the orderbook recorder
+type OrderbookRecorder struct {
+	Enabled bool `json:"enabled"`
+	// Output is either "database" or "file". File output writes to rotating
+	// archives stored in Directory
+	Output               string                  `json:"output"`
+	Directory            string                  `json:"directory"`
+	SnapshotInterval     time.Duration           `json:"snapshotInterval"`
+	FlushInterval        time.Duration           `json:"flushInterval"`
+	FileRotationInterval time.Duration           `json:"fileRotationInterval"`
+	Pairs                []OrderbookRecorderPair `json:"pairs"`
+}
+
+// OrderbookRecorderPair defines an exchange orderbook to record
+type OrderbookRecorderPair struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+}
+
+// AlgoExecutionManager holds all information required for the algo execution
+// manager
+type AlgoExecutionManager struct {
+	Enabled bool `json:"enabled"`
+	// CheckInterval is how often running algo orders are evaluated
+	CheckInterval time.Duration `json:"checkInterval"`
+	Verbose       bool          `json:"verbose"`
+}
+
+// MarketMakingManager holds all information required for the market making
+// manager
+type MarketMakingManager struct {
+	Enabled bool `json:"enabled"`
+	// CheckInterval is how often the quotes of each market maker are evaluated
+	CheckInterval time.Duration `json:"checkInterval"`
+	Verbose       bool          `json:"verbose"`
+	MarketMakers  []MarketMaker `json:"marketMakers"`
+}
+
+// MarketMaker defines an exchange pair quoted by the market making manager
+// and the ladder it quotes
+type MarketMaker struct {
+	Exchange string        `json:"exchange"`
+	Asset    asset.Item    `json:"asset"`
+	Pair     currency.Pair `json:"pair"`
+	marketmaker.Config
+}
+
+// StrategyRunner holds all information required for the strategy runner,
+// which runs backtester strategies live against websocket trade data
+type StrategyRunner struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// StrategyPluginPath is an optional Go plugin of custom strategies to load
+	StrategyPluginPath string `json:"strategyPluginPath"`
+	// Strategies are the paths of backtester strategy config files to run
+	Strategies []string `json:"strategies"`
+}
+
+// CandleAggregator holds all information required for the candle aggregator,
+// which builds candles for enabled pairs from websocket trade data
+type CandleAggregator struct {
+	Enabled   bool             `json:"enabled"`
+	Verbose   bool             `json:"verbose"`
+	Intervals []kline.Interval `json:"intervals"`
+	// MaxCandles is the number of closed candles retained per series
+	MaxCandles int `json:"maxCandles"`
+	// SaveToDatabase stores closed candles using the database connection
+	SaveToDatabase bool `json:"saveToDatabase"`
+}
+
+// MetricsManager holds all information required for the metrics manager,
+// which serves Prometheus metrics over HTTP
+type MetricsManager struct {
+	Enabled bool `json:"enabled"`
+	// ListenAddress is the address serving the /metrics endpoint
+	ListenAddress string `json:"listenAddress"`
+}
+
+// PortfolioValuation holds all information required for the portfolio
+// manager to save periodic valuation snapshots of portfolio holdings
+type PortfolioValuation struct {
+	Enabled bool `json:"enabled"`
+	// Interval is the minimum time between valuation snapshots
+	Interval time.Duration `json:"interval"`
+}
+
+// WithdrawalPolicy holds the rules the withdraw manager applies to
+// withdrawal requests before they are submitted to an exchange
+type WithdrawalPolicy struct {
+	Enabled bool `json:"enabled"`
+	// Limits restrict the amount withdrawn per currency
+	Limits []WithdrawalLimit `json:"limits"`
+	// Allowlist restricts cryptocurrency withdrawals to the listed addresses.
+	// An empty allowlist allows any address
+	Allowlist []WithdrawalAddress `json:"allowlist"`
+	// AddressCooldown is the time after an address is added to the allowlist
+	// before withdrawals to it are allowed
+	AddressCooldown time.Duration      `json:"addressCooldown"`
+	Approval        WithdrawalApproval `json:"approval"`
+}
+
+// WithdrawalLimit restricts the amount of a currency withdrawn. A zero amount
+// is not limited
+type WithdrawalLimit struct {
+	Currency currency.Code `json:"currency"`
+	// MaxAmount is the maximum amount of a single withdrawal
+	MaxAmount float64 `json:"maxAmount"`
+	// DailyAmount is the maximum amount withdrawn over a rolling 24 hours
+	DailyAmount float64 `json:"dailyAmount"`
+}
+
+// WithdrawalAddress is an allowed cryptocurrency withdrawal address
+type WithdrawalAddress struct {
+	Currency currency.Code `json:"currency"`
+	// Chain restricts the address to a transfer chain, an empty chain allows
+	// any chain
+	Chain      string `json:"chain"`
+	Address    string `json:"address"`
+	AddressTag string `json:"addressTag,omitempty"`
+	// AddedAt is when the address was added, used for the address cooldown.
+	// Addresses without an added time are stamped when the withdrawal policy
+	// is set up, so the cooldown applies from then
+	AddedAt time.Time `json:"addedAt"`
+}
+
+// WithdrawalApproval holds the settings for queuing withdrawals until they
+// are approved
+type WithdrawalApproval struct {
+	Enabled bool `json:"enabled"`
+	// Timeout is how long a withdrawal awaits approval before it expires,
+	// defaulting to an hour
+	Timeout time.Duration `json:"timeout"`
+	// TOTPSecret requires approvals to provide a TOTP code generated from the
+	// secret, such as by cmd/gen_otp
+	TOTPSecret string `json:"totpSecret"`
+	// Relayers allows withdrawals to be approved by replying to the
+	// communications relayers
+	Relayers bool `json:"relayers"`
+	// AuthorisedUsers lists the usernames allowed to approve or reject
+	// withdrawals from the communications relayers, keyed by relayer name
+	AuthorisedUsers map[string][]string `json:"authorisedUsers"`
+}
+
 // CurrencyStateManager defines a set of configuration options for the currency
 // state manager
 type CurrencyStateManager struct {
@@ -199,6 +382,7 @@ type Exchange struct {
 	Features                      *FeaturesConfig        `json:"features"`
 	BankAccounts                  []banking.Account      `json:"bankAccounts,omitempty"`
 	Orderbook                     Orderbook              `json:"orderbook"`
+	PaperTrading                  *PaperTrading          `json:"paperTrading,omitempty"`
 
 	// Deprecated settings which will be removed in a future update
 	AuthenticatedAPISupport          *bool   `json:"authenticatedApiSupport,omitempty"`
@@ -302,6 +486,7 @@ type FeaturesSupportedConfig struct {
 	RESTCapabilities      protocol.Features `json:"restCapabilities,omitzero"`
 	Websocket             bool              `json:"websocketAPI"`
 	WebsocketCapabilities protocol.Features `json:"websocketCapabilities,omitzero"`
+	FIX                   bool              `json:"fixAPI,omitempty"`
 }
 
 // FeaturesEnabledConfig stores the exchanges enabled features
@@ -362,6 +547,19 @@ type APIConfig struct {
 	Endpoints            map[string]string              `json:"urlEndpoints"`
 }
 
+// PaperTrading stores the simulated balances an exchange starts with when the
+// bot is run in paper trading mode
+type PaperTrading struct {
+	Balances []PaperTradingBalance `json:"balances"`
+}
+
+// PaperTradingBalance defines a simulated starting balance
+type PaperTradingBalance struct {
+	Asset    asset.Item    `json:"asset"`
+	Currency currency.Code `json:"currency"`
+	Amount   float64       `json:"amount"`
+}
+
 // Orderbook stores the orderbook configuration variables
 type Orderbook struct {
 	VerificationBypass     bool `json:"verificationBypass"`
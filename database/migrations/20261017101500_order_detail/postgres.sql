@@ -0,0 +1,52 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS order_detail
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    order_id varchar NOT NULL,
+    client_order_id varchar,
+    client_id varchar,
+    account_id varchar,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    side varchar NOT NULL,
+    type varchar NOT NULL,
+    status varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    trigger_price DOUBLE PRECISION NOT NULL,
+    average_executed_price DOUBLE PRECISION NOT NULL,
+    executed_amount DOUBLE PRECISION NOT NULL,
+    remaining_amount DOUBLE PRECISION NOT NULL,
+    cost DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_asset varchar,
+    leverage DOUBLE PRECISION NOT NULL,
+    reduce_only BOOLEAN NOT NULL,
+    post_only BOOLEAN NOT NULL,
+    date TIMESTAMPTZ NOT NULL,
+    last_updated TIMESTAMPTZ NOT NULL,
+    close_time TIMESTAMPTZ,
+    CONSTRAINT uniqueexchangeorderid
+        unique(exchange_name_id, order_id)
+);
+
+CREATE TABLE IF NOT EXISTS order_fill
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    order_detail_id uuid NOT NULL REFERENCES order_detail(id) ON DELETE CASCADE,
+    trade_id varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    fee_asset varchar,
+    side varchar NOT NULL,
+    is_maker BOOLEAN NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL,
+    CONSTRAINT uniqueorderfill
+        unique(order_detail_id, trade_id)
+);
+-- +goose Down
+DROP TABLE order_fill;
+DROP TABLE order_detail;
@@ -0,0 +1,52 @@
+-- +goose Up
+CREATE TABLE order_detail
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    order_id text NOT NULL,
+    client_order_id text,
+    client_id text,
+    account_id text,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    side text NOT NULL,
+    type text NOT NULL,
+    status text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    trigger_price real NOT NULL,
+    average_executed_price real NOT NULL,
+    executed_amount real NOT NULL,
+    remaining_amount real NOT NULL,
+    cost real NOT NULL,
+    fee real NOT NULL,
+    fee_asset text,
+    leverage real NOT NULL,
+    reduce_only boolean NOT NULL,
+    post_only boolean NOT NULL,
+    date timestamp NOT NULL,
+    last_updated timestamp NOT NULL,
+    close_time timestamp,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT,
+    UNIQUE(exchange_name_id, order_id)
+);
+
+CREATE TABLE order_fill
+(
+    id text NOT NULL primary key,
+    order_detail_id text NOT NULL,
+    trade_id text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    fee real NOT NULL,
+    fee_asset text,
+    side text NOT NULL,
+    is_maker boolean NOT NULL,
+    timestamp timestamp NOT NULL,
+    FOREIGN KEY(order_detail_id) REFERENCES order_detail(id) ON DELETE CASCADE,
+    UNIQUE(order_detail_id, trade_id) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE order_fill;
+DROP TABLE order_detail;
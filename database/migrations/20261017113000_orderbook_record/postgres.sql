@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook_record
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    is_snapshot BOOLEAN NOT NULL,
+    bids BYTEA NOT NULL,
+    asks BYTEA NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS orderbook_record_lookup_idx
+    ON orderbook_record(exchange_name_id, base, quote, asset, timestamp);
+-- +goose Down
+DROP TABLE orderbook_record;
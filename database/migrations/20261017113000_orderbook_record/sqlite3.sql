@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE orderbook_record
+(
+    id text NOT NULL primary key,
+    exchange_name_id text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset text NOT NULL,
+    is_snapshot boolean NOT NULL,
+    bids blob NOT NULL,
+    asks blob NOT NULL,
+    timestamp timestamp NOT NULL,
+    FOREIGN KEY(exchange_name_id) REFERENCES exchange(id) ON DELETE RESTRICT
+);
+CREATE INDEX orderbook_record_lookup_idx
+    ON orderbook_record(exchange_name_id, base, quote, asset, timestamp);
+-- +goose Down
+DROP TABLE orderbook_record;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS event_rule
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    definition text NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc')
+);
+-- +goose Down
+DROP TABLE event_rule;
@@ -0,0 +1,9 @@
+-- +goose Up
+CREATE TABLE event_rule
+(
+    id integer not null primary key,
+    definition text not null,
+    created_at timestamp not null default CURRENT_TIMESTAMP
+);
+-- +goose Down
+DROP TABLE event_rule;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS script_store
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    script text NOT NULL,
+    key text NOT NULL,
+    value text NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT (now() at time zone 'utc'),
+    CONSTRAINT script_store_uniq UNIQUE (script, key)
+);
+-- +goose Down
+DROP TABLE script_store;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE script_store
+(
+    id integer not null primary key,
+    script text not null,
+    key text not null,
+    value text not null,
+    updated_at timestamp not null default CURRENT_TIMESTAMP,
+    unique(script, key) ON CONFLICT REPLACE
+);
+-- +goose Down
+DROP TABLE script_store;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS portfolio_valuation
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    snapshot_time TIMESTAMPTZ NOT NULL,
+    fiat_currency varchar(30) NOT NULL,
+    exchange text NOT NULL,
+    currency varchar(30) NOT NULL,
+    balance DOUBLE PRECISION NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    value DOUBLE PRECISION NOT NULL
+);
+CREATE INDEX IF NOT EXISTS portfolio_valuation_snapshot_time_idx
+    ON portfolio_valuation(snapshot_time);
+-- +goose Down
+DROP TABLE portfolio_valuation;
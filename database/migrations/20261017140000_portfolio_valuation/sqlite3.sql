@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE portfolio_valuation
+(
+    id integer not null primary key,
+    snapshot_time timestamp not null,
+    fiat_currency text not null,
+    exchange text not null,
+    currency text not null,
+    balance real not null,
+    price real not null,
+    value real not null
+);
+CREATE INDEX portfolio_valuation_snapshot_time_idx
+    ON portfolio_valuation(snapshot_time);
+-- +goose Down
+DROP TABLE portfolio_valuation;
@@ -13,77 +13,116 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("EventRules", testEventRules)
 	t.Run("Exchanges", testExchanges)
+	t.Run("PortfolioValuations", testPortfolioValuations)
 	t.Run("Scripts", testScripts)
+	t.Run("ScriptStores", testScriptStores)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("EventRules", testEventRulesDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("PortfolioValuations", testPortfolioValuationsDelete)
 	t.Run("Scripts", testScriptsDelete)
+	t.Run("ScriptStores", testScriptStoresDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("EventRules", testEventRulesQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
+	t.Run("ScriptStores", testScriptStoresQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("EventRules", testEventRulesSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
+	t.Run("ScriptStores", testScriptStoresSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("EventRules", testEventRulesExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("PortfolioValuations", testPortfolioValuationsExists)
 	t.Run("Scripts", testScriptsExists)
+	t.Run("ScriptStores", testScriptStoresExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("EventRules", testEventRulesFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("PortfolioValuations", testPortfolioValuationsFind)
 	t.Run("Scripts", testScriptsFind)
+	t.Run("ScriptStores", testScriptStoresFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("EventRules", testEventRulesBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("PortfolioValuations", testPortfolioValuationsBind)
 	t.Run("Scripts", testScriptsBind)
+	t.Run("ScriptStores", testScriptStoresBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("EventRules", testEventRulesOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("PortfolioValuations", testPortfolioValuationsOne)
 	t.Run("Scripts", testScriptsOne)
+	t.Run("ScriptStores", testScriptStoresOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("EventRules", testEventRulesAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsAll)
 	t.Run("Scripts", testScriptsAll)
+	t.Run("ScriptStores", testScriptStoresAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("EventRules", testEventRulesCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("PortfolioValuations", testPortfolioValuationsCount)
 	t.Run("Scripts", testScriptsCount)
+	t.Run("ScriptStores", testScriptStoresCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("EventRules", testEventRulesHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("PortfolioValuations", testPortfolioValuationsHooks)
 	t.Run("Scripts", testScriptsHooks)
+	t.Run("ScriptStores", testScriptStoresHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("EventRules", testEventRulesInsert)
+	t.Run("EventRules", testEventRulesInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("PortfolioValuations", testPortfolioValuationsInsert)
+	t.Run("PortfolioValuations", testPortfolioValuationsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
+	t.Run("ScriptStores", testScriptStoresInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
+	t.Run("ScriptStores", testScriptStoresInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -128,29 +167,44 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("EventRules", testEventRulesReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("PortfolioValuations", testPortfolioValuationsReload)
+	t.Run("ScriptStores", testScriptStoresReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("EventRules", testEventRulesReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
+	t.Run("ScriptStores", testScriptStoresReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("EventRules", testEventRulesSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("PortfolioValuations", testPortfolioValuationsSelect)
 	t.Run("Scripts", testScriptsSelect)
+	t.Run("ScriptStores", testScriptStoresSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("EventRules", testEventRulesUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("PortfolioValuations", testPortfolioValuationsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
+	t.Run("ScriptStores", testScriptStoresUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("EventRules", testEventRulesSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
+	t.Run("ScriptStores", testScriptStoresSliceUpdateAll)
 }
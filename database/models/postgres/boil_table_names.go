@@ -9,9 +9,15 @@ var TableNames = struct {
 	Datahistoryjob          string
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
+	EventRule               string
 	Exchange                string
+	OrderDetail             string
+	OrderFill               string
+	OrderbookRecord         string
+	PortfolioValuation      string
 	Script                  string
 	ScriptExecution         string
+	ScriptStore             string
 	Trade                   string
 	WithdrawalCrypto        string
 	WithdrawalFiat          string
@@ -22,9 +28,15 @@ var TableNames = struct {
 	Datahistoryjob:          "datahistoryjob",
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
+	EventRule:               "event_rule",
 	Exchange:                "exchange",
+	OrderDetail:             "order_detail",
+	OrderFill:               "order_fill",
+	OrderbookRecord:         "orderbook_record",
+	PortfolioValuation:      "portfolio_valuation",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
+	ScriptStore:             "script_store",
 	Trade:                   "trade",
 	WithdrawalCrypto:        "withdrawal_crypto",
 	WithdrawalFiat:          "withdrawal_fiat",
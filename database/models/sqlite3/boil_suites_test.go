@@ -16,9 +16,15 @@ func TestParent(t *testing.T) {
 	t.Run("Candles", testCandles)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
+	t.Run("EventRules", testEventRules)
 	t.Run("Exchanges", testExchanges)
+	t.Run("OrderDetails", testOrderDetails)
+	t.Run("OrderFills", testOrderFills)
+	t.Run("OrderbookRecords", testOrderbookRecords)
+	t.Run("PortfolioValuations", testPortfolioValuations)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("ScriptStores", testScriptStores)
 	t.Run("Trades", testTrades)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
 	t.Run("WithdrawalFiats", testWithdrawalFiats)
@@ -30,9 +36,15 @@ func TestDelete(t *testing.T) {
 	t.Run("Candles", testCandlesDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
+	t.Run("EventRules", testEventRulesDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("OrderDetails", testOrderDetailsDelete)
+	t.Run("OrderFills", testOrderFillsDelete)
+	t.Run("OrderbookRecords", testOrderbookRecordsDelete)
+	t.Run("PortfolioValuations", testPortfolioValuationsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("ScriptStores", testScriptStoresDelete)
 	t.Run("Trades", testTradesDelete)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
@@ -44,9 +56,15 @@ func TestQueryDeleteAll(t *testing.T) {
 	t.Run("Candles", testCandlesQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
+	t.Run("EventRules", testEventRulesQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("OrderDetails", testOrderDetailsQueryDeleteAll)
+	t.Run("OrderFills", testOrderFillsQueryDeleteAll)
+	t.Run("OrderbookRecords", testOrderbookRecordsQueryDeleteAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("ScriptStores", testScriptStoresQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
@@ -58,9 +76,15 @@ func TestSliceDeleteAll(t *testing.T) {
 	t.Run("Candles", testCandlesSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
+	t.Run("EventRules", testEventRulesSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("OrderDetails", testOrderDetailsSliceDeleteAll)
+	t.Run("OrderFills", testOrderFillsSliceDeleteAll)
+	t.Run("OrderbookRecords", testOrderbookRecordsSliceDeleteAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("ScriptStores", testScriptStoresSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
@@ -72,9 +96,15 @@ func TestExists(t *testing.T) {
 	t.Run("Candles", testCandlesExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
+	t.Run("EventRules", testEventRulesExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("OrderDetails", testOrderDetailsExists)
+	t.Run("OrderFills", testOrderFillsExists)
+	t.Run("OrderbookRecords", testOrderbookRecordsExists)
+	t.Run("PortfolioValuations", testPortfolioValuationsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("ScriptStores", testScriptStoresExists)
 	t.Run("Trades", testTradesExists)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
@@ -86,9 +116,15 @@ func TestFind(t *testing.T) {
 	t.Run("Candles", testCandlesFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
+	t.Run("EventRules", testEventRulesFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("OrderDetails", testOrderDetailsFind)
+	t.Run("OrderFills", testOrderFillsFind)
+	t.Run("OrderbookRecords", testOrderbookRecordsFind)
+	t.Run("PortfolioValuations", testPortfolioValuationsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("ScriptStores", testScriptStoresFind)
 	t.Run("Trades", testTradesFind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
@@ -100,9 +136,15 @@ func TestBind(t *testing.T) {
 	t.Run("Candles", testCandlesBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
+	t.Run("EventRules", testEventRulesBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("OrderDetails", testOrderDetailsBind)
+	t.Run("OrderFills", testOrderFillsBind)
+	t.Run("OrderbookRecords", testOrderbookRecordsBind)
+	t.Run("PortfolioValuations", testPortfolioValuationsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("ScriptStores", testScriptStoresBind)
 	t.Run("Trades", testTradesBind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
@@ -114,9 +156,15 @@ func TestOne(t *testing.T) {
 	t.Run("Candles", testCandlesOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
+	t.Run("EventRules", testEventRulesOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("OrderDetails", testOrderDetailsOne)
+	t.Run("OrderFills", testOrderFillsOne)
+	t.Run("OrderbookRecords", testOrderbookRecordsOne)
+	t.Run("PortfolioValuations", testPortfolioValuationsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("ScriptStores", testScriptStoresOne)
 	t.Run("Trades", testTradesOne)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
@@ -128,9 +176,15 @@ func TestAll(t *testing.T) {
 	t.Run("Candles", testCandlesAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
+	t.Run("EventRules", testEventRulesAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("OrderDetails", testOrderDetailsAll)
+	t.Run("OrderFills", testOrderFillsAll)
+	t.Run("OrderbookRecords", testOrderbookRecordsAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("ScriptStores", testScriptStoresAll)
 	t.Run("Trades", testTradesAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
@@ -142,9 +196,15 @@ func TestCount(t *testing.T) {
 	t.Run("Candles", testCandlesCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
+	t.Run("EventRules", testEventRulesCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("OrderDetails", testOrderDetailsCount)
+	t.Run("OrderFills", testOrderFillsCount)
+	t.Run("OrderbookRecords", testOrderbookRecordsCount)
+	t.Run("PortfolioValuations", testPortfolioValuationsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("ScriptStores", testScriptStoresCount)
 	t.Run("Trades", testTradesCount)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
@@ -156,9 +216,15 @@ func TestHooks(t *testing.T) {
 	t.Run("Candles", testCandlesHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
+	t.Run("EventRules", testEventRulesHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("OrderDetails", testOrderDetailsHooks)
+	t.Run("OrderFills", testOrderFillsHooks)
+	t.Run("OrderbookRecords", testOrderbookRecordsHooks)
+	t.Run("PortfolioValuations", testPortfolioValuationsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("ScriptStores", testScriptStoresHooks)
 	t.Run("Trades", testTradesHooks)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
@@ -174,12 +240,24 @@ func TestInsert(t *testing.T) {
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
+	t.Run("EventRules", testEventRulesInsert)
+	t.Run("EventRules", testEventRulesInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("OrderDetails", testOrderDetailsInsert)
+	t.Run("OrderDetails", testOrderDetailsInsertWhitelist)
+	t.Run("OrderFills", testOrderFillsInsert)
+	t.Run("OrderFills", testOrderFillsInsertWhitelist)
+	t.Run("OrderbookRecords", testOrderbookRecordsInsert)
+	t.Run("OrderbookRecords", testOrderbookRecordsInsertWhitelist)
+	t.Run("PortfolioValuations", testPortfolioValuationsInsert)
+	t.Run("PortfolioValuations", testPortfolioValuationsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
+	t.Run("ScriptStores", testScriptStoresInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("ScriptStores", testScriptStoresInsertWhitelist)
 	t.Run("Trades", testTradesInsert)
 	t.Run("Trades", testTradesInsertWhitelist)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
@@ -199,6 +277,9 @@ func TestToOne(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("OrderDetailToExchangeUsingExchangeName", testOrderDetailToOneExchangeUsingExchangeName)
+	t.Run("OrderFillToOrderDetailUsingOrderDetail", testOrderFillToOneOrderDetailUsingOrderDetail)
+	t.Run("OrderbookRecordToExchangeUsingExchangeName", testOrderbookRecordToOneExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
@@ -210,7 +291,9 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToOrderDetailUsingExchangeNameOrderDetail", testExchangeOneToOneOrderDetailUsingExchangeNameOrderDetail)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneTradeUsingExchangeNameTrade)
+	t.Run("OrderDetailToOrderFillUsingOrderFill", testOrderDetailOneToOneOrderFillUsingOrderFill)
 }
 
 // TestToMany tests cannot be run in parallel
@@ -223,6 +306,7 @@ func TestToMany(t *testing.T) {
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameOrderbookRecords", testExchangeToManyExchangeNameOrderbookRecords)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
@@ -238,6 +322,9 @@ func TestToOneSet(t *testing.T) {
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("OrderDetailToExchangeUsingExchangeNameOrderDetail", testOrderDetailToOneSetOpExchangeUsingExchangeName)
+	t.Run("OrderFillToOrderDetailUsingOrderFill", testOrderFillToOneSetOpOrderDetailUsingOrderDetail)
+	t.Run("OrderbookRecordToExchangeUsingExchangeNameOrderbookRecords", testOrderbookRecordToOneSetOpExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
@@ -257,7 +344,9 @@ func TestToOneRemove(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneSetOpCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToOrderDetailUsingExchangeNameOrderDetail", testExchangeOneToOneSetOpOrderDetailUsingExchangeNameOrderDetail)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneSetOpTradeUsingExchangeNameTrade)
+	t.Run("OrderDetailToOrderFillUsingOrderFill", testOrderDetailOneToOneSetOpOrderFillUsingOrderFill)
 }
 
 // TestOneToOneRemove tests cannot be run in parallel
@@ -274,6 +363,7 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameOrderbookRecords", testExchangeToManyAddOpExchangeNameOrderbookRecords)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
@@ -305,9 +395,15 @@ func TestReload(t *testing.T) {
 	t.Run("Candles", testCandlesReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
+	t.Run("EventRules", testEventRulesReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("OrderDetails", testOrderDetailsReload)
+	t.Run("OrderFills", testOrderFillsReload)
+	t.Run("OrderbookRecords", testOrderbookRecordsReload)
+	t.Run("PortfolioValuations", testPortfolioValuationsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("ScriptStores", testScriptStoresReload)
 	t.Run("Trades", testTradesReload)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
@@ -319,9 +415,15 @@ func TestReloadAll(t *testing.T) {
 	t.Run("Candles", testCandlesReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
+	t.Run("EventRules", testEventRulesReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("OrderDetails", testOrderDetailsReloadAll)
+	t.Run("OrderFills", testOrderFillsReloadAll)
+	t.Run("OrderbookRecords", testOrderbookRecordsReloadAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("ScriptStores", testScriptStoresReloadAll)
 	t.Run("Trades", testTradesReloadAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
@@ -333,9 +435,15 @@ func TestSelect(t *testing.T) {
 	t.Run("Candles", testCandlesSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
+	t.Run("EventRules", testEventRulesSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("OrderDetails", testOrderDetailsSelect)
+	t.Run("OrderFills", testOrderFillsSelect)
+	t.Run("OrderbookRecords", testOrderbookRecordsSelect)
+	t.Run("PortfolioValuations", testPortfolioValuationsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("ScriptStores", testScriptStoresSelect)
 	t.Run("Trades", testTradesSelect)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
@@ -347,9 +455,15 @@ func TestUpdate(t *testing.T) {
 	t.Run("Candles", testCandlesUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
+	t.Run("EventRules", testEventRulesUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("OrderDetails", testOrderDetailsUpdate)
+	t.Run("OrderFills", testOrderFillsUpdate)
+	t.Run("OrderbookRecords", testOrderbookRecordsUpdate)
+	t.Run("PortfolioValuations", testPortfolioValuationsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("ScriptStores", testScriptStoresUpdate)
 	t.Run("Trades", testTradesUpdate)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
@@ -361,9 +475,15 @@ func TestSliceUpdateAll(t *testing.T) {
 	t.Run("Candles", testCandlesSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
+	t.Run("EventRules", testEventRulesSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("OrderDetails", testOrderDetailsSliceUpdateAll)
+	t.Run("OrderFills", testOrderFillsSliceUpdateAll)
+	t.Run("OrderbookRecords", testOrderbookRecordsSliceUpdateAll)
+	t.Run("PortfolioValuations", testPortfolioValuationsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("ScriptStores", testScriptStoresSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
@@ -0,0 +1,165 @@
+package eventrule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert saves a new event rule to the database and sets the record's ID
+// and creation time
+func (db *DBService) Insert(r *Record) error {
+	if r == nil {
+		return errNilRecord
+	}
+	if r.Definition == "" {
+		return errDefinitionUnset
+	}
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = time.Now()
+	}
+	ctx := boil.SkipTimestamps(context.TODO())
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		tempRecord := sqlite3.EventRule{
+			Definition: r.Definition,
+			CreatedAt:  r.CreatedAt.UTC().Format(time.RFC3339),
+		}
+		err := tempRecord.Insert(ctx, db.sql, boil.Infer())
+		if err != nil {
+			return err
+		}
+		r.ID = tempRecord.ID
+	case database.DBPostgreSQL:
+		tempRecord := postgres.EventRule{
+			Definition: r.Definition,
+			CreatedAt:  r.CreatedAt.UTC(),
+		}
+		err := tempRecord.Insert(ctx, db.sql, boil.Infer())
+		if err != nil {
+			return err
+		}
+		r.ID = tempRecord.ID
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	return nil
+}
+
+// Update replaces the definition of an existing event rule
+func (db *DBService) Update(r *Record) error {
+	if r == nil {
+		return errNilRecord
+	}
+	if r.ID <= 0 {
+		return errInvalidID
+	}
+	if r.Definition == "" {
+		return errDefinitionUnset
+	}
+	ctx := context.TODO()
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		result, err := sqlite3.FindEventRule(ctx, db.sql, r.ID)
+		if err != nil {
+			return fmt.Errorf("could not retrieve event rule %v, %w", r.ID, err)
+		}
+		result.Definition = r.Definition
+		_, err = result.Update(ctx, db.sql, boil.Whitelist(sqlite3.EventRuleColumns.Definition))
+		return err
+	case database.DBPostgreSQL:
+		result, err := postgres.FindEventRule(ctx, db.sql, r.ID)
+		if err != nil {
+			return fmt.Errorf("could not retrieve event rule %v, %w", r.ID, err)
+		}
+		result.Definition = r.Definition
+		_, err = result.Update(ctx, db.sql, boil.Whitelist(postgres.EventRuleColumns.Definition))
+		return err
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+}
+
+// Delete removes an event rule from the database
+func (db *DBService) Delete(id int64) error {
+	if id <= 0 {
+		return errInvalidID
+	}
+	ctx := context.TODO()
+	var err error
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		_, err = sqlite3.EventRules(qm.Where("id = ?", id)).DeleteAll(ctx, db.sql)
+	case database.DBPostgreSQL:
+		_, err = postgres.EventRules(qm.Where("id = ?", id)).DeleteAll(ctx, db.sql)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	return err
+}
+
+// GetAll returns all event rules ordered by ID
+func (db *DBService) GetAll() ([]Record, error) {
+	ctx := context.TODO()
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		results, err := sqlite3.EventRules(qm.OrderBy("id")).All(ctx, db.sql)
+		if err != nil {
+			return nil, err
+		}
+		resp := make([]Record, len(results))
+		for i := range results {
+			createdAt, err := time.Parse(time.RFC3339, results[i].CreatedAt)
+			if err != nil {
+				return nil, err
+			}
+			resp[i] = Record{
+				ID:         results[i].ID,
+				Definition: results[i].Definition,
+				CreatedAt:  createdAt,
+			}
+		}
+		return resp, nil
+	case database.DBPostgreSQL:
+		results, err := postgres.EventRules(qm.OrderBy("id")).All(ctx, db.sql)
+		if err != nil {
+			return nil, err
+		}
+		resp := make([]Record, len(results))
+		for i := range results {
+			resp[i] = Record{
+				ID:         results[i].ID,
+				Definition: results[i].Definition,
+				CreatedAt:  results[i].CreatedAt.UTC(),
+			}
+		}
+		return resp, nil
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
@@ -0,0 +1,112 @@
+package eventrule
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestEventRule(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err, "Setup must not error")
+
+			err = db.Insert(nil)
+			assert.ErrorIs(t, err, errNilRecord)
+			err = db.Insert(&Record{})
+			assert.ErrorIs(t, err, errDefinitionUnset)
+			err = db.Update(&Record{Definition: "{}"})
+			assert.ErrorIs(t, err, errInvalidID)
+			err = db.Delete(0)
+			assert.ErrorIs(t, err, errInvalidID)
+
+			tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			first := &Record{Definition: `{"item":"PRICE"}`, CreatedAt: tt}
+			require.NoError(t, db.Insert(first), "Insert must not error")
+			assert.Positive(t, first.ID, "Insert should set the record ID")
+			second := &Record{Definition: `{"item":"SPREAD"}`}
+			require.NoError(t, db.Insert(second), "Insert must not error")
+			assert.Greater(t, second.ID, first.ID, "Insert should increment the record ID")
+
+			second.Definition = `{"item":"RSI"}`
+			require.NoError(t, db.Update(second), "Update must not error")
+			err = db.Update(&Record{ID: second.ID + 1, Definition: "{}"})
+			assert.Error(t, err, "Update should error for an unknown ID")
+
+			resp, err := db.GetAll()
+			require.NoError(t, err, "GetAll must not error")
+			require.Len(t, resp, 2, "GetAll must return the correct amount of records")
+			assert.Equal(t, first.ID, resp[0].ID, "GetAll should order records by ID")
+			assert.Equal(t, tt, resp[0].CreatedAt, "GetAll should return the creation time")
+			assert.Equal(t, `{"item":"RSI"}`, resp[1].Definition, "GetAll should return the updated definition")
+
+			require.NoError(t, db.Delete(first.ID), "Delete must not error")
+			resp, err = db.GetAll()
+			require.NoError(t, err, "GetAll must not error")
+			require.Len(t, resp, 1, "GetAll must return the correct amount of records")
+			assert.Equal(t, second.ID, resp[0].ID, "Delete should remove the correct record")
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err, "CloseDatabase should not error")
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package eventrule
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNilRecord       = errors.New("event rule record is nil")
+	errDefinitionUnset = errors.New("event rule definition must be set")
+	errInvalidID       = errors.New("event rule ID must be greater than zero")
+)
+
+// Record is a DTO for a persisted event rule. The definition holds the
+// serialised rule, conditions and actions of the event so the repository
+// does not need to know about their structure
+type Record struct {
+	ID         int64
+	Definition string
+	CreatedAt  time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the event rule database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(*Record) error
+	Update(*Record) error
+	Delete(id int64) error
+	GetAll() ([]Record, error)
+}
@@ -0,0 +1,491 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates orders and their fills into the database. Orders
+// are uniquely identified by their exchange and order ID. Fills without a
+// trade ID cannot be uniquely identified and are not stored
+func (db *DBService) Upsert(orders ...*Detail) error {
+	for i := range orders {
+		if orders[i] == nil {
+			return errNilOrder
+		}
+		if orders[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+		if orders[i].OrderID == "" {
+			return fmt.Errorf("%s %w", orders[i].Exchange, errOrderIDUnset)
+		}
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSQLite(ctx, tx, orders...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, orders...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByExchangeAndID returns an order and its fills by exchange name and
+// exchange order ID
+func (db *DBService) GetByExchangeAndID(exchangeName, orderID string) (*Detail, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByExchangeAndIDSQLite(exchangeName, orderID)
+	case database.DBPostgreSQL:
+		return db.getByExchangeAndIDPostgres(exchangeName, orderID)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetByStatus returns all orders and their fills which match any of the
+// supplied statuses
+func (db *DBService) GetByStatus(statuses ...string) ([]Detail, error) {
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+	s := make([]any, len(statuses))
+	for i := range statuses {
+		s[i] = statuses[i]
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getByStatusSQLite(s)
+	case database.DBPostgreSQL:
+		return db.getByStatusPostgres(s)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func upsertSQLite(ctx context.Context, tx *sql.Tx, orders ...*Detail) error {
+	for i := range orders {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+		}
+
+		tempOrder := sqlite3.OrderDetail{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exch.ID,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			ClientID:             null.NewString(orders[i].ClientID, orders[i].ClientID != ""),
+			AccountID:            null.NewString(orders[i].AccountID, orders[i].AccountID != ""),
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Asset:                strings.ToLower(orders[i].Asset),
+			Side:                 orders[i].Side,
+			Type:                 orders[i].Type,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			TriggerPrice:         orders[i].TriggerPrice,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             null.NewString(orders[i].FeeAsset, orders[i].FeeAsset != ""),
+			Leverage:             orders[i].Leverage,
+			ReduceOnly:           orders[i].ReduceOnly,
+			PostOnly:             orders[i].PostOnly,
+			Date:                 orders[i].Date.UTC().Format(time.RFC3339),
+			LastUpdated:          orders[i].LastUpdated.UTC().Format(time.RFC3339),
+			CloseTime:            null.NewString(orders[i].CloseTime.UTC().Format(time.RFC3339), !orders[i].CloseTime.IsZero()),
+		}
+
+		existing, err := sqlite3.OrderDetails(
+			qm.Where("exchange_name_id = ? AND order_id = ?", exch.ID, orders[i].OrderID)).One(ctx, tx)
+		switch {
+		case err == nil:
+			tempOrder.ID = existing.ID
+			_, err = tempOrder.Update(ctx, tx, boil.Infer())
+		case errors.Is(err, sql.ErrNoRows):
+			if tempOrder.ID == "" {
+				tempOrder.ID, err = newID()
+				if err != nil {
+					return err
+				}
+			}
+			err = tempOrder.Insert(ctx, tx, boil.Infer())
+		}
+		if err != nil {
+			return err
+		}
+		orders[i].ID = tempOrder.ID
+		orders[i].ExchangeNameID = exch.ID
+
+		for j := range orders[i].Fills {
+			if orders[i].Fills[j].TradeID == "" {
+				continue
+			}
+			fillID, err := newID()
+			if err != nil {
+				return err
+			}
+			tempFill := sqlite3.OrderFill{
+				ID:            fillID,
+				OrderDetailID: tempOrder.ID,
+				TradeID:       orders[i].Fills[j].TradeID,
+				Price:         orders[i].Fills[j].Price,
+				Amount:        orders[i].Fills[j].Amount,
+				Fee:           orders[i].Fills[j].Fee,
+				FeeAsset:      null.NewString(orders[i].Fills[j].FeeAsset, orders[i].Fills[j].FeeAsset != ""),
+				Side:          orders[i].Fills[j].Side,
+				IsMaker:       orders[i].Fills[j].IsMaker,
+				Timestamp:     orders[i].Fills[j].Timestamp.UTC().Format(time.RFC3339),
+			}
+			err = tempFill.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, orders ...*Detail) error {
+	for i := range orders {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(orders[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", orders[i].Exchange, err)
+		}
+
+		tempOrder := postgres.OrderDetail{
+			ID:                   orders[i].ID,
+			ExchangeNameID:       exch.ID,
+			OrderID:              orders[i].OrderID,
+			ClientOrderID:        null.NewString(orders[i].ClientOrderID, orders[i].ClientOrderID != ""),
+			ClientID:             null.NewString(orders[i].ClientID, orders[i].ClientID != ""),
+			AccountID:            null.NewString(orders[i].AccountID, orders[i].AccountID != ""),
+			Base:                 strings.ToUpper(orders[i].Base),
+			Quote:                strings.ToUpper(orders[i].Quote),
+			Asset:                strings.ToLower(orders[i].Asset),
+			Side:                 orders[i].Side,
+			Type:                 orders[i].Type,
+			Status:               orders[i].Status,
+			Price:                orders[i].Price,
+			Amount:               orders[i].Amount,
+			TriggerPrice:         orders[i].TriggerPrice,
+			AverageExecutedPrice: orders[i].AverageExecutedPrice,
+			ExecutedAmount:       orders[i].ExecutedAmount,
+			RemainingAmount:      orders[i].RemainingAmount,
+			Cost:                 orders[i].Cost,
+			Fee:                  orders[i].Fee,
+			FeeAsset:             null.NewString(orders[i].FeeAsset, orders[i].FeeAsset != ""),
+			Leverage:             orders[i].Leverage,
+			ReduceOnly:           orders[i].ReduceOnly,
+			PostOnly:             orders[i].PostOnly,
+			Date:                 orders[i].Date.UTC(),
+			LastUpdated:          orders[i].LastUpdated.UTC(),
+			CloseTime:            null.NewTime(orders[i].CloseTime.UTC(), !orders[i].CloseTime.IsZero()),
+		}
+
+		existing, err := postgres.OrderDetails(
+			qm.Where("exchange_name_id = ? AND order_id = ?", exch.ID, orders[i].OrderID)).One(ctx, tx)
+		switch {
+		case err == nil:
+			tempOrder.ID = existing.ID
+		case errors.Is(err, sql.ErrNoRows):
+			if tempOrder.ID == "" {
+				tempOrder.ID, err = newID()
+				if err != nil {
+					return err
+				}
+			}
+		default:
+			return err
+		}
+		err = tempOrder.Upsert(ctx, tx, true, []string{"exchange_name_id", "order_id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+		orders[i].ID = tempOrder.ID
+		orders[i].ExchangeNameID = exch.ID
+
+		for j := range orders[i].Fills {
+			if orders[i].Fills[j].TradeID == "" {
+				continue
+			}
+			fillID, err := newID()
+			if err != nil {
+				return err
+			}
+			tempFill := postgres.OrderFill{
+				ID:            fillID,
+				OrderDetailID: tempOrder.ID,
+				TradeID:       orders[i].Fills[j].TradeID,
+				Price:         orders[i].Fills[j].Price,
+				Amount:        orders[i].Fills[j].Amount,
+				Fee:           orders[i].Fills[j].Fee,
+				FeeAsset:      null.NewString(orders[i].Fills[j].FeeAsset, orders[i].Fills[j].FeeAsset != ""),
+				Side:          orders[i].Fills[j].Side,
+				IsMaker:       orders[i].Fills[j].IsMaker,
+				Timestamp:     orders[i].Fills[j].Timestamp.UTC(),
+			}
+			err = tempFill.Upsert(ctx, tx, true, []string{"order_detail_id", "trade_id"}, boil.Infer(), boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getByExchangeAndIDSQLite(exchangeName, orderID string) (*Detail, error) {
+	exch, err := sqlite3.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	result, err := sqlite3.OrderDetails(qm.Where("exchange_name_id = ? AND order_id = ?", exch.ID, orderID)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return db.createSQLiteOrderResponse(result, exch.Name)
+}
+
+func (db *DBService) getByExchangeAndIDPostgres(exchangeName, orderID string) (*Detail, error) {
+	exch, err := postgres.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	result, err := postgres.OrderDetails(qm.Where("exchange_name_id = ? AND order_id = ?", exch.ID, orderID)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return db.createPostgresOrderResponse(result, exch.Name)
+}
+
+func (db *DBService) getByStatusSQLite(statuses []any) ([]Detail, error) {
+	results, err := sqlite3.OrderDetails(qm.WhereIn("status in ?", statuses...), qm.OrderBy("date")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Detail, len(results))
+	for i := range results {
+		exch, err := results[i].ExchangeName().One(context.TODO(), db.sql)
+		if err != nil {
+			return nil, err
+		}
+		o, err := db.createSQLiteOrderResponse(results[i], exch.Name)
+		if err != nil {
+			return nil, fmt.Errorf("could not return order %v: %w", results[i].OrderID, err)
+		}
+		resp[i] = *o
+	}
+	return resp, nil
+}
+
+func (db *DBService) getByStatusPostgres(statuses []any) ([]Detail, error) {
+	results, err := postgres.OrderDetails(qm.WhereIn("status in ?", statuses...), qm.OrderBy("date")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Detail, len(results))
+	for i := range results {
+		exch, err := results[i].ExchangeName().One(context.TODO(), db.sql)
+		if err != nil {
+			return nil, err
+		}
+		o, err := db.createPostgresOrderResponse(results[i], exch.Name)
+		if err != nil {
+			return nil, fmt.Errorf("could not return order %v: %w", results[i].OrderID, err)
+		}
+		resp[i] = *o
+	}
+	return resp, nil
+}
+
+func (db *DBService) createSQLiteOrderResponse(result *sqlite3.OrderDetail, exchangeName string) (*Detail, error) {
+	date, err := time.Parse(time.RFC3339, result.Date)
+	if err != nil {
+		return nil, err
+	}
+	lastUpdated, err := time.Parse(time.RFC3339, result.LastUpdated)
+	if err != nil {
+		return nil, err
+	}
+	var closeTime time.Time
+	if result.CloseTime.Valid {
+		closeTime, err = time.Parse(time.RFC3339, result.CloseTime.String)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fills, err := sqlite3.OrderFills(qm.Where("order_detail_id = ?", result.ID), qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Detail{
+		ID:                   result.ID,
+		ExchangeNameID:       result.ExchangeNameID,
+		Exchange:             exchangeName,
+		OrderID:              result.OrderID,
+		ClientOrderID:        result.ClientOrderID.String,
+		ClientID:             result.ClientID.String,
+		AccountID:            result.AccountID.String,
+		Base:                 result.Base,
+		Quote:                result.Quote,
+		Asset:                result.Asset,
+		Side:                 result.Side,
+		Type:                 result.Type,
+		Status:               result.Status,
+		Price:                result.Price,
+		Amount:               result.Amount,
+		TriggerPrice:         result.TriggerPrice,
+		AverageExecutedPrice: result.AverageExecutedPrice,
+		ExecutedAmount:       result.ExecutedAmount,
+		RemainingAmount:      result.RemainingAmount,
+		Cost:                 result.Cost,
+		Fee:                  result.Fee,
+		FeeAsset:             result.FeeAsset.String,
+		Leverage:             result.Leverage,
+		ReduceOnly:           result.ReduceOnly,
+		PostOnly:             result.PostOnly,
+		Date:                 date,
+		LastUpdated:          lastUpdated,
+		CloseTime:            closeTime,
+		Fills:                make([]Fill, len(fills)),
+	}
+	for i := range fills {
+		ts, err := time.Parse(time.RFC3339, fills[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		resp.Fills[i] = Fill{
+			ID:        fills[i].ID,
+			TradeID:   fills[i].TradeID,
+			Price:     fills[i].Price,
+			Amount:    fills[i].Amount,
+			Fee:       fills[i].Fee,
+			FeeAsset:  fills[i].FeeAsset.String,
+			Side:      fills[i].Side,
+			IsMaker:   fills[i].IsMaker,
+			Timestamp: ts,
+		}
+	}
+	return resp, nil
+}
+
+func (db *DBService) createPostgresOrderResponse(result *postgres.OrderDetail, exchangeName string) (*Detail, error) {
+	fills, err := postgres.OrderFills(qm.Where("order_detail_id = ?", result.ID), qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := &Detail{
+		ID:                   result.ID,
+		ExchangeNameID:       result.ExchangeNameID,
+		Exchange:             exchangeName,
+		OrderID:              result.OrderID,
+		ClientOrderID:        result.ClientOrderID.String,
+		ClientID:             result.ClientID.String,
+		AccountID:            result.AccountID.String,
+		Base:                 result.Base,
+		Quote:                result.Quote,
+		Asset:                result.Asset,
+		Side:                 result.Side,
+		Type:                 result.Type,
+		Status:               result.Status,
+		Price:                result.Price,
+		Amount:               result.Amount,
+		TriggerPrice:         result.TriggerPrice,
+		AverageExecutedPrice: result.AverageExecutedPrice,
+		ExecutedAmount:       result.ExecutedAmount,
+		RemainingAmount:      result.RemainingAmount,
+		Cost:                 result.Cost,
+		Fee:                  result.Fee,
+		FeeAsset:             result.FeeAsset.String,
+		Leverage:             result.Leverage,
+		ReduceOnly:           result.ReduceOnly,
+		PostOnly:             result.PostOnly,
+		Date:                 result.Date,
+		LastUpdated:          result.LastUpdated,
+		CloseTime:            result.CloseTime.Time,
+		Fills:                make([]Fill, len(fills)),
+	}
+	for i := range fills {
+		resp.Fills[i] = Fill{
+			ID:        fills[i].ID,
+			TradeID:   fills[i].TradeID,
+			Price:     fills[i].Price,
+			Amount:    fills[i].Amount,
+			Fee:       fills[i].Fee,
+			FeeAsset:  fills[i].FeeAsset.String,
+			Side:      fills[i].Side,
+			IsMaker:   fills[i].IsMaker,
+			Timestamp: fills[i].Timestamp,
+		}
+	}
+	return resp, nil
+}
+
+func newID() (string, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
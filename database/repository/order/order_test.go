@@ -0,0 +1,200 @@
+package order
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestOrder(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			if test.seedDB != nil {
+				require.NoError(t, test.seedDB(), "seedDB must not error")
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err, "Setup must not error")
+
+			err = db.Upsert(nil)
+			assert.ErrorIs(t, err, errNilOrder)
+			err = db.Upsert(&Detail{OrderID: "1"})
+			assert.ErrorIs(t, err, errExchangeNameUnset)
+			err = db.Upsert(&Detail{Exchange: testExchanges[0].Name})
+			assert.ErrorIs(t, err, errOrderIDUnset)
+
+			tt := time.Now().Truncate(time.Second).UTC()
+			orders := make([]*Detail, 10)
+			for i := range orders {
+				orders[i] = &Detail{
+					Exchange:    testExchanges[i%2].Name,
+					OrderID:     fmt.Sprintf("TestOrder%v", i),
+					Base:        "btc",
+					Quote:       "usd",
+					Asset:       "SPOT",
+					Side:        "BUY",
+					Type:        "LIMIT",
+					Status:      "ACTIVE",
+					Price:       1337,
+					Amount:      float64(i + 1),
+					Date:        tt.Add(time.Duration(i) * time.Second),
+					LastUpdated: tt,
+				}
+			}
+			err = db.Upsert(orders...)
+			require.NoError(t, err, "Upsert must not error")
+			for i := range orders {
+				assert.NotEmpty(t, orders[i].ID, "Upsert should set the order ID")
+			}
+
+			resp, err := db.GetByExchangeAndID(testExchanges[0].Name, "TestOrder0")
+			require.NoError(t, err, "GetByExchangeAndID must not error")
+			assert.Equal(t, orders[0].ID, resp.ID)
+			assert.Equal(t, testExchanges[0].Name, resp.Exchange)
+			assert.Equal(t, "BTC", resp.Base)
+			assert.Equal(t, "spot", resp.Asset)
+			assert.True(t, resp.Date.Equal(tt), "Date should be equal")
+			assert.True(t, resp.CloseTime.IsZero(), "CloseTime should be zero")
+			assert.Empty(t, resp.Fills)
+
+			_, err = db.GetByExchangeAndID(testExchanges[1].Name, "TestOrder0")
+			assert.ErrorIs(t, err, sql.ErrNoRows)
+
+			// update the same order to test conflict resolution and fill
+			// insertion
+			update := &Detail{
+				Exchange:       testExchanges[0].Name,
+				OrderID:        "TestOrder0",
+				Base:           "btc",
+				Quote:          "usd",
+				Asset:          "spot",
+				Side:           "BUY",
+				Type:           "LIMIT",
+				Status:         "FILLED",
+				Price:          1337,
+				Amount:         1,
+				ExecutedAmount: 1,
+				FeeAsset:       "usd",
+				PostOnly:       true,
+				Date:           tt,
+				LastUpdated:    tt.Add(time.Minute),
+				CloseTime:      tt.Add(time.Minute),
+				Fills: []Fill{
+					{TradeID: "1", Price: 1337, Amount: 0.5, Side: "BUY", Timestamp: tt},
+					{TradeID: "2", Price: 1337, Amount: 0.5, Side: "BUY", IsMaker: true, Timestamp: tt.Add(time.Second)},
+					{Price: 1337, Amount: 0.5, Side: "BUY", Timestamp: tt},
+				},
+			}
+			err = db.Upsert(update)
+			require.NoError(t, err, "Upsert must not error")
+			assert.Equal(t, orders[0].ID, update.ID, "Upsert should retain the original order ID")
+
+			// resubmitting fills must not duplicate them
+			err = db.Upsert(update)
+			require.NoError(t, err, "Upsert must not error")
+
+			resp, err = db.GetByExchangeAndID(testExchanges[0].Name, "TestOrder0")
+			require.NoError(t, err, "GetByExchangeAndID must not error")
+			assert.Equal(t, "FILLED", resp.Status)
+			assert.True(t, resp.PostOnly, "PostOnly should be true")
+			assert.Equal(t, "usd", resp.FeeAsset)
+			assert.True(t, resp.CloseTime.Equal(tt.Add(time.Minute)), "CloseTime should be equal")
+			require.Len(t, resp.Fills, 2, "fills without a trade ID must not be stored")
+			assert.Equal(t, "1", resp.Fills[0].TradeID)
+			assert.False(t, resp.Fills[0].IsMaker, "IsMaker should be false")
+			assert.True(t, resp.Fills[1].IsMaker, "IsMaker should be true")
+
+			results, err := db.GetByStatus()
+			assert.NoError(t, err, "GetByStatus should not error")
+			assert.Empty(t, results)
+
+			results, err = db.GetByStatus("ACTIVE")
+			require.NoError(t, err, "GetByStatus must not error")
+			assert.Len(t, results, 9)
+
+			results, err = db.GetByStatus("ACTIVE", "FILLED")
+			require.NoError(t, err, "GetByStatus must not error")
+			assert.Len(t, results, 10)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err, "CloseDatabase should not error")
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNilOrder          = errors.New("order is nil")
+	errOrderIDUnset      = errors.New("order ID must be set")
+	errExchangeNameUnset = errors.New("exchange name must be set")
+)
+
+// Detail is a DTO for order database data
+type Detail struct {
+	ID                   string
+	ExchangeNameID       string
+	Exchange             string
+	OrderID              string
+	ClientOrderID        string
+	ClientID             string
+	AccountID            string
+	Base                 string
+	Quote                string
+	Asset                string
+	Side                 string
+	Type                 string
+	Status               string
+	Price                float64
+	Amount               float64
+	TriggerPrice         float64
+	AverageExecutedPrice float64
+	ExecutedAmount       float64
+	RemainingAmount      float64
+	Cost                 float64
+	Fee                  float64
+	FeeAsset             string
+	Leverage             float64
+	ReduceOnly           bool
+	PostOnly             bool
+	Date                 time.Time
+	LastUpdated          time.Time
+	CloseTime            time.Time
+	Fills                []Fill
+}
+
+// Fill is a DTO for a trade which has partially or completely filled an
+// order
+type Fill struct {
+	ID        string
+	TradeID   string
+	Price     float64
+	Amount    float64
+	Fee       float64
+	FeeAsset  string
+	Side      string
+	IsMaker   bool
+	Timestamp time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the order database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(...*Detail) error
+	GetByExchangeAndID(string, string) (*Detail, error)
+	GetByStatus(...string) ([]Detail, error)
+}
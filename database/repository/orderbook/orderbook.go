@@ -0,0 +1,393 @@
+package orderbook
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeLayout is fixed width so that timestamps stored as text keep
+// their ordering while retaining sub second precision. Stored values are
+// returned by the driver in RFC3339Nano format
+const sqliteTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert saves orderbook snapshots and updates to the database. Price levels
+// are stored gzip compressed
+func (db *DBService) Insert(records ...*Record) error {
+	for i := range records {
+		if records[i] == nil {
+			return errNilRecord
+		}
+		if records[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+		if records[i].Timestamp.IsZero() {
+			return fmt.Errorf("%s %w", records[i].Exchange, errTimestampUnset)
+		}
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, records...)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, records...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns all orderbook snapshots and updates for an exchange,
+// asset and pair between the start and end dates ordered by time
+func (db *DBService) GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Record, error) {
+	if !endDate.After(startDate) {
+		return nil, errInvalidRange
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+	case database.DBPostgreSQL:
+		return db.getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// GetLatestSnapshot returns the most recent snapshot recorded at or before
+// the supplied time, allowing a book to be rebuilt from that point
+func (db *DBService) GetLatestSnapshot(exchangeName, assetType, base, quote string, before time.Time) (*Record, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getLatestSnapshotSQLite(exchangeName, assetType, base, quote, before)
+	case database.DBPostgreSQL:
+		return db.getLatestSnapshotPostgres(exchangeName, assetType, base, quote, before)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, records ...*Record) error {
+	for i := range records {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(records[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", records[i].Exchange, err)
+		}
+		if records[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			records[i].ID = freshUUID.String()
+		}
+		bids, err := encodeLevels(records[i].Bids)
+		if err != nil {
+			return err
+		}
+		asks, err := encodeLevels(records[i].Asks)
+		if err != nil {
+			return err
+		}
+		tempRecord := sqlite3.OrderbookRecord{
+			ID:             records[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           strings.ToUpper(records[i].Base),
+			Quote:          strings.ToUpper(records[i].Quote),
+			Asset:          strings.ToLower(records[i].Asset),
+			IsSnapshot:     records[i].IsSnapshot,
+			Bids:           bids,
+			Asks:           asks,
+			Timestamp:      records[i].Timestamp.UTC().Format(sqliteTimeLayout),
+		}
+		err = tempRecord.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, records ...*Record) error {
+	for i := range records {
+		exch, err := postgres.Exchanges(
+			qm.Where("name = ?", strings.ToLower(records[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", records[i].Exchange, err)
+		}
+		if records[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			records[i].ID = freshUUID.String()
+		}
+		bids, err := encodeLevels(records[i].Bids)
+		if err != nil {
+			return err
+		}
+		asks, err := encodeLevels(records[i].Asks)
+		if err != nil {
+			return err
+		}
+		tempRecord := postgres.OrderbookRecord{
+			ID:             records[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           strings.ToUpper(records[i].Base),
+			Quote:          strings.ToUpper(records[i].Quote),
+			Asset:          strings.ToLower(records[i].Asset),
+			IsSnapshot:     records[i].IsSnapshot,
+			Bids:           bids,
+			Asks:           asks,
+			Timestamp:      records[i].Timestamp.UTC(),
+		}
+		err = tempRecord.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Record, error) {
+	exch, err := sqlite3.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	results, err := sqlite3.OrderbookRecords(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ?",
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote)),
+		qm.Where("timestamp BETWEEN ? AND ?",
+			startDate.UTC().Format(sqliteTimeLayout),
+			endDate.UTC().Format(sqliteTimeLayout)),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Record, len(results))
+	for i := range results {
+		r, err := createSQLiteRecordResponse(results[i], exch.Name)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = *r
+	}
+	return resp, nil
+}
+
+func (db *DBService) getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Record, error) {
+	exch, err := postgres.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	results, err := postgres.OrderbookRecords(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ?",
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote)),
+		qm.Where("timestamp BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]Record, len(results))
+	for i := range results {
+		r, err := createPostgresRecordResponse(results[i], exch.Name)
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = *r
+	}
+	return resp, nil
+}
+
+func (db *DBService) getLatestSnapshotSQLite(exchangeName, assetType, base, quote string, before time.Time) (*Record, error) {
+	exch, err := sqlite3.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	result, err := sqlite3.OrderbookRecords(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND is_snapshot = ?",
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			true),
+		qm.Where("timestamp <= ?", before.UTC().Format(sqliteTimeLayout)),
+		qm.OrderBy("timestamp DESC")).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createSQLiteRecordResponse(result, exch.Name)
+}
+
+func (db *DBService) getLatestSnapshotPostgres(exchangeName, assetType, base, quote string, before time.Time) (*Record, error) {
+	exch, err := postgres.Exchanges(qm.Where("name = ?", strings.ToLower(exchangeName))).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	result, err := postgres.OrderbookRecords(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND is_snapshot = ?",
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			true),
+		qm.Where("timestamp <= ?", before.UTC()),
+		qm.OrderBy("timestamp DESC")).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	return createPostgresRecordResponse(result, exch.Name)
+}
+
+func createSQLiteRecordResponse(result *sqlite3.OrderbookRecord, exchangeName string) (*Record, error) {
+	ts, err := time.Parse(time.RFC3339Nano, result.Timestamp)
+	if err != nil {
+		return nil, err
+	}
+	bids, err := decodeLevels(result.Bids)
+	if err != nil {
+		return nil, err
+	}
+	asks, err := decodeLevels(result.Asks)
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		ID:         result.ID,
+		Exchange:   exchangeName,
+		Base:       result.Base,
+		Quote:      result.Quote,
+		Asset:      result.Asset,
+		IsSnapshot: result.IsSnapshot,
+		Bids:       bids,
+		Asks:       asks,
+		Timestamp:  ts,
+	}, nil
+}
+
+func createPostgresRecordResponse(result *postgres.OrderbookRecord, exchangeName string) (*Record, error) {
+	bids, err := decodeLevels(result.Bids)
+	if err != nil {
+		return nil, err
+	}
+	asks, err := decodeLevels(result.Asks)
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		ID:         result.ID,
+		Exchange:   exchangeName,
+		Base:       result.Base,
+		Quote:      result.Quote,
+		Asset:      result.Asset,
+		IsSnapshot: result.IsSnapshot,
+		Bids:       bids,
+		Asks:       asks,
+		Timestamp:  result.Timestamp.UTC(),
+	}, nil
+}
+
+// encodeLevels stores price levels as gzip compressed [price, amount] pairs
+func encodeLevels(levels []Level) ([]byte, error) {
+	pairs := make([][2]float64, len(levels))
+	for i := range levels {
+		pairs[i] = [2]float64{levels[i].Price, levels[i].Amount}
+	}
+	raw, err := json.Marshal(pairs)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err = w.Write(raw)
+	if err != nil {
+		return nil, err
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeLevels(data []byte) ([]Level, error) {
+	r, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	raw, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	err = r.Close()
+	if err != nil {
+		return nil, err
+	}
+	var pairs [][2]float64
+	err = json.Unmarshal(raw, &pairs)
+	if err != nil {
+		return nil, err
+	}
+	levels := make([]Level, len(pairs))
+	for i := range pairs {
+		levels[i] = Level{Price: pairs[i][0], Amount: pairs[i][1]}
+	}
+	return levels, nil
+}
@@ -0,0 +1,164 @@
+package orderbook
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestOrderbook(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			if test.seedDB != nil {
+				require.NoError(t, test.seedDB(), "seedDB must not error")
+			}
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err, "Setup must not error")
+
+			err = db.Insert(nil)
+			assert.ErrorIs(t, err, errNilRecord)
+			err = db.Insert(&Record{Timestamp: time.Now()})
+			assert.ErrorIs(t, err, errExchangeNameUnset)
+			err = db.Insert(&Record{Exchange: testExchanges[0].Name})
+			assert.ErrorIs(t, err, errTimestampUnset)
+
+			tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			records := []*Record{
+				{
+					Exchange:   testExchanges[0].Name,
+					Base:       "btc",
+					Quote:      "usd",
+					Asset:      "SPOT",
+					IsSnapshot: true,
+					Bids:       []Level{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+					Asks:       []Level{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+					Timestamp:  tt,
+				},
+				{
+					Exchange:  testExchanges[0].Name,
+					Base:      "btc",
+					Quote:     "usd",
+					Asset:     "SPOT",
+					Asks:      []Level{{Price: 101, Amount: 0}},
+					Timestamp: tt.Add(time.Millisecond * 250),
+				},
+				{
+					Exchange:   testExchanges[0].Name,
+					Base:       "btc",
+					Quote:      "usd",
+					Asset:      "SPOT",
+					IsSnapshot: true,
+					Bids:       []Level{{Price: 100, Amount: 3}},
+					Asks:       []Level{{Price: 103, Amount: 4}},
+					Timestamp:  tt.Add(time.Second),
+				},
+			}
+			err = db.Insert(records...)
+			require.NoError(t, err, "Insert must not error")
+
+			_, err = db.GetInRange(testExchanges[0].Name, "spot", "btc", "usd", tt, tt)
+			assert.ErrorIs(t, err, errInvalidRange)
+
+			resp, err := db.GetInRange(testExchanges[0].Name, "spot", "btc", "usd", tt, tt.Add(time.Millisecond*500))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, resp, 2, "GetInRange must return the correct amount of records")
+			assert.True(t, resp[0].IsSnapshot, "first record should be a snapshot")
+			assert.Equal(t, records[0].Bids, resp[0].Bids, "bids should be decoded correctly")
+			assert.Equal(t, records[0].Asks, resp[0].Asks, "asks should be decoded correctly")
+			assert.Equal(t, tt.Add(time.Millisecond*250), resp[1].Timestamp, "timestamp should retain sub second precision")
+			assert.Empty(t, resp[1].Bids, "bids should be empty")
+
+			resp, err = db.GetInRange(testExchanges[1].Name, "spot", "btc", "usd", tt, tt.Add(time.Hour))
+			require.NoError(t, err, "GetInRange must not error")
+			assert.Empty(t, resp, "GetInRange should return no records for another exchange")
+
+			snap, err := db.GetLatestSnapshot(testExchanges[0].Name, "spot", "btc", "usd", tt.Add(time.Millisecond*500))
+			require.NoError(t, err, "GetLatestSnapshot must not error")
+			assert.Equal(t, tt, snap.Timestamp, "GetLatestSnapshot should return the first snapshot")
+
+			snap, err = db.GetLatestSnapshot(testExchanges[0].Name, "spot", "btc", "usd", tt.Add(time.Hour))
+			require.NoError(t, err, "GetLatestSnapshot must not error")
+			assert.Equal(t, records[2].Bids, snap.Bids, "GetLatestSnapshot should return the latest snapshot")
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err, "CloseDatabase should not error")
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package orderbook
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNilRecord         = errors.New("orderbook record is nil")
+	errExchangeNameUnset = errors.New("exchange name must be set")
+	errTimestampUnset    = errors.New("timestamp must be set")
+	errInvalidRange      = errors.New("end date must be after start date")
+)
+
+// Record is a DTO for a recorded orderbook snapshot or incremental update.
+// Snapshots replace the entire book, updates amend individual price levels
+// where an amount of zero removes the level
+type Record struct {
+	ID         string
+	Exchange   string
+	Base       string
+	Quote      string
+	Asset      string
+	IsSnapshot bool
+	Bids       []Level
+	Asks       []Level
+	Timestamp  time.Time
+}
+
+// Level is a single price level on one side of the orderbook
+type Level struct {
+	Price  float64
+	Amount float64
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the orderbook database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(...*Record) error
+	GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Record, error)
+	GetLatestSnapshot(exchangeName, assetType, base, quote string, before time.Time) (*Record, error)
+}
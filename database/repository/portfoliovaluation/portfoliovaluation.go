@@ -0,0 +1,194 @@
+package portfoliovaluation
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// sqliteTimeLayout is fixed width so that timestamps stored as text keep
+// their ordering. Stored values are returned by the driver in RFC3339Nano
+// format
+const sqliteTimeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert saves a valuation snapshot to the database, storing a row for each
+// of its holdings
+func (db *DBService) Insert(s *Snapshot) error {
+	if s == nil {
+		return errNilSnapshot
+	}
+	if s.Timestamp.IsZero() {
+		return errTimestampUnset
+	}
+	if s.FiatCurrency == "" {
+		return errFiatCurrencyUnset
+	}
+	if len(s.Holdings) == 0 {
+		return errNoHoldings
+	}
+	ctx := context.TODO()
+	ctx = boil.SkipTimestamps(ctx)
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, s)
+	case database.DBPostgreSQL:
+		err = insertPostgres(ctx, tx, s)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns all valuation snapshots taken between the start and end
+// dates ordered by time
+func (db *DBService) GetInRange(startDate, endDate time.Time) ([]Snapshot, error) {
+	if !endDate.After(startDate) {
+		return nil, errInvalidRange
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(startDate, endDate)
+	case database.DBPostgreSQL:
+		return db.getInRangePostgres(startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, s *Snapshot) error {
+	for i := range s.Holdings {
+		tempValuation := sqlite3.PortfolioValuation{
+			SnapshotTime: s.Timestamp.UTC().Format(sqliteTimeLayout),
+			FiatCurrency: strings.ToUpper(s.FiatCurrency),
+			Exchange:     strings.ToLower(s.Holdings[i].Exchange),
+			Currency:     strings.ToUpper(s.Holdings[i].Currency),
+			Balance:      s.Holdings[i].Balance,
+			Price:        s.Holdings[i].Price,
+			Value:        s.Holdings[i].Value,
+		}
+		if err := tempValuation.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insertPostgres(ctx context.Context, tx *sql.Tx, s *Snapshot) error {
+	for i := range s.Holdings {
+		tempValuation := postgres.PortfolioValuation{
+			SnapshotTime: s.Timestamp.UTC(),
+			FiatCurrency: strings.ToUpper(s.FiatCurrency),
+			Exchange:     strings.ToLower(s.Holdings[i].Exchange),
+			Currency:     strings.ToUpper(s.Holdings[i].Currency),
+			Balance:      s.Holdings[i].Balance,
+			Price:        s.Holdings[i].Price,
+			Value:        s.Holdings[i].Value,
+		}
+		if err := tempValuation.Insert(ctx, tx, boil.Infer()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(startDate, endDate time.Time) ([]Snapshot, error) {
+	results, err := sqlite3.PortfolioValuations(
+		qm.Where("snapshot_time BETWEEN ? AND ?",
+			startDate.UTC().Format(sqliteTimeLayout),
+			endDate.UTC().Format(sqliteTimeLayout)),
+		qm.OrderBy("snapshot_time, id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	var resp []Snapshot
+	for i := range results {
+		ts, err := time.Parse(time.RFC3339Nano, results[i].SnapshotTime)
+		if err != nil {
+			return nil, err
+		}
+		resp = appendHolding(resp, ts, results[i].FiatCurrency, Holding{
+			Exchange: results[i].Exchange,
+			Currency: results[i].Currency,
+			Balance:  results[i].Balance,
+			Price:    results[i].Price,
+			Value:    results[i].Value,
+		})
+	}
+	return resp, nil
+}
+
+func (db *DBService) getInRangePostgres(startDate, endDate time.Time) ([]Snapshot, error) {
+	results, err := postgres.PortfolioValuations(
+		qm.Where("snapshot_time BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()),
+		qm.OrderBy("snapshot_time, id")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	var resp []Snapshot
+	for i := range results {
+		resp = appendHolding(resp, results[i].SnapshotTime.UTC(), results[i].FiatCurrency, Holding{
+			Exchange: results[i].Exchange,
+			Currency: results[i].Currency,
+			Balance:  results[i].Balance,
+			Price:    results[i].Price,
+			Value:    results[i].Value,
+		})
+	}
+	return resp, nil
+}
+
+// appendHolding groups holding rows ordered by time into their snapshots
+func appendHolding(snapshots []Snapshot, ts time.Time, fiatCurrency string, h Holding) []Snapshot {
+	if len(snapshots) == 0 || !snapshots[len(snapshots)-1].Timestamp.Equal(ts) {
+		snapshots = append(snapshots, Snapshot{Timestamp: ts, FiatCurrency: fiatCurrency})
+	}
+	last := &snapshots[len(snapshots)-1]
+	last.Holdings = append(last.Holdings, h)
+	return snapshots
+}
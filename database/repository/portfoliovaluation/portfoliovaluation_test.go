@@ -0,0 +1,128 @@
+package portfoliovaluation
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestPortfolioValuation(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err, "Setup must not error")
+
+			tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			err = db.Insert(nil)
+			assert.ErrorIs(t, err, errNilSnapshot)
+			err = db.Insert(&Snapshot{FiatCurrency: "USD"})
+			assert.ErrorIs(t, err, errTimestampUnset)
+			err = db.Insert(&Snapshot{Timestamp: tt})
+			assert.ErrorIs(t, err, errFiatCurrencyUnset)
+			err = db.Insert(&Snapshot{Timestamp: tt, FiatCurrency: "USD"})
+			assert.ErrorIs(t, err, errNoHoldings)
+
+			snapshots := []*Snapshot{
+				{
+					Timestamp:    tt,
+					FiatCurrency: "usd",
+					Holdings: []Holding{
+						{Exchange: "Binance", Currency: "btc", Balance: 1, Price: 40000, Value: 40000},
+						{Currency: "eth", Balance: 2, Price: 2000, Value: 4000},
+					},
+				},
+				{
+					Timestamp:    tt.Add(time.Hour),
+					FiatCurrency: "USD",
+					Holdings: []Holding{
+						{Exchange: "binance", Currency: "BTC", Balance: 1, Price: 42000, Value: 42000},
+						{Exchange: "binance", Currency: "LUNA", Balance: 10},
+					},
+				},
+			}
+			for i := range snapshots {
+				require.NoError(t, db.Insert(snapshots[i]), "Insert must not error")
+			}
+
+			_, err = db.GetInRange(tt, tt)
+			assert.ErrorIs(t, err, errInvalidRange)
+
+			resp, err := db.GetInRange(tt, tt.Add(time.Hour*2))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, resp, 2, "GetInRange must group holdings into their snapshots")
+			assert.Equal(t, tt, resp[0].Timestamp)
+			assert.Equal(t, "USD", resp[0].FiatCurrency)
+			assert.Equal(t, []Holding{
+				{Exchange: "binance", Currency: "BTC", Balance: 1, Price: 40000, Value: 40000},
+				{Currency: "ETH", Balance: 2, Price: 2000, Value: 4000},
+			}, resp[0].Holdings)
+			assert.Len(t, resp[1].Holdings, 2)
+
+			resp, err = db.GetInRange(tt.Add(time.Minute), tt.Add(time.Hour*2))
+			require.NoError(t, err, "GetInRange must not error")
+			require.Len(t, resp, 1, "GetInRange must only return snapshots in range")
+			assert.Equal(t, tt.Add(time.Hour), resp[0].Timestamp)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err, "CloseDatabase should not error")
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package portfoliovaluation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNilSnapshot       = errors.New("portfolio valuation snapshot is nil")
+	errTimestampUnset    = errors.New("timestamp must be set")
+	errFiatCurrencyUnset = errors.New("fiat currency must be set")
+	errNoHoldings        = errors.New("portfolio valuation snapshot has no holdings")
+	errInvalidRange      = errors.New("end date must be after start date")
+)
+
+// Snapshot is a DTO for the valuation of all portfolio holdings at a point in
+// time
+type Snapshot struct {
+	Timestamp    time.Time
+	FiatCurrency string
+	Holdings     []Holding
+}
+
+// Holding is the valuation of a single currency balance held on an exchange
+// or, when the exchange is empty, across personal portfolio addresses
+type Holding struct {
+	Exchange string
+	Currency string
+	Balance  float64
+	// Price is the price of one unit of the currency in the fiat currency.
+	// It is zero when no price was available
+	Price float64
+	Value float64
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the portfolio valuation database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(*Snapshot) error
+	GetInRange(startDate, endDate time.Time) ([]Snapshot, error)
+}
@@ -0,0 +1,190 @@
+package scriptstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, database.ErrNilInstance
+	}
+	if !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Get returns the value a script has stored for a key
+func (db *DBService) Get(script, key string) (*Record, error) {
+	if script == "" {
+		return nil, errScriptUnset
+	}
+	if key == "" {
+		return nil, errKeyUnset
+	}
+	ctx := context.TODO()
+	query := qm.Where("script = ? AND key = ?", script, key)
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		result, err := sqlite3.ScriptStores(query).One(ctx, db.sql)
+		if err != nil {
+			return nil, notFound(script, key, err)
+		}
+		return sqliteRecord(result)
+	case database.DBPostgreSQL:
+		result, err := postgres.ScriptStores(query).One(ctx, db.sql)
+		if err != nil {
+			return nil, notFound(script, key, err)
+		}
+		return postgresRecord(result), nil
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+// Set stores a value for a script, replacing any existing value for the key
+func (db *DBService) Set(r *Record) error {
+	if r == nil {
+		return errNilRecord
+	}
+	if r.Script == "" {
+		return errScriptUnset
+	}
+	if r.Key == "" {
+		return errKeyUnset
+	}
+	if r.UpdatedAt.IsZero() {
+		r.UpdatedAt = time.Now()
+	}
+	ctx := boil.SkipTimestamps(context.TODO())
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		// the unique constraint replaces any existing value on conflict
+		tempRecord := sqlite3.ScriptStore{
+			Script:    r.Script,
+			Key:       r.Key,
+			Value:     r.Value,
+			UpdatedAt: r.UpdatedAt.UTC().Format(time.RFC3339),
+		}
+		return tempRecord.Insert(ctx, db.sql, boil.Infer())
+	case database.DBPostgreSQL:
+		tempRecord := postgres.ScriptStore{
+			Script:    r.Script,
+			Key:       r.Key,
+			Value:     r.Value,
+			UpdatedAt: r.UpdatedAt.UTC(),
+		}
+		return tempRecord.Upsert(ctx, db.sql, true, []string{postgres.ScriptStoreColumns.Script, postgres.ScriptStoreColumns.Key},
+			boil.Whitelist(postgres.ScriptStoreColumns.Value, postgres.ScriptStoreColumns.UpdatedAt), boil.Infer())
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+}
+
+// Delete removes the value a script has stored for a key. Deleting a key
+// which is not stored is not an error
+func (db *DBService) Delete(script, key string) error {
+	if script == "" {
+		return errScriptUnset
+	}
+	if key == "" {
+		return errKeyUnset
+	}
+	ctx := context.TODO()
+	query := qm.Where("script = ? AND key = ?", script, key)
+	var err error
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		_, err = sqlite3.ScriptStores(query).DeleteAll(ctx, db.sql)
+	case database.DBPostgreSQL:
+		_, err = postgres.ScriptStores(query).DeleteAll(ctx, db.sql)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	return err
+}
+
+// GetAll returns all values stored by a script ordered by key
+func (db *DBService) GetAll(script string) ([]Record, error) {
+	if script == "" {
+		return nil, errScriptUnset
+	}
+	ctx := context.TODO()
+	mods := []qm.QueryMod{qm.Where("script = ?", script), qm.OrderBy("key")}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		results, err := sqlite3.ScriptStores(mods...).All(ctx, db.sql)
+		if err != nil {
+			return nil, err
+		}
+		resp := make([]Record, len(results))
+		for i := range results {
+			r, err := sqliteRecord(results[i])
+			if err != nil {
+				return nil, err
+			}
+			resp[i] = *r
+		}
+		return resp, nil
+	case database.DBPostgreSQL:
+		results, err := postgres.ScriptStores(mods...).All(ctx, db.sql)
+		if err != nil {
+			return nil, err
+		}
+		resp := make([]Record, len(results))
+		for i := range results {
+			resp[i] = *postgresRecord(results[i])
+		}
+		return resp, nil
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func notFound(script, key string, err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("%s %q: %w", script, key, ErrKeyNotFound)
+	}
+	return err
+}
+
+func sqliteRecord(r *sqlite3.ScriptStore) (*Record, error) {
+	updatedAt, err := time.Parse(time.RFC3339, r.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &Record{
+		Script:    r.Script,
+		Key:       r.Key,
+		Value:     r.Value,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+func postgresRecord(r *postgres.ScriptStore) *Record {
+	return &Record{
+		Script:    r.Script,
+		Key:       r.Key,
+		Value:     r.Value,
+		UpdatedAt: r.UpdatedAt.UTC(),
+	}
+}
@@ -0,0 +1,124 @@
+package scriptstore
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestScriptStore(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			require.NoError(t, err, "ConnectToDatabase must not error")
+
+			db, err := Setup(dbConn)
+			require.NoError(t, err, "Setup must not error")
+
+			err = db.Set(nil)
+			assert.ErrorIs(t, err, errNilRecord)
+			err = db.Set(&Record{Key: "position"})
+			assert.ErrorIs(t, err, errScriptUnset)
+			err = db.Set(&Record{Script: "strategy.gct"})
+			assert.ErrorIs(t, err, errKeyUnset)
+			_, err = db.Get("", "position")
+			assert.ErrorIs(t, err, errScriptUnset)
+			_, err = db.Get("strategy.gct", "")
+			assert.ErrorIs(t, err, errKeyUnset)
+			err = db.Delete("strategy.gct", "")
+			assert.ErrorIs(t, err, errKeyUnset)
+			_, err = db.GetAll("")
+			assert.ErrorIs(t, err, errScriptUnset)
+
+			_, err = db.Get("strategy.gct", "position")
+			assert.ErrorIs(t, err, ErrKeyNotFound)
+
+			tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			require.NoError(t, db.Set(&Record{Script: "strategy.gct", Key: "position", Value: "1", UpdatedAt: tt}), "Set must not error")
+			require.NoError(t, db.Set(&Record{Script: "strategy.gct", Key: "threshold", Value: "0.5"}), "Set must not error")
+			require.NoError(t, db.Set(&Record{Script: "other.gct", Key: "position", Value: "2"}), "Set must not error")
+
+			r, err := db.Get("strategy.gct", "position")
+			require.NoError(t, err, "Get must not error")
+			assert.Equal(t, "1", r.Value, "Get should return the stored value")
+			assert.Equal(t, tt, r.UpdatedAt, "Get should return the update time")
+
+			require.NoError(t, db.Set(&Record{Script: "strategy.gct", Key: "position", Value: "3"}), "Set must not error")
+			r, err = db.Get("strategy.gct", "position")
+			require.NoError(t, err, "Get must not error")
+			assert.Equal(t, "3", r.Value, "Set should replace an existing value")
+
+			resp, err := db.GetAll("strategy.gct")
+			require.NoError(t, err, "GetAll must not error")
+			require.Len(t, resp, 2, "GetAll must only return records for the script")
+			assert.Equal(t, "position", resp[0].Key, "GetAll should order records by key")
+			assert.Equal(t, "0.5", resp[1].Value, "GetAll should return the stored value")
+
+			require.NoError(t, db.Delete("strategy.gct", "position"), "Delete must not error")
+			require.NoError(t, db.Delete("strategy.gct", "position"), "Delete must not error for a missing key")
+			_, err = db.Get("strategy.gct", "position")
+			assert.ErrorIs(t, err, ErrKeyNotFound, "Delete should remove the value")
+			r, err = db.Get("other.gct", "position")
+			require.NoError(t, err, "Get must not error")
+			assert.Equal(t, "2", r.Value, "Delete should not remove values stored by other scripts")
+
+			err = testhelpers.CloseDatabase(dbConn)
+			assert.NoError(t, err, "CloseDatabase should not error")
+		})
+	}
+}
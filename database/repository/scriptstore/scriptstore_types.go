@@ -0,0 +1,42 @@
+package scriptstore
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	// ErrKeyNotFound is returned when a script has no value stored for a key
+	ErrKeyNotFound = errors.New("script store key not found")
+
+	errNilRecord   = errors.New("script store record is nil")
+	errScriptUnset = errors.New("script store script name must be set")
+	errKeyUnset    = errors.New("script store key must be set")
+)
+
+// Record is a DTO for a value persisted by a script. Values are stored
+// serialised so the repository does not need to know about their structure
+type Record struct {
+	Script    string
+	Key       string
+	Value     string
+	UpdatedAt time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the script store database service
+// without needing to care about implementation
+type IDBService interface {
+	Get(script, key string) (*Record, error)
+	Set(*Record) error
+	Delete(script, key string) error
+	GetAll(script string) ([]Record, error)
+}
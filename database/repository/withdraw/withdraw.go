@@ -3,6 +3,7 @@ package withdraw
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -214,6 +215,39 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 	return nil
 }
 
+// UpdateEvent updates the exchange ID and status of a stored withdrawal event
+func UpdateEvent(id uuid.UUID, exchangeID, status string) error {
+	if database.DB.SQL == nil {
+		return database.ErrDatabaseSupportDisabled
+	}
+
+	ctx := context.Background()
+	var (
+		rows int64
+		err  error
+	)
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		rows, err = modelSQLite.WithdrawalHistories(qm.Where("id = ?", id.String())).UpdateAll(ctx, database.DB.SQL, modelSQLite.M{
+			modelSQLite.WithdrawalHistoryColumns.ExchangeID: exchangeID,
+			modelSQLite.WithdrawalHistoryColumns.Status:     status,
+			modelSQLite.WithdrawalHistoryColumns.UpdatedAt:  time.Now().UTC().Format(time.RFC3339),
+		})
+	} else {
+		rows, err = modelPSQL.WithdrawalHistories(qm.Where("id = ?", id.String())).UpdateAll(ctx, database.DB.SQL, modelPSQL.M{
+			modelPSQL.WithdrawalHistoryColumns.ExchangeID: exchangeID,
+			modelPSQL.WithdrawalHistoryColumns.Status:     status,
+			modelPSQL.WithdrawalHistoryColumns.UpdatedAt:  time.Now().UTC(),
+		})
+	}
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("withdrawal event %v %w", id, common.ErrNoResults)
+	}
+	return nil
+}
+
 // GetEventByUUID return requested withdraw information by ID
 func GetEventByUUID(id string) (*withdraw.Response, error) {
 	resp, err := getByColumns(generateWhereQuery([]string{"id"}, []string{id}, 1))
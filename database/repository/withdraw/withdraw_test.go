@@ -186,6 +186,22 @@ func withdrawHelper(t *testing.T) {
 				t.Error(err)
 			}
 		}
+
+		err = UpdateEvent(v[0].ID, "updated", "approved")
+		if err != nil {
+			t.Error(err)
+		}
+		updated, err := GetEventByUUID(v[0].ID.String())
+		if err != nil {
+			t.Error(err)
+		} else if updated.Exchange.ID != "updated" || updated.Exchange.Status != "approved" {
+			t.Errorf("expected updated exchange ID and status, received %v %v", updated.Exchange.ID, updated.Exchange.Status)
+		}
+	}
+
+	err = UpdateEvent(withdraw.DryRunID, "", "")
+	if !errors.Is(err, common.ErrNoResults) {
+		t.Errorf("received %v, expected %v", err, common.ErrNoResults)
 	}
 
 	_, err = GetEventsByDate(testExchanges[0].Name, time.Now().UTC().Add(-time.Minute), time.Now().UTC(), 5)
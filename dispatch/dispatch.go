@@ -68,6 +68,11 @@ func IsRunning() bool {
 	return dispatcher.isRunning()
 }
 
+// QueueDepth returns the number of jobs waiting to be relayed to subscribers
+func QueueDepth() int {
+	return dispatcher.queueDepth()
+}
+
 // start compares atomic running value, sets defaults, overrides with
 // configuration, then spawns workers
 func (d *Dispatcher) start(workers, channelCapacity int) error {
@@ -166,6 +171,17 @@ func (d *Dispatcher) isRunning() bool {
 	return d.running
 }
 
+// queueDepth returns the number of jobs in the job queue
+func (d *Dispatcher) queueDepth() int {
+	if d == nil {
+		return 0
+	}
+
+	d.m.RLock()
+	defer d.m.RUnlock()
+	return len(d.jobs)
+}
+
 // relayer routine relays communications across the defined routes
 func (d *Dispatcher) relayer() {
 	for {
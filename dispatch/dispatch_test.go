@@ -180,6 +180,21 @@ func TestPublish(t *testing.T) {
 	assert.ErrorIs(t, err, errDispatcherJobsAtLimit, "publish should eventually error at limit")
 }
 
+func TestQueueDepth(t *testing.T) {
+	t.Parallel()
+	var d *Dispatcher
+	assert.Zero(t, d.queueDepth(), "queueDepth should return zero for a nil dispatcher")
+
+	d = NewDispatcher()
+	assert.Zero(t, d.queueDepth(), "queueDepth should return zero when not started")
+
+	d.jobs = make(chan job, 10)
+	d.jobs <- job{Data: "test", ID: nonEmptyUUID}
+	d.jobs <- job{Data: "test", ID: nonEmptyUUID}
+	assert.Equal(t, 2, d.queueDepth(), "queueDepth should return the queued jobs")
+	assert.GreaterOrEqual(t, QueueDepth(), 0, "QueueDepth should not be negative")
+}
+
 func TestPublishReceive(t *testing.T) {
 	t.Parallel()
 	d := NewDispatcher()
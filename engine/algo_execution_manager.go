@@ -0,0 +1,754 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupAlgoExecutionManager creates an algo execution manager which submits
+// child orders through the order manager
+func SetupAlgoExecutionManager(em iExchangeManager, om iAlgoOrderManager, cfg *config.AlgoExecutionManager) (*AlgoExecutionManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilAlgoOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w AlgoExecutionManager", errNilConfig)
+	}
+	a := &AlgoExecutionManager{
+		shutdown:        make(chan struct{}),
+		interval:        cfg.CheckInterval,
+		verbose:         cfg.Verbose,
+		orderManager:    om,
+		exchangeManager: em,
+		orders:          make(map[uuid.UUID]*AlgoOrder),
+	}
+	if a.interval <= 0 {
+		a.interval = defaultAlgoCheckInterval
+	}
+	return a, nil
+}
+
+// Start runs the subsystem
+func (a *AlgoExecutionManager) Start() error {
+	if a == nil {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&a.started, 0, 1) {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemStarting)
+	a.shutdown = make(chan struct{})
+	a.wg.Add(1)
+	go a.run()
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem. Running algo orders are paused and resume when
+// the subsystem is started again
+func (a *AlgoExecutionManager) Stop() error {
+	if a == nil {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&a.started, 1, 0) {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemShuttingDown)
+	close(a.shutdown)
+	a.wg.Wait()
+	log.Debugf(log.OrderMgr, "Algo execution manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (a *AlgoExecutionManager) IsRunning() bool {
+	return a != nil && atomic.LoadInt32(&a.started) == 1
+}
+
+func (a *AlgoExecutionManager) run() {
+	defer a.wg.Done()
+	t := time.NewTicker(a.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-a.shutdown:
+			return
+		case <-t.C:
+			a.processAlgoOrders(context.TODO())
+		}
+	}
+}
+
+// String implements the stringer interface
+func (t AlgoType) String() string {
+	switch t {
+	case TWAPAlgo:
+		return "TWAP"
+	case VWAPAlgo:
+		return "VWAP"
+	case IcebergAlgo:
+		return "ICEBERG"
+	case ChaseAlgo:
+		return "CHASE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// StringToAlgoType converts a string to an algo type
+func StringToAlgoType(s string) (AlgoType, error) {
+	switch strings.ToUpper(s) {
+	case TWAPAlgo.String():
+		return TWAPAlgo, nil
+	case VWAPAlgo.String():
+		return VWAPAlgo, nil
+	case IcebergAlgo.String():
+		return IcebergAlgo, nil
+	case ChaseAlgo.String():
+		return ChaseAlgo, nil
+	}
+	return UnknownAlgo, fmt.Errorf("%w '%s'", errAlgoTypeUnsupported, s)
+}
+
+// String implements the stringer interface
+func (s AlgoStatus) String() string {
+	switch s {
+	case AlgoRunning:
+		return "RUNNING"
+	case AlgoCompleted:
+		return "COMPLETED"
+	case AlgoCancelled:
+		return "CANCELLED"
+	case AlgoFailed:
+		return "FAILED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// SubmitAlgo validates and stores an algo order which is executed as child
+// orders from the next check interval. The submission's type is the type of
+// TWAP and VWAP child orders and its price is the child order limit price
+func (a *AlgoExecutionManager) SubmitAlgo(ctx context.Context, s *order.Submit, params AlgoParameters) (*AlgoOrder, error) {
+	if a == nil {
+		return nil, fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	if !a.IsRunning() {
+		return nil, fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	if s == nil {
+		return nil, errNilOrder
+	}
+	exch, err := a.exchangeManager.GetExchangeByName(s.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if s.Pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if !s.AssetType.IsValid() {
+		return nil, fmt.Errorf("%w: %v", order.ErrAssetNotSet, s.AssetType)
+	}
+	if !s.Side.IsLong() && !s.Side.IsShort() {
+		return nil, fmt.Errorf("%w: %v", order.ErrSideIsInvalid, s.Side)
+	}
+	if s.Amount <= 0 {
+		return nil, fmt.Errorf("%w: %v", order.ErrAmountIsInvalid, s.Amount)
+	}
+
+	ao := newAlgoOrder(s, params)
+	switch params.Type {
+	case TWAPAlgo:
+		if err := validateAlgoChildOrder(s); err != nil {
+			return nil, err
+		}
+		if params.Duration <= 0 {
+			return nil, errInvalidAlgoDuration
+		}
+		if params.Slices <= 0 {
+			return nil, errInvalidAlgoSlices
+		}
+		ao.schedule = getTWAPSchedule(ao.CreatedAt, s.Amount, params.Duration, params.Slices)
+	case VWAPAlgo:
+		if err := validateAlgoChildOrder(s); err != nil {
+			return nil, err
+		}
+		if params.Duration <= 0 {
+			return nil, errInvalidAlgoDuration
+		}
+		if params.Interval <= 0 || params.Interval.Duration() > params.Duration {
+			return nil, errInvalidAlgoInterval
+		}
+		ao.schedule, err = getVWAPSchedule(ctx, exch, ao)
+		if err != nil {
+			return nil, err
+		}
+	case IcebergAlgo:
+		if s.Price <= 0 {
+			return nil, order.ErrPriceMustBeSetIfLimitOrder
+		}
+		if params.VisibleAmount <= 0 || params.VisibleAmount > s.Amount {
+			return nil, fmt.Errorf("%w: %v", errInvalidVisibleAmount, params.VisibleAmount)
+		}
+		ao.OrderType = order.Limit
+	case ChaseAlgo:
+		ao.OrderType = order.Limit
+	default:
+		return nil, fmt.Errorf("%w %v", errAlgoTypeUnsupported, params.Type)
+	}
+
+	a.m.Lock()
+	a.orders[ao.ID] = ao
+	resp := ao.copy()
+	a.m.Unlock()
+	log.Infof(log.OrderMgr, "Algo order %v added: %v %s %s %s %v amount %v", resp.ID, resp.Type, resp.Exchange, resp.AssetType, resp.Pair, resp.Side, resp.Amount)
+	return &resp, nil
+}
+
+// GetAlgoOrders returns a copy of the algo orders sorted by creation time. An
+// empty exchange name returns orders for all exchanges
+func (a *AlgoExecutionManager) GetAlgoOrders(exchangeName string, runningOnly bool) ([]AlgoOrder, error) {
+	if a == nil {
+		return nil, fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	if !a.IsRunning() {
+		return nil, fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	a.m.Lock()
+	resp := make([]AlgoOrder, 0, len(a.orders))
+	for _, ao := range a.orders {
+		if exchangeName != "" && !strings.EqualFold(ao.Exchange, exchangeName) {
+			continue
+		}
+		if runningOnly && ao.Status != AlgoRunning {
+			continue
+		}
+		resp = append(resp, ao.copy())
+	}
+	a.m.Unlock()
+	slices.SortFunc(resp, func(x, y AlgoOrder) int {
+		return x.CreatedAt.Compare(y.CreatedAt)
+	})
+	return resp, nil
+}
+
+// CancelAlgo stops a running algo order and cancels any of its child orders
+// which are still open
+func (a *AlgoExecutionManager) CancelAlgo(ctx context.Context, id uuid.UUID) error {
+	if a == nil {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrNilSubsystem)
+	}
+	if !a.IsRunning() {
+		return fmt.Errorf("%s %w", AlgoExecutionManagerName, ErrSubSystemNotStarted)
+	}
+	a.m.Lock()
+	ao, ok := a.orders[id]
+	if !ok {
+		a.m.Unlock()
+		return fmt.Errorf("%w %v", ErrAlgoOrderNotFound, id)
+	}
+	if ao.Status != AlgoRunning {
+		a.m.Unlock()
+		return fmt.Errorf("%w %v %v", ErrAlgoOrderNotRunning, id, ao.Status)
+	}
+	ao.Status = AlgoCancelled
+	ao.LastUpdated = time.Now()
+	ao.activeChild = ""
+	cancelled := ao.copy()
+	a.m.Unlock()
+
+	var errs error
+	for _, orderID := range cancelled.ChildOrderIDs {
+		d, err := a.orderManager.GetByExchangeAndID(cancelled.Exchange, orderID)
+		if err != nil || d.IsInactive() {
+			continue
+		}
+		err = a.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  cancelled.Exchange,
+			OrderID:   orderID,
+			Pair:      cancelled.Pair,
+			AssetType: cancelled.AssetType,
+			Side:      cancelled.Side,
+		})
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("child order %s: %w", orderID, err))
+		}
+	}
+	a.unlinkChildren(id)
+	log.Infof(log.OrderMgr, "Algo order %v cancelled with %v of %v filled", id, cancelled.FilledAmount, cancelled.Amount)
+	return errs
+}
+
+// processAlgoOrders advances every running algo order
+func (a *AlgoExecutionManager) processAlgoOrders(ctx context.Context) {
+	if !a.orderManager.IsRunning() {
+		return
+	}
+	a.m.Lock()
+	running := make([]*AlgoOrder, 0, len(a.orders))
+	for _, ao := range a.orders {
+		if ao.Status == AlgoRunning {
+			running = append(running, ao)
+		}
+	}
+	a.m.Unlock()
+	for i := range running {
+		a.processAlgoOrder(ctx, running[i])
+	}
+}
+
+// processAlgoOrder updates the fills of an algo order from its child orders
+// and places, re-prices or completes child orders as required
+func (a *AlgoExecutionManager) processAlgoOrder(ctx context.Context, ao *AlgoOrder) {
+	// Identifying fields are immutable after creation so can be read
+	// without holding the lock
+	exch, err := a.exchangeManager.GetExchangeByName(ao.Exchange)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Algo order %v: %v", ao.ID, err)
+		return
+	}
+	allInactive := a.updateFills(ao)
+	switch ao.Type {
+	case TWAPAlgo, VWAPAlgo:
+		a.processScheduledAlgo(ctx, exch, ao, allInactive)
+	case IcebergAlgo, ChaseAlgo:
+		a.processRestingAlgo(ctx, exch, ao)
+	}
+	a.m.Lock()
+	finished := ao.Status != AlgoRunning
+	a.m.Unlock()
+	if finished {
+		a.unlinkChildren(ao.ID)
+	}
+}
+
+// updateFills sums the executed amounts of an algo order's child orders and
+// returns whether none of them remain open. Child orders are looked up
+// without holding the lock so that the order store is never locked within it
+func (a *AlgoExecutionManager) updateFills(ao *AlgoOrder) bool {
+	a.m.Lock()
+	childOrderIDs := slices.Clone(ao.ChildOrderIDs)
+	a.m.Unlock()
+	var filled float64
+	allInactive := true
+	for _, orderID := range childOrderIDs {
+		d, err := a.orderManager.GetByExchangeAndID(ao.Exchange, orderID)
+		if err != nil {
+			continue
+		}
+		filled += getExecutedAmount(d)
+		if !d.IsInactive() {
+			allInactive = false
+		}
+	}
+	a.m.Lock()
+	defer a.m.Unlock()
+	if len(ao.ChildOrderIDs) != len(childOrderIDs) {
+		// a child order was submitted during the lookup so its fills are
+		// accounted for on the next update
+		return false
+	}
+	if filled != ao.FilledAmount {
+		ao.FilledAmount = filled
+		ao.LastUpdated = time.Now()
+	}
+	return allInactive
+}
+
+// processScheduledAlgo submits any TWAP or VWAP child orders which are due.
+// The algo order completes once every child order has been submitted and
+// none remain open
+func (a *AlgoExecutionManager) processScheduledAlgo(ctx context.Context, exch exchange.IBotExchange, ao *AlgoOrder, allInactive bool) {
+	a.m.Lock()
+	if ao.Status != AlgoRunning {
+		a.m.Unlock()
+		return
+	}
+	if ao.nextSlice == len(ao.schedule) {
+		if allInactive {
+			a.completeLocked(ao)
+		}
+		a.m.Unlock()
+		return
+	}
+	now := time.Now()
+	amount := ao.carry
+	for ao.nextSlice < len(ao.schedule) && !ao.schedule[ao.nextSlice].at.After(now) {
+		amount += ao.schedule[ao.nextSlice].amount
+		ao.nextSlice++
+	}
+	last := ao.nextSlice == len(ao.schedule)
+	if last {
+		// the final child order picks up any rounding remainder
+		amount = ao.Amount - ao.SubmittedAmount
+	}
+	ao.carry = amount
+	a.m.Unlock()
+
+	conformed := getConformedChildAmount(exch, ao, amount, ao.Price)
+	if conformed <= 0 {
+		if last && a.verbose {
+			log.Debugf(log.OrderMgr, "Algo order %v: remaining amount %v is below the exchange minimum", ao.ID, amount)
+		}
+		return
+	}
+	if err := a.submitChild(ctx, ao, conformed, ao.Price); err != nil {
+		return
+	}
+	a.m.Lock()
+	ao.carry = amount - conformed
+	a.m.Unlock()
+}
+
+// processRestingAlgo keeps a single iceberg or chase child order resting,
+// re-pricing chase orders to the best price and replacing filled child orders
+// until the algo order is filled
+func (a *AlgoExecutionManager) processRestingAlgo(ctx context.Context, exch exchange.IBotExchange, ao *AlgoOrder) {
+	a.m.Lock()
+	if ao.Status != AlgoRunning {
+		a.m.Unlock()
+		return
+	}
+	active := ao.activeChild
+	remaining := ao.Amount - ao.FilledAmount
+	a.m.Unlock()
+
+	if active != "" {
+		d, err := a.orderManager.GetByExchangeAndID(ao.Exchange, active)
+		if err == nil && !d.IsInactive() {
+			if ao.Type == ChaseAlgo {
+				a.repriceChase(ctx, ao, d)
+			}
+			return
+		}
+		a.m.Lock()
+		ao.activeChild = ""
+		a.m.Unlock()
+	}
+
+	if remaining <= ao.Amount*algoAmountTolerance {
+		a.m.Lock()
+		a.completeLocked(ao)
+		a.m.Unlock()
+		return
+	}
+	price := ao.Price
+	amount := remaining
+	if ao.Type == IcebergAlgo {
+		amount = math.Min(ao.VisibleAmount, remaining)
+	} else {
+		var err error
+		price, err = getChasePrice(ao)
+		if err != nil {
+			if a.verbose {
+				log.Debugf(log.OrderMgr, "Algo order %v: %v", ao.ID, err)
+			}
+			return
+		}
+	}
+	conformed := getConformedChildAmount(exch, ao, amount, price)
+	if conformed <= 0 {
+		a.m.Lock()
+		a.completeLocked(ao)
+		a.m.Unlock()
+		return
+	}
+	_ = a.submitChild(ctx, ao, conformed, price)
+}
+
+// repriceChase modifies a resting chase child order to the best bid or ask
+func (a *AlgoExecutionManager) repriceChase(ctx context.Context, ao *AlgoOrder, child *order.Detail) {
+	price, err := getChasePrice(ao)
+	if err != nil || price == child.Price {
+		return
+	}
+	resp, err := a.orderManager.Modify(ctx, &order.Modify{
+		Exchange:  ao.Exchange,
+		OrderID:   child.OrderID,
+		Type:      order.Limit,
+		Side:      ao.Side,
+		AssetType: ao.AssetType,
+		Pair:      ao.Pair,
+		Price:     price,
+		Amount:    child.Amount,
+	})
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Algo order %v unable to re-price child order %s to %v: %v", ao.ID, child.OrderID, price, err)
+		return
+	}
+	if a.verbose {
+		log.Debugf(log.OrderMgr, "Algo order %v re-priced child order %s from %v to %v", ao.ID, child.OrderID, child.Price, price)
+	}
+	if resp.OrderID == "" || resp.OrderID == child.OrderID {
+		return
+	}
+	// some exchanges replace the order when it is modified
+	a.m.Lock()
+	if i := slices.Index(ao.ChildOrderIDs, child.OrderID); i >= 0 {
+		ao.ChildOrderIDs[i] = resp.OrderID
+	}
+	ao.activeChild = resp.OrderID
+	ao.LastUpdated = time.Now()
+	a.m.Unlock()
+	a.linkChild(ao, resp.OrderID)
+}
+
+// submitChild submits a child order through the order manager and links it
+// to the algo order. A failed submission fails the algo order
+func (a *AlgoExecutionManager) submitChild(ctx context.Context, ao *AlgoOrder, amount, price float64) error {
+	a.m.Lock()
+	child := ao.submit
+	child.Type = ao.OrderType
+	child.Amount = amount
+	child.QuoteAmount = 0
+	child.Price = price
+	if child.ClientOrderID != "" {
+		child.ClientOrderID += "-" + strconv.Itoa(len(ao.ChildOrderIDs)+1)
+	}
+	a.m.Unlock()
+
+	resp, err := a.orderManager.Submit(ctx, &child)
+
+	a.m.Lock()
+	ao.LastUpdated = time.Now()
+	if err != nil {
+		ao.Status = AlgoFailed
+		ao.Error = err.Error()
+		a.m.Unlock()
+		log.Errorf(log.OrderMgr, "Algo order %v failed to submit child order: %v", ao.ID, err)
+		return err
+	}
+	ao.ChildOrderIDs = append(ao.ChildOrderIDs, resp.OrderID)
+	ao.SubmittedAmount += amount
+	if ao.Type == IcebergAlgo || ao.Type == ChaseAlgo {
+		ao.activeChild = resp.OrderID
+	}
+	status := ao.Status
+	a.m.Unlock()
+
+	a.linkChild(ao, resp.OrderID)
+	if a.verbose {
+		log.Debugf(log.OrderMgr, "Algo order %v submitted child order %s amount %v price %v", ao.ID, resp.OrderID, amount, price)
+	}
+	if status == AlgoCancelled {
+		// the algo order was cancelled while the child order was submitted
+		err = a.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  ao.Exchange,
+			OrderID:   resp.OrderID,
+			Pair:      ao.Pair,
+			AssetType: ao.AssetType,
+			Side:      ao.Side,
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Algo order %v unable to cancel child order %s: %v", ao.ID, resp.OrderID, err)
+		}
+	}
+	return nil
+}
+
+// linkChild links a child order to its algo order in the order manager. Should
+// the algo order have finished in the meantime, the links are removed again
+// so that they are not held for the lifetime of the order manager
+func (a *AlgoExecutionManager) linkChild(ao *AlgoOrder, orderID string) {
+	if err := a.orderManager.LinkChildOrder(ao.ID, ao.Exchange, orderID); err != nil {
+		log.Warnf(log.OrderMgr, "Algo order %v unable to link child order %s: %v", ao.ID, orderID, err)
+	}
+	a.m.Lock()
+	finished := ao.Status != AlgoRunning
+	a.m.Unlock()
+	if finished {
+		a.unlinkChildren(ao.ID)
+	}
+}
+
+// unlinkChildren removes the child order links of a finished algo order
+func (a *AlgoExecutionManager) unlinkChildren(id uuid.UUID) {
+	if err := a.orderManager.UnlinkChildOrders(id); err != nil {
+		log.Warnf(log.OrderMgr, "Algo order %v unable to unlink child orders: %v", id, err)
+	}
+}
+
+// completeLocked marks an algo order as completed. The lock must be held
+func (a *AlgoExecutionManager) completeLocked(ao *AlgoOrder) {
+	if ao.Status != AlgoRunning {
+		return
+	}
+	ao.Status = AlgoCompleted
+	ao.activeChild = ""
+	ao.LastUpdated = time.Now()
+	log.Infof(log.OrderMgr, "Algo order %v completed with %v of %v filled", ao.ID, ao.FilledAmount, ao.Amount)
+}
+
+// newAlgoOrder creates a running algo order from a submission
+func newAlgoOrder(s *order.Submit, params AlgoParameters) *AlgoOrder {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Unable to generate UUID. Err: %s", err)
+	}
+	now := time.Now()
+	return &AlgoOrder{
+		AlgoParameters: params,
+		ID:             id,
+		Exchange:       s.Exchange,
+		Pair:           s.Pair,
+		AssetType:      s.AssetType,
+		Side:           s.Side,
+		OrderType:      s.Type,
+		Price:          s.Price,
+		Amount:         s.Amount,
+		Status:         AlgoRunning,
+		CreatedAt:      now,
+		LastUpdated:    now,
+		submit:         *s,
+	}
+}
+
+// copy returns a copy of an algo order which is safe to return to callers
+func (ao *AlgoOrder) copy() AlgoOrder {
+	c := *ao
+	c.ChildOrderIDs = slices.Clone(ao.ChildOrderIDs)
+	c.schedule = nil
+	return c
+}
+
+// validateAlgoChildOrder ensures TWAP and VWAP child orders can be submitted
+func validateAlgoChildOrder(s *order.Submit) error {
+	switch s.Type {
+	case order.Market:
+		return nil
+	case order.Limit:
+		if s.Price <= 0 {
+			return order.ErrPriceMustBeSetIfLimitOrder
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %v", errAlgoChildOrderType, s.Type)
+}
+
+// getTWAPSchedule splits an amount into equal child orders spread evenly over
+// a duration, with the first submitted immediately
+func getTWAPSchedule(start time.Time, amount float64, duration time.Duration, slices int) []algoSlice {
+	schedule := make([]algoSlice, slices)
+	step := duration / time.Duration(slices)
+	for i := range schedule {
+		schedule[i] = algoSlice{
+			at:     start.Add(step * time.Duration(i)),
+			amount: amount / float64(slices),
+		}
+	}
+	return schedule
+}
+
+// getVWAPSchedule splits an amount over a duration in proportion to the
+// volume traded over the same time of day on the previous day
+func getVWAPSchedule(ctx context.Context, exch exchange.IBotExchange, ao *AlgoOrder) ([]algoSlice, error) {
+	interval := ao.Interval.Duration()
+	buckets := int((ao.Duration + interval - 1) / interval)
+	profileStart := ao.CreatedAt.Add(-vwapProfileLookback).Truncate(interval)
+	candles, err := exch.GetHistoricCandles(ctx, ao.Pair, ao.AssetType, ao.Interval, profileStart, profileStart.Add(interval*time.Duration(buckets)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", errNoVolumeProfile, err)
+	}
+	return getVolumeWeightedSchedule(ao.CreatedAt, ao.Amount, profileStart, ao.Interval, buckets, candles.Candles)
+}
+
+// getVolumeWeightedSchedule weights each interval's child order by the
+// volume of its matching candle from the profile
+func getVolumeWeightedSchedule(start time.Time, amount float64, profileStart time.Time, interval kline.Interval, buckets int, candles []kline.Candle) ([]algoSlice, error) {
+	volumes := make([]float64, buckets)
+	var total float64
+	for i := range candles {
+		idx := int(candles[i].Time.Sub(profileStart) / interval.Duration())
+		if idx < 0 || idx >= buckets || candles[i].Volume <= 0 {
+			continue
+		}
+		volumes[idx] += candles[i].Volume
+		total += candles[i].Volume
+	}
+	if total == 0 {
+		return nil, errNoVolumeProfile
+	}
+	schedule := make([]algoSlice, 0, buckets)
+	for i := range volumes {
+		if volumes[i] == 0 {
+			continue
+		}
+		schedule = append(schedule, algoSlice{
+			at:     start.Add(interval.Duration() * time.Duration(i)),
+			amount: amount * volumes[i] / total,
+		})
+	}
+	return schedule, nil
+}
+
+// getChasePrice returns the best bid for buys or best ask for sells from the
+// orderbook, bounded by the algo order's price when set
+func getChasePrice(ao *AlgoOrder) (float64, error) {
+	depth, err := orderbook.GetDepth(ao.Exchange, ao.Pair, ao.AssetType)
+	if err != nil {
+		return 0, err
+	}
+	var price float64
+	if ao.Side.IsLong() {
+		price, err = depth.GetBestBid()
+	} else {
+		price, err = depth.GetBestAsk()
+	}
+	if err != nil {
+		return 0, err
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("%w %v %v %v", errNoReferencePrice, ao.Exchange, ao.AssetType, ao.Pair)
+	}
+	if ao.Price > 0 {
+		if ao.Side.IsLong() {
+			price = math.Min(price, ao.Price)
+		} else {
+			price = math.Max(price, ao.Price)
+		}
+	}
+	return price, nil
+}
+
+// getConformedChildAmount conforms a child order amount to the exchange's
+// amount step, returning zero when it is below the exchange's minimums
+func getConformedChildAmount(exch exchange.IBotExchange, ao *AlgoOrder, amount, price float64) float64 {
+	limits, err := exch.GetOrderExecutionLimits(ao.AssetType, ao.Pair)
+	if err != nil {
+		// exchanges without loaded limits are unrestricted
+		return amount
+	}
+	conformed := limits.ConformToAmount(amount)
+	if conformed <= 0 ||
+		(limits.MinimumBaseAmount > 0 && conformed < limits.MinimumBaseAmount) ||
+		(limits.MinNotional > 0 && price > 0 && conformed*price < limits.MinNotional) {
+		return 0
+	}
+	return conformed
+}
+
+// getExecutedAmount returns the filled amount of a child order
+func getExecutedAmount(d *order.Detail) float64 {
+	if d.ExecutedAmount == 0 && d.Status == order.Filled {
+		return d.Amount
+	}
+	return d.ExecutedAmount
+}
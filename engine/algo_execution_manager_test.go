@@ -0,0 +1,413 @@
+package engine
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// aofExchange aka algo order fake exchange returns unique order IDs and a
+// fixed volume profile so algo orders can be executed without making
+// exchange requests
+type aofExchange struct {
+	omfExchange
+	ids     *atomic.Int64
+	volumes []float64
+}
+
+func (f aofExchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	return s.DeriveSubmitResponse("algo-" + strconv.FormatInt(f.ids.Add(1), 10))
+}
+
+func (f aofExchange) ModifyOrder(_ context.Context, action *order.Modify) (*order.ModifyResponse, error) {
+	resp, err := action.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.OrderID = "algo-" + strconv.FormatInt(f.ids.Add(1), 10)
+	return resp, nil
+}
+
+func (f aofExchange) GetHistoricCandles(_ context.Context, pair currency.Pair, a asset.Item, interval kline.Interval, start, _ time.Time) (*kline.Item, error) {
+	item := &kline.Item{Exchange: testExchange, Pair: pair, Asset: a, Interval: interval}
+	for i := range f.volumes {
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:   start.Add(interval.Duration() * time.Duration(i)),
+			Volume: f.volumes[i],
+		})
+	}
+	return item, nil
+}
+
+// algoSetup returns a started algo execution manager which is only advanced
+// by calling processAlgoOrders. Each test uses its own pair so orderbooks are
+// not shared between tests
+func algoSetup(t *testing.T, pair currency.Pair, volumes ...float64) (*AlgoExecutionManager, *OrderManager) {
+	t.Helper()
+	c := &config.Config{}
+	require.NoError(t, c.LoadConfig(config.TestFile, true))
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	cfg, err := c.GetExchangeConfig(testExchange)
+	require.NoError(t, err)
+	require.NoError(t, exch.Setup(cfg))
+	b := exch.GetBase()
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, false))
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, true))
+	require.NoError(t, em.Add(aofExchange{omfExchange: omfExchange{IBotExchange: exch}, ids: new(atomic.Int64), volumes: volumes}))
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	a, err := SetupAlgoExecutionManager(em, m, &config.AlgoExecutionManager{CheckInterval: time.Hour})
+	require.NoError(t, err)
+	a.started = 1
+	return a, m
+}
+
+func algoSubmit(pair currency.Pair, side order.Side, oType order.Type, amount, price float64) *order.Submit {
+	return &order.Submit{Exchange: testExchange, Pair: pair, AssetType: asset.Spot, Side: side, Type: oType, Amount: amount, Price: price}
+}
+
+// fillChildOrders marks every open child order of an algo order as filled
+func fillChildOrders(t *testing.T, a *AlgoExecutionManager, m *OrderManager, id uuid.UUID) {
+	t.Helper()
+	children, err := m.GetChildOrders(id)
+	require.NoError(t, err, "GetChildOrders must not error")
+	for i := range children {
+		if children[i].IsInactive() {
+			continue
+		}
+		children[i].Status = order.Filled
+		children[i].ExecutedAmount = children[i].Amount
+		require.NoError(t, m.UpdateExistingOrder(&children[i]), "UpdateExistingOrder must not error")
+	}
+	a.processAlgoOrders(context.Background())
+}
+
+func getAlgoOrder(t *testing.T, a *AlgoExecutionManager, id uuid.UUID) AlgoOrder {
+	t.Helper()
+	orders, err := a.GetAlgoOrders("", false)
+	require.NoError(t, err, "GetAlgoOrders must not error")
+	for i := range orders {
+		if orders[i].ID == id {
+			return orders[i]
+		}
+	}
+	require.FailNow(t, "algo order not found")
+	return AlgoOrder{}
+}
+
+func TestSetupAlgoExecutionManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupAlgoExecutionManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupAlgoExecutionManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilAlgoOrderManager)
+	_, err = SetupAlgoExecutionManager(NewExchangeManager(), &OrderManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	a, err := SetupAlgoExecutionManager(NewExchangeManager(), &OrderManager{}, &config.AlgoExecutionManager{})
+	require.NoError(t, err, "SetupAlgoExecutionManager must not error")
+	assert.Equal(t, defaultAlgoCheckInterval, a.interval, "interval should default when unset")
+}
+
+func TestAlgoExecutionManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var a *AlgoExecutionManager
+	assert.ErrorIs(t, a.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, a.Stop(), ErrNilSubsystem)
+	assert.False(t, a.IsRunning())
+
+	a, err := SetupAlgoExecutionManager(NewExchangeManager(), &OrderManager{}, &config.AlgoExecutionManager{})
+	require.NoError(t, err, "SetupAlgoExecutionManager must not error")
+	assert.ErrorIs(t, a.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, a.Start(), "Start must not error")
+	assert.True(t, a.IsRunning())
+	assert.ErrorIs(t, a.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, a.Stop(), "Stop must not error")
+	assert.False(t, a.IsRunning())
+	require.NoError(t, a.Start(), "Start must not error after being stopped")
+	require.NoError(t, a.Stop(), "Stop must not error")
+}
+
+func TestStringToAlgoType(t *testing.T) {
+	t.Parallel()
+	for _, algo := range []AlgoType{TWAPAlgo, VWAPAlgo, IcebergAlgo, ChaseAlgo} {
+		resp, err := StringToAlgoType(algo.String())
+		require.NoError(t, err, "StringToAlgoType must not error")
+		assert.Equal(t, algo, resp)
+	}
+	resp, err := StringToAlgoType("twap")
+	require.NoError(t, err, "StringToAlgoType must not error")
+	assert.Equal(t, TWAPAlgo, resp, "StringToAlgoType should be case insensitive")
+	_, err = StringToAlgoType("POV")
+	assert.ErrorIs(t, err, errAlgoTypeUnsupported)
+	assert.Equal(t, "UNKNOWN", UnknownAlgo.String())
+	assert.Equal(t, "COMPLETED", AlgoCompleted.String())
+}
+
+func TestSubmitAlgo(t *testing.T) {
+	t.Parallel()
+	var a *AlgoExecutionManager
+	_, err := a.SubmitAlgo(context.Background(), nil, AlgoParameters{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	a = &AlgoExecutionManager{}
+	_, err = a.SubmitAlgo(context.Background(), nil, AlgoParameters{})
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	pair := routingPair("ALGOSUB")
+	a, _ = algoSetup(t, pair)
+	_, err = a.SubmitAlgo(context.Background(), nil, AlgoParameters{})
+	assert.ErrorIs(t, err, errNilOrder)
+	_, err = a.SubmitAlgo(context.Background(), &order.Submit{Exchange: "bogus"}, AlgoParameters{})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(currency.EMPTYPAIR, order.Buy, order.Market, 1, 0), AlgoParameters{})
+	assert.ErrorIs(t, err, order.ErrPairIsEmpty)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.UnknownSide, order.Market, 1, 0), AlgoParameters{})
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 0, 0), AlgoParameters{})
+	assert.ErrorIs(t, err, order.ErrAmountIsInvalid)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 1, 0), AlgoParameters{})
+	assert.ErrorIs(t, err, errAlgoTypeUnsupported)
+
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Stop, 1, 0), AlgoParameters{Type: TWAPAlgo})
+	assert.ErrorIs(t, err, errAlgoChildOrderType)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Limit, 1, 0), AlgoParameters{Type: TWAPAlgo})
+	assert.ErrorIs(t, err, order.ErrPriceMustBeSetIfLimitOrder)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 1, 0), AlgoParameters{Type: TWAPAlgo})
+	assert.ErrorIs(t, err, errInvalidAlgoDuration)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 1, 0), AlgoParameters{Type: TWAPAlgo, Duration: time.Hour})
+	assert.ErrorIs(t, err, errInvalidAlgoSlices)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 1, 0), AlgoParameters{Type: VWAPAlgo, Duration: time.Hour, Interval: kline.OneDay})
+	assert.ErrorIs(t, err, errInvalidAlgoInterval)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 1, 0), AlgoParameters{Type: VWAPAlgo, Duration: time.Hour, Interval: kline.FifteenMin})
+	assert.ErrorIs(t, err, errNoVolumeProfile, "an empty volume profile should error")
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Limit, 1, 0), AlgoParameters{Type: IcebergAlgo, VisibleAmount: 0.1})
+	assert.ErrorIs(t, err, order.ErrPriceMustBeSetIfLimitOrder)
+	_, err = a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Limit, 1, 100), AlgoParameters{Type: IcebergAlgo, VisibleAmount: 2})
+	assert.ErrorIs(t, err, errInvalidVisibleAmount)
+
+	ao, err := a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 1, 0), AlgoParameters{Type: ChaseAlgo})
+	require.NoError(t, err, "SubmitAlgo must not error")
+	assert.Equal(t, order.Limit, ao.OrderType, "chase child orders should be limit orders")
+	assert.Equal(t, AlgoRunning, ao.Status)
+}
+
+func TestAlgoTWAP(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ALGOTWAP")
+	a, m := algoSetup(t, pair)
+	s := algoSubmit(pair, order.Buy, order.Limit, 1, 100)
+	s.ClientOrderID = "twap"
+	ao, err := a.SubmitAlgo(context.Background(), s, AlgoParameters{Type: TWAPAlgo, Duration: time.Hour, Slices: 3})
+	require.NoError(t, err, "SubmitAlgo must not error")
+
+	a.processAlgoOrders(context.Background())
+	resp := getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 1, "only the first slice should be due")
+	child, err := m.GetByExchangeAndID(testExchange, resp.ChildOrderIDs[0])
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.InDelta(t, 1.0/3, child.Amount, 1e-9)
+	assert.Equal(t, 100.0, child.Price)
+	assert.Equal(t, "twap-1", child.ClientOrderID)
+
+	a.m.Lock()
+	for i := range a.orders[ao.ID].schedule {
+		a.orders[ao.ID].schedule[i].at = time.Now().Add(-time.Minute)
+	}
+	a.m.Unlock()
+	a.processAlgoOrders(context.Background())
+	resp = getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 2, "overdue slices should be combined")
+	assert.InDelta(t, 1, resp.SubmittedAmount, 1e-9)
+	assert.Equal(t, AlgoRunning, resp.Status, "algo order should run until child orders are filled")
+
+	fillChildOrders(t, a, m, ao.ID)
+	resp = getAlgoOrder(t, a, ao.ID)
+	assert.Equal(t, AlgoCompleted, resp.Status)
+	assert.InDelta(t, 1, resp.FilledAmount, 1e-9)
+	children, err := m.GetChildOrders(ao.ID)
+	require.NoError(t, err, "GetChildOrders must not error")
+	assert.Empty(t, children, "child order links should be removed once the algo order completes")
+	running, err := a.GetAlgoOrders(testExchange, true)
+	require.NoError(t, err, "GetAlgoOrders must not error")
+	assert.Empty(t, running)
+}
+
+func TestAlgoTWAPMinimumAmount(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ALGOTMIN")
+	a, m := algoSetup(t, pair)
+	loadRoutingLimits(t, m, testExchange, order.MinMaxLevel{
+		Pair:                    pair,
+		Asset:                   asset.Spot,
+		MinimumBaseAmount:       0.5,
+		AmountStepIncrementSize: 0.1,
+	})
+	ao, err := a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Sell, order.Market, 1, 0), AlgoParameters{Type: TWAPAlgo, Duration: time.Hour, Slices: 4})
+	require.NoError(t, err, "SubmitAlgo must not error")
+	a.processAlgoOrders(context.Background())
+	resp := getAlgoOrder(t, a, ao.ID)
+	assert.Empty(t, resp.ChildOrderIDs, "slices below the minimum amount should be carried")
+
+	a.m.Lock()
+	a.orders[ao.ID].schedule[1].at = time.Now()
+	a.m.Unlock()
+	a.processAlgoOrders(context.Background())
+	resp = getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 1, "carried amount should be submitted once above the minimum")
+	assert.InDelta(t, 0.5, resp.SubmittedAmount, 1e-9)
+}
+
+func TestAlgoVWAP(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ALGOVWAP")
+	a, m := algoSetup(t, pair, 1, 3)
+	ao, err := a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Market, 2, 0), AlgoParameters{Type: VWAPAlgo, Duration: time.Hour, Interval: kline.ThirtyMin})
+	require.NoError(t, err, "SubmitAlgo must not error")
+	a.processAlgoOrders(context.Background())
+	resp := getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 1)
+	child, err := m.GetByExchangeAndID(testExchange, resp.ChildOrderIDs[0])
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.InDelta(t, 0.5, child.Amount, 1e-9, "first slice should be weighted by its share of volume")
+	assert.Equal(t, order.Market, child.Type)
+}
+
+func TestGetVolumeWeightedSchedule(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	profileStart := start.Add(-vwapProfileLookback)
+	_, err := getVolumeWeightedSchedule(start, 1, profileStart, kline.OneHour, 3, nil)
+	assert.ErrorIs(t, err, errNoVolumeProfile)
+
+	schedule, err := getVolumeWeightedSchedule(start, 10, profileStart, kline.OneHour, 3, []kline.Candle{
+		{Time: profileStart, Volume: 2},
+		{Time: profileStart.Add(time.Hour * 2), Volume: 8},
+		{Time: profileStart.Add(time.Hour * 3), Volume: 100},
+	})
+	require.NoError(t, err, "getVolumeWeightedSchedule must not error")
+	require.Len(t, schedule, 2, "intervals without volume and candles outside the profile should be skipped")
+	assert.Equal(t, start, schedule[0].at)
+	assert.InDelta(t, 2, schedule[0].amount, 1e-9)
+	assert.Equal(t, start.Add(time.Hour*2), schedule[1].at)
+	assert.InDelta(t, 8, schedule[1].amount, 1e-9)
+
+	twap := getTWAPSchedule(start, 1, time.Hour, 4)
+	require.Len(t, twap, 4)
+	assert.Equal(t, start.Add(time.Minute*45), twap[3].at)
+	assert.Equal(t, 0.25, twap[3].amount)
+}
+
+func TestAlgoIceberg(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ALGOICE")
+	a, m := algoSetup(t, pair)
+	ao, err := a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Sell, order.Limit, 2.5, 100), AlgoParameters{Type: IcebergAlgo, VisibleAmount: 1})
+	require.NoError(t, err, "SubmitAlgo must not error")
+
+	a.processAlgoOrders(context.Background())
+	a.processAlgoOrders(context.Background())
+	resp := getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 1, "only one child order should rest at a time")
+
+	fillChildOrders(t, a, m, ao.ID)
+	resp = getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 2, "a filled child order should be replaced")
+	fillChildOrders(t, a, m, ao.ID)
+	resp = getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 3)
+	last, err := m.GetByExchangeAndID(testExchange, resp.ChildOrderIDs[2])
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.InDelta(t, 0.5, last.Amount, 1e-9, "the last child order should be the remaining amount")
+
+	fillChildOrders(t, a, m, ao.ID)
+	resp = getAlgoOrder(t, a, ao.ID)
+	assert.Equal(t, AlgoCompleted, resp.Status)
+	assert.InDelta(t, 2.5, resp.FilledAmount, 1e-9)
+}
+
+func TestAlgoChase(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ALGOCHASE")
+	a, m := algoSetup(t, pair)
+	ao, err := a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Limit, 1, 101), AlgoParameters{Type: ChaseAlgo})
+	require.NoError(t, err, "SubmitAlgo must not error")
+	a.processAlgoOrders(context.Background())
+	resp := getAlgoOrder(t, a, ao.ID)
+	assert.Empty(t, resp.ChildOrderIDs, "no child order should be placed without an orderbook")
+
+	processRoutingOrderbook(t, testExchange, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 102, Amount: 1}})
+	a.processAlgoOrders(context.Background())
+	resp = getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 1)
+	child, err := m.GetByExchangeAndID(testExchange, resp.ChildOrderIDs[0])
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.Equal(t, 99.0, child.Price, "child order should rest at the best bid")
+
+	processRoutingOrderbook(t, testExchange, pair, orderbook.Tranches{{Price: 100, Amount: 1}}, orderbook.Tranches{{Price: 102, Amount: 1}})
+	a.processAlgoOrders(context.Background())
+	resp = getAlgoOrder(t, a, ao.ID)
+	require.Len(t, resp.ChildOrderIDs, 1, "re-pricing should not add a child order")
+	assert.NotEqual(t, child.OrderID, resp.ChildOrderIDs[0], "a replaced order ID should be tracked")
+	child, err = m.GetByExchangeAndID(testExchange, resp.ChildOrderIDs[0])
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.Equal(t, 100.0, child.Price, "child order should be re-priced to the best bid")
+
+	processRoutingOrderbook(t, testExchange, pair, orderbook.Tranches{{Price: 101.5, Amount: 1}}, orderbook.Tranches{{Price: 102, Amount: 1}})
+	a.processAlgoOrders(context.Background())
+	resp = getAlgoOrder(t, a, ao.ID)
+	child, err = m.GetByExchangeAndID(testExchange, resp.ChildOrderIDs[0])
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.Equal(t, 101.0, child.Price, "child order should not be re-priced beyond the limit price")
+
+	children, err := m.GetChildOrders(ao.ID)
+	require.NoError(t, err, "GetChildOrders must not error")
+	require.Len(t, children, 1, "replaced child orders should no longer be returned")
+	assert.Equal(t, child.OrderID, children[0].OrderID, "the replacement child order should be linked to the algo order")
+}
+
+func TestCancelAlgo(t *testing.T) {
+	t.Parallel()
+	var a *AlgoExecutionManager
+	assert.ErrorIs(t, a.CancelAlgo(context.Background(), uuid.Nil), ErrNilSubsystem)
+	a = &AlgoExecutionManager{}
+	assert.ErrorIs(t, a.CancelAlgo(context.Background(), uuid.Nil), ErrSubSystemNotStarted)
+
+	pair := routingPair("ALGOCANCEL")
+	a, m := algoSetup(t, pair)
+	assert.ErrorIs(t, a.CancelAlgo(context.Background(), uuid.Nil), ErrAlgoOrderNotFound)
+	ao, err := a.SubmitAlgo(context.Background(), algoSubmit(pair, order.Buy, order.Limit, 3, 100), AlgoParameters{Type: IcebergAlgo, VisibleAmount: 1})
+	require.NoError(t, err, "SubmitAlgo must not error")
+	a.processAlgoOrders(context.Background())
+
+	require.NoError(t, a.CancelAlgo(context.Background(), ao.ID), "CancelAlgo must not error")
+	resp := getAlgoOrder(t, a, ao.ID)
+	assert.Equal(t, AlgoCancelled, resp.Status)
+	require.Len(t, resp.ChildOrderIDs, 1)
+	child, err := m.GetByExchangeAndID(testExchange, resp.ChildOrderIDs[0])
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.Equal(t, order.Cancelled, child.Status, "open child orders should be cancelled")
+	children, err := m.GetChildOrders(ao.ID)
+	require.NoError(t, err, "GetChildOrders must not error")
+	assert.Empty(t, children, "child order links should be removed once the algo order is cancelled")
+	assert.ErrorIs(t, a.CancelAlgo(context.Background(), ao.ID), ErrAlgoOrderNotRunning)
+
+	a.processAlgoOrders(context.Background())
+	resp = getAlgoOrder(t, a, ao.ID)
+	assert.Len(t, resp.ChildOrderIDs, 1, "a cancelled algo order should not submit child orders")
+}
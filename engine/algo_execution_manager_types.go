@@ -0,0 +1,143 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// AlgoExecutionManagerName is an exported subsystem name
+const AlgoExecutionManagerName = "algo_execution_manager"
+
+const (
+	defaultAlgoCheckInterval = time.Second
+	// vwapProfileLookback is how far back the volume profile of a VWAP order
+	// is taken from, so the profile covers the same time of day
+	vwapProfileLookback = time.Hour * 24
+	// algoAmountTolerance is the fraction of an algo order's amount which
+	// can remain unfilled due to floating point error
+	algoAmountTolerance = 1e-9
+)
+
+var (
+	// ErrAlgoOrderNotFound is returned when an algo order ID is not held by
+	// the algo execution manager
+	ErrAlgoOrderNotFound = errors.New("algo order does not exist")
+	// ErrAlgoOrderNotRunning is returned when attempting to cancel an algo
+	// order which has already completed, failed or been cancelled
+	ErrAlgoOrderNotRunning = errors.New("algo order is not running")
+
+	errAlgoTypeUnsupported  = errors.New("unsupported algo type")
+	errInvalidAlgoDuration  = errors.New("algo duration must be greater than zero")
+	errInvalidAlgoSlices    = errors.New("algo slices must be greater than zero")
+	errInvalidAlgoInterval  = errors.New("algo interval must be greater than zero and no longer than the duration")
+	errInvalidVisibleAmount = errors.New("visible amount must be greater than zero and no more than the order amount")
+	errAlgoChildOrderType   = errors.New("algo child orders must be market or limit orders")
+	errNoVolumeProfile      = errors.New("no volume available to build a volume profile")
+	errNilAlgoOrderManager  = errors.New("cannot start with nil order manager")
+)
+
+// AlgoType defines how an algo order is sliced into child orders
+type AlgoType uint8
+
+// Algo types
+const (
+	UnknownAlgo AlgoType = iota
+	// TWAPAlgo splits an order into equal child orders spread evenly over
+	// a duration
+	TWAPAlgo
+	// VWAPAlgo splits an order over a duration in proportion to the traded
+	// volume of the same period on the previous day
+	VWAPAlgo
+	// IcebergAlgo rests a single limit order of the visible amount at a time,
+	// replacing it once filled
+	IcebergAlgo
+	// ChaseAlgo rests a limit order at the best bid or ask, re-pricing it as
+	// the market moves until filled
+	ChaseAlgo
+)
+
+// AlgoStatus defines the lifecycle state of an algo order
+type AlgoStatus uint8
+
+// Algo order statuses
+const (
+	AlgoRunning AlgoStatus = iota
+	AlgoCompleted
+	AlgoCancelled
+	AlgoFailed
+)
+
+// AlgoParameters configures how an algo order is executed
+type AlgoParameters struct {
+	Type AlgoType
+	// Duration is the period TWAP and VWAP orders are executed over
+	Duration time.Duration
+	// Slices is the number of child orders a TWAP order is split into
+	Slices int
+	// Interval is the candle interval of a VWAP volume profile, with a child
+	// order submitted at the start of each interval
+	Interval kline.Interval
+	// VisibleAmount is the amount of each iceberg child order
+	VisibleAmount float64
+}
+
+// AlgoOrder is a parent order which is executed by the algo execution manager
+// as a series of child orders submitted through the order manager
+type AlgoOrder struct {
+	AlgoParameters
+	ID        uuid.UUID
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      order.Side
+	// OrderType is the type of TWAP and VWAP child orders. Iceberg and chase
+	// child orders are always limit orders
+	OrderType order.Type
+	// Price is the limit price of child orders. For chase orders it is the
+	// worst price the order will be re-priced to, with zero being unbounded
+	Price           float64
+	Amount          float64
+	SubmittedAmount float64
+	FilledAmount    float64
+	Status          AlgoStatus
+	ChildOrderIDs   []string
+	Error           string
+	CreatedAt       time.Time
+	LastUpdated     time.Time
+	// schedule holds the child orders of TWAP and VWAP orders
+	schedule  []algoSlice
+	nextSlice int
+	// carry is scheduled amount which could not be submitted as it was below
+	// the exchange's minimum amount, which is added to the next slice
+	carry float64
+	// activeChild is the resting iceberg or chase child order ID
+	activeChild string
+	submit      order.Submit
+}
+
+// algoSlice is a scheduled TWAP or VWAP child order
+type algoSlice struct {
+	at     time.Time
+	amount float64
+}
+
+// AlgoExecutionManager slices parent orders into child orders which are
+// submitted, tracked and re-priced through the order manager
+type AlgoExecutionManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	interval        time.Duration
+	verbose         bool
+	orderManager    iAlgoOrderManager
+	exchangeManager iExchangeManager
+	m               sync.Mutex
+	orders          map[uuid.UUID]*AlgoOrder
+}
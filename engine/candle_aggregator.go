@@ -0,0 +1,301 @@
+package engine
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupCandleAggregator creates a candle aggregator which builds candles at
+// the configured intervals for the enabled pairs of each exchange
+func SetupCandleAggregator(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.CandleAggregator) (*CandleAggregator, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w CandleAggregator", errNilConfig)
+	}
+	if len(cfg.Intervals) == 0 {
+		return nil, errNoCandleAggregatorIntervals
+	}
+	if cfg.SaveToDatabase && dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	intervals := make([]kline.Interval, 0, len(cfg.Intervals))
+	for i := range cfg.Intervals {
+		if cfg.Intervals[i].Duration() <= 0 {
+			return nil, fmt.Errorf("%w: %v", kline.ErrInvalidInterval, cfg.Intervals[i])
+		}
+		if !slices.Contains(intervals, cfg.Intervals[i]) {
+			intervals = append(intervals, cfg.Intervals[i])
+		}
+	}
+	maxCandles := cfg.MaxCandles
+	if maxCandles <= 0 {
+		maxCandles = defaultCandleAggregatorMaxCandles
+	}
+	return &CandleAggregator{
+		shutdown:                  make(chan struct{}),
+		verbose:                   cfg.Verbose,
+		saveToDatabase:            cfg.SaveToDatabase,
+		intervals:                 intervals,
+		maxCandles:                maxCandles,
+		exchangeManager:           em,
+		databaseConnectionManager: dcm,
+		candleSaver:               kline.StoreInDatabase,
+		mux:                       dispatch.GetNewMux(nil),
+		series:                    make(map[candleSeriesKey]*candleSeries),
+	}, nil
+}
+
+// Start starts building candles from received trades. Saving candles
+// requires a connected database
+func (c *CandleAggregator) Start() error {
+	if c == nil {
+		return fmt.Errorf("%s %w", CandleAggregatorName, ErrNilSubsystem)
+	}
+	if c.saveToDatabase {
+		if db := c.databaseConnectionManager.GetInstance(); db == nil || !db.IsConnected() {
+			return fmt.Errorf("%s %w", CandleAggregatorName, database.ErrDatabaseNotConnected)
+		}
+	}
+	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
+		return fmt.Errorf("%s %w", CandleAggregatorName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Trade, "Candle aggregator %s", MsgSubSystemStarting)
+	c.shutdown = make(chan struct{})
+	c.wg.Add(1)
+	go c.run()
+	log.Debugf(log.Trade, "Candle aggregator %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops building candles. Open candles are discarded as trades missed
+// while stopped would leave them incomplete
+func (c *CandleAggregator) Stop() error {
+	if c == nil {
+		return fmt.Errorf("%s %w", CandleAggregatorName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&c.started, 1, 0) {
+		return fmt.Errorf("%s %w", CandleAggregatorName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Trade, "Candle aggregator %s", MsgSubSystemShuttingDown)
+	close(c.shutdown)
+	c.wg.Wait()
+	c.m.Lock()
+	for _, s := range c.series {
+		s.builder.Reset()
+	}
+	c.m.Unlock()
+	log.Debugf(log.Trade, "Candle aggregator %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (c *CandleAggregator) IsRunning() bool {
+	return c != nil && atomic.LoadInt32(&c.started) == 1
+}
+
+// WebsocketDataHandler builds candles from trades received by the websocket
+// routine manager
+func (c *CandleAggregator) WebsocketDataHandler(service string, data any) error {
+	if !c.IsRunning() {
+		return nil
+	}
+	switch d := data.(type) {
+	case trade.Data:
+		c.processTrades(service, d)
+	case []trade.Data:
+		c.processTrades(service, d...)
+	}
+	return nil
+}
+
+// SubscribeCandles returns a pipe which receives a *kline.Item holding the
+// candles closed for an exchange pair at the provided interval
+func (c *CandleAggregator) SubscribeCandles(exchangeName string, cp currency.Pair, a asset.Item, interval kline.Interval) (dispatch.Pipe, error) {
+	if c == nil {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", CandleAggregatorName, ErrNilSubsystem)
+	}
+	c.m.Lock()
+	s, err := c.getSeries(exchangeName, cp, a, interval)
+	c.m.Unlock()
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return c.mux.Subscribe(s.id)
+}
+
+// GetCandles returns the closed candles retained for an exchange pair at the
+// provided interval
+func (c *CandleAggregator) GetCandles(exchangeName string, cp currency.Pair, a asset.Item, interval kline.Interval) (*kline.Item, error) {
+	if c == nil {
+		return nil, fmt.Errorf("%s %w", CandleAggregatorName, ErrNilSubsystem)
+	}
+	if !slices.Contains(c.intervals, interval) {
+		return nil, fmt.Errorf("%w: %v", errIntervalNotAggregated, interval)
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	s, ok := c.series[newCandleSeriesKey(exchangeName, cp, a, interval)]
+	if !ok || len(s.item.Candles) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v %v", errNoAggregatedCandles, exchangeName, a, cp, interval)
+	}
+	resp := *s.item
+	resp.Candles = slices.Clone(s.item.Candles)
+	return &resp, nil
+}
+
+func (c *CandleAggregator) run() {
+	defer c.wg.Done()
+	t := time.NewTicker(defaultCandleAggregatorCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-t.C:
+			c.closeCandles(time.Now().Add(-candleCloseGracePeriod))
+		}
+	}
+}
+
+// processTrades adds trades to the candles of their enabled pair at every
+// interval, handling any candles closed by them
+func (c *CandleAggregator) processTrades(service string, trades ...trade.Data) {
+	var closed []closedCandles
+	c.m.Lock()
+	for i := range trades {
+		exch := trades[i].Exchange
+		if exch == "" {
+			exch = service
+		}
+		for j := range c.intervals {
+			s, err := c.getSeries(exch, trades[i].CurrencyPair, trades[i].AssetType, c.intervals[j])
+			if err != nil {
+				if c.verbose {
+					log.Debugf(log.Trade, "Candle aggregator ignoring %v %v %v trade: %v", exch, trades[i].AssetType, trades[i].CurrencyPair, err)
+				}
+				break
+			}
+			if cc, ok := s.appendCandles(s.builder.AddTrade(trades[i].Price, trades[i].Amount, trades[i].Timestamp), c.maxCandles); ok {
+				closed = append(closed, cc)
+			}
+		}
+	}
+	c.m.Unlock()
+	c.handleClosedCandles(closed)
+}
+
+// closeCandles closes all candles which ended before the provided time
+func (c *CandleAggregator) closeCandles(until time.Time) {
+	var closed []closedCandles
+	c.m.Lock()
+	for _, s := range c.series {
+		if cc, ok := s.appendCandles(s.builder.Close(until), c.maxCandles); ok {
+			closed = append(closed, cc)
+		}
+	}
+	c.m.Unlock()
+	c.handleClosedCandles(closed)
+}
+
+// handleClosedCandles publishes closed candles to their subscribers and saves
+// them to the database when enabled
+func (c *CandleAggregator) handleClosedCandles(closed []closedCandles) {
+	for i := range closed {
+		item := closed[i].item
+		if err := c.mux.Publish(item, closed[i].id); err != nil {
+			log.Errorf(log.Trade, "Candle aggregator unable to publish %v %v %v %v candles: %v", item.Exchange, item.Asset, item.Pair, item.Interval, err)
+		}
+		if !c.saveToDatabase {
+			continue
+		}
+		if _, err := c.candleSaver(item, false); err != nil {
+			log.Errorf(log.Trade, "Candle aggregator unable to save %v %v %v %v candles: %v", item.Exchange, item.Asset, item.Pair, item.Interval, err)
+		}
+	}
+}
+
+// getSeries returns the candle series of an enabled exchange pair at a
+// configured interval, creating it if required. Must be called with the lock
+// held
+func (c *CandleAggregator) getSeries(exchangeName string, cp currency.Pair, a asset.Item, interval kline.Interval) (*candleSeries, error) {
+	if !slices.Contains(c.intervals, interval) {
+		return nil, fmt.Errorf("%w: %v", errIntervalNotAggregated, interval)
+	}
+	k := newCandleSeriesKey(exchangeName, cp, a, interval)
+	if s, ok := c.series[k]; ok {
+		return s, nil
+	}
+	exch, err := c.exchangeManager.GetExchangeByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	enabled, err := exch.IsPairEnabled(cp, a)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return nil, fmt.Errorf("%w %v %v", currency.ErrPairNotEnabled, a, cp)
+	}
+	b, err := kline.NewBuilder(interval)
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.mux.GetID()
+	if err != nil {
+		return nil, err
+	}
+	s := &candleSeries{
+		id:      id,
+		builder: b,
+		item: &kline.Item{
+			Exchange: exch.GetName(),
+			Pair:     cp,
+			Asset:    a,
+			Interval: interval,
+		},
+	}
+	c.series[k] = s
+	return s, nil
+}
+
+// appendCandles adds closed candles to the rolling series, trimming it to
+// the max candles retained. It returns the closed candles to be published
+func (s *candleSeries) appendCandles(candles []kline.Candle, maxCandles int) (closedCandles, bool) {
+	if len(candles) == 0 {
+		return closedCandles{}, false
+	}
+	s.item.Candles = append(s.item.Candles, candles...)
+	if excess := len(s.item.Candles) - maxCandles; excess > 0 {
+		s.item.Candles = slices.Delete(s.item.Candles, 0, excess)
+	}
+	item := *s.item
+	item.Candles = candles
+	return closedCandles{id: s.id, item: &item}, true
+}
+
+func newCandleSeriesKey(exchangeName string, cp currency.Pair, a asset.Item, interval kline.Interval) candleSeriesKey {
+	return candleSeriesKey{
+		ExchangePairAsset: key.ExchangePairAsset{
+			Exchange: strings.ToLower(exchangeName),
+			Base:     cp.Base.Item,
+			Quote:    cp.Quote.Item,
+			Asset:    a,
+		},
+		Interval: interval,
+	}
+}
@@ -0,0 +1,188 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var errCandleSaverTest = errors.New("candle saver test error")
+
+// fakeCandleSaver records the candles saved by the candle aggregator
+type fakeCandleSaver struct {
+	m     sync.Mutex
+	err   error
+	saved []*kline.Item
+}
+
+func (f *fakeCandleSaver) save(item *kline.Item, _ bool) (uint64, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.saved = append(f.saved, item)
+	return uint64(len(item.Candles)), f.err
+}
+
+func candleAggregatorSetup(t *testing.T, cfg *config.CandleAggregator) (*CandleAggregator, currency.Pair) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	b := exch.GetBase()
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		Available:     currency.Pairs{cp},
+		Enabled:       currency.Pairs{cp},
+		AssetEnabled:  true,
+		ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		RequestFormat: &currency.PairFormat{Uppercase: true},
+	}
+	require.NoError(t, em.Add(exch), "Add must not error")
+	c, err := SetupCandleAggregator(em, &DatabaseConnectionManager{}, cfg)
+	require.NoError(t, err, "SetupCandleAggregator must not error")
+	return c, cp
+}
+
+func TestSetupCandleAggregator(t *testing.T) {
+	t.Parallel()
+	_, err := SetupCandleAggregator(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupCandleAggregator(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupCandleAggregator(NewExchangeManager(), nil, &config.CandleAggregator{})
+	assert.ErrorIs(t, err, errNoCandleAggregatorIntervals)
+	_, err = SetupCandleAggregator(NewExchangeManager(), nil, &config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin}, SaveToDatabase: true})
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+	_, err = SetupCandleAggregator(NewExchangeManager(), nil, &config.CandleAggregator{Intervals: []kline.Interval{0}})
+	assert.ErrorIs(t, err, kline.ErrInvalidInterval)
+
+	c, err := SetupCandleAggregator(NewExchangeManager(), nil, &config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin, kline.FiveMin, kline.OneMin}})
+	require.NoError(t, err, "SetupCandleAggregator must not error")
+	assert.Equal(t, []kline.Interval{kline.OneMin, kline.FiveMin}, c.intervals, "duplicate intervals should be removed")
+	assert.Equal(t, defaultCandleAggregatorMaxCandles, c.maxCandles)
+}
+
+func TestCandleAggregatorStartStop(t *testing.T) {
+	t.Parallel()
+	var c *CandleAggregator
+	assert.ErrorIs(t, c.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, c.Stop(), ErrNilSubsystem)
+	assert.False(t, c.IsRunning())
+
+	c, _ = candleAggregatorSetup(t, &config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin}})
+	assert.ErrorIs(t, c.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, c.Start(), "Start must not error")
+	assert.True(t, c.IsRunning())
+	assert.ErrorIs(t, c.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, c.Stop(), "Stop must not error")
+	assert.False(t, c.IsRunning())
+
+	c.saveToDatabase = true
+	assert.ErrorIs(t, c.Start(), database.ErrDatabaseNotConnected)
+	assert.False(t, c.IsRunning(), "a failed Start should not leave the subsystem running")
+}
+
+func TestCandleAggregatorWebsocketDataHandler(t *testing.T) {
+	t.Parallel()
+	c, cp := candleAggregatorSetup(t, &config.CandleAggregator{
+		Intervals:  []kline.Interval{kline.OneMin, kline.OneHour},
+		MaxCandles: 2,
+	})
+	saver := &fakeCandleSaver{err: errCandleSaverTest}
+	c.candleSaver = saver.save
+	c.saveToDatabase = true
+	// trades are timestamped in the past and closed manually so the check
+	// ticker is not started
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	trades := []trade.Data{
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 100, Amount: 1, Timestamp: start},
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 105, Amount: 2, Timestamp: start.Add(time.Second)},
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Futures, Price: 1, Amount: 1, Timestamp: start.Add(time.Second)},
+		{Exchange: testExchange, CurrencyPair: currency.NewPair(currency.ETH, currency.USDT), AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: start.Add(time.Second)},
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 95, Amount: 1, Timestamp: start.Add(time.Second * 2)},
+	}
+	require.NoError(t, c.WebsocketDataHandler(testExchange, trades), "WebsocketDataHandler must not error when not running")
+	assert.Empty(t, c.series, "trades should be ignored when not running")
+
+	c.started = 1
+	require.NoError(t, c.WebsocketDataHandler(testExchange, trades), "WebsocketDataHandler must not error")
+	assert.Len(t, c.series, 2, "series should only be created for enabled pairs at each interval")
+	require.NoError(t, c.WebsocketDataHandler(testExchange, trade.Data{
+		CurrencyPair: cp,
+		AssetType:    asset.Spot,
+		Price:        110,
+		Amount:       1,
+		Timestamp:    start.Add(time.Minute),
+	}), "WebsocketDataHandler must not error")
+
+	item, err := c.GetCandles("BITSTAMP", cp, asset.Spot, kline.OneMin)
+	require.NoError(t, err, "GetCandles must not error")
+	assert.Equal(t, testExchange, item.Exchange)
+	assert.Equal(t, kline.OneMin, item.Interval)
+	require.Len(t, item.Candles, 1, "a trade in the next interval must close the candle")
+	assert.Equal(t, kline.Candle{Time: start, Open: 100, High: 105, Low: 95, Close: 95, Volume: 4}, item.Candles[0])
+	_, err = c.GetCandles(testExchange, cp, asset.Spot, kline.OneHour)
+	assert.ErrorIs(t, err, errNoAggregatedCandles)
+	_, err = c.GetCandles(testExchange, cp, asset.Spot, kline.FiveMin)
+	assert.ErrorIs(t, err, errIntervalNotAggregated)
+
+	c.closeCandles(start.Add(time.Minute * 3))
+	item, err = c.GetCandles(testExchange, cp, asset.Spot, kline.OneMin)
+	require.NoError(t, err, "GetCandles must not error")
+	require.Len(t, item.Candles, 2, "candles must be trimmed to the max candles")
+	assert.Equal(t, kline.Candle{Time: start.Add(time.Minute), Open: 110, High: 110, Low: 110, Close: 110, Volume: 1}, item.Candles[0])
+	assert.Equal(t, kline.Candle{Time: start.Add(time.Minute * 2), Open: 110, High: 110, Low: 110, Close: 110}, item.Candles[1])
+
+	saver.m.Lock()
+	defer saver.m.Unlock()
+	require.Len(t, saver.saved, 2, "closed candles must be saved, regardless of save errors")
+	assert.Len(t, saver.saved[0].Candles, 1)
+	assert.Len(t, saver.saved[1].Candles, 2)
+}
+
+func TestSubscribeCandles(t *testing.T) {
+	t.Parallel()
+	var c *CandleAggregator
+	_, err := c.SubscribeCandles(testExchange, currency.EMPTYPAIR, asset.Spot, kline.OneMin)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	c, cp := candleAggregatorSetup(t, &config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin}})
+	_, err = c.SubscribeCandles(testExchange, cp, asset.Spot, kline.OneHour)
+	assert.ErrorIs(t, err, errIntervalNotAggregated)
+	_, err = c.SubscribeCandles(testExchange, currency.NewPair(currency.ETH, currency.USDT), asset.Spot, kline.OneMin)
+	assert.ErrorIs(t, err, currency.ErrPairNotEnabled)
+	_, err = c.SubscribeCandles("bad", cp, asset.Spot, kline.OneMin)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+	assert.Empty(t, c.series, "series should not be created for invalid subscriptions")
+}
+
+func TestCandleAggregatorGetSeries(t *testing.T) {
+	t.Parallel()
+	c, cp := candleAggregatorSetup(t, &config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin}})
+	s, err := c.getSeries("BITSTAMP", currency.NewPairWithDelimiter("BTC", "USDT", "-"), asset.Spot, kline.OneMin)
+	require.NoError(t, err, "getSeries must not error")
+	assert.False(t, s.id.IsNil(), "series should be assigned a dispatch ID")
+	assert.Equal(t, testExchange, s.item.Exchange, "series should use the exchange name")
+	s2, err := c.getSeries(testExchange, cp, asset.Spot, kline.OneMin)
+	require.NoError(t, err, "getSeries must not error")
+	assert.Same(t, s, s2, "getSeries should return the existing series")
+
+	closed, ok := s.appendCandles(nil, c.maxCandles)
+	assert.False(t, ok, "appendCandles should not return closed candles without candles")
+	assert.Nil(t, closed.item)
+	closed, ok = s.appendCandles([]kline.Candle{{Close: 1}}, c.maxCandles)
+	require.True(t, ok, "appendCandles must return closed candles")
+	assert.Equal(t, s.id, closed.id)
+	assert.Len(t, closed.item.Candles, 1)
+}
@@ -0,0 +1,66 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// CandleAggregatorName is an exported subsystem name
+const CandleAggregatorName = "candle_aggregator"
+
+const (
+	defaultCandleAggregatorCheckInterval = time.Second
+	defaultCandleAggregatorMaxCandles    = 1000
+)
+
+var (
+	errNoCandleAggregatorIntervals = errors.New("no candle aggregator intervals configured")
+	errIntervalNotAggregated       = errors.New("interval is not aggregated")
+	errNoAggregatedCandles         = errors.New("no aggregated candles found")
+)
+
+// CandleAggregator builds candles from the websocket trade feed for every
+// enabled pair at each configured interval. Closed candles are retained in a
+// rolling series, published over dispatch and optionally saved to the
+// database
+type CandleAggregator struct {
+	started                   int32
+	verbose                   bool
+	saveToDatabase            bool
+	intervals                 []kline.Interval
+	maxCandles                int
+	exchangeManager           iExchangeManager
+	databaseConnectionManager iDatabaseConnectionManager
+	candleSaver               func(*kline.Item, bool) (uint64, error)
+	mux                       *dispatch.Mux
+	shutdown                  chan struct{}
+	wg                        sync.WaitGroup
+	m                         sync.Mutex
+	series                    map[candleSeriesKey]*candleSeries
+}
+
+// candleSeriesKey identifies the candles of an exchange pair at an interval
+type candleSeriesKey struct {
+	key.ExchangePairAsset
+	Interval kline.Interval
+}
+
+// candleSeries holds the open candle being built and the rolling closed
+// candles of an exchange pair at an interval
+type candleSeries struct {
+	id      uuid.UUID
+	builder *kline.Builder
+	item    *kline.Item
+}
+
+// closedCandles are candles closed for a series awaiting publishing
+type closedCandles struct {
+	id   uuid.UUID
+	item *kline.Item
+}
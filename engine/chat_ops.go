@@ -0,0 +1,251 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// chatOpsOrderLookbackMonths is how far back open orders are requested from
+// the exchange
+const chatOpsOrderLookbackMonths = 1
+
+var errChatOpsInvalidArguments = errors.New("invalid command arguments")
+
+// chatOps handles the commands sent to the comms relayers for querying and
+// controlling the engine. Commands are dispatched through the RPC server so
+// they follow the same paths as gRPC clients
+type chatOps struct {
+	rpc *RPCServer
+}
+
+// setupChatOps registers the chat-ops commands with the communications
+// manager
+func (bot *Engine) setupChatOps(comms *CommunicationManager) error {
+	c := &chatOps{rpc: &RPCServer{Engine: bot}}
+	for _, cmd := range c.commands() {
+		if err := comms.RegisterCommand(cmd); err != nil {
+			return err
+		}
+	}
+	log.Debugln(log.CommunicationMgr, "Communications manager chat-ops commands registered")
+	return nil
+}
+
+// commands returns the chat-ops commands
+func (c *chatOps) commands() []*CommsCommand {
+	return []*CommsCommand{
+		{Name: "balances", Usage: "[exchange] [asset]", Description: "Shows account balances, defaulting to spot on all authenticated exchanges", Handler: c.balances},
+		{Name: "orders", Usage: "<exchange> <asset> <pair>", Description: "Shows open orders", Handler: c.orders},
+		{Name: "positions", Description: "Shows open futures positions tracked by the order manager", Handler: c.positions},
+		{Name: "cancelorder", Usage: "<exchange> <asset> <pair> <order id> [side]", Description: "Cancels an order", Confirm: true, Handler: c.cancelOrder},
+		{Name: "cancelallorders", Usage: "<exchange>", Description: "Cancels all orders on an exchange", Confirm: true, Handler: c.cancelAllOrders},
+		{Name: "pausesync", Description: "Disables the exchange sync manager", Handler: c.setSync(false)},
+		{Name: "resumesync", Description: "Enables the exchange sync manager", Handler: c.setSync(true)},
+		{Name: "enableexchange", Usage: "<exchange>", Description: "Loads an exchange", Handler: c.setExchange(true)},
+		{Name: "disableexchange", Usage: "<exchange>", Description: "Unloads an exchange", Confirm: true, Handler: c.setExchange(false)},
+		{Name: "killswitch", Description: "Engages the risk kill switch, cancelling all orders on every exchange", Confirm: true, Handler: c.setKillSwitch(true)},
+		{Name: "releasekillswitch", Description: "Releases the risk kill switch", Confirm: true, Handler: c.setKillSwitch(false)},
+	}
+}
+
+func (c *chatOps) balances(_, _ string, args []string) (string, error) {
+	exchanges := c.rpc.GetAuthAPISupportedExchanges()
+	if len(args) > 0 {
+		exchanges = []string{args[0]}
+	}
+	assetType := asset.Spot.String()
+	if len(args) > 1 {
+		assetType = args[1]
+	}
+	if len(exchanges) == 0 {
+		return "No authenticated exchanges loaded", nil
+	}
+	var sb strings.Builder
+	for i, exch := range exchanges {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		resp, err := c.rpc.GetAccountInfo(context.Background(), &gctrpc.GetAccountInfoRequest{Exchange: exch, AssetType: assetType})
+		if err != nil {
+			fmt.Fprintf(&sb, "%s %s: %s", exch, assetType, err)
+			continue
+		}
+		fmt.Fprintf(&sb, "%s %s:", exch, assetType)
+		var found bool
+		for _, acc := range resp.Accounts {
+			for _, bal := range acc.Currencies {
+				if bal.TotalValue == 0 {
+					continue
+				}
+				found = true
+				fmt.Fprintf(&sb, "\n  %s total %v free %v hold %v", bal.Currency, bal.TotalValue, bal.Free, bal.Hold)
+			}
+		}
+		if !found {
+			sb.WriteString(" no balances")
+		}
+	}
+	return sb.String(), nil
+}
+
+func (c *chatOps) orders(_, _ string, args []string) (string, error) {
+	if len(args) < 3 {
+		return "", fmt.Errorf("%w, usage: orders <exchange> <asset> <pair>", errChatOpsInvalidArguments)
+	}
+	pair, err := chatOpsPair(args[2])
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	resp, err := c.rpc.GetOrders(context.Background(), &gctrpc.GetOrdersRequest{
+		Exchange:  args[0],
+		AssetType: args[1],
+		Pair:      pair,
+		StartDate: now.AddDate(0, -chatOpsOrderLookbackMonths, 0).Format(common.SimpleTimeFormatWithTimezone),
+		EndDate:   now.Format(common.SimpleTimeFormatWithTimezone),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(resp.Orders) == 0 {
+		return fmt.Sprintf("No open %s %s %s orders", args[0], args[1], args[2]), nil
+	}
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Open %s %s %s orders:", args[0], args[1], args[2])
+	for _, o := range resp.Orders {
+		fmt.Fprintf(&sb, "\n  %s %s %s %v @ %v %s", o.Id, o.OrderSide, o.OrderType, o.Amount, o.Price, o.Status)
+	}
+	return sb.String(), nil
+}
+
+func (c *chatOps) positions(_, _ string, _ []string) (string, error) {
+	resp, err := c.rpc.GetAllManagedPositions(context.Background(), &gctrpc.GetAllManagedPositionsRequest{})
+	if err != nil && !errors.Is(err, futures.ErrNoPositionsFound) {
+		return "", err
+	}
+	if resp == nil || len(resp.Positions) == 0 {
+		return "No open positions", nil
+	}
+	var sb strings.Builder
+	sb.WriteString("Open positions:")
+	for _, p := range resp.Positions {
+		var pair string
+		if p.Pair != nil {
+			pair = p.Pair.Base + p.Pair.Delimiter + p.Pair.Quote
+		}
+		fmt.Fprintf(&sb, "\n  %s %s %s %s size %s unrealised PnL %s", p.Exchange, p.Asset, pair, p.CurrentDirection, p.CurrentSize, p.UnrealisedPnl)
+	}
+	return sb.String(), nil
+}
+
+func (c *chatOps) cancelOrder(relayer, user string, args []string) (string, error) {
+	if len(args) < 4 {
+		return "", fmt.Errorf("%w, usage: cancelorder <exchange> <asset> <pair> <order id> [side]", errChatOpsInvalidArguments)
+	}
+	pair, err := chatOpsPair(args[2])
+	if err != nil {
+		return "", err
+	}
+	side := order.AnySide.String()
+	if len(args) > 4 {
+		side = args[4]
+	}
+	if _, err := c.rpc.CancelOrder(context.Background(), &gctrpc.CancelOrderRequest{
+		Exchange:  args[0],
+		AssetType: args[1],
+		Pair:      pair,
+		OrderId:   args[3],
+		Side:      side,
+	}); err != nil {
+		return "", err
+	}
+	log.Infof(log.CommunicationMgr, "Order %s on %s cancelled by %s user %s", args[3], args[0], relayer, user)
+	return fmt.Sprintf("Order %s on %s cancelled", args[3], args[0]), nil
+}
+
+func (c *chatOps) cancelAllOrders(relayer, user string, args []string) (string, error) {
+	if len(args) < 1 {
+		return "", fmt.Errorf("%w, usage: cancelallorders <exchange>", errChatOpsInvalidArguments)
+	}
+	resp, err := c.rpc.CancelAllOrders(context.Background(), &gctrpc.CancelAllOrdersRequest{Exchange: args[0]})
+	if err != nil {
+		return "", err
+	}
+	log.Infof(log.CommunicationMgr, "All orders on %s cancelled by %s user %s", args[0], relayer, user)
+	return fmt.Sprintf("Cancelled %d orders on %s", resp.Count, args[0]), nil
+}
+
+func (c *chatOps) setSync(enable bool) CommandHandler {
+	return func(relayer, user string, _ []string) (string, error) {
+		req := &gctrpc.GenericSubsystemRequest{Subsystem: SyncManagerName}
+		var resp *gctrpc.GenericResponse
+		var err error
+		if enable {
+			resp, err = c.rpc.EnableSubsystem(context.Background(), req)
+		} else {
+			resp, err = c.rpc.DisableSubsystem(context.Background(), req)
+		}
+		if err != nil {
+			return "", err
+		}
+		log.Infof(log.CommunicationMgr, "%s by %s user %s", resp.Data, relayer, user)
+		return resp.Data, nil
+	}
+}
+
+func (c *chatOps) setExchange(enable bool) CommandHandler {
+	return func(relayer, user string, args []string) (string, error) {
+		if len(args) < 1 {
+			return "", fmt.Errorf("%w, exchange name required", errChatOpsInvalidArguments)
+		}
+		req := &gctrpc.GenericExchangeNameRequest{Exchange: args[0]}
+		action := "enabled"
+		var err error
+		if enable {
+			_, err = c.rpc.EnableExchange(context.Background(), req)
+		} else {
+			action = "disabled"
+			_, err = c.rpc.DisableExchange(context.Background(), req)
+		}
+		if err != nil {
+			return "", err
+		}
+		log.Infof(log.CommunicationMgr, "Exchange %s %s by %s user %s", args[0], action, relayer, user)
+		return fmt.Sprintf("Exchange %s %s", args[0], action), nil
+	}
+}
+
+func (c *chatOps) setKillSwitch(engage bool) CommandHandler {
+	return func(relayer, user string, _ []string) (string, error) {
+		resp, err := c.rpc.SetRiskKillSwitch(context.Background(), &gctrpc.SetRiskKillSwitchRequest{Engaged: engage})
+		if err != nil {
+			return "", err
+		}
+		log.Warnf(log.CommunicationMgr, "%s by %s user %s", resp.Data, relayer, user)
+		return resp.Data, nil
+	}
+}
+
+// chatOpsPair parses a currency pair sent in a command
+func chatOpsPair(s string) (*gctrpc.CurrencyPair, error) {
+	p, err := currency.NewPairFromString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: pair %q: %w", errChatOpsInvalidArguments, s, err)
+	}
+	return &gctrpc.CurrencyPair{
+		Delimiter: p.Delimiter,
+		Base:      p.Base.String(),
+		Quote:     p.Quote.String(),
+	}, nil
+}
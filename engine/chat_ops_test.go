@@ -0,0 +1,98 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func setupChatOpsTest(t *testing.T) (*CommunicationManager, *OrderManager) {
+	t.Helper()
+	om, _ := riskSetup(t)
+	em, ok := om.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok, "exchange manager must be an *ExchangeManager")
+	bot := &Engine{ExchangeManager: em, OrderManager: om, Config: &config.Config{}}
+	m, err := SetupCommunicationManager(&base.CommunicationsConfig{
+		SMSGlobalConfig: base.SMSGlobalConfig{Enabled: true},
+		ChatOps: base.ChatOpsConfig{
+			Enabled:         true,
+			AuthorisedUsers: map[string][]string{"telegram": {"satoshi"}},
+		},
+	})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+	require.NoError(t, bot.setupChatOps(m), "setupChatOps must not error")
+	return m, om
+}
+
+// confirmChatOpsCommand sends a command requiring confirmation and confirms it
+func confirmChatOpsCommand(t *testing.T, m *CommunicationManager, text string) (string, error) {
+	t.Helper()
+	_, err := m.handleCommand("telegram", "satoshi", text)
+	require.NoError(t, err, "handleCommand must not error")
+	pending := m.confirmations[confirmationKey("telegram", "satoshi")]
+	require.NotNil(t, pending, "command must await confirmation")
+	return m.handleCommand("telegram", "satoshi", "/confirm "+pending.code)
+}
+
+func TestSetupChatOps(t *testing.T) {
+	t.Parallel()
+	m, _ := setupChatOpsTest(t)
+	assert.ErrorIs(t, (&Engine{}).setupChatOps(m), errCommandAlreadyDefined, "commands should only be registered once")
+
+	reply, err := m.handleCommand("telegram", "satoshi", "/commands")
+	require.NoError(t, err, "handleCommand must not error")
+	for _, cmd := range (&chatOps{}).commands() {
+		assert.Contains(t, reply, cmd.Name, "commands should list every chat-ops command")
+	}
+	_, err = m.handleCommand("telegram", "mallory", "/balances")
+	assert.ErrorIs(t, err, errCommandUnauthorised)
+}
+
+func TestChatOpsQueries(t *testing.T) {
+	t.Parallel()
+	m, _ := setupChatOpsTest(t)
+
+	reply, err := m.handleCommand("telegram", "satoshi", "/balances unknown")
+	require.NoError(t, err, "balances must not error")
+	assert.Contains(t, reply, "unknown spot: ", "balances should report per exchange errors")
+	assert.Contains(t, reply, ErrExchangeNotFound.Error(), "balances should report per exchange errors")
+
+	_, err = m.handleCommand("telegram", "satoshi", "/orders "+testExchange)
+	assert.ErrorIs(t, err, errChatOpsInvalidArguments)
+	_, err = m.handleCommand("telegram", "satoshi", "/orders "+testExchange+" spot b")
+	assert.ErrorIs(t, err, errChatOpsInvalidArguments)
+
+	_, err = m.handleCommand("telegram", "satoshi", "/positions")
+	assert.ErrorIs(t, err, errFuturesTrackingDisabled)
+}
+
+func TestChatOpsControls(t *testing.T) {
+	t.Parallel()
+	m, om := setupChatOpsTest(t)
+
+	reply, err := confirmChatOpsCommand(t, m, "/killswitch")
+	require.NoError(t, err, "killswitch must not error")
+	assert.Equal(t, "risk kill switch engaged", reply)
+	assert.True(t, om.IsKillSwitchEngaged(), "kill switch should be engaged")
+
+	reply, err = confirmChatOpsCommand(t, m, "/releasekillswitch")
+	require.NoError(t, err, "releasekillswitch must not error")
+	assert.Equal(t, "risk kill switch released", reply)
+	assert.False(t, om.IsKillSwitchEngaged(), "kill switch should be released")
+
+	_, err = confirmChatOpsCommand(t, m, "/cancelorder "+testExchange)
+	assert.ErrorIs(t, err, errChatOpsInvalidArguments)
+	_, err = confirmChatOpsCommand(t, m, "/cancelallorders")
+	assert.ErrorIs(t, err, errChatOpsInvalidArguments)
+
+	_, err = m.handleCommand("telegram", "satoshi", "/enableexchange")
+	assert.ErrorIs(t, err, errChatOpsInvalidArguments)
+	_, err = confirmChatOpsCommand(t, m, "/disableexchange unknown")
+	assert.ErrorIs(t, err, config.ErrExchangeNotFound)
+
+	_, err = m.handleCommand("telegram", "satoshi", "/pausesync")
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+}
@@ -1,9 +1,16 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -18,22 +25,75 @@ type CommunicationManager struct {
 	shutdown chan struct{}
 	relayMsg chan base.Event
 	comms    *communications.Communications
+
+	chatOps       base.ChatOpsConfig
+	commands      map[string]*CommsCommand
+	confirmations map[string]*pendingCommand
+	commandMtx    sync.RWMutex
+}
+
+// CommandHandler handles a command received from a user on a comms relayer.
+// Args are the whitespace separated fields following the command name
+type CommandHandler func(relayer, user string, args []string) (string, error)
+
+// CommsCommand is a command which can be sent to the comms relayers
+type CommsCommand struct {
+	Name        string
+	Usage       string
+	Description string
+	// Confirm requires the user to reply with a confirmation code before the
+	// command is run
+	Confirm bool
+	Handler CommandHandler
+}
+
+// pendingCommand is a command awaiting confirmation from a user
+type pendingCommand struct {
+	command *CommsCommand
+	args    []string
+	code    string
+	expires time.Time
 }
 
+const (
+	commandsCommand                   = "commands"
+	confirmCommand                    = "confirm"
+	commandConfirmationCodeLength     = 6
+	defaultCommandConfirmationTimeout = time.Minute
+)
+
+var (
+	errCommandNameEmpty              = errors.New("command name cannot be empty")
+	errCommandHandlerNil             = errors.New("command handler cannot be nil")
+	errCommandAlreadyDefined         = errors.New("command already registered")
+	errCommandUnauthorised           = errors.New("not authorised to send commands")
+	errNoCommandAwaitingConfirmation = errors.New("no command awaiting confirmation")
+	errCommandConfirmationExpired    = errors.New("command confirmation expired")
+	errInvalidConfirmationCode       = errors.New("invalid confirmation code")
+)
+
 // SetupCommunicationManager creates a communications manager
 func SetupCommunicationManager(cfg *base.CommunicationsConfig) (*CommunicationManager, error) {
 	if cfg == nil {
 		return nil, errNilConfig
 	}
 	manager := &CommunicationManager{
-		shutdown: make(chan struct{}),
-		relayMsg: make(chan base.Event),
+		shutdown:      make(chan struct{}),
+		relayMsg:      make(chan base.Event),
+		chatOps:       cfg.ChatOps,
+		commands:      make(map[string]*CommsCommand),
+		confirmations: make(map[string]*pendingCommand),
+	}
+	manager.chatOps.AuthorisedUsers = maps.Clone(cfg.ChatOps.AuthorisedUsers)
+	if manager.chatOps.ConfirmationTimeout <= 0 {
+		manager.chatOps.ConfirmationTimeout = defaultCommandConfirmationTimeout
 	}
 	var err error
 	manager.comms, err = communications.NewComm(cfg)
 	if err != nil {
 		return nil, err
 	}
+	manager.comms.SetCommandHandler(manager.handleCommand)
 	return manager, nil
 }
 
@@ -112,3 +172,130 @@ func (m *CommunicationManager) run() {
 		}
 	}
 }
+
+// RegisterCommand registers a command sent to the comms relayers. Command
+// names are case insensitive and may be sent with a leading '/' or '!' to suit
+// the relayer
+func (m *CommunicationManager) RegisterCommand(cmd *CommsCommand) error {
+	if m == nil {
+		return fmt.Errorf("communications manager %w", ErrNilSubsystem)
+	}
+	if cmd == nil {
+		return fmt.Errorf("%w: command", common.ErrNilPointer)
+	}
+	name := strings.ToLower(strings.TrimLeft(cmd.Name, "/!"))
+	if name == "" {
+		return errCommandNameEmpty
+	}
+	if cmd.Handler == nil {
+		return fmt.Errorf("%w for %s", errCommandHandlerNil, name)
+	}
+	m.commandMtx.Lock()
+	defer m.commandMtx.Unlock()
+	if _, ok := m.commands[name]; ok || name == commandsCommand || name == confirmCommand {
+		return fmt.Errorf("%w: %s", errCommandAlreadyDefined, name)
+	}
+	c := *cmd
+	c.Name = name
+	m.commands[name] = &c
+	return nil
+}
+
+// handleCommand dispatches a command received from a comms relayer to its
+// registered handler once the user is authorised. Commands requiring
+// confirmation are held until the user replies with the confirmation code
+func (m *CommunicationManager) handleCommand(relayer, user, text string) (string, error) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", base.ErrUnknownCommand
+	}
+	name := strings.ToLower(strings.TrimLeft(fields[0], "/!"))
+	m.commandMtx.RLock()
+	cmd, ok := m.commands[name]
+	m.commandMtx.RUnlock()
+	if !ok && name != commandsCommand && name != confirmCommand {
+		return "", base.ErrUnknownCommand
+	}
+	if !m.chatOps.IsAuthorised(relayer, user) {
+		log.Warnf(log.CommunicationMgr, "Communications manager rejected command %q from unauthorised %s user %q", name, relayer, user)
+		return "", fmt.Errorf("%w: %s user %q", errCommandUnauthorised, relayer, user)
+	}
+	switch name {
+	case commandsCommand:
+		return m.listCommands(), nil
+	case confirmCommand:
+		return m.confirmCommand(relayer, user, fields[1:])
+	}
+	if !cmd.Confirm {
+		return cmd.Handler(relayer, user, fields[1:])
+	}
+	code, err := common.GenerateRandomString(commandConfirmationCodeLength)
+	if err != nil {
+		return "", err
+	}
+	m.commandMtx.Lock()
+	m.confirmations[confirmationKey(relayer, user)] = &pendingCommand{
+		command: cmd,
+		args:    fields[1:],
+		code:    code,
+		expires: time.Now().Add(m.chatOps.ConfirmationTimeout),
+	}
+	m.commandMtx.Unlock()
+	return fmt.Sprintf("Reply %s %s within %s to run: %s", confirmCommand, code, m.chatOps.ConfirmationTimeout, strings.Join(fields, " ")), nil
+}
+
+// confirmCommand runs the command awaiting confirmation from the user if the
+// code matches
+func (m *CommunicationManager) confirmCommand(relayer, user string, args []string) (string, error) {
+	key := confirmationKey(relayer, user)
+	m.commandMtx.Lock()
+	pending, ok := m.confirmations[key]
+	if !ok {
+		m.commandMtx.Unlock()
+		return "", errNoCommandAwaitingConfirmation
+	}
+	if time.Now().After(pending.expires) {
+		delete(m.confirmations, key)
+		m.commandMtx.Unlock()
+		return "", fmt.Errorf("%w: %s", errCommandConfirmationExpired, pending.command.Name)
+	}
+	if len(args) == 0 || args[0] != pending.code {
+		m.commandMtx.Unlock()
+		return "", fmt.Errorf("%w for %s", errInvalidConfirmationCode, pending.command.Name)
+	}
+	delete(m.confirmations, key)
+	m.commandMtx.Unlock()
+	log.Infof(log.CommunicationMgr, "Communications manager running command %q confirmed by %s user %q", pending.command.Name, relayer, user)
+	return pending.command.Handler(relayer, user, pending.args)
+}
+
+// listCommands returns the usage of the registered commands
+func (m *CommunicationManager) listCommands() string {
+	m.commandMtx.RLock()
+	defer m.commandMtx.RUnlock()
+	names := make([]string, 0, len(m.commands))
+	for name := range m.commands {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	var sb strings.Builder
+	sb.WriteString("Available commands:")
+	for _, name := range names {
+		cmd := m.commands[name]
+		sb.WriteString("\n")
+		sb.WriteString(strings.TrimSpace(name + " " + cmd.Usage))
+		if cmd.Description != "" {
+			sb.WriteString(" - ")
+			sb.WriteString(cmd.Description)
+		}
+		if cmd.Confirm {
+			sb.WriteString(" (requires confirmation)")
+		}
+	}
+	sb.WriteString("\n" + confirmCommand + " <code> - Runs a command awaiting confirmation")
+	return sb.String()
+}
+
+func confirmationKey(relayer, user string) string {
+	return strings.ToLower(relayer) + "/" + strings.ToLower(user)
+}
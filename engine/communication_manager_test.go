@@ -3,7 +3,11 @@ package engine
 import (
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 )
@@ -154,3 +158,93 @@ func TestPushEvent(t *testing.T) {
 	m = nil
 	m.PushEvent(base.Event{})
 }
+
+func TestRegisterCommand(t *testing.T) {
+	t.Parallel()
+	handler := func(relayer, user string, args []string) (string, error) {
+		return relayer + " " + user + " " + args[0], nil
+	}
+	assert.ErrorIs(t, (*CommunicationManager)(nil).RegisterCommand(&CommsCommand{Name: "cmd", Handler: handler}), ErrNilSubsystem)
+
+	m, err := SetupCommunicationManager(&base.CommunicationsConfig{
+		SMSGlobalConfig: base.SMSGlobalConfig{Enabled: true},
+		ChatOps:         base.ChatOpsConfig{AuthorisedUsers: map[string][]string{"Slack": {"Satoshi"}}},
+	})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+	assert.ErrorIs(t, m.RegisterCommand(nil), common.ErrNilPointer)
+	assert.ErrorIs(t, m.RegisterCommand(&CommsCommand{Name: "/", Handler: handler}), errCommandNameEmpty)
+	assert.ErrorIs(t, m.RegisterCommand(&CommsCommand{Name: "cmd"}), errCommandHandlerNil)
+	require.NoError(t, m.RegisterCommand(&CommsCommand{Name: "/Cmd", Handler: handler}), "RegisterCommand must not error")
+	assert.ErrorIs(t, m.RegisterCommand(&CommsCommand{Name: "cmd", Handler: handler}), errCommandAlreadyDefined)
+	assert.ErrorIs(t, m.RegisterCommand(&CommsCommand{Name: confirmCommand, Handler: handler}), errCommandAlreadyDefined, "built in commands should not be overridden")
+
+	_, err = m.handleCommand("telegram", "satoshi", "")
+	assert.ErrorIs(t, err, base.ErrUnknownCommand)
+	_, err = m.handleCommand("telegram", "satoshi", "/unknown")
+	assert.ErrorIs(t, err, base.ErrUnknownCommand)
+	_, err = m.handleCommand("telegram", "satoshi", "/cmd arg")
+	assert.ErrorIs(t, err, errCommandUnauthorised, "users should only be authorised for their relayer")
+	_, err = m.handleCommand("slack", "", "!cmd arg")
+	assert.ErrorIs(t, err, errCommandUnauthorised)
+	reply, err := m.handleCommand("slack", "satoshi", "!CMD  arg")
+	require.NoError(t, err, "handleCommand must not error")
+	assert.Equal(t, "slack satoshi arg", reply, "handleCommand should pass the relayer, user and arguments to the handler")
+}
+
+func TestCommandConfirmation(t *testing.T) {
+	t.Parallel()
+	m, err := SetupCommunicationManager(&base.CommunicationsConfig{
+		SMSGlobalConfig: base.SMSGlobalConfig{Enabled: true},
+		ChatOps:         base.ChatOpsConfig{AuthorisedUsers: map[string][]string{"slack": {"satoshi", "hal"}}},
+	})
+	require.NoError(t, err, "SetupCommunicationManager must not error")
+	assert.Equal(t, defaultCommandConfirmationTimeout, m.chatOps.ConfirmationTimeout, "ConfirmationTimeout should default")
+
+	var runs int
+	require.NoError(t, m.RegisterCommand(&CommsCommand{
+		Name:        "nuke",
+		Usage:       "<target>",
+		Description: "Nukes the target",
+		Confirm:     true,
+		Handler: func(_, _ string, args []string) (string, error) {
+			runs++
+			return "nuked " + args[0], nil
+		},
+	}), "RegisterCommand must not error")
+
+	reply, err := m.handleCommand("slack", "satoshi", "!commands")
+	require.NoError(t, err, "handleCommand must not error")
+	assert.Contains(t, reply, "nuke <target> - Nukes the target (requires confirmation)")
+
+	_, err = m.handleCommand("slack", "satoshi", "!confirm 123456")
+	assert.ErrorIs(t, err, errNoCommandAwaitingConfirmation)
+
+	reply, err = m.handleCommand("slack", "satoshi", "!nuke moon")
+	require.NoError(t, err, "handleCommand must not error")
+	assert.Zero(t, runs, "command should not run before confirmation")
+	pending := m.confirmations[confirmationKey("slack", "satoshi")]
+	require.NotNil(t, pending, "command must await confirmation")
+	assert.Contains(t, reply, pending.code)
+
+	_, err = m.handleCommand("slack", "hal", "!confirm "+pending.code)
+	assert.ErrorIs(t, err, errNoCommandAwaitingConfirmation, "confirmations should be per user")
+	_, err = m.handleCommand("slack", "satoshi", "!confirm")
+	assert.ErrorIs(t, err, errInvalidConfirmationCode)
+	_, err = m.handleCommand("slack", "satoshi", "!confirm nope")
+	assert.ErrorIs(t, err, errInvalidConfirmationCode)
+
+	reply, err = m.handleCommand("slack", "satoshi", "!confirm "+pending.code)
+	require.NoError(t, err, "handleCommand must not error")
+	assert.Equal(t, "nuked moon", reply)
+	assert.Equal(t, 1, runs)
+	_, err = m.handleCommand("slack", "satoshi", "!confirm "+pending.code)
+	assert.ErrorIs(t, err, errNoCommandAwaitingConfirmation, "confirmations should only run once")
+
+	_, err = m.handleCommand("slack", "satoshi", "!nuke mars")
+	require.NoError(t, err, "handleCommand must not error")
+	pending = m.confirmations[confirmationKey("slack", "satoshi")]
+	pending.expires = time.Now().Add(-time.Second)
+	_, err = m.handleCommand("slack", "satoshi", "!confirm "+pending.code)
+	assert.ErrorIs(t, err, errCommandConfirmationExpired)
+	assert.Equal(t, 1, runs, "expired commands should not run")
+}
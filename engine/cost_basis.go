@@ -0,0 +1,358 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
+)
+
+var errNoCostBasisExchanges = errors.New("no exchanges with authenticated REST support to build a cost basis report from")
+
+// costBasisCandleKey identifies a day of hourly candles for a pair on an
+// exchange
+type costBasisCandleKey struct {
+	exchange string
+	base     *currency.Item
+	quote    *currency.Item
+	day      int64
+}
+
+// costBasisBuilder converts exchange trade and transfer history into cost
+// basis events valued in the fiat currency
+type costBasisBuilder struct {
+	fiatCurrency currency.Code
+	exchanges    []exchange.IBotExchange
+	// candles caches historic candles. Failed requests are stored as nil so
+	// that they are not repeated
+	candles map[costBasisCandleKey]*kline.Item
+	events  []costbasis.Event
+}
+
+// buildCostBasisReport retrieves the spot trades, deposits and withdrawals of
+// the exchanges between the start and end dates, values them in the fiat
+// currency using historic candles and matches disposals against acquisition
+// lots using the method. Disposals of holdings acquired before the start date
+// are realised with a zero cost basis
+func buildCostBasisReport(ctx context.Context, exchanges []exchange.IBotExchange, fiatCurrency currency.Code, method costbasis.Method, start, end time.Time) (*costbasis.Report, error) {
+	if len(exchanges) == 0 {
+		return nil, errNoCostBasisExchanges
+	}
+	if !fiatCurrency.IsFiatCurrency() {
+		return nil, fmt.Errorf("%s %w", fiatCurrency, currency.ErrFiatDisplayCurrencyIsNotFiat)
+	}
+	b := &costBasisBuilder{
+		fiatCurrency: fiatCurrency,
+		exchanges:    exchanges,
+		candles:      make(map[costBasisCandleKey]*kline.Item),
+	}
+	for _, exch := range exchanges {
+		if err := b.addExchangeHistory(ctx, exch, start, end); err != nil {
+			return nil, fmt.Errorf("%s %w", exch.GetName(), err)
+		}
+	}
+	return costbasis.Calculate(method, b.events)
+}
+
+// addExchangeHistory adds the spot order fills and transfers of an exchange
+func (b *costBasisBuilder) addExchangeHistory(ctx context.Context, exch exchange.IBotExchange, start, end time.Time) error {
+	exchName := exch.GetName()
+	codes := make(map[*currency.Item]currency.Code)
+	pairs, err := exch.GetEnabledPairs(asset.Spot)
+	if err == nil && len(pairs) > 0 {
+		orders, err := exch.GetOrderHistory(ctx, &order.MultiOrderRequest{
+			Pairs:     pairs,
+			AssetType: asset.Spot,
+			Type:      order.AnyType,
+			Side:      order.AnySide,
+			StartTime: start,
+			EndTime:   end,
+		})
+		if err != nil && !isUnsupportedHistory(exchName, "order", err) {
+			return err
+		}
+		for i := range orders {
+			if err := b.addOrder(ctx, exchName, &orders[i]); err != nil {
+				return err
+			}
+			codes[orders[i].Pair.Base.Item] = orders[i].Pair.Base
+			codes[orders[i].Pair.Quote.Item] = orders[i].Pair.Quote
+		}
+	}
+
+	seen := make(map[string]struct{})
+	funding, err := exch.GetAccountFundingHistory(ctx)
+	if err != nil && !isUnsupportedHistory(exchName, "funding", err) {
+		return err
+	}
+	for i := range funding {
+		if funding[i].Timestamp.Before(start) || funding[i].Timestamp.After(end) {
+			continue
+		}
+		code := currency.NewCode(funding[i].Currency)
+		codes[code.Item] = code
+		seen[transferKey(funding[i].TransferID, funding[i].Currency, funding[i].Timestamp, funding[i].Amount)] = struct{}{}
+		err = b.addTransfer(ctx, exchName, funding[i].TransferType, funding[i].Status, funding[i].TransferID, code, funding[i].Timestamp, funding[i].Amount, funding[i].Fee)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, code := range slices.SortedFunc(maps.Values(codes), compareCodes) {
+		if code.IsEmpty() || code.IsFiatCurrency() {
+			continue
+		}
+		withdrawals, err := exch.GetWithdrawalsHistory(ctx, code, asset.Spot)
+		if err != nil {
+			if isUnsupportedHistory(exchName, "withdrawal", err) {
+				break
+			}
+			return err
+		}
+		for i := range withdrawals {
+			if withdrawals[i].Timestamp.Before(start) || withdrawals[i].Timestamp.After(end) {
+				continue
+			}
+			k := transferKey(withdrawals[i].TransferID, withdrawals[i].Currency, withdrawals[i].Timestamp, withdrawals[i].Amount)
+			if _, ok := seen[k]; ok {
+				continue
+			}
+			seen[k] = struct{}{}
+			err = b.addTransfer(ctx, exchName, "withdrawal", withdrawals[i].Status, withdrawals[i].TransferID, currency.NewCode(withdrawals[i].Currency), withdrawals[i].Timestamp, withdrawals[i].Amount, withdrawals[i].Fee)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// addOrder adds the fills of an order. Orders without fill details are added
+// as a single fill at their average executed price
+func (b *costBasisBuilder) addOrder(ctx context.Context, exchName string, d *order.Detail) error {
+	if len(d.Trades) > 0 {
+		for i := range d.Trades {
+			ts := d.Trades[i].Timestamp
+			if ts.IsZero() {
+				ts = d.Date
+			}
+			feeAsset := d.FeeAsset
+			if d.Trades[i].FeeAsset != "" {
+				feeAsset = currency.NewCode(d.Trades[i].FeeAsset)
+			}
+			side := d.Trades[i].Side
+			if side == order.UnknownSide {
+				side = d.Side
+			}
+			reference := d.OrderID
+			if d.Trades[i].TID != "" {
+				reference = d.Trades[i].TID
+			}
+			err := b.addFill(ctx, exchName, d.Pair, side, ts, d.Trades[i].Amount, d.Trades[i].Price, d.Trades[i].Fee, feeAsset, reference)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	price := d.AverageExecutedPrice
+	if price == 0 {
+		price = d.Price
+	}
+	ts := d.CloseTime
+	if ts.IsZero() {
+		ts = d.Date
+	}
+	return b.addFill(ctx, exchName, d.Pair, d.Side, ts, d.ExecutedAmount, price, d.Fee, d.FeeAsset, d.OrderID)
+}
+
+// addFill adds an acquisition and a disposal for each non-fiat currency of a
+// fill. Fees are valued in the fiat currency and attributed to the base
+// currency event
+func (b *costBasisBuilder) addFill(ctx context.Context, exchName string, pair currency.Pair, side order.Side, ts time.Time, amount, price, fee float64, feeAsset currency.Code, reference string) error {
+	if amount <= 0 || price <= 0 || (!side.IsLong() && !side.IsShort()) {
+		return nil
+	}
+	var basePrice float64
+	quotePrice, err := b.getPrice(ctx, exchName, pair.Quote, ts)
+	if err == nil {
+		basePrice = price * quotePrice
+	} else {
+		basePrice, err = b.getPrice(ctx, exchName, pair.Base, ts)
+		if err != nil {
+			return fmt.Errorf("%s %s %w", pair, ts.UTC().Format(common.SimpleTimeFormatWithTimezone), err)
+		}
+		quotePrice = basePrice / price
+	}
+	var feeValue float64
+	if fee > 0 {
+		switch {
+		case feeAsset.IsEmpty() || feeAsset.Equal(pair.Quote):
+			feeValue = fee * quotePrice
+		case feeAsset.Equal(pair.Base):
+			feeValue = fee * basePrice
+		default:
+			feePrice, err := b.getPrice(ctx, exchName, feeAsset, ts)
+			if err != nil {
+				return fmt.Errorf("%s fee %s %w", reference, feeAsset, err)
+			}
+			feeValue = fee * feePrice
+		}
+	}
+	baseType, quoteType := costbasis.Acquisition, costbasis.Disposal
+	if side.IsShort() {
+		baseType, quoteType = costbasis.Disposal, costbasis.Acquisition
+	}
+	if !pair.Base.IsFiatCurrency() {
+		b.events = append(b.events, costbasis.Event{
+			Time:      ts,
+			Exchange:  exchName,
+			Currency:  pair.Base,
+			Type:      baseType,
+			Amount:    amount,
+			Price:     basePrice,
+			Fee:       feeValue,
+			Reference: reference,
+		})
+	}
+	if !pair.Quote.IsFiatCurrency() {
+		b.events = append(b.events, costbasis.Event{
+			Time:      ts,
+			Exchange:  exchName,
+			Currency:  pair.Quote,
+			Type:      quoteType,
+			Amount:    amount * price,
+			Price:     quotePrice,
+			Reference: reference,
+		})
+	}
+	return nil
+}
+
+// addTransfer adds a deposit or withdrawal of a cryptocurrency. Withdrawal
+// fees are disposed of with no proceeds. Deposits which cannot be priced are
+// added with a zero price so that any lot they open has a zero cost basis
+func (b *costBasisBuilder) addTransfer(ctx context.Context, exchName, transferType, status, id string, code currency.Code, ts time.Time, amount, fee float64) error {
+	if code.IsEmpty() || code.IsFiatCurrency() || amount <= 0 {
+		return nil
+	}
+	code = code.Upper()
+	status = strings.ToLower(status)
+	if strings.Contains(status, "fail") || strings.Contains(status, "cancel") || strings.Contains(status, "reject") {
+		return nil
+	}
+	transferType = strings.ToLower(transferType)
+	switch {
+	case strings.Contains(transferType, "deposit"):
+		price, err := b.getPrice(ctx, exchName, code, ts)
+		if err != nil {
+			log.Warnf(log.Global, "Cost basis %s %s deposit %s valued at zero: %v", exchName, code, id, err)
+		}
+		b.events = append(b.events, costbasis.Event{
+			Time:      ts,
+			Exchange:  exchName,
+			Currency:  code,
+			Type:      costbasis.TransferIn,
+			Amount:    amount,
+			Price:     price,
+			Reference: id,
+		})
+	case strings.Contains(transferType, "withdraw"):
+		b.events = append(b.events, costbasis.Event{
+			Time:      ts,
+			Exchange:  exchName,
+			Currency:  code,
+			Type:      costbasis.TransferOut,
+			Amount:    amount,
+			Reference: id,
+		})
+		if fee > 0 {
+			b.events = append(b.events, costbasis.Event{
+				Time:      ts,
+				Exchange:  exchName,
+				Currency:  code,
+				Type:      costbasis.Disposal,
+				Amount:    fee,
+				Reference: id,
+			})
+		}
+	}
+	return nil
+}
+
+// getPrice returns the fiat price of a currency at a point in time using
+// hourly candle close prices, preferring the exchange the event occurred on
+func (b *costBasisBuilder) getPrice(ctx context.Context, exchName string, code currency.Code, ts time.Time) (float64, error) {
+	return fiatPrice(code, b.fiatCurrency, func(cp currency.Pair) (float64, error) {
+		return b.getClose(ctx, exchName, cp, ts)
+	})
+}
+
+func (b *costBasisBuilder) getClose(ctx context.Context, exchName string, cp currency.Pair, ts time.Time) (float64, error) {
+	exchanges := make([]exchange.IBotExchange, 0, len(b.exchanges))
+	for _, exch := range b.exchanges {
+		if exch.GetName() == exchName {
+			exchanges = append([]exchange.IBotExchange{exch}, exchanges...)
+			continue
+		}
+		exchanges = append(exchanges, exch)
+	}
+	day := ts.UTC().Truncate(kline.OneDay.Duration())
+	for _, exch := range exchanges {
+		avail, err := exch.GetAvailablePairs(asset.Spot)
+		if err != nil || !avail.Contains(cp, true) {
+			continue
+		}
+		k := costBasisCandleKey{exchange: exch.GetName(), base: cp.Base.Item, quote: cp.Quote.Item, day: day.Unix()}
+		candles, ok := b.candles[k]
+		if !ok {
+			candles, err = exch.GetHistoricCandles(ctx, cp, asset.Spot, kline.OneHour, day, day.Add(kline.OneDay.Duration()))
+			if err != nil {
+				log.Debugf(log.Global, "Cost basis unable to get %s %s candles: %v", exch.GetName(), cp, err)
+				candles = nil
+			}
+			b.candles[k] = candles
+		}
+		if candles == nil {
+			continue
+		}
+		for i := len(candles.Candles) - 1; i >= 0; i-- {
+			if !candles.Candles[i].Time.After(ts) && candles.Candles[i].Close > 0 {
+				return candles.Candles[i].Close, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w %s", kline.ErrNoTimeSeriesDataToConvert, cp)
+}
+
+// isUnsupportedHistory returns whether the exchange does not support
+// retrieving a type of history, logging it
+func isUnsupportedHistory(exchName, historyType string, err error) bool {
+	if !errors.Is(err, common.ErrNotYetImplemented) && !errors.Is(err, common.ErrFunctionNotSupported) {
+		return false
+	}
+	log.Warnf(log.Global, "Cost basis %s %s history is not supported and will be excluded", exchName, historyType)
+	return true
+}
+
+// transferKey identifies a transfer returned by both funding and withdrawal
+// history
+func transferKey(id, code string, ts time.Time, amount float64) string {
+	if id != "" {
+		return id
+	}
+	return strings.ToUpper(code) + "|" + strconv.FormatInt(ts.UnixNano(), 10) + "|" + strconv.FormatFloat(amount, 'f', -1, 64)
+}
@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
+)
+
+var costBasisTestDay = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeCostBasisExchange returns fixed trade, transfer and candle history
+type fakeCostBasisExchange struct {
+	exchange.IBotExchange
+	name        string
+	pairs       currency.Pairs
+	orders      []order.Detail
+	funding     []exchange.FundingHistory
+	fundingErr  error
+	withdrawals map[string][]exchange.WithdrawalHistory
+}
+
+func (f *fakeCostBasisExchange) GetName() string {
+	return f.name
+}
+
+func (f *fakeCostBasisExchange) GetEnabledPairs(asset.Item) (currency.Pairs, error) {
+	return f.pairs, nil
+}
+
+func (f *fakeCostBasisExchange) GetAvailablePairs(asset.Item) (currency.Pairs, error) {
+	return f.pairs, nil
+}
+
+func (f *fakeCostBasisExchange) GetOrderHistory(context.Context, *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	return f.orders, nil
+}
+
+func (f *fakeCostBasisExchange) GetAccountFundingHistory(context.Context) ([]exchange.FundingHistory, error) {
+	return f.funding, f.fundingErr
+}
+
+func (f *fakeCostBasisExchange) GetWithdrawalsHistory(_ context.Context, c currency.Code, _ asset.Item) ([]exchange.WithdrawalHistory, error) {
+	return f.withdrawals[c.String()], nil
+}
+
+func (f *fakeCostBasisExchange) GetHistoricCandles(_ context.Context, cp currency.Pair, _ asset.Item, _ kline.Interval, start, _ time.Time) (*kline.Item, error) {
+	if !cp.Equal(currency.NewPair(currency.ETH, currency.USD)) {
+		return nil, kline.ErrNoTimeSeriesDataToConvert
+	}
+	return &kline.Item{Candles: []kline.Candle{{Time: start.Add(time.Hour * 12), Close: 50}}}, nil
+}
+
+func (f *fakeCostBasisExchange) IsRESTAuthenticationSupported() bool {
+	return true
+}
+
+func newFakeCostBasisExchange() *fakeCostBasisExchange {
+	return &fakeCostBasisExchange{
+		name:  "costbasistest",
+		pairs: currency.Pairs{currency.NewPair(currency.BTC, currency.USDT), currency.NewPair(currency.ETH, currency.USD)},
+		orders: []order.Detail{
+			{
+				OrderID:              "1",
+				Pair:                 currency.NewPair(currency.BTC, currency.USDT),
+				Side:                 order.Buy,
+				Date:                 costBasisTestDay.Add(time.Hour * 10),
+				ExecutedAmount:       1,
+				AverageExecutedPrice: 100,
+				Fee:                  1,
+			},
+			{
+				OrderID: "2",
+				Pair:    currency.NewPair(currency.BTC, currency.USDT),
+				Side:    order.Sell,
+				Trades: []order.TradeHistory{
+					{TID: "t1", Price: 300, Amount: 0.5, Timestamp: costBasisTestDay.Add(time.Hour * 34)},
+				},
+			},
+			{OrderID: "3", Pair: currency.NewPair(currency.BTC, currency.USDT), Side: order.Buy, Date: costBasisTestDay, Status: order.Cancelled},
+		},
+		funding: []exchange.FundingHistory{
+			{TransferID: "d1", TransferType: "deposit", Status: "completed", Currency: "eth", Amount: 2, Timestamp: costBasisTestDay.Add(time.Hour * 12)},
+			{TransferID: "d2", TransferType: "deposit", Status: "Failed", Currency: "eth", Amount: 100, Timestamp: costBasisTestDay.Add(time.Hour * 12)},
+			{TransferID: "d3", TransferType: "deposit", Status: "completed", Currency: "eth", Amount: 100, Timestamp: costBasisTestDay.AddDate(-1, 0, 0)},
+			{TransferID: "w1", TransferType: "withdrawal", Currency: "ETH", Amount: 1, Fee: 0.1, Timestamp: costBasisTestDay.Add(time.Hour * 36)},
+		},
+		withdrawals: map[string][]exchange.WithdrawalHistory{
+			"ETH": {
+				{TransferID: "w1", Currency: "ETH", Amount: 1, Fee: 0.1, Timestamp: costBasisTestDay.Add(time.Hour * 36)},
+				{TransferID: "w2", Currency: "ETH", Amount: 0.5, Timestamp: costBasisTestDay.Add(time.Hour * 37)},
+			},
+		},
+	}
+}
+
+func TestBuildCostBasisReport(t *testing.T) {
+	t.Parallel()
+	start, end := costBasisTestDay, costBasisTestDay.AddDate(0, 0, 7)
+	_, err := buildCostBasisReport(t.Context(), nil, currency.USD, costbasis.FIFO, start, end)
+	assert.ErrorIs(t, err, errNoCostBasisExchanges)
+
+	exch := newFakeCostBasisExchange()
+	unsupported := &fakeCostBasisExchange{name: "unsupported", fundingErr: common.ErrFunctionNotSupported}
+	_, err = buildCostBasisReport(t.Context(), []exchange.IBotExchange{exch}, currency.BTC, costbasis.FIFO, start, end)
+	assert.ErrorIs(t, err, currency.ErrFiatDisplayCurrencyIsNotFiat)
+
+	r, err := buildCostBasisReport(t.Context(), []exchange.IBotExchange{exch, unsupported}, currency.USD, costbasis.FIFO, start, end)
+	require.NoError(t, err, "buildCostBasisReport must not error")
+	require.Len(t, r.Gains, 3, "buildCostBasisReport must return the correct number of gains")
+
+	assert.Equal(t, currency.USDT, r.Gains[0].Currency, "buying with a stablecoin should dispose of it")
+	assert.InDelta(t, 100, r.Gains[0].Proceeds, 1e-9, "stablecoins should be pegged to USD without a candle price")
+	assert.True(t, r.Gains[0].Unmatched, "disposals of holdings acquired before the start date should be unmatched")
+
+	assert.Equal(t, currency.BTC, r.Gains[1].Currency)
+	assert.Equal(t, "t1", r.Gains[1].Reference, "fills should be used when available")
+	assert.InDelta(t, 150, r.Gains[1].Proceeds, 1e-9)
+	assert.InDelta(t, 50.5, r.Gains[1].CostBasis, 1e-9, "the order fee should be included in the cost basis")
+
+	assert.Equal(t, currency.ETH, r.Gains[2].Currency)
+	assert.Equal(t, "w1", r.Gains[2].Reference)
+	assert.InDelta(t, 0.1, r.Gains[2].Amount, 1e-9, "withdrawal fees should be disposed of")
+	assert.InDelta(t, -5, r.Gains[2].Gain, 1e-9, "withdrawal fees should be disposed of with no proceeds")
+
+	require.Len(t, r.Lots, 3, "buildCostBasisReport must return the open lots")
+	assert.InDelta(t, 0.5, r.Lots[0].Remaining, 1e-9)
+	assert.Equal(t, currency.ETH, r.Lots[1].Currency)
+	assert.InDelta(t, 1.9, r.Lots[1].Remaining, 1e-9, "transfers should not close lots and duplicated withdrawals should be ignored")
+	assert.InDelta(t, 50, r.Lots[1].UnitCost, 1e-9, "deposits should be valued using historic candles")
+	assert.Equal(t, currency.USDT, r.Lots[2].Currency)
+	assert.InDelta(t, 150, r.Lots[2].Remaining, 1e-9, "selling for a stablecoin should acquire it")
+
+	exch.orders = append(exch.orders, order.Detail{
+		OrderID:        "4",
+		Pair:           currency.NewPair(currency.NewCode("COSTBASISA"), currency.NewCode("COSTBASISB")),
+		Side:           order.Buy,
+		Date:           costBasisTestDay,
+		ExecutedAmount: 1,
+		Price:          1,
+	})
+	_, err = buildCostBasisReport(t.Context(), []exchange.IBotExchange{exch}, currency.USD, costbasis.FIFO, start, end)
+	assert.ErrorIs(t, err, errNoValuationPrice, "buildCostBasisReport should error when a trade cannot be valued")
+}
@@ -17,12 +17,18 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/eventrule"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstore"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/paper"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -48,6 +54,13 @@ type Engine struct {
 	WebsocketRoutineManager *WebsocketRoutineManager
 	WithdrawManager         *WithdrawManager
 	dataHistoryManager      *DataHistoryManager
+	orderbookRecorder       *OrderbookRecorder
+	algoExecutionManager    *AlgoExecutionManager
+	marketMakingManager     *MarketMakingManager
+	strategyRunnerManager   *StrategyRunnerManager
+	candleAggregator        *CandleAggregator
+	metricsManager          *MetricsManager
+	strategyLoader          StrategyLoader
 	currencyStateManager    *CurrencyStateManager
 	Settings                Settings
 	uptime                  time.Time
@@ -175,6 +188,12 @@ func validateSettings(b *Engine, s *Settings, flagSet FlagSet) {
 	flagSet.WithBool("openexchangerates", &b.Settings.EnableOpenExchangeRates, b.Config.Currency.ForexProviders.IsEnabled("openexchangerates"))
 
 	flagSet.WithBool("datahistorymanager", &b.Settings.EnableDataHistoryManager, b.Config.DataHistoryManager.Enabled)
+	flagSet.WithBool("orderbookrecorder", &b.Settings.EnableOrderbookRecorder, b.Config.OrderbookRecorder.Enabled)
+	flagSet.WithBool("algoexecutionmanager", &b.Settings.EnableAlgoExecutionManager, b.Config.AlgoExecutionManager.Enabled)
+	flagSet.WithBool("marketmakingmanager", &b.Settings.EnableMarketMakingManager, b.Config.MarketMakingManager.Enabled)
+	flagSet.WithBool("strategyrunner", &b.Settings.EnableStrategyRunner, b.Config.StrategyRunner.Enabled)
+	flagSet.WithBool("candleaggregator", &b.Settings.EnableCandleAggregator, b.Config.CandleAggregator.Enabled)
+	flagSet.WithBool("metricsmanager", &b.Settings.EnableMetricsManager, b.Config.MetricsManager.Enabled)
 	flagSet.WithBool("currencystatemanager", &b.Settings.EnableCurrencyStateManager, b.Config.CurrencyStateManager.Enabled != nil && *b.Config.CurrencyStateManager.Enabled)
 	flagSet.WithBool("gctscriptmanager", &b.Settings.EnableGCTScriptManager, b.Config.GCTScript.Enabled)
 
@@ -372,6 +391,16 @@ func (bot *Engine) Start() error {
 		bot.Config.PurgeExchangeAPICredentials()
 	}
 
+	if bot.Settings.EnableMetricsManager {
+		// The metrics manager must be set up before exchanges as they capture
+		// the global reporters when they are loaded
+		if err := bot.setupMetricsManager(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to setup: %s", err)
+		} else if err = bot.metricsManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to start: %s", err)
+		}
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	if err := bot.SetupExchanges(); err != nil {
 		return err
@@ -385,6 +414,11 @@ func (bot *Engine) Start() error {
 			if err := bot.CommunicationsManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "Communications manager unable to start: %s", err)
 			}
+			if bot.Config.Communications.ChatOps.Enabled {
+				if err := bot.setupChatOps(c); err != nil {
+					gctlog.Errorf(gctlog.Global, "Communications manager unable to register chat-ops commands: %s", err)
+				}
+			}
 		}
 	}
 
@@ -413,6 +447,11 @@ func (bot *Engine) Start() error {
 				gctlog.Errorf(gctlog.Global, "portfolio manager unable to setup: %s", err)
 			} else {
 				bot.portfolioManager = p
+				if bot.Config.PortfolioValuation.Enabled {
+					if err = bot.portfolioManager.setupValuation(bot.DatabaseManager, bot.Config.Currency.FiatDisplayCurrency, &bot.Config.PortfolioValuation); err != nil {
+						gctlog.Errorf(gctlog.Global, "portfolio manager unable to setup valuation snapshots: %s", err)
+					}
+				}
 				if err := bot.portfolioManager.Start(&bot.ServicesWG); err != nil {
 					gctlog.Errorf(gctlog.Global, "portfolio manager unable to start: %s", err)
 				}
@@ -433,10 +472,28 @@ func (bot *Engine) Start() error {
 		}
 	}
 
+	if bot.Settings.EnableOrderbookRecorder {
+		if bot.orderbookRecorder == nil {
+			if r, err := SetupOrderbookRecorder(bot.DatabaseManager, &bot.Config.OrderbookRecorder); err != nil {
+				gctlog.Errorf(gctlog.Global, "orderbook recorder unable to setup: %s", err)
+			} else {
+				bot.orderbookRecorder = r
+				if err := bot.orderbookRecorder.Start(); err != nil {
+					gctlog.Errorf(gctlog.Global, "orderbook recorder unable to start: %s", err)
+				}
+			}
+		}
+	}
+
 	if w, err := SetupWithdrawManager(bot.ExchangeManager, bot.portfolioManager, bot.Settings.EnableDryRun); err != nil {
 		return err
 	} else { //nolint:revive // TODO: revive false positive, see https://github.com/mgechev/revive/pull/832 for more information
 		bot.WithdrawManager = w
+		if bot.Config.WithdrawalPolicy.Enabled {
+			if err := w.setupPolicy(&bot.Config.WithdrawalPolicy, bot.CommunicationsManager, bot.GetExchangeOTPByName); err != nil {
+				return err
+			}
+		}
 	}
 
 	if bot.Settings.EnableDeprecatedRPC || bot.Settings.EnableWebsocketRPC {
@@ -479,12 +536,39 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to setup: %s", err)
 		} else {
 			bot.OrderManager = o
+			if bot.Config.OrderManager.PersistOrders {
+				if err = bot.OrderManager.SetupPersistence(bot.DatabaseManager); err != nil {
+					gctlog.Errorf(gctlog.Global, "Order manager unable to setup order persistence: %s", err)
+				}
+			}
 			if err = bot.OrderManager.Start(); err != nil {
 				gctlog.Errorf(gctlog.Global, "Order manager unable to start: %s", err)
 			}
 		}
 	}
 
+	if bot.Settings.EnableAlgoExecutionManager && bot.OrderManager.IsRunning() {
+		if a, err := SetupAlgoExecutionManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.AlgoExecutionManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Algo execution manager unable to setup: %s", err)
+		} else {
+			bot.algoExecutionManager = a
+			if err = bot.algoExecutionManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Algo execution manager unable to start: %s", err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableMarketMakingManager && bot.OrderManager.IsRunning() {
+		if m, err := SetupMarketMakingManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.MarketMakingManager); err != nil {
+			gctlog.Errorf(gctlog.Global, "Market making manager unable to setup: %s", err)
+		} else {
+			bot.marketMakingManager = m
+			if err = bot.marketMakingManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "Market making manager unable to start: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableExchangeSyncManager {
 		cfg := bot.Config.SyncManagerConfig
 		cfg.SynchronizeTicker = bot.Settings.EnableTickerSyncing
@@ -522,17 +606,6 @@ func (bot *Engine) Start() error {
 		}
 	}
 
-	if bot.Settings.EnableEventManager {
-		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.Settings.EventManagerDelay, bot.Settings.EnableDryRun); err != nil {
-			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
-		} else {
-			bot.eventManager = e
-			if err = bot.eventManager.Start(); err != nil {
-				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
-			}
-		}
-	}
-
 	if bot.Settings.EnableWebsocketRoutine {
 		if w, err := setupWebsocketRoutineManager(bot.ExchangeManager, bot.OrderManager, bot.currencyPairSyncer, &bot.Config.Currency, bot.Settings.Verbose); err != nil {
 			gctlog.Errorf(gctlog.Global, "Unable to initialise websocket routine manager. Err: %s", err)
@@ -549,12 +622,65 @@ func (bot *Engine) Start() error {
 			gctlog.Errorf(gctlog.Global, "failed to create script manager. Err: %s", err)
 		} else {
 			bot.gctScriptManager = g
+			if bot.DatabaseManager.IsRunning() {
+				if s, err := scriptstore.Setup(bot.DatabaseManager.GetInstance()); err != nil {
+					gctlog.Errorf(gctlog.Global, "GCTScript manager unable to use database, script store will be unavailable. Err: %s", err)
+				} else {
+					modules.SetModuleStore(s)
+				}
+			}
 			if err := bot.gctScriptManager.Start(&bot.ServicesWG); err != nil {
 				gctlog.Errorf(gctlog.Global, "GCTScript manager unable to start: %s", err)
 			}
 		}
 	}
 
+	if bot.WebsocketRoutineManager != nil && bot.gctScriptManager != nil {
+		// Registered regardless of the script manager running so scripts
+		// receive websocket events once it is enabled
+		if err := bot.WebsocketRoutineManager.registerWebsocketDataHandler(bot.gctScriptManager.WebsocketDataHandler, false); err != nil {
+			gctlog.Errorf(gctlog.Global, "Unable to register GCTScript websocket data handler. Err: %s", err)
+		}
+	}
+
+	if bot.Settings.EnableCandleAggregator {
+		if err := bot.setupCandleAggregator(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle aggregator unable to setup: %s", err)
+		} else if err = bot.candleAggregator.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle aggregator unable to start: %s", err)
+		}
+	}
+
+	if bot.Settings.EnableStrategyRunner && bot.OrderManager.IsRunning() {
+		if err := bot.setupStrategyRunnerManager(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Strategy runner unable to setup: %s", err)
+		} else if err = bot.strategyRunnerManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Strategy runner unable to start: %s", err)
+		}
+	}
+
+	if bot.Settings.EnableEventManager {
+		var eventDB eventrule.IDBService
+		if bot.DatabaseManager.IsRunning() {
+			if db, err := eventrule.Setup(bot.DatabaseManager.GetInstance()); err != nil {
+				gctlog.Errorf(gctlog.Global, "Event manager unable to use database, events will not be saved. Err: %s", err)
+			} else {
+				eventDB = db
+			}
+		}
+		// Paper trading exchanges match orders locally, so event order
+		// actions are only skipped in dry run mode against live exchanges
+		isDryRun := bot.Settings.EnableDryRun && !bot.Settings.EnablePaperTrading
+		if e, err := setupEventManager(bot.CommunicationsManager, bot.ExchangeManager, bot.OrderManager, bot.gctScriptManager, eventDB, bot.Settings.EventManagerDelay, isDryRun, bot.Settings.Verbose); err != nil {
+			gctlog.Errorf(gctlog.Global, "Unable to initialise event manager. Err: %s", err)
+		} else {
+			bot.eventManager = e
+			if err = bot.eventManager.Start(); err != nil {
+				gctlog.Errorf(gctlog.Global, "failed to start event manager. Err: %s", err)
+			}
+		}
+	}
+
 	if bot.Settings.EnableCurrencyStateManager {
 		if c, err := SetupCurrencyStateManager(
 			bot.Config.CurrencyStateManager.Delay,
@@ -594,6 +720,31 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.algoExecutionManager.IsRunning() {
+		if err := bot.algoExecutionManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.OrderMgr, "Algo execution manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.marketMakingManager.IsRunning() {
+		if err := bot.marketMakingManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.OrderMgr, "Market making manager unable to stop. Error: %v", err)
+		}
+	}
+	if bot.strategyRunnerManager.IsRunning() {
+		if err := bot.strategyRunnerManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.OrderMgr, "Strategy runner unable to stop. Error: %v", err)
+		}
+	}
+	if bot.candleAggregator.IsRunning() {
+		if err := bot.candleAggregator.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle aggregator unable to stop. Error: %v", err)
+		}
+	}
+	if bot.metricsManager.IsRunning() {
+		if err := bot.metricsManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Metrics manager unable to stop. Error: %v", err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -639,6 +790,11 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.DataHistory, "data history manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.orderbookRecorder.IsRunning() {
+		if err := bot.orderbookRecorder.Stop(); err != nil {
+			gctlog.Errorf(gctlog.OrderBook, "orderbook recorder unable to stop. Error: %v", err)
+		}
+	}
 	if bot.DatabaseManager.IsRunning() {
 		if err := bot.DatabaseManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to stop. Error: %v", err)
@@ -809,6 +965,18 @@ func (bot *Engine) LoadExchange(name string) error {
 		return err
 	}
 
+	if bot.Settings.EnablePaperTrading {
+		var balances []config.PaperTradingBalance
+		if exchCfg.PaperTrading != nil {
+			balances = exchCfg.PaperTrading.Balances
+		}
+		exch, err = paper.New(exch, balances)
+		if err != nil {
+			return err
+		}
+		gctlog.Warnf(gctlog.ExchangeSys, "%s paper trading is enabled, orders will be matched locally against simulated balances", exch.GetName())
+	}
+
 	err = bot.ExchangeManager.Add(exch)
 	if err != nil {
 		return err
@@ -877,6 +1045,9 @@ func (bot *Engine) SetupExchanges() error {
 	if bot.Settings.EnableExchangeHTTPDebugging {
 		bot.dryRunParamInteraction("exchangehttpdebugging")
 	}
+	if bot.Settings.EnablePaperTrading {
+		bot.dryRunParamInteraction("papertrading")
+	}
 
 	var exchangesOverride []string
 	if bot.Settings.Exchanges != "" {
@@ -934,6 +1105,81 @@ func (bot *Engine) SetupExchanges() error {
 	return nil
 }
 
+// SetStrategyLoader sets the loader the strategy runner uses to build the
+// strategies it hosts. It must be set before the strategy runner is started
+func (bot *Engine) SetStrategyLoader(l StrategyLoader) error {
+	if bot == nil {
+		return errNilBot
+	}
+	if l == nil {
+		return errNilStrategyLoader
+	}
+	bot.strategyLoader = l
+	return nil
+}
+
+// setupStrategyRunnerManager creates the strategy runner and registers it to
+// receive websocket trades
+func (bot *Engine) setupStrategyRunnerManager() error {
+	if bot.WebsocketRoutineManager == nil {
+		return fmt.Errorf("websocket routine manager %w", ErrNilSubsystem)
+	}
+	s, err := SetupStrategyRunnerManager(bot.ExchangeManager, bot.OrderManager, bot.strategyLoader, &bot.Config.StrategyRunner)
+	if err != nil {
+		return err
+	}
+	err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(s.WebsocketDataHandler, false)
+	if err != nil {
+		return err
+	}
+	bot.strategyRunnerManager = s
+	return nil
+}
+
+// setupCandleAggregator creates the candle aggregator and registers it to
+// receive websocket trades
+func (bot *Engine) setupCandleAggregator() error {
+	if bot.WebsocketRoutineManager == nil {
+		return fmt.Errorf("websocket routine manager %w", ErrNilSubsystem)
+	}
+	c, err := SetupCandleAggregator(bot.ExchangeManager, bot.DatabaseManager, &bot.Config.CandleAggregator)
+	if err != nil {
+		return err
+	}
+	err = bot.WebsocketRoutineManager.registerWebsocketDataHandler(c.WebsocketDataHandler, false)
+	if err != nil {
+		return err
+	}
+	bot.candleAggregator = c
+	return nil
+}
+
+// setupMetricsManager creates the metrics manager and installs it as the
+// global request, websocket and orderbook reporter. Exchanges loaded before
+// this is called will not report their request, websocket or orderbook
+// metrics
+func (bot *Engine) setupMetricsManager() error {
+	m, err := SetupMetricsManager(bot, &bot.Config.MetricsManager)
+	if err != nil {
+		return err
+	}
+	request.SetupGlobalReporter(m)
+	websocket.SetupGlobalReporter(m.WebsocketReporter())
+	orderbook.SetupGlobalReporter(m)
+	bot.metricsManager = m
+	return nil
+}
+
+// getSyncManager returns the currency pair syncer for the metrics manager
+func (bot *Engine) getSyncManager() *SyncManager {
+	return bot.currencyPairSyncer
+}
+
+// getOrderManager returns the order manager for the metrics manager
+func (bot *Engine) getOrderManager() *OrderManager {
+	return bot.OrderManager
+}
+
 // WaitForInitialCurrencySync allows for a routine to wait for the initial sync
 // of the currency pair syncer management system.
 func (bot *Engine) WaitForInitialCurrencySync() error {
@@ -32,11 +32,18 @@ type Settings struct {
 // CoreSettings defines settings related to core engine operations
 type CoreSettings struct {
 	EnableDryRun                bool
+	EnablePaperTrading          bool
 	EnableAllExchanges          bool
 	EnableAllPairs              bool
 	EnableCoinmarketcapAnalysis bool
 	EnablePortfolioManager      bool
 	EnableDataHistoryManager    bool
+	EnableOrderbookRecorder     bool
+	EnableAlgoExecutionManager  bool
+	EnableMarketMakingManager   bool
+	EnableStrategyRunner        bool
+	EnableCandleAggregator      bool
+	EnableMetricsManager        bool
 	PortfolioManagerDelay       time.Duration
 	EnableGRPC                  bool
 	EnableGRPCProxy             bool
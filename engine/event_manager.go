@@ -1,23 +1,37 @@
 package engine
 
 import (
-	"errors"
+	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gct-ta/indicators"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/eventrule"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-// setupEventManager loads and validates the communications manager config
-func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, sleepDelay time.Duration, verbose bool) (*eventManager, error) {
+// setupEventManager loads and validates the communications manager config.
+// The order manager, script manager and database service are optional and
+// only required by events which submit orders, run scripts or are persisted.
+// Order actions are skipped when isDryRun is set
+func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManager, orderManager iEventOrderManager, scriptManager iEventScriptManager, db eventrule.IDBService, sleepDelay time.Duration, isDryRun, verbose bool) (*eventManager, error) {
 	if comManager == nil {
 		return nil, errNilComManager
 	}
@@ -30,9 +44,16 @@ func setupEventManager(comManager iCommsManager, exchangeManager iExchangeManage
 	return &eventManager{
 		comms:           comManager,
 		exchangeManager: exchangeManager,
+		orderManager:    orderManager,
+		scriptManager:   scriptManager,
+		db:              db,
+		isDryRun:        isDryRun,
 		verbose:         verbose,
 		sleepDelay:      sleepDelay,
 		shutdown:        make(chan struct{}),
+		candles:         make(map[eventCandleKey]*eventCandles),
+		fundingRates:    make(map[key.ExchangePairAsset]*eventFundingRate),
+		balances:        make(map[eventBalanceKey]float64),
 	}, nil
 }
 
@@ -44,8 +65,12 @@ func (m *eventManager) Start() error {
 	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
 		return fmt.Errorf("event manager %w", ErrSubSystemAlreadyStarted)
 	}
+	if err := m.loadEvents(); err != nil {
+		log.Errorf(log.EventMgr, "Events: Unable to load stored events. Err: %s\n", err)
+	}
 	log.Debugf(log.EventMgr, "Event Manager started. SleepDelay: %v\n", m.sleepDelay.String())
 	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
 	go m.run()
 	return nil
 }
@@ -67,76 +92,206 @@ func (m *eventManager) Stop() error {
 		return fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
 	}
 	close(m.shutdown)
+	m.wg.Wait()
 	return nil
 }
 
 func (m *eventManager) run() {
+	defer m.wg.Done()
 	t := time.NewTicker(m.sleepDelay)
-	select {
-	case <-m.shutdown:
-		return
-	case <-t.C:
-		total, executed := m.getEventCounter()
-		if total > 0 && executed != total {
-			m.m.Lock()
-			for i := range m.events {
-				m.executeEvent(i)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			total, executed := m.getEventCounter()
+			if total > 0 && executed != total {
+				m.processEvents()
 			}
-			m.m.Unlock()
 		}
 	}
 }
 
-func (m *eventManager) executeEvent(i int) {
-	if !m.events[i].Executed {
+// processEvents evaluates all events which can currently trigger. Events are
+// copied so conditions which require network requests are evaluated without
+// holding the lock
+func (m *eventManager) processEvents() {
+	now := time.Now()
+	m.m.Lock()
+	pending := make([]Event, 0, len(m.events))
+	for i := range m.events {
+		if m.events[i].canTrigger(now) {
+			pending = append(pending, m.events[i])
+		}
+	}
+	m.m.Unlock()
+	for i := range pending {
+		m.executeEvent(&pending[i])
+	}
+}
+
+func (m *eventManager) executeEvent(e *Event) {
+	if m.verbose {
+		log.Debugf(log.EventMgr, "Events: Processing event %s.\n", e.String())
+	}
+	if err := m.checkEventCondition(e); err != nil {
 		if m.verbose {
-			log.Debugf(log.EventMgr, "Events: Processing event %s.\n", m.events[i].String())
+			log.Debugf(log.EventMgr, "Events: ID: %d %v\n", e.ID, err)
+		}
+		return
+	}
+	if err := m.recordTrigger(e.ID, time.Now()); err != nil {
+		// the event was removed while being evaluated
+		return
+	}
+	msg := fmt.Sprintf(
+		"Events: ID: %d triggered on %s successfully [%v]\n", e.ID,
+		e.Exchange, e.String(),
+	)
+	log.Infoln(log.EventMgr, msg)
+	m.resetBalanceReferences(e.ID)
+	if e.Action != "" {
+		m.comms.PushEvent(base.Event{Type: "event", Message: msg})
+	}
+	for i := range e.Actions {
+		if err := m.executeAction(e, &e.Actions[i], msg); err != nil {
+			log.Errorf(log.EventMgr, "Events: ID: %d %s action failed. Err: %s\n", e.ID, e.Actions[i].Type, err)
+		}
+	}
+}
+
+// recordTrigger updates the trigger count and time of an event and saves it
+func (m *eventManager) recordTrigger(id int64, at time.Time) error {
+	m.m.Lock()
+	i := slices.IndexFunc(m.events, func(e Event) bool { return e.ID == id })
+	if i == -1 {
+		m.m.Unlock()
+		return fmt.Errorf("%w %d", errEventNotFound, id)
+	}
+	m.events[i].TriggerCount++
+	m.events[i].LastTriggered = at
+	e := m.events[i]
+	m.m.Unlock()
+	m.saveEvent(&e)
+	return nil
+}
+
+// executeAction runs a single action of a triggered event
+func (m *eventManager) executeAction(e *Event, a *EventAction, msg string) error {
+	if m.isDryRun && (a.Type == ActionSubmitOrder || a.Type == ActionCancelOrder) {
+		log.Infof(log.EventMgr, "Events: ID: %d %s action skipped in dry run mode\n", e.ID, a.Type)
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), eventRequestTimeout)
+	defer cancel()
+	switch a.Type {
+	case ActionSubmitOrder:
+		if m.orderManager == nil || !m.orderManager.IsRunning() {
+			return errEventOrderManager
 		}
-		err := m.checkEventCondition(&m.events[i])
+		resp, err := m.orderManager.Submit(ctx, &order.Submit{
+			Exchange:  e.Exchange,
+			Pair:      e.Pair,
+			AssetType: e.Asset,
+			Side:      a.Side,
+			Type:      a.OrderType,
+			Amount:    a.Amount,
+			Price:     a.Price,
+		})
 		if err != nil {
-			msg := fmt.Sprintf(
-				"Events: ID: %d triggered on %s successfully [%v]\n", m.events[i].ID,
-				m.events[i].Exchange, m.events[i].String(),
-			)
-			log.Infoln(log.EventMgr, msg)
-			m.comms.PushEvent(base.Event{Type: "event", Message: msg})
-			m.events[i].Executed = true
-		} else if m.verbose {
-			log.Debugf(log.EventMgr, "%v", err)
+			return err
 		}
+		log.Infof(log.EventMgr, "Events: ID: %d submitted order %s\n", e.ID, resp.OrderID)
+	case ActionCancelOrder:
+		if m.orderManager == nil || !m.orderManager.IsRunning() {
+			return errEventOrderManager
+		}
+		err := m.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  e.Exchange,
+			OrderID:   a.OrderID,
+			Pair:      e.Pair,
+			AssetType: e.Asset,
+		})
+		if err != nil {
+			return err
+		}
+		log.Infof(log.EventMgr, "Events: ID: %d cancelled order %s\n", e.ID, a.OrderID)
+	case ActionRunScript:
+		if m.scriptManager == nil || !m.scriptManager.IsRunning() {
+			return errEventScriptManager
+		}
+		script, err := eventScriptPath(a.Script)
+		if err != nil {
+			return err
+		}
+		vm := m.scriptManager.New()
+		if vm == nil {
+			return errEventScriptVM
+		}
+		if err := vm.Load(script); err != nil {
+			if errRemove := m.scriptManager.RemoveVM(vm.ID); errRemove != nil {
+				log.Errorf(log.EventMgr, "Events: ID: %d unable to remove script VM. Err: %s\n", e.ID, errRemove)
+			}
+			return err
+		}
+		go vm.CompileAndRun()
+	case ActionComms:
+		if a.Message != "" {
+			msg = a.Message
+		}
+		m.comms.PushEvent(base.Event{Type: "event", Message: msg, Relayers: a.Relayers})
+	default:
+		return fmt.Errorf("%w %q", errInvalidAction, a.Type)
 	}
+	return nil
 }
 
-// Add adds an event to the Events chain and returns an index/eventID
-// and an error
-func (m *eventManager) Add(exchange, item string, condition EventConditionParams, p currency.Pair, a asset.Item, action string) (int64, error) {
+// Add adds an event to the Events chain and returns its ID and an error. If
+// a database is available the event is saved so it is restored on restart
+func (m *eventManager) Add(evt *Event) (int64, error) {
 	if m == nil {
 		return 0, fmt.Errorf("event manager %w", ErrNilSubsystem)
 	}
 	if atomic.LoadInt32(&m.started) == 0 {
 		return 0, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
 	}
-	err := m.isValidEvent(exchange, item, condition, action)
+	if evt == nil {
+		return 0, errNilEvent
+	}
+	e := *evt
+	e.EventRule = e.EventRule.withDefaults()
+	e.Action = strings.ToUpper(e.Action)
+	e.Actions = slices.Clone(e.Actions)
+	for i := range e.Actions {
+		e.Actions[i].Type = strings.ToUpper(e.Actions[i].Type)
+	}
+	e.TriggerCount = 0
+	e.LastTriggered = time.Time{}
+	err := m.isValidEvent(&e)
 	if err != nil {
 		return 0, err
 	}
-	evt := Event{
-		Exchange:  exchange,
-		Item:      item,
-		Condition: condition,
-		Pair:      p,
-		Asset:     a,
-		Action:    action,
-		Executed:  false,
+	if m.db != nil {
+		definition, err := marshalEvent(&e)
+		if err != nil {
+			return 0, err
+		}
+		r := &eventrule.Record{Definition: string(definition)}
+		if err := m.db.Insert(r); err != nil {
+			return 0, err
+		}
+		e.ID = r.ID
 	}
 	m.m.Lock()
-	if len(m.events) > 0 {
-		evt.ID = int64(len(m.events) + 1)
+	if m.db == nil {
+		m.nextID++
+		e.ID = m.nextID
 	}
-	m.events = append(m.events, evt)
+	m.events = append(m.events, e)
 	m.m.Unlock()
 
-	return evt.ID, nil
+	return e.ID, nil
 }
 
 // Remove deletes an event by its ID
@@ -145,18 +300,37 @@ func (m *eventManager) Remove(eventID int64) bool {
 		return false
 	}
 	m.m.Lock()
-	defer m.m.Unlock()
-	for i := range m.events {
-		if m.events[i].ID == eventID {
-			m.events = slices.Delete(m.events, i, i+1)
-			return true
+	i := slices.IndexFunc(m.events, func(e Event) bool { return e.ID == eventID })
+	if i == -1 {
+		m.m.Unlock()
+		return false
+	}
+	m.events = slices.Delete(m.events, i, i+1)
+	m.m.Unlock()
+	m.resetBalanceReferences(eventID)
+	if m.db != nil {
+		if err := m.db.Delete(eventID); err != nil {
+			log.Errorf(log.EventMgr, "Events: Unable to delete stored event %d. Err: %s\n", eventID, err)
 		}
 	}
-	return false
+	return true
+}
+
+// GetEvents returns a copy of all events
+func (m *eventManager) GetEvents() ([]Event, error) {
+	if m == nil {
+		return nil, fmt.Errorf("event manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("event manager %w", ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	return slices.Clone(m.events), nil
 }
 
 // getEventCounter displays the amount of total events on the chain and the
-// events that have been executed.
+// events that have been executed and will not trigger again.
 func (m *eventManager) getEventCounter() (total, executed int) {
 	if m == nil || atomic.LoadInt32(&m.started) == 0 {
 		return 0, 0
@@ -165,13 +339,61 @@ func (m *eventManager) getEventCounter() (total, executed int) {
 	defer m.m.Unlock()
 	total = len(m.events)
 	for i := range m.events {
-		if m.events[i].Executed {
+		if !m.events[i].Repeat && m.events[i].TriggerCount > 0 {
 			executed++
 		}
 	}
 	return total, executed
 }
 
+// loadEvents restores stored events the first time the manager is started
+func (m *eventManager) loadEvents() error {
+	m.m.Lock()
+	defer m.m.Unlock()
+	if m.loaded || m.db == nil {
+		return nil
+	}
+	records, err := m.db.GetAll()
+	if err != nil {
+		return err
+	}
+	for i := range records {
+		var e Event
+		if err := json.Unmarshal([]byte(records[i].Definition), &e); err != nil {
+			log.Errorf(log.EventMgr, "Events: Unable to load stored event %d. Err: %s\n", records[i].ID, err)
+			continue
+		}
+		e.ID = records[i].ID
+		m.events = append(m.events, e)
+	}
+	m.loaded = true
+	return nil
+}
+
+// saveEvent updates a stored event
+func (m *eventManager) saveEvent(e *Event) {
+	if m.db == nil {
+		return
+	}
+	definition, err := marshalEvent(e)
+	if err == nil {
+		err = m.db.Update(&eventrule.Record{ID: e.ID, Definition: string(definition)})
+	}
+	if err != nil {
+		log.Errorf(log.EventMgr, "Events: Unable to save event %d. Err: %s\n", e.ID, err)
+	}
+}
+
+// marshalEvent returns the stored definition of an event. Pairs without a
+// delimiter cannot be parsed when loaded so a delimiter is always stored
+func marshalEvent(e *Event) ([]byte, error) {
+	stored := *e
+	if stored.Pair.Delimiter == "" {
+		stored.Pair.Delimiter = currency.DashDelimiter
+	}
+	return json.Marshal(stored)
+}
+
 // checkEventCondition will check the event structure to see if there is a condition
 // met
 func (m *eventManager) checkEventCondition(e *Event) error {
@@ -184,55 +406,199 @@ func (m *eventManager) checkEventCondition(e *Event) error {
 	if e == nil {
 		return errNilEvent
 	}
-	if e.Item == ItemPrice {
-		return e.processTicker()
+	return m.checkRule(e, &e.EventRule)
+}
+
+// checkRule checks a single item condition or a composite of rules. AND rules
+// require every rule to be met, OR rules require at least one
+func (m *eventManager) checkRule(e *Event, r *EventRule) error {
+	if len(r.Rules) == 0 {
+		return m.checkItem(e, r)
+	}
+	switch r.Operator {
+	case OperatorAnd:
+		for i := range r.Rules {
+			if err := m.checkRule(e, &r.Rules[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	case OperatorOr:
+		var errs error
+		for i := range r.Rules {
+			err := m.checkRule(e, &r.Rules[i])
+			if err == nil {
+				return nil
+			}
+			errs = common.AppendError(errs, err)
+		}
+		return errs
 	}
-	return e.processOrderbook()
+	return fmt.Errorf("%w %q", errInvalidOperator, r.Operator)
 }
 
-// isValidEvent checks the actions to be taken and returns an error if incorrect
-func (m *eventManager) isValidEvent(exchange, item string, condition EventConditionParams, action string) error {
-	exchange = strings.ToUpper(exchange)
-	item = strings.ToUpper(item)
-	action = strings.ToUpper(action)
+func (m *eventManager) checkItem(e *Event, r *EventRule) error {
+	switch r.Item {
+	case ItemPrice:
+		return e.processTicker(r)
+	case ItemOrderbook:
+		return e.processOrderbook(r)
+	case ItemSpread:
+		return e.processSpread(r)
+	case ItemRSI, ItemBollinger, ItemMACD:
+		return m.processIndicator(e, r)
+	case ItemFundingRate:
+		return m.processFundingRate(e, r)
+	case ItemBalanceChange:
+		return m.processBalanceChange(e, r)
+	}
+	return fmt.Errorf("%w %q", errInvalidItem, r.Item)
+}
 
-	if !m.isValidExchange(exchange) {
+// isValidEvent checks the rules and actions to be taken and returns an error
+// if incorrect
+func (m *eventManager) isValidEvent(e *Event) error {
+	if !m.isValidExchange(e.Exchange) {
 		return errExchangeDisabled
 	}
 
-	if !isValidItem(item) {
-		return errInvalidItem
+	if err := e.isValidRule(&e.EventRule); err != nil {
+		return err
 	}
 
-	if !isValidCondition(condition.Condition) {
-		return errInvalidCondition
+	if e.Action == "" && len(e.Actions) == 0 {
+		return errInvalidAction
 	}
 
-	if item == ItemPrice {
-		if condition.Price <= 0 {
-			return errInvalidCondition
+	if e.Action != "" {
+		if strings.Contains(e.Action, ",") {
+			a := strings.Split(e.Action, ",")
+
+			if a[0] != ActionSMSNotify {
+				return errInvalidAction
+			}
+		} else if e.Action != ActionConsolePrint && e.Action != ActionTest {
+			return errInvalidAction
 		}
 	}
 
-	if item == ItemOrderbook {
-		if condition.OrderbookAmount <= 0 {
-			return errInvalidCondition
+	for i := range e.Actions {
+		if err := isValidAction(&e.Actions[i]); err != nil {
+			return err
 		}
 	}
 
-	if strings.Contains(action, ",") {
-		a := strings.Split(action, ",")
+	if e.Cooldown < 0 {
+		return errInvalidCooldown
+	}
+	return nil
+}
 
-		if a[0] != ActionSMSNotify {
-			return errInvalidAction
+// isValidRule validates an item condition or each rule of a composite
+func (e *Event) isValidRule(r *EventRule) error {
+	if len(r.Rules) > 0 {
+		if r.Operator != OperatorAnd && r.Operator != OperatorOr {
+			return fmt.Errorf("%w %q", errInvalidOperator, r.Operator)
+		}
+		if r.Item != "" {
+			return fmt.Errorf("%w %q, composite rules cannot have an item", errInvalidItem, r.Item)
+		}
+		for i := range r.Rules {
+			if err := e.isValidRule(&r.Rules[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if !isValidItem(r.Item) {
+		return errInvalidItem
+	}
+
+	if !isValidCondition(r.Condition.Condition) {
+		return errInvalidCondition
+	}
+
+	switch r.Item {
+	case ItemPrice:
+		if r.Condition.Price <= 0 {
+			return errInvalidCondition
+		}
+	case ItemOrderbook:
+		if r.Condition.OrderbookAmount <= 0 {
+			return errInvalidCondition
+		}
+	case ItemRSI, ItemBollinger:
+		if r.Condition.Interval <= 0 || r.Condition.Period <= 0 || r.Condition.StdDev < 0 {
+			return fmt.Errorf("%w %s parameters", errInvalidCondition, r.Item)
+		}
+	case ItemMACD:
+		if r.Condition.Interval <= 0 || r.Condition.FastPeriod <= 0 ||
+			r.Condition.FastPeriod >= r.Condition.SlowPeriod || r.Condition.SignalPeriod <= 0 {
+			return fmt.Errorf("%w %s parameters", errInvalidCondition, r.Item)
+		}
+	case ItemFundingRate:
+		if !e.Asset.IsFutures() {
+			return fmt.Errorf("%w %s requires a futures asset", errInvalidCondition, r.Item)
+		}
+	case ItemBalanceChange:
+		if r.Condition.Currency.IsEmpty() {
+			return fmt.Errorf("%w %s requires a currency", errInvalidCondition, r.Item)
 		}
-	} else if action != ActionConsolePrint && action != ActionTest {
-		return errInvalidAction
 	}
+	return nil
+}
 
+// isValidAction validates the parameters of an action
+func isValidAction(a *EventAction) error {
+	switch a.Type {
+	case ActionSubmitOrder:
+		if !a.Side.IsLong() && !a.Side.IsShort() {
+			return fmt.Errorf("%w %s %w", errInvalidAction, a.Type, order.ErrSideIsInvalid)
+		}
+		if a.Amount <= 0 {
+			return fmt.Errorf("%w %s %w", errInvalidAction, a.Type, order.ErrAmountIsInvalid)
+		}
+		switch a.OrderType {
+		case order.Market:
+		case order.Limit:
+			if a.Price <= 0 {
+				return fmt.Errorf("%w %s %w", errInvalidAction, a.Type, order.ErrPriceMustBeSetIfLimitOrder)
+			}
+		default:
+			return fmt.Errorf("%w %s %w", errInvalidAction, a.Type, order.ErrUnsupportedOrderType)
+		}
+	case ActionCancelOrder:
+		if a.OrderID == "" {
+			return fmt.Errorf("%w %s %w", errInvalidAction, a.Type, order.ErrOrderIDNotSet)
+		}
+	case ActionRunScript:
+		if a.Script == "" {
+			return fmt.Errorf("%w %s requires a script", errInvalidAction, a.Type)
+		}
+		if _, err := eventScriptPath(a.Script); err != nil {
+			return fmt.Errorf("%w %s %w", errInvalidAction, a.Type, err)
+		}
+	case ActionComms:
+	default:
+		return fmt.Errorf("%w %q", errInvalidAction, a.Type)
+	}
 	return nil
 }
 
+// eventScriptPath resolves a script action against the gctscript directory
+// and rejects paths which resolve outside of it
+func eventScriptPath(script string) (string, error) {
+	filename := filepath.Clean(script)
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(gctscript.ScriptPath, filename)
+	}
+	if !strings.HasPrefix(filename, filepath.Clean(gctscript.ScriptPath)+string(os.PathSeparator)) {
+		return "", fmt.Errorf("%w %q", errInvalidScriptPath, script)
+	}
+	return filename, nil
+}
+
 // isValidExchange validates the exchange
 func (m *eventManager) isValidExchange(exchangeName string) bool {
 	_, err := m.exchangeManager.GetExchangeByName(exchangeName)
@@ -252,21 +618,107 @@ func isValidCondition(condition string) bool {
 func isValidItem(item string) bool {
 	item = strings.ToUpper(item)
 	switch item {
-	case ItemPrice, ItemOrderbook:
+	case ItemPrice, ItemOrderbook, ItemRSI, ItemBollinger, ItemMACD, ItemFundingRate, ItemSpread, ItemBalanceChange:
 		return true
 	}
 	return false
 }
 
+// withDefaults returns a copy of the rule with upper case items and operators
+// and default indicator parameters set
+func (r EventRule) withDefaults() EventRule {
+	r.Item = strings.ToUpper(r.Item)
+	r.Operator = strings.ToUpper(r.Operator)
+	switch r.Item {
+	case ItemRSI, ItemBollinger, ItemMACD:
+		if r.Condition.Interval == 0 {
+			r.Condition.Interval = defaultIndicatorInterval
+		}
+	}
+	switch r.Item {
+	case ItemRSI:
+		if r.Condition.Period == 0 {
+			r.Condition.Period = defaultRSIPeriod
+		}
+	case ItemBollinger:
+		if r.Condition.Period == 0 {
+			r.Condition.Period = defaultBollingerPeriod
+		}
+		if r.Condition.StdDev == 0 {
+			r.Condition.StdDev = defaultBollingerStdDev
+		}
+	case ItemMACD:
+		if r.Condition.FastPeriod == 0 {
+			r.Condition.FastPeriod = defaultMACDFastPeriod
+		}
+		if r.Condition.SlowPeriod == 0 {
+			r.Condition.SlowPeriod = defaultMACDSlowPeriod
+		}
+		if r.Condition.SignalPeriod == 0 {
+			r.Condition.SignalPeriod = defaultMACDSignalPeriod
+		}
+	}
+	if len(r.Rules) > 0 {
+		rules := make([]EventRule, len(r.Rules))
+		for i := range r.Rules {
+			rules[i] = r.Rules[i].withDefaults()
+		}
+		r.Rules = rules
+	}
+	return r
+}
+
+// threshold returns the condition value an item is compared against
+func (r *EventRule) threshold() float64 {
+	switch r.Item {
+	case ItemPrice:
+		return r.Condition.Price
+	case ItemOrderbook:
+		return r.Condition.OrderbookAmount
+	}
+	return r.Condition.Value
+}
+
+// String turns a rule into a string
+func (r *EventRule) String() string {
+	if len(r.Rules) == 0 {
+		return fmt.Sprintf("%s %s %v", r.Item, r.Condition.Condition, r.threshold())
+	}
+	rules := make([]string, len(r.Rules))
+	for i := range r.Rules {
+		rules[i] = r.Rules[i].String()
+	}
+	return "(" + strings.Join(rules, " "+r.Operator+" ") + ")"
+}
+
 // String turns the structure event into a string
 func (e *Event) String() string {
+	actions := make([]string, 0, len(e.Actions)+1)
+	if e.Action != "" {
+		actions = append(actions, e.Action)
+	}
+	for i := range e.Actions {
+		actions = append(actions, e.Actions[i].Type)
+	}
 	return fmt.Sprintf(
-		"If the %s [%s] %s on %s meets the following %v then %s.", e.Pair.String(),
-		strings.ToUpper(e.Asset.String()), e.Item, e.Exchange, e.Condition, e.Action,
+		"If the %s [%s] on %s meets %s then %s.", e.Pair.String(),
+		strings.ToUpper(e.Asset.String()), e.Exchange, e.EventRule.String(), strings.Join(actions, ","),
 	)
 }
 
-func (e *Event) processTicker() error {
+// canTrigger returns whether an event has not yet triggered, or is repeating
+// and its cooldown has elapsed
+func (e *Event) canTrigger(now time.Time) bool {
+	if e.TriggerCount == 0 {
+		return true
+	}
+	if !e.Repeat {
+		return false
+	}
+	return !now.Before(e.LastTriggered.Add(e.Cooldown))
+}
+
+func (e *Event) processTicker(r *EventRule) error {
 	t, err := ticker.GetTicker(e.Exchange, e.Pair, e.Asset)
 	if err != nil {
 		return fmt.Errorf("failed to get ticker. Err: %w", err)
@@ -275,11 +727,11 @@ func (e *Event) processTicker() error {
 	if t.Last == 0 {
 		return errTickerLastPriceZero
 	}
-	return e.shouldProcessEvent(t.Last, e.Condition.Price)
+	return r.shouldProcessEvent(t.Last, r.Condition.Price)
 }
 
-func (e *Event) shouldProcessEvent(actual, threshold float64) error {
-	switch e.Condition.Condition {
+func (r *EventRule) shouldProcessEvent(actual, threshold float64) error {
+	switch r.Condition.Condition {
 	case ConditionGreaterThan:
 		if actual > threshold {
 			return nil
@@ -301,32 +753,32 @@ func (e *Event) shouldProcessEvent(actual, threshold float64) error {
 			return nil
 		}
 	}
-	return errors.New("does not meet conditions")
+	return errConditionNotMet
 }
 
-func (e *Event) processOrderbook() error {
+func (e *Event) processOrderbook(r *EventRule) error {
 	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
 	if err != nil {
 		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
 	}
-	if !e.Condition.CheckBids && !e.Condition.CheckAsks {
+	if !r.Condition.CheckBids && !r.Condition.CheckAsks {
 		return nil
 	}
 
-	if e.Condition.CheckBids {
+	if r.Condition.CheckBids {
 		for x := range ob.Bids {
 			subtotal := ob.Bids[x].Amount * ob.Bids[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
+			err = r.shouldProcessEvent(subtotal, r.Condition.OrderbookAmount)
 			if err == nil {
 				log.Debugf(log.EventMgr, "Events: Bid Amount: %f Price: %v Subtotal: %v\n", ob.Bids[x].Amount, ob.Bids[x].Price, subtotal)
 			}
 		}
 	}
 
-	if e.Condition.CheckAsks {
+	if r.Condition.CheckAsks {
 		for x := range ob.Asks {
 			subtotal := ob.Asks[x].Amount * ob.Asks[x].Price
-			err = e.shouldProcessEvent(subtotal, e.Condition.OrderbookAmount)
+			err = r.shouldProcessEvent(subtotal, r.Condition.OrderbookAmount)
 			if err == nil {
 				log.Debugf(log.EventMgr, "Events: Ask Amount: %f Price: %v Subtotal: %v\n", ob.Asks[x].Amount, ob.Asks[x].Price, subtotal)
 			}
@@ -334,3 +786,202 @@ func (e *Event) processOrderbook() error {
 	}
 	return err
 }
+
+// processSpread compares the spread between the best bid and ask as a
+// percentage of the mid price
+func (e *Event) processSpread(r *EventRule) error {
+	ob, err := orderbook.Get(e.Exchange, e.Pair, e.Asset)
+	if err != nil {
+		return fmt.Errorf("events: Failed to get orderbook. Err: %w", err)
+	}
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		return errEmptyOrderbook
+	}
+	mid := (ob.Asks[0].Price + ob.Bids[0].Price) / 2
+	if mid <= 0 {
+		return errEmptyOrderbook
+	}
+	spread := (ob.Asks[0].Price - ob.Bids[0].Price) / mid * 100
+	return r.shouldProcessEvent(spread, r.Condition.Value)
+}
+
+// processIndicator compares the RSI, Bollinger band position or MACD
+// histogram of the latest closed candle
+func (m *eventManager) processIndicator(e *Event, r *EventRule) error {
+	var required int64
+	switch r.Item {
+	case ItemRSI:
+		required = r.Condition.Period + 1
+	case ItemBollinger:
+		required = r.Condition.Period
+	case ItemMACD:
+		required = r.Condition.SlowPeriod + r.Condition.SignalPeriod
+	}
+	k, err := m.getClosedCandles(e, r.Condition.Interval, required*eventIndicatorCandleMultiplier)
+	if err != nil {
+		return err
+	}
+	if int64(len(k.Candles)) < required {
+		return fmt.Errorf("%s %w, received %d of %d", r.Item, errNoIndicatorData, len(k.Candles), required)
+	}
+	var value float64
+	switch r.Item {
+	case ItemRSI:
+		rsi, err := k.GetRelativeStrengthIndexOnClose(r.Condition.Period)
+		if err != nil {
+			return err
+		}
+		value = rsi[len(rsi)-1]
+	case ItemBollinger:
+		bands, err := k.GetBollingerBands(r.Condition.Period, r.Condition.StdDev, r.Condition.StdDev, indicators.Sma)
+		if err != nil {
+			return err
+		}
+		last := len(bands.Upper) - 1
+		width := bands.Upper[last] - bands.Lower[last]
+		// a flat market has no band width so the close sits in the middle
+		value = 0.5
+		if width > 0 {
+			value = (k.Candles[len(k.Candles)-1].Close - bands.Lower[last]) / width
+		}
+	case ItemMACD:
+		macd, err := k.GetMovingAverageConvergenceDivergenceOnClose(r.Condition.FastPeriod, r.Condition.SlowPeriod, r.Condition.SignalPeriod)
+		if err != nil {
+			return err
+		}
+		value = macd.Histogram[len(macd.Histogram)-1]
+	}
+	return r.shouldProcessEvent(value, r.Condition.Value)
+}
+
+// getClosedCandles returns at most count closed candles, which are cached
+// until the next candle closes
+func (m *eventManager) getClosedCandles(e *Event, interval kline.Interval, count int64) (*kline.Item, error) {
+	duration := interval.Duration()
+	end := time.Now().UTC().Truncate(duration)
+	k := eventCandleKey{
+		ExchangePairAsset: eventMarketKey(e),
+		Interval:          interval,
+	}
+	m.cacheMtx.Lock()
+	cached, ok := m.candles[k]
+	m.cacheMtx.Unlock()
+	if ok && cached.end.Equal(end) && cached.requested >= count {
+		return cached.item, nil
+	}
+
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), eventRequestTimeout)
+	defer cancel()
+	item, err := exch.GetHistoricCandles(ctx, e.Pair, e.Asset, interval, end.Add(-duration*time.Duration(count)), end)
+	if err != nil {
+		return nil, err
+	}
+	// the latest candle may still be open
+	item.Candles = slices.DeleteFunc(item.Candles, func(c kline.Candle) bool {
+		return c.Time.Add(duration).After(end)
+	})
+	m.cacheMtx.Lock()
+	m.candles[k] = &eventCandles{end: end, requested: count, item: item}
+	m.cacheMtx.Unlock()
+	return item, nil
+}
+
+// eventMarketKey returns the cache key of an event's exchange, pair and asset
+func eventMarketKey(e *Event) key.ExchangePairAsset {
+	return key.ExchangePairAsset{
+		Exchange: strings.ToLower(e.Exchange),
+		Base:     e.Pair.Base.Item,
+		Quote:    e.Pair.Quote.Item,
+		Asset:    e.Asset,
+	}
+}
+
+// processFundingRate compares the latest funding rate, which is cached to
+// avoid requesting it every check
+func (m *eventManager) processFundingRate(e *Event, r *EventRule) error {
+	k := eventMarketKey(e)
+	m.cacheMtx.Lock()
+	cached, ok := m.fundingRates[k]
+	m.cacheMtx.Unlock()
+	if !ok || time.Since(cached.fetched) > eventFundingRateCacheTime {
+		exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), eventRequestTimeout)
+		defer cancel()
+		rates, err := exch.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{
+			Asset: e.Asset,
+			Pair:  e.Pair,
+		})
+		if err != nil {
+			return err
+		}
+		if len(rates) != 1 {
+			return fmt.Errorf("expected 1 funding rate, received %v", len(rates))
+		}
+		cached = &eventFundingRate{
+			rate:    rates[0].LatestRate.Rate.InexactFloat64(),
+			fetched: time.Now(),
+		}
+		m.cacheMtx.Lock()
+		m.fundingRates[k] = cached
+		m.cacheMtx.Unlock()
+	}
+	return r.shouldProcessEvent(cached.rate, r.Condition.Value)
+}
+
+// processBalanceChange compares the change of a currency's total balance
+// against the balance when the event was first checked or last triggered
+func (m *eventManager) processBalanceChange(e *Event, r *EventRule) error {
+	exch, err := m.exchangeManager.GetExchangeByName(e.Exchange)
+	if err != nil {
+		return err
+	}
+	creds, err := exch.GetCredentials(context.TODO())
+	if err != nil {
+		return err
+	}
+	holdings, err := account.GetHoldings(exch.GetName(), creds, e.Asset)
+	if err != nil {
+		return err
+	}
+	var total float64
+	for i := range holdings.Accounts {
+		for j := range holdings.Accounts[i].Currencies {
+			if holdings.Accounts[i].Currencies[j].Currency.Equal(r.Condition.Currency) {
+				total += holdings.Accounts[i].Currencies[j].Total
+			}
+		}
+	}
+	k := eventBalanceKey{
+		eventID:  e.ID,
+		exchange: strings.ToLower(e.Exchange),
+		asset:    e.Asset,
+		currency: r.Condition.Currency.Item,
+	}
+	m.cacheMtx.Lock()
+	reference, ok := m.balances[k]
+	if !ok {
+		m.balances[k] = total
+		reference = total
+	}
+	m.cacheMtx.Unlock()
+	return r.shouldProcessEvent(total-reference, r.Condition.Value)
+}
+
+// resetBalanceReferences removes the reference balances of an event so
+// balance changes are measured from the next check
+func (m *eventManager) resetBalanceReferences(eventID int64) {
+	m.cacheMtx.Lock()
+	defer m.cacheMtx.Unlock()
+	for k := range m.balances {
+		if k.eventID == eventID {
+			delete(m.balances, k)
+		}
+	}
+}
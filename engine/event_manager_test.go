@@ -1,32 +1,213 @@
 package engine
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/eventrule"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 )
 
+// evmExchange serves candles, funding rates and credentials without network
+// requests
+type evmExchange struct {
+	omfExchange
+	closes      []float64
+	fundingRate float64
+	creds       *account.Credentials
+}
+
+func (f *evmExchange) GetHistoricCandles(_ context.Context, pair currency.Pair, a asset.Item, interval kline.Interval, _, end time.Time) (*kline.Item, error) {
+	item := &kline.Item{Exchange: testExchange, Pair: pair, Asset: a, Interval: interval}
+	// the final candle is still open and should be ignored
+	for i := range len(f.closes) + 1 {
+		price := f.closes[min(i, len(f.closes)-1)]
+		item.Candles = append(item.Candles, kline.Candle{
+			Time:  end.Add(-interval.Duration() * time.Duration(len(f.closes)-i)),
+			Open:  price,
+			High:  price,
+			Low:   price,
+			Close: price,
+		})
+	}
+	return item, nil
+}
+
+func (f *evmExchange) GetLatestFundingRates(_ context.Context, r *fundingrate.LatestRateRequest) ([]fundingrate.LatestRateResponse, error) {
+	return []fundingrate.LatestRateResponse{{
+		Exchange:   testExchange,
+		Asset:      r.Asset,
+		Pair:       r.Pair,
+		LatestRate: fundingrate.Rate{Rate: decimal.NewFromFloat(f.fundingRate)},
+	}}, nil
+}
+
+func (f *evmExchange) GetCredentials(context.Context) (*account.Credentials, error) {
+	return f.creds, nil
+}
+
+type evtComms struct {
+	m      sync.Mutex
+	events []base.Event
+}
+
+func (c *evtComms) PushEvent(evt base.Event) {
+	c.m.Lock()
+	c.events = append(c.events, evt)
+	c.m.Unlock()
+}
+
+func (c *evtComms) getEvents() []base.Event {
+	c.m.Lock()
+	defer c.m.Unlock()
+	return slices.Clone(c.events)
+}
+
+type evtOrderManager struct {
+	submitted []*order.Submit
+	cancelled []*order.Cancel
+}
+
+func (o *evtOrderManager) IsRunning() bool { return true }
+
+func (o *evtOrderManager) Submit(_ context.Context, s *order.Submit) (*OrderSubmitResponse, error) {
+	o.submitted = append(o.submitted, s)
+	return &OrderSubmitResponse{Detail: &order.Detail{OrderID: "evt"}}, nil
+}
+
+func (o *evtOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	o.cancelled = append(o.cancelled, c)
+	return nil
+}
+
+type evtDB struct {
+	m       sync.Mutex
+	nextID  int64
+	records map[int64]string
+}
+
+func (d *evtDB) Insert(r *eventrule.Record) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.records == nil {
+		d.records = make(map[int64]string)
+	}
+	d.nextID++
+	r.ID = d.nextID
+	d.records[r.ID] = r.Definition
+	return nil
+}
+
+func (d *evtDB) Update(r *eventrule.Record) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if _, ok := d.records[r.ID]; !ok {
+		return errEventNotFound
+	}
+	d.records[r.ID] = r.Definition
+	return nil
+}
+
+func (d *evtDB) Delete(id int64) error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	delete(d.records, id)
+	return nil
+}
+
+func (d *evtDB) GetAll() ([]eventrule.Record, error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	resp := make([]eventrule.Record, 0, len(d.records))
+	for _, id := range slices.Sorted(maps.Keys(d.records)) {
+		resp = append(resp, eventrule.Record{ID: id, Definition: d.records[id]})
+	}
+	return resp, nil
+}
+
+// eventSetup returns an event manager which is marked as started but is only
+// advanced by calling processEvents directly
+func eventSetup(t *testing.T) (*eventManager, *evmExchange) {
+	t.Helper()
+	c := &config.Config{}
+	require.NoError(t, c.LoadConfig(config.TestFile, true), "LoadConfig must not error")
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err, "NewExchangeByName must not error")
+	exch.SetDefaults()
+	cfg, err := c.GetExchangeConfig(testExchange)
+	require.NoError(t, err, "GetExchangeConfig must not error")
+	require.NoError(t, exch.Setup(cfg), "Setup must not error")
+	f := &evmExchange{omfExchange: omfExchange{IBotExchange: exch}, creds: &account.Credentials{Key: t.Name()}}
+	require.NoError(t, em.Add(f), "ExchangeManager Add must not error")
+	m, err := setupEventManager(&evtComms{}, em, &evtOrderManager{}, nil, &evtDB{}, time.Hour, false, false)
+	require.NoError(t, err, "setupEventManager must not error")
+	m.started = 1
+	return m, f
+}
+
+func eventPair(base string) currency.Pair {
+	return currency.NewPair(currency.NewCode(base), currency.USD)
+}
+
+func eventPrices(t *testing.T, pair currency.Pair, last, bid, ask float64) {
+	t.Helper()
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: testExchange,
+		Pair:         pair,
+		AssetType:    asset.Spot,
+		Last:         last,
+	}), "ProcessTicker must not error")
+	b := &orderbook.Base{
+		Exchange:    testExchange,
+		Pair:        pair,
+		Asset:       asset.Spot,
+		Bids:        orderbook.Tranches{{Price: bid, Amount: 1}},
+		Asks:        orderbook.Tranches{{Price: ask, Amount: 1}},
+		LastUpdated: time.Now(),
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+}
+
 func TestSetupEventManager(t *testing.T) {
 	t.Parallel()
-	_, err := setupEventManager(nil, nil, 0, false)
+	_, err := setupEventManager(nil, nil, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, errNilComManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilComManager)
 	}
 
-	_, err = setupEventManager(&CommunicationManager{}, nil, 0, false)
+	_, err = setupEventManager(&CommunicationManager{}, nil, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, errNilExchangeManager) {
 		t.Errorf("error '%v', expected '%v'", err, errNilExchangeManager)
 	}
 
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, nil) {
 		t.Fatalf("error '%v', expected '%v'", err, nil)
 	}
@@ -39,7 +220,7 @@ func TestSetupEventManager(t *testing.T) {
 }
 
 func TestEventManagerStart(t *testing.T) {
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -62,7 +243,7 @@ func TestEventManagerStart(t *testing.T) {
 
 func TestEventManagerIsRunning(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -85,7 +266,7 @@ func TestEventManagerIsRunning(t *testing.T) {
 
 func TestEventManagerStop(t *testing.T) {
 	t.Parallel()
-	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, &ExchangeManager{}, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -111,11 +292,11 @@ func TestEventManagerStop(t *testing.T) {
 func TestEventManagerAdd(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
-	_, err = m.Add("", "", EventConditionParams{}, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, "")
+	_, err = m.Add(&Event{Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot})
 	if !errors.Is(err, ErrSubSystemNotStarted) {
 		t.Errorf("error '%v', expected '%v'", err, ErrSubSystemNotStarted)
 	}
@@ -123,7 +304,7 @@ func TestEventManagerAdd(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
-	_, err = m.Add("", "", EventConditionParams{}, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, "")
+	_, err = m.Add(&Event{Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot})
 	if !errors.Is(err, errExchangeDisabled) {
 		t.Errorf("error '%v', expected '%v'", err, errExchangeDisabled)
 	}
@@ -136,7 +317,7 @@ func TestEventManagerAdd(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
 	}
-	_, err = m.Add(testExchange, "", EventConditionParams{}, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, "")
+	_, err = m.Add(&Event{Exchange: testExchange, Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot})
 	if !errors.Is(err, errInvalidItem) {
 		t.Errorf("error '%v', expected '%v'", err, errInvalidItem)
 	}
@@ -146,18 +327,18 @@ func TestEventManagerAdd(t *testing.T) {
 		Price:           1337,
 		OrderbookAmount: 1337,
 	}
-	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, "")
+	_, err = m.Add(&Event{Exchange: testExchange, EventRule: EventRule{Item: ItemPrice, Condition: cond}, Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot})
 	if !errors.Is(err, errInvalidAction) {
 		t.Errorf("error '%v', expected '%v'", err, errInvalidAction)
 	}
 
-	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, ActionTest)
+	_, err = m.Add(&Event{Exchange: testExchange, EventRule: EventRule{Item: ItemPrice, Condition: cond}, Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot, Action: ActionTest})
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
 
 	action := ActionSMSNotify + "," + ActionTest
-	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, action)
+	_, err = m.Add(&Event{Exchange: testExchange, EventRule: EventRule{Item: ItemPrice, Condition: cond}, Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot, Action: action})
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -166,7 +347,7 @@ func TestEventManagerAdd(t *testing.T) {
 func TestEventManagerRemove(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -195,7 +376,7 @@ func TestEventManagerRemove(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
 	}
-	id, err := m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, action)
+	id, err := m.Add(&Event{Exchange: testExchange, EventRule: EventRule{Item: ItemPrice, Condition: cond}, Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot, Action: action})
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -208,7 +389,7 @@ func TestEventManagerRemove(t *testing.T) {
 func TestGetEventCounter(t *testing.T) {
 	t.Parallel()
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, nil, 0, false, false)
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -239,7 +420,7 @@ func TestGetEventCounter(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
 	}
-	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USDC), asset.Spot, action)
+	_, err = m.Add(&Event{Exchange: testExchange, EventRule: EventRule{Item: ItemPrice, Condition: cond}, Pair: currency.NewPair(currency.BTC, currency.USDC), Asset: asset.Spot, Action: action})
 	if !errors.Is(err, nil) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
@@ -252,7 +433,7 @@ func TestGetEventCounter(t *testing.T) {
 
 func TestCheckEventCondition(t *testing.T) {
 	em := NewExchangeManager()
-	m, err := setupEventManager(&CommunicationManager{}, em, 0, false)
+	m, err := setupEventManager(&CommunicationManager{}, em, nil, nil, nil, 0, false, false)
 	require.NoError(t, err, "setupEventManager must not error")
 
 	m.m.Lock()
@@ -284,7 +465,7 @@ func TestCheckEventCondition(t *testing.T) {
 	err = em.Add(exch)
 	require.NoError(t, err, "ExchangeManager Add must not error")
 
-	_, err = m.Add(testExchange, ItemPrice, cond, currency.NewPair(currency.BTC, currency.USD), asset.Spot, action)
+	_, err = m.Add(&Event{Exchange: testExchange, EventRule: EventRule{Item: ItemPrice, Condition: cond}, Pair: currency.NewPair(currency.BTC, currency.USD), Asset: asset.Spot, Action: action})
 	require.NoError(t, err, "eventManager Add must not error")
 
 	m.m.Lock()
@@ -301,7 +482,6 @@ func TestCheckEventCondition(t *testing.T) {
 	m.m.Unlock()
 
 	m.events[0].Item = ItemOrderbook
-	m.events[0].Executed = false
 	m.events[0].Condition.CheckAsks = true
 	m.events[0].Condition.CheckBids = true
 
@@ -318,3 +498,458 @@ func TestCheckEventCondition(t *testing.T) {
 	assert.NoError(t, err, "checkEventCondition should not error")
 	m.m.Unlock()
 }
+
+func TestEventManagerAddRules(t *testing.T) {
+	t.Parallel()
+	m, _ := eventSetup(t)
+	pair := eventPair("EVTADD")
+	price := EventRule{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 1}}
+	add := func(r EventRule, actions ...EventAction) (int64, error) {
+		return m.Add(&Event{Exchange: testExchange, EventRule: r, Pair: pair, Asset: asset.Spot, Actions: actions})
+	}
+	comms := EventAction{Type: ActionComms}
+
+	_, err := m.Add(nil)
+	assert.ErrorIs(t, err, errNilEvent)
+
+	_, err = add(EventRule{Operator: "XOR", Rules: []EventRule{price}}, comms)
+	assert.ErrorIs(t, err, errInvalidOperator)
+	_, err = add(EventRule{Item: ItemPrice, Operator: OperatorAnd, Rules: []EventRule{price}}, comms)
+	assert.ErrorIs(t, err, errInvalidItem, "composite rules should not have an item")
+	_, err = add(EventRule{Operator: OperatorAnd, Rules: []EventRule{price, {Item: ItemSpread}}}, comms)
+	assert.ErrorIs(t, err, errInvalidCondition, "nested rules should be validated")
+	_, err = add(EventRule{Item: ItemMACD, Condition: EventConditionParams{Condition: ConditionGreaterThan, FastPeriod: 30}}, comms)
+	assert.ErrorIs(t, err, errInvalidCondition, "MACD fast period should be less than the slow period")
+	_, err = add(EventRule{Item: ItemFundingRate, Condition: EventConditionParams{Condition: ConditionGreaterThan}}, comms)
+	assert.ErrorIs(t, err, errInvalidCondition, "funding rate should require a futures asset")
+	_, err = add(EventRule{Item: ItemBalanceChange, Condition: EventConditionParams{Condition: ConditionLessThan}}, comms)
+	assert.ErrorIs(t, err, errInvalidCondition, "balance change should require a currency")
+
+	_, err = add(price)
+	assert.ErrorIs(t, err, errInvalidAction, "an action should be required")
+	_, err = add(price, EventAction{Type: ActionSubmitOrder, Amount: 1, OrderType: order.Market})
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+	_, err = add(price, EventAction{Type: ActionSubmitOrder, Side: order.Buy, OrderType: order.Market})
+	assert.ErrorIs(t, err, order.ErrAmountIsInvalid)
+	_, err = add(price, EventAction{Type: ActionSubmitOrder, Side: order.Buy, Amount: 1, OrderType: order.Limit})
+	assert.ErrorIs(t, err, order.ErrPriceMustBeSetIfLimitOrder)
+	_, err = add(price, EventAction{Type: ActionSubmitOrder, Side: order.Buy, Amount: 1, OrderType: order.Stop})
+	assert.ErrorIs(t, err, order.ErrUnsupportedOrderType)
+	_, err = add(price, EventAction{Type: ActionCancelOrder})
+	assert.ErrorIs(t, err, order.ErrOrderIDNotSet)
+	_, err = add(price, EventAction{Type: ActionRunScript})
+	assert.ErrorIs(t, err, errInvalidAction)
+	_, err = add(price, EventAction{Type: ActionRunScript, Script: filepath.Join("..", "..", "escape")})
+	assert.ErrorIs(t, err, errInvalidScriptPath)
+	_, err = add(price, EventAction{Type: "TELEPORT"})
+	assert.ErrorIs(t, err, errInvalidAction)
+	_, err = m.Add(&Event{Exchange: testExchange, EventRule: price, Pair: pair, Asset: asset.Spot, Action: ActionTest, Cooldown: -time.Second})
+	assert.ErrorIs(t, err, errInvalidCooldown)
+
+	id, err := add(EventRule{
+		Operator: "or",
+		Rules: []EventRule{
+			{Item: "rsi", Condition: EventConditionParams{Condition: ConditionLessThan, Value: 30}},
+			{Operator: OperatorAnd, Rules: []EventRule{
+				{Item: ItemMACD, Condition: EventConditionParams{Condition: ConditionGreaterThan}},
+				{Item: ItemBollinger, Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 1}},
+			}},
+		},
+	}, comms, EventAction{Type: "submit_order", Side: order.Buy, OrderType: order.Market, Amount: 1})
+	require.NoError(t, err, "Add must not error")
+
+	events, err := m.GetEvents()
+	require.NoError(t, err, "GetEvents must not error")
+	require.Len(t, events, 1, "GetEvents must return the added event")
+	e := events[0]
+	assert.Equal(t, id, e.ID, "Add should return the event ID")
+	assert.Equal(t, OperatorOr, e.Operator, "operator should be upper case")
+	assert.Equal(t, ItemRSI, e.Rules[0].Item, "item should be upper case")
+	assert.Equal(t, int64(defaultRSIPeriod), e.Rules[0].Condition.Period, "RSI period should default")
+	assert.Equal(t, defaultIndicatorInterval, e.Rules[0].Condition.Interval, "indicator interval should default")
+	assert.Equal(t, int64(defaultMACDSlowPeriod), e.Rules[1].Rules[0].Condition.SlowPeriod, "MACD slow period should default")
+	assert.Equal(t, float64(defaultBollingerStdDev), e.Rules[1].Rules[1].Condition.StdDev, "Bollinger deviation should default")
+	assert.Equal(t, ActionSubmitOrder, e.Actions[1].Type, "action type should be upper case")
+
+	db, ok := m.db.(*evtDB)
+	require.True(t, ok, "db must be an evtDB")
+	var stored Event
+	require.NoError(t, json.Unmarshal([]byte(db.records[id]), &stored), "stored definition must unmarshal")
+	assert.Equal(t, e.EventRule, stored.EventRule, "stored definition should hold the rules")
+	assert.Equal(t, e.Actions, stored.Actions, "stored definition should hold the actions")
+
+	_, err = m.GetEvents()
+	assert.NoError(t, err, "GetEvents should not error")
+	m.started = 0
+	_, err = m.GetEvents()
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+}
+
+func TestCheckCompositeRule(t *testing.T) {
+	t.Parallel()
+	m, _ := eventSetup(t)
+	pair := eventPair("EVTRULE")
+	eventPrices(t, pair, 100, 99.9, 100.1)
+
+	priceAbove := EventRule{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 50}}
+	priceBelow := EventRule{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionLessThan, Price: 50}}
+	tightSpread := EventRule{Item: ItemSpread, Condition: EventConditionParams{Condition: ConditionLessThan, Value: 0.5}}
+	wideSpread := EventRule{Item: ItemSpread, Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.5}}
+
+	for _, tc := range []struct {
+		rule EventRule
+		err  error
+	}{
+		{rule: tightSpread},
+		{rule: wideSpread, err: errConditionNotMet},
+		{rule: EventRule{Operator: OperatorAnd, Rules: []EventRule{priceAbove, tightSpread}}},
+		{rule: EventRule{Operator: OperatorAnd, Rules: []EventRule{priceAbove, wideSpread}}, err: errConditionNotMet},
+		{rule: EventRule{Operator: OperatorOr, Rules: []EventRule{priceBelow, tightSpread}}},
+		{rule: EventRule{Operator: OperatorOr, Rules: []EventRule{priceBelow, wideSpread}}, err: errConditionNotMet},
+		{rule: EventRule{Operator: OperatorOr, Rules: []EventRule{
+			priceBelow,
+			{Operator: OperatorAnd, Rules: []EventRule{priceAbove, tightSpread}},
+		}}},
+		{rule: EventRule{Operator: "XOR", Rules: []EventRule{priceAbove}}, err: errInvalidOperator},
+	} {
+		t.Run(tc.rule.String(), func(t *testing.T) {
+			t.Parallel()
+			err := m.checkEventCondition(&Event{Exchange: testExchange, EventRule: tc.rule, Pair: pair, Asset: asset.Spot})
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	empty := eventPair("EVTEMPTY")
+	b := &orderbook.Base{Exchange: testExchange, Pair: empty, Asset: asset.Spot, LastUpdated: time.Now()}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+	err := m.checkEventCondition(&Event{Exchange: testExchange, EventRule: tightSpread, Pair: empty, Asset: asset.Spot})
+	assert.ErrorIs(t, err, errEmptyOrderbook)
+}
+
+func TestEventIndicators(t *testing.T) {
+	t.Parallel()
+	m, f := eventSetup(t)
+	pair := eventPair("EVTTA")
+	// an accelerating uptrend
+	f.closes = make([]float64, 100)
+	for i := range f.closes {
+		f.closes[i] = 100 * math.Pow(1.01, float64(i))
+	}
+	check := func(r EventRule) error {
+		return m.checkEventCondition(&Event{Exchange: testExchange, EventRule: r.withDefaults(), Pair: pair, Asset: asset.Spot})
+	}
+
+	assert.NoError(t, check(EventRule{Item: ItemRSI, Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 70}}), "RSI should be overbought")
+	assert.ErrorIs(t, check(EventRule{Item: ItemRSI, Condition: EventConditionParams{Condition: ConditionLessThan, Value: 30}}), errConditionNotMet)
+	assert.NoError(t, check(EventRule{Item: ItemBollinger, Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.5}}), "close should be in the upper band")
+	assert.NoError(t, check(EventRule{Item: ItemMACD, Condition: EventConditionParams{Condition: ConditionGreaterThan}}), "MACD histogram should be positive")
+
+	k := eventCandleKey{
+		ExchangePairAsset: eventMarketKey(&Event{Exchange: testExchange, Pair: pair, Asset: asset.Spot}),
+		Interval:          defaultIndicatorInterval,
+	}
+	m.cacheMtx.Lock()
+	cached := m.candles[k]
+	m.cacheMtx.Unlock()
+	require.NotNil(t, cached, "candles must be cached")
+	assert.Len(t, cached.item.Candles, len(f.closes), "the open candle should be removed")
+
+	short := eventPair("EVTTASHORT")
+	f.closes = f.closes[:5]
+	err := m.checkEventCondition(&Event{
+		Exchange:  testExchange,
+		EventRule: EventRule{Item: ItemRSI, Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 70}}.withDefaults(),
+		Pair:      short,
+		Asset:     asset.Spot,
+	})
+	assert.ErrorIs(t, err, errNoIndicatorData)
+}
+
+func TestEventFundingRate(t *testing.T) {
+	t.Parallel()
+	m, f := eventSetup(t)
+	f.fundingRate = 0.001
+	e := &Event{
+		Exchange:  testExchange,
+		EventRule: EventRule{Item: ItemFundingRate, Condition: EventConditionParams{Condition: ConditionGreaterThan, Value: 0.0005}},
+		Pair:      eventPair("EVTFUND"),
+		Asset:     asset.PerpetualSwap,
+	}
+	assert.NoError(t, m.checkEventCondition(e), "funding rate should be above the threshold")
+
+	f.fundingRate = 0
+	assert.NoError(t, m.checkEventCondition(e), "funding rate should be cached")
+
+	m.cacheMtx.Lock()
+	for _, v := range m.fundingRates {
+		v.fetched = time.Now().Add(-eventFundingRateCacheTime * 2)
+	}
+	m.cacheMtx.Unlock()
+	assert.ErrorIs(t, m.checkEventCondition(e), errConditionNotMet)
+}
+
+func TestEventBalanceChange(t *testing.T) {
+	t.Parallel()
+	m, f := eventSetup(t)
+	code := currency.NewCode("EVTBAL")
+	setBalance := func(total float64) {
+		require.NoError(t, account.Process(&account.Holdings{
+			Exchange: testExchange,
+			Accounts: []account.SubAccount{{
+				AssetType:  asset.Spot,
+				Currencies: []account.Balance{{Currency: code, Total: total, Free: total}},
+			}},
+		}, f.creds), "account Process must not error")
+	}
+	e := &Event{
+		ID:        1,
+		Exchange:  testExchange,
+		EventRule: EventRule{Item: ItemBalanceChange, Condition: EventConditionParams{Condition: ConditionLessThanOrEqual, Value: -1, Currency: code}},
+		Pair:      eventPair("EVTBAL"),
+		Asset:     asset.Spot,
+	}
+	setBalance(10)
+	assert.ErrorIs(t, m.checkEventCondition(e), errConditionNotMet, "first check should set the reference balance")
+	setBalance(8)
+	assert.NoError(t, m.checkEventCondition(e), "balance should have decreased by more than 1")
+	m.resetBalanceReferences(e.ID)
+	assert.ErrorIs(t, m.checkEventCondition(e), errConditionNotMet, "reset should measure from the current balance")
+}
+
+func TestEventManagerProcessEvents(t *testing.T) {
+	t.Parallel()
+	m, _ := eventSetup(t)
+	pair := eventPair("EVTPROC")
+	eventPrices(t, pair, 100, 99, 101)
+	rule := EventRule{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 50}}
+	add := func(repeat bool, cooldown time.Duration, action string, actions ...EventAction) int64 {
+		t.Helper()
+		id, err := m.Add(&Event{
+			Exchange:  testExchange,
+			EventRule: rule,
+			Pair:      pair,
+			Asset:     asset.Spot,
+			Action:    action,
+			Actions:   actions,
+			Repeat:    repeat,
+			Cooldown:  cooldown,
+		})
+		require.NoError(t, err, "Add must not error")
+		return id
+	}
+	once := add(false, 0, "", EventAction{Type: ActionComms, Relayers: []string{"slack"}, Message: "once"})
+	cooled := add(true, time.Hour, ActionConsolePrint,
+		EventAction{Type: ActionSubmitOrder, Side: order.Buy, OrderType: order.Limit, Amount: 2, Price: 90},
+		EventAction{Type: ActionCancelOrder, OrderID: "1337"})
+	repeating := add(true, 0, "", EventAction{Type: ActionComms, Message: "repeat"})
+
+	m.processEvents()
+	m.processEvents()
+
+	events, err := m.GetEvents()
+	require.NoError(t, err, "GetEvents must not error")
+	counts := make(map[int64]int64)
+	for i := range events {
+		counts[events[i].ID] = events[i].TriggerCount
+		assert.False(t, events[i].LastTriggered.IsZero(), "LastTriggered should be set")
+	}
+	assert.Equal(t, int64(1), counts[once], "one-shot event should trigger once")
+	assert.Equal(t, int64(1), counts[cooled], "event should not trigger during its cooldown")
+	assert.Equal(t, int64(2), counts[repeating], "repeating event should trigger each check")
+
+	total, executed := m.getEventCounter()
+	assert.Equal(t, 3, total, "getEventCounter should return all events")
+	assert.Equal(t, 1, executed, "getEventCounter should only count finished one-shot events")
+
+	pushed := m.comms.(*evtComms).getEvents()
+	require.Len(t, pushed, 4, "comms must receive each triggered message")
+	var relayed, repeated int
+	for i := range pushed {
+		switch pushed[i].Message {
+		case "once":
+			relayed++
+			assert.Equal(t, []string{"slack"}, pushed[i].Relayers, "relayers should be passed to comms")
+		case "repeat":
+			repeated++
+		default:
+			assert.Contains(t, pushed[i].Message, "triggered", "legacy actions should push the trigger message")
+		}
+	}
+	assert.Equal(t, 1, relayed, "one-shot message should be pushed once")
+	assert.Equal(t, 2, repeated, "repeating message should be pushed each trigger")
+
+	om := m.orderManager.(*evtOrderManager)
+	require.Len(t, om.submitted, 1, "an order must be submitted")
+	assert.Equal(t, order.Limit, om.submitted[0].Type, "order type should match the action")
+	assert.Equal(t, 90.0, om.submitted[0].Price, "order price should match the action")
+	assert.True(t, om.submitted[0].Pair.Equal(pair), "order should be on the event pair")
+	require.Len(t, om.cancelled, 1, "an order must be cancelled")
+	assert.Equal(t, "1337", om.cancelled[0].OrderID, "cancelled order ID should match the action")
+
+	db := m.db.(*evtDB)
+	var stored Event
+	require.NoError(t, json.Unmarshal([]byte(db.records[repeating]), &stored), "stored definition must unmarshal")
+	assert.Equal(t, int64(2), stored.TriggerCount, "trigger count should be saved")
+}
+
+func TestEventManagerDryRun(t *testing.T) {
+	t.Parallel()
+	m, _ := eventSetup(t)
+	m.isDryRun = true
+	pair := eventPair("EVTDRY")
+	eventPrices(t, pair, 100, 99, 101)
+	id, err := m.Add(&Event{
+		Exchange:  testExchange,
+		EventRule: EventRule{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 50}},
+		Pair:      pair,
+		Asset:     asset.Spot,
+		Actions: []EventAction{
+			{Type: ActionSubmitOrder, Side: order.Buy, OrderType: order.Market, Amount: 1},
+			{Type: ActionCancelOrder, OrderID: "1337"},
+		},
+	})
+	require.NoError(t, err, "Add must not error")
+
+	m.processEvents()
+
+	events, err := m.GetEvents()
+	require.NoError(t, err, "GetEvents must not error")
+	require.Len(t, events, 1, "GetEvents must return the event")
+	assert.Equal(t, id, events[0].ID)
+	assert.Equal(t, int64(1), events[0].TriggerCount, "event should trigger in dry run mode")
+	om := m.orderManager.(*evtOrderManager)
+	assert.Empty(t, om.submitted, "orders should not be submitted in dry run mode")
+	assert.Empty(t, om.cancelled, "orders should not be cancelled in dry run mode")
+}
+
+type evtScriptManager struct {
+	created int
+}
+
+func (*evtScriptManager) IsRunning() bool { return true }
+
+func (s *evtScriptManager) New() *gctscript.VM {
+	s.created++
+	return nil
+}
+
+func (*evtScriptManager) RemoveVM(uuid.UUID) error { return nil }
+
+func TestEventScriptPath(t *testing.T) {
+	scriptPath := gctscript.ScriptPath
+	gctscript.ScriptPath = filepath.Join(string(os.PathSeparator), "gct", "scripts")
+	t.Cleanup(func() { gctscript.ScriptPath = scriptPath })
+
+	p, err := eventScriptPath("test")
+	require.NoError(t, err, "eventScriptPath must not error")
+	assert.Equal(t, filepath.Join(gctscript.ScriptPath, "test"), p)
+	p, err = eventScriptPath(filepath.Join(gctscript.ScriptPath, "nested", "test"))
+	require.NoError(t, err, "eventScriptPath must not error")
+	assert.Equal(t, filepath.Join(gctscript.ScriptPath, "nested", "test"), p)
+	_, err = eventScriptPath(filepath.Join("..", "test"))
+	assert.ErrorIs(t, err, errInvalidScriptPath)
+	_, err = eventScriptPath(filepath.Join(gctscript.ScriptPath, "..", "test"))
+	assert.ErrorIs(t, err, errInvalidScriptPath)
+	_, err = eventScriptPath(filepath.Join(string(os.PathSeparator), "etc", "passwd"))
+	assert.ErrorIs(t, err, errInvalidScriptPath)
+}
+
+func TestEventManagerExecuteAction(t *testing.T) {
+	t.Parallel()
+	m, _ := eventSetup(t)
+	e := &Event{Exchange: testExchange, Pair: eventPair("EVTACT"), Asset: asset.Spot}
+	err := m.executeAction(e, &EventAction{Type: ActionRunScript, Script: "test"}, "")
+	assert.ErrorIs(t, err, errEventScriptManager)
+	sm := &evtScriptManager{}
+	m.scriptManager = sm
+	err = m.executeAction(e, &EventAction{Type: ActionRunScript, Script: filepath.Join(string(os.PathSeparator), "tmp", "escape")}, "")
+	assert.ErrorIs(t, err, errInvalidScriptPath)
+	assert.Zero(t, sm.created, "a VM should not be created for a script outside of the script path")
+	err = m.executeAction(e, &EventAction{Type: "TELEPORT"}, "")
+	assert.ErrorIs(t, err, errInvalidAction)
+	m.orderManager = nil
+	err = m.executeAction(e, &EventAction{Type: ActionCancelOrder, OrderID: "1"}, "")
+	assert.ErrorIs(t, err, errEventOrderManager)
+}
+
+func TestEventManagerPersistence(t *testing.T) {
+	t.Parallel()
+	m, _ := eventSetup(t)
+	db := m.db.(*evtDB)
+	pair := eventPair("EVTDB")
+	id, err := m.Add(&Event{
+		Exchange:  testExchange,
+		EventRule: EventRule{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 1}},
+		Pair:      pair,
+		Asset:     asset.Spot,
+		Actions:   []EventAction{{Type: ActionComms}},
+		Repeat:    true,
+		Cooldown:  time.Minute,
+	})
+	require.NoError(t, err, "Add must not error")
+	require.Contains(t, db.records, id, "Add must save the event")
+
+	restarted, err := setupEventManager(&evtComms{}, m.exchangeManager, nil, nil, db, time.Hour, false, false)
+	require.NoError(t, err, "setupEventManager must not error")
+	require.NoError(t, restarted.Start(), "Start must not error")
+	events, err := restarted.GetEvents()
+	require.NoError(t, err, "GetEvents must not error")
+	require.Len(t, events, 1, "Start must load saved events")
+	assert.Equal(t, id, events[0].ID, "loaded event should keep its ID")
+	assert.True(t, events[0].Pair.Equal(pair), "loaded event should keep its pair")
+	assert.Equal(t, time.Minute, events[0].Cooldown, "loaded event should keep its cooldown")
+
+	require.NoError(t, restarted.Stop(), "Stop must not error")
+	require.NoError(t, restarted.Start(), "Start must not error")
+	events, err = restarted.GetEvents()
+	require.NoError(t, err, "GetEvents must not error")
+	assert.Len(t, events, 1, "restarting should not reload saved events")
+
+	assert.True(t, restarted.Remove(id), "Remove should remove the event")
+	assert.NotContains(t, db.records, id, "Remove should delete the saved event")
+	require.NoError(t, restarted.Stop(), "Stop must not error")
+}
+
+func TestEventManagerRun(t *testing.T) {
+	t.Parallel()
+	m, _ := eventSetup(t)
+	m.started = 0
+	m.sleepDelay = time.Millisecond * 10
+	pair := eventPair("EVTRUN")
+	eventPrices(t, pair, 100, 99, 101)
+	require.NoError(t, m.Start(), "Start must not error")
+	_, err := m.Add(&Event{
+		Exchange:  testExchange,
+		EventRule: EventRule{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 50}},
+		Pair:      pair,
+		Asset:     asset.Spot,
+		Actions:   []EventAction{{Type: ActionComms, Message: "run"}},
+		Repeat:    true,
+	})
+	require.NoError(t, err, "Add must not error")
+	comms := m.comms.(*evtComms)
+	assert.Eventually(t, func() bool {
+		return len(comms.getEvents()) >= 2
+	}, time.Second*5, time.Millisecond*10, "run should keep checking events")
+	require.NoError(t, m.Stop(), "Stop must not error")
+}
+
+func TestEventString(t *testing.T) {
+	t.Parallel()
+	e := &Event{
+		Exchange: testExchange,
+		EventRule: EventRule{Operator: OperatorOr, Rules: []EventRule{
+			{Item: ItemPrice, Condition: EventConditionParams{Condition: ConditionGreaterThan, Price: 10}},
+			{Item: ItemRSI, Condition: EventConditionParams{Condition: ConditionLessThan, Value: 30}},
+		}},
+		Pair:    currency.NewPair(currency.BTC, currency.USD),
+		Asset:   asset.Spot,
+		Action:  ActionTest,
+		Actions: []EventAction{{Type: ActionSubmitOrder}},
+	}
+	assert.Equal(t, fmt.Sprintf("If the BTCUSD [SPOT] on %s meets (PRICE > 10 OR RSI < 30) then ACTION_TEST,SUBMIT_ORDER.", testExchange), e.String())
+}
@@ -5,14 +5,38 @@ import (
 	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/eventrule"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
 // Event const vars
 const (
 	ItemPrice     = "PRICE"
 	ItemOrderbook = "ORDERBOOK"
+	// ItemRSI compares the relative strength index of the latest closed
+	// candle
+	ItemRSI = "RSI"
+	// ItemBollinger compares the position of the latest close within its
+	// Bollinger bands, where 0 is the lower band and 1 the upper band
+	ItemBollinger = "BOLLINGER"
+	// ItemMACD compares the MACD histogram of the latest closed candle
+	ItemMACD = "MACD"
+	// ItemFundingRate compares the latest funding rate of a perpetual
+	// contract
+	ItemFundingRate = "FUNDING_RATE"
+	// ItemSpread compares the orderbook spread as a percentage of the mid
+	// price
+	ItemSpread = "SPREAD"
+	// ItemBalanceChange compares the change of a currency's total balance
+	// since the event was first evaluated or last triggered
+	ItemBalanceChange = "BALANCE_CHANGE"
+
+	OperatorAnd = "AND"
+	OperatorOr  = "OR"
 
 	ConditionGreaterThan        = ">"
 	ConditionGreaterThanOrEqual = ">="
@@ -24,7 +48,25 @@ const (
 	ActionConsolePrint = "CONSOLE_PRINT"
 	ActionTest         = "ACTION_TEST"
 
+	ActionSubmitOrder = "SUBMIT_ORDER"
+	ActionCancelOrder = "CANCEL_ORDER"
+	ActionRunScript   = "RUN_SCRIPT"
+	ActionComms       = "COMMS"
+
 	defaultSleepDelay = time.Millisecond * 500
+
+	defaultRSIPeriod          = 14
+	defaultBollingerPeriod    = 20
+	defaultBollingerStdDev    = 2
+	defaultMACDFastPeriod     = 12
+	defaultMACDSlowPeriod     = 26
+	defaultMACDSignalPeriod   = 9
+	defaultIndicatorInterval  = kline.OneHour
+	eventFundingRateCacheTime = time.Minute
+	// eventIndicatorCandleMultiplier is how many times more candles than an
+	// indicator's period are requested, allowing smoothed indicators to settle
+	eventIndicatorCandleMultiplier = 3
+	eventRequestTimeout            = time.Second * 30
 )
 
 // vars related to events package
@@ -33,10 +75,20 @@ var (
 	errInvalidItem         = errors.New("invalid item")
 	errInvalidCondition    = errors.New("invalid conditional option")
 	errInvalidAction       = errors.New("invalid action")
+	errInvalidOperator     = errors.New("invalid rule operator")
 	errExchangeDisabled    = errors.New("desired exchange is disabled")
 	errNilEvent            = errors.New("nil event received")
 	errNilComManager       = errors.New("nil communications manager received")
 	errTickerLastPriceZero = errors.New("ticker last price is 0")
+	errConditionNotMet     = errors.New("does not meet conditions")
+	errEventNotFound       = errors.New("event not found")
+	errEmptyOrderbook      = errors.New("orderbook has no bids or asks")
+	errNoIndicatorData     = errors.New("not enough candles to calculate indicator")
+	errEventOrderManager   = errors.New("order manager is not running")
+	errEventScriptManager  = errors.New("gctscript manager is not running")
+	errEventScriptVM       = errors.New("unable to create gctscript virtual machine")
+	errInvalidScriptPath   = errors.New("script path is outside of the gctscript directory")
+	errInvalidCooldown     = errors.New("event cooldown cannot be negative")
 )
 
 // EventConditionParams holds the event condition variables
@@ -47,18 +99,97 @@ type EventConditionParams struct {
 	CheckBids       bool
 	CheckAsks       bool
 	OrderbookAmount float64
+
+	// Value is the threshold of indicator, funding rate, spread and balance
+	// change items
+	Value float64
+	// Interval is the candle interval indicators are calculated on
+	Interval kline.Interval
+	// Period is the RSI and Bollinger band period
+	Period       int64
+	FastPeriod   int64
+	SlowPeriod   int64
+	SignalPeriod int64
+	// StdDev is the Bollinger band standard deviation multiplier
+	StdDev float64
+	// Currency is the currency of a balance change item
+	Currency currency.Code
 }
 
-// Event struct holds the event variables
-type Event struct {
-	ID        int64
-	Exchange  string
+// EventRule is either a single condition on an item or a composite of rules
+// joined by an AND/OR operator
+type EventRule struct {
 	Item      string
 	Condition EventConditionParams
-	Pair      currency.Pair
-	Asset     asset.Item
-	Action    string
-	Executed  bool
+	Operator  string
+	Rules     []EventRule
+}
+
+// EventAction is an action taken when an event triggers
+type EventAction struct {
+	Type string
+	// Side, OrderType, Amount and Price define an order submitted on the
+	// event's exchange, pair and asset. An unset side cannot be unmarshalled
+	// so is omitted when stored
+	Side      order.Side `json:",omitempty"`
+	OrderType order.Type
+	Amount    float64
+	Price     float64
+	// OrderID is the order cancelled on the event's exchange
+	OrderID string
+	// Script is the gctscript run, relative to the script directory unless
+	// an absolute path
+	Script string
+	// Relayers are the comms relayers the message is pushed to, where empty
+	// pushes to all relayers
+	Relayers []string
+	// Message replaces the default trigger message
+	Message string
+}
+
+// Event struct holds the event variables
+type Event struct {
+	ID       int64
+	Exchange string
+	EventRule
+	Pair   currency.Pair
+	Asset  asset.Item
+	Action string
+	// Actions are taken in order when the event triggers
+	Actions []EventAction
+	// Repeat allows an event to trigger more than once, waiting at least the
+	// cooldown between triggers
+	Repeat        bool
+	Cooldown      time.Duration
+	TriggerCount  int64
+	LastTriggered time.Time
+}
+
+// eventCandleKey identifies cached candles used by indicator items
+type eventCandleKey struct {
+	key.ExchangePairAsset
+	Interval kline.Interval
+}
+
+// eventCandles are closed candles up to a candle boundary
+type eventCandles struct {
+	end       time.Time
+	requested int64
+	item      *kline.Item
+}
+
+// eventFundingRate is a cached funding rate
+type eventFundingRate struct {
+	rate    float64
+	fetched time.Time
+}
+
+// eventBalanceKey identifies the reference balance of a balance change item
+type eventBalanceKey struct {
+	eventID  int64
+	exchange string
+	asset    asset.Item
+	currency *currency.Item
 }
 
 // eventManager holds communication manager data
@@ -66,9 +197,22 @@ type eventManager struct {
 	started         int32
 	comms           iCommsManager
 	events          []Event
+	nextID          int64
+	loaded          bool
+	isDryRun        bool
 	verbose         bool
 	sleepDelay      time.Duration
 	exchangeManager iExchangeManager
+	orderManager    iEventOrderManager
+	scriptManager   iEventScriptManager
+	db              eventrule.IDBService
 	shutdown        chan struct{}
+	wg              sync.WaitGroup
 	m               sync.Mutex
+	// cacheMtx protects market data and balance references used when
+	// evaluating rules
+	cacheMtx     sync.Mutex
+	candles      map[eventCandleKey]*eventCandles
+	fundingRates map[key.ExchangePairAsset]*eventFundingRate
+	balances     map[eventBalanceKey]float64
 }
@@ -82,6 +82,12 @@ func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 		WebsocketName:                 bot.Settings.EnableWebsocketRPC,
 		dispatch.Name:                 dispatch.IsRunning(),
 		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
+		OrderbookRecorderName:         bot.orderbookRecorder.IsRunning(),
+		AlgoExecutionManagerName:      bot.algoExecutionManager.IsRunning(),
+		MarketMakingManagerName:       bot.marketMakingManager.IsRunning(),
+		StrategyRunnerManagerName:     bot.strategyRunnerManager.IsRunning(),
+		CandleAggregatorName:          bot.candleAggregator.IsRunning(),
+		MetricsManagerName:            bot.metricsManager.IsRunning(),
 		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
 	}
 }
@@ -282,6 +288,81 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.dataHistoryManager.Start()
 		}
 		return bot.dataHistoryManager.Stop()
+	case OrderbookRecorderName:
+		if enable {
+			if bot.orderbookRecorder == nil {
+				bot.orderbookRecorder, err = SetupOrderbookRecorder(bot.DatabaseManager, &bot.Config.OrderbookRecorder)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.orderbookRecorder.Start()
+		}
+		return bot.orderbookRecorder.Stop()
+	case AlgoExecutionManagerName:
+		if enable {
+			if !bot.OrderManager.IsRunning() {
+				return fmt.Errorf("%s %w", OrderManagerName, ErrSubSystemNotStarted)
+			}
+			if bot.algoExecutionManager == nil {
+				bot.algoExecutionManager, err = SetupAlgoExecutionManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.AlgoExecutionManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.algoExecutionManager.Start()
+		}
+		return bot.algoExecutionManager.Stop()
+	case MarketMakingManagerName:
+		if enable {
+			if !bot.OrderManager.IsRunning() {
+				return fmt.Errorf("%s %w", OrderManagerName, ErrSubSystemNotStarted)
+			}
+			if bot.marketMakingManager == nil {
+				bot.marketMakingManager, err = SetupMarketMakingManager(bot.ExchangeManager, bot.OrderManager, &bot.Config.MarketMakingManager)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.marketMakingManager.Start()
+		}
+		return bot.marketMakingManager.Stop()
+	case StrategyRunnerManagerName:
+		if enable {
+			if !bot.OrderManager.IsRunning() {
+				return fmt.Errorf("%s %w", OrderManagerName, ErrSubSystemNotStarted)
+			}
+			if bot.strategyRunnerManager == nil {
+				err = bot.setupStrategyRunnerManager()
+				if err != nil {
+					return err
+				}
+			}
+			return bot.strategyRunnerManager.Start()
+		}
+		return bot.strategyRunnerManager.Stop()
+	case CandleAggregatorName:
+		if enable {
+			if bot.candleAggregator == nil {
+				err = bot.setupCandleAggregator()
+				if err != nil {
+					return err
+				}
+			}
+			return bot.candleAggregator.Start()
+		}
+		return bot.candleAggregator.Stop()
+	case MetricsManagerName:
+		if enable {
+			if bot.metricsManager == nil {
+				err = bot.setupMetricsManager()
+				if err != nil {
+					return err
+				}
+			}
+			return bot.metricsManager.Start()
+		}
+		return bot.metricsManager.Stop()
 	case vm.Name:
 		if enable {
 			if bot.gctScriptManager == nil {
@@ -100,8 +100,8 @@ func CreateTestBot(tb testing.TB) *Engine {
 
 func TestGetSubsystemsStatus(t *testing.T) {
 	m := (&Engine{}).GetSubsystemsStatus()
-	if len(m) != 15 {
-		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 15, len(m))
+	if len(m) != 21 {
+		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 21, len(m))
 	}
 }
 
@@ -209,6 +209,42 @@ func TestSetSubsystem(t *testing.T) { //nolint // TO-DO: Fix race t.Parallel() u
 			EnableError:  database.ErrNilInstance,
 			DisableError: ErrNilSubsystem,
 		},
+		{
+			Subsystem:    OrderbookRecorderName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNoOrderbookRecorderPairs,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    AlgoExecutionManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  ErrSubSystemNotStarted,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    MarketMakingManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  ErrSubSystemNotStarted,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    StrategyRunnerManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  ErrSubSystemNotStarted,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    CandleAggregatorName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  ErrNilSubsystem,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    MetricsManagerName,
+			Engine:       &Engine{Config: &config.Config{}, metricsManager: &MetricsManager{listenAddress: "localhost:0"}},
+			EnableError:  nil,
+			DisableError: nil,
+		},
 		{
 			Subsystem:    vm.Name,
 			Engine:       &Engine{Config: &config.Config{}},
@@ -0,0 +1,546 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/marketmaker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupMarketMakingManager creates a market making manager which quotes
+// through the order manager, loading the market makers defined in its config
+func SetupMarketMakingManager(em iExchangeManager, om iMarketMakingOrderManager, cfg *config.MarketMakingManager) (*MarketMakingManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilMarketMakingOrderManager
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w MarketMakingManager", errNilConfig)
+	}
+	m := &MarketMakingManager{
+		shutdown:        make(chan struct{}),
+		interval:        cfg.CheckInterval,
+		verbose:         cfg.Verbose,
+		orderManager:    om,
+		exchangeManager: em,
+		makers:          make(map[uuid.UUID]*MarketMaker),
+	}
+	if m.interval <= 0 {
+		m.interval = defaultMarketMakingCheckInterval
+	}
+	for i := range cfg.MarketMakers {
+		if _, err := m.add(cfg.MarketMakers[i].Exchange, cfg.MarketMakers[i].Asset, cfg.MarketMakers[i].Pair, cfg.MarketMakers[i].Config); err != nil {
+			return nil, fmt.Errorf("market maker %s %s %s: %w", cfg.MarketMakers[i].Exchange, cfg.MarketMakers[i].Asset, cfg.MarketMakers[i].Pair, err)
+		}
+	}
+	return m, nil
+}
+
+// Start runs the subsystem
+func (m *MarketMakingManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemStarting)
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem and cancels all resting quotes. Running market
+// makers resume quoting when the subsystem is started again
+func (m *MarketMakingManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.process.Lock()
+	m.m.Lock()
+	makers := make([]*MarketMaker, 0, len(m.makers))
+	for _, mm := range m.makers {
+		makers = append(makers, mm)
+	}
+	m.m.Unlock()
+	for i := range makers {
+		if err := m.cancelAllQuotes(context.TODO(), makers[i]); err != nil {
+			log.Errorf(log.OrderMgr, "Market maker %v unable to cancel quotes: %v", makers[i].ID, err)
+		}
+	}
+	m.process.Unlock()
+	log.Debugf(log.OrderMgr, "Market making manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MarketMakingManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *MarketMakingManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.processMarketMakers(context.TODO())
+		}
+	}
+}
+
+// String implements the stringer interface
+func (s MarketMakerStatus) String() string {
+	switch s {
+	case MarketMakerRunning:
+		return "RUNNING"
+	case MarketMakerStopped:
+		return "STOPPED"
+	case MarketMakerHalted:
+		return "HALTED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// AddMarketMaker validates and stores a market maker which starts quoting
+// from the next check interval
+func (m *MarketMakingManager) AddMarketMaker(exchangeName string, a asset.Item, pair currency.Pair, cfg marketmaker.Config) (*MarketMaker, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemNotStarted)
+	}
+	return m.add(exchangeName, a, pair, cfg)
+}
+
+func (m *MarketMakingManager) add(exchangeName string, a asset.Item, pair currency.Pair, cfg marketmaker.Config) (*MarketMaker, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	if pair.IsEmpty() {
+		return nil, order.ErrPairIsEmpty
+	}
+	if a != asset.Spot {
+		return nil, fmt.Errorf("%w: %v", errMarketMakingAssetUnsupported, a)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	mm := &MarketMaker{
+		ID:          id,
+		Exchange:    exch.GetName(),
+		Pair:        pair,
+		AssetType:   a,
+		Config:      cfg,
+		Status:      MarketMakerRunning,
+		CreatedAt:   now,
+		LastUpdated: now,
+	}
+
+	m.m.Lock()
+	defer m.m.Unlock()
+	for _, existing := range m.makers {
+		if existing.Status == MarketMakerRunning &&
+			strings.EqualFold(existing.Exchange, mm.Exchange) &&
+			existing.AssetType == a &&
+			existing.Pair.Equal(pair) {
+			return nil, fmt.Errorf("%w %s %s %s", errMarketMakerExists, mm.Exchange, a, pair)
+		}
+	}
+	m.makers[id] = mm
+	resp := mm.copy()
+	log.Infof(log.OrderMgr, "Market maker %v added: %s %s %s %v levels", resp.ID, resp.Exchange, resp.AssetType, resp.Pair, resp.Config.Levels)
+	return &resp, nil
+}
+
+// GetMarketMakers returns a copy of the market makers sorted by creation time
+func (m *MarketMakingManager) GetMarketMakers() ([]MarketMaker, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemNotStarted)
+	}
+	m.m.Lock()
+	resp := make([]MarketMaker, 0, len(m.makers))
+	for _, mm := range m.makers {
+		resp = append(resp, mm.copy())
+	}
+	m.m.Unlock()
+	slices.SortFunc(resp, func(x, y MarketMaker) int {
+		return x.CreatedAt.Compare(y.CreatedAt)
+	})
+	return resp, nil
+}
+
+// StopMarketMaker stops a running market maker and cancels its quotes
+func (m *MarketMakingManager) StopMarketMaker(ctx context.Context, id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return fmt.Errorf("%s %w", MarketMakingManagerName, ErrSubSystemNotStarted)
+	}
+	m.process.Lock()
+	defer m.process.Unlock()
+	m.m.Lock()
+	mm, ok := m.makers[id]
+	if !ok {
+		m.m.Unlock()
+		return fmt.Errorf("%w %v", ErrMarketMakerNotFound, id)
+	}
+	if mm.Status != MarketMakerRunning {
+		m.m.Unlock()
+		return fmt.Errorf("%w %v %v", ErrMarketMakerNotRunning, id, mm.Status)
+	}
+	mm.Status = MarketMakerStopped
+	mm.LastUpdated = time.Now()
+	m.m.Unlock()
+	if err := m.cancelAllQuotes(ctx, mm); err != nil {
+		return err
+	}
+	log.Infof(log.OrderMgr, "Market maker %v stopped with PNL %v", id, mm.PNL)
+	return nil
+}
+
+// processMarketMakers re-quotes every running market maker
+func (m *MarketMakingManager) processMarketMakers(ctx context.Context) {
+	if !m.orderManager.IsRunning() {
+		return
+	}
+	m.process.Lock()
+	defer m.process.Unlock()
+	m.m.Lock()
+	running := make([]*MarketMaker, 0, len(m.makers))
+	for _, mm := range m.makers {
+		if mm.Status == MarketMakerRunning {
+			running = append(running, mm)
+		}
+	}
+	m.m.Unlock()
+	for i := range running {
+		m.processMarketMaker(ctx, running[i])
+	}
+}
+
+// processMarketMaker updates the inventory and profit of a market maker,
+// halting it when its loss limit is reached, otherwise moving its quotes to
+// the ladder around the current reference price
+func (m *MarketMakingManager) processMarketMaker(ctx context.Context, mm *MarketMaker) {
+	// Identifying fields and config are immutable after creation so can be
+	// read without holding the lock
+	exch, err := m.exchangeManager.GetExchangeByName(mm.Exchange)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Market maker %v: %v", mm.ID, err)
+		return
+	}
+	m.pruneQuotes(mm)
+	depth, err := orderbook.GetDepth(mm.Exchange, mm.Pair, mm.AssetType)
+	if err != nil {
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Market maker %v: %v", mm.ID, err)
+		}
+		return
+	}
+	reference, err := marketmaker.GetDepthReferencePrice(depth, mm.Config.PriceSource)
+	if err != nil {
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Market maker %v: %v", mm.ID, err)
+		}
+		return
+	}
+	inventory, err := getMarketMakerInventory(ctx, exch, mm)
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Market maker %v unable to get holdings: %v", mm.ID, err)
+		return
+	}
+
+	m.m.Lock()
+	if !mm.hasStartingInventory {
+		mm.StartingInventory = inventory
+		mm.hasStartingInventory = true
+	}
+	mm.Inventory = inventory
+	mm.ReferencePrice = reference
+	mm.PNL = marketmaker.GetPNL(mm.StartingInventory, inventory, reference)
+	mm.LastUpdated = time.Now()
+	lossErr := mm.Config.CheckLoss(mm.PNL)
+	if lossErr != nil {
+		mm.Status = MarketMakerHalted
+		mm.Error = lossErr.Error()
+	}
+	m.m.Unlock()
+	if lossErr != nil {
+		log.Errorf(log.OrderMgr, "Market maker %v halted: %v", mm.ID, lossErr)
+		if err := m.cancelAllQuotes(ctx, mm); err != nil {
+			log.Errorf(log.OrderMgr, "Market maker %v unable to cancel quotes: %v", mm.ID, err)
+		}
+		return
+	}
+
+	var limits *order.MinMaxLevel
+	if l, err := exch.GetOrderExecutionLimits(mm.AssetType, mm.Pair); err == nil {
+		limits = &l
+	}
+	targets, err := marketmaker.GetQuotes(&mm.Config, reference, inventory.Base, limits)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Market maker %v: %v", mm.ID, err)
+		return
+	}
+	m.requote(ctx, exch, mm, targets)
+}
+
+// pruneQuotes removes resting quotes which have been filled or cancelled
+func (m *MarketMakingManager) pruneQuotes(mm *MarketMaker) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	mm.Quotes = slices.DeleteFunc(mm.Quotes, func(q MarketMakerQuote) bool {
+		d, err := m.orderManager.GetByExchangeAndID(mm.Exchange, q.OrderID)
+		return err != nil || d.IsInactive()
+	})
+}
+
+// requote moves resting quotes to their targets by side and level. Quotes
+// which have drifted are modified, falling back to cancelling and
+// resubmitting them when the exchange cannot modify orders. Quotes without a
+// target are cancelled and targets without a quote are submitted
+func (m *MarketMakingManager) requote(ctx context.Context, exch exchange.IBotExchange, mm *MarketMaker, targets []marketmaker.Quote) {
+	m.m.Lock()
+	resting := slices.Clone(mm.Quotes)
+	cancelReplace := mm.cancelReplace
+	m.m.Unlock()
+
+	keep := make([]MarketMakerQuote, 0, len(targets))
+	var cancels []MarketMakerQuote
+	var submits []marketmaker.Quote
+	matched := make([]bool, len(targets))
+	for i := range resting {
+		idx := slices.IndexFunc(targets, func(t marketmaker.Quote) bool {
+			return t.Side == resting[i].Side && t.Level == resting[i].Level
+		})
+		if idx < 0 || matched[idx] {
+			cancels = append(cancels, resting[i])
+			continue
+		}
+		matched[idx] = true
+		if !marketmaker.NeedsRequote(&resting[i].Quote, &targets[idx], mm.Config.RequoteTolerance) {
+			keep = append(keep, resting[i])
+			continue
+		}
+		if !cancelReplace {
+			resp, err := m.orderManager.Modify(ctx, &order.Modify{
+				Exchange:  mm.Exchange,
+				OrderID:   resting[i].OrderID,
+				Type:      order.Limit,
+				Side:      resting[i].Side,
+				AssetType: mm.AssetType,
+				Pair:      mm.Pair,
+				Price:     targets[idx].Price,
+				Amount:    targets[idx].Amount,
+			})
+			if err == nil {
+				orderID := resting[i].OrderID
+				if resp.OrderID != "" {
+					// some exchanges replace the order when it is modified
+					orderID = resp.OrderID
+				}
+				keep = append(keep, MarketMakerQuote{Quote: targets[idx], OrderID: orderID})
+				if m.verbose {
+					log.Debugf(log.OrderMgr, "Market maker %v modified %v quote %s from %v to %v", mm.ID, resting[i].Side, orderID, resting[i].Price, targets[idx].Price)
+				}
+				continue
+			}
+			if errors.Is(err, common.ErrFunctionNotSupported) || errors.Is(err, common.ErrNotYetImplemented) {
+				cancelReplace = true
+				m.m.Lock()
+				mm.cancelReplace = true
+				m.m.Unlock()
+				log.Warnf(log.OrderMgr, "Market maker %v: %s cannot modify orders, quotes will be cancelled and replaced", mm.ID, mm.Exchange)
+			} else {
+				log.Warnf(log.OrderMgr, "Market maker %v unable to modify quote %s: %v", mm.ID, resting[i].OrderID, err)
+			}
+		}
+		cancels = append(cancels, resting[i])
+		submits = append(submits, targets[idx])
+	}
+	for i := range targets {
+		if !matched[i] {
+			submits = append(submits, targets[i])
+		}
+	}
+
+	failed := m.cancelQuotes(ctx, exch, mm, cancels)
+	// quotes which could not be cancelled are still resting, so they are not
+	// replaced until they can be
+	keep = append(keep, failed...)
+	for i := range submits {
+		if slices.ContainsFunc(failed, func(q MarketMakerQuote) bool {
+			return q.Side == submits[i].Side && q.Level == submits[i].Level
+		}) {
+			continue
+		}
+		resp, err := m.orderManager.Submit(ctx, &order.Submit{
+			Exchange:  mm.Exchange,
+			Pair:      mm.Pair,
+			AssetType: mm.AssetType,
+			Side:      submits[i].Side,
+			Type:      order.Limit,
+			Price:     submits[i].Price,
+			Amount:    submits[i].Amount,
+			PostOnly:  mm.Config.PostOnly,
+		})
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Market maker %v unable to submit %v quote at %v: %v", mm.ID, submits[i].Side, submits[i].Price, err)
+			continue
+		}
+		keep = append(keep, MarketMakerQuote{Quote: submits[i], OrderID: resp.OrderID})
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Market maker %v submitted %v quote %s amount %v price %v", mm.ID, submits[i].Side, resp.OrderID, submits[i].Amount, submits[i].Price)
+		}
+	}
+
+	m.m.Lock()
+	mm.Quotes = keep
+	mm.LastUpdated = time.Now()
+	m.m.Unlock()
+}
+
+// cancelAllQuotes cancels every resting quote of a market maker
+func (m *MarketMakingManager) cancelAllQuotes(ctx context.Context, mm *MarketMaker) error {
+	exch, err := m.exchangeManager.GetExchangeByName(mm.Exchange)
+	if err != nil {
+		return err
+	}
+	m.pruneQuotes(mm)
+	m.m.Lock()
+	resting := slices.Clone(mm.Quotes)
+	m.m.Unlock()
+	failed := m.cancelQuotes(ctx, exch, mm, resting)
+	m.m.Lock()
+	mm.Quotes = failed
+	m.m.Unlock()
+	if len(failed) > 0 {
+		return fmt.Errorf("market maker %v unable to cancel %v of %v quotes", mm.ID, len(failed), len(resting))
+	}
+	return nil
+}
+
+// cancelQuotes cancels quotes as a batch, falling back to cancelling each
+// quote through the order manager when the exchange cannot batch cancel.
+// Quotes which could not be cancelled are returned
+func (m *MarketMakingManager) cancelQuotes(ctx context.Context, exch exchange.IBotExchange, mm *MarketMaker, quotes []MarketMakerQuote) []MarketMakerQuote {
+	if len(quotes) == 0 {
+		return nil
+	}
+	if len(quotes) > 1 {
+		cancels := make([]order.Cancel, len(quotes))
+		for i := range quotes {
+			cancels[i] = order.Cancel{
+				Exchange:  mm.Exchange,
+				OrderID:   quotes[i].OrderID,
+				Pair:      mm.Pair,
+				AssetType: mm.AssetType,
+				Side:      quotes[i].Side,
+			}
+		}
+		_, err := exch.CancelBatchOrders(ctx, cancels)
+		if err == nil {
+			for i := range quotes {
+				d, err := m.orderManager.GetByExchangeAndID(mm.Exchange, quotes[i].OrderID)
+				if err != nil {
+					continue
+				}
+				d.Status = order.Cancelled
+				d.LastUpdated = time.Now()
+				if err := m.orderManager.UpdateExistingOrder(d); err != nil {
+					log.Warnf(log.OrderMgr, "Market maker %v unable to update cancelled quote %s: %v", mm.ID, quotes[i].OrderID, err)
+				}
+			}
+			return nil
+		}
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Market maker %v unable to batch cancel quotes, cancelling individually: %v", mm.ID, err)
+		}
+	}
+	var failed []MarketMakerQuote
+	for i := range quotes {
+		err := m.orderManager.Cancel(ctx, &order.Cancel{
+			Exchange:  mm.Exchange,
+			OrderID:   quotes[i].OrderID,
+			Pair:      mm.Pair,
+			AssetType: mm.AssetType,
+			Side:      quotes[i].Side,
+		})
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Market maker %v unable to cancel quote %s: %v", mm.ID, quotes[i].OrderID, err)
+			failed = append(failed, quotes[i])
+		}
+	}
+	return failed
+}
+
+// getMarketMakerInventory sums the base and quote holdings of a market
+// maker's pair across the exchange's accounts
+func getMarketMakerInventory(ctx context.Context, exch exchange.IBotExchange, mm *MarketMaker) (marketmaker.Inventory, error) {
+	h, err := exch.GetCachedAccountInfo(ctx, mm.AssetType)
+	if err != nil {
+		return marketmaker.Inventory{}, err
+	}
+	var inventory marketmaker.Inventory
+	for i := range h.Accounts {
+		if h.Accounts[i].AssetType != mm.AssetType {
+			continue
+		}
+		for j := range h.Accounts[i].Currencies {
+			switch {
+			case h.Accounts[i].Currencies[j].Currency.Equal(mm.Pair.Base):
+				inventory.Base += h.Accounts[i].Currencies[j].Total
+			case h.Accounts[i].Currencies[j].Currency.Equal(mm.Pair.Quote):
+				inventory.Quote += h.Accounts[i].Currencies[j].Total
+			}
+		}
+	}
+	return inventory, nil
+}
+
+// copy returns a copy of a market maker which is safe to return to callers
+func (mm *MarketMaker) copy() MarketMaker {
+	c := *mm
+	c.Quotes = slices.Clone(mm.Quotes)
+	return c
+}
@@ -0,0 +1,324 @@
+package engine
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/marketmaker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// mmfState holds the holdings and request counts of a market making fake
+// exchange
+type mmfState struct {
+	m             sync.Mutex
+	base, quote   float64
+	noModify      bool
+	modifies      int
+	batchCancels  int
+	ids           atomic.Int64
+	pair          currency.Pair
+	exchangeName  string
+	accountsError error
+}
+
+// mmfExchange aka market making fake exchange returns unique order IDs and
+// holdings from its state so quotes can be placed without making exchange
+// requests
+type mmfExchange struct {
+	omfExchange
+	state *mmfState
+}
+
+func (f mmfExchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	return s.DeriveSubmitResponse("mm-" + strconv.FormatInt(f.state.ids.Add(1), 10))
+}
+
+func (f mmfExchange) ModifyOrder(_ context.Context, action *order.Modify) (*order.ModifyResponse, error) {
+	f.state.m.Lock()
+	defer f.state.m.Unlock()
+	if f.state.noModify {
+		return nil, common.ErrFunctionNotSupported
+	}
+	f.state.modifies++
+	return action.DeriveModifyResponse()
+}
+
+func (f mmfExchange) CancelBatchOrders(_ context.Context, _ []order.Cancel) (*order.CancelBatchResponse, error) {
+	f.state.m.Lock()
+	defer f.state.m.Unlock()
+	f.state.batchCancels++
+	return &order.CancelBatchResponse{}, nil
+}
+
+func (f mmfExchange) GetCachedAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	f.state.m.Lock()
+	defer f.state.m.Unlock()
+	return account.Holdings{
+		Exchange: f.state.exchangeName,
+		Accounts: []account.SubAccount{{
+			AssetType: a,
+			Currencies: []account.Balance{
+				{Currency: f.state.pair.Base, Total: f.state.base},
+				{Currency: f.state.pair.Quote, Total: f.state.quote},
+			},
+		}},
+	}, f.state.accountsError
+}
+
+func (s *mmfState) setHoldings(base, quote float64) {
+	s.m.Lock()
+	s.base, s.quote = base, quote
+	s.m.Unlock()
+}
+
+// marketMakingSetup returns a started market making manager which is only
+// advanced by calling processMarketMakers. Each test uses its own pair so
+// orderbooks are not shared between tests
+func marketMakingSetup(t *testing.T, pair currency.Pair) (*MarketMakingManager, *OrderManager, *mmfState) {
+	t.Helper()
+	c := &config.Config{}
+	require.NoError(t, c.LoadConfig(config.TestFile, true))
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	cfg, err := c.GetExchangeConfig(testExchange)
+	require.NoError(t, err)
+	require.NoError(t, exch.Setup(cfg))
+	b := exch.GetBase()
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, false))
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, true))
+	require.NoError(t, b.LoadLimits([]order.MinMaxLevel{{Pair: pair, Asset: asset.Spot, PriceStepIncrementSize: 0.5}}))
+	state := &mmfState{pair: pair, exchangeName: exch.GetName()}
+	require.NoError(t, em.Add(mmfExchange{omfExchange: omfExchange{IBotExchange: exch}, state: state}))
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	mm, err := SetupMarketMakingManager(em, m, &config.MarketMakingManager{CheckInterval: time.Hour})
+	require.NoError(t, err)
+	mm.started = 1
+	return mm, m, state
+}
+
+func testMarketMakerConfig() marketmaker.Config {
+	return marketmaker.Config{
+		Levels:           2,
+		Spread:           0.01,
+		LevelSpacing:     0.01,
+		OrderAmount:      1,
+		AmountMultiplier: 2,
+		InventoryTarget:  5,
+		MaxInventory:     4,
+		InventorySkew:    0.1,
+		MaxLoss:          50,
+	}
+}
+
+func getMarketMaker(t *testing.T, m *MarketMakingManager, id uuid.UUID) MarketMaker {
+	t.Helper()
+	makers, err := m.GetMarketMakers()
+	require.NoError(t, err, "GetMarketMakers must not error")
+	for i := range makers {
+		if makers[i].ID == id {
+			return makers[i]
+		}
+	}
+	require.FailNow(t, "market maker not found")
+	return MarketMaker{}
+}
+
+func getQuotePrices(mm *MarketMaker) []float64 {
+	prices := make([]float64, len(mm.Quotes))
+	for i := range mm.Quotes {
+		prices[i] = mm.Quotes[i].Price
+	}
+	return prices
+}
+
+func TestSetupMarketMakingManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMarketMakingManager(nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupMarketMakingManager(NewExchangeManager(), nil, nil)
+	assert.ErrorIs(t, err, errNilMarketMakingOrderManager)
+	_, err = SetupMarketMakingManager(NewExchangeManager(), &OrderManager{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	m, err := SetupMarketMakingManager(NewExchangeManager(), &OrderManager{}, &config.MarketMakingManager{})
+	require.NoError(t, err, "SetupMarketMakingManager must not error")
+	assert.Equal(t, defaultMarketMakingCheckInterval, m.interval, "interval should default when unset")
+
+	_, err = SetupMarketMakingManager(NewExchangeManager(), &OrderManager{}, &config.MarketMakingManager{
+		MarketMakers: []config.MarketMaker{{Exchange: "bogus", Asset: asset.Spot, Pair: currency.NewBTCUSD(), Config: testMarketMakerConfig()}},
+	})
+	assert.ErrorIs(t, err, ErrExchangeNotFound, "SetupMarketMakingManager should error for an invalid market maker")
+}
+
+func TestMarketMakingManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MarketMakingManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := SetupMarketMakingManager(NewExchangeManager(), &OrderManager{}, &config.MarketMakingManager{})
+	require.NoError(t, err, "SetupMarketMakingManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+}
+
+func TestMarketMakerStatusString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "RUNNING", MarketMakerRunning.String())
+	assert.Equal(t, "STOPPED", MarketMakerStopped.String())
+	assert.Equal(t, "HALTED", MarketMakerHalted.String())
+	assert.Equal(t, "UNKNOWN", MarketMakerStatus(99).String())
+}
+
+func TestAddMarketMaker(t *testing.T) {
+	t.Parallel()
+	var m *MarketMakingManager
+	_, err := m.AddMarketMaker(testExchange, asset.Spot, currency.NewBTCUSD(), testMarketMakerConfig())
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	m = &MarketMakingManager{}
+	_, err = m.AddMarketMaker(testExchange, asset.Spot, currency.NewBTCUSD(), testMarketMakerConfig())
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	pair := routingPair("MMADD")
+	m, _, _ = marketMakingSetup(t, pair)
+	_, err = m.AddMarketMaker("bogus", asset.Spot, pair, testMarketMakerConfig())
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+	_, err = m.AddMarketMaker(testExchange, asset.Spot, currency.EMPTYPAIR, testMarketMakerConfig())
+	assert.ErrorIs(t, err, order.ErrPairIsEmpty)
+	_, err = m.AddMarketMaker(testExchange, asset.Futures, pair, testMarketMakerConfig())
+	assert.ErrorIs(t, err, errMarketMakingAssetUnsupported)
+	_, err = m.AddMarketMaker(testExchange, asset.Spot, pair, marketmaker.Config{})
+	assert.Error(t, err, "AddMarketMaker should error for an invalid config")
+
+	mm, err := m.AddMarketMaker(testExchange, asset.Spot, pair, testMarketMakerConfig())
+	require.NoError(t, err, "AddMarketMaker must not error")
+	assert.Equal(t, MarketMakerRunning, mm.Status, "Status should be running")
+	assert.Equal(t, marketmaker.MidPrice, mm.Config.PriceSource, "PriceSource should default to the mid price")
+	_, err = m.AddMarketMaker(testExchange, asset.Spot, pair, testMarketMakerConfig())
+	assert.ErrorIs(t, err, errMarketMakerExists, "AddMarketMaker should error when the pair is already quoted")
+}
+
+func TestMarketMakingManagerQuoting(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("MMQUOTE")
+	m, om, state := marketMakingSetup(t, pair)
+	mm, err := m.AddMarketMaker(testExchange, asset.Spot, pair, testMarketMakerConfig())
+	require.NoError(t, err, "AddMarketMaker must not error")
+
+	m.processMarketMakers(context.Background())
+	assert.Empty(t, getMarketMaker(t, m, mm.ID).Quotes, "No quotes should be placed without an orderbook")
+
+	state.setHoldings(5, 1000)
+	processRoutingOrderbook(t, testExchange, pair, orderbook.Tranches{{Price: 99.5, Amount: 1}}, orderbook.Tranches{{Price: 100.5, Amount: 1}})
+	m.processMarketMakers(context.Background())
+	resp := getMarketMaker(t, m, mm.ID)
+	assert.Equal(t, 100.0, resp.ReferencePrice, "ReferencePrice should be the mid price")
+	assert.Equal(t, marketmaker.Inventory{Base: 5, Quote: 1000}, resp.StartingInventory, "StartingInventory should be set from the holdings")
+	assert.Equal(t, []float64{99, 98, 101, 102}, getQuotePrices(&resp), "Quotes should ladder both sides of the reference price")
+	for i := range resp.Quotes {
+		d, err := om.GetByExchangeAndID(testExchange, resp.Quotes[i].OrderID)
+		require.NoError(t, err, "GetByExchangeAndID must not error")
+		assert.Equal(t, order.Limit, d.Type, "Quotes should be limit orders")
+		assert.Equal(t, resp.Quotes[i].Amount, d.Amount, "Quote amounts should be submitted")
+	}
+
+	m.processMarketMakers(context.Background())
+	assert.Equal(t, resp.Quotes, getMarketMaker(t, m, mm.ID).Quotes, "Quotes should not change while the orderbook is unchanged")
+
+	processRoutingOrderbook(t, testExchange, pair, orderbook.Tranches{{Price: 109.5, Amount: 1}}, orderbook.Tranches{{Price: 110.5, Amount: 1}})
+	m.processMarketMakers(context.Background())
+	moved := getMarketMaker(t, m, mm.ID)
+	assert.Equal(t, []float64{108.5, 107.5, 111.5, 112.5}, getQuotePrices(&moved), "Quotes should follow the reference price rounded to the price step")
+	assert.Equal(t, 4, state.modifies, "Quotes should be modified")
+	for i := range moved.Quotes {
+		assert.Equal(t, resp.Quotes[i].OrderID, moved.Quotes[i].OrderID, "Modified quotes should keep their order IDs")
+	}
+
+	state.m.Lock()
+	state.noModify = true
+	state.m.Unlock()
+	processRoutingOrderbook(t, testExchange, pair, orderbook.Tranches{{Price: 99.5, Amount: 1}}, orderbook.Tranches{{Price: 100.5, Amount: 1}})
+	m.processMarketMakers(context.Background())
+	replaced := getMarketMaker(t, m, mm.ID)
+	assert.Equal(t, []float64{99, 98, 101, 102}, getQuotePrices(&replaced), "Quotes should be replaced when the exchange cannot modify orders")
+	assert.Equal(t, 1, state.batchCancels, "Replaced quotes should be batch cancelled")
+	d, err := om.GetByExchangeAndID(testExchange, moved.Quotes[0].OrderID)
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.Equal(t, order.Cancelled, d.Status, "Batch cancelled quotes should be cancelled in the order manager")
+
+	d, err = om.GetByExchangeAndID(testExchange, replaced.Quotes[0].OrderID)
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	d.Status = order.Filled
+	d.ExecutedAmount = d.Amount
+	require.NoError(t, om.UpdateExistingOrder(d), "UpdateExistingOrder must not error")
+	state.setHoldings(6, 901)
+	m.processMarketMakers(context.Background())
+	filled := getMarketMaker(t, m, mm.ID)
+	assert.Equal(t, 1.0, filled.PNL, "PNL should value the traded inventory at the reference price")
+	assert.Equal(t, marketmaker.Inventory{Base: 6, Quote: 901}, filled.Inventory, "Inventory should be updated from the holdings")
+	assert.Len(t, filled.Quotes, 4, "Filled quotes should be replaced")
+	assert.NotEqual(t, replaced.Quotes[0].OrderID, filled.Quotes[0].OrderID, "Filled quotes should be replaced with new orders")
+
+	state.setHoldings(6, 840)
+	m.processMarketMakers(context.Background())
+	halted := getMarketMaker(t, m, mm.ID)
+	assert.Equal(t, MarketMakerHalted, halted.Status, "Market maker should halt at its loss limit")
+	assert.NotEmpty(t, halted.Error, "Error should be set when halted")
+	assert.Empty(t, halted.Quotes, "Quotes should be cancelled when halted")
+	err = m.StopMarketMaker(context.Background(), mm.ID)
+	assert.ErrorIs(t, err, ErrMarketMakerNotRunning, "StopMarketMaker should error for a halted market maker")
+}
+
+func TestStopMarketMaker(t *testing.T) {
+	t.Parallel()
+	var m *MarketMakingManager
+	assert.ErrorIs(t, m.StopMarketMaker(context.Background(), uuid.Nil), ErrNilSubsystem)
+	m = &MarketMakingManager{}
+	assert.ErrorIs(t, m.StopMarketMaker(context.Background(), uuid.Nil), ErrSubSystemNotStarted)
+
+	pair := routingPair("MMSTOP")
+	m, om, state := marketMakingSetup(t, pair)
+	assert.ErrorIs(t, m.StopMarketMaker(context.Background(), uuid.Nil), ErrMarketMakerNotFound)
+	mm, err := m.AddMarketMaker(testExchange, asset.Spot, pair, testMarketMakerConfig())
+	require.NoError(t, err, "AddMarketMaker must not error")
+	state.setHoldings(9, 1000)
+	processRoutingOrderbook(t, testExchange, pair, orderbook.Tranches{{Price: 99.5, Amount: 1}}, orderbook.Tranches{{Price: 100.5, Amount: 1}})
+	m.processMarketMakers(context.Background())
+	quoted := getMarketMaker(t, m, mm.ID)
+	require.NotEmpty(t, quoted.Quotes, "Quotes must be placed")
+	for i := range quoted.Quotes {
+		assert.Equal(t, order.Sell, quoted.Quotes[i].Side, "Only asks should be quoted at the max inventory")
+	}
+
+	require.NoError(t, m.StopMarketMaker(context.Background(), mm.ID), "StopMarketMaker must not error")
+	stopped := getMarketMaker(t, m, mm.ID)
+	assert.Equal(t, MarketMakerStopped, stopped.Status, "Status should be stopped")
+	assert.Empty(t, stopped.Quotes, "Quotes should be cancelled")
+	d, err := om.GetByExchangeAndID(testExchange, quoted.Quotes[0].OrderID)
+	require.NoError(t, err, "GetByExchangeAndID must not error")
+	assert.Equal(t, order.Cancelled, d.Status, "Quotes should be cancelled in the order manager")
+	_, err = m.AddMarketMaker(testExchange, asset.Spot, pair, testMarketMakerConfig())
+	assert.NoError(t, err, "AddMarketMaker should allow a stopped pair to be quoted again")
+}
@@ -0,0 +1,91 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/marketmaker"
+)
+
+// MarketMakingManagerName is an exported subsystem name
+const MarketMakingManagerName = "market_making_manager"
+
+const defaultMarketMakingCheckInterval = time.Second
+
+var (
+	// ErrMarketMakerNotFound is returned when a market maker ID is not held
+	// by the market making manager
+	ErrMarketMakerNotFound = errors.New("market maker does not exist")
+	// ErrMarketMakerNotRunning is returned when attempting to stop a market
+	// maker which has already been stopped or halted
+	ErrMarketMakerNotRunning = errors.New("market maker is not running")
+
+	errMarketMakerExists            = errors.New("market maker already quoting exchange asset pair")
+	errMarketMakingAssetUnsupported = errors.New("market making only supports spot assets")
+	errNilMarketMakingOrderManager  = errors.New("cannot start with nil order manager")
+)
+
+// MarketMakerStatus defines the lifecycle state of a market maker
+type MarketMakerStatus uint8
+
+// Market maker statuses
+const (
+	MarketMakerRunning MarketMakerStatus = iota
+	MarketMakerStopped
+	// MarketMakerHalted is set when a market maker reaches its loss limit
+	MarketMakerHalted
+)
+
+// MarketMaker keeps a ladder of quotes on both sides of an exchange pair's
+// orderbook through the order manager
+type MarketMaker struct {
+	ID        uuid.UUID
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Config    marketmaker.Config
+	Status    MarketMakerStatus
+	Error     string
+	// ReferencePrice is the orderbook price quotes were last placed around
+	ReferencePrice float64
+	// StartingInventory is the holding when quoting first started, which
+	// profit and loss is measured against
+	StartingInventory marketmaker.Inventory
+	Inventory         marketmaker.Inventory
+	PNL               float64
+	Quotes            []MarketMakerQuote
+	CreatedAt         time.Time
+	LastUpdated       time.Time
+	// hasStartingInventory is set once the starting inventory is recorded
+	hasStartingInventory bool
+	// cancelReplace is set when the exchange cannot modify orders, so
+	// quotes are cancelled and resubmitted instead
+	cancelReplace bool
+}
+
+// MarketMakerQuote is a resting quote of a market maker
+type MarketMakerQuote struct {
+	marketmaker.Quote
+	OrderID string
+}
+
+// MarketMakingManager quotes configured exchange pairs, re-quoting as the
+// orderbook and inventory change
+type MarketMakingManager struct {
+	started         int32
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	interval        time.Duration
+	verbose         bool
+	orderManager    iMarketMakingOrderManager
+	exchangeManager iExchangeManager
+	// process serialises quoting so market makers cannot be stopped while
+	// their quotes are being placed
+	process sync.Mutex
+	m       sync.Mutex
+	makers  map[uuid.UUID]*MarketMaker
+}
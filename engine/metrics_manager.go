@@ -0,0 +1,402 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var labelValueReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// SetupMetricsManager creates a metrics manager. The manager must be
+// installed as the global request, websocket and orderbook reporter before
+// exchanges are loaded for their metrics to be recorded
+func SetupMetricsManager(source iMetricsSource, cfg *config.MetricsManager) (*MetricsManager, error) {
+	if source == nil {
+		return nil, fmt.Errorf("%s %w", MetricsManagerName, errNilBot)
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w MetricsManager", errNilConfig)
+	}
+	listenAddress := cfg.ListenAddress
+	if listenAddress == "" {
+		listenAddress = defaultMetricsListenAddress
+	}
+	return &MetricsManager{
+		listenAddress:          listenAddress,
+		source:                 source,
+		requestLatency:         make(map[requestMetricKey]*histogram),
+		requestErrors:          make(map[requestErrorMetricKey]uint64),
+		rateLimitWait:          make(map[string]*histogram),
+		websocketLatency:       make(map[string]*histogram),
+		websocketMessages:      make(map[string]uint64),
+		websocketBytes:         make(map[string]uint64),
+		websocketReconnects:    make(map[string]uint64),
+		orderbookInvalidations: make(map[orderbookMetricKey]uint64),
+	}, nil
+}
+
+// Start records metrics and serves them on the configured listen address
+func (m *MetricsManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemStarting)
+	listener, err := net.Listen("tcp", m.listenAddress)
+	if err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return fmt.Errorf("%s unable to listen on %s: %w", MetricsManagerName, m.listenAddress, err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, m)
+	m.server = &http.Server{
+		Addr:              m.listenAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: time.Minute,
+	}
+	m.wg.Add(1)
+	go func(s *http.Server) {
+		defer m.wg.Done()
+		if err := s.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.Global, "Metrics manager unable to serve metrics: %v", err)
+		}
+	}(m.server)
+	log.Debugf(log.Global, "Metrics manager %s. Serving metrics at http://%s%s", MsgSubSystemStarted, listener.Addr(), metricsPath)
+	return nil
+}
+
+// Stop stops serving and recording metrics. Recorded metrics are retained
+// should the subsystem be started again
+func (m *MetricsManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("%s %w", MetricsManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemShuttingDown)
+	err := m.server.Shutdown(context.Background())
+	m.wg.Wait()
+	m.server = nil
+	if err != nil {
+		return err
+	}
+	log.Debugf(log.Global, "Metrics manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *MetricsManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// Latency records the latency of an exchange HTTP request. Request paths
+// are not recorded as they contain IDs and parameters which would create an
+// unbounded number of series
+func (m *MetricsManager) Latency(name, method, _ string, t time.Duration) {
+	if !m.IsRunning() {
+		return
+	}
+	k := requestMetricKey{exchange: name, method: method}
+	m.m.Lock()
+	observe(m.requestLatency, k, t)
+	m.m.Unlock()
+}
+
+// Error records a failed exchange HTTP request
+func (m *MetricsManager) Error(name, method, _ string, statusCode int) {
+	if !m.IsRunning() {
+		return
+	}
+	k := requestErrorMetricKey{
+		requestMetricKey: requestMetricKey{exchange: name, method: method},
+		statusCode:       statusCode,
+	}
+	m.m.Lock()
+	m.requestErrors[k]++
+	m.m.Unlock()
+}
+
+// RateLimitWait records the time an exchange HTTP request waited on its rate
+// limiter
+func (m *MetricsManager) RateLimitWait(name string, t time.Duration) {
+	if !m.IsRunning() {
+		return
+	}
+	m.m.Lock()
+	observe(m.rateLimitWait, name, t)
+	m.m.Unlock()
+}
+
+// websocketReporter records websocket metrics for the metrics manager. It
+// is required as the websocket and request reporters both define Latency
+type websocketReporter MetricsManager
+
+// WebsocketReporter returns the websocket reporter of the metrics manager
+func (m *MetricsManager) WebsocketReporter() websocket.Reporter {
+	return (*websocketReporter)(m)
+}
+
+// Latency records the latency of a websocket request
+func (w *websocketReporter) Latency(name string, _ []byte, t time.Duration) {
+	m := (*MetricsManager)(w)
+	if !m.IsRunning() {
+		return
+	}
+	m.m.Lock()
+	observe(m.websocketLatency, name, t)
+	m.m.Unlock()
+}
+
+// MessageReceived records a message read from a websocket connection
+func (w *websocketReporter) MessageReceived(name string, size int) {
+	m := (*MetricsManager)(w)
+	if !m.IsRunning() {
+		return
+	}
+	m.m.Lock()
+	m.websocketMessages[name]++
+	m.websocketBytes[name] += uint64(size) //nolint:gosec // message sizes are never negative
+	m.m.Unlock()
+}
+
+// Reconnect records a websocket reconnection attempt
+func (w *websocketReporter) Reconnect(name string) {
+	m := (*MetricsManager)(w)
+	if !m.IsRunning() {
+		return
+	}
+	m.m.Lock()
+	m.websocketReconnects[name]++
+	m.m.Unlock()
+}
+
+// Invalidated records an orderbook being invalidated after a desync
+func (m *MetricsManager) Invalidated(exchange string, a asset.Item) {
+	if !m.IsRunning() {
+		return
+	}
+	m.m.Lock()
+	m.orderbookInvalidations[orderbookMetricKey{exchange: exchange, asset: a}]++
+	m.m.Unlock()
+}
+
+// ServeHTTP writes all metrics in the Prometheus text exposition format
+func (m *MetricsManager) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", metricsContentType)
+	var sb strings.Builder
+	m.writeMetrics(&sb, time.Now())
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		log.Errorf(log.Global, "Metrics manager unable to write metrics: %v", err)
+	}
+}
+
+// writeMetrics writes the recorded metrics followed by those gathered from
+// other subsystems
+func (m *MetricsManager) writeMetrics(sb *strings.Builder, now time.Time) {
+	m.m.Lock()
+	writeHeader(sb, "http_request_duration_seconds", "histogram", "Exchange HTTP request latency")
+	for _, k := range sortedKeys(m.requestLatency, compareRequestMetricKeys) {
+		writeHistogram(sb, "http_request_duration_seconds", m.requestLatency[k], "exchange", k.exchange, "method", k.method)
+	}
+	writeHeader(sb, "http_request_errors_total", "counter", "Exchange HTTP requests which failed, status code 0 is reported when no response was received")
+	for _, k := range sortedKeys(m.requestErrors, func(a, b requestErrorMetricKey) int {
+		if c := compareRequestMetricKeys(a.requestMetricKey, b.requestMetricKey); c != 0 {
+			return c
+		}
+		return a.statusCode - b.statusCode
+	}) {
+		writeSample(sb, "http_request_errors_total", float64(m.requestErrors[k]), "exchange", k.exchange, "method", k.method, "status", strconv.Itoa(k.statusCode))
+	}
+	writeHeader(sb, "rate_limit_wait_seconds", "histogram", "Time exchange HTTP requests waited on their rate limiter")
+	for _, k := range slices.Sorted(maps.Keys(m.rateLimitWait)) {
+		writeHistogram(sb, "rate_limit_wait_seconds", m.rateLimitWait[k], "exchange", k)
+	}
+	writeHeader(sb, "websocket_request_duration_seconds", "histogram", "Exchange websocket request latency")
+	for _, k := range slices.Sorted(maps.Keys(m.websocketLatency)) {
+		writeHistogram(sb, "websocket_request_duration_seconds", m.websocketLatency[k], "exchange", k)
+	}
+	writeCounters(sb, "websocket_messages_received_total", "Messages received by exchange websocket connections", m.websocketMessages)
+	writeCounters(sb, "websocket_received_bytes_total", "Bytes received by exchange websocket connections", m.websocketBytes)
+	writeCounters(sb, "websocket_reconnects_total", "Exchange websocket reconnection attempts", m.websocketReconnects)
+	writeHeader(sb, "orderbook_invalidations_total", "counter", "Orderbooks invalidated after a desync")
+	for _, k := range sortedKeys(m.orderbookInvalidations, func(a, b orderbookMetricKey) int {
+		if c := strings.Compare(a.exchange, b.exchange); c != 0 {
+			return c
+		}
+		return strings.Compare(a.asset.String(), b.asset.String())
+	}) {
+		writeSample(sb, "orderbook_invalidations_total", float64(m.orderbookInvalidations[k]), "exchange", k.exchange, "asset", k.asset.String())
+	}
+	m.m.Unlock()
+
+	var sm *SyncManager
+	var om *OrderManager
+	if m.source != nil {
+		sm, om = m.source.getSyncManager(), m.source.getOrderManager()
+	}
+	writeHeader(sb, "sync_staleness_seconds", "gauge", "Longest time since a synced exchange asset item was last updated")
+	for _, s := range getSyncStaleness(sm, now) {
+		writeSample(sb, "sync_staleness_seconds", s.seconds, "exchange", s.exchange, "asset", s.asset.String(), "item", s.item.String())
+	}
+	writeHeader(sb, "orders", "gauge", "Orders stored by the order manager by status")
+	for _, c := range getOrderCounts(om) {
+		writeSample(sb, "orders", float64(c.count), "exchange", c.exchange, "status", c.status)
+	}
+	writeHeader(sb, "dispatch_queue_depth", "gauge", "Jobs waiting in the dispatch queue")
+	writeSample(sb, "dispatch_queue_depth", float64(dispatch.QueueDepth()))
+}
+
+// getSyncStaleness returns the longest time since each exchange asset sync
+// item was last updated
+func getSyncStaleness(sm *SyncManager, now time.Time) []syncStaleness {
+	if !sm.IsRunning() {
+		return nil
+	}
+	sm.mux.Lock()
+	agents := slices.Collect(maps.Values(sm.currencyPairs))
+	sm.mux.Unlock()
+	stalest := make(map[syncStaleness]float64)
+	for _, agent := range agents {
+		for i := range agent.trackers {
+			agent.locks[i].Lock()
+			tracker := agent.trackers[i]
+			var lastUpdated time.Time
+			if tracker != nil {
+				lastUpdated = tracker.LastUpdated
+			}
+			agent.locks[i].Unlock()
+			if lastUpdated.IsZero() {
+				continue
+			}
+			k := syncStaleness{exchange: agent.Key.Exchange, asset: agent.Key.Asset, item: syncItemType(i)}
+			stalest[k] = max(stalest[k], now.Sub(lastUpdated).Seconds())
+		}
+	}
+	resp := make([]syncStaleness, 0, len(stalest))
+	for k, seconds := range stalest {
+		k.seconds = seconds
+		resp = append(resp, k)
+	}
+	slices.SortFunc(resp, func(a, b syncStaleness) int {
+		if c := strings.Compare(a.exchange, b.exchange); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.asset.String(), b.asset.String()); c != 0 {
+			return c
+		}
+		return int(a.item) - int(b.item)
+	})
+	return resp
+}
+
+// getOrderCounts returns the number of orders stored for each exchange by
+// status
+func getOrderCounts(om *OrderManager) []orderCount {
+	if !om.IsRunning() {
+		return nil
+	}
+	counts := make(map[orderCount]int)
+	for exch, orders := range om.orderStore.get() {
+		for i := range orders {
+			counts[orderCount{exchange: exch, status: orders[i].Status.String()}]++
+		}
+	}
+	resp := make([]orderCount, 0, len(counts))
+	for k, count := range counts {
+		k.count = count
+		resp = append(resp, k)
+	}
+	slices.SortFunc(resp, func(a, b orderCount) int {
+		if c := strings.Compare(a.exchange, b.exchange); c != 0 {
+			return c
+		}
+		return strings.Compare(a.status, b.status)
+	})
+	return resp
+}
+
+// observe adds a duration to the histogram of a metric, creating it if
+// required
+func observe[K comparable](histograms map[K]*histogram, k K, t time.Duration) {
+	h, ok := histograms[k]
+	if !ok {
+		h = &histogram{buckets: make([]uint64, len(metricsBuckets))}
+		histograms[k] = h
+	}
+	seconds := t.Seconds()
+	for i := range metricsBuckets {
+		if seconds <= metricsBuckets[i] {
+			h.buckets[i]++
+		}
+	}
+	h.count++
+	h.sum += seconds
+}
+
+func writeHeader(sb *strings.Builder, name, metricType, help string) {
+	sb.WriteString("# HELP " + metricsNamespace + name + " " + help + "\n")
+	sb.WriteString("# TYPE " + metricsNamespace + name + " " + metricType + "\n")
+}
+
+// writeSample writes a single sample with its labels provided as name value
+// pairs
+func writeSample(sb *strings.Builder, name string, value float64, labels ...string) {
+	sb.WriteString(metricsNamespace + name)
+	if len(labels) > 0 {
+		sb.WriteByte('{')
+		for i := 0; i < len(labels); i += 2 {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(labels[i] + `="` + labelValueReplacer.Replace(labels[i+1]) + `"`)
+		}
+		sb.WriteByte('}')
+	}
+	sb.WriteString(" " + strconv.FormatFloat(value, 'g', -1, 64) + "\n")
+}
+
+func writeHistogram(sb *strings.Builder, name string, h *histogram, labels ...string) {
+	for i := range metricsBuckets {
+		writeSample(sb, name+"_bucket", float64(h.buckets[i]), append(labels, "le", strconv.FormatFloat(metricsBuckets[i], 'g', -1, 64))...)
+	}
+	writeSample(sb, name+"_bucket", float64(h.count), append(labels, "le", "+Inf")...)
+	writeSample(sb, name+"_sum", h.sum, labels...)
+	writeSample(sb, name+"_count", float64(h.count), labels...)
+}
+
+func writeCounters(sb *strings.Builder, name, help string, counters map[string]uint64) {
+	writeHeader(sb, name, "counter", help)
+	for _, k := range slices.Sorted(maps.Keys(counters)) {
+		writeSample(sb, name, float64(counters[k]), "exchange", k)
+	}
+}
+
+func sortedKeys[K comparable, V any](m map[K]V, cmp func(a, b K) int) []K {
+	return slices.SortedFunc(maps.Keys(m), cmp)
+}
+
+func compareRequestMetricKeys(a, b requestMetricKey) int {
+	if c := strings.Compare(a.exchange, b.exchange); c != 0 {
+		return c
+	}
+	return strings.Compare(a.method, b.method)
+}
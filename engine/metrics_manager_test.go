@@ -0,0 +1,182 @@
+package engine
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// fakeMetricsSource provides subsystems to the metrics manager
+type fakeMetricsSource struct {
+	syncManager  *SyncManager
+	orderManager *OrderManager
+}
+
+func (f *fakeMetricsSource) getSyncManager() *SyncManager {
+	return f.syncManager
+}
+
+func (f *fakeMetricsSource) getOrderManager() *OrderManager {
+	return f.orderManager
+}
+
+func TestSetupMetricsManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupMetricsManager(nil, nil)
+	assert.ErrorIs(t, err, errNilBot)
+	_, err = SetupMetricsManager(&fakeMetricsSource{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	m, err := SetupMetricsManager(&fakeMetricsSource{}, &config.MetricsManager{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.Equal(t, defaultMetricsListenAddress, m.listenAddress)
+	m, err = SetupMetricsManager(&fakeMetricsSource{}, &config.MetricsManager{ListenAddress: "localhost:1337"})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.Equal(t, "localhost:1337", m.listenAddress)
+}
+
+func TestMetricsManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *MetricsManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, err := SetupMetricsManager(&fakeMetricsSource{}, &config.MetricsManager{ListenAddress: "localhost:0"})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+
+	l, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err, "net.Listen must not error")
+	defer l.Close()
+	m, err = SetupMetricsManager(&fakeMetricsSource{}, &config.MetricsManager{ListenAddress: l.Addr().String()})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	assert.Error(t, m.Start(), "Start should error when the listen address is in use")
+	assert.False(t, m.IsRunning(), "metrics manager should not be running when it cannot listen")
+}
+
+func TestMetricsManagerRecording(t *testing.T) {
+	t.Parallel()
+	m, err := SetupMetricsManager(&fakeMetricsSource{}, &config.MetricsManager{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	m.Latency(testExchange, http.MethodGet, "/ticker", time.Millisecond)
+	m.Invalidated(testExchange, asset.Spot)
+	assert.Empty(t, m.requestLatency, "metrics should not be recorded when not running")
+	assert.Empty(t, m.orderbookInvalidations, "metrics should not be recorded when not running")
+
+	m.started = 1
+	m.Latency(testExchange, http.MethodGet, "/ticker?pair=BTCUSD", time.Millisecond*20)
+	m.Latency(testExchange, http.MethodGet, "/orders/1337", time.Second*20)
+	m.Error(testExchange, http.MethodGet, "/ticker?pair=BTCUSD", http.StatusTooManyRequests)
+	m.RateLimitWait(testExchange, time.Millisecond)
+	ws := m.WebsocketReporter()
+	ws.Latency(testExchange, nil, time.Millisecond)
+	ws.MessageReceived(testExchange, 10)
+	ws.MessageReceived(testExchange, 5)
+	ws.Reconnect(testExchange)
+	m.Invalidated(testExchange, asset.Spot)
+
+	k := requestMetricKey{exchange: testExchange, method: http.MethodGet}
+	require.Contains(t, m.requestLatency, k, "request latency must be recorded per exchange and method")
+	assert.Len(t, m.requestLatency, 1, "request paths must not create new series")
+	h := m.requestLatency[k]
+	assert.Equal(t, uint64(2), h.count)
+	assert.InDelta(t, 20.02, h.sum, 1e-9)
+	assert.Equal(t, []uint64{0, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1}, h.buckets, "buckets should be cumulative and exclude observations above the largest bucket")
+	assert.Equal(t, uint64(1), m.requestErrors[requestErrorMetricKey{requestMetricKey: k, statusCode: http.StatusTooManyRequests}])
+	assert.Equal(t, uint64(1), m.rateLimitWait[testExchange].count)
+	assert.Equal(t, uint64(1), m.websocketLatency[testExchange].count)
+	assert.Equal(t, uint64(2), m.websocketMessages[testExchange])
+	assert.Equal(t, uint64(15), m.websocketBytes[testExchange])
+	assert.Equal(t, uint64(1), m.websocketReconnects[testExchange])
+	assert.Equal(t, uint64(1), m.orderbookInvalidations[orderbookMetricKey{exchange: testExchange, asset: asset.Spot}])
+}
+
+func TestMetricsManagerServeHTTP(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	agent := &currencyPairSyncAgent{
+		Key:      key.ExchangePairAsset{Exchange: testExchange, Base: currency.BTC.Item, Quote: currency.USD.Item, Asset: asset.Spot},
+		locks:    make([]sync.Mutex, SyncItemTrade+1),
+		trackers: make([]*syncBase, SyncItemTrade+1),
+	}
+	agent.trackers[SyncItemTicker] = &syncBase{LastUpdated: now.Add(-time.Second * 5)}
+	agent.trackers[SyncItemOrderbook] = &syncBase{}
+	agent2 := &currencyPairSyncAgent{
+		Key:      key.ExchangePairAsset{Exchange: testExchange, Base: currency.ETH.Item, Quote: currency.USD.Item, Asset: asset.Spot},
+		locks:    make([]sync.Mutex, SyncItemTrade+1),
+		trackers: make([]*syncBase, SyncItemTrade+1),
+	}
+	agent2.trackers[SyncItemTicker] = &syncBase{LastUpdated: now.Add(-time.Second * 10)}
+	source := &fakeMetricsSource{
+		syncManager: &SyncManager{
+			started:       1,
+			currencyPairs: map[key.ExchangePairAsset]*currencyPairSyncAgent{agent.Key: agent, agent2.Key: agent2},
+		},
+		orderManager: &OrderManager{
+			started: 1,
+			orderStore: store{Orders: map[string][]*order.Detail{
+				testExchange: {{Status: order.New}, {Status: order.New}, {Status: order.Filled}},
+			}},
+		},
+	}
+	m, err := SetupMetricsManager(source, &config.MetricsManager{})
+	require.NoError(t, err, "SetupMetricsManager must not error")
+	m.started = 1
+	m.Latency(testExchange, http.MethodGet, "/ticker", time.Millisecond*20)
+	m.Error(testExchange, http.MethodGet, "/ticker", 0)
+	m.WebsocketReporter().Reconnect(testExchange)
+	m.Invalidated(testExchange, asset.Spot)
+
+	stale := getSyncStaleness(source.syncManager, now)
+	require.Len(t, stale, 1, "staleness must be reported per exchange asset item which has been updated")
+	assert.Equal(t, syncStaleness{exchange: testExchange, asset: asset.Spot, item: SyncItemTicker, seconds: 10}, stale[0], "the stalest pair should be reported")
+	assert.Nil(t, getSyncStaleness(nil, now), "a nil sync manager should not report staleness")
+	assert.Equal(t, []orderCount{{testExchange, order.Filled.String(), 1}, {testExchange, order.New.String(), 2}}, getOrderCounts(source.orderManager))
+	assert.Nil(t, getOrderCounts(&OrderManager{}), "a stopped order manager should not report order counts")
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, metricsPath, http.NoBody))
+	assert.Equal(t, metricsContentType, rec.Header().Get("Content-Type"))
+	body := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE gct_http_request_duration_seconds histogram\n",
+		`gct_http_request_duration_seconds_bucket{exchange="Bitstamp",method="GET",le="0.025"} 1` + "\n",
+		`gct_http_request_duration_seconds_bucket{exchange="Bitstamp",method="GET",le="+Inf"} 1` + "\n",
+		`gct_http_request_duration_seconds_sum{exchange="Bitstamp",method="GET"} 0.02` + "\n",
+		`gct_http_request_duration_seconds_count{exchange="Bitstamp",method="GET"} 1` + "\n",
+		`gct_http_request_errors_total{exchange="Bitstamp",method="GET",status="0"} 1` + "\n",
+		`gct_websocket_reconnects_total{exchange="Bitstamp"} 1` + "\n",
+		`gct_orderbook_invalidations_total{exchange="Bitstamp",asset="spot"} 1` + "\n",
+		`gct_orders{exchange="Bitstamp",status="FILLED"} 1` + "\n",
+		`gct_orders{exchange="Bitstamp",status="NEW"} 2` + "\n",
+		"# TYPE gct_sync_staleness_seconds gauge\n",
+		"# TYPE gct_dispatch_queue_depth gauge\n",
+	} {
+		assert.Contains(t, body, line, "metrics should contain the expected line")
+	}
+}
+
+func TestWriteSample(t *testing.T) {
+	t.Parallel()
+	var sb strings.Builder
+	writeSample(&sb, "test", 1.5, "label", "a\"b\\c\nd")
+	assert.Equal(t, `gct_test{label="a\"b\\c\nd"} 1.5`+"\n", sb.String(), "label values should be escaped")
+}
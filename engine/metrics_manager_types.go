@@ -0,0 +1,91 @@
+package engine
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// MetricsManagerName is an exported subsystem name
+const MetricsManagerName = "metrics_manager"
+
+const (
+	defaultMetricsListenAddress = "localhost:9054"
+	metricsPath                 = "/metrics"
+	metricsNamespace            = "gct_"
+	metricsContentType          = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// metricsBuckets are the upper bounds in seconds of the histogram buckets
+var metricsBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// MetricsManager records exchange HTTP, rate limiter, websocket and orderbook
+// metrics through the global reporters and serves them, along with the
+// state of other subsystems, in the Prometheus text format
+type MetricsManager struct {
+	started       int32
+	listenAddress string
+	source        iMetricsSource
+	server        *http.Server
+	wg            sync.WaitGroup
+
+	m                      sync.Mutex
+	requestLatency         map[requestMetricKey]*histogram
+	requestErrors          map[requestErrorMetricKey]uint64
+	rateLimitWait          map[string]*histogram
+	websocketLatency       map[string]*histogram
+	websocketMessages      map[string]uint64
+	websocketBytes         map[string]uint64
+	websocketReconnects    map[string]uint64
+	orderbookInvalidations map[orderbookMetricKey]uint64
+}
+
+// iMetricsSource provides the subsystems which are reported at scrape time.
+// They are fetched on each scrape as they can be set up after the metrics
+// manager
+type iMetricsSource interface {
+	getSyncManager() *SyncManager
+	getOrderManager() *OrderManager
+}
+
+// requestMetricKey labels HTTP request metrics
+type requestMetricKey struct {
+	exchange string
+	method   string
+}
+
+// requestErrorMetricKey labels HTTP request error metrics
+type requestErrorMetricKey struct {
+	requestMetricKey
+	statusCode int
+}
+
+// orderbookMetricKey labels orderbook metrics
+type orderbookMetricKey struct {
+	exchange string
+	asset    asset.Item
+}
+
+// histogram holds the cumulative observations of a metric
+type histogram struct {
+	buckets []uint64
+	count   uint64
+	sum     float64
+}
+
+// syncStaleness holds the longest time since an exchange asset sync item was
+// last updated
+type syncStaleness struct {
+	exchange string
+	asset    asset.Item
+	item     syncItemType
+	seconds  float64
+}
+
+// orderCount holds the number of orders stored for an exchange by status
+type orderCount struct {
+	exchange string
+	status   string
+	count    int
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -16,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
@@ -42,6 +44,9 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 	if cfg.ActivelyTrackFuturesPositions && cfg.FuturesTrackingSeekDuration <= 0 {
 		return nil, errInvalidFuturesTrackingSeekDuration
 	}
+	if err := validateRiskLimits(cfg.Risk.Limits); err != nil {
+		return nil, err
+	}
 
 	om := &OrderManager{
 		shutdown:                      make(chan struct{}),
@@ -54,11 +59,25 @@ func SetupOrderManager(exchangeManager iExchangeManager, communicationsManager i
 			commsManager:              communicationsManager,
 			wg:                        wg,
 			futuresPositionController: futures.SetupPositionController(),
+			childOrders:               make(map[uuid.UUID][]orderReference),
+		},
+		conditionals: conditionalStore{
+			orders:              make(map[uuid.UUID]*ConditionalOrder),
+			tickerFeeds:         make(map[string]bool),
+			orderbookFeeds:      make(map[string]bool),
+			subscribeTickers:    subscribeConditionalTickers,
+			subscribeOrderbooks: subscribeConditionalOrderbooks,
 		},
 		verbose: cfg.Verbose,
 		cfg: orderManagerConfig{
 			CancelOrdersOnShutdown: cfg.CancelOrdersOnShutdown,
 		},
+		risk: preTradeRisk{
+			enabled:     cfg.Risk.Enabled,
+			limits:      cfg.Risk.Limits,
+			submissions: make(map[int][]time.Time),
+			auditEvent:  audit.Event,
+		},
 	}
 	return om, nil
 }
@@ -78,8 +97,9 @@ func (m *OrderManager) Start() error {
 	}
 	log.Debugln(log.OrderMgr, "Order manager starting...")
 	m.shutdown = make(chan struct{})
+	restored := m.loadPersistedOrders()
 	m.orderStore.wg.Add(1)
-	go m.run()
+	go m.run(restored)
 	return nil
 }
 
@@ -111,20 +131,40 @@ func (m *OrderManager) gracefulShutdown() {
 	m.CancelAllOrders(context.TODO(), exchanges)
 }
 
-// run will periodically process orders
-func (m *OrderManager) run() {
+// run will reconcile any orders restored from the database then periodically
+// process orders
+func (m *OrderManager) run(restored []order.Detail) {
 	log.Debugln(log.OrderMgr, "Order manager started.")
+	m.reconcilePersistedOrders(restored)
 	m.processOrders()
+	processTicker := time.NewTicker(orderManagerInterval)
+	defer processTicker.Stop()
+	conditionalTicker := time.NewTicker(conditionalOrderInterval)
+	defer conditionalTicker.Stop()
+	// Conditional order triggers are tracked so that any in flight submissions
+	// are cancelled and finished before the subsystem reports shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	for {
 		select {
 		case <-m.shutdown:
+			cancel()
 			m.gracefulShutdown()
 			m.orderStore.wg.Done()
 			log.Debugln(log.OrderMgr, "Order manager shutdown.")
 			return
-		case <-time.After(orderManagerInterval):
+		case <-processTicker.C:
 			// Process orders go routine allows shutdown procedures to continue
 			go m.processOrders()
+		case <-conditionalTicker.C:
+			// Triggers are checked on each ticker and orderbook update once
+			// subscribed, with cached data checked as a fallback until then
+			m.subscribeConditionalFeeds(ctx)
+			m.orderStore.wg.Add(1)
+			go func() {
+				defer m.orderStore.wg.Done()
+				m.processConditionalOrders(ctx)
+			}()
 		}
 	}
 }
@@ -409,6 +449,11 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		mod.Price = det.Price
 	}
 
+	err = m.checkModifyRisk(mod, det)
+	if err != nil {
+		return nil, err
+	}
+
 	// Get exchange instance and submit order modification request.
 	exch, err := m.orderStore.exchangeManager.GetExchangeByName(mod.Exchange)
 	if err != nil {
@@ -468,6 +513,10 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	if err != nil {
 		return nil, err
 	}
+	err = m.checkSubmitRisk(newOrder)
+	if err != nil {
+		return nil, err
+	}
 	// Checks for exchange min max limits for order amounts before order
 	// execution can occur
 	err = exch.CheckOrderExecutionLimits(newOrder.AssetType,
@@ -874,6 +923,44 @@ func (m *OrderManager) GetByExchangeAndID(exchangeName, id string) (*order.Detai
 	return m.orderStore.getByExchangeAndID(exchangeName, id)
 }
 
+// LinkChildOrder links an order held by the order manager to the parent order
+// it was placed for
+func (m *OrderManager) LinkChildOrder(parentID uuid.UUID, exchangeName, orderID string) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	return m.orderStore.linkChild(parentID, exchangeName, orderID)
+}
+
+// GetChildOrders returns a copy of the orders linked to a parent order in the
+// order they were linked
+func (m *OrderManager) GetChildOrders(parentID uuid.UUID) ([]order.Detail, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	return m.orderStore.getChildren(parentID), nil
+}
+
+// UnlinkChildOrders removes the links between a parent order and its child
+// orders once the parent order has finished. The child orders remain held by
+// the order manager
+func (m *OrderManager) UnlinkChildOrders(parentID uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.orderStore.unlinkChildren(parentID)
+	return nil
+}
+
 // UpdateExistingOrder will update an existing order in the orderstore
 func (m *OrderManager) UpdateExistingOrder(od *order.Detail) error {
 	if m == nil {
@@ -960,14 +1047,62 @@ func (s *store) getByExchangeAndID(exchange, id string) (*order.Detail, error) {
 	return nil, ErrOrderNotFound
 }
 
+// linkChild links an existing order to a parent order ID
+func (s *store) linkChild(parentID uuid.UUID, exchange, id string) error {
+	if parentID.IsNil() {
+		return fmt.Errorf("%w parent order ID", common.ErrNilPointer)
+	}
+	ref := orderReference{exchange: strings.ToLower(exchange), orderID: id}
+	s.m.Lock()
+	defer s.m.Unlock()
+	if !slices.ContainsFunc(s.Orders[ref.exchange], func(d *order.Detail) bool { return d.OrderID == id }) {
+		return fmt.Errorf("%w %s %s", ErrOrderNotFound, exchange, id)
+	}
+	if s.childOrders == nil {
+		s.childOrders = make(map[uuid.UUID][]orderReference)
+	}
+	if !slices.Contains(s.childOrders[parentID], ref) {
+		s.childOrders[parentID] = append(s.childOrders[parentID], ref)
+	}
+	return nil
+}
+
+// unlinkChildren removes all links to a parent order ID
+func (s *store) unlinkChildren(parentID uuid.UUID) {
+	s.m.Lock()
+	delete(s.childOrders, parentID)
+	s.m.Unlock()
+}
+
+// getChildren returns copies of the orders linked to a parent order ID
+func (s *store) getChildren(parentID uuid.UUID) []order.Detail {
+	s.m.Lock()
+	defer s.m.Unlock()
+	refs := s.childOrders[parentID]
+	children := make([]order.Detail, 0, len(refs))
+	for i := range refs {
+		for _, d := range s.Orders[refs[i].exchange] {
+			if d.OrderID == refs[i].orderID {
+				children = append(children, d.Copy())
+				break
+			}
+		}
+	}
+	return children
+}
+
 // updateExisting checks if an order exists in the orderstore
 // and then updates it
 func (s *store) updateExisting(od *order.Detail) error {
 	if od == nil {
 		return errNilOrder
 	}
+	var persisted *orderSnapshot
 	s.m.Lock()
-	defer s.m.Unlock()
+	defer func() {
+		s.m.Unlock()
+		s.persist(persisted)
+	}()
 	r, ok := s.Orders[strings.ToLower(od.Exchange)]
 	if !ok {
 		return ErrExchangeNotFound
@@ -976,10 +1111,14 @@ func (s *store) updateExisting(od *order.Detail) error {
 		if r[x].OrderID != od.OrderID {
 			continue
 		}
+		lastUpdated := r[x].LastUpdated
 		err := r[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return err
 		}
+		if !r[x].LastUpdated.Equal(lastUpdated) {
+			persisted = s.snapshot(r[x])
+		}
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
@@ -995,8 +1134,12 @@ func (s *store) updateExisting(od *order.Detail) error {
 // modifyExisting depends on mod.Exchange and given ID to uniquely identify an order and
 // modify it.
 func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
+	var persisted *orderSnapshot
 	s.m.Lock()
-	defer s.m.Unlock()
+	defer func() {
+		s.m.Unlock()
+		s.persist(persisted)
+	}()
 	r, ok := s.Orders[strings.ToLower(mod.Exchange)]
 	if !ok {
 		return ErrExchangeNotFound
@@ -1006,6 +1149,7 @@ func (s *store) modifyExisting(id string, mod *order.ModifyResponse) error {
 			continue
 		}
 		r[x].UpdateOrderFromModifyResponse(mod)
+		persisted = s.snapshot(r[x])
 		if !r[x].AssetType.IsFutures() {
 			return nil
 		}
@@ -1029,8 +1173,12 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	if err != nil {
 		return nil, err
 	}
+	var persisted *orderSnapshot
 	s.m.Lock()
-	defer s.m.Unlock()
+	defer func() {
+		s.m.Unlock()
+		s.persist(persisted)
+	}()
 	if od.AssetType.IsFutures() {
 		err = s.futuresPositionController.TrackNewOrder(od)
 		if err != nil && !errors.Is(err, futures.ErrPositionClosed) {
@@ -1044,10 +1192,15 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 		if exchangeOrders[x].OrderID != od.OrderID {
 			continue
 		}
+		lastUpdated := exchangeOrders[x].LastUpdated
 		err := exchangeOrders[x].UpdateOrderFromDetail(od)
 		if err != nil {
 			return nil, err
 		}
+		// Only write through when the order has changed
+		if !exchangeOrders[x].LastUpdated.Equal(lastUpdated) {
+			persisted = s.snapshot(exchangeOrders[x])
+		}
 		return &OrderUpsertResponse{
 			OrderDetails: exchangeOrders[x].Copy(),
 			IsNewOrder:   false,
@@ -1056,6 +1209,7 @@ func (s *store) upsert(od *order.Detail) (*OrderUpsertResponse, error) {
 	// Untracked websocket orders will not have internalIDs yet
 	od.GenerateInternalOrderID()
 	s.Orders[lName] = append(s.Orders[lName], od)
+	persisted = s.snapshot(od)
 	return &OrderUpsertResponse{OrderDetails: od.Copy(), IsNewOrder: true}, nil
 }
 
@@ -1092,8 +1246,12 @@ func (s *store) add(det *order.Detail) error {
 		return err
 	}
 
+	var persisted *orderSnapshot
 	s.m.Lock()
-	defer s.m.Unlock()
+	defer func() {
+		s.m.Unlock()
+		s.persist(persisted)
+	}()
 
 	// Inline copy of getByDetail to avoid possible lock races
 	for _, o := range s.Orders[name] {
@@ -1105,6 +1263,7 @@ func (s *store) add(det *order.Detail) error {
 	// Untracked websocket orders will not have internalIDs yet
 	det.GenerateInternalOrderID()
 	s.Orders[name] = append(s.Orders[name], det)
+	persisted = s.snapshot(det)
 	if !det.AssetType.IsFutures() {
 		return nil
 	}
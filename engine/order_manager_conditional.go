@@ -0,0 +1,645 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// String implements the stringer interface
+func (c ConditionalStatus) String() string {
+	switch c {
+	case ConditionalPending:
+		return "PENDING"
+	case ConditionalTriggered:
+		return "TRIGGERED"
+	case ConditionalCancelled:
+		return "CANCELLED"
+	case ConditionalFailed:
+		return "FAILED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// IsConditionalOrderType returns whether the order type can be held by the
+// order manager as a synthetic conditional order
+func IsConditionalOrderType(t order.Type) bool {
+	switch t {
+	case order.Stop, order.StopMarket, order.StopLimit, order.TakeProfit, order.TakeProfitMarket, order.TrailingStop, order.OCO:
+		return true
+	}
+	return false
+}
+
+// SubmitConditional validates and stores a stop, take profit, trailing stop or
+// OCO order locally. No request is sent to the exchange until the trigger
+// condition is met. OCO orders return both linked legs, take profit first
+func (m *OrderManager) SubmitConditional(newOrder *order.Submit) ([]ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if newOrder == nil {
+		return nil, errNilOrder
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(newOrder.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	var legs []*ConditionalOrder
+	switch newOrder.Type {
+	case order.OCO:
+		tp := newOrder.RiskManagementModes.TakeProfit
+		sl := newOrder.RiskManagementModes.StopLoss
+		if tp.Price <= 0 || sl.Price <= 0 {
+			return nil, errOCOLegsUnset
+		}
+		takeProfit := newConditionalOrder(newOrder, order.TakeProfitMarket, tp.Price, 0)
+		if tp.LimitPrice > 0 || tp.OrderType == order.Limit {
+			takeProfit = newConditionalOrder(newOrder, order.TakeProfit, tp.Price, tp.LimitPrice)
+		}
+		stopLoss := newConditionalOrder(newOrder, order.Stop, sl.Price, 0)
+		if sl.LimitPrice > 0 || sl.OrderType == order.Limit {
+			stopLoss = newConditionalOrder(newOrder, order.StopLimit, sl.Price, sl.LimitPrice)
+		}
+		takeProfit.LinkedID, stopLoss.LinkedID = stopLoss.ID, takeProfit.ID
+		legs = []*ConditionalOrder{takeProfit, stopLoss}
+	case order.Stop, order.StopMarket, order.TakeProfitMarket:
+		legs = []*ConditionalOrder{newConditionalOrder(newOrder, newOrder.Type, newOrder.TriggerPrice, 0)}
+	case order.StopLimit, order.TakeProfit:
+		legs = []*ConditionalOrder{newConditionalOrder(newOrder, newOrder.Type, newOrder.TriggerPrice, newOrder.Price)}
+	case order.TrailingStop:
+		co := newConditionalOrder(newOrder, order.TrailingStop, 0, 0)
+		co.TrackingMode = newOrder.TrackingMode
+		co.TrackingValue = newOrder.TrackingValue
+		legs = []*ConditionalOrder{co}
+	default:
+		return nil, fmt.Errorf("%w %v", errConditionalTypeUnsupported, newOrder.Type)
+	}
+
+	for i := range legs {
+		if err := legs[i].validate(); err != nil {
+			return nil, err
+		}
+		// Validate the order which will eventually be sent to the exchange so
+		// that configuration and trading requirements are enforced up front
+		execution := legs[i].deriveSubmit()
+		if err := m.validate(exch, execution); err != nil {
+			return nil, err
+		}
+	}
+
+	m.conditionals.m.Lock()
+	resp := make([]ConditionalOrder, len(legs))
+	for i := range legs {
+		m.conditionals.orders[legs[i].ID] = legs[i]
+		resp[i] = *legs[i]
+	}
+	m.conditionals.m.Unlock()
+
+	for i := range resp {
+		m.pushConditionalEvent(&resp[i], "added")
+	}
+	return resp, nil
+}
+
+// GetConditionalOrders returns a copy of the conditional orders held by the
+// order manager sorted by creation time. An empty exchange name returns orders
+// for all exchanges
+func (m *OrderManager) GetConditionalOrders(exchangeName string, pendingOnly bool) ([]ConditionalOrder, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.conditionals.m.Lock()
+	resp := make([]ConditionalOrder, 0, len(m.conditionals.orders))
+	for _, co := range m.conditionals.orders {
+		if exchangeName != "" && !strings.EqualFold(co.Exchange, exchangeName) {
+			continue
+		}
+		if pendingOnly && co.Status != ConditionalPending {
+			continue
+		}
+		resp = append(resp, *co)
+	}
+	m.conditionals.m.Unlock()
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].CreatedAt.Before(resp[j].CreatedAt)
+	})
+	return resp, nil
+}
+
+// CancelConditional cancels a pending conditional order along with its linked
+// OCO leg. As conditional orders are held locally no exchange request is made
+func (m *OrderManager) CancelConditional(id uuid.UUID) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	m.conditionals.m.Lock()
+	co, ok := m.conditionals.orders[id]
+	if !ok {
+		m.conditionals.m.Unlock()
+		return fmt.Errorf("%w %v", ErrConditionalOrderNotFound, id)
+	}
+	if co.Status != ConditionalPending {
+		m.conditionals.m.Unlock()
+		return fmt.Errorf("%w %v %v", ErrConditionalOrderNotPending, id, co.Status)
+	}
+	toCancel := []*ConditionalOrder{co}
+	if linked, ok := m.conditionals.orders[co.LinkedID]; ok && linked.Status == ConditionalPending {
+		toCancel = append(toCancel, linked)
+	}
+	now := time.Now()
+	cancelled := make([]ConditionalOrder, len(toCancel))
+	for i := range toCancel {
+		toCancel[i].Status = ConditionalCancelled
+		toCancel[i].LastUpdated = now
+		cancelled[i] = *toCancel[i]
+	}
+	m.conditionals.m.Unlock()
+
+	for i := range cancelled {
+		m.pushConditionalEvent(&cancelled[i], "cancelled")
+	}
+	return nil
+}
+
+// processConditionalOrders checks all pending conditional orders against the
+// latest cached market data and submits any which have been triggered
+func (m *OrderManager) processConditionalOrders(ctx context.Context) {
+	if !atomic.CompareAndSwapInt32(&m.processingConditionals, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&m.processingConditionals, 0)
+
+	m.conditionals.m.Lock()
+	pending := make([]*ConditionalOrder, 0, len(m.conditionals.orders))
+	for _, co := range m.conditionals.orders {
+		if co.Status == ConditionalPending {
+			pending = append(pending, co)
+		}
+	}
+	m.conditionals.m.Unlock()
+
+	for i := range pending {
+		if ctx.Err() != nil {
+			return
+		}
+		// Identifying fields are immutable after creation so can be read
+		// without holding the lock
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(pending[i].Exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Conditional order %v: %v", pending[i].ID, err)
+			continue
+		}
+		price, err := getConditionalReferencePrice(exch, pending[i])
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.OrderMgr, "Conditional order %v: %v", pending[i].ID, err)
+			}
+			continue
+		}
+		m.processConditionalOrder(ctx, pending[i], price)
+	}
+}
+
+// subscribeConditionalFeeds attaches to the ticker and orderbook feeds of
+// exchanges with pending conditional orders, so triggers are checked on each
+// update. A feed is only available once an exchange has loaded its first
+// ticker or orderbook, so subscription is retried until successful
+func (m *OrderManager) subscribeConditionalFeeds(ctx context.Context) {
+	m.conditionals.m.Lock()
+	var tickers, orderbooks []string
+	for _, co := range m.conditionals.orders {
+		if co.Status != ConditionalPending {
+			continue
+		}
+		exch := strings.ToLower(co.Exchange)
+		if !m.conditionals.tickerFeeds[exch] && !slices.Contains(tickers, exch) {
+			tickers = append(tickers, exch)
+		}
+		if !m.conditionals.orderbookFeeds[exch] && !slices.Contains(orderbooks, exch) {
+			orderbooks = append(orderbooks, exch)
+		}
+	}
+	m.conditionals.m.Unlock()
+
+	// Feeds are subscribed without holding the lock as the ticker and
+	// orderbook services are locked while subscribing
+	for _, exch := range tickers {
+		feed, err := m.conditionals.subscribeTickers(exch)
+		if err != nil {
+			if m.verbose {
+				log.Debugf(log.OrderMgr, "Conditional orders unable to subscribe to %s tickers: %v", exch, err)
+			}
+			continue
+		}
+		m.startConditionalFeed(ctx, exch, feed, m.conditionals.tickerFeeds)
+	}
+	for _, exch := range orderbooks {
+		feed, err := m.conditionals.subscribeOrderbooks(exch)
+		if err != nil {
+			if !errors.Is(err, orderbook.ErrOrderbookNotFound) {
+				log.Errorf(log.OrderMgr, "Conditional orders unable to subscribe to %s orderbooks: %v", exch, err)
+			}
+			continue
+		}
+		m.startConditionalFeed(ctx, exch, feed, m.conditionals.orderbookFeeds)
+	}
+}
+
+// startConditionalFeed records an exchange feed as subscribed and consumes it
+func (m *OrderManager) startConditionalFeed(ctx context.Context, exch string, feed conditionalFeed, subscribed map[string]bool) {
+	m.conditionals.m.Lock()
+	subscribed[exch] = true
+	m.conditionals.m.Unlock()
+	m.orderStore.wg.Add(1)
+	go m.consumeConditionalFeed(ctx, exch, feed, subscribed)
+}
+
+// consumeConditionalFeed checks pending conditional orders against each
+// update from an exchange ticker or orderbook feed until shutdown. If the feed
+// is closed it is removed from subscribed so it is resubscribed
+func (m *OrderManager) consumeConditionalFeed(ctx context.Context, exch string, feed conditionalFeed, subscribed map[string]bool) {
+	defer m.orderStore.wg.Done()
+	defer func() {
+		m.conditionals.m.Lock()
+		delete(subscribed, exch)
+		m.conditionals.m.Unlock()
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := feed.Release(); err != nil {
+				log.Errorln(log.OrderMgr, err)
+			}
+			return
+		case data, ok := <-feed.Channel():
+			if !ok {
+				return
+			}
+			switch d := data.(type) {
+			case *ticker.Price:
+				m.processConditionalTicker(ctx, d)
+			case orderbook.Outbound:
+				depth, ok := d.(*orderbook.Depth)
+				if !ok {
+					log.Errorln(log.OrderMgr, common.GetTypeAssertError("*orderbook.Depth", d))
+					continue
+				}
+				m.processConditionalDepth(ctx, depth)
+			default:
+				log.Errorln(log.OrderMgr, common.GetTypeAssertError("*ticker.Price or orderbook.Outbound", data))
+			}
+		}
+	}
+}
+
+// processConditionalDepth checks the pending conditional orders of an
+// orderbook against its best bid or ask when it is updated
+func (m *OrderManager) processConditionalDepth(ctx context.Context, depth *orderbook.Depth) {
+	if !depth.IsValid() {
+		return
+	}
+	pending := m.pendingConditionals(depth.Exchange(), depth.Pair(), depth.Asset())
+	for i := range pending {
+		price, err := depthReferencePrice(depth, pending[i])
+		if err != nil {
+			continue
+		}
+		m.checkConditionalOrder(ctx, pending[i], price)
+	}
+}
+
+// processConditionalTicker checks the pending conditional orders of a ticker
+// when it is updated. Orders with a valid orderbook are left to orderbook
+// updates, as its best bid or ask is preferred
+func (m *OrderManager) processConditionalTicker(ctx context.Context, tick *ticker.Price) {
+	if depth, err := orderbook.GetDepth(tick.ExchangeName, tick.Pair, tick.AssetType); err == nil && depth.IsValid() {
+		return
+	}
+	pending := m.pendingConditionals(tick.ExchangeName, tick.Pair, tick.AssetType)
+	for i := range pending {
+		price, err := tickerReferencePrice(tick, pending[i])
+		if err != nil {
+			continue
+		}
+		m.checkConditionalOrder(ctx, pending[i], price)
+	}
+}
+
+// pendingConditionals returns the pending conditional orders for an exchange
+// pair and asset
+func (m *OrderManager) pendingConditionals(exch string, pair currency.Pair, a asset.Item) []*ConditionalOrder {
+	m.conditionals.m.Lock()
+	defer m.conditionals.m.Unlock()
+	var pending []*ConditionalOrder
+	for _, co := range m.conditionals.orders {
+		if co.Status == ConditionalPending && co.AssetType == a && co.Pair.Equal(pair) && strings.EqualFold(co.Exchange, exch) {
+			pending = append(pending, co)
+		}
+	}
+	return pending
+}
+
+// checkConditionalOrder evaluates a conditional order against a feed update.
+// Triggered orders are submitted in the background so the feed is not held
+// up by the exchange request
+func (m *OrderManager) checkConditionalOrder(ctx context.Context, co *ConditionalOrder, price float64) {
+	execution, linked, ok := m.triggerConditionalOrder(co, price)
+	if !ok {
+		return
+	}
+	m.orderStore.wg.Add(1)
+	go func() {
+		defer m.orderStore.wg.Done()
+		m.submitConditionalOrder(ctx, co, linked, execution, price)
+	}()
+}
+
+// processConditionalOrder evaluates a single conditional order against the
+// supplied price and submits the resulting order to the exchange if triggered
+func (m *OrderManager) processConditionalOrder(ctx context.Context, co *ConditionalOrder, price float64) {
+	if execution, linked, ok := m.triggerConditionalOrder(co, price); ok {
+		m.submitConditionalOrder(ctx, co, linked, execution, price)
+	}
+}
+
+// triggerConditionalOrder marks a pending conditional order as triggered if
+// the price meets its trigger condition, cancelling its linked OCO leg. The
+// order to submit is returned along with the cancelled leg, if any
+func (m *OrderManager) triggerConditionalOrder(co *ConditionalOrder, price float64) (execution *order.Submit, linked *ConditionalOrder, triggered bool) {
+	m.conditionals.m.Lock()
+	defer m.conditionals.m.Unlock()
+	if co.Status != ConditionalPending || !co.isTriggered(price) {
+		return nil, nil, false
+	}
+	now := time.Now()
+	co.Status = ConditionalTriggered
+	co.TriggeredAt = now
+	co.LastUpdated = now
+	linked, ok := m.conditionals.orders[co.LinkedID]
+	if ok && linked.Status == ConditionalPending {
+		// Cancel the other OCO leg before submission so it cannot also fire
+		linked.Status = ConditionalCancelled
+		linked.LastUpdated = now
+	} else {
+		linked = nil
+	}
+	return co.deriveSubmit(), linked, true
+}
+
+// submitConditionalOrder submits the order of a triggered conditional order,
+// restoring its linked OCO leg if the submission fails
+func (m *OrderManager) submitConditionalOrder(ctx context.Context, co, linked *ConditionalOrder, execution *order.Submit, price float64) {
+	resp, err := m.Submit(ctx, execution)
+
+	m.conditionals.m.Lock()
+	co.LastUpdated = time.Now()
+	if err != nil {
+		co.Status = ConditionalFailed
+		co.Error = err.Error()
+		if linked != nil {
+			// The triggered leg never reached the exchange so the other leg
+			// remains the only protection for the position
+			linked.Status = ConditionalPending
+			linked.LastUpdated = co.LastUpdated
+			linked = nil
+		}
+	} else {
+		co.OrderID = resp.OrderID
+	}
+	triggered := *co
+	var cancelled *ConditionalOrder
+	if linked != nil {
+		cancelled = new(ConditionalOrder)
+		*cancelled = *linked
+	}
+	m.conditionals.m.Unlock()
+
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Conditional order %v triggered at price %v but failed to submit: %v", triggered.ID, price, err)
+		m.pushConditionalEvent(&triggered, "failed")
+		return
+	}
+	m.pushConditionalEvent(&triggered, fmt.Sprintf("triggered at price %v", price))
+	if cancelled != nil {
+		m.pushConditionalEvent(cancelled, "cancelled by linked order")
+	}
+}
+
+// pushConditionalEvent logs and relays a conditional order state change
+func (m *OrderManager) pushConditionalEvent(co *ConditionalOrder, action string) {
+	msg := fmt.Sprintf("Exchange %s conditional order ID=%v %s pair=%v side=%v type=%v trigger=%v amount=%v.",
+		co.Exchange, co.ID, action, co.Pair, co.Side, co.Type, co.TriggerPrice, co.Amount)
+	log.Debugln(log.OrderMgr, msg)
+	if m.orderStore.commsManager != nil {
+		m.orderStore.commsManager.PushEvent(base.Event{Type: "order", Message: msg})
+	}
+}
+
+// getConditionalReferencePrice returns the price a conditional order is
+// evaluated against. The best bid or ask from the orderbook depth is preferred
+// as it is the price the resulting order would execute against, with the
+// cached ticker used as a fallback
+func getConditionalReferencePrice(exch exchange.IBotExchange, co *ConditionalOrder) (float64, error) {
+	depth, err := orderbook.GetDepth(exch.GetName(), co.Pair, co.AssetType)
+	if err == nil && depth.IsValid() {
+		if price, err := depthReferencePrice(depth, co); err == nil {
+			return price, nil
+		}
+	}
+	tick, err := exch.GetCachedTicker(co.Pair, co.AssetType)
+	if err != nil {
+		return 0, err
+	}
+	return tickerReferencePrice(tick, co)
+}
+
+// depthReferencePrice returns the best ask for long orders and the best bid
+// for short orders
+func depthReferencePrice(depth *orderbook.Depth, co *ConditionalOrder) (float64, error) {
+	var price float64
+	var err error
+	if co.Side.IsLong() {
+		price, err = depth.GetBestAsk()
+	} else {
+		price, err = depth.GetBestBid()
+	}
+	if err != nil {
+		return 0, err
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("%w %v %v %v", errNoReferencePrice, co.Exchange, co.AssetType, co.Pair)
+	}
+	return price, nil
+}
+
+// tickerReferencePrice returns the last price of a ticker, falling back to
+// the ask for long orders and the bid for short orders
+func tickerReferencePrice(tick *ticker.Price, co *ConditionalOrder) (float64, error) {
+	switch {
+	case tick.Last > 0:
+		return tick.Last, nil
+	case co.Side.IsLong() && tick.Ask > 0:
+		return tick.Ask, nil
+	case co.Side.IsShort() && tick.Bid > 0:
+		return tick.Bid, nil
+	}
+	return 0, fmt.Errorf("%w %v %v %v", errNoReferencePrice, co.Exchange, co.AssetType, co.Pair)
+}
+
+// subscribeConditionalTickers subscribes to the dispatch ticker feed of an
+// exchange
+func subscribeConditionalTickers(exch string) (conditionalFeed, error) {
+	pipe, err := ticker.SubscribeToExchangeTickers(exch)
+	if err != nil {
+		return nil, err
+	}
+	return &pipe, nil
+}
+
+// subscribeConditionalOrderbooks subscribes to the dispatch orderbook feed of
+// an exchange
+func subscribeConditionalOrderbooks(exch string) (conditionalFeed, error) {
+	pipe, err := orderbook.SubscribeToExchangeOrderbooks(exch)
+	if err != nil {
+		return nil, err
+	}
+	return &pipe, nil
+}
+
+// newConditionalOrder creates a pending conditional order from a submission
+func newConditionalOrder(s *order.Submit, t order.Type, triggerPrice, limitPrice float64) *ConditionalOrder {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Unable to generate UUID. Err: %s", err)
+	}
+	now := time.Now()
+	return &ConditionalOrder{
+		ID:           id,
+		Exchange:     s.Exchange,
+		Pair:         s.Pair,
+		AssetType:    s.AssetType,
+		Side:         s.Side,
+		Type:         t,
+		Amount:       s.Amount,
+		TriggerPrice: triggerPrice,
+		LimitPrice:   limitPrice,
+		Status:       ConditionalPending,
+		CreatedAt:    now,
+		LastUpdated:  now,
+		submit:       *s,
+	}
+}
+
+// validate ensures the conditional order can be evaluated
+func (c *ConditionalOrder) validate() error {
+	if !c.Side.IsLong() && !c.Side.IsShort() {
+		return fmt.Errorf("%w %v", order.ErrSideIsInvalid, c.Side)
+	}
+	if c.Amount <= 0 {
+		return fmt.Errorf("%w %v", order.ErrAmountIsInvalid, c.Amount)
+	}
+	switch c.Type {
+	case order.TrailingStop:
+		if c.TrackingValue <= 0 || (c.TrackingMode != order.Distance && c.TrackingMode != order.Percentage) {
+			return errTrackingValueUnset
+		}
+		if c.TrackingMode == order.Percentage && c.TrackingValue >= 100 {
+			return fmt.Errorf("%w percentage must be less than 100", errTrackingValueUnset)
+		}
+	case order.StopLimit, order.TakeProfit:
+		if c.LimitPrice <= 0 {
+			return fmt.Errorf("%w for %v order", errLimitPriceUnset, c.Type)
+		}
+		fallthrough
+	default:
+		if c.TriggerPrice <= 0 {
+			return fmt.Errorf("%w for %v order", errTriggerPriceUnset, c.Type)
+		}
+	}
+	return nil
+}
+
+// isTriggered checks the price against the trigger condition, updating the
+// trailing stop trigger price as the market moves in the order's favour.
+// Stop orders trigger when the price moves against the side of the order and
+// take profit orders trigger when the price moves in favour of it
+func (c *ConditionalOrder) isTriggered(price float64) bool {
+	if price <= 0 {
+		return false
+	}
+	long := c.Side.IsLong()
+	switch c.Type {
+	case order.TrailingStop:
+		if c.ExtremePrice == 0 || (long && price < c.ExtremePrice) || (!long && price > c.ExtremePrice) {
+			c.ExtremePrice = price
+			offset := c.TrackingValue
+			if c.TrackingMode == order.Percentage {
+				offset = price * c.TrackingValue / 100
+			}
+			if long {
+				c.TriggerPrice = price + offset
+			} else {
+				c.TriggerPrice = price - offset
+			}
+		}
+		if long {
+			return price >= c.TriggerPrice
+		}
+		return price <= c.TriggerPrice
+	case order.TakeProfit, order.TakeProfitMarket:
+		if long {
+			return price <= c.TriggerPrice
+		}
+		return price >= c.TriggerPrice
+	default:
+		if long {
+			return price >= c.TriggerPrice
+		}
+		return price <= c.TriggerPrice
+	}
+}
+
+// deriveSubmit returns the market or limit order which is sent to the exchange
+// once the conditional order has been triggered
+func (c *ConditionalOrder) deriveSubmit() *order.Submit {
+	s := c.submit
+	s.Type = order.Market
+	s.Price = 0
+	if c.LimitPrice > 0 {
+		s.Type = order.Limit
+		s.Price = c.LimitPrice
+	}
+	s.TriggerPrice = 0
+	s.TrackingMode = order.UnknownTrackingMode
+	s.TrackingValue = 0
+	s.RiskManagementModes = order.RiskManagementModes{}
+	return &s
+}
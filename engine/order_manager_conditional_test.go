@@ -0,0 +1,504 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+var errConditionalSubmitFailure = errors.New("conditional submit failure")
+
+// cofExchange aka conditional order fake exchange allows triggered conditional
+// orders to be submitted without making exchange requests
+type cofExchange struct {
+	omfExchange
+	fail bool
+}
+
+func (f cofExchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if f.fail {
+		return nil, errConditionalSubmitFailure
+	}
+	return s.DeriveSubmitResponse("conditional")
+}
+
+func conditionalOrdersSetup(t *testing.T, fail bool) *OrderManager {
+	t.Helper()
+	em := NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	require.NoError(t, err)
+	exch.SetDefaults()
+	// Load the exchange config from the test file so no exchange requests are
+	// made during setup
+	c := &config.Config{}
+	require.NoError(t, c.LoadConfig(config.TestFile, true))
+	cfg, err := c.GetExchangeConfig(testExchange)
+	require.NoError(t, err)
+	require.NoError(t, exch.Setup(cfg))
+	require.NoError(t, em.Add(cofExchange{omfExchange: omfExchange{IBotExchange: exch}, fail: fail}))
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	return m
+}
+
+func conditionalSubmit(t order.Type) *order.Submit {
+	return &order.Submit{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      t,
+		Amount:    1,
+	}
+}
+
+func TestConditionalStatusString(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, "PENDING", ConditionalPending.String())
+	assert.Equal(t, "TRIGGERED", ConditionalTriggered.String())
+	assert.Equal(t, "CANCELLED", ConditionalCancelled.String())
+	assert.Equal(t, "FAILED", ConditionalFailed.String())
+	assert.Equal(t, "UNKNOWN", ConditionalStatus(255).String())
+}
+
+func TestIsConditionalOrderType(t *testing.T) {
+	t.Parallel()
+	for _, ot := range []order.Type{order.Stop, order.StopMarket, order.StopLimit, order.TakeProfit, order.TakeProfitMarket, order.TrailingStop, order.OCO} {
+		assert.Truef(t, IsConditionalOrderType(ot), "%v should be a conditional order type", ot)
+	}
+	assert.False(t, IsConditionalOrderType(order.Limit))
+	assert.False(t, IsConditionalOrderType(order.Market))
+}
+
+func TestSubmitConditional(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.SubmitConditional(nil)
+	require.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = conditionalOrdersSetup(t, false)
+	m.started = 0
+	_, err = m.SubmitConditional(nil)
+	require.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started = 1
+	_, err = m.SubmitConditional(nil)
+	require.ErrorIs(t, err, errNilOrder)
+
+	s := conditionalSubmit(order.Limit)
+	_, err = m.SubmitConditional(s)
+	require.ErrorIs(t, err, errConditionalTypeUnsupported)
+
+	s.Type = order.Stop
+	_, err = m.SubmitConditional(s)
+	require.ErrorIs(t, err, errTriggerPriceUnset)
+
+	s.TriggerPrice = 1000
+	s.Amount = 0
+	_, err = m.SubmitConditional(s)
+	require.ErrorIs(t, err, order.ErrAmountIsInvalid)
+
+	s.Amount = 1
+	s.Type = order.StopLimit
+	_, err = m.SubmitConditional(s)
+	require.ErrorIs(t, err, errLimitPriceUnset)
+
+	s.Price = 990
+	resp, err := m.SubmitConditional(s)
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Equal(t, order.StopLimit, resp[0].Type)
+	assert.Equal(t, 1000.0, resp[0].TriggerPrice)
+	assert.Equal(t, 990.0, resp[0].LimitPrice)
+	assert.Equal(t, ConditionalPending, resp[0].Status)
+
+	s = conditionalSubmit(order.TrailingStop)
+	_, err = m.SubmitConditional(s)
+	require.ErrorIs(t, err, errTrackingValueUnset)
+
+	s.TrackingMode = order.Percentage
+	s.TrackingValue = 100
+	_, err = m.SubmitConditional(s)
+	require.ErrorIs(t, err, errTrackingValueUnset)
+
+	s.TrackingValue = 5
+	resp, err = m.SubmitConditional(s)
+	require.NoError(t, err)
+	require.Len(t, resp, 1)
+	assert.Zero(t, resp[0].TriggerPrice, "trailing stop trigger price should be set on first evaluation")
+
+	s = conditionalSubmit(order.OCO)
+	_, err = m.SubmitConditional(s)
+	require.ErrorIs(t, err, errOCOLegsUnset)
+
+	s.RiskManagementModes.TakeProfit.Price = 1100
+	s.RiskManagementModes.StopLoss.Price = 900
+	s.RiskManagementModes.StopLoss.LimitPrice = 890
+	resp, err = m.SubmitConditional(s)
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	assert.Equal(t, order.TakeProfitMarket, resp[0].Type)
+	assert.Equal(t, order.StopLimit, resp[1].Type)
+	assert.Equal(t, resp[1].ID, resp[0].LinkedID)
+	assert.Equal(t, resp[0].ID, resp[1].LinkedID)
+
+	m.cfg.EnforceLimitConfig = true
+	m.cfg.AllowMarketOrders = false
+	s = conditionalSubmit(order.Stop)
+	s.TriggerPrice = 1000
+	_, err = m.SubmitConditional(s)
+	assert.ErrorContains(t, err, "order market type is not allowed", "derived execution order should be validated")
+}
+
+func TestGetConditionalOrders(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.GetConditionalOrders("", false)
+	require.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = conditionalOrdersSetup(t, false)
+	m.started = 0
+	_, err = m.GetConditionalOrders("", false)
+	require.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started = 1
+	s := conditionalSubmit(order.OCO)
+	s.RiskManagementModes.TakeProfit.Price = 1100
+	s.RiskManagementModes.StopLoss.Price = 900
+	legs, err := m.SubmitConditional(s)
+	require.NoError(t, err)
+
+	resp, err := m.GetConditionalOrders("", true)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2)
+
+	resp, err = m.GetConditionalOrders("fake", true)
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+
+	require.NoError(t, m.CancelConditional(legs[0].ID))
+	resp, err = m.GetConditionalOrders(testExchange, true)
+	require.NoError(t, err)
+	assert.Empty(t, resp)
+
+	resp, err = m.GetConditionalOrders(testExchange, false)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2)
+}
+
+func TestCancelConditional(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.CancelConditional(uuid.Nil)
+	require.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = conditionalOrdersSetup(t, false)
+	m.started = 0
+	err = m.CancelConditional(uuid.Nil)
+	require.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started = 1
+	err = m.CancelConditional(uuid.Nil)
+	require.ErrorIs(t, err, ErrConditionalOrderNotFound)
+
+	s := conditionalSubmit(order.OCO)
+	s.RiskManagementModes.TakeProfit.Price = 1100
+	s.RiskManagementModes.StopLoss.Price = 900
+	legs, err := m.SubmitConditional(s)
+	require.NoError(t, err)
+
+	require.NoError(t, m.CancelConditional(legs[1].ID))
+	err = m.CancelConditional(legs[0].ID)
+	require.ErrorIs(t, err, ErrConditionalOrderNotPending, "linked leg should also be cancelled")
+}
+
+func TestConditionalOrderIsTriggered(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		name      string
+		orderType order.Type
+		side      order.Side
+		price     float64
+		triggered bool
+	}{
+		{"long stop below trigger", order.Stop, order.Buy, 99, false},
+		{"long stop at trigger", order.Stop, order.Buy, 100, true},
+		{"short stop above trigger", order.Stop, order.Sell, 101, false},
+		{"short stop at trigger", order.Stop, order.Sell, 100, true},
+		{"long take profit above trigger", order.TakeProfit, order.Buy, 101, false},
+		{"long take profit below trigger", order.TakeProfit, order.Buy, 99, true},
+		{"short take profit below trigger", order.TakeProfitMarket, order.Sell, 99, false},
+		{"short take profit above trigger", order.TakeProfitMarket, order.Sell, 101, true},
+		{"zero price", order.Stop, order.Sell, 0, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			co := &ConditionalOrder{Type: tc.orderType, Side: tc.side, TriggerPrice: 100}
+			assert.Equal(t, tc.triggered, co.isTriggered(tc.price))
+		})
+	}
+}
+
+func TestConditionalOrderIsTriggeredTrailing(t *testing.T) {
+	t.Parallel()
+	co := &ConditionalOrder{Type: order.TrailingStop, Side: order.Sell, TrackingMode: order.Distance, TrackingValue: 10}
+	assert.False(t, co.isTriggered(100))
+	assert.Equal(t, 90.0, co.TriggerPrice)
+	assert.False(t, co.isTriggered(120), "rising price should raise the trigger")
+	assert.Equal(t, 110.0, co.TriggerPrice)
+	assert.False(t, co.isTriggered(115), "falling price should not lower the trigger")
+	assert.Equal(t, 110.0, co.TriggerPrice)
+	assert.True(t, co.isTriggered(110))
+
+	co = &ConditionalOrder{Type: order.TrailingStop, Side: order.Buy, TrackingMode: order.Percentage, TrackingValue: 10}
+	assert.False(t, co.isTriggered(100))
+	assert.Equal(t, 110.0, co.TriggerPrice)
+	assert.False(t, co.isTriggered(50), "falling price should lower the trigger")
+	assert.Equal(t, 55.0, co.TriggerPrice)
+	assert.True(t, co.isTriggered(56))
+}
+
+func TestConditionalOrderDeriveSubmit(t *testing.T) {
+	t.Parallel()
+	s := conditionalSubmit(order.StopLimit)
+	s.TriggerPrice = 100
+	s.Price = 95
+	co := newConditionalOrder(s, order.StopLimit, s.TriggerPrice, s.Price)
+	execution := co.deriveSubmit()
+	assert.Equal(t, order.Limit, execution.Type)
+	assert.Equal(t, 95.0, execution.Price)
+	assert.Zero(t, execution.TriggerPrice)
+	assert.Equal(t, order.StopLimit, s.Type, "original submission should not be modified")
+
+	co = newConditionalOrder(s, order.Stop, s.TriggerPrice, 0)
+	execution = co.deriveSubmit()
+	assert.Equal(t, order.Market, execution.Type)
+	assert.Zero(t, execution.Price)
+	assert.NoError(t, execution.Validate(protocol.TradingRequirements{}))
+}
+
+func TestProcessConditionalOrders(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	s := conditionalSubmit(order.OCO)
+	s.RiskManagementModes.TakeProfit.Price = 1500
+	s.RiskManagementModes.StopLoss.Price = 1000
+	legs, err := m.SubmitConditional(s)
+	require.NoError(t, err)
+
+	// omfExchange returns a cached ticker price of 1337
+	m.processConditionalOrders(t.Context())
+	resp, err := m.GetConditionalOrders("", true)
+	require.NoError(t, err)
+	assert.Len(t, resp, 2, "neither leg should trigger")
+
+	s = conditionalSubmit(order.Stop)
+	s.TriggerPrice = 1400
+	stop, err := m.SubmitConditional(s)
+	require.NoError(t, err)
+	m.processConditionalOrders(t.Context())
+
+	resp, err = m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	for i := range resp {
+		if resp[i].ID != stop[0].ID {
+			assert.Equal(t, ConditionalPending, resp[i].Status)
+			continue
+		}
+		assert.Equal(t, ConditionalTriggered, resp[i].Status)
+		assert.Equal(t, "conditional", resp[i].OrderID)
+		assert.False(t, resp[i].TriggeredAt.IsZero())
+	}
+
+	m.conditionals.m.Lock()
+	tp := m.conditionals.orders[legs[0].ID]
+	m.conditionals.m.Unlock()
+	m.processConditionalOrder(t.Context(), tp, 1600)
+	resp, err = m.GetConditionalOrders("", true)
+	require.NoError(t, err)
+	assert.Empty(t, resp, "triggering one OCO leg should cancel the other")
+
+	resp, err = m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	for i := range resp {
+		if resp[i].ID == legs[1].ID {
+			assert.Equal(t, ConditionalCancelled, resp[i].Status)
+		}
+	}
+}
+
+func TestProcessConditionalOrdersCancelled(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	s := conditionalSubmit(order.Stop)
+	s.TriggerPrice = 1400
+	_, err := m.SubmitConditional(s)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+	m.processConditionalOrders(ctx)
+	resp, err := m.GetConditionalOrders("", true)
+	require.NoError(t, err)
+	assert.Len(t, resp, 1, "cancelled context should not trigger orders")
+}
+
+func TestProcessConditionalOrderSubmitFailure(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, true)
+	s := conditionalSubmit(order.OCO)
+	s.RiskManagementModes.TakeProfit.Price = 1500
+	s.RiskManagementModes.StopLoss.Price = 1000
+	legs, err := m.SubmitConditional(s)
+	require.NoError(t, err)
+
+	m.conditionals.m.Lock()
+	sl := m.conditionals.orders[legs[1].ID]
+	m.conditionals.m.Unlock()
+	m.processConditionalOrder(t.Context(), sl, 900)
+
+	resp, err := m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	require.Len(t, resp, 2)
+	for i := range resp {
+		switch resp[i].ID {
+		case legs[0].ID:
+			assert.Equal(t, ConditionalPending, resp[i].Status, "linked leg should be restored when submission fails")
+		case legs[1].ID:
+			assert.Equal(t, ConditionalFailed, resp[i].Status)
+			assert.Contains(t, resp[i].Error, errConditionalSubmitFailure.Error())
+		}
+	}
+}
+
+// cofFeed is a ticker or orderbook feed driven by the test
+type cofFeed struct {
+	ch       chan any
+	released atomic.Bool
+}
+
+func (f *cofFeed) Channel() <-chan any { return f.ch }
+
+func (f *cofFeed) Release() error {
+	f.released.Store(true)
+	return nil
+}
+
+// conditionalOrderFilled returns whether a conditional order was triggered
+// and submitted to the exchange
+func conditionalOrderFilled(m *OrderManager, id uuid.UUID) bool {
+	m.conditionals.m.Lock()
+	defer m.conditionals.m.Unlock()
+	co := m.conditionals.orders[id]
+	return co.Status == ConditionalTriggered && co.OrderID != ""
+}
+
+func TestConditionalOrderFeeds(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	var subscriptions atomic.Int32
+	feeds := make(chan *cofFeed, 2)
+	m.conditionals.subscribeTickers = func(string) (conditionalFeed, error) {
+		subscriptions.Add(1)
+		f := &cofFeed{ch: make(chan any)}
+		feeds <- f
+		return f, nil
+	}
+	m.conditionals.subscribeOrderbooks = func(string) (conditionalFeed, error) {
+		return nil, orderbook.ErrOrderbookNotFound
+	}
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	m.subscribeConditionalFeeds(ctx)
+	assert.Zero(t, subscriptions.Load(), "feeds should not be subscribed without pending conditional orders")
+
+	pair := currency.NewPair(currency.NewCode("CONDFEED"), currency.USD)
+	s := conditionalSubmit(order.TrailingStop)
+	s.Pair = pair
+	s.TrackingMode = order.Distance
+	s.TrackingValue = 100
+	trailing, err := m.SubmitConditional(s)
+	require.NoError(t, err, "SubmitConditional must not error")
+	m.subscribeConditionalFeeds(ctx)
+	m.subscribeConditionalFeeds(ctx)
+	require.Equal(t, int32(1), subscriptions.Load(), "ticker feed must be subscribed once")
+	feed := <-feeds
+
+	// Each update is checked, so the high between updates is tracked
+	for _, price := range []float64{1000, 1100, 1050, 995} {
+		feed.ch <- &ticker.Price{ExchangeName: testExchange, Pair: pair, AssetType: asset.Spot, Last: price}
+	}
+	assert.Eventually(t, func() bool { return conditionalOrderFilled(m, trailing[0].ID) }, time.Second, time.Millisecond, "trailing stop should trigger from ticker updates")
+	m.conditionals.m.Lock()
+	assert.Equal(t, 1000.0, m.conditionals.orders[trailing[0].ID].TriggerPrice, "trailing stop should trail the highest update")
+	m.conditionals.m.Unlock()
+
+	close(feed.ch)
+	assert.Eventually(t, func() bool {
+		m.conditionals.m.Lock()
+		defer m.conditionals.m.Unlock()
+		return !m.conditionals.tickerFeeds[strings.ToLower(testExchange)]
+	}, time.Second, time.Millisecond, "closed feeds should be resubscribed")
+
+	s = conditionalSubmit(order.Stop)
+	s.Pair = pair
+	s.TriggerPrice = 500
+	_, err = m.SubmitConditional(s)
+	require.NoError(t, err, "SubmitConditional must not error")
+	m.subscribeConditionalFeeds(ctx)
+	require.Equal(t, int32(2), subscriptions.Load(), "closed ticker feed must be resubscribed")
+	feed = <-feeds
+	cancel()
+	assert.Eventually(t, feed.released.Load, time.Second, time.Millisecond, "feeds should be released on shutdown")
+}
+
+func TestProcessConditionalDepth(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	pair := currency.NewPair(currency.NewCode("CONDBOOK"), currency.USD)
+	s := conditionalSubmit(order.Stop)
+	s.Pair = pair
+	s.TriggerPrice = 1000
+	stop, err := m.SubmitConditional(s)
+	require.NoError(t, err, "SubmitConditional must not error")
+
+	b := &orderbook.Base{
+		Exchange:    testExchange,
+		Pair:        pair,
+		Asset:       asset.Spot,
+		Bids:        orderbook.Tranches{{Price: 1001, Amount: 1}},
+		Asks:        orderbook.Tranches{{Price: 1002, Amount: 1}},
+		LastUpdated: time.Now(),
+	}
+	require.NoError(t, b.Process(), "Process must not error")
+	depth, err := orderbook.GetDepth(testExchange, pair, asset.Spot)
+	require.NoError(t, err, "GetDepth must not error")
+	m.processConditionalDepth(t.Context(), depth)
+	m.processConditionalTicker(t.Context(), &ticker.Price{ExchangeName: testExchange, Pair: pair, AssetType: asset.Spot, Last: 900})
+	resp, err := m.GetConditionalOrders("", true)
+	require.NoError(t, err, "GetConditionalOrders must not error")
+	assert.Len(t, resp, 1, "stop should not trigger above the best bid or from tickers while the orderbook is valid")
+
+	b.Bids = orderbook.Tranches{{Price: 999, Amount: 1}}
+	b.LastUpdated = time.Now()
+	require.NoError(t, b.Process(), "Process must not error")
+	m.processConditionalDepth(t.Context(), depth)
+	assert.Eventually(t, func() bool { return conditionalOrderFilled(m, stop[0].ID) }, time.Second, time.Millisecond, "stop should trigger when the best bid crosses it")
+}
@@ -0,0 +1,349 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderdb "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// persistedOpenStatuses are the order statuses which indicate that a persisted
+// order may still be resting on an exchange and is reloaded on start
+var persistedOpenStatuses = []order.Status{
+	order.Active,
+	order.Open,
+	order.PartiallyFilled,
+	order.New,
+	order.PendingCancel,
+	order.Hidden,
+	order.AutoDeleverage,
+	order.Pending,
+}
+
+// SetupPersistence enables writing all tracked orders and their fills through
+// to the database. Open orders are reloaded from the database when the order
+// manager is started and reconciled against the exchange. It must be called
+// before Start
+func (m *OrderManager) SetupPersistence(dcm iDatabaseConnectionManager) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("order manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	db, err := orderdb.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	m.orderStore.db = db
+	return nil
+}
+
+// loadPersistedOrders adds all open orders from the database to the order
+// store and returns them for reconciliation
+func (m *OrderManager) loadPersistedOrders() []order.Detail {
+	if m.orderStore.db == nil {
+		return nil
+	}
+	statuses := make([]string, len(persistedOpenStatuses))
+	for i := range persistedOpenStatuses {
+		statuses[i] = persistedOpenStatuses[i].String()
+	}
+	persisted, err := m.orderStore.db.GetByStatus(statuses...)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to load persisted orders: %v", err)
+		return nil
+	}
+	restored := make([]order.Detail, 0, len(persisted))
+	for i := range persisted {
+		od, err := dbDetailToOrder(&persisted[i])
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to restore %s order ID=%v: %v", persisted[i].Exchange, persisted[i].OrderID, err)
+			continue
+		}
+		err = m.orderStore.add(od)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to restore %s order ID=%v: %v", persisted[i].Exchange, persisted[i].OrderID, err)
+			continue
+		}
+		restored = append(restored, od.Copy())
+	}
+	if len(restored) > 0 {
+		log.Infof(log.OrderMgr, "Order manager restored %d open orders from the database", len(restored))
+	}
+	return restored
+}
+
+// reconcilePersistedOrders compares orders restored from the database against
+// the active orders on each exchange. Restored orders no longer resting on the
+// exchange have their final state fetched, or are marked as unknown when it
+// cannot be determined
+func (m *OrderManager) reconcilePersistedOrders(restored []order.Detail) {
+	if len(restored) == 0 {
+		return
+	}
+	type exchangeAsset struct {
+		exchange string
+		asset    asset.Item
+	}
+	grouped := make(map[exchangeAsset][]order.Detail)
+	for i := range restored {
+		k := exchangeAsset{exchange: strings.ToLower(restored[i].Exchange), asset: restored[i].AssetType}
+		grouped[k] = append(grouped[k], restored[i])
+	}
+	for k, orders := range grouped {
+		exch, err := m.orderStore.exchangeManager.GetExchangeByName(k.exchange)
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to reconcile %s orders: %v", k.exchange, err)
+			continue
+		}
+		if !exch.IsRESTAuthenticationSupported() {
+			log.Warnf(log.OrderMgr, "Order manager unable to reconcile %s orders: REST authentication not supported", k.exchange)
+			continue
+		}
+		var pairs currency.Pairs
+		for i := range orders {
+			pairs = pairs.Add(orders[i].Pair)
+		}
+		active, err := exch.GetActiveOrders(context.TODO(), &order.MultiOrderRequest{
+			Side:      order.AnySide,
+			Type:      order.AnyType,
+			Pairs:     pairs,
+			AssetType: k.asset,
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr, "Order manager unable to reconcile %s %s orders: %v", k.exchange, k.asset, err)
+			continue
+		}
+		resting := make(map[string]struct{}, len(active))
+		for i := range active {
+			if _, err = m.UpsertOrder(&active[i]); err != nil {
+				log.Errorln(log.OrderMgr, err)
+				continue
+			}
+			resting[active[i].OrderID] = struct{}{}
+		}
+		for i := range orders {
+			if _, ok := resting[orders[i].OrderID]; ok {
+				continue
+			}
+			if exch.GetBase().GetSupportedFeatures().RESTCapabilities.GetOrder {
+				err = m.FetchAndUpdateExchangeOrder(exch, &orders[i], orders[i].AssetType)
+				if err == nil {
+					continue
+				}
+				log.Errorf(log.OrderMgr, "Order manager unable to fetch %s order ID=%v: %v", k.exchange, orders[i].OrderID, err)
+			}
+			err = m.orderStore.setStatus(orders[i].Exchange, orders[i].OrderID, order.UnknownStatus)
+			if err != nil {
+				log.Errorln(log.OrderMgr, err)
+				continue
+			}
+			log.Warnf(log.OrderMgr, "Order manager %s order ID=%v is no longer active and its final state is unknown", k.exchange, orders[i].OrderID)
+		}
+	}
+}
+
+// setStatus forcibly sets the status of an existing order
+func (s *store) setStatus(exchange, id string, status order.Status) error {
+	var persisted *orderSnapshot
+	s.m.Lock()
+	defer func() {
+		s.m.Unlock()
+		s.persist(persisted)
+	}()
+	r, ok := s.Orders[strings.ToLower(exchange)]
+	if !ok {
+		return ErrExchangeNotFound
+	}
+	for x := range r {
+		if r[x].OrderID != id {
+			continue
+		}
+		r[x].Status = status
+		r[x].LastUpdated = time.Now()
+		persisted = s.snapshot(r[x])
+		return nil
+	}
+	return ErrOrderNotFound
+}
+
+// orderSnapshot is the database representation of an order taken while holding
+// the store lock, sequenced so that writes made after the lock is released
+// are applied in the order the changes were made
+type orderSnapshot struct {
+	detail *orderdb.Detail
+	seq    uint64
+}
+
+// snapshot converts an order to its database representation when persistence
+// is enabled. It must be called while holding the store lock and the result
+// passed to persist once the lock has been released
+func (s *store) snapshot(det *order.Detail) *orderSnapshot {
+	if s.db == nil {
+		return nil
+	}
+	s.persistSeq++
+	return &orderSnapshot{detail: orderToDBDetail(det), seq: s.persistSeq}
+}
+
+// persist writes an order snapshot through to the database. Failures are
+// logged so that order tracking is not interrupted by database issues. It is
+// called without the store lock so that database latency does not block order
+// updates, with snapshots older than the last one written for the order being
+// dropped so that concurrent writes cannot regress the persisted state
+func (s *store) persist(snap *orderSnapshot) {
+	if snap == nil || s.db == nil {
+		return
+	}
+	key := strings.ToLower(snap.detail.Exchange) + "/" + snap.detail.OrderID
+	s.persistM.Lock()
+	defer s.persistM.Unlock()
+	if snap.seq < s.persistedSeq[key] {
+		return
+	}
+	if err := s.db.Upsert(snap.detail); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager unable to persist %s order ID=%v: %v", snap.detail.Exchange, snap.detail.OrderID, err)
+		return
+	}
+	if s.persistedSeq == nil {
+		s.persistedSeq = make(map[string]uint64)
+	}
+	s.persistedSeq[key] = snap.seq
+}
+
+// orderToDBDetail converts an order to its database representation
+func orderToDBDetail(det *order.Detail) *orderdb.Detail {
+	resp := &orderdb.Detail{
+		Exchange:             det.Exchange,
+		OrderID:              det.OrderID,
+		ClientOrderID:        det.ClientOrderID,
+		ClientID:             det.ClientID,
+		AccountID:            det.AccountID,
+		Base:                 det.Pair.Base.String(),
+		Quote:                det.Pair.Quote.String(),
+		Asset:                det.AssetType.String(),
+		Side:                 det.Side.String(),
+		Type:                 det.Type.String(),
+		Status:               det.Status.String(),
+		Price:                det.Price,
+		Amount:               det.Amount,
+		TriggerPrice:         det.TriggerPrice,
+		AverageExecutedPrice: det.AverageExecutedPrice,
+		ExecutedAmount:       det.ExecutedAmount,
+		RemainingAmount:      det.RemainingAmount,
+		Cost:                 det.Cost,
+		Fee:                  det.Fee,
+		FeeAsset:             det.FeeAsset.String(),
+		Leverage:             det.Leverage,
+		ReduceOnly:           det.ReduceOnly,
+		PostOnly:             det.PostOnly,
+		Date:                 det.Date,
+		LastUpdated:          det.LastUpdated,
+		CloseTime:            det.CloseTime,
+		Fills:                make([]orderdb.Fill, len(det.Trades)),
+	}
+	if !det.InternalOrderID.IsNil() {
+		resp.ID = det.InternalOrderID.String()
+	}
+	for i := range det.Trades {
+		resp.Fills[i] = orderdb.Fill{
+			TradeID:   det.Trades[i].TID,
+			Price:     det.Trades[i].Price,
+			Amount:    det.Trades[i].Amount,
+			Fee:       det.Trades[i].Fee,
+			FeeAsset:  det.Trades[i].FeeAsset,
+			Side:      det.Trades[i].Side.String(),
+			IsMaker:   det.Trades[i].IsMaker,
+			Timestamp: det.Trades[i].Timestamp,
+		}
+	}
+	return resp
+}
+
+// dbDetailToOrder converts a persisted order to an order
+func dbDetailToOrder(det *orderdb.Detail) (*order.Detail, error) {
+	id, err := uuid.FromString(det.ID)
+	if err != nil {
+		return nil, err
+	}
+	a, err := asset.New(det.Asset)
+	if err != nil {
+		return nil, err
+	}
+	// Untracked websocket orders may not have a known side or type
+	side, err := order.StringToOrderSide(det.Side)
+	if err != nil && det.Side != order.UnknownSide.String() {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(det.Type)
+	if err != nil && det.Type != order.UnknownType.String() {
+		return nil, err
+	}
+	status, err := order.StringToOrderStatus(det.Status)
+	if err != nil {
+		return nil, err
+	}
+	resp := &order.Detail{
+		Exchange:             det.Exchange,
+		InternalOrderID:      id,
+		OrderID:              det.OrderID,
+		ClientOrderID:        det.ClientOrderID,
+		ClientID:             det.ClientID,
+		AccountID:            det.AccountID,
+		Pair:                 currency.NewPair(currency.NewCode(det.Base), currency.NewCode(det.Quote)),
+		AssetType:            a,
+		Side:                 side,
+		Type:                 oType,
+		Status:               status,
+		Price:                det.Price,
+		Amount:               det.Amount,
+		TriggerPrice:         det.TriggerPrice,
+		AverageExecutedPrice: det.AverageExecutedPrice,
+		ExecutedAmount:       det.ExecutedAmount,
+		RemainingAmount:      det.RemainingAmount,
+		Cost:                 det.Cost,
+		Fee:                  det.Fee,
+		Leverage:             det.Leverage,
+		ReduceOnly:           det.ReduceOnly,
+		PostOnly:             det.PostOnly,
+		Date:                 det.Date,
+		LastUpdated:          det.LastUpdated,
+		CloseTime:            det.CloseTime,
+	}
+	if det.FeeAsset != "" {
+		resp.FeeAsset = currency.NewCode(det.FeeAsset)
+	}
+	if len(det.Fills) > 0 {
+		resp.Trades = make([]order.TradeHistory, len(det.Fills))
+	}
+	for i := range det.Fills {
+		fillSide, err := order.StringToOrderSide(det.Fills[i].Side)
+		if err != nil && det.Fills[i].Side != order.UnknownSide.String() {
+			return nil, err
+		}
+		resp.Trades[i] = order.TradeHistory{
+			Exchange:  det.Exchange,
+			TID:       det.Fills[i].TradeID,
+			Price:     det.Fills[i].Price,
+			Amount:    det.Fills[i].Amount,
+			Fee:       det.Fills[i].Fee,
+			FeeAsset:  det.Fills[i].FeeAsset,
+			Side:      fillSide,
+			IsMaker:   det.Fills[i].IsMaker,
+			Timestamp: det.Fills[i].Timestamp,
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,320 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	orderdb "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var errFakeOrderDB = errors.New("fake order db error")
+
+// fakeOrderDB is an in memory order database service
+type fakeOrderDB struct {
+	m       sync.Mutex
+	orders  map[string]orderdb.Detail
+	writes  int
+	loadErr bool
+	// onUpsert is called before each write when set
+	onUpsert func()
+}
+
+// pofExchange aka persisted order fake exchange allows persisted orders to be
+// reconciled without credentials
+type pofExchange struct {
+	cofExchange
+}
+
+func (f pofExchange) IsRESTAuthenticationSupported() bool {
+	return true
+}
+
+func newFakeOrderDB() *fakeOrderDB {
+	return &fakeOrderDB{orders: make(map[string]orderdb.Detail)}
+}
+
+func (f *fakeOrderDB) Upsert(orders ...*orderdb.Detail) error {
+	if f.onUpsert != nil {
+		f.onUpsert()
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	for i := range orders {
+		f.writes++
+		f.orders[strings.ToLower(orders[i].Exchange)+orders[i].OrderID] = *orders[i]
+	}
+	return nil
+}
+
+func (f *fakeOrderDB) GetByExchangeAndID(exch, id string) (*orderdb.Detail, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	o, ok := f.orders[strings.ToLower(exch)+id]
+	if !ok {
+		return nil, sql.ErrNoRows
+	}
+	return &o, nil
+}
+
+func (f *fakeOrderDB) GetByStatus(statuses ...string) ([]orderdb.Detail, error) {
+	if f.loadErr {
+		return nil, errFakeOrderDB
+	}
+	f.m.Lock()
+	defer f.m.Unlock()
+	var resp []orderdb.Detail
+	for _, o := range f.orders {
+		for i := range statuses {
+			if o.Status == statuses[i] {
+				resp = append(resp, o)
+				break
+			}
+		}
+	}
+	return resp, nil
+}
+
+func TestSetupPersistence(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	err := m.SetupPersistence(nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = &OrderManager{}
+	err = m.SetupPersistence(nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	err = m.SetupPersistence(&DatabaseConnectionManager{})
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	m.started = 1
+	err = m.SetupPersistence(&DatabaseConnectionManager{})
+	assert.ErrorIs(t, err, ErrSubSystemAlreadyStarted)
+}
+
+func TestOrderDBDetailConversion(t *testing.T) {
+	t.Parallel()
+	id, err := uuid.NewV4()
+	require.NoError(t, err, "NewV4 must not error")
+	tt := time.Now().UTC()
+	od := &order.Detail{
+		Exchange:        testExchange,
+		InternalOrderID: id,
+		OrderID:         "1337",
+		ClientOrderID:   "client",
+		Pair:            currency.NewPair(currency.BTC, currency.USD),
+		AssetType:       asset.Spot,
+		Side:            order.Buy,
+		Type:            order.Limit,
+		Status:          order.PartiallyFilled,
+		Price:           1337,
+		Amount:          2,
+		ExecutedAmount:  1,
+		FeeAsset:        currency.USD,
+		PostOnly:        true,
+		Date:            tt,
+		LastUpdated:     tt,
+		Trades: []order.TradeHistory{
+			{TID: "1", Price: 1337, Amount: 1, Side: order.Buy, IsMaker: true, Timestamp: tt},
+		},
+	}
+	dbDetail := orderToDBDetail(od)
+	assert.Equal(t, id.String(), dbDetail.ID)
+	assert.Equal(t, "PARTIALLY_FILLED", dbDetail.Status)
+	require.Len(t, dbDetail.Fills, 1, "Fills must be converted")
+
+	resp, err := dbDetailToOrder(dbDetail)
+	require.NoError(t, err, "dbDetailToOrder must not error")
+	od.Trades[0].Exchange = testExchange
+	assert.Equal(t, od, resp)
+
+	dbDetail.Side = order.UnknownSide.String()
+	dbDetail.Type = order.UnknownType.String()
+	resp, err = dbDetailToOrder(dbDetail)
+	require.NoError(t, err, "dbDetailToOrder must not error for unknown sides and types")
+	assert.Equal(t, order.UnknownSide, resp.Side)
+	assert.Equal(t, order.UnknownType, resp.Type)
+
+	dbDetail.Side = "sideways"
+	_, err = dbDetailToOrder(dbDetail)
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+
+	dbDetail.ID = "1337"
+	_, err = dbDetailToOrder(dbDetail)
+	assert.Error(t, err, "dbDetailToOrder should error on an invalid ID")
+}
+
+func TestOrderStoreWriteThrough(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	db := newFakeOrderDB()
+	m.orderStore.db = db
+
+	od := &order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "writethrough",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.New,
+		Price:     1337,
+		Amount:    1,
+	}
+	require.NoError(t, m.orderStore.add(od), "add must not error")
+	persisted, err := db.GetByExchangeAndID(testExchange, "writethrough")
+	require.NoError(t, err, "add must persist the order")
+	assert.Equal(t, od.InternalOrderID.String(), persisted.ID)
+
+	_, err = m.orderStore.upsert(&order.Detail{
+		Exchange:    testExchange,
+		OrderID:     "writethrough",
+		Status:      order.PartiallyFilled,
+		LastUpdated: time.Now(),
+	})
+	require.NoError(t, err, "upsert must not error")
+	persisted, err = db.GetByExchangeAndID(testExchange, "writethrough")
+	require.NoError(t, err)
+	assert.Equal(t, order.PartiallyFilled.String(), persisted.Status)
+
+	writes := db.writes
+	_, err = m.orderStore.upsert(&order.Detail{
+		Exchange: testExchange,
+		OrderID:  "writethrough",
+	})
+	require.NoError(t, err, "upsert must not error")
+	assert.Equal(t, writes, db.writes, "unchanged orders should not be written")
+
+	err = m.orderStore.modifyExisting("writethrough", &order.ModifyResponse{
+		Exchange: testExchange,
+		OrderID:  "writethrough",
+		Price:    1338,
+		Amount:   1,
+	})
+	require.NoError(t, err, "modifyExisting must not error")
+	persisted, err = db.GetByExchangeAndID(testExchange, "writethrough")
+	require.NoError(t, err)
+	assert.Equal(t, 1338.0, persisted.Price)
+
+	err = m.orderStore.setStatus(testExchange, "writethrough", order.UnknownStatus)
+	require.NoError(t, err, "setStatus must not error")
+	persisted, err = db.GetByExchangeAndID(testExchange, "writethrough")
+	require.NoError(t, err)
+	assert.Equal(t, order.UnknownStatus.String(), persisted.Status)
+
+	err = m.orderStore.setStatus(testExchange, "unknown", order.UnknownStatus)
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+	err = m.orderStore.setStatus("unknown", "writethrough", order.UnknownStatus)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+}
+
+func TestLoadAndReconcilePersistedOrders(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	em, ok := m.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok, "exchange manager must be an *ExchangeManager")
+	em.mtx.Lock()
+	cof, ok := em.exchanges[strings.ToLower(testExchange)].(cofExchange)
+	require.True(t, ok, "exchange must be a cofExchange")
+	em.exchanges[strings.ToLower(testExchange)] = pofExchange{cofExchange: cof}
+	em.mtx.Unlock()
+	assert.Nil(t, m.loadPersistedOrders(), "loadPersistedOrders should return nil without a database")
+
+	db := newFakeOrderDB()
+	m.orderStore.db = db
+	db.loadErr = true
+	assert.Nil(t, m.loadPersistedOrders(), "loadPersistedOrders should return nil on error")
+	db.loadErr = false
+
+	for _, o := range []*order.Detail{
+		// still resting on the exchange as returned by GetActiveOrders
+		{OrderID: "Order3-unknown-to-active", Status: order.Active},
+		// no longer resting, GetOrderInfo returns it as cancelled
+		{OrderID: "crashed", Status: order.New},
+		// closed orders are not restored
+		{OrderID: "filled", Status: order.Filled},
+	} {
+		id, err := uuid.NewV4()
+		require.NoError(t, err)
+		o.InternalOrderID = id
+		o.Exchange = testExchange
+		o.Pair = btcusdPair
+		o.AssetType = asset.Spot
+		o.Side = order.Sell
+		o.Type = order.Limit
+		o.Amount = 2
+		o.LastUpdated = time.Now().Add(-time.Hour)
+		require.NoError(t, db.Upsert(orderToDBDetail(o)))
+	}
+	require.NoError(t, db.Upsert(&orderdb.Detail{Exchange: "unloaded", OrderID: "1", Status: order.Active.String()}))
+
+	restored := m.loadPersistedOrders()
+	require.Len(t, restored, 2, "loadPersistedOrders must only restore open orders from loaded exchanges")
+	assert.True(t, m.orderStore.exists(&order.Detail{Exchange: testExchange, OrderID: "crashed"}), "crashed order should be restored")
+	assert.False(t, m.orderStore.exists(&order.Detail{Exchange: testExchange, OrderID: "filled"}), "filled order should not be restored")
+
+	m.reconcilePersistedOrders(restored)
+	od, err := m.orderStore.getByExchangeAndID(testExchange, "Order3-unknown-to-active")
+	require.NoError(t, err)
+	assert.Equal(t, order.Active, od.Status)
+	od, err = m.orderStore.getByExchangeAndID(testExchange, "crashed")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, od.Status)
+	persisted, err := db.GetByExchangeAndID(testExchange, "crashed")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled.String(), persisted.Status, "reconciled state must be persisted")
+}
+
+func TestOrderStorePersistOutsideLock(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	db := newFakeOrderDB()
+	m.orderStore.db = db
+	var lockHeld bool
+	db.onUpsert = func() {
+		if !m.orderStore.m.TryLock() {
+			lockHeld = true
+			return
+		}
+		m.orderStore.m.Unlock()
+	}
+
+	od := &order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "outsidelock",
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.New,
+		Price:     1337,
+		Amount:    1,
+	}
+	require.NoError(t, m.orderStore.add(od), "add must not error")
+	require.NoError(t, m.orderStore.setStatus(testExchange, "outsidelock", order.Open), "setStatus must not error")
+	assert.Equal(t, 2, db.writes)
+	assert.False(t, lockHeld, "store lock must not be held during database writes")
+
+	m.orderStore.m.Lock()
+	stale := m.orderStore.snapshot(od)
+	od.Status = order.Cancelled
+	latest := m.orderStore.snapshot(od)
+	m.orderStore.m.Unlock()
+	m.orderStore.persist(latest)
+	m.orderStore.persist(stale)
+	persisted, err := db.GetByExchangeAndID(testExchange, "outsidelock")
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled.String(), persisted.Status, "stale snapshot must not overwrite a newer one")
+	assert.Equal(t, 3, db.writes)
+}
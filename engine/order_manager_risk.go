@@ -0,0 +1,336 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const riskAuditType = "risk"
+
+var (
+	errInvalidRiskLimit     = errors.New("invalid risk limit, values cannot be negative")
+	errKillSwitchNotEngaged = errors.New("risk kill switch not engaged")
+)
+
+// riskRequest contains the details of an order submission or modification
+// required to run pre-trade risk checks
+type riskRequest struct {
+	exchange  string
+	pair      currency.Pair
+	assetType asset.Item
+	orderID   string
+	price     float64
+	amount    float64
+	// amountIncrease is the amount added to the position by the request
+	amountIncrease float64
+	// isNew is true for new orders which increase the open order count
+	isNew bool
+}
+
+// Error implements the error interface
+func (r *RiskRejectionError) Error() string {
+	msg := fmt.Sprintf("order manager: %s %s %s order rejected: %v", r.Exchange, r.AssetType, r.Pair, r.Reason)
+	if r.OrderID != "" {
+		msg = fmt.Sprintf("order manager: %s %s %s order ID %s rejected: %v", r.Exchange, r.AssetType, r.Pair, r.OrderID, r.Reason)
+	}
+	if r.Limit != 0 || r.Value != 0 {
+		msg += fmt.Sprintf(" value %v limit %v", r.Value, r.Limit)
+	}
+	return msg
+}
+
+// Unwrap returns the underlying risk error
+func (r *RiskRejectionError) Unwrap() error {
+	return r.Reason
+}
+
+// validateRiskLimits ensures no risk limit values are negative
+func validateRiskLimits(limits []config.RiskLimit) error {
+	for i := range limits {
+		if limits[i].MaxNotional < 0 ||
+			limits[i].MaxPositionSize < 0 ||
+			limits[i].MaxOpenOrders < 0 ||
+			limits[i].PriceBandPercentage < 0 ||
+			limits[i].MaxOrdersPerMinute < 0 {
+			return fmt.Errorf("%w: limit %d", errInvalidRiskLimit, i)
+		}
+	}
+	return nil
+}
+
+// audit writes a risk event to the audit table
+func (r *preTradeRisk) audit(id, message string) {
+	if r.auditEvent != nil {
+		r.auditEvent(id, riskAuditType, message)
+	}
+}
+
+// EngageKillSwitch rejects all subsequent order submissions and
+// modifications, cancels all pending conditional orders and cancels all
+// orders on every exchange
+func (m *OrderManager) EngageKillSwitch(ctx context.Context) error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if !atomic.CompareAndSwapInt32(&m.risk.killSwitch, 0, 1) {
+		return ErrKillSwitchEngaged
+	}
+	log.Warnln(log.OrderMgr, "Order manager risk kill switch engaged, cancelling all orders")
+	m.orderStore.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: "Risk kill switch engaged, cancelling all orders",
+	})
+	m.risk.audit("killSwitch", "risk kill switch engaged")
+
+	pending, err := m.GetConditionalOrders("", true)
+	if err != nil {
+		return err
+	}
+	for i := range pending {
+		if err = m.CancelConditional(pending[i].ID); err != nil && !errors.Is(err, ErrConditionalOrderNotPending) {
+			log.Errorln(log.OrderMgr, err)
+		}
+	}
+
+	exchanges, err := m.orderStore.exchangeManager.GetExchanges()
+	if err != nil {
+		return err
+	}
+	m.CancelAllOrders(ctx, exchanges)
+	return nil
+}
+
+// ReleaseKillSwitch allows order submissions and modifications to resume
+func (m *OrderManager) ReleaseKillSwitch() error {
+	if m == nil {
+		return fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.risk.killSwitch, 1, 0) {
+		return errKillSwitchNotEngaged
+	}
+	log.Warnln(log.OrderMgr, "Order manager risk kill switch released")
+	m.risk.audit("killSwitch", "risk kill switch released")
+	return nil
+}
+
+// IsKillSwitchEngaged returns whether the risk kill switch is engaged
+func (m *OrderManager) IsKillSwitchEngaged() bool {
+	return m != nil && atomic.LoadInt32(&m.risk.killSwitch) == 1
+}
+
+// checkSubmitRisk runs pre-trade risk checks against a new order
+func (m *OrderManager) checkSubmitRisk(s *order.Submit) error {
+	return m.checkRisk(&riskRequest{
+		exchange:       s.Exchange,
+		pair:           s.Pair,
+		assetType:      s.AssetType,
+		price:          s.Price,
+		amount:         s.Amount,
+		amountIncrease: s.Amount,
+		isNew:          true,
+	})
+}
+
+// checkModifyRisk runs pre-trade risk checks against an order modification
+func (m *OrderManager) checkModifyRisk(mod *order.Modify, det *order.Detail) error {
+	return m.checkRisk(&riskRequest{
+		exchange:       mod.Exchange,
+		pair:           det.Pair,
+		assetType:      det.AssetType,
+		orderID:        mod.OrderID,
+		price:          mod.Price,
+		amount:         mod.Amount,
+		amountIncrease: math.Max(mod.Amount-det.Amount, 0),
+	})
+}
+
+// checkRisk runs all pre-trade risk checks for limits matching the request.
+// Checks are serialised so the order rate is accounted accurately
+func (m *OrderManager) checkRisk(req *riskRequest) error {
+	if atomic.LoadInt32(&m.risk.killSwitch) == 1 {
+		return m.notifyRiskRejection(newRiskRejection(req, ErrKillSwitchEngaged, 0, 0))
+	}
+	if !m.risk.enabled {
+		return nil
+	}
+	// Notifications and auditing are done without holding the risk lock so
+	// that order submissions are not serialised behind comms and database I/O
+	if rejection := m.evaluateRiskLimits(req); rejection != nil {
+		return m.notifyRiskRejection(rejection)
+	}
+	return nil
+}
+
+// evaluateRiskLimits checks the request against all matching risk limits,
+// returning the rejection for the first limit breached
+func (m *OrderManager) evaluateRiskLimits(req *riskRequest) *RiskRejectionError {
+	var midPrice float64
+	var midErr error
+	getMidPrice := func() (float64, error) {
+		if midPrice != 0 || midErr != nil {
+			return midPrice, midErr
+		}
+		var depth *orderbook.Depth
+		depth, midErr = orderbook.GetDepth(req.exchange, req.pair, req.assetType)
+		if midErr != nil {
+			return 0, midErr
+		}
+		midPrice, midErr = depth.GetMidPrice()
+		return midPrice, midErr
+	}
+
+	m.risk.m.Lock()
+	defer m.risk.m.Unlock()
+	now := time.Now()
+	var throttled []int
+	for i := range m.risk.limits {
+		l := &m.risk.limits[i]
+		if !riskLimitMatches(l, req.exchange, req.assetType, req.pair) {
+			continue
+		}
+		if l.MaxNotional > 0 {
+			price := req.price
+			if price == 0 {
+				var err error
+				if price, err = getMidPrice(); err != nil {
+					return newRiskRejection(req, fmt.Errorf("%w: %w", ErrRiskReferencePriceUnavailable, err), 0, 0)
+				}
+			}
+			if notional := price * req.amount; notional > l.MaxNotional {
+				return newRiskRejection(req, ErrMaxNotionalExceeded, l.MaxNotional, notional)
+			}
+		}
+		if l.PriceBandPercentage > 0 && req.price > 0 {
+			mid, err := getMidPrice()
+			if err != nil {
+				return newRiskRejection(req, fmt.Errorf("%w: %w", ErrRiskReferencePriceUnavailable, err), 0, 0)
+			}
+			if deviation := math.Abs(req.price-mid) / mid * 100; deviation > l.PriceBandPercentage {
+				return newRiskRejection(req, ErrPriceOutsideBand, l.PriceBandPercentage, deviation)
+			}
+		}
+		if l.MaxOpenOrders > 0 && req.isNew {
+			if count := m.riskOpenOrderCount(l) + 1; count > l.MaxOpenOrders {
+				return newRiskRejection(req, ErrMaxOpenOrdersExceeded, float64(l.MaxOpenOrders), float64(count))
+			}
+		}
+		if l.MaxPositionSize > 0 && req.amountIncrease > 0 {
+			if size := m.riskPositionSize(req) + req.amountIncrease; size > l.MaxPositionSize {
+				return newRiskRejection(req, ErrMaxPositionSizeExceeded, l.MaxPositionSize, size)
+			}
+		}
+		if l.MaxOrdersPerMinute > 0 {
+			recent := m.risk.submissions[i][:0]
+			for _, t := range m.risk.submissions[i] {
+				if now.Sub(t) < time.Minute {
+					recent = append(recent, t)
+				}
+			}
+			m.risk.submissions[i] = recent
+			if count := int64(len(recent)) + 1; count > l.MaxOrdersPerMinute {
+				return newRiskRejection(req, ErrOrderRateExceeded, float64(l.MaxOrdersPerMinute), float64(count))
+			}
+			throttled = append(throttled, i)
+		}
+	}
+	// Only account for requests which pass every check
+	for _, i := range throttled {
+		m.risk.submissions[i] = append(m.risk.submissions[i], now)
+	}
+	return nil
+}
+
+// riskOpenOrderCount returns the number of active orders within the scope of a
+// risk limit
+func (m *OrderManager) riskOpenOrderCount(l *config.RiskLimit) int64 {
+	var count int64
+	active := m.orderStore.getActiveOrders(nil)
+	for i := range active {
+		if riskLimitMatches(l, active[i].Exchange, active[i].AssetType, active[i].Pair) {
+			count++
+		}
+	}
+	return count
+}
+
+// riskPositionSize returns the gross size of the current futures position
+// and the remaining amount of all active orders for the requested exchange,
+// asset and pair
+func (m *OrderManager) riskPositionSize(req *riskRequest) float64 {
+	var size float64
+	if req.assetType.IsFutures() && m.activelyTrackFuturesPositions {
+		pos, err := m.orderStore.futuresPositionController.GetOpenPosition(req.exchange, req.assetType, req.pair)
+		if err == nil {
+			size += pos.LatestSize.Abs().InexactFloat64()
+		}
+	}
+	active := m.orderStore.getActiveOrders(&order.Filter{
+		Exchange:  req.exchange,
+		AssetType: req.assetType,
+		Pair:      req.pair,
+	})
+	for i := range active {
+		if req.orderID != "" && active[i].OrderID == req.orderID {
+			// Modifications account for the existing order via the amount
+			// increase
+			size += active[i].Amount - active[i].ExecutedAmount
+			continue
+		}
+		remaining := active[i].RemainingAmount
+		if remaining <= 0 {
+			remaining = active[i].Amount - active[i].ExecutedAmount
+		}
+		size += remaining
+	}
+	return size
+}
+
+// newRiskRejection returns a risk rejection for the request
+func newRiskRejection(req *riskRequest, reason error, limit, value float64) *RiskRejectionError {
+	return &RiskRejectionError{
+		Exchange:  req.exchange,
+		Pair:      req.pair,
+		AssetType: req.assetType,
+		OrderID:   req.orderID,
+		Reason:    reason,
+		Limit:     limit,
+		Value:     value,
+	}
+}
+
+// notifyRiskRejection notifies and audits a risk rejection and returns it as
+// an error. It must not be called while holding the risk lock
+func (m *OrderManager) notifyRiskRejection(rejection *RiskRejectionError) error {
+	msg := rejection.Error()
+	log.Warnln(log.OrderMgr, msg)
+	m.orderStore.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: msg,
+	})
+	m.risk.audit(rejection.Exchange, msg)
+	return rejection
+}
+
+// riskLimitMatches returns whether a risk limit applies to an exchange, asset
+// and pair
+func riskLimitMatches(l *config.RiskLimit, exch string, a asset.Item, p currency.Pair) bool {
+	return (l.Exchange == "" || strings.EqualFold(l.Exchange, exch)) &&
+		(l.Asset == asset.Empty || l.Asset == a) &&
+		(l.Pair.IsEmpty() || l.Pair.Equal(p))
+}
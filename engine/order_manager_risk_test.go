@@ -0,0 +1,327 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// riskPair has its own orderbook so mid prices are not shared with other
+// tests
+var riskPair = currency.NewPair(currency.XRP, currency.USD)
+
+// fakeAudit records audit events
+type fakeAudit struct {
+	m      sync.Mutex
+	events []string
+}
+
+func (f *fakeAudit) event(id, msgtype, message string) {
+	f.m.Lock()
+	f.events = append(f.events, id+":"+msgtype+":"+message)
+	f.m.Unlock()
+}
+
+func (f *fakeAudit) len() int {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return len(f.events)
+}
+
+func riskSetup(t *testing.T, limits ...config.RiskLimit) (*OrderManager, *fakeAudit) {
+	t.Helper()
+	m := conditionalOrdersSetup(t, false)
+	fa := &fakeAudit{}
+	m.risk.enabled = true
+	m.risk.limits = limits
+	m.risk.auditEvent = fa.event
+	return m, fa
+}
+
+func riskSubmit(price, amount float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  testExchange,
+		Pair:      riskPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     price,
+		Amount:    amount,
+	}
+}
+
+func processRiskOrderbook(t *testing.T) {
+	t.Helper()
+	b := &orderbook.Base{
+		Exchange:    testExchange,
+		Pair:        riskPair,
+		Asset:       asset.Spot,
+		Bids:        orderbook.Tranches{{Price: 99, Amount: 10}},
+		Asks:        orderbook.Tranches{{Price: 101, Amount: 10}},
+		LastUpdated: time.Now(),
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+}
+
+func TestValidateRiskLimits(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, validateRiskLimits(nil))
+	assert.NoError(t, validateRiskLimits([]config.RiskLimit{{MaxNotional: 1}}))
+	for _, l := range []config.RiskLimit{
+		{MaxNotional: -1},
+		{MaxPositionSize: -1},
+		{MaxOpenOrders: -1},
+		{PriceBandPercentage: -1},
+		{MaxOrdersPerMinute: -1},
+	} {
+		assert.ErrorIs(t, validateRiskLimits([]config.RiskLimit{l}), errInvalidRiskLimit)
+	}
+
+	_, err := SetupOrderManager(NewExchangeManager(), &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{
+		Risk: config.PreTradeRisk{Limits: []config.RiskLimit{{MaxNotional: -1}}},
+	})
+	assert.ErrorIs(t, err, errInvalidRiskLimit)
+}
+
+func TestRiskRejectionError(t *testing.T) {
+	t.Parallel()
+	err := error(&RiskRejectionError{
+		Exchange:  testExchange,
+		Pair:      btcusdPair,
+		AssetType: asset.Spot,
+		Reason:    ErrMaxNotionalExceeded,
+		Limit:     100,
+		Value:     200,
+	})
+	assert.ErrorIs(t, err, ErrMaxNotionalExceeded)
+	assert.Equal(t, "order manager: Bitstamp spot BTCUSD order rejected: order exceeds maximum notional value 200 limit 100", err.Error())
+
+	var rejection *RiskRejectionError
+	require.True(t, errors.As(err, &rejection), "error must be a RiskRejectionError")
+	rejection.OrderID = "1337"
+	rejection.Limit, rejection.Value = 0, 0
+	assert.Equal(t, "order manager: Bitstamp spot BTCUSD order ID 1337 rejected: order exceeds maximum notional", err.Error())
+}
+
+func TestRiskLimitMatches(t *testing.T) {
+	t.Parallel()
+	assert.True(t, riskLimitMatches(&config.RiskLimit{}, testExchange, asset.Spot, btcusdPair))
+	assert.True(t, riskLimitMatches(&config.RiskLimit{Exchange: "bitstamp", Asset: asset.Spot, Pair: btcusdPair}, testExchange, asset.Spot, btcusdPair))
+	assert.False(t, riskLimitMatches(&config.RiskLimit{Exchange: "binance"}, testExchange, asset.Spot, btcusdPair))
+	assert.False(t, riskLimitMatches(&config.RiskLimit{Asset: asset.Futures}, testExchange, asset.Spot, btcusdPair))
+	assert.False(t, riskLimitMatches(&config.RiskLimit{Pair: riskPair}, testExchange, asset.Spot, btcusdPair))
+}
+
+func TestCheckRiskDisabled(t *testing.T) {
+	t.Parallel()
+	m, fa := riskSetup(t, config.RiskLimit{MaxNotional: 1})
+	m.risk.enabled = false
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(100, 1)), "checkSubmitRisk should not error when disabled")
+	assert.Zero(t, fa.len())
+}
+
+func TestCheckRiskRejectionOutsideLock(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, config.RiskLimit{MaxNotional: 1})
+	var lockHeld bool
+	m.risk.auditEvent = func(string, string, string) {
+		if !m.risk.m.TryLock() {
+			lockHeld = true
+			return
+		}
+		m.risk.m.Unlock()
+	}
+	assert.ErrorIs(t, m.checkSubmitRisk(riskSubmit(100, 1)), ErrMaxNotionalExceeded)
+	assert.False(t, lockHeld, "risk lock must not be held while auditing rejections")
+}
+
+func TestCheckRiskMaxNotional(t *testing.T) {
+	t.Parallel()
+	processRiskOrderbook(t)
+	m, fa := riskSetup(t, config.RiskLimit{Exchange: testExchange, MaxNotional: 1000})
+
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(100, 10)))
+	err := m.checkSubmitRisk(riskSubmit(100, 10.1))
+	assert.ErrorIs(t, err, ErrMaxNotionalExceeded)
+	assert.Equal(t, 1, fa.len(), "rejections should be audited")
+
+	// market orders use the orderbook mid price of 100
+	s := riskSubmit(0, 11)
+	s.Type = order.Market
+	assert.ErrorIs(t, m.checkSubmitRisk(s), ErrMaxNotionalExceeded)
+
+	s.Pair = currency.NewPair(currency.XRP, currency.EUR)
+	assert.ErrorIs(t, m.checkSubmitRisk(s), ErrRiskReferencePriceUnavailable)
+	assert.Equal(t, 3, fa.len())
+}
+
+func TestCheckRiskPriceBand(t *testing.T) {
+	t.Parallel()
+	processRiskOrderbook(t)
+	m, _ := riskSetup(t, config.RiskLimit{Pair: riskPair, PriceBandPercentage: 5})
+
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(104, 1)))
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(95, 1)))
+	assert.ErrorIs(t, m.checkSubmitRisk(riskSubmit(106, 1)), ErrPriceOutsideBand)
+	assert.ErrorIs(t, m.checkSubmitRisk(riskSubmit(94, 1)), ErrPriceOutsideBand)
+
+	// limits only apply to matching pairs
+	s := riskSubmit(1000, 1)
+	s.Pair = btcusdPair
+	assert.NoError(t, m.checkSubmitRisk(s))
+}
+
+func TestCheckRiskMaxOpenOrders(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, config.RiskLimit{Exchange: testExchange, Asset: asset.Spot, MaxOpenOrders: 1})
+
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(100, 1)))
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		OrderID:   "riskOpen",
+		Pair:      riskPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Status:    order.Active,
+		Price:     100,
+		Amount:    1,
+	}))
+	assert.ErrorIs(t, m.checkSubmitRisk(riskSubmit(100, 1)), ErrMaxOpenOrdersExceeded)
+
+	// modifications do not increase the open order count
+	det, err := m.orderStore.getByExchangeAndID(testExchange, "riskOpen")
+	require.NoError(t, err)
+	assert.NoError(t, m.checkModifyRisk(&order.Modify{Exchange: testExchange, OrderID: "riskOpen", Price: 100, Amount: 1}, det))
+}
+
+func TestCheckRiskMaxPositionSize(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, config.RiskLimit{MaxPositionSize: 5})
+
+	require.NoError(t, m.orderStore.add(&order.Detail{
+		Exchange:       testExchange,
+		OrderID:        "riskPosition",
+		Pair:           riskPair,
+		AssetType:      asset.Spot,
+		Side:           order.Buy,
+		Type:           order.Limit,
+		Status:         order.PartiallyFilled,
+		Price:          100,
+		Amount:         4,
+		ExecutedAmount: 1,
+	}))
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(100, 2)))
+	assert.ErrorIs(t, m.checkSubmitRisk(riskSubmit(100, 2.1)), ErrMaxPositionSizeExceeded)
+
+	det, err := m.orderStore.getByExchangeAndID(testExchange, "riskPosition")
+	require.NoError(t, err)
+	mod := &order.Modify{Exchange: testExchange, OrderID: "riskPosition", Price: 100, Amount: 6}
+	assert.NoError(t, m.checkModifyRisk(mod, det))
+	mod.Amount = 6.1
+	err = m.checkModifyRisk(mod, det)
+	require.ErrorIs(t, err, ErrMaxPositionSizeExceeded)
+	var rejection *RiskRejectionError
+	require.True(t, errors.As(err, &rejection), "error must be a RiskRejectionError")
+	assert.Equal(t, "riskPosition", rejection.OrderID)
+	assert.InDelta(t, 5.1, rejection.Value, 1e-9)
+
+	mod.Amount = 3
+	assert.NoError(t, m.checkModifyRisk(mod, det), "reducing an order should not be rejected")
+}
+
+func TestCheckRiskOrderRate(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t,
+		config.RiskLimit{MaxOrdersPerMinute: 2},
+		config.RiskLimit{Exchange: testExchange, MaxNotional: 1000},
+	)
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(100, 1)))
+	// rejected requests are not counted towards the rate
+	assert.ErrorIs(t, m.checkSubmitRisk(riskSubmit(100, 100)), ErrMaxNotionalExceeded)
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(100, 1)))
+	assert.ErrorIs(t, m.checkSubmitRisk(riskSubmit(100, 1)), ErrOrderRateExceeded)
+
+	m.risk.m.Lock()
+	m.risk.submissions[0][0] = time.Now().Add(-time.Minute)
+	m.risk.m.Unlock()
+	assert.NoError(t, m.checkSubmitRisk(riskSubmit(100, 1)), "expired submissions should not be counted")
+}
+
+func TestSubmitAndModifyRiskRejection(t *testing.T) {
+	t.Parallel()
+	m, fa := riskSetup(t, config.RiskLimit{MaxNotional: 1000})
+	_, err := m.Submit(t.Context(), riskSubmit(100, 11))
+	assert.ErrorIs(t, err, ErrMaxNotionalExceeded)
+
+	resp, err := m.Submit(t.Context(), riskSubmit(100, 1))
+	require.NoError(t, err, "Submit must not error")
+
+	_, err = m.Modify(t.Context(), &order.Modify{
+		Exchange:  testExchange,
+		AssetType: asset.Spot,
+		Pair:      riskPair,
+		OrderID:   resp.OrderID,
+		Amount:    11,
+	})
+	assert.ErrorIs(t, err, ErrMaxNotionalExceeded)
+	assert.Equal(t, 2, fa.len())
+}
+
+func TestKillSwitch(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	assert.ErrorIs(t, m.EngageKillSwitch(t.Context()), ErrNilSubsystem)
+	assert.ErrorIs(t, m.ReleaseKillSwitch(), ErrNilSubsystem)
+	assert.False(t, m.IsKillSwitchEngaged())
+
+	m, fa := riskSetup(t)
+	m.risk.enabled = false
+	m.started = 0
+	assert.ErrorIs(t, m.EngageKillSwitch(t.Context()), ErrSubSystemNotStarted)
+	m.started = 1
+	assert.ErrorIs(t, m.ReleaseKillSwitch(), errKillSwitchNotEngaged)
+
+	resp, err := m.Submit(t.Context(), riskSubmit(100, 1))
+	require.NoError(t, err, "Submit must not error")
+	s := conditionalSubmit(order.Stop)
+	s.TriggerPrice = 1000
+	pending, err := m.SubmitConditional(s)
+	require.NoError(t, err, "SubmitConditional must not error")
+
+	require.NoError(t, m.EngageKillSwitch(t.Context()), "EngageKillSwitch must not error")
+	assert.True(t, m.IsKillSwitchEngaged())
+	assert.ErrorIs(t, m.EngageKillSwitch(t.Context()), ErrKillSwitchEngaged)
+
+	det, err := m.orderStore.getByExchangeAndID(testExchange, resp.OrderID)
+	require.NoError(t, err)
+	assert.Equal(t, order.Cancelled, det.Status, "orders should be cancelled")
+	co, err := m.GetConditionalOrders("", false)
+	require.NoError(t, err)
+	for i := range co {
+		if co[i].ID == pending[0].ID {
+			assert.Equal(t, ConditionalCancelled, co[i].Status, "conditional orders should be cancelled")
+		}
+	}
+
+	// the kill switch applies even when risk checks are disabled
+	_, err = m.Submit(t.Context(), riskSubmit(100, 1))
+	assert.ErrorIs(t, err, ErrKillSwitchEngaged)
+	_, err = m.Modify(t.Context(), &order.Modify{Exchange: testExchange, AssetType: asset.Spot, Pair: riskPair, OrderID: resp.OrderID, Amount: 2})
+	assert.ErrorIs(t, err, ErrKillSwitchEngaged)
+
+	require.NoError(t, m.ReleaseKillSwitch(), "ReleaseKillSwitch must not error")
+	assert.False(t, m.IsKillSwitchEngaged())
+	_, err = m.Submit(t.Context(), riskSubmit(100, 1))
+	assert.NoError(t, err, "Submit should not error once the kill switch is released")
+	assert.Equal(t, 4, fa.len(), "engaging, releasing and rejections should be audited")
+}
@@ -0,0 +1,374 @@
+package engine
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// maxRoutingPasses limits how many times a routing plan is recalculated
+// while exchanges are excluded or capped to conform to their limits
+const maxRoutingPasses = 100
+
+var (
+	errNoConsolidatedOrderbook = errors.New("no orderbooks available to consolidate")
+	errNoRoutableLiquidity     = errors.New("no exchange can fill any of the order")
+	errRoutingAmountBelowLimit = errors.New("routed amount is below the exchange's minimum amount step")
+	errNoFreeBalance           = errors.New("no free balance available")
+)
+
+// GetConsolidatedOrderbook merges the orderbooks of a pair and asset across
+// all enabled exchanges with the pair enabled. Each level's effective price
+// includes the exchange's taker fee so levels are comparable across exchanges
+func (m *OrderManager) GetConsolidatedOrderbook(ctx context.Context, pair currency.Pair, a asset.Item) (*ConsolidatedOrderbook, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if pair.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	exchanges, err := m.orderStore.exchangeManager.GetExchanges()
+	if err != nil {
+		return nil, err
+	}
+	book := &ConsolidatedOrderbook{
+		Pair:  pair,
+		Asset: a,
+		Fees:  make(map[string]float64),
+	}
+	for _, exch := range exchanges {
+		if !exch.IsEnabled() {
+			continue
+		}
+		if enabled, err := exch.IsPairEnabled(pair, a); err != nil || !enabled {
+			continue
+		}
+		depth, err := orderbook.GetDepth(exch.GetName(), pair, a)
+		if err != nil {
+			continue
+		}
+		ob, err := depth.Retrieve()
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: %s %s %s orderbook excluded from consolidated orderbook: %v", exch.GetName(), a, pair, err)
+			continue
+		}
+		fee, err := getTakerFeeRate(ctx, exch, pair)
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: %s %s %s orderbook excluded from consolidated orderbook: %v", exch.GetName(), a, pair, err)
+			continue
+		}
+		book.Exchanges = append(book.Exchanges, exch.GetName())
+		book.Fees[exch.GetName()] = fee
+		for i := range ob.Bids {
+			book.Bids = append(book.Bids, ConsolidatedLevel{
+				Exchange:       exch.GetName(),
+				Price:          ob.Bids[i].Price,
+				EffectivePrice: ob.Bids[i].Price * (1 - fee),
+				Amount:         ob.Bids[i].Amount,
+			})
+		}
+		for i := range ob.Asks {
+			book.Asks = append(book.Asks, ConsolidatedLevel{
+				Exchange:       exch.GetName(),
+				Price:          ob.Asks[i].Price,
+				EffectivePrice: ob.Asks[i].Price * (1 + fee),
+				Amount:         ob.Asks[i].Amount,
+			})
+		}
+	}
+	if len(book.Exchanges) == 0 {
+		return nil, fmt.Errorf("%w for %s %s", errNoConsolidatedOrderbook, a, pair)
+	}
+	slices.SortStableFunc(book.Bids, func(a, b ConsolidatedLevel) int {
+		return cmp.Or(cmp.Compare(b.EffectivePrice, a.EffectivePrice), cmp.Compare(a.Exchange, b.Exchange))
+	})
+	slices.SortStableFunc(book.Asks, func(a, b ConsolidatedLevel) int {
+		return cmp.Or(cmp.Compare(a.EffectivePrice, b.EffectivePrice), cmp.Compare(a.Exchange, b.Exchange))
+	})
+	return book, nil
+}
+
+// getTakerFeeRate returns the taker fee of an exchange as a rate by pricing a
+// trade with a value of one
+func getTakerFeeRate(ctx context.Context, exch exchange.IBotExchange, pair currency.Pair) (float64, error) {
+	fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          pair,
+		PurchasePrice: 1,
+		Amount:        1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if fee < 0 || fee >= 1 {
+		return 0, fmt.Errorf("invalid taker fee rate %v", fee)
+	}
+	return fee, nil
+}
+
+// PlanSmartOrder splits an order across exchanges by best effective price
+// without submitting anything. The order's exchange is ignored. Each exchange
+// is limited by its execution limits and the available balance of the
+// currency spent. Limit orders only route to levels at or better than the
+// order price
+func (m *OrderManager) PlanSmartOrder(ctx context.Context, s *order.Submit) (*RoutingPlan, error) {
+	if err := validateSmartOrder(s); err != nil {
+		return nil, err
+	}
+	book, err := m.GetConsolidatedOrderbook(ctx, s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	plan := &RoutingPlan{
+		Pair:   s.Pair,
+		Asset:  s.AssetType,
+		Side:   s.Side,
+		Amount: s.Amount,
+	}
+	levels := book.Asks
+	if s.Side.IsShort() {
+		levels = book.Bids
+	}
+	venues := make(map[string]*routingVenue, len(book.Exchanges))
+	for _, name := range book.Exchanges {
+		venue, err := m.getRoutingVenue(ctx, name, s, book.Fees[name])
+		if err != nil {
+			plan.Rejections = append(plan.Rejections, RoutingRejection{Exchange: name, Reason: err})
+			continue
+		}
+		venues[name] = venue
+	}
+
+	for range maxRoutingPasses {
+		routeLevels(venues, levels, s)
+		changed := false
+		for name, venue := range venues {
+			if venue.order.Amount == 0 {
+				continue
+			}
+			conformed := venue.limits.ConformToAmount(venue.order.Amount)
+			if conformed == 0 {
+				plan.Rejections = append(plan.Rejections, RoutingRejection{
+					Exchange: name,
+					Reason:   fmt.Errorf("%w: %v", errRoutingAmountBelowLimit, venue.order.Amount),
+				})
+				delete(venues, name)
+				changed = true
+				continue
+			}
+			if conformed != venue.order.Amount {
+				venue.capacity = conformed
+				changed = true
+				continue
+			}
+			if err := venue.limits.Conforms(venue.order.WorstPrice, venue.order.Amount, s.Type); err != nil {
+				plan.Rejections = append(plan.Rejections, RoutingRejection{Exchange: name, Reason: err})
+				delete(venues, name)
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	var cost, effectiveCost float64
+	for _, venue := range venues {
+		if venue.order.Amount == 0 {
+			continue
+		}
+		plan.Orders = append(plan.Orders, venue.order)
+		plan.RoutedAmount += venue.order.Amount
+		cost += venue.order.AveragePrice * venue.order.Amount
+		effectiveCost += venue.order.AverageEffectivePrice * venue.order.Amount
+	}
+	if plan.RoutedAmount > 0 {
+		plan.AveragePrice = cost / plan.RoutedAmount
+		plan.AverageEffectivePrice = effectiveCost / plan.RoutedAmount
+	}
+	slices.SortFunc(plan.Orders, func(a, b RoutedOrder) int {
+		return cmp.Or(cmp.Compare(b.Amount, a.Amount), cmp.Compare(a.Exchange, b.Exchange))
+	})
+	slices.SortFunc(plan.Rejections, func(a, b RoutingRejection) int {
+		return cmp.Compare(a.Exchange, b.Exchange)
+	})
+	return plan, nil
+}
+
+// SubmitSmartOrder plans a smart order and submits an order to each exchange
+// it is routed to. Limit orders are submitted at the worst price routed to
+// each exchange. Client order IDs are suffixed with the order's position in
+// the plan. Submission continues when an exchange fails and all errors are
+// returned alongside the orders that were placed
+func (m *OrderManager) SubmitSmartOrder(ctx context.Context, s *order.Submit) (*SmartOrderResponse, error) {
+	plan, err := m.PlanSmartOrder(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	resp := &SmartOrderResponse{Plan: plan}
+	if len(plan.Orders) == 0 {
+		return resp, fmt.Errorf("%w for %s %s %s", errNoRoutableLiquidity, s.AssetType, s.Pair, s.Side)
+	}
+	var errs error
+	for i := range plan.Orders {
+		child := *s
+		child.Exchange = plan.Orders[i].Exchange
+		child.Amount = plan.Orders[i].Amount
+		child.QuoteAmount = 0
+		if s.Type == order.Limit {
+			child.Price = plan.Orders[i].WorstPrice
+		}
+		if s.ClientOrderID != "" {
+			child.ClientOrderID = s.ClientOrderID + "-" + strconv.Itoa(i+1)
+		}
+		submitted, err := m.Submit(ctx, &child)
+		if err != nil {
+			errs = common.AppendError(errs, fmt.Errorf("%s: %w", child.Exchange, err))
+			continue
+		}
+		resp.Orders = append(resp.Orders, submitted)
+	}
+	return resp, errs
+}
+
+// validateSmartOrder ensures a smart order has the details required to route
+// it across exchanges
+func validateSmartOrder(s *order.Submit) error {
+	if s == nil {
+		return errNilOrder
+	}
+	if s.Pair.IsEmpty() {
+		return order.ErrPairIsEmpty
+	}
+	if !s.AssetType.IsValid() {
+		return fmt.Errorf("%w: %v", order.ErrAssetNotSet, s.AssetType)
+	}
+	if !s.Side.IsLong() && !s.Side.IsShort() {
+		return fmt.Errorf("%w: %v", order.ErrSideIsInvalid, s.Side)
+	}
+	if s.Amount <= 0 {
+		return fmt.Errorf("%w: %v", order.ErrAmountIsInvalid, s.Amount)
+	}
+	switch s.Type {
+	case order.Market:
+	case order.Limit:
+		if s.Price <= 0 {
+			return order.ErrPriceMustBeSetIfLimitOrder
+		}
+	default:
+		return fmt.Errorf("%w: %v", order.ErrTypeIsInvalid, s.Type)
+	}
+	return nil
+}
+
+// getRoutingVenue derives how much of an order an exchange can fill from its
+// execution limits and the available balance of the currency spent
+func (m *OrderManager) getRoutingVenue(ctx context.Context, name string, s *order.Submit, fee float64) (*routingVenue, error) {
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(name)
+	if err != nil {
+		return nil, err
+	}
+	venue := &routingVenue{
+		capacity: math.Inf(1),
+		funds:    math.Inf(1),
+		order: RoutedOrder{
+			Exchange: exch.GetName(),
+			FeeRate:  fee,
+		},
+	}
+	// exchanges without loaded limits are unrestricted
+	if limits, err := exch.GetOrderExecutionLimits(s.AssetType, s.Pair); err == nil {
+		venue.limits = &limits
+		if limits.MaximumBaseAmount > 0 {
+			venue.capacity = limits.MaximumBaseAmount
+		}
+	}
+	creds, err := exch.GetCredentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+	code := s.Pair.Quote
+	if s.Side.IsShort() {
+		code = s.Pair.Base
+	}
+	balance, err := account.GetBalance(exch.GetName(), creds.SubAccount, creds, s.AssetType, code)
+	if err != nil {
+		return nil, err
+	}
+	free := balance.GetFree()
+	if free <= 0 {
+		return nil, fmt.Errorf("%w for %s", errNoFreeBalance, code)
+	}
+	if s.Side.IsShort() {
+		venue.capacity = math.Min(venue.capacity, free)
+	} else {
+		venue.funds = free
+	}
+	return venue, nil
+}
+
+// routeLevels fills an order from the best effective price levels until it is
+// filled or no exchange has remaining capacity, recording the portion routed
+// to each exchange
+func routeLevels(venues map[string]*routingVenue, levels []ConsolidatedLevel, s *order.Submit) {
+	remaining := make(map[string]*routingVenue, len(venues))
+	for name, venue := range venues {
+		venue.order.Amount = 0
+		venue.order.AveragePrice = 0
+		venue.order.AverageEffectivePrice = 0
+		venue.order.WorstPrice = 0
+		remaining[name] = &routingVenue{capacity: venue.capacity, funds: venue.funds}
+	}
+	toFill := s.Amount
+	for i := range levels {
+		if toFill <= 0 {
+			break
+		}
+		venue, ok := venues[levels[i].Exchange]
+		if !ok {
+			continue
+		}
+		if s.Type == order.Limit &&
+			((s.Side.IsLong() && levels[i].Price > s.Price) ||
+				(s.Side.IsShort() && levels[i].Price < s.Price)) {
+			continue
+		}
+		left := remaining[levels[i].Exchange]
+		amount := math.Min(math.Min(toFill, levels[i].Amount), left.capacity)
+		if s.Side.IsLong() {
+			amount = math.Min(amount, left.funds/levels[i].EffectivePrice)
+		}
+		if amount <= 0 {
+			continue
+		}
+		left.capacity -= amount
+		left.funds -= amount * levels[i].EffectivePrice
+		toFill -= amount
+		total := venue.order.Amount + amount
+		venue.order.AveragePrice = (venue.order.AveragePrice*venue.order.Amount + levels[i].Price*amount) / total
+		venue.order.AverageEffectivePrice = (venue.order.AverageEffectivePrice*venue.order.Amount + levels[i].EffectivePrice*amount) / total
+		venue.order.Amount = total
+		// levels of an exchange are visited from best to worst price
+		venue.order.WorstPrice = levels[i].Price
+	}
+}
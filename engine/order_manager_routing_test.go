@@ -0,0 +1,267 @@
+package engine
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const routingSecondExchange = "Bitfinex"
+
+// rofExchange aka routing order fake exchange returns a fixed taker fee and
+// credentials so smart orders can be routed without making exchange requests
+type rofExchange struct {
+	omfExchange
+	fee   float64
+	creds *account.Credentials
+}
+
+func (f rofExchange) GetFeeByType(_ context.Context, b *exchange.FeeBuilder) (float64, error) {
+	return f.fee * b.PurchasePrice * b.Amount, nil
+}
+
+func (f rofExchange) GetCredentials(_ context.Context) (*account.Credentials, error) {
+	return f.creds, nil
+}
+
+func (f rofExchange) SubmitOrder(_ context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	return s.DeriveSubmitResponse(s.Exchange + "-routed")
+}
+
+// routingSetup adds Bitstamp and Bitfinex with the pair enabled and the
+// supplied taker fees. Each test uses its own pair and credentials so
+// orderbooks and balances are not shared between tests
+func routingSetup(t *testing.T, pair currency.Pair, fees map[string]float64) *OrderManager {
+	t.Helper()
+	c := &config.Config{}
+	require.NoError(t, c.LoadConfig(config.TestFile, true))
+	em := NewExchangeManager()
+	creds := &account.Credentials{Key: t.Name()}
+	for _, name := range []string{testExchange, routingSecondExchange} {
+		exch, err := em.NewExchangeByName(name)
+		require.NoError(t, err)
+		exch.SetDefaults()
+		cfg, err := c.GetExchangeConfig(name)
+		require.NoError(t, err)
+		require.NoError(t, exch.Setup(cfg))
+		b := exch.GetBase()
+		require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, false))
+		require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, true))
+		require.NoError(t, em.Add(rofExchange{omfExchange: omfExchange{IBotExchange: exch}, fee: fees[name], creds: creds}))
+	}
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &sync.WaitGroup{}, &config.OrderManager{})
+	require.NoError(t, err)
+	m.started = 1
+	return m
+}
+
+func processRoutingOrderbook(t *testing.T, exch string, pair currency.Pair, bids, asks orderbook.Tranches) {
+	t.Helper()
+	b := &orderbook.Base{
+		Exchange:    exch,
+		Pair:        pair,
+		Asset:       asset.Spot,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: time.Now(),
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+}
+
+func processRoutingBalance(t *testing.T, exch string, code currency.Code, free float64) {
+	t.Helper()
+	require.NoError(t, account.Process(&account.Holdings{
+		Exchange: exch,
+		Accounts: []account.SubAccount{{
+			AssetType:  asset.Spot,
+			Currencies: []account.Balance{{Currency: code, Total: free, Free: free}},
+		}},
+	}, &account.Credentials{Key: t.Name()}), "account Process must not error")
+}
+
+func loadRoutingLimits(t *testing.T, m *OrderManager, exch string, limits order.MinMaxLevel) {
+	t.Helper()
+	e, err := m.orderStore.exchangeManager.GetExchangeByName(exch)
+	require.NoError(t, err, "GetExchangeByName must not error")
+	require.NoError(t, e.GetBase().LoadLimits([]order.MinMaxLevel{limits}), "LoadLimits must not error")
+}
+
+func routingPair(base string) currency.Pair {
+	return currency.NewPair(currency.NewCode(base), currency.USD)
+}
+
+func TestGetConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	var m *OrderManager
+	_, err := m.GetConsolidatedOrderbook(context.Background(), currency.EMPTYPAIR, asset.Spot)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	m = &OrderManager{}
+	_, err = m.GetConsolidatedOrderbook(context.Background(), currency.EMPTYPAIR, asset.Spot)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	pair := routingPair("ROUTECB")
+	m = routingSetup(t, pair, map[string]float64{testExchange: 0.01})
+	_, err = m.GetConsolidatedOrderbook(context.Background(), currency.EMPTYPAIR, asset.Spot)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	_, err = m.GetConsolidatedOrderbook(context.Background(), pair, asset.Empty)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+	_, err = m.GetConsolidatedOrderbook(context.Background(), pair, asset.Spot)
+	assert.ErrorIs(t, err, errNoConsolidatedOrderbook)
+
+	processRoutingOrderbook(t, testExchange, pair,
+		orderbook.Tranches{{Price: 99, Amount: 1}},
+		orderbook.Tranches{{Price: 100, Amount: 1}, {Price: 102, Amount: 1}})
+	processRoutingOrderbook(t, routingSecondExchange, pair,
+		orderbook.Tranches{{Price: 98.5, Amount: 1}},
+		orderbook.Tranches{{Price: 100.5, Amount: 1}, {Price: 101, Amount: 2}})
+	book, err := m.GetConsolidatedOrderbook(context.Background(), pair, asset.Spot)
+	require.NoError(t, err, "GetConsolidatedOrderbook must not error")
+	assert.ElementsMatch(t, []string{testExchange, routingSecondExchange}, book.Exchanges)
+	assert.Equal(t, 0.01, book.Fees[testExchange])
+	require.Len(t, book.Asks, 4)
+	assert.Equal(t, routingSecondExchange, book.Asks[0].Exchange, "best effective ask should be first")
+	assert.Equal(t, 100.5, book.Asks[0].EffectivePrice)
+	assert.Equal(t, testExchange, book.Asks[2].Exchange)
+	assert.InDelta(t, 101, book.Asks[2].EffectivePrice, 1e-9, "effective ask should include the taker fee")
+	require.Len(t, book.Bids, 2)
+	assert.Equal(t, routingSecondExchange, book.Bids[0].Exchange, "best effective bid should be first")
+	assert.InDelta(t, 98.01, book.Bids[1].EffectivePrice, 1e-9, "effective bid should include the taker fee")
+}
+
+func TestValidateSmartOrder(t *testing.T) {
+	t.Parallel()
+	assert.ErrorIs(t, validateSmartOrder(nil), errNilOrder)
+	s := &order.Submit{}
+	assert.ErrorIs(t, validateSmartOrder(s), order.ErrPairIsEmpty)
+	s.Pair = btcusdPair
+	assert.ErrorIs(t, validateSmartOrder(s), order.ErrAssetNotSet)
+	s.AssetType = asset.Spot
+	assert.ErrorIs(t, validateSmartOrder(s), order.ErrSideIsInvalid)
+	s.Side = order.Buy
+	assert.ErrorIs(t, validateSmartOrder(s), order.ErrAmountIsInvalid)
+	s.Amount = 1
+	assert.ErrorIs(t, validateSmartOrder(s), order.ErrTypeIsInvalid)
+	s.Type = order.Limit
+	assert.ErrorIs(t, validateSmartOrder(s), order.ErrPriceMustBeSetIfLimitOrder)
+	s.Price = 1
+	assert.NoError(t, validateSmartOrder(s))
+	s.Type = order.Market
+	assert.NoError(t, validateSmartOrder(s))
+}
+
+func TestPlanSmartOrder(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ROUTEPL")
+	m := routingSetup(t, pair, map[string]float64{testExchange: 0.01})
+	processRoutingOrderbook(t, testExchange, pair,
+		orderbook.Tranches{{Price: 99, Amount: 1}},
+		orderbook.Tranches{{Price: 100, Amount: 1}, {Price: 102, Amount: 1}})
+	processRoutingOrderbook(t, routingSecondExchange, pair,
+		orderbook.Tranches{{Price: 98.5, Amount: 1}},
+		orderbook.Tranches{{Price: 100.5, Amount: 1}, {Price: 101, Amount: 2}})
+	processRoutingBalance(t, testExchange, currency.USD, 1000)
+	processRoutingBalance(t, routingSecondExchange, currency.USD, 1000)
+
+	_, err := m.PlanSmartOrder(context.Background(), nil)
+	assert.ErrorIs(t, err, errNilOrder)
+
+	s := &order.Submit{Pair: pair, AssetType: asset.Spot, Side: order.Buy, Type: order.Market, Amount: 3}
+	plan, err := m.PlanSmartOrder(context.Background(), s)
+	require.NoError(t, err, "PlanSmartOrder must not error")
+	assert.Equal(t, 3.0, plan.RoutedAmount)
+	require.Len(t, plan.Orders, 1, "all liquidity should route to the exchange with the best effective prices")
+	assert.Equal(t, routingSecondExchange, plan.Orders[0].Exchange)
+	assert.Equal(t, 101.0, plan.Orders[0].WorstPrice)
+	assert.InDelta(t, 302.5/3, plan.AveragePrice, 1e-9)
+
+	loadRoutingLimits(t, m, routingSecondExchange, order.MinMaxLevel{Pair: pair, Asset: asset.Spot, MaximumBaseAmount: 2})
+	plan, err = m.PlanSmartOrder(context.Background(), s)
+	require.NoError(t, err, "PlanSmartOrder must not error")
+	require.Len(t, plan.Orders, 2, "maximum amount should route the remainder to another exchange")
+	assert.Equal(t, routingSecondExchange, plan.Orders[0].Exchange)
+	assert.Equal(t, 2.0, plan.Orders[0].Amount)
+	assert.Equal(t, testExchange, plan.Orders[1].Exchange)
+	assert.Equal(t, 1.0, plan.Orders[1].Amount)
+	assert.InDelta(t, 101, plan.Orders[1].AverageEffectivePrice, 1e-9)
+
+	processRoutingBalance(t, testExchange, currency.USD, 50)
+	loadRoutingLimits(t, m, testExchange, order.MinMaxLevel{Pair: pair, Asset: asset.Spot, AmountStepIncrementSize: 0.1})
+	plan, err = m.PlanSmartOrder(context.Background(), s)
+	require.NoError(t, err, "PlanSmartOrder must not error")
+	require.Len(t, plan.Orders, 2)
+	assert.Equal(t, 0.4, plan.Orders[1].Amount, "balance should limit the amount which is conformed to the step size")
+	assert.Equal(t, 2.4, plan.RoutedAmount)
+
+	s.Amount = 2.05
+	plan, err = m.PlanSmartOrder(context.Background(), s)
+	require.NoError(t, err, "PlanSmartOrder must not error")
+	require.Len(t, plan.Orders, 1)
+	require.Len(t, plan.Rejections, 1, "amount below the step size should reject the exchange")
+	assert.ErrorIs(t, plan.Rejections[0].Reason, errRoutingAmountBelowLimit)
+
+	s.Type = order.Limit
+	s.Price = 100.5
+	s.Amount = 3
+	plan, err = m.PlanSmartOrder(context.Background(), s)
+	require.NoError(t, err, "PlanSmartOrder must not error")
+	assert.Equal(t, 1.4, plan.RoutedAmount, "limit price should exclude worse levels")
+	for i := range plan.Orders {
+		assert.LessOrEqual(t, plan.Orders[i].WorstPrice, s.Price)
+	}
+
+	s = &order.Submit{Pair: pair, AssetType: asset.Spot, Side: order.Sell, Type: order.Market, Amount: 1}
+	plan, err = m.PlanSmartOrder(context.Background(), s)
+	require.NoError(t, err, "PlanSmartOrder must not error")
+	assert.Empty(t, plan.Orders, "no base balance should prevent selling")
+	assert.Len(t, plan.Rejections, 2)
+
+	processRoutingBalance(t, testExchange, pair.Base, 0.5)
+	plan, err = m.PlanSmartOrder(context.Background(), s)
+	require.NoError(t, err, "PlanSmartOrder must not error")
+	require.Len(t, plan.Orders, 1)
+	assert.Equal(t, testExchange, plan.Orders[0].Exchange)
+	assert.Equal(t, 0.5, plan.Orders[0].Amount)
+}
+
+func TestSubmitSmartOrder(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ROUTESB")
+	m := routingSetup(t, pair, nil)
+	s := &order.Submit{Pair: pair, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Price: 200, Amount: 2, ClientOrderID: "smart"}
+	_, err := m.SubmitSmartOrder(context.Background(), s)
+	assert.ErrorIs(t, err, errNoConsolidatedOrderbook)
+
+	processRoutingOrderbook(t, testExchange, pair, nil, orderbook.Tranches{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}})
+	processRoutingOrderbook(t, routingSecondExchange, pair, nil, orderbook.Tranches{{Price: 100.5, Amount: 0.5}})
+	resp, err := m.SubmitSmartOrder(context.Background(), s)
+	assert.ErrorIs(t, err, errNoRoutableLiquidity)
+	require.NotNil(t, resp)
+	assert.Len(t, resp.Plan.Rejections, 2)
+
+	processRoutingBalance(t, testExchange, currency.USD, 1000)
+	processRoutingBalance(t, routingSecondExchange, currency.USD, 1000)
+	resp, err = m.SubmitSmartOrder(context.Background(), s)
+	require.NoError(t, err, "SubmitSmartOrder must not error")
+	require.Len(t, resp.Orders, 2)
+	assert.Equal(t, testExchange, resp.Orders[0].Exchange)
+	assert.Equal(t, 1.5, resp.Orders[0].Amount)
+	assert.Equal(t, 101.0, resp.Orders[0].Price, "limit price should be the worst routed price")
+	assert.Equal(t, "smart-1", resp.Orders[0].ClientOrderID)
+	assert.Equal(t, routingSecondExchange, resp.Orders[1].Exchange)
+	assert.Equal(t, "smart-2", resp.Orders[1].ClientOrderID)
+	for i := range resp.Orders {
+		_, err = m.GetByExchangeAndID(resp.Orders[i].Exchange, resp.Orders[i].OrderID)
+		assert.NoError(t, err, "routed order should be stored by the order manager")
+	}
+}
@@ -1718,3 +1718,48 @@ func TestGetByDetail(t *testing.T) {
 		assert.Equal(t, od.ClientOrderID, byID.ClientOrderID, "Retrieve by id pointer should contain the correct ClientOrderID")
 	}
 }
+
+func TestLinkChildOrder(t *testing.T) {
+	t.Parallel()
+	parentID := uuid.Must(uuid.NewV4())
+	var m *OrderManager
+	assert.ErrorIs(t, m.LinkChildOrder(parentID, testExchange, "child"), ErrNilSubsystem)
+	_, err := m.GetChildOrders(parentID)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	assert.ErrorIs(t, m.UnlinkChildOrders(parentID), ErrNilSubsystem)
+	m = &OrderManager{}
+	assert.ErrorIs(t, m.LinkChildOrder(parentID, testExchange, "child"), ErrSubSystemNotStarted)
+	_, err = m.GetChildOrders(parentID)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+	assert.ErrorIs(t, m.UnlinkChildOrders(parentID), ErrSubSystemNotStarted)
+
+	m = routingSetup(t, btcusdPair, nil)
+	assert.ErrorIs(t, m.LinkChildOrder(uuid.Nil, testExchange, "child"), common.ErrNilPointer)
+	assert.ErrorIs(t, m.LinkChildOrder(parentID, testExchange, "child"), ErrOrderNotFound)
+	for _, id := range []string{"child1", "child2"} {
+		require.NoError(t, m.orderStore.add(&order.Detail{
+			Exchange:  testExchange,
+			OrderID:   id,
+			Pair:      btcusdPair,
+			AssetType: asset.Spot,
+			Side:      order.Buy,
+			Status:    order.New,
+		}), "add must not error")
+	}
+	require.NoError(t, m.LinkChildOrder(parentID, testExchange, "child2"), "LinkChildOrder must not error")
+	require.NoError(t, m.LinkChildOrder(parentID, testExchange, "child1"), "LinkChildOrder must not error")
+	require.NoError(t, m.LinkChildOrder(parentID, testExchange, "child2"), "LinkChildOrder must not error when already linked")
+	children, err := m.GetChildOrders(parentID)
+	require.NoError(t, err, "GetChildOrders must not error")
+	require.Len(t, children, 2, "a child order should only be linked once")
+	assert.Equal(t, "child2", children[0].OrderID, "children should be returned in the order they were linked")
+	assert.Equal(t, "child1", children[1].OrderID)
+	children, err = m.GetChildOrders(uuid.Must(uuid.NewV4()))
+	require.NoError(t, err, "GetChildOrders must not error")
+	assert.Empty(t, children)
+
+	require.NoError(t, m.UnlinkChildOrders(parentID), "UnlinkChildOrders must not error")
+	assert.NotContains(t, m.orderStore.childOrders, parentID, "parent order should be removed")
+	_, err = m.GetByExchangeAndID(testExchange, "child1")
+	assert.NoError(t, err, "unlinked child orders should still be held")
+}
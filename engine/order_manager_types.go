@@ -5,7 +5,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderdb "github.com/thrasher-corp/gocryptotrader/database/repository/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -18,6 +22,33 @@ var (
 	ErrOrdersAlreadyExists  = errors.New("order already exists")
 	ErrOrderIDCannotBeEmpty = errors.New("orderID cannot be empty")
 	ErrOrderNotFound        = errors.New("order does not exist")
+	// ErrConditionalOrderNotFound is returned when a conditional order ID is
+	// not held by the order manager
+	ErrConditionalOrderNotFound = errors.New("conditional order does not exist")
+	// ErrConditionalOrderNotPending is returned when attempting to cancel a
+	// conditional order which has already been triggered or cancelled
+	ErrConditionalOrderNotPending = errors.New("conditional order is not pending")
+	// ErrKillSwitchEngaged is returned for all order submissions and
+	// modifications while the risk kill switch is engaged
+	ErrKillSwitchEngaged = errors.New("risk kill switch engaged")
+	// ErrMaxNotionalExceeded is returned when an order's value exceeds the
+	// configured maximum notional
+	ErrMaxNotionalExceeded = errors.New("order exceeds maximum notional")
+	// ErrMaxPositionSizeExceeded is returned when an order would take the
+	// position size over the configured maximum
+	ErrMaxPositionSizeExceeded = errors.New("order exceeds maximum position size")
+	// ErrMaxOpenOrdersExceeded is returned when an order would take the
+	// number of open orders over the configured maximum
+	ErrMaxOpenOrdersExceeded = errors.New("order exceeds maximum open orders")
+	// ErrPriceOutsideBand is returned when an order price deviates too far
+	// from the orderbook mid price
+	ErrPriceOutsideBand = errors.New("order price outside of allowed price band")
+	// ErrOrderRateExceeded is returned when too many orders have been
+	// submitted or modified within the last minute
+	ErrOrderRateExceeded = errors.New("order rate exceeds maximum orders per minute")
+	// ErrRiskReferencePriceUnavailable is returned when a risk check requires
+	// the orderbook mid price but none is available
+	ErrRiskReferencePriceUnavailable = errors.New("risk reference price unavailable")
 )
 
 var (
@@ -25,8 +56,15 @@ var (
 	errNilOrder                 = errors.New("nil order received")
 	errFuturesTrackingDisabled  = errors.New("tracking futures positions disabled. enable it via config under orderManager activelyTrackFuturesPositions")
 	orderManagerInterval        = time.Second * 10
+	conditionalOrderInterval    = time.Second
 
 	errInvalidFuturesTrackingSeekDuration = errors.New("invalid config value for futuresTrackingSeekDuration")
+	errConditionalTypeUnsupported         = errors.New("unsupported conditional order type")
+	errTriggerPriceUnset                  = errors.New("trigger price must be set")
+	errTrackingValueUnset                 = errors.New("tracking mode and tracking value must be set for trailing stop orders")
+	errOCOLegsUnset                       = errors.New("OCO orders require both take profit and stop loss trigger prices")
+	errLimitPriceUnset                    = errors.New("limit price must be set")
+	errNoReferencePrice                   = errors.New("no reference price available")
 )
 
 type orderManagerConfig struct {
@@ -43,13 +81,16 @@ type orderManagerConfig struct {
 type OrderManager struct {
 	started                       int32
 	processingOrders              int32
+	processingConditionals        int32
 	shutdown                      chan struct{}
 	orderStore                    store
+	conditionals                  conditionalStore
 	cfg                           orderManagerConfig
 	verbose                       bool
 	activelyTrackFuturesPositions bool
 	futuresPositionSeekDuration   time.Duration
 	respectOrderHistoryLimits     bool
+	risk                          preTradeRisk
 }
 
 // store holds all orders by exchange
@@ -60,6 +101,21 @@ type store struct {
 	exchangeManager           iExchangeManager
 	wg                        *sync.WaitGroup
 	futuresPositionController futures.PositionController
+	db                        orderdb.IDBService
+	// persistSeq is incremented under m for each order snapshot, with
+	// persistM serialising the database writes which are made outside of m
+	persistSeq   uint64
+	persistM     sync.Mutex
+	persistedSeq map[string]uint64
+	// childOrders links orders to the parent order they were placed for,
+	// such as the child orders of an algo order
+	childOrders map[uuid.UUID][]orderReference
+}
+
+// orderReference identifies an order held by the order store
+type orderReference struct {
+	exchange string
+	orderID  string
 }
 
 // OrderSubmitResponse contains the order response along with an internal order ID
@@ -74,3 +130,167 @@ type OrderUpsertResponse struct {
 	OrderDetails order.Detail
 	IsNewOrder   bool
 }
+
+// ConditionalStatus defines the lifecycle state of a conditional order held
+// locally by the order manager
+type ConditionalStatus uint8
+
+// Conditional order statuses
+const (
+	ConditionalPending ConditionalStatus = iota
+	ConditionalTriggered
+	ConditionalCancelled
+	ConditionalFailed
+)
+
+// ConditionalOrder is a synthetic stop, take profit or trailing stop order held
+// by the order manager. When its trigger condition is met against the latest
+// orderbook or ticker price a real market or limit order is submitted
+type ConditionalOrder struct {
+	ID        uuid.UUID
+	LinkedID  uuid.UUID
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	Side      order.Side
+	Type      order.Type
+	Amount    float64
+	// TriggerPrice is the price at which the order is submitted. For trailing
+	// stops this is recalculated as the market moves in the order's favour
+	TriggerPrice float64
+	// LimitPrice when set will submit a limit order, otherwise a market order
+	// is submitted
+	LimitPrice    float64
+	TrackingMode  order.TrackingMode
+	TrackingValue float64
+	// ExtremePrice is the most favourable price observed for trailing stops
+	ExtremePrice float64
+	Status       ConditionalStatus
+	// OrderID is the exchange order ID assigned once triggered
+	OrderID     string
+	Error       string
+	CreatedAt   time.Time
+	LastUpdated time.Time
+	TriggeredAt time.Time
+	submit      order.Submit
+}
+
+// conditionalStore holds all conditional orders by ID, along with the
+// exchanges whose ticker and orderbook feeds are subscribed to evaluate them
+type conditionalStore struct {
+	m                   sync.Mutex
+	orders              map[uuid.UUID]*ConditionalOrder
+	tickerFeeds         map[string]bool
+	orderbookFeeds      map[string]bool
+	subscribeTickers    func(exchange string) (conditionalFeed, error)
+	subscribeOrderbooks func(exchange string) (conditionalFeed, error)
+}
+
+// conditionalFeed is a dispatch feed of ticker or orderbook updates for an
+// exchange
+type conditionalFeed interface {
+	Channel() <-chan any
+	Release() error
+}
+
+// RiskRejectionError is returned when an order submission or modification is
+// rejected by a pre-trade risk check
+type RiskRejectionError struct {
+	Exchange  string
+	Pair      currency.Pair
+	AssetType asset.Item
+	OrderID   string
+	// Reason is the underlying risk error e.g. ErrMaxNotionalExceeded
+	Reason error
+	// Limit and Value are the configured limit and the offending value
+	Limit float64
+	Value float64
+}
+
+// preTradeRisk holds the pre-trade risk limits and the state required to
+// enforce them
+type preTradeRisk struct {
+	enabled    bool
+	killSwitch int32
+	limits     []config.RiskLimit
+	m          sync.Mutex
+	// submissions holds recent order submission times by limit index for
+	// order rate throttling
+	submissions map[int][]time.Time
+	// auditEvent writes a rejection to the audit table
+	auditEvent func(id, msgtype, message string)
+}
+
+// ConsolidatedLevel is a price level from a single exchange within a
+// consolidated orderbook
+type ConsolidatedLevel struct {
+	Exchange string
+	Price    float64
+	// EffectivePrice is the price after the exchange's taker fee is applied
+	EffectivePrice float64
+	Amount         float64
+}
+
+// ConsolidatedOrderbook merges the orderbooks of the same pair and asset
+// across enabled exchanges. Levels are sorted by best effective price
+type ConsolidatedOrderbook struct {
+	Pair      currency.Pair
+	Asset     asset.Item
+	Exchanges []string
+	Bids      []ConsolidatedLevel
+	Asks      []ConsolidatedLevel
+	// Fees holds the taker fee rate of each exchange
+	Fees map[string]float64
+}
+
+// RoutingPlan details how a smart order is split across exchanges by best
+// effective price
+type RoutingPlan struct {
+	Pair   currency.Pair
+	Asset  asset.Item
+	Side   order.Side
+	Amount float64
+	// RoutedAmount is the amount which can be filled across all exchanges
+	RoutedAmount          float64
+	AveragePrice          float64
+	AverageEffectivePrice float64
+	Orders                []RoutedOrder
+	Rejections            []RoutingRejection
+}
+
+// RoutedOrder is the portion of a smart order routed to an exchange
+type RoutedOrder struct {
+	Exchange              string
+	Amount                float64
+	AveragePrice          float64
+	AverageEffectivePrice float64
+	// WorstPrice is the least favourable price level the order fills against
+	// and is used as the limit price of routed limit orders
+	WorstPrice float64
+	FeeRate    float64
+}
+
+// RoutingRejection details why an exchange was excluded from a routing plan
+type RoutingRejection struct {
+	Exchange string
+	Reason   error
+}
+
+// SmartOrderResponse holds the routing plan of a smart order and the
+// submitted order for each exchange it was routed to
+type SmartOrderResponse struct {
+	Plan   *RoutingPlan
+	Orders []*OrderSubmitResponse
+}
+
+// routingVenue tracks the remaining capacity of an exchange while a smart
+// order is being routed
+type routingVenue struct {
+	limits *order.MinMaxLevel
+	// capacity is the remaining base amount allowed by the exchange's
+	// maximum order size and, for sells, the available base balance
+	capacity float64
+	// funds is the remaining quote balance available for buys
+	funds float64
+	order RoutedOrder
+}
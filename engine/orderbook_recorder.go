@@ -0,0 +1,463 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	orderbookdb "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupOrderbookRecorder creates an orderbook recorder subsystem which writes
+// to either the database or to rotating files
+func SetupOrderbookRecorder(dcm iDatabaseConnectionManager, cfg *config.OrderbookRecorder) (*OrderbookRecorder, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if len(cfg.Pairs) == 0 {
+		return nil, errNoOrderbookRecorderPairs
+	}
+	r := &OrderbookRecorder{
+		shutdown:         make(chan struct{}),
+		snapshotInterval: cfg.SnapshotInterval,
+		flushInterval:    cfg.FlushInterval,
+		subscribed:       make(map[string]bool),
+		subscribeFn:      subscribeToExchangeOrderbooks,
+		books:            make(map[key.ExchangePairAsset]*recordedBook),
+	}
+	if r.snapshotInterval <= 0 {
+		r.snapshotInterval = defaultOrderbookRecorderSnapshotInterval
+	}
+	if r.flushInterval <= 0 {
+		r.flushInterval = defaultOrderbookRecorderFlushInterval
+	}
+	for i := range cfg.Pairs {
+		if cfg.Pairs[i].Exchange == "" {
+			return nil, ErrExchangeNameIsEmpty
+		}
+		if !cfg.Pairs[i].Asset.IsValid() {
+			return nil, fmt.Errorf("%s %w", cfg.Pairs[i].Asset, asset.ErrNotSupported)
+		}
+		if cfg.Pairs[i].Pair.IsEmpty() {
+			return nil, fmt.Errorf("%s %w", cfg.Pairs[i].Exchange, currency.ErrCurrencyPairEmpty)
+		}
+		exch := strings.ToLower(cfg.Pairs[i].Exchange)
+		r.subscribed[exch] = false
+		r.books[key.ExchangePairAsset{
+			Exchange: exch,
+			Base:     cfg.Pairs[i].Pair.Base.Item,
+			Quote:    cfg.Pairs[i].Pair.Quote.Item,
+			Asset:    cfg.Pairs[i].Asset,
+		}] = &recordedBook{}
+	}
+
+	switch strings.ToLower(cfg.Output) {
+	case OrderbookRecorderDatabaseOutput:
+		if dcm == nil {
+			return nil, errNilDatabaseConnectionManager
+		}
+		db, err := orderbookdb.Setup(dcm.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		r.store = db
+	case OrderbookRecorderFileOutput:
+		dir := cfg.Directory
+		if dir == "" {
+			dir = filepath.Join(common.GetDefaultDataDir(runtime.GOOS), "orderbooks")
+		}
+		store, err := newOrderbookFileStore(dir, cfg.FileRotationInterval)
+		if err != nil {
+			return nil, err
+		}
+		r.store = store
+	default:
+		return nil, fmt.Errorf("%w '%s', must be '%s' or '%s'", errInvalidOrderbookRecorderType, cfg.Output, OrderbookRecorderDatabaseOutput, OrderbookRecorderFileOutput)
+	}
+	return r, nil
+}
+
+// Start runs the subsystem
+func (r *OrderbookRecorder) Start() error {
+	if r == nil {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 0, 1) {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderBook, "Orderbook recorder %s", MsgSubSystemStarting)
+	r.shutdown = make(chan struct{})
+	r.wg.Add(1)
+	go r.run()
+	log.Debugf(log.OrderBook, "Orderbook recorder %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem, writing any outstanding records
+func (r *OrderbookRecorder) Stop() error {
+	if r == nil {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&r.started, 1, 0) {
+		return fmt.Errorf("%s %w", OrderbookRecorderName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderBook, "Orderbook recorder %s", MsgSubSystemShuttingDown)
+	close(r.shutdown)
+	r.wg.Wait()
+	r.m.Lock()
+	for exch := range r.subscribed {
+		r.subscribed[exch] = false
+	}
+	// force a snapshot for each orderbook when restarted
+	for k := range r.books {
+		r.books[k] = &recordedBook{}
+	}
+	r.m.Unlock()
+	r.flush()
+	if c, ok := r.store.(io.Closer); ok {
+		if err := c.Close(); err != nil {
+			return err
+		}
+	}
+	log.Debugf(log.OrderBook, "Orderbook recorder %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (r *OrderbookRecorder) IsRunning() bool {
+	if r == nil {
+		return false
+	}
+	return atomic.LoadInt32(&r.started) == 1
+}
+
+// run subscribes to exchange orderbook feeds as they become available and
+// periodically writes recorded data
+func (r *OrderbookRecorder) run() {
+	defer r.wg.Done()
+	r.subscribe()
+	ticker := time.NewTicker(r.flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-ticker.C:
+			r.subscribe()
+			r.flush()
+		}
+	}
+}
+
+// subscribe attaches to the orderbook feed of each configured exchange. An
+// exchange feed is only available once its first orderbook has been loaded,
+// so subscription is retried until successful
+func (r *OrderbookRecorder) subscribe() {
+	r.m.Lock()
+	defer r.m.Unlock()
+	for exch, ok := range r.subscribed {
+		if ok {
+			continue
+		}
+		feed, err := r.subscribeFn(exch)
+		if err != nil {
+			if !errors.Is(err, orderbook.ErrOrderbookNotFound) {
+				log.Errorf(log.OrderBook, "Orderbook recorder unable to subscribe to %s: %v", exch, err)
+			}
+			continue
+		}
+		r.subscribed[exch] = true
+		r.wg.Add(1)
+		go r.consume(exch, feed)
+	}
+}
+
+// subscribeToExchangeOrderbooks subscribes to the dispatch orderbook feed of
+// an exchange
+func subscribeToExchangeOrderbooks(exch string) (orderbookFeed, error) {
+	pipe, err := orderbook.SubscribeToExchangeOrderbooks(exch)
+	if err != nil {
+		return nil, err
+	}
+	return &pipe, nil
+}
+
+// consume processes orderbook updates from an exchange feed until shutdown.
+// The feed is closed when the dispatcher is stopped, in which case it is
+// resubscribed with an increasing delay so that recording resumes once the
+// dispatcher is restarted
+func (r *OrderbookRecorder) consume(exch string, feed orderbookFeed) {
+	defer r.wg.Done()
+	for {
+		if !r.consumeFeed(feed) {
+			if err := feed.Release(); err != nil {
+				log.Errorln(log.OrderBook, err)
+			}
+			return
+		}
+		log.Warnf(log.OrderBook, "Orderbook recorder %s feed closed, resubscribing", exch)
+		if feed = r.resubscribe(exch); feed == nil {
+			return
+		}
+	}
+}
+
+// consumeFeed records orderbook updates from a feed, returning true when the
+// feed has been closed and false on shutdown
+func (r *OrderbookRecorder) consumeFeed(feed orderbookFeed) bool {
+	for {
+		select {
+		case <-r.shutdown:
+			return false
+		case data, ok := <-feed.Channel():
+			if !ok {
+				return true
+			}
+			d, ok := data.(orderbook.Outbound)
+			if !ok {
+				log.Errorln(log.OrderBook, common.GetTypeAssertError("orderbook.Outbound", data))
+				continue
+			}
+			ob, err := d.Retrieve()
+			if err != nil {
+				// invalid orderbooks are recorded again once resynced
+				continue
+			}
+			r.record(ob)
+		}
+	}
+}
+
+// resubscribe retries subscribing to an exchange orderbook feed with
+// exponential backoff, returning nil on shutdown
+func (r *OrderbookRecorder) resubscribe(exch string) orderbookFeed {
+	delay := orderbookRecorderResubscribeDelay
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-r.shutdown:
+			return nil
+		case <-timer.C:
+		}
+		feed, err := r.subscribeFn(exch)
+		if err == nil {
+			log.Infof(log.OrderBook, "Orderbook recorder %s feed resubscribed", exch)
+			return feed
+		}
+		delay = min(delay*2, orderbookRecorderMaxResubscribeDelay)
+		log.Errorf(log.OrderBook, "Orderbook recorder unable to resubscribe to %s, retrying in %s: %v", exch, delay, err)
+		timer.Reset(delay)
+	}
+}
+
+// record compares an orderbook against its last recorded state, queuing a
+// snapshot when the snapshot interval has elapsed or a delta otherwise
+func (r *OrderbookRecorder) record(ob *orderbook.Base) {
+	k := key.ExchangePairAsset{
+		Exchange: strings.ToLower(ob.Exchange),
+		Base:     ob.Pair.Base.Item,
+		Quote:    ob.Pair.Quote.Item,
+		Asset:    ob.Asset,
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	book, ok := r.books[k]
+	if !ok {
+		return
+	}
+	ts := ob.LastUpdated
+	if ts.IsZero() {
+		ts = time.Now()
+	}
+	bids := tranchesToMap(ob.Bids)
+	asks := tranchesToMap(ob.Asks)
+	rec := &orderbookdb.Record{
+		Exchange:  k.Exchange,
+		Base:      ob.Pair.Base.String(),
+		Quote:     ob.Pair.Quote.String(),
+		Asset:     ob.Asset.String(),
+		Timestamp: ts,
+	}
+	if book.lastSnapshot.IsZero() || ts.Sub(book.lastSnapshot) >= r.snapshotInterval {
+		rec.IsSnapshot = true
+		rec.Bids = levelsFromMap(bids, true)
+		rec.Asks = levelsFromMap(asks, false)
+		book.lastSnapshot = ts
+	} else {
+		rec.Bids = levelDelta(book.bids, bids, true)
+		rec.Asks = levelDelta(book.asks, asks, false)
+		if len(rec.Bids) == 0 && len(rec.Asks) == 0 {
+			return
+		}
+	}
+	book.bids = bids
+	book.asks = asks
+	r.pending = append(r.pending, rec)
+}
+
+// flush writes all queued records
+func (r *OrderbookRecorder) flush() {
+	r.m.Lock()
+	pending := r.pending
+	r.pending = nil
+	r.m.Unlock()
+	if len(pending) == 0 {
+		return
+	}
+	if err := r.store.Insert(pending...); err != nil {
+		log.Errorf(log.OrderBook, "Orderbook recorder unable to write %d records: %v", len(pending), err)
+	}
+}
+
+// GetRecordedOrderbook reconstructs a recorded orderbook as it was at the
+// supplied time from the latest snapshot and the deltas which followed it
+func (r *OrderbookRecorder) GetRecordedOrderbook(exch string, a asset.Item, cp currency.Pair, at time.Time) (*orderbook.Base, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%s %w", OrderbookRecorderName, ErrNilSubsystem)
+	}
+	if exch == "" {
+		return nil, ErrExchangeNameIsEmpty
+	}
+	if cp.IsEmpty() {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	r.m.Lock()
+	_, ok := r.books[key.ExchangePairAsset{
+		Exchange: strings.ToLower(exch),
+		Base:     cp.Base.Item,
+		Quote:    cp.Quote.Item,
+		Asset:    a,
+	}]
+	r.m.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("%w %s %s %s", errOrderbookNotRecorded, exch, a, cp)
+	}
+	// ensure queued records are included in the reconstruction
+	r.flush()
+
+	snapshot, err := r.store.GetLatestSnapshot(exch, a.String(), cp.Base.String(), cp.Quote.String(), at)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, errNoRecordedOrderbook) {
+			return nil, fmt.Errorf("%w for %s %s %s at %s", errNoRecordedOrderbook, exch, a, cp, at)
+		}
+		return nil, err
+	}
+	bids := levelsToMap(snapshot.Bids)
+	asks := levelsToMap(snapshot.Asks)
+	lastUpdated := snapshot.Timestamp
+	if at.After(snapshot.Timestamp) {
+		var deltas []orderbookdb.Record
+		deltas, err = r.store.GetInRange(exch, a.String(), cp.Base.String(), cp.Quote.String(), snapshot.Timestamp.Add(time.Nanosecond), at)
+		if err != nil {
+			return nil, err
+		}
+		for i := range deltas {
+			if deltas[i].IsSnapshot {
+				bids = levelsToMap(deltas[i].Bids)
+				asks = levelsToMap(deltas[i].Asks)
+			} else {
+				applyLevels(bids, deltas[i].Bids)
+				applyLevels(asks, deltas[i].Asks)
+			}
+			lastUpdated = deltas[i].Timestamp
+		}
+	}
+	return &orderbook.Base{
+		Exchange:    exch,
+		Pair:        cp,
+		Asset:       a,
+		Bids:        tranchesFromMap(bids, true),
+		Asks:        tranchesFromMap(asks, false),
+		LastUpdated: lastUpdated,
+	}, nil
+}
+
+// levelDelta returns the price levels which have changed between two states
+// of one side of an orderbook. Removed levels have an amount of zero
+func levelDelta(previous, current map[float64]float64, descending bool) []orderbookdb.Level {
+	var changed []orderbookdb.Level
+	for price, amount := range current {
+		if prev, ok := previous[price]; !ok || prev != amount {
+			changed = append(changed, orderbookdb.Level{Price: price, Amount: amount})
+		}
+	}
+	for price := range previous {
+		if _, ok := current[price]; !ok {
+			changed = append(changed, orderbookdb.Level{Price: price})
+		}
+	}
+	sortLevels(changed, descending)
+	return changed
+}
+
+func applyLevels(side map[float64]float64, levels []orderbookdb.Level) {
+	for i := range levels {
+		if levels[i].Amount <= 0 {
+			delete(side, levels[i].Price)
+			continue
+		}
+		side[levels[i].Price] = levels[i].Amount
+	}
+}
+
+func tranchesToMap(t orderbook.Tranches) map[float64]float64 {
+	m := make(map[float64]float64, len(t))
+	for i := range t {
+		m[t[i].Price] = t[i].Amount
+	}
+	return m
+}
+
+func levelsToMap(levels []orderbookdb.Level) map[float64]float64 {
+	m := make(map[float64]float64, len(levels))
+	applyLevels(m, levels)
+	return m
+}
+
+func levelsFromMap(side map[float64]float64, descending bool) []orderbookdb.Level {
+	levels := make([]orderbookdb.Level, 0, len(side))
+	for price, amount := range side {
+		levels = append(levels, orderbookdb.Level{Price: price, Amount: amount})
+	}
+	sortLevels(levels, descending)
+	return levels
+}
+
+func tranchesFromMap(side map[float64]float64, descending bool) orderbook.Tranches {
+	levels := levelsFromMap(side, descending)
+	t := make(orderbook.Tranches, len(levels))
+	for i := range levels {
+		t[i] = orderbook.Tranche{Price: levels[i].Price, Amount: levels[i].Amount}
+	}
+	return t
+}
+
+func sortLevels(levels []orderbookdb.Level, descending bool) {
+	slices.SortFunc(levels, func(a, b orderbookdb.Level) int {
+		if descending {
+			a, b = b, a
+		}
+		switch {
+		case a.Price < b.Price:
+			return -1
+		case a.Price > b.Price:
+			return 1
+		}
+		return 0
+	})
+}
@@ -0,0 +1,289 @@
+package engine
+
+import (
+	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
+	orderbookdb "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+func newOrderbookFileStore(dir string, rotation time.Duration) (*orderbookFileStore, error) {
+	if rotation <= 0 {
+		rotation = defaultOrderbookRecorderFileRotation
+	}
+	if err := common.CreateDir(dir); err != nil {
+		return nil, err
+	}
+	return &orderbookFileStore{
+		dir:      dir,
+		rotation: rotation,
+		files:    make(map[string]*recordingFile),
+	}, nil
+}
+
+// recordingPrefix returns the file name prefix for an exchange, asset and pair
+func recordingPrefix(exch, a, base, quote string) string {
+	return strings.ToLower(exch) + "_" + strings.ToLower(a) + "_" + strings.ToUpper(base) + "_" + strings.ToUpper(quote) + "_"
+}
+
+// Insert appends records to the current recording file for their exchange,
+// asset and pair, rotating the file once the rotation interval has elapsed
+func (s *orderbookFileStore) Insert(records ...*orderbookdb.Record) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	for i := range records {
+		if records[i] == nil {
+			return fmt.Errorf("%w orderbook record", common.ErrNilPointer)
+		}
+		f, err := s.getFile(recordingPrefix(records[i].Exchange, records[i].Asset, records[i].Base, records[i].Quote), records[i].Timestamp)
+		if err != nil {
+			return err
+		}
+		line, err := json.Marshal(&fileRecord{
+			Timestamp:  records[i].Timestamp.UTC(),
+			IsSnapshot: records[i].IsSnapshot,
+			Bids:       levelsToPairs(records[i].Bids),
+			Asks:       levelsToPairs(records[i].Asks),
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = f.file.Write(append(line, '\n')); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getFile returns the open recording file for a prefix, archiving the
+// current file and opening a new one when due for rotation
+func (s *orderbookFileStore) getFile(prefix string, ts time.Time) (*recordingFile, error) {
+	f, ok := s.files[prefix]
+	if ok && ts.Sub(f.started) < s.rotation {
+		return f, nil
+	}
+	if ok {
+		delete(s.files, prefix)
+		if err := f.archive(); err != nil {
+			return nil, err
+		}
+	}
+	path := filepath.Join(s.dir, prefix+strconv.FormatInt(ts.UnixNano(), 10)+orderbookRecorderFileExtension)
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o640)
+	if err != nil {
+		return nil, err
+	}
+	f = &recordingFile{file: file, path: path, started: ts}
+	s.files[prefix] = f
+	return f, nil
+}
+
+// archive closes a recording file and compresses it into a zip archive
+func (f *recordingFile) archive() error {
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if err := archive.Zip(f.path, strings.TrimSuffix(f.path, orderbookRecorderFileExtension)+orderbookRecorderArchiveExtension); err != nil {
+		return err
+	}
+	return os.Remove(f.path)
+}
+
+// Close archives all open recording files
+func (s *orderbookFileStore) Close() error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	var errs error
+	for prefix, f := range s.files {
+		delete(s.files, prefix)
+		errs = common.AppendError(errs, f.archive())
+	}
+	return errs
+}
+
+// GetInRange returns all records for an exchange, asset and pair between
+// the start and end dates inclusive
+func (s *orderbookFileStore) GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]orderbookdb.Record, error) {
+	if err := common.StartEndTimeCheck(startDate, endDate); err != nil {
+		return nil, err
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	recordings, err := s.listRecordings(recordingPrefix(exchangeName, assetType, base, quote))
+	if err != nil {
+		return nil, err
+	}
+	var resp []orderbookdb.Record
+	for i := range recordings {
+		if recordings[i].started > endDate.UnixNano() {
+			break
+		}
+		if i+1 < len(recordings) && recordings[i+1].started < startDate.UnixNano() {
+			continue
+		}
+		err = readRecording(recordings[i].path, func(r *fileRecord) {
+			if r.Timestamp.Before(startDate) || r.Timestamp.After(endDate) {
+				return
+			}
+			resp = append(resp, fileRecordToRecord(r, exchangeName, assetType, base, quote))
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resp, nil
+}
+
+// GetLatestSnapshot returns the most recent snapshot recorded at or before
+// the supplied time
+func (s *orderbookFileStore) GetLatestSnapshot(exchangeName, assetType, base, quote string, before time.Time) (*orderbookdb.Record, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	recordings, err := s.listRecordings(recordingPrefix(exchangeName, assetType, base, quote))
+	if err != nil {
+		return nil, err
+	}
+	for i := len(recordings) - 1; i >= 0; i-- {
+		if recordings[i].started > before.UnixNano() {
+			continue
+		}
+		var snapshot *fileRecord
+		err = readRecording(recordings[i].path, func(r *fileRecord) {
+			if r.IsSnapshot && !r.Timestamp.After(before) {
+				snapshot = r
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+		if snapshot != nil {
+			resp := fileRecordToRecord(snapshot, exchangeName, assetType, base, quote)
+			return &resp, nil
+		}
+	}
+	return nil, errNoRecordedOrderbook
+}
+
+// listRecordings returns all recording files and archives for a prefix in
+// the order they were recorded
+func (s *orderbookFileStore) listRecordings(prefix string) ([]recording, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	var recordings []recording
+	for i := range entries {
+		name := entries[i].Name()
+		if entries[i].IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		ext := filepath.Ext(name)
+		if ext != orderbookRecorderFileExtension && ext != orderbookRecorderArchiveExtension {
+			continue
+		}
+		started, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext), 10, 64)
+		if err != nil {
+			continue
+		}
+		recordings = append(recordings, recording{path: filepath.Join(s.dir, name), started: started})
+	}
+	slices.SortFunc(recordings, func(a, b recording) int {
+		switch {
+		case a.started < b.started:
+			return -1
+		case a.started > b.started:
+			return 1
+		}
+		return 0
+	})
+	return recordings, nil
+}
+
+// readRecording decodes each record of a recording file or archive
+func readRecording(path string, fn func(*fileRecord)) error {
+	if filepath.Ext(path) != orderbookRecorderArchiveExtension {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return decodeRecords(f, fn)
+	}
+	z, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer z.Close()
+	for i := range z.File {
+		f, err := z.File[i].Open()
+		if err != nil {
+			return err
+		}
+		err = decodeRecords(f, fn)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decodeRecords(r io.Reader, fn func(*fileRecord)) error {
+	dec := json.NewDecoder(r)
+	for {
+		record := &fileRecord{}
+		if err := dec.Decode(record); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		fn(record)
+	}
+}
+
+func fileRecordToRecord(r *fileRecord, exchangeName, assetType, base, quote string) orderbookdb.Record {
+	return orderbookdb.Record{
+		Exchange:   exchangeName,
+		Base:       base,
+		Quote:      quote,
+		Asset:      assetType,
+		IsSnapshot: r.IsSnapshot,
+		Bids:       pairsToLevels(r.Bids),
+		Asks:       pairsToLevels(r.Asks),
+		Timestamp:  r.Timestamp,
+	}
+}
+
+func levelsToPairs(levels []orderbookdb.Level) [][2]float64 {
+	if len(levels) == 0 {
+		return nil
+	}
+	pairs := make([][2]float64, len(levels))
+	for i := range levels {
+		pairs[i] = [2]float64{levels[i].Price, levels[i].Amount}
+	}
+	return pairs
+}
+
+func pairsToLevels(pairs [][2]float64) []orderbookdb.Level {
+	if len(pairs) == 0 {
+		return nil
+	}
+	levels := make([]orderbookdb.Level, len(pairs))
+	for i := range pairs {
+		levels[i] = orderbookdb.Level{Price: pairs[i][0], Amount: pairs[i][1]}
+	}
+	return levels
+}
@@ -0,0 +1,288 @@
+package engine
+
+import (
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	orderbookdb "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var recorderTestPair = currency.NewPair(currency.BTC, currency.USDT)
+
+func newTestOrderbookRecorder(t *testing.T) *OrderbookRecorder {
+	t.Helper()
+	r, err := SetupOrderbookRecorder(nil, &config.OrderbookRecorder{
+		Output:               OrderbookRecorderFileOutput,
+		Directory:            t.TempDir(),
+		SnapshotInterval:     time.Minute,
+		FileRotationInterval: time.Minute * 2,
+		Pairs: []config.OrderbookRecorderPair{
+			{Exchange: testExchange, Asset: asset.Spot, Pair: recorderTestPair},
+		},
+	})
+	require.NoError(t, err, "SetupOrderbookRecorder must not error")
+	return r
+}
+
+func recorderTestBook(ts time.Time, bids, asks orderbook.Tranches) *orderbook.Base {
+	return &orderbook.Base{
+		Exchange:    testExchange,
+		Pair:        recorderTestPair,
+		Asset:       asset.Spot,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: ts,
+	}
+}
+
+func TestSetupOrderbookRecorder(t *testing.T) {
+	t.Parallel()
+	_, err := SetupOrderbookRecorder(nil, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+
+	cfg := &config.OrderbookRecorder{}
+	_, err = SetupOrderbookRecorder(nil, cfg)
+	assert.ErrorIs(t, err, errNoOrderbookRecorderPairs)
+
+	cfg.Pairs = []config.OrderbookRecorderPair{{}}
+	_, err = SetupOrderbookRecorder(nil, cfg)
+	assert.ErrorIs(t, err, ErrExchangeNameIsEmpty)
+
+	cfg.Pairs[0].Exchange = testExchange
+	_, err = SetupOrderbookRecorder(nil, cfg)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	cfg.Pairs[0].Asset = asset.Spot
+	_, err = SetupOrderbookRecorder(nil, cfg)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+
+	cfg.Pairs[0].Pair = recorderTestPair
+	_, err = SetupOrderbookRecorder(nil, cfg)
+	assert.ErrorIs(t, err, errInvalidOrderbookRecorderType)
+
+	cfg.Output = OrderbookRecorderDatabaseOutput
+	_, err = SetupOrderbookRecorder(nil, cfg)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+
+	_, err = SetupOrderbookRecorder(&DatabaseConnectionManager{}, cfg)
+	assert.ErrorIs(t, err, database.ErrNilInstance)
+
+	cfg.Output = OrderbookRecorderFileOutput
+	cfg.Directory = t.TempDir()
+	r, err := SetupOrderbookRecorder(nil, cfg)
+	require.NoError(t, err, "SetupOrderbookRecorder must not error")
+	assert.Equal(t, defaultOrderbookRecorderSnapshotInterval, r.snapshotInterval, "snapshot interval should be defaulted")
+	assert.Equal(t, defaultOrderbookRecorderFlushInterval, r.flushInterval, "flush interval should be defaulted")
+	assert.IsType(t, &orderbookFileStore{}, r.store, "store should be a file store")
+}
+
+func TestOrderbookRecorderStartStop(t *testing.T) {
+	t.Parallel()
+	var r *OrderbookRecorder
+	assert.ErrorIs(t, r.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, r.Stop(), ErrNilSubsystem)
+	assert.False(t, r.IsRunning(), "IsRunning should return false on a nil recorder")
+
+	r = newTestOrderbookRecorder(t)
+	assert.ErrorIs(t, r.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, r.Start(), "Start must not error")
+	assert.ErrorIs(t, r.Start(), ErrSubSystemAlreadyStarted)
+	assert.True(t, r.IsRunning(), "IsRunning should return true")
+
+	tt := time.Now()
+	r.record(recorderTestBook(tt, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 101, Amount: 1}}))
+	require.NoError(t, r.Stop(), "Stop must not error")
+	assert.False(t, r.IsRunning(), "IsRunning should return false")
+	assert.Empty(t, r.pending, "Stop should write all pending records")
+
+	files, err := os.ReadDir(r.store.(*orderbookFileStore).dir)
+	require.NoError(t, err, "ReadDir must not error")
+	require.Len(t, files, 1, "Stop must archive the recording file")
+	assert.Equal(t, orderbookRecorderArchiveExtension, filepath.Ext(files[0].Name()), "recording should be archived")
+}
+
+// fakeOrderbookFeed is an orderbook feed which can be closed to simulate a
+// dispatcher restart
+type fakeOrderbookFeed struct {
+	c        chan any
+	released atomic.Bool
+}
+
+func (f *fakeOrderbookFeed) Channel() <-chan any {
+	return f.c
+}
+
+func (f *fakeOrderbookFeed) Release() error {
+	f.released.Store(true)
+	return nil
+}
+
+func TestOrderbookRecorderResubscribe(t *testing.T) {
+	t.Parallel()
+	r := newTestOrderbookRecorder(t)
+	feeds := make(chan *fakeOrderbookFeed, 3)
+	var attempts atomic.Int32
+	r.subscribeFn = func(string) (orderbookFeed, error) {
+		if attempts.Add(1) == 2 {
+			return nil, errExpectedTestError
+		}
+		f := &fakeOrderbookFeed{c: make(chan any)}
+		feeds <- f
+		return f, nil
+	}
+	require.NoError(t, r.Start(), "Start must not error")
+	first := <-feeds
+	close(first.c)
+
+	var second *fakeOrderbookFeed
+	select {
+	case second = <-feeds:
+	case <-time.After(time.Second * 10):
+		require.FailNow(t, "closed feed must be resubscribed")
+	}
+	assert.Equal(t, int32(3), attempts.Load(), "failed resubscription should be retried")
+	assert.False(t, first.released.Load(), "closed feed should not be released")
+	require.NoError(t, r.Stop(), "Stop must not error")
+	assert.True(t, second.released.Load(), "Stop should release the resubscribed feed")
+}
+
+func TestOrderbookRecorderRecord(t *testing.T) {
+	t.Parallel()
+	r := newTestOrderbookRecorder(t)
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	r.record(&orderbook.Base{Exchange: "unrecorded", Pair: recorderTestPair, Asset: asset.Spot, LastUpdated: tt})
+	assert.Empty(t, r.pending, "unconfigured orderbooks should not be recorded")
+
+	r.record(recorderTestBook(tt,
+		orderbook.Tranches{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		orderbook.Tranches{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}}))
+	require.Len(t, r.pending, 1, "first orderbook must be recorded")
+	assert.True(t, r.pending[0].IsSnapshot, "first record should be a snapshot")
+	assert.Equal(t, []orderbookdb.Level{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}}, r.pending[0].Bids, "snapshot bids should be sorted descending")
+	assert.Equal(t, []orderbookdb.Level{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}}, r.pending[0].Asks, "snapshot asks should be sorted ascending")
+
+	r.record(recorderTestBook(tt.Add(time.Second),
+		orderbook.Tranches{{Price: 99, Amount: 1}, {Price: 98, Amount: 2}},
+		orderbook.Tranches{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}}))
+	assert.Len(t, r.pending, 1, "unchanged orderbooks should not be recorded")
+
+	r.record(recorderTestBook(tt.Add(time.Second*2),
+		orderbook.Tranches{{Price: 99, Amount: 3}, {Price: 98, Amount: 2}},
+		orderbook.Tranches{{Price: 102, Amount: 2}, {Price: 103, Amount: 1}}))
+	require.Len(t, r.pending, 2, "changed orderbook must be recorded")
+	assert.False(t, r.pending[1].IsSnapshot, "record should be a delta")
+	assert.Equal(t, []orderbookdb.Level{{Price: 99, Amount: 3}}, r.pending[1].Bids, "delta should only contain changed bids")
+	assert.Equal(t, []orderbookdb.Level{{Price: 101, Amount: 0}, {Price: 103, Amount: 1}}, r.pending[1].Asks, "delta should contain removed and added asks")
+
+	r.record(recorderTestBook(tt.Add(time.Minute),
+		orderbook.Tranches{{Price: 99, Amount: 3}, {Price: 98, Amount: 2}},
+		orderbook.Tranches{{Price: 102, Amount: 2}, {Price: 103, Amount: 1}}))
+	require.Len(t, r.pending, 3, "snapshot must be recorded once the snapshot interval elapses")
+	assert.True(t, r.pending[2].IsSnapshot, "record should be a snapshot")
+}
+
+func TestGetRecordedOrderbook(t *testing.T) {
+	t.Parallel()
+	var r *OrderbookRecorder
+	_, err := r.GetRecordedOrderbook(testExchange, asset.Spot, recorderTestPair, time.Now())
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	r = newTestOrderbookRecorder(t)
+	_, err = r.GetRecordedOrderbook("", asset.Spot, recorderTestPair, time.Now())
+	assert.ErrorIs(t, err, ErrExchangeNameIsEmpty)
+	_, err = r.GetRecordedOrderbook(testExchange, asset.Spot, currency.EMPTYPAIR, time.Now())
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+	_, err = r.GetRecordedOrderbook(testExchange, asset.Futures, recorderTestPair, time.Now())
+	assert.ErrorIs(t, err, errOrderbookNotRecorded)
+
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = r.GetRecordedOrderbook(testExchange, asset.Spot, recorderTestPair, tt)
+	assert.ErrorIs(t, err, errNoRecordedOrderbook)
+
+	r.record(recorderTestBook(tt,
+		orderbook.Tranches{{Price: 99, Amount: 1}},
+		orderbook.Tranches{{Price: 101, Amount: 1}}))
+	r.record(recorderTestBook(tt.Add(time.Second*30),
+		orderbook.Tranches{{Price: 100, Amount: 2}, {Price: 99, Amount: 1}},
+		orderbook.Tranches{{Price: 101, Amount: 1}}))
+	r.record(recorderTestBook(tt.Add(time.Minute+time.Second),
+		orderbook.Tranches{{Price: 100, Amount: 2}},
+		orderbook.Tranches{{Price: 102, Amount: 4}}))
+	r.flush()
+	// rotated into a second recording file, archiving the first
+	r.record(recorderTestBook(tt.Add(time.Minute*3),
+		orderbook.Tranches{{Price: 100, Amount: 5}},
+		orderbook.Tranches{{Price: 102, Amount: 4}}))
+
+	_, err = r.GetRecordedOrderbook(testExchange, asset.Spot, recorderTestPair, tt.Add(-time.Second))
+	assert.ErrorIs(t, err, errNoRecordedOrderbook)
+
+	ob, err := r.GetRecordedOrderbook(testExchange, asset.Spot, recorderTestPair, tt)
+	require.NoError(t, err, "GetRecordedOrderbook must not error")
+	assert.Equal(t, orderbook.Tranches{{Price: 99, Amount: 1}}, ob.Bids, "bids should match the snapshot")
+	assert.Equal(t, tt, ob.LastUpdated, "last updated should match the snapshot")
+
+	ob, err = r.GetRecordedOrderbook(testExchange, asset.Spot, recorderTestPair, tt.Add(time.Minute))
+	require.NoError(t, err, "GetRecordedOrderbook must not error")
+	assert.Equal(t, orderbook.Tranches{{Price: 100, Amount: 2}, {Price: 99, Amount: 1}}, ob.Bids, "bids should include the delta")
+	assert.Equal(t, orderbook.Tranches{{Price: 101, Amount: 1}}, ob.Asks, "asks should be unchanged")
+	assert.Equal(t, tt.Add(time.Second*30), ob.LastUpdated, "last updated should match the delta")
+
+	ob, err = r.GetRecordedOrderbook(testExchange, asset.Spot, recorderTestPair, tt.Add(time.Hour))
+	require.NoError(t, err, "GetRecordedOrderbook must not error")
+	assert.Equal(t, orderbook.Tranches{{Price: 100, Amount: 5}}, ob.Bids, "bids should be rebuilt from the latest snapshot")
+	assert.Equal(t, orderbook.Tranches{{Price: 102, Amount: 4}}, ob.Asks, "asks should be rebuilt from the latest snapshot")
+
+	files, err := os.ReadDir(r.store.(*orderbookFileStore).dir)
+	require.NoError(t, err, "ReadDir must not error")
+	require.Len(t, files, 2, "recording must be rotated")
+	assert.Equal(t, orderbookRecorderArchiveExtension, filepath.Ext(files[0].Name()), "first recording should be archived")
+	assert.Equal(t, orderbookRecorderFileExtension, filepath.Ext(files[1].Name()), "current recording should not be archived")
+}
+
+func TestOrderbookFileStoreGetInRange(t *testing.T) {
+	t.Parallel()
+	s, err := newOrderbookFileStore(t.TempDir(), time.Minute)
+	require.NoError(t, err, "newOrderbookFileStore must not error")
+	assert.Equal(t, time.Minute, s.rotation, "rotation should be set")
+
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	_, err = s.GetInRange(testExchange, "spot", "btc", "usdt", tt, tt.Add(-time.Second))
+	assert.Error(t, err, "GetInRange should error on an invalid range")
+
+	var records []*orderbookdb.Record
+	for i := range 5 {
+		records = append(records, &orderbookdb.Record{
+			Exchange:   testExchange,
+			Base:       "BTC",
+			Quote:      "USDT",
+			Asset:      "spot",
+			IsSnapshot: i == 0,
+			Bids:       []orderbookdb.Level{{Price: float64(100 + i), Amount: 1}},
+			Timestamp:  tt.Add(time.Second * 30 * time.Duration(i)),
+		})
+	}
+	require.NoError(t, s.Insert(records...), "Insert must not error")
+	require.NoError(t, s.Close(), "Close must not error")
+
+	resp, err := s.GetInRange(testExchange, "spot", "btc", "usdt", tt.Add(time.Second*30), tt.Add(time.Minute*3/2))
+	require.NoError(t, err, "GetInRange must not error")
+	require.Len(t, resp, 3, "GetInRange must return records across recordings")
+	assert.Equal(t, 101.0, resp[0].Bids[0].Price, "records should be returned in order")
+	assert.Equal(t, tt.Add(time.Minute*3/2), resp[2].Timestamp, "end date should be inclusive")
+
+	resp, err = s.GetInRange("other", "spot", "btc", "usdt", tt, tt.Add(time.Hour))
+	require.NoError(t, err, "GetInRange must not error")
+	assert.Empty(t, resp, "GetInRange should not return records for another exchange")
+}
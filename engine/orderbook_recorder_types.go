@@ -0,0 +1,100 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	orderbookdb "github.com/thrasher-corp/gocryptotrader/database/repository/orderbook"
+)
+
+// OrderbookRecorderName is an exported subsystem name
+const OrderbookRecorderName = "orderbook_recorder"
+
+// Orderbook recorder output types
+const (
+	OrderbookRecorderDatabaseOutput = "database"
+	OrderbookRecorderFileOutput     = "file"
+)
+
+const (
+	defaultOrderbookRecorderSnapshotInterval = time.Minute
+	defaultOrderbookRecorderFlushInterval    = time.Second * 5
+	defaultOrderbookRecorderFileRotation     = time.Hour
+	orderbookRecorderResubscribeDelay        = time.Second
+	orderbookRecorderMaxResubscribeDelay     = time.Minute
+	orderbookRecorderFileExtension           = ".jsonl"
+	orderbookRecorderArchiveExtension        = ".zip"
+)
+
+var (
+	errNoOrderbookRecorderPairs     = errors.New("no orderbook recorder pairs configured")
+	errInvalidOrderbookRecorderType = errors.New("invalid orderbook recorder output")
+	errOrderbookNotRecorded         = errors.New("orderbook is not configured for recording")
+	errNoRecordedOrderbook          = errors.New("no recorded orderbook found")
+)
+
+// OrderbookRecorder subscribes to exchange orderbook updates and records
+// periodic snapshots along with the deltas between them, allowing an
+// orderbook to be reconstructed at any point in time
+type OrderbookRecorder struct {
+	started          int32
+	shutdown         chan struct{}
+	wg               sync.WaitGroup
+	m                sync.Mutex
+	snapshotInterval time.Duration
+	flushInterval    time.Duration
+	store            orderbookdb.IDBService
+	// subscribed tracks whether an exchange orderbook feed is being consumed
+	subscribed  map[string]bool
+	subscribeFn func(exchange string) (orderbookFeed, error)
+	books       map[key.ExchangePairAsset]*recordedBook
+	pending     []*orderbookdb.Record
+}
+
+// orderbookFeed is an exchange orderbook feed subscription
+type orderbookFeed interface {
+	Channel() <-chan any
+	Release() error
+}
+
+// recordedBook holds the last recorded state of an orderbook so that
+// deltas can be derived from subsequent updates
+type recordedBook struct {
+	bids         map[float64]float64
+	asks         map[float64]float64
+	lastSnapshot time.Time
+}
+
+// orderbookFileStore writes recorded orderbooks to files which are rotated
+// into zip archives, one set of files per exchange, asset and pair
+type orderbookFileStore struct {
+	m        sync.Mutex
+	dir      string
+	rotation time.Duration
+	files    map[string]*recordingFile
+}
+
+// recordingFile is the currently open, unarchived recording file
+type recordingFile struct {
+	file    *os.File
+	path    string
+	started time.Time
+}
+
+// recording is a recording file for an exchange, asset and pair, which is
+// named after the time of its first record
+type recording struct {
+	path    string
+	started int64
+}
+
+// fileRecord is a single line in an orderbook recording file
+type fileRecord struct {
+	Timestamp  time.Time    `json:"timestamp"`
+	IsSnapshot bool         `json:"snapshot,omitempty"`
+	Bids       [][2]float64 `json:"bids,omitempty"`
+	Asks       [][2]float64 `json:"asks,omitempty"`
+}
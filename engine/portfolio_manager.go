@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliovaluation"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -31,6 +32,12 @@ type portfolioManager struct {
 	shutdown              chan struct{}
 	base                  *portfolio.Base
 	m                     sync.Mutex
+	// valuationDB is set when periodic valuation snapshots are saved to the
+	// database
+	valuationDB       portfoliovaluation.IDBService
+	valuationInterval time.Duration
+	valuationFiat     currency.Code
+	lastValuation     time.Time
 }
 
 // setupPortfolioManager creates a new portfolio manager
@@ -140,6 +147,7 @@ func (m *portfolioManager) processPortfolio() {
 
 		log.Debugf(log.PortfolioMgr, "Portfolio manager: Successfully updated address balance for %s address(es) %s\n", key, value)
 	}
+	m.saveValuation(time.Now())
 	atomic.CompareAndSwapInt32(&m.processing, 1, 0)
 }
 
@@ -0,0 +1,211 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliovaluation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+const defaultPortfolioValuationInterval = time.Hour
+
+var (
+	errPortfolioValuationNotEnabled = errors.New("portfolio valuation snapshots are not enabled")
+	errNoValuationPrice             = errors.New("no price available to value currency")
+)
+
+// valuationStablecoins are valued at their USD peg when no ticker price is
+// available, and are used as quote currencies to price other holdings
+var valuationStablecoins = []currency.Code{currency.USDT, currency.USDC, currency.DAI}
+
+// setupValuation enables saving periodic valuation snapshots of all holdings
+// in the fiat currency to the database. It must be called before Start
+func (m *portfolioManager) setupValuation(dcm iDatabaseConnectionManager, fiatCurrency currency.Code, cfg *config.PortfolioValuation) error {
+	if m == nil {
+		return fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 1 {
+		return fmt.Errorf("portfolio manager %w", ErrSubSystemAlreadyStarted)
+	}
+	if dcm == nil {
+		return errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return fmt.Errorf("%w PortfolioValuation", errNilConfig)
+	}
+	if !fiatCurrency.IsFiatCurrency() {
+		return fmt.Errorf("%s %w", fiatCurrency, currency.ErrFiatDisplayCurrencyIsNotFiat)
+	}
+	db, err := portfoliovaluation.Setup(dcm.GetInstance())
+	if err != nil {
+		return err
+	}
+	m.valuationDB = db
+	m.valuationFiat = fiatCurrency
+	m.valuationInterval = cfg.Interval
+	if m.valuationInterval <= 0 {
+		m.valuationInterval = defaultPortfolioValuationInterval
+	}
+	return nil
+}
+
+// GetValuation values all current holdings in the fiat currency
+func (m *portfolioManager) GetValuation(fiatCurrency currency.Code) (*portfoliovaluation.Snapshot, error) {
+	if m == nil {
+		return nil, fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
+	}
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("portfolio manager %w", ErrSubSystemNotStarted)
+	}
+	if !fiatCurrency.IsFiatCurrency() {
+		return nil, fmt.Errorf("%s %w", fiatCurrency, currency.ErrFiatDisplayCurrencyIsNotFiat)
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	return m.getValuation(fiatCurrency, time.Now()), nil
+}
+
+// GetValuationHistory returns the valuation snapshots saved between the start
+// and end dates
+func (m *portfolioManager) GetValuationHistory(start, end time.Time) ([]portfoliovaluation.Snapshot, error) {
+	if m == nil {
+		return nil, fmt.Errorf("portfolio manager %w", ErrNilSubsystem)
+	}
+	if m.valuationDB == nil {
+		return nil, errPortfolioValuationNotEnabled
+	}
+	return m.valuationDB.GetInRange(start, end)
+}
+
+// saveValuation saves a valuation snapshot when enabled and the valuation
+// interval has elapsed. Must be called with the lock held
+func (m *portfolioManager) saveValuation(now time.Time) {
+	if m.valuationDB == nil || now.Sub(m.lastValuation) < m.valuationInterval {
+		return
+	}
+	s := m.getValuation(m.valuationFiat, now)
+	if len(s.Holdings) == 0 {
+		return
+	}
+	if err := m.valuationDB.Insert(s); err != nil {
+		log.Errorf(log.PortfolioMgr, "Portfolio manager unable to save valuation: %v", err)
+		return
+	}
+	m.lastValuation = now
+}
+
+// getValuation values the portfolio holdings of each exchange and the
+// personal address holdings in the fiat currency. Holdings which cannot be
+// priced are included with a zero price. Must be called with the lock held
+func (m *portfolioManager) getValuation(fiatCurrency currency.Code, now time.Time) *portfoliovaluation.Snapshot {
+	summary := m.base.GetPortfolioSummary()
+	s := &portfoliovaluation.Snapshot{
+		Timestamp:    now,
+		FiatCurrency: fiatCurrency.String(),
+	}
+	for _, exch := range slices.Sorted(maps.Keys(summary.OnlineSummary)) {
+		coins := summary.OnlineSummary[exch]
+		for _, code := range slices.SortedFunc(maps.Keys(coins), compareCodes) {
+			s.Holdings = append(s.Holdings, m.valueHolding(exch, code, coins[code].Balance, fiatCurrency))
+		}
+	}
+	slices.SortFunc(summary.Offline, func(a, b portfolio.Coin) int { return compareCodes(a.Coin, b.Coin) })
+	for i := range summary.Offline {
+		s.Holdings = append(s.Holdings, m.valueHolding("", summary.Offline[i].Coin, summary.Offline[i].Balance, fiatCurrency))
+	}
+	return s
+}
+
+func (m *portfolioManager) valueHolding(exchName string, code currency.Code, balance float64, fiatCurrency currency.Code) portfoliovaluation.Holding {
+	h := portfoliovaluation.Holding{
+		Exchange: exchName,
+		Currency: code.String(),
+		Balance:  balance,
+	}
+	price, err := getFiatPrice(exchName, code, fiatCurrency)
+	if err != nil {
+		if m.base.Verbose {
+			log.Debugf(log.PortfolioMgr, "Portfolio manager unable to value %s %s holding: %v", exchName, code, err)
+		}
+		return h
+	}
+	h.Price = price
+	h.Value = balance * price
+	return h
+}
+
+// getFiatPrice returns the price of a currency in the fiat currency. Fiat
+// currencies are converted using foreign exchange rates and cryptocurrencies
+// are priced using ticker last prices, preferring the holding's exchange
+func getFiatPrice(exchName string, code, fiatCurrency currency.Code) (float64, error) {
+	return fiatPrice(code, fiatCurrency, func(cp currency.Pair) (float64, error) {
+		return getTickerPrice(exchName, cp)
+	})
+}
+
+// fiatPrice returns the price of a currency in the fiat currency, using the
+// lookup to price cryptocurrencies quoted in the fiat currency, USD or a USD
+// stablecoin
+func fiatPrice(code, fiatCurrency currency.Code, lookup func(currency.Pair) (float64, error)) (float64, error) {
+	if code.Equal(fiatCurrency) {
+		return 1, nil
+	}
+	if code.IsFiatCurrency() {
+		return currency.ConvertFiat(1, code, fiatCurrency)
+	}
+	for _, quote := range append([]currency.Code{fiatCurrency, currency.USD}, valuationStablecoins...) {
+		if code.Equal(quote) {
+			continue
+		}
+		price, err := lookup(currency.NewPair(code, quote))
+		if err != nil {
+			continue
+		}
+		if quote.Equal(fiatCurrency) {
+			return price, nil
+		}
+		quotePrice, err := getUSDFiatPrice(fiatCurrency)
+		if err != nil {
+			return 0, err
+		}
+		return price * quotePrice, nil
+	}
+	if slices.ContainsFunc(valuationStablecoins, code.Equal) {
+		return getUSDFiatPrice(fiatCurrency)
+	}
+	return 0, fmt.Errorf("%w %s", errNoValuationPrice, code)
+}
+
+// getTickerPrice returns the last spot price of a pair on an exchange,
+// falling back to any exchange
+func getTickerPrice(exchName string, cp currency.Pair) (float64, error) {
+	if exchName != "" {
+		if t, err := ticker.GetTicker(exchName, cp, asset.Spot); err == nil && t.Last > 0 {
+			return t.Last, nil
+		}
+	}
+	return ticker.FindLast(cp, asset.Spot)
+}
+
+// getUSDFiatPrice returns the price of one USD in the fiat currency
+func getUSDFiatPrice(fiatCurrency currency.Code) (float64, error) {
+	if fiatCurrency.Equal(currency.USD) {
+		return 1, nil
+	}
+	return currency.ConvertFiat(1, currency.USD, fiatCurrency)
+}
+
+func compareCodes(a, b currency.Code) int {
+	return strings.Compare(a.String(), b.String())
+}
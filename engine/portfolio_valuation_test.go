@@ -0,0 +1,193 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliovaluation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+const valuationTestExchange = "valuationtestexch"
+
+var (
+	valuationTestCoinA = currency.NewCode("PVALA")
+	valuationTestCoinB = currency.NewCode("PVALB")
+	valuationTestCoinC = currency.NewCode("PVALC")
+)
+
+var errValuationTest = errors.New("valuation test error")
+
+// fakeValuationDB stores valuation snapshots in memory
+type fakeValuationDB struct {
+	inserted []*portfoliovaluation.Snapshot
+	history  []portfoliovaluation.Snapshot
+	err      error
+}
+
+func (f *fakeValuationDB) Insert(s *portfoliovaluation.Snapshot) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.inserted = append(f.inserted, s)
+	return nil
+}
+
+func (f *fakeValuationDB) GetInRange(_, _ time.Time) ([]portfoliovaluation.Snapshot, error) {
+	return f.history, f.err
+}
+
+func setupValuationTestTickers(t *testing.T) {
+	t.Helper()
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: valuationTestExchange,
+		Pair:         currency.NewPair(valuationTestCoinA, currency.USD),
+		AssetType:    asset.Spot,
+		Last:         10,
+	}), "ProcessTicker must not error")
+	require.NoError(t, ticker.ProcessTicker(&ticker.Price{
+		ExchangeName: valuationTestExchange + "2",
+		Pair:         currency.NewPair(valuationTestCoinB, currency.USDT),
+		AssetType:    asset.Spot,
+		Last:         2,
+	}), "ProcessTicker must not error")
+}
+
+func setupValuationTestManager(t *testing.T) *portfolioManager {
+	t.Helper()
+	m, err := setupPortfolioManager(NewExchangeManager(), 0, &portfolio.Base{
+		Addresses: []portfolio.Address{
+			{Address: valuationTestExchange, CoinType: valuationTestCoinA, Balance: 2, Description: portfolio.ExchangeAddress},
+			{Address: valuationTestExchange, CoinType: valuationTestCoinC, Balance: 1, Description: portfolio.ExchangeAddress},
+			{Address: "0xvaluation", CoinType: valuationTestCoinB, Balance: 5, Description: portfolio.PersonalAddress},
+		},
+	})
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	return m
+}
+
+func TestPortfolioManagerSetupValuation(t *testing.T) {
+	t.Parallel()
+	var m *portfolioManager
+	err := m.setupValuation(nil, currency.USD, nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m, err = setupPortfolioManager(NewExchangeManager(), 0, nil)
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	m.started = 1
+	err = m.setupValuation(nil, currency.USD, nil)
+	assert.ErrorIs(t, err, ErrSubSystemAlreadyStarted)
+
+	m.started = 0
+	err = m.setupValuation(nil, currency.USD, nil)
+	assert.ErrorIs(t, err, errNilDatabaseConnectionManager)
+	err = m.setupValuation(&DatabaseConnectionManager{}, currency.USD, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	err = m.setupValuation(&DatabaseConnectionManager{}, currency.BTC, &config.PortfolioValuation{})
+	assert.ErrorIs(t, err, currency.ErrFiatDisplayCurrencyIsNotFiat)
+	err = m.setupValuation(&DatabaseConnectionManager{}, currency.USD, &config.PortfolioValuation{})
+	assert.ErrorIs(t, err, database.ErrNilInstance, "setupValuation should error when the database is not connected")
+}
+
+func TestPortfolioManagerGetValuation(t *testing.T) {
+	t.Parallel()
+	setupValuationTestTickers(t)
+	var m *portfolioManager
+	_, err := m.GetValuation(currency.USD)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = setupValuationTestManager(t)
+	_, err = m.GetValuation(currency.USD)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started = 1
+	_, err = m.GetValuation(currency.BTC)
+	assert.ErrorIs(t, err, currency.ErrFiatDisplayCurrencyIsNotFiat)
+
+	s, err := m.GetValuation(currency.USD)
+	require.NoError(t, err, "GetValuation must not error")
+	assert.Equal(t, "USD", s.FiatCurrency)
+	assert.Equal(t, []portfoliovaluation.Holding{
+		{Exchange: valuationTestExchange, Currency: "PVALA", Balance: 2, Price: 10, Value: 20},
+		{Exchange: valuationTestExchange, Currency: "PVALC", Balance: 1},
+		{Currency: "PVALB", Balance: 5, Price: 2, Value: 10},
+	}, s.Holdings, "holdings should be valued using any exchange's ticker and include holdings without a price")
+}
+
+func TestPortfolioManagerGetValuationHistory(t *testing.T) {
+	t.Parallel()
+	var m *portfolioManager
+	_, err := m.GetValuationHistory(time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m = setupValuationTestManager(t)
+	_, err = m.GetValuationHistory(time.Time{}, time.Time{})
+	assert.ErrorIs(t, err, errPortfolioValuationNotEnabled)
+
+	db := &fakeValuationDB{history: []portfoliovaluation.Snapshot{{FiatCurrency: "USD"}}}
+	m.valuationDB = db
+	resp, err := m.GetValuationHistory(time.Time{}, time.Time{})
+	require.NoError(t, err, "GetValuationHistory must not error")
+	assert.Equal(t, db.history, resp)
+}
+
+func TestPortfolioManagerSaveValuation(t *testing.T) {
+	t.Parallel()
+	setupValuationTestTickers(t)
+	m, err := setupPortfolioManager(NewExchangeManager(), 0, nil)
+	require.NoError(t, err, "setupPortfolioManager must not error")
+	db := &fakeValuationDB{}
+	m.valuationDB = db
+	m.valuationFiat = currency.USD
+	m.valuationInterval = time.Hour
+
+	now := time.Now()
+	m.saveValuation(now)
+	assert.Empty(t, db.inserted, "saveValuation should not save a snapshot without holdings")
+
+	m = setupValuationTestManager(t)
+	m.valuationDB = db
+	m.valuationFiat = currency.USD
+	m.valuationInterval = time.Hour
+	db.err = errValuationTest
+	m.saveValuation(now)
+	assert.True(t, m.lastValuation.IsZero(), "saveValuation should not update the last valuation time on error")
+
+	db.err = nil
+	m.saveValuation(now)
+	require.Len(t, db.inserted, 1, "saveValuation must save a snapshot")
+	assert.Equal(t, now, db.inserted[0].Timestamp)
+	assert.Len(t, db.inserted[0].Holdings, 3)
+
+	m.saveValuation(now.Add(time.Minute))
+	assert.Len(t, db.inserted, 1, "saveValuation should not save before the interval has elapsed")
+	m.saveValuation(now.Add(time.Hour))
+	assert.Len(t, db.inserted, 2, "saveValuation should save once the interval has elapsed")
+}
+
+func TestGetFiatPrice(t *testing.T) {
+	t.Parallel()
+	setupValuationTestTickers(t)
+	price, err := getFiatPrice(valuationTestExchange, currency.USD, currency.USD)
+	require.NoError(t, err, "getFiatPrice must not error")
+	assert.Equal(t, 1.0, price, "the fiat currency should be priced at one")
+
+	price, err = getFiatPrice(valuationTestExchange, valuationTestCoinA, currency.USD)
+	require.NoError(t, err, "getFiatPrice must not error")
+	assert.Equal(t, 10.0, price)
+
+	price, err = getFiatPrice(valuationTestExchange, valuationTestCoinB, currency.USD)
+	require.NoError(t, err, "getFiatPrice must not error")
+	assert.Equal(t, 2.0, price, "getFiatPrice should price using a stablecoin quote on any exchange")
+
+	_, err = getFiatPrice(valuationTestExchange, valuationTestCoinC, currency.USD)
+	assert.ErrorIs(t, err, errNoValuationPrice)
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -31,6 +33,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliovaluation"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
@@ -51,11 +54,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/gocryptotrader/utils"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -1209,7 +1215,7 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 
 	resp, err := s.OrderManager.Submit(ctx, submission)
 	if err != nil {
-		return &gctrpc.SubmitOrderResponse{}, err
+		return &gctrpc.SubmitOrderResponse{}, riskRejectionToRPCError(err)
 	}
 
 	trades := make([]*gctrpc.Trades, len(resp.Trades))
@@ -1276,6 +1282,77 @@ func (s *RPCServer) SimulateOrder(_ context.Context, r *gctrpc.SimulateOrderRequ
 	return &resp, nil
 }
 
+// SimulateSmartOrder previews how an order would be routed across all enabled
+// exchanges by best effective price without submitting any orders
+func (s *RPCServer) SimulateSmartOrder(ctx context.Context, r *gctrpc.SimulateSmartOrderRequest) (*gctrpc.SimulateSmartOrderResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w SimulateSmartOrderRequest", common.ErrNilPointer)
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+
+	oType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := s.OrderManager.PlanSmartOrder(ctx, &order.Submit{
+		Pair:      currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter),
+		AssetType: a,
+		Side:      side,
+		Type:      oType,
+		Amount:    r.Amount,
+		Price:     r.Price,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.SimulateSmartOrderResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: plan.Pair.Delimiter,
+			Base:      plan.Pair.Base.String(),
+			Quote:     plan.Pair.Quote.String(),
+		},
+		AssetType:             plan.Asset.String(),
+		Side:                  plan.Side.String(),
+		Amount:                plan.Amount,
+		RoutedAmount:          plan.RoutedAmount,
+		AveragePrice:          plan.AveragePrice,
+		AverageEffectivePrice: plan.AverageEffectivePrice,
+		Orders:                make([]*gctrpc.SmartOrderRoute, len(plan.Orders)),
+		Rejections:            make([]*gctrpc.SmartOrderRejection, len(plan.Rejections)),
+	}
+	for i := range plan.Orders {
+		resp.Orders[i] = &gctrpc.SmartOrderRoute{
+			Exchange:              plan.Orders[i].Exchange,
+			Amount:                plan.Orders[i].Amount,
+			AveragePrice:          plan.Orders[i].AveragePrice,
+			AverageEffectivePrice: plan.Orders[i].AverageEffectivePrice,
+			WorstPrice:            plan.Orders[i].WorstPrice,
+			FeeRate:               plan.Orders[i].FeeRate,
+		}
+	}
+	for i := range plan.Rejections {
+		resp.Rejections[i] = &gctrpc.SmartOrderRejection{
+			Exchange: plan.Rejections[i].Exchange,
+			Reason:   plan.Rejections[i].Reason.Error(),
+		}
+	}
+	return resp, nil
+}
+
 // WhaleBomb finds the amount required to reach a specific price target for a given exchange, pair
 // and asset type
 func (s *RPCServer) WhaleBomb(_ context.Context, r *gctrpc.WhaleBombRequest) (*gctrpc.SimulateOrderResponse, error) {
@@ -1474,7 +1551,7 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 		Price:     r.Price,
 	})
 	if err != nil {
-		return nil, err
+		return nil, riskRejectionToRPCError(err)
 	}
 	return &gctrpc.ModifyOrderResponse{
 		ModifiedOrderId: resp.OrderID,
@@ -1483,19 +1560,36 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 
 // GetEvents returns the stored events list
 func (s *RPCServer) GetEvents(_ context.Context, _ *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
-	return &gctrpc.GetEventsResponse{}, common.ErrNotYetImplemented
+	events, err := s.eventManager.GetEvents()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetEventsResponse{Events: make([]*gctrpc.Event, len(events))}
+	for i := range events {
+		resp.Events[i] = eventToRPC(&events[i])
+	}
+	return resp, nil
 }
 
-// AddEvent adds an event
+// AddEvent adds an event. A rule replaces the single item and condition
+// params, allowing composite AND/OR rules
 func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gctrpc.AddEventResponse, error) {
-	evtCondition := EventConditionParams{
-		CheckBids:       r.ConditionParams.CheckBids,
-		CheckAsks:       r.ConditionParams.CheckAsks,
-		Condition:       r.ConditionParams.Condition,
-		OrderbookAmount: r.ConditionParams.OrderbookAmount,
-		Price:           r.ConditionParams.Price,
+	if r == nil {
+		return nil, fmt.Errorf("%w AddEventRequest", common.ErrNilPointer)
+	}
+	rule := r.Rule
+	if rule == nil {
+		rule = &gctrpc.EventRule{Item: r.Item, ConditionParams: r.ConditionParams}
+	}
+
+	actions, err := eventActionsFromRPC(r.Actions)
+	if err != nil {
+		return nil, err
 	}
 
+	if r.Pair == nil {
+		return nil, fmt.Errorf("%w AddEventRequest pair", common.ErrNilPointer)
+	}
 	p := currency.NewPairWithDelimiter(r.Pair.Base,
 		r.Pair.Quote, r.Pair.Delimiter)
 
@@ -1514,7 +1608,16 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 		return nil, err
 	}
 
-	id, err := s.eventManager.Add(r.Exchange, r.Item, evtCondition, p, a, r.Action)
+	id, err := s.eventManager.Add(&Event{
+		Exchange:  r.Exchange,
+		EventRule: eventRuleFromRPC(rule),
+		Pair:      p,
+		Asset:     a,
+		Action:    r.Action,
+		Actions:   actions,
+		Repeat:    r.Repeat,
+		Cooldown:  time.Duration(r.Cooldown),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -1522,6 +1625,142 @@ func (s *RPCServer) AddEvent(_ context.Context, r *gctrpc.AddEventRequest) (*gct
 	return &gctrpc.AddEventResponse{Id: id}, nil
 }
 
+// eventRuleFromRPC converts an RPC event rule and its nested rules
+func eventRuleFromRPC(r *gctrpc.EventRule) EventRule {
+	if r == nil {
+		return EventRule{}
+	}
+	rule := EventRule{
+		Item:     r.Item,
+		Operator: r.Operator,
+	}
+	if c := r.ConditionParams; c != nil {
+		rule.Condition = EventConditionParams{
+			Condition:       c.Condition,
+			Price:           c.Price,
+			CheckBids:       c.CheckBids,
+			CheckAsks:       c.CheckAsks,
+			OrderbookAmount: c.OrderbookAmount,
+			Value:           c.Value,
+			Interval:        kline.Interval(c.Interval),
+			Period:          c.Period,
+			FastPeriod:      c.FastPeriod,
+			SlowPeriod:      c.SlowPeriod,
+			SignalPeriod:    c.SignalPeriod,
+			StdDev:          c.StdDev,
+		}
+		if c.Currency != "" {
+			rule.Condition.Currency = currency.NewCode(c.Currency).Upper()
+		}
+	}
+	if len(r.Rules) > 0 {
+		rule.Rules = make([]EventRule, len(r.Rules))
+		for i := range r.Rules {
+			rule.Rules[i] = eventRuleFromRPC(r.Rules[i])
+		}
+	}
+	return rule
+}
+
+// eventActionsFromRPC converts RPC event actions
+func eventActionsFromRPC(actions []*gctrpc.EventAction) ([]EventAction, error) {
+	resp := make([]EventAction, 0, len(actions))
+	for i := range actions {
+		if actions[i] == nil {
+			return nil, fmt.Errorf("%w EventAction", common.ErrNilPointer)
+		}
+		a := EventAction{
+			Type:     actions[i].Type,
+			Amount:   actions[i].Amount,
+			Price:    actions[i].Price,
+			OrderID:  actions[i].OrderId,
+			Script:   actions[i].Script,
+			Relayers: actions[i].Relayers,
+			Message:  actions[i].Message,
+		}
+		var err error
+		if actions[i].Side != "" {
+			a.Side, err = order.StringToOrderSide(actions[i].Side)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if actions[i].OrderType != "" {
+			a.OrderType, err = order.StringToOrderType(actions[i].OrderType)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp = append(resp, a)
+	}
+	return resp, nil
+}
+
+func eventRuleToRPC(r *EventRule) *gctrpc.EventRule {
+	rule := &gctrpc.EventRule{
+		Item:     r.Item,
+		Operator: r.Operator,
+		ConditionParams: &gctrpc.ConditionParams{
+			Condition:       r.Condition.Condition,
+			Price:           r.Condition.Price,
+			CheckBids:       r.Condition.CheckBids,
+			CheckAsks:       r.Condition.CheckAsks,
+			OrderbookAmount: r.Condition.OrderbookAmount,
+			Value:           r.Condition.Value,
+			Interval:        int64(r.Condition.Interval),
+			Period:          r.Condition.Period,
+			FastPeriod:      r.Condition.FastPeriod,
+			SlowPeriod:      r.Condition.SlowPeriod,
+			SignalPeriod:    r.Condition.SignalPeriod,
+			StdDev:          r.Condition.StdDev,
+			Currency:        r.Condition.Currency.String(),
+		},
+	}
+	for i := range r.Rules {
+		rule.Rules = append(rule.Rules, eventRuleToRPC(&r.Rules[i]))
+	}
+	return rule
+}
+
+func eventToRPC(e *Event) *gctrpc.Event {
+	resp := &gctrpc.Event{
+		Id:       e.ID,
+		Exchange: e.Exchange,
+		Rule:     eventRuleToRPC(&e.EventRule),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: e.Pair.Delimiter,
+			Base:      e.Pair.Base.String(),
+			Quote:     e.Pair.Quote.String(),
+		},
+		AssetType:    e.Asset.String(),
+		Action:       e.Action,
+		Actions:      make([]*gctrpc.EventAction, len(e.Actions)),
+		Repeat:       e.Repeat,
+		Cooldown:     int64(e.Cooldown),
+		TriggerCount: e.TriggerCount,
+	}
+	if !e.LastTriggered.IsZero() {
+		resp.LastTriggered = timestamppb.New(e.LastTriggered)
+	}
+	for i := range e.Actions {
+		a := &gctrpc.EventAction{
+			Type:     e.Actions[i].Type,
+			Amount:   e.Actions[i].Amount,
+			Price:    e.Actions[i].Price,
+			OrderId:  e.Actions[i].OrderID,
+			Script:   e.Actions[i].Script,
+			Relayers: e.Actions[i].Relayers,
+			Message:  e.Actions[i].Message,
+		}
+		if e.Actions[i].Type == ActionSubmitOrder {
+			a.Side = e.Actions[i].Side.String()
+			a.OrderType = e.Actions[i].OrderType.String()
+		}
+		resp.Actions[i] = a
+	}
+	return resp
+}
+
 // RemoveEvent removes an event, specified by an event ID
 func (s *RPCServer) RemoveEvent(_ context.Context, r *gctrpc.RemoveEventRequest) (*gctrpc.GenericResponse, error) {
 	if !s.eventManager.Remove(r.Id) {
@@ -5942,3 +6181,699 @@ func (s *RPCServer) GetCurrencyTradeURL(ctx context.Context, r *gctrpc.GetCurren
 		Url: url,
 	}, nil
 }
+
+// SubmitConditionalOrder stores a stop, take profit, trailing stop or OCO order
+// within the order manager which is submitted to the exchange as a market or
+// limit order once its trigger price is reached
+func (s *RPCServer) SubmitConditionalOrder(_ context.Context, r *gctrpc.SubmitConditionalOrderRequest) (*gctrpc.ConditionalOrdersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w SubmitConditionalOrderRequest", common.ErrNilPointer)
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	oType, err := order.StringToOrderType(r.OrderType)
+	if err != nil {
+		return nil, err
+	}
+	if !IsConditionalOrderType(oType) {
+		return nil, fmt.Errorf("%w %v", errConditionalTypeUnsupported, oType)
+	}
+
+	submission := &order.Submit{
+		Exchange:      r.Exchange,
+		Pair:          p,
+		AssetType:     a,
+		Side:          side,
+		Type:          oType,
+		Amount:        r.Amount,
+		Price:         r.LimitPrice,
+		TriggerPrice:  r.TriggerPrice,
+		TrackingMode:  order.StringToTrackingMode(r.TrackingMode),
+		TrackingValue: r.TrackingValue,
+		ClientID:      r.ClientId,
+		ClientOrderID: r.ClientId,
+		RiskManagementModes: order.RiskManagementModes{
+			TakeProfit: order.RiskManagement{
+				Enabled:    r.TakeProfitPrice > 0,
+				Price:      r.TakeProfitPrice,
+				LimitPrice: r.TakeProfitLimitPrice,
+			},
+			StopLoss: order.RiskManagement{
+				Enabled:    r.StopLossPrice > 0,
+				Price:      r.StopLossPrice,
+				LimitPrice: r.StopLossLimitPrice,
+			},
+		},
+	}
+	orders, err := s.OrderManager.SubmitConditional(submission)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ConditionalOrdersResponse{Orders: conditionalOrdersToRPC(orders)}, nil
+}
+
+// GetConditionalOrders returns conditional orders held by the order manager
+func (s *RPCServer) GetConditionalOrders(_ context.Context, r *gctrpc.GetConditionalOrdersRequest) (*gctrpc.ConditionalOrdersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetConditionalOrdersRequest", common.ErrNilPointer)
+	}
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	orders, err := s.OrderManager.GetConditionalOrders(r.Exchange, !r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ConditionalOrdersResponse{Orders: conditionalOrdersToRPC(orders)}, nil
+}
+
+// CancelConditionalOrder cancels a pending conditional order and any linked OCO
+// leg held by the order manager
+func (s *RPCServer) CancelConditionalOrder(_ context.Context, r *gctrpc.CancelConditionalOrderRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w CancelConditionalOrderRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.OrderManager.CancelConditional(id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{
+		Status: MsgStatusSuccess,
+		Data:   fmt.Sprintf("conditional order %s cancelled", r.Id),
+	}, nil
+}
+
+// SetRiskKillSwitch engages or releases the order manager risk kill switch.
+// Engaging the kill switch cancels all orders on every exchange
+func (s *RPCServer) SetRiskKillSwitch(ctx context.Context, r *gctrpc.SetRiskKillSwitchRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w SetRiskKillSwitchRequest", common.ErrNilPointer)
+	}
+	if !r.Engaged {
+		if err := s.OrderManager.ReleaseKillSwitch(); err != nil {
+			return nil, err
+		}
+		return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "risk kill switch released"}, nil
+	}
+	if err := s.OrderManager.EngageKillSwitch(ctx); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess, Data: "risk kill switch engaged"}, nil
+}
+
+// riskRejectionToRPCError converts an order manager risk rejection to a gRPC
+// status error so clients can distinguish it from other failures
+func riskRejectionToRPCError(err error) error {
+	var rejection *RiskRejectionError
+	if !errors.As(err, &rejection) {
+		return err
+	}
+	code := codes.FailedPrecondition
+	switch {
+	case errors.Is(rejection, ErrOrderRateExceeded):
+		code = codes.ResourceExhausted
+	case errors.Is(rejection, ErrKillSwitchEngaged):
+		code = codes.Unavailable
+	}
+	return status.Error(code, rejection.Error())
+}
+
+func conditionalOrdersToRPC(orders []ConditionalOrder) []*gctrpc.ConditionalOrder {
+	resp := make([]*gctrpc.ConditionalOrder, len(orders))
+	for i := range orders {
+		resp[i] = &gctrpc.ConditionalOrder{
+			Id:       orders[i].ID.String(),
+			Exchange: orders[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: orders[i].Pair.Delimiter,
+				Base:      orders[i].Pair.Base.String(),
+				Quote:     orders[i].Pair.Quote.String(),
+			},
+			Asset:         orders[i].AssetType.String(),
+			Side:          orders[i].Side.String(),
+			OrderType:     orders[i].Type.String(),
+			Amount:        orders[i].Amount,
+			TriggerPrice:  orders[i].TriggerPrice,
+			LimitPrice:    orders[i].LimitPrice,
+			TrackingMode:  orders[i].TrackingMode.String(),
+			TrackingValue: orders[i].TrackingValue,
+			Status:        orders[i].Status.String(),
+			OrderId:       orders[i].OrderID,
+			Error:         orders[i].Error,
+			CreatedAt:     timestamppb.New(orders[i].CreatedAt),
+			UpdatedAt:     timestamppb.New(orders[i].LastUpdated),
+		}
+		if !orders[i].LinkedID.IsNil() {
+			resp[i].LinkedId = orders[i].LinkedID.String()
+		}
+		if !orders[i].TriggeredAt.IsZero() {
+			resp[i].TriggeredAt = timestamppb.New(orders[i].TriggeredAt)
+		}
+	}
+	return resp
+}
+
+// GetRecordedOrderbook reconstructs an orderbook from the orderbook recorder as
+// it was at the requested time
+func (s *RPCServer) GetRecordedOrderbook(_ context.Context, r *gctrpc.GetRecordedOrderbookRequest) (*gctrpc.OrderbookResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetRecordedOrderbookRequest", common.ErrNilPointer)
+	}
+	if r.Pair == nil {
+		return nil, currency.ErrCurrencyPairEmpty
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	at, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse timestamp %v", errInvalidTimes, err)
+	}
+	pair := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	ob, err := s.orderbookRecorder.GetRecordedOrderbook(r.Exchange, a, pair, at)
+	if err != nil {
+		return nil, err
+	}
+
+	bids := make([]*gctrpc.OrderbookItem, len(ob.Bids))
+	for x := range ob.Bids {
+		bids[x] = &gctrpc.OrderbookItem{Amount: ob.Bids[x].Amount, Price: ob.Bids[x].Price}
+	}
+	asks := make([]*gctrpc.OrderbookItem, len(ob.Asks))
+	for x := range ob.Asks {
+		asks[x] = &gctrpc.OrderbookItem{Amount: ob.Asks[x].Amount, Price: ob.Asks[x].Price}
+	}
+	return &gctrpc.OrderbookResponse{
+		Pair:        r.Pair,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: s.unixTimestamp(ob.LastUpdated),
+		AssetType:   r.AssetType,
+	}, nil
+}
+
+// SubmitAlgoOrder submits a parent order to the algo execution manager which
+// executes it as TWAP, VWAP, iceberg or chase child orders
+func (s *RPCServer) SubmitAlgoOrder(ctx context.Context, r *gctrpc.SubmitAlgoOrderRequest) (*gctrpc.AlgoOrdersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w SubmitAlgoOrderRequest", common.ErrNilPointer)
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return nil, err
+	}
+	algoType, err := StringToAlgoType(r.AlgoType)
+	if err != nil {
+		return nil, err
+	}
+	oType := order.Limit
+	if r.OrderType != "" {
+		oType, err = order.StringToOrderType(r.OrderType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ao, err := s.algoExecutionManager.SubmitAlgo(ctx, &order.Submit{
+		Exchange:      r.Exchange,
+		Pair:          p,
+		AssetType:     a,
+		Side:          side,
+		Type:          oType,
+		Amount:        r.Amount,
+		Price:         r.Price,
+		ClientID:      r.ClientId,
+		ClientOrderID: r.ClientId,
+	}, AlgoParameters{
+		Type:          algoType,
+		Duration:      time.Duration(r.Duration),
+		Slices:        int(r.Slices),
+		Interval:      kline.Interval(r.Interval),
+		VisibleAmount: r.VisibleAmount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.AlgoOrdersResponse{Orders: s.algoOrdersToRPC([]AlgoOrder{*ao})}, nil
+}
+
+// GetAlgoOrders returns algo orders with their progress and child orders
+func (s *RPCServer) GetAlgoOrders(_ context.Context, r *gctrpc.GetAlgoOrdersRequest) (*gctrpc.AlgoOrdersResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w GetAlgoOrdersRequest", common.ErrNilPointer)
+	}
+	if r.Exchange != "" {
+		if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+			return nil, err
+		}
+	}
+	orders, err := s.algoExecutionManager.GetAlgoOrders(r.Exchange, !r.IncludeInactive)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.AlgoOrdersResponse{Orders: s.algoOrdersToRPC(orders)}, nil
+}
+
+// CancelAlgoOrder stops a running algo order and cancels its open child
+// orders
+func (s *RPCServer) CancelAlgoOrder(ctx context.Context, r *gctrpc.CancelAlgoOrderRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w CancelAlgoOrderRequest", common.ErrNilPointer)
+	}
+	id, err := uuid.FromString(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.algoExecutionManager.CancelAlgo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{
+		Status: MsgStatusSuccess,
+		Data:   fmt.Sprintf("algo order %s cancelled", r.Id),
+	}, nil
+}
+
+// algoOrdersToRPC converts algo orders to their RPC representation, including
+// the child orders linked to each in the order store
+func (s *RPCServer) algoOrdersToRPC(orders []AlgoOrder) []*gctrpc.AlgoOrder {
+	resp := make([]*gctrpc.AlgoOrder, len(orders))
+	for i := range orders {
+		resp[i] = &gctrpc.AlgoOrder{
+			Id:       orders[i].ID.String(),
+			Exchange: orders[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: orders[i].Pair.Delimiter,
+				Base:      orders[i].Pair.Base.String(),
+				Quote:     orders[i].Pair.Quote.String(),
+			},
+			Asset:           orders[i].AssetType.String(),
+			Side:            orders[i].Side.String(),
+			AlgoType:        orders[i].Type.String(),
+			OrderType:       orders[i].OrderType.String(),
+			Price:           orders[i].Price,
+			Amount:          orders[i].Amount,
+			SubmittedAmount: orders[i].SubmittedAmount,
+			FilledAmount:    orders[i].FilledAmount,
+			Progress:        orders[i].FilledAmount / orders[i].Amount * 100,
+			Status:          orders[i].Status.String(),
+			Error:           orders[i].Error,
+			Duration:        int64(orders[i].Duration),
+			Slices:          int64(orders[i].Slices),
+			Interval:        int64(orders[i].Interval),
+			VisibleAmount:   orders[i].VisibleAmount,
+			CreatedAt:       timestamppb.New(orders[i].CreatedAt),
+			UpdatedAt:       timestamppb.New(orders[i].LastUpdated),
+		}
+		// child orders are looked up by ID as their links to the algo order
+		// are removed once it has finished
+		resp[i].ChildOrders = make([]*gctrpc.AlgoChildOrder, 0, len(orders[i].ChildOrderIDs))
+		for _, orderID := range orders[i].ChildOrderIDs {
+			child, err := s.OrderManager.GetByExchangeAndID(orders[i].Exchange, orderID)
+			if err != nil {
+				continue
+			}
+			resp[i].ChildOrders = append(resp[i].ChildOrders, &gctrpc.AlgoChildOrder{
+				OrderId:        child.OrderID,
+				Status:         child.Status.String(),
+				Price:          child.Price,
+				Amount:         child.Amount,
+				ExecutedAmount: getExecutedAmount(child),
+			})
+		}
+	}
+	return resp
+}
+
+// GetCandleStream streams the candles closed by the candle aggregator for an
+// exchange pair at the requested interval
+func (s *RPCServer) GetCandleStream(r *gctrpc.GetCandleStreamRequest, stream gctrpc.GoCryptoTraderService_GetCandleStreamServer) error {
+	if r == nil {
+		return fmt.Errorf("%w GetCandleStreamRequest", common.ErrNilPointer)
+	}
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Pair == nil {
+		return errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return err
+	}
+	pipe, err := s.candleAggregator.SubscribeCandles(r.Exchange, p, a, kline.Interval(r.TimeInterval))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if pipeErr := pipe.Release(); pipeErr != nil {
+			log.Errorln(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		var data any
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case d, ok := <-pipe.Channel():
+			if !ok {
+				return errDispatchSystem
+			}
+			data = d
+		}
+		item, ok := data.(*kline.Item)
+		if !ok {
+			return common.GetTypeAssertError("*kline.Item", data)
+		}
+		resp := &gctrpc.GetHistoricCandlesResponse{
+			Exchange: item.Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      item.Pair.Base.String(),
+				Quote:     item.Pair.Quote.String(),
+				Delimiter: item.Pair.Delimiter,
+			},
+			Interval: item.Interval.Short(),
+			Start:    item.Candles[0].Time.UTC().Format(common.SimpleTimeFormatWithTimezone),
+			End:      item.Candles[len(item.Candles)-1].Time.Add(item.Interval.Duration()).UTC().Format(common.SimpleTimeFormatWithTimezone),
+			Candle:   make([]*gctrpc.Candle, len(item.Candles)),
+		}
+		for i := range item.Candles {
+			resp.Candle[i] = &gctrpc.Candle{
+				Time:   item.Candles[i].Time.UTC().Format(common.SimpleTimeFormatWithTimezone),
+				Low:    item.Candles[i].Low,
+				High:   item.Candles[i].High,
+				Open:   item.Candles[i].Open,
+				Close:  item.Candles[i].Close,
+				Volume: item.Candles[i].Volume,
+			}
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// GetPortfolioValuation values all current portfolio holdings in the fiat
+// display currency
+func (s *RPCServer) GetPortfolioValuation(_ context.Context, _ *gctrpc.GetPortfolioValuationRequest) (*gctrpc.PortfolioValuation, error) {
+	snapshot, err := s.portfolioManager.GetValuation(s.Config.Currency.FiatDisplayCurrency)
+	if err != nil {
+		return nil, err
+	}
+	return portfolioValuationToRPC(snapshot), nil
+}
+
+// GetPortfolioValuationHistory returns the saved portfolio valuation snapshots
+// between the start and end dates along with the change in value and the
+// maximum drawdown over the period
+func (s *RPCServer) GetPortfolioValuationHistory(_ context.Context, r *gctrpc.GetPortfolioValuationHistoryRequest) (*gctrpc.GetPortfolioValuationHistoryResponse, error) {
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	err = common.StartEndTimeCheck(start, end)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := s.portfolioManager.GetValuationHistory(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPortfolioValuationHistoryResponse{
+		Valuations: make([]*gctrpc.PortfolioValuation, len(snapshots)),
+	}
+	var peak float64
+	for i := range snapshots {
+		resp.Valuations[i] = portfolioValuationToRPC(&snapshots[i])
+		total := resp.Valuations[i].TotalValue
+		if total > peak {
+			peak = total
+			continue
+		}
+		if drawdown := peak - total; drawdown > resp.MaxDrawdown {
+			resp.MaxDrawdown = drawdown
+		}
+		if peak > 0 {
+			if percentage := (peak - total) / peak * 100; percentage > resp.MaxDrawdownPercentage {
+				resp.MaxDrawdownPercentage = percentage
+			}
+		}
+	}
+	if len(snapshots) == 0 {
+		return resp, nil
+	}
+	resp.StartValue = resp.Valuations[0].TotalValue
+	resp.EndValue = resp.Valuations[len(resp.Valuations)-1].TotalValue
+	resp.Change = resp.EndValue - resp.StartValue
+
+	startValues := currencyValues(&snapshots[0])
+	endValues := currencyValues(&snapshots[len(snapshots)-1])
+	codes := slices.Collect(maps.Keys(startValues))
+	for code := range endValues {
+		if _, ok := startValues[code]; !ok {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes)
+	resp.CurrencyChanges = make([]*gctrpc.PortfolioCurrencyValueChange, len(codes))
+	for i, code := range codes {
+		resp.CurrencyChanges[i] = &gctrpc.PortfolioCurrencyValueChange{
+			Currency:   code,
+			StartValue: startValues[code],
+			EndValue:   endValues[code],
+			Change:     endValues[code] - startValues[code],
+		}
+	}
+	return resp, nil
+}
+
+func portfolioValuationToRPC(s *portfoliovaluation.Snapshot) *gctrpc.PortfolioValuation {
+	resp := &gctrpc.PortfolioValuation{
+		Timestamp:    s.Timestamp.UTC().Format(common.SimpleTimeFormatWithTimezone),
+		FiatCurrency: s.FiatCurrency,
+		Holdings:     make([]*gctrpc.PortfolioValuationHolding, len(s.Holdings)),
+	}
+	exchangeValues := make(map[string]float64)
+	for i := range s.Holdings {
+		resp.Holdings[i] = &gctrpc.PortfolioValuationHolding{
+			Exchange: s.Holdings[i].Exchange,
+			Currency: s.Holdings[i].Currency,
+			Balance:  s.Holdings[i].Balance,
+			Price:    s.Holdings[i].Price,
+			Value:    s.Holdings[i].Value,
+		}
+		resp.TotalValue += s.Holdings[i].Value
+		exchangeValues[s.Holdings[i].Exchange] += s.Holdings[i].Value
+	}
+	for _, exch := range slices.Sorted(maps.Keys(exchangeValues)) {
+		resp.Exchanges = append(resp.Exchanges, &gctrpc.PortfolioExchangeValuation{
+			Exchange: exch,
+			Value:    exchangeValues[exch],
+		})
+	}
+	return resp
+}
+
+// currencyValues returns the total value of each currency held in a snapshot
+func currencyValues(s *portfoliovaluation.Snapshot) map[string]float64 {
+	values := make(map[string]float64)
+	for i := range s.Holdings {
+		values[s.Holdings[i].Currency] += s.Holdings[i].Value
+	}
+	return values
+}
+
+// GetCostBasisReport builds tax lots from the spot trades and transfers of
+// exchanges and returns the gains realised between the start and end dates
+func (s *RPCServer) GetCostBasisReport(ctx context.Context, r *gctrpc.GetCostBasisReportRequest) (*gctrpc.GetCostBasisReportResponse, error) {
+	method, err := costbasis.ParseMethod(r.Method)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormatWithTimezone, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	err = common.StartEndTimeCheck(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var exchanges []exchange.IBotExchange
+	if len(r.Exchanges) == 0 {
+		exchs, err := s.ExchangeManager.GetExchanges()
+		if err != nil {
+			return nil, err
+		}
+		for _, exch := range exchs {
+			if exch.IsRESTAuthenticationSupported() {
+				exchanges = append(exchanges, exch)
+			}
+		}
+	}
+	for _, name := range r.Exchanges {
+		exch, err := s.GetExchangeByName(name)
+		if err != nil {
+			return nil, err
+		}
+		if !exch.IsRESTAuthenticationSupported() {
+			return nil, fmt.Errorf("%s, %w", name, exchange.ErrAuthenticationSupportNotEnabled)
+		}
+		exchanges = append(exchanges, exch)
+	}
+
+	report, err := buildCostBasisReport(ctx, exchanges, s.Config.Currency.FiatDisplayCurrency, method, start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetCostBasisReportResponse{
+		Method:       report.Method.String(),
+		FiatCurrency: s.Config.Currency.FiatDisplayCurrency.String(),
+		Gains:        make([]*gctrpc.CostBasisGain, len(report.Gains)),
+		Lots:         make([]*gctrpc.CostBasisLot, len(report.Lots)),
+	}
+	for i := range report.Gains {
+		var acquired string
+		if !report.Gains[i].Acquired.IsZero() {
+			acquired = report.Gains[i].Acquired.UTC().Format(common.SimpleTimeFormatWithTimezone)
+		}
+		resp.Gains[i] = &gctrpc.CostBasisGain{
+			Exchange:  report.Gains[i].Exchange,
+			Currency:  report.Gains[i].Currency.String(),
+			Amount:    report.Gains[i].Amount,
+			Acquired:  acquired,
+			Disposed:  report.Gains[i].Disposed.UTC().Format(common.SimpleTimeFormatWithTimezone),
+			Proceeds:  report.Gains[i].Proceeds,
+			CostBasis: report.Gains[i].CostBasis,
+			Gain:      report.Gains[i].Gain,
+			LotId:     report.Gains[i].LotID,
+			Unmatched: report.Gains[i].Unmatched,
+			Reference: report.Gains[i].Reference,
+		}
+		resp.TotalProceeds += report.Gains[i].Proceeds
+		resp.TotalCostBasis += report.Gains[i].CostBasis
+		resp.TotalGain += report.Gains[i].Gain
+	}
+	for i := range report.Lots {
+		resp.Lots[i] = &gctrpc.CostBasisLot{
+			Id:        report.Lots[i].ID,
+			Exchange:  report.Lots[i].Exchange,
+			Currency:  report.Lots[i].Currency.String(),
+			Acquired:  report.Lots[i].Acquired.UTC().Format(common.SimpleTimeFormatWithTimezone),
+			Amount:    report.Lots[i].Amount,
+			Remaining: report.Lots[i].Remaining,
+			UnitCost:  report.Lots[i].UnitCost,
+			Reference: report.Lots[i].Reference,
+		}
+	}
+	return resp, nil
+}
+
+// GetPendingWithdrawals returns the withdrawal requests awaiting approval
+func (s *RPCServer) GetPendingWithdrawals(_ context.Context, _ *gctrpc.GetPendingWithdrawalsRequest) (*gctrpc.GetPendingWithdrawalsResponse, error) {
+	pending, err := s.WithdrawManager.GetPendingWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPendingWithdrawalsResponse{
+		Withdrawals: make([]*gctrpc.PendingWithdrawal, len(pending)),
+	}
+	for i := range pending {
+		req := &pending[i].Request
+		w := &gctrpc.PendingWithdrawal{
+			Id:          pending[i].ID.String(),
+			Exchange:    req.Exchange,
+			Currency:    req.Currency.String(),
+			Amount:      req.Amount,
+			Description: req.Description,
+			CreatedAt:   timestamppb.New(pending[i].CreatedAt),
+			ExpiresAt:   timestamppb.New(pending[i].ExpiresAt),
+		}
+		switch req.Type {
+		case withdraw.Crypto:
+			w.Type = "crypto"
+			w.Address = req.Crypto.Address
+			w.AddressTag = req.Crypto.AddressTag
+			w.Chain = req.Crypto.Chain
+		case withdraw.Fiat:
+			w.Type = "fiat"
+			w.BankAccountId = req.Fiat.Bank.ID
+		}
+		resp.Withdrawals[i] = w
+	}
+	return resp, nil
+}
+
+// ApproveWithdrawal submits a pending withdrawal request to its exchange
+func (s *RPCServer) ApproveWithdrawal(ctx context.Context, r *gctrpc.ApproveWithdrawalRequest) (*gctrpc.WithdrawResponse, error) {
+	resp, err := s.WithdrawManager.ApproveWithdrawal(ctx, r.Id, r.Code)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.WithdrawResponse{
+		Id:     resp.ID.String(),
+		Status: resp.Exchange.Status,
+	}, nil
+}
+
+// RejectWithdrawal removes a pending withdrawal request without submitting it
+func (s *RPCServer) RejectWithdrawal(_ context.Context, r *gctrpc.RejectWithdrawalRequest) (*gctrpc.GenericResponse, error) {
+	if err := s.WithdrawManager.RejectWithdrawal(r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/portfoliovaluation"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -50,9 +51,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/costbasis"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 	"github.com/thrasher-corp/goose"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -4292,3 +4296,491 @@ func TestGetCurrencyTradeURL(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, resp.Url)
 }
+
+func TestConditionalOrdersRPC(t *testing.T) {
+	t.Parallel()
+	m := conditionalOrdersSetup(t, false)
+	em, ok := m.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: m}}
+
+	_, err := s.SubmitConditionalOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.SubmitConditionalOrderRequest{
+		Exchange:  testExchange,
+		Asset:     asset.Spot.String(),
+		Side:      order.Sell.String(),
+		OrderType: order.Limit.String(),
+		Amount:    1,
+	}
+	_, err = s.SubmitConditionalOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"}
+	_, err = s.SubmitConditionalOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errConditionalTypeUnsupported)
+
+	req.OrderType = order.OCO.String()
+	req.TakeProfitPrice = 1100
+	req.StopLossPrice = 900
+	resp, err := s.SubmitConditionalOrder(t.Context(), req)
+	require.NoError(t, err)
+	require.Len(t, resp.Orders, 2)
+	assert.Equal(t, resp.Orders[1].Id, resp.Orders[0].LinkedId)
+
+	_, err = s.GetConditionalOrders(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.GetConditionalOrders(t.Context(), &gctrpc.GetConditionalOrdersRequest{Exchange: "fake"})
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	list, err := s.GetConditionalOrders(t.Context(), &gctrpc.GetConditionalOrdersRequest{Exchange: testExchange})
+	require.NoError(t, err)
+	assert.Len(t, list.Orders, 2)
+
+	_, err = s.CancelConditionalOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.CancelConditionalOrder(t.Context(), &gctrpc.CancelConditionalOrderRequest{Id: "bad"})
+	assert.Error(t, err)
+
+	_, err = s.CancelConditionalOrder(t.Context(), &gctrpc.CancelConditionalOrderRequest{Id: resp.Orders[0].Id})
+	require.NoError(t, err)
+
+	list, err = s.GetConditionalOrders(t.Context(), &gctrpc.GetConditionalOrdersRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list.Orders)
+}
+
+func TestRiskRPC(t *testing.T) {
+	t.Parallel()
+	m, _ := riskSetup(t, config.RiskLimit{MaxNotional: 1000, MaxOrdersPerMinute: 2})
+	em, ok := m.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: m}}
+
+	req := &gctrpc.SubmitOrderRequest{
+		Exchange:  testExchange,
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: asset.Spot.String(),
+		Side:      order.Buy.String(),
+		OrderType: order.Limit.String(),
+		Price:     100,
+		Amount:    11,
+	}
+	_, err := s.SubmitOrder(t.Context(), req)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	req.Amount = 1
+	_, err = s.SubmitOrder(t.Context(), req)
+	require.NoError(t, err, "SubmitOrder must not error")
+	_, err = s.SubmitOrder(t.Context(), req)
+	require.NoError(t, err, "SubmitOrder must not error")
+	_, err = s.SubmitOrder(t.Context(), req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	_, err = s.SetRiskKillSwitch(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.SetRiskKillSwitch(t.Context(), &gctrpc.SetRiskKillSwitchRequest{})
+	assert.ErrorIs(t, err, errKillSwitchNotEngaged)
+
+	_, err = s.SetRiskKillSwitch(t.Context(), &gctrpc.SetRiskKillSwitchRequest{Engaged: true})
+	require.NoError(t, err, "SetRiskKillSwitch must not error")
+	_, err = s.SubmitOrder(t.Context(), req)
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+
+	_, err = s.SetRiskKillSwitch(t.Context(), &gctrpc.SetRiskKillSwitchRequest{})
+	require.NoError(t, err, "SetRiskKillSwitch must not error")
+	assert.False(t, m.IsKillSwitchEngaged())
+
+	assert.NoError(t, riskRejectionToRPCError(nil))
+	assert.ErrorIs(t, riskRejectionToRPCError(errKillSwitchNotEngaged), errKillSwitchNotEngaged, "other errors should be returned unchanged")
+}
+
+func TestSimulateSmartOrderRPC(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ROUTERPC")
+	m := routingSetup(t, pair, map[string]float64{routingSecondExchange: 0.01})
+	em, ok := m.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: m}}
+
+	_, err := s.SimulateSmartOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.SimulateSmartOrderRequest{
+		AssetType: asset.Spot.String(),
+		Side:      order.Buy.String(),
+		OrderType: order.Market.String(),
+		Amount:    2,
+	}
+	_, err = s.SimulateSmartOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Base: pair.Base.String(), Quote: pair.Quote.String()}
+	processRoutingOrderbook(t, testExchange, pair, nil, orderbook.Tranches{{Price: 100, Amount: 1}})
+	processRoutingOrderbook(t, routingSecondExchange, pair, nil, orderbook.Tranches{{Price: 99.5, Amount: 5}})
+	processRoutingBalance(t, testExchange, currency.USD, 1000)
+	resp, err := s.SimulateSmartOrder(t.Context(), req)
+	require.NoError(t, err, "SimulateSmartOrder must not error")
+	assert.Equal(t, 1.0, resp.RoutedAmount, "only exchanges with a balance should be routed to")
+	require.Len(t, resp.Orders, 1)
+	assert.Equal(t, testExchange, resp.Orders[0].Exchange)
+	require.Len(t, resp.Rejections, 1)
+	assert.Equal(t, routingSecondExchange, resp.Rejections[0].Exchange)
+	assert.NotEmpty(t, resp.Rejections[0].Reason)
+}
+
+func TestGetRecordedOrderbookRPC(t *testing.T) {
+	t.Parallel()
+	r := newTestOrderbookRecorder(t)
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}, orderbookRecorder: r}}
+
+	_, err := s.GetRecordedOrderbook(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.GetRecordedOrderbookRequest{
+		Exchange:  testExchange,
+		AssetType: asset.Spot.String(),
+	}
+	_, err = s.GetRecordedOrderbook(t.Context(), req)
+	assert.ErrorIs(t, err, currency.ErrCurrencyPairEmpty)
+
+	req.Pair = &gctrpc.CurrencyPair{Base: recorderTestPair.Base.String(), Quote: recorderTestPair.Quote.String()}
+	_, err = s.GetRecordedOrderbook(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidTimes)
+
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	r.record(recorderTestBook(tt, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 101, Amount: 2}}))
+	req.Timestamp = tt.Add(time.Second).Format(common.SimpleTimeFormatWithTimezone)
+	resp, err := s.GetRecordedOrderbook(t.Context(), req)
+	require.NoError(t, err, "GetRecordedOrderbook must not error")
+	require.Len(t, resp.Bids, 1, "response must contain the recorded bids")
+	require.Len(t, resp.Asks, 1, "response must contain the recorded asks")
+	assert.Equal(t, 2.0, resp.Asks[0].Amount, "ask amount should match the recorded amount")
+}
+
+func TestAlgoOrderRPC(t *testing.T) {
+	t.Parallel()
+	pair := routingPair("ALGORPC")
+	a, m := algoSetup(t, pair)
+	em, ok := m.orderStore.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, OrderManager: m, algoExecutionManager: a}}
+
+	_, err := s.SubmitAlgoOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.GetAlgoOrders(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+	_, err = s.CancelAlgoOrder(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.SubmitAlgoOrderRequest{
+		Exchange:      testExchange,
+		Asset:         asset.Spot.String(),
+		Side:          order.Sell.String(),
+		AlgoType:      "iceberg",
+		Amount:        2,
+		Price:         100,
+		VisibleAmount: 0.5,
+	}
+	_, err = s.SubmitAlgoOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+	req.Pair = &gctrpc.CurrencyPair{Base: pair.Base.String(), Quote: pair.Quote.String()}
+	req.AlgoType = "POV"
+	_, err = s.SubmitAlgoOrder(t.Context(), req)
+	assert.ErrorIs(t, err, errAlgoTypeUnsupported)
+	req.AlgoType = "iceberg"
+	resp, err := s.SubmitAlgoOrder(t.Context(), req)
+	require.NoError(t, err, "SubmitAlgoOrder must not error")
+	require.Len(t, resp.Orders, 1)
+	assert.Equal(t, IcebergAlgo.String(), resp.Orders[0].AlgoType)
+	assert.Equal(t, AlgoRunning.String(), resp.Orders[0].Status)
+
+	a.processAlgoOrders(t.Context())
+	id, err := uuid.FromString(resp.Orders[0].Id)
+	require.NoError(t, err, "FromString must not error")
+	fillChildOrders(t, a, m, id)
+	orders, err := s.GetAlgoOrders(t.Context(), &gctrpc.GetAlgoOrdersRequest{Exchange: testExchange})
+	require.NoError(t, err, "GetAlgoOrders must not error")
+	require.Len(t, orders.Orders, 1)
+	assert.Equal(t, 25.0, orders.Orders[0].Progress)
+	require.Len(t, orders.Orders[0].ChildOrders, 2, "child orders should be returned from the order store")
+	assert.Equal(t, order.Filled.String(), orders.Orders[0].ChildOrders[0].Status)
+	assert.Equal(t, 0.5, orders.Orders[0].ChildOrders[0].ExecutedAmount)
+
+	_, err = s.CancelAlgoOrder(t.Context(), &gctrpc.CancelAlgoOrderRequest{Id: "bad"})
+	assert.Error(t, err, "CancelAlgoOrder should error on an invalid ID")
+	_, err = s.CancelAlgoOrder(t.Context(), &gctrpc.CancelAlgoOrderRequest{Id: resp.Orders[0].Id})
+	require.NoError(t, err, "CancelAlgoOrder must not error")
+	orders, err = s.GetAlgoOrders(t.Context(), &gctrpc.GetAlgoOrdersRequest{})
+	require.NoError(t, err, "GetAlgoOrders must not error")
+	assert.Empty(t, orders.Orders, "cancelled algo orders should not be returned unless requested")
+	orders, err = s.GetAlgoOrders(t.Context(), &gctrpc.GetAlgoOrdersRequest{IncludeInactive: true})
+	require.NoError(t, err, "GetAlgoOrders must not error")
+	require.Len(t, orders.Orders, 1)
+	assert.Equal(t, AlgoCancelled.String(), orders.Orders[0].Status)
+}
+
+func TestEventRPC(t *testing.T) {
+	t.Parallel()
+	m, f := eventSetup(t)
+	pair := eventPair("EVTRPC")
+	b := f.GetBase()
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, false))
+	require.NoError(t, b.CurrencyPairs.StorePairs(asset.Spot, currency.Pairs{pair}, true))
+	em, ok := m.exchangeManager.(*ExchangeManager)
+	require.True(t, ok)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em, eventManager: m}}
+	rpcPair := &gctrpc.CurrencyPair{Base: pair.Base.String(), Quote: pair.Quote.String()}
+
+	_, err := s.AddEvent(t.Context(), nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = s.AddEvent(t.Context(), &gctrpc.AddEventRequest{
+		Exchange:  testExchange,
+		Pair:      rpcPair,
+		AssetType: asset.Spot.String(),
+		Item:      ItemPrice,
+		Actions:   []*gctrpc.EventAction{{Type: ActionSubmitOrder, Side: "sideways"}},
+	})
+	assert.ErrorIs(t, err, order.ErrSideIsInvalid)
+
+	legacy, err := s.AddEvent(t.Context(), &gctrpc.AddEventRequest{
+		Exchange:        testExchange,
+		Pair:            rpcPair,
+		AssetType:       asset.Spot.String(),
+		Item:            ItemPrice,
+		ConditionParams: &gctrpc.ConditionParams{Condition: ConditionGreaterThan, Price: 10},
+		Action:          ActionConsolePrint,
+	})
+	require.NoError(t, err, "AddEvent must not error")
+
+	composite, err := s.AddEvent(t.Context(), &gctrpc.AddEventRequest{
+		Exchange:  testExchange,
+		Pair:      rpcPair,
+		AssetType: asset.Spot.String(),
+		Rule: &gctrpc.EventRule{
+			Operator: OperatorAnd,
+			Rules: []*gctrpc.EventRule{
+				{Item: ItemRSI, ConditionParams: &gctrpc.ConditionParams{Condition: ConditionLessThan, Value: 30, Interval: int64(kline.FifteenMin)}},
+				{Item: ItemBalanceChange, ConditionParams: &gctrpc.ConditionParams{Condition: ConditionLessThan, Value: -1, Currency: "usd"}},
+			},
+		},
+		Actions: []*gctrpc.EventAction{
+			{Type: ActionSubmitOrder, Side: "buy", OrderType: "limit", Amount: 1, Price: 5},
+			{Type: ActionComms, Relayers: []string{"telegram"}},
+		},
+		Repeat:   true,
+		Cooldown: int64(time.Hour),
+	})
+	require.NoError(t, err, "AddEvent must not error")
+
+	resp, err := s.GetEvents(t.Context(), &gctrpc.GetEventsRequest{})
+	require.NoError(t, err, "GetEvents must not error")
+	require.Len(t, resp.Events, 2, "GetEvents must return all events")
+	assert.Equal(t, legacy.Id, resp.Events[0].Id)
+	assert.Equal(t, ItemPrice, resp.Events[0].Rule.Item)
+	assert.Equal(t, 10.0, resp.Events[0].Rule.ConditionParams.Price)
+
+	e := resp.Events[1]
+	assert.Equal(t, composite.Id, e.Id)
+	assert.Equal(t, OperatorAnd, e.Rule.Operator)
+	require.Len(t, e.Rule.Rules, 2)
+	assert.Equal(t, int64(kline.FifteenMin), e.Rule.Rules[0].ConditionParams.Interval)
+	assert.Equal(t, int64(defaultRSIPeriod), e.Rule.Rules[0].ConditionParams.Period, "default period should be returned")
+	assert.Equal(t, "USD", e.Rule.Rules[1].ConditionParams.Currency)
+	require.Len(t, e.Actions, 2)
+	assert.Equal(t, order.Buy.String(), e.Actions[0].Side)
+	assert.Equal(t, order.Limit.String(), e.Actions[0].OrderType)
+	assert.Equal(t, []string{"telegram"}, e.Actions[1].Relayers)
+	assert.True(t, e.Repeat)
+	assert.Equal(t, int64(time.Hour), e.Cooldown)
+	assert.Nil(t, e.LastTriggered, "LastTriggered should not be set before triggering")
+
+	_, err = s.RemoveEvent(t.Context(), &gctrpc.RemoveEventRequest{Id: legacy.Id})
+	require.NoError(t, err, "RemoveEvent must not error")
+	resp, err = s.GetEvents(t.Context(), &gctrpc.GetEventsRequest{})
+	require.NoError(t, err, "GetEvents must not error")
+	assert.Len(t, resp.Events, 1, "RemoveEvent should remove the event")
+}
+
+func TestGetCandleStream(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	err := s.GetCandleStream(nil, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	req := &gctrpc.GetCandleStreamRequest{}
+	err = s.GetCandleStream(req, nil)
+	assert.ErrorIs(t, err, errExchangeNameUnset)
+
+	req.Exchange = testExchange
+	err = s.GetCandleStream(req, nil)
+	assert.ErrorIs(t, err, errCurrencyPairUnset)
+
+	req.Pair = &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USDT.String()}
+	err = s.GetCandleStream(req, nil)
+	assert.ErrorIs(t, err, asset.ErrNotSupported)
+
+	req.AssetType = asset.Spot.String()
+	req.TimeInterval = int64(kline.OneMin)
+	err = s.GetCandleStream(req, nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem, "GetCandleStream should error when the candle aggregator is not setup")
+
+	s.candleAggregator, _ = candleAggregatorSetup(t, &config.CandleAggregator{Intervals: []kline.Interval{kline.OneMin}})
+	req.TimeInterval = int64(kline.OneHour)
+	err = s.GetCandleStream(req, nil)
+	assert.ErrorIs(t, err, errIntervalNotAggregated)
+}
+
+func TestGetPortfolioValuation(t *testing.T) {
+	t.Parallel()
+	setupValuationTestTickers(t)
+	m := setupValuationTestManager(t)
+	s := RPCServer{Engine: &Engine{Config: &config.Config{Currency: currency.Config{FiatDisplayCurrency: currency.USD}}, portfolioManager: m}}
+	_, err := s.GetPortfolioValuation(t.Context(), nil)
+	assert.ErrorIs(t, err, ErrSubSystemNotStarted)
+
+	m.started = 1
+	resp, err := s.GetPortfolioValuation(t.Context(), nil)
+	require.NoError(t, err, "GetPortfolioValuation must not error")
+	assert.Equal(t, "USD", resp.FiatCurrency)
+	assert.Equal(t, 30.0, resp.TotalValue)
+	assert.Len(t, resp.Holdings, 3)
+	require.Len(t, resp.Exchanges, 2, "exchange values must include personal holdings")
+	assert.Equal(t, &gctrpc.PortfolioExchangeValuation{Value: 10}, resp.Exchanges[0], "personal holdings should have no exchange")
+	assert.Equal(t, &gctrpc.PortfolioExchangeValuation{Exchange: valuationTestExchange, Value: 20}, resp.Exchanges[1])
+}
+
+func TestGetPortfolioValuationHistory(t *testing.T) {
+	t.Parallel()
+	m := setupValuationTestManager(t)
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}, portfolioManager: m}}
+	_, err := s.GetPortfolioValuationHistory(t.Context(), &gctrpc.GetPortfolioValuationHistoryRequest{})
+	assert.ErrorIs(t, err, errInvalidTimes)
+
+	tt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	req := &gctrpc.GetPortfolioValuationHistoryRequest{
+		Start: tt.Format(common.SimpleTimeFormatWithTimezone),
+		End:   tt.Add(-time.Hour).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	_, err = s.GetPortfolioValuationHistory(t.Context(), req)
+	assert.ErrorIs(t, err, common.ErrStartAfterEnd)
+
+	req.End = tt.Add(time.Hour * 4).Format(common.SimpleTimeFormatWithTimezone)
+	_, err = s.GetPortfolioValuationHistory(t.Context(), req)
+	assert.ErrorIs(t, err, errPortfolioValuationNotEnabled)
+
+	db := &fakeValuationDB{}
+	m.valuationDB = db
+	resp, err := s.GetPortfolioValuationHistory(t.Context(), req)
+	require.NoError(t, err, "GetPortfolioValuationHistory must not error")
+	assert.Empty(t, resp.Valuations)
+
+	db.history = []portfoliovaluation.Snapshot{
+		{Timestamp: tt, FiatCurrency: "USD", Holdings: []portfoliovaluation.Holding{
+			{Exchange: "binance", Currency: "BTC", Balance: 1, Price: 100, Value: 100},
+		}},
+		{Timestamp: tt.Add(time.Hour), FiatCurrency: "USD", Holdings: []portfoliovaluation.Holding{
+			{Exchange: "binance", Currency: "BTC", Balance: 1, Price: 200, Value: 200},
+		}},
+		{Timestamp: tt.Add(time.Hour * 2), FiatCurrency: "USD", Holdings: []portfoliovaluation.Holding{
+			{Exchange: "binance", Currency: "BTC", Balance: 1, Price: 150, Value: 150},
+		}},
+		{Timestamp: tt.Add(time.Hour * 3), FiatCurrency: "USD", Holdings: []portfoliovaluation.Holding{
+			{Exchange: "binance", Currency: "BTC", Balance: 0.5, Price: 180, Value: 90},
+			{Currency: "ETH", Balance: 1, Price: 60, Value: 60},
+		}},
+	}
+	resp, err = s.GetPortfolioValuationHistory(t.Context(), req)
+	require.NoError(t, err, "GetPortfolioValuationHistory must not error")
+	require.Len(t, resp.Valuations, 4, "GetPortfolioValuationHistory must return all snapshots")
+	assert.Equal(t, tt.Format(common.SimpleTimeFormatWithTimezone), resp.Valuations[0].Timestamp)
+	assert.Equal(t, 100.0, resp.StartValue)
+	assert.Equal(t, 150.0, resp.EndValue)
+	assert.Equal(t, 50.0, resp.Change)
+	assert.Equal(t, 50.0, resp.MaxDrawdown, "max drawdown should be measured from the highest peak")
+	assert.Equal(t, 25.0, resp.MaxDrawdownPercentage)
+	assert.Equal(t, []*gctrpc.PortfolioCurrencyValueChange{
+		{Currency: "BTC", StartValue: 100, EndValue: 90, Change: -10},
+		{Currency: "ETH", EndValue: 60, Change: 60},
+	}, resp.CurrencyChanges)
+}
+
+func TestGetCostBasisReport(t *testing.T) {
+	t.Parallel()
+	em := NewExchangeManager()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{Currency: currency.Config{FiatDisplayCurrency: currency.USD}}, ExchangeManager: em}}
+	_, err := s.GetCostBasisReport(t.Context(), &gctrpc.GetCostBasisReportRequest{})
+	assert.ErrorIs(t, err, costbasis.ErrInvalidMethod)
+
+	req := &gctrpc.GetCostBasisReportRequest{Method: "fifo"}
+	_, err = s.GetCostBasisReport(t.Context(), req)
+	assert.ErrorIs(t, err, errInvalidTimes)
+
+	req.Start = costBasisTestDay.Format(common.SimpleTimeFormatWithTimezone)
+	req.End = costBasisTestDay.AddDate(0, 0, 7).Format(common.SimpleTimeFormatWithTimezone)
+	_, err = s.GetCostBasisReport(t.Context(), req)
+	assert.ErrorIs(t, err, errNoCostBasisExchanges)
+
+	req.Exchanges = []string{"costbasistest"}
+	_, err = s.GetCostBasisReport(t.Context(), req)
+	assert.ErrorIs(t, err, ErrExchangeNotFound)
+
+	require.NoError(t, em.Add(newFakeCostBasisExchange()), "Add must not error")
+	resp, err := s.GetCostBasisReport(t.Context(), req)
+	require.NoError(t, err, "GetCostBasisReport must not error")
+	assert.Equal(t, "FIFO", resp.Method)
+	assert.Equal(t, "USD", resp.FiatCurrency)
+	require.Len(t, resp.Gains, 3, "GetCostBasisReport must return all gains")
+	assert.Empty(t, resp.Gains[0].Acquired, "unmatched gains should have no acquisition time")
+	assert.Equal(t, "2024-01-01 10:00:00 UTC", resp.Gains[1].Acquired)
+	assert.Len(t, resp.Lots, 3)
+	assert.InDelta(t, 250, resp.TotalProceeds, 1e-9)
+	assert.InDelta(t, 55.5, resp.TotalCostBasis, 1e-9)
+	assert.InDelta(t, 194.5, resp.TotalGain, 1e-9)
+}
+
+func TestPendingWithdrawalRPCs(t *testing.T) {
+	t.Parallel()
+	m, exch := setupWithdrawPolicyTestManager(t, &config.WithdrawalPolicy{
+		Approval: config.WithdrawalApproval{Enabled: true},
+	}, nil)
+	s := RPCServer{Engine: &Engine{WithdrawManager: m}}
+
+	first, err := m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	m.mtx.Lock()
+	m.pending[first.ID].CreatedAt = m.pending[first.ID].CreatedAt.Add(-time.Second)
+	m.mtx.Unlock()
+	second, err := m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(2))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+
+	resp, err := s.GetPendingWithdrawals(t.Context(), &gctrpc.GetPendingWithdrawalsRequest{})
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, resp.Withdrawals, 2, "GetPendingWithdrawals must return all pending withdrawals")
+	assert.Equal(t, first.ID.String(), resp.Withdrawals[0].Id, "pending withdrawals should be ordered oldest first")
+	assert.Equal(t, "crypto", resp.Withdrawals[0].Type)
+	assert.Equal(t, withdrawPolicyTestAddress, resp.Withdrawals[0].Address)
+	assert.Equal(t, "btc", resp.Withdrawals[0].Chain)
+	assert.Equal(t, 1.0, resp.Withdrawals[0].Amount)
+	assert.True(t, resp.Withdrawals[0].ExpiresAt.AsTime().After(resp.Withdrawals[0].CreatedAt.AsTime()), "pending withdrawals should expire after they are created")
+
+	approved, err := s.ApproveWithdrawal(t.Context(), &gctrpc.ApproveWithdrawalRequest{Id: first.ID.String()})
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "submitted", approved.Status)
+	assert.Len(t, exch.submitted, 1, "approved withdrawals should be submitted")
+
+	_, err = s.RejectWithdrawal(t.Context(), &gctrpc.RejectWithdrawalRequest{Id: first.ID.String()})
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+	rejected, err := s.RejectWithdrawal(t.Context(), &gctrpc.RejectWithdrawalRequest{Id: second.ID.String()})
+	require.NoError(t, err, "RejectWithdrawal must not error")
+	assert.Equal(t, MsgStatusSuccess, rejected.Status)
+
+	resp, err = s.GetPendingWithdrawals(t.Context(), &gctrpc.GetPendingWithdrawalsRequest{})
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, resp.Withdrawals)
+}
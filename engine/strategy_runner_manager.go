@@ -0,0 +1,230 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupStrategyRunnerManager creates a strategy runner which hosts the
+// strategy configs defined in its config, using the loader to build them
+func SetupStrategyRunnerManager(em *ExchangeManager, om *OrderManager, loader StrategyLoader, cfg *config.StrategyRunner) (*StrategyRunnerManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilStrategyRunnerOrderMgr
+	}
+	if loader == nil {
+		return nil, errNilStrategyLoader
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w StrategyRunner", errNilConfig)
+	}
+	if len(cfg.Strategies) == 0 {
+		return nil, errStrategyRunnerNoStrategies
+	}
+	return &StrategyRunnerManager{
+		shutdown:        make(chan struct{}),
+		verbose:         cfg.Verbose,
+		pluginPath:      cfg.StrategyPluginPath,
+		strategyConfigs: cfg.Strategies,
+		loader:          loader,
+		exchangeManager: em,
+		orderManager:    om,
+	}, nil
+}
+
+// Start loads and runs each configured strategy. Strategies are loaded from
+// their config on every start, so their state does not carry over restarts
+func (m *StrategyRunnerManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", StrategyRunnerManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", StrategyRunnerManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderMgr, "Strategy runner %s", MsgSubSystemStarting)
+	if err := m.loadStrategies(); err != nil {
+		atomic.StoreInt32(&m.started, 0)
+		return err
+	}
+	m.shutdown = make(chan struct{})
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.OrderMgr, "Strategy runner %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops all running strategies
+func (m *StrategyRunnerManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", StrategyRunnerManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 1, 0) {
+		return fmt.Errorf("%s %w", StrategyRunnerManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Strategy runner %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.m.Lock()
+	for i := range m.strategies {
+		if err := m.strategies[i].strategy.Stop(); err != nil {
+			log.Errorf(log.OrderMgr, "Strategy runner unable to stop strategy %v: %v", m.strategies[i].configPath, err)
+		}
+	}
+	m.strategies = nil
+	m.m.Unlock()
+	log.Debugf(log.OrderMgr, "Strategy runner %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *StrategyRunnerManager) IsRunning() bool {
+	return m != nil && atomic.LoadInt32(&m.started) == 1
+}
+
+// WebsocketDataHandler builds candles for running strategies from trades
+// received by the websocket routine manager
+func (m *StrategyRunnerManager) WebsocketDataHandler(service string, data any) error {
+	if !m.IsRunning() {
+		return nil
+	}
+	switch d := data.(type) {
+	case trade.Data:
+		m.processTrades(service, d)
+	case []trade.Data:
+		m.processTrades(service, d...)
+	}
+	return nil
+}
+
+func (m *StrategyRunnerManager) loadStrategies() error {
+	if m.pluginPath != "" && !m.pluginLoaded {
+		if err := m.loader.LoadStrategyPlugin(m.pluginPath); err != nil {
+			return fmt.Errorf("could not load strategy plugin %v: %w", m.pluginPath, err)
+		}
+		m.pluginLoaded = true
+	}
+	loaded := make([]*runningStrategy, 0, len(m.strategyConfigs))
+	for i := range m.strategyConfigs {
+		rs, err := m.loadStrategy(m.strategyConfigs[i])
+		if err == nil {
+			err = rs.strategy.Start()
+		}
+		if err != nil {
+			for j := range loaded {
+				if stopErr := loaded[j].strategy.Stop(); stopErr != nil {
+					log.Errorf(log.OrderMgr, "Strategy runner unable to stop strategy %v: %v", loaded[j].configPath, stopErr)
+				}
+			}
+			return fmt.Errorf("strategy %v: %w", m.strategyConfigs[i], err)
+		}
+		loaded = append(loaded, rs)
+		if m.verbose {
+			log.Debugf(log.OrderMgr, "Strategy runner started strategy %v", m.strategyConfigs[i])
+		}
+	}
+	m.m.Lock()
+	m.strategies = loaded
+	m.m.Unlock()
+	return nil
+}
+
+func (m *StrategyRunnerManager) loadStrategy(configPath string) (*runningStrategy, error) {
+	s, err := m.loader.LoadStrategy(configPath, m.exchangeManager, m.orderManager, m.verbose)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		return nil, errNilHostedStrategy
+	}
+	subs := s.GetCandleSubscriptions()
+	if len(subs) == 0 {
+		return nil, errNoCandleSubscriptions
+	}
+	rs := &runningStrategy{
+		configPath: configPath,
+		strategy:   s,
+		builders:   make([]*candleBuilder, len(subs)),
+	}
+	for i := range subs {
+		if subs[i].Exchange == "" || !subs[i].Asset.IsValid() || subs[i].Pair.IsEmpty() || subs[i].Interval.Duration() <= 0 {
+			return nil, fmt.Errorf("%w %s %s %s %s", errInvalidCandleSubscription, subs[i].Exchange, subs[i].Asset, subs[i].Pair, subs[i].Interval)
+		}
+		b, err := kline.NewBuilder(subs[i].Interval)
+		if err != nil {
+			return nil, err
+		}
+		rs.builders[i] = &candleBuilder{CandleSubscription: subs[i], builder: b}
+	}
+	return rs, nil
+}
+
+func (m *StrategyRunnerManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(defaultStrategyRunnerCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			m.closeCandles(time.Now().Add(-candleCloseGracePeriod))
+		}
+	}
+}
+
+// processTrades adds trades to the candles of matching subscriptions and
+// appends any candles closed by them to their strategy
+func (m *StrategyRunnerManager) processTrades(service string, trades ...trade.Data) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range trades {
+		exch := trades[i].Exchange
+		if exch == "" {
+			exch = service
+		}
+		for j := range m.strategies {
+			for k := range m.strategies[j].builders {
+				b := m.strategies[j].builders[k]
+				if !b.matches(exch, trades[i].AssetType, trades[i].CurrencyPair) {
+					continue
+				}
+				m.appendCandles(m.strategies[j], b, b.builder.AddTrade(trades[i].Price, trades[i].Amount, trades[i].Timestamp))
+			}
+		}
+	}
+}
+
+// closeCandles closes all candles which ended before the provided time
+func (m *StrategyRunnerManager) closeCandles(until time.Time) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	for i := range m.strategies {
+		for j := range m.strategies[i].builders {
+			b := m.strategies[i].builders[j]
+			m.appendCandles(m.strategies[i], b, b.builder.Close(until))
+		}
+	}
+}
+
+func (m *StrategyRunnerManager) appendCandles(rs *runningStrategy, b *candleBuilder, candles []kline.Candle) {
+	for i := range candles {
+		if err := rs.strategy.AppendCandle(b.Exchange, b.Asset, b.Pair, &candles[i]); err != nil {
+			log.Errorf(log.OrderMgr, "Strategy runner unable to append %v %v %v candle to strategy %v: %v", b.Exchange, b.Asset, b.Pair, rs.configPath, err)
+		}
+	}
+}
+
+func (b *candleBuilder) matches(exchangeName string, a asset.Item, cp currency.Pair) bool {
+	return strings.EqualFold(b.Exchange, exchangeName) && b.Asset == a && b.Pair.Equal(cp)
+}
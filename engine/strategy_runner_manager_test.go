@@ -0,0 +1,210 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var errStrategyLoaderTest = errors.New("strategy loader test error")
+
+// fakeStrategyLoader loads fakeHostedStrategy instances, recording the
+// strategies it has loaded
+type fakeStrategyLoader struct {
+	m             sync.Mutex
+	pluginLoads   int
+	subscriptions []CandleSubscription
+	loadErr       error
+	loaded        []*fakeHostedStrategy
+}
+
+func (f *fakeStrategyLoader) LoadStrategyPlugin(string) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.pluginLoads++
+	return nil
+}
+
+func (f *fakeStrategyLoader) LoadStrategy(string, *ExchangeManager, *OrderManager, bool) (HostedStrategy, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if f.loadErr != nil {
+		return nil, f.loadErr
+	}
+	s := &fakeHostedStrategy{subscriptions: f.subscriptions}
+	f.loaded = append(f.loaded, s)
+	return s, nil
+}
+
+// fakeHostedStrategy records its lifecycle and the candles appended to it
+type fakeHostedStrategy struct {
+	m             sync.Mutex
+	started       bool
+	stopped       bool
+	subscriptions []CandleSubscription
+	candles       []kline.Candle
+}
+
+func (f *fakeHostedStrategy) Start() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.started = true
+	return nil
+}
+
+func (f *fakeHostedStrategy) Stop() error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.stopped = true
+	return nil
+}
+
+func (f *fakeHostedStrategy) GetCandleSubscriptions() []CandleSubscription {
+	return f.subscriptions
+}
+
+func (f *fakeHostedStrategy) AppendCandle(_ string, _ asset.Item, _ currency.Pair, c *kline.Candle) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	f.candles = append(f.candles, *c)
+	return nil
+}
+
+func (f *fakeHostedStrategy) getCandles() []kline.Candle {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return append([]kline.Candle(nil), f.candles...)
+}
+
+func strategyRunnerSetup(t *testing.T) (*StrategyRunnerManager, *fakeStrategyLoader) {
+	t.Helper()
+	loader := &fakeStrategyLoader{
+		subscriptions: []CandleSubscription{{
+			Exchange: testExchange,
+			Asset:    asset.Spot,
+			Pair:     currency.NewPair(currency.BTC, currency.USDT),
+			Interval: kline.OneMin,
+		}},
+	}
+	m, err := SetupStrategyRunnerManager(NewExchangeManager(), &OrderManager{}, loader, &config.StrategyRunner{
+		StrategyPluginPath: "strategies.so",
+		Strategies:         []string{"a.strat", "b.strat"},
+	})
+	require.NoError(t, err, "SetupStrategyRunnerManager must not error")
+	return m, loader
+}
+
+func TestSetupStrategyRunnerManager(t *testing.T) {
+	t.Parallel()
+	cfg := &config.StrategyRunner{Strategies: []string{"a.strat"}}
+	_, err := SetupStrategyRunnerManager(nil, nil, nil, nil)
+	assert.ErrorIs(t, err, errNilExchangeManager)
+	_, err = SetupStrategyRunnerManager(NewExchangeManager(), nil, nil, nil)
+	assert.ErrorIs(t, err, errNilStrategyRunnerOrderMgr)
+	_, err = SetupStrategyRunnerManager(NewExchangeManager(), &OrderManager{}, nil, nil)
+	assert.ErrorIs(t, err, errNilStrategyLoader)
+	_, err = SetupStrategyRunnerManager(NewExchangeManager(), &OrderManager{}, &fakeStrategyLoader{}, nil)
+	assert.ErrorIs(t, err, errNilConfig)
+	_, err = SetupStrategyRunnerManager(NewExchangeManager(), &OrderManager{}, &fakeStrategyLoader{}, &config.StrategyRunner{})
+	assert.ErrorIs(t, err, errStrategyRunnerNoStrategies)
+	m, err := SetupStrategyRunnerManager(NewExchangeManager(), &OrderManager{}, &fakeStrategyLoader{}, cfg)
+	require.NoError(t, err, "SetupStrategyRunnerManager must not error")
+	assert.NotNil(t, m)
+}
+
+func TestStrategyRunnerManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *StrategyRunnerManager
+	assert.ErrorIs(t, m.Start(), ErrNilSubsystem)
+	assert.ErrorIs(t, m.Stop(), ErrNilSubsystem)
+	assert.False(t, m.IsRunning())
+
+	m, loader := strategyRunnerSetup(t)
+	assert.ErrorIs(t, m.Stop(), ErrSubSystemNotStarted)
+	require.NoError(t, m.Start(), "Start must not error")
+	assert.True(t, m.IsRunning())
+	assert.ErrorIs(t, m.Start(), ErrSubSystemAlreadyStarted)
+	require.Len(t, loader.loaded, 2, "Start must load each strategy")
+	for i := range loader.loaded {
+		assert.True(t, loader.loaded[i].started, "Start should start each strategy")
+	}
+	require.NoError(t, m.Stop(), "Stop must not error")
+	assert.False(t, m.IsRunning())
+	for i := range loader.loaded {
+		assert.True(t, loader.loaded[i].stopped, "Stop should stop each strategy")
+	}
+
+	require.NoError(t, m.Start(), "Start must not error on restart")
+	assert.Len(t, loader.loaded, 4, "Start should reload strategies on restart")
+	assert.Equal(t, 1, loader.pluginLoads, "the strategy plugin should only be loaded once")
+	require.NoError(t, m.Stop(), "Stop must not error")
+
+	loader.loadErr = errStrategyLoaderTest
+	assert.ErrorIs(t, m.Start(), errStrategyLoaderTest)
+	assert.False(t, m.IsRunning(), "a failed Start should not leave the subsystem running")
+
+	loader.loadErr = nil
+	loader.subscriptions = nil
+	assert.ErrorIs(t, m.Start(), errNoCandleSubscriptions)
+
+	loader.subscriptions = []CandleSubscription{{Exchange: testExchange, Asset: asset.Spot}}
+	assert.ErrorIs(t, m.Start(), errInvalidCandleSubscription)
+}
+
+func TestStrategyRunnerWebsocketDataHandler(t *testing.T) {
+	t.Parallel()
+	m, loader := strategyRunnerSetup(t)
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	// trades are timestamped from the current interval so the check ticker
+	// cannot close the candle before the assertions
+	start := time.Now().Truncate(time.Minute)
+	trades := []trade.Data{
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 100, Amount: 1, Timestamp: start},
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 105, Amount: 2, Timestamp: start.Add(time.Second)},
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Futures, Price: 1, Amount: 1, Timestamp: start.Add(time.Second)},
+		{Exchange: testExchange, CurrencyPair: cp, AssetType: asset.Spot, Price: 95, Amount: 1, Timestamp: start.Add(time.Second * 2)},
+	}
+	require.NoError(t, m.WebsocketDataHandler(testExchange, trades), "WebsocketDataHandler must not error when not running")
+
+	require.NoError(t, m.Start(), "Start must not error")
+	defer func() {
+		assert.NoError(t, m.Stop(), "Stop should not error")
+	}()
+	require.NoError(t, m.WebsocketDataHandler(testExchange, trades), "WebsocketDataHandler must not error")
+	require.NoError(t, m.WebsocketDataHandler(testExchange, trade.Data{
+		CurrencyPair: cp,
+		AssetType:    asset.Spot,
+		Price:        110,
+		Amount:       1,
+		Timestamp:    start.Add(time.Minute),
+	}), "WebsocketDataHandler must not error")
+
+	for i := range loader.loaded {
+		candles := loader.loaded[i].getCandles()
+		require.Len(t, candles, 1, "a trade in the next interval must close the candle")
+		assert.Equal(t, kline.Candle{Time: start, Open: 100, High: 105, Low: 95, Close: 95, Volume: 4}, candles[0])
+	}
+}
+
+func TestCandleBuilderMatches(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	b := &candleBuilder{CandleSubscription: CandleSubscription{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     cp,
+		Interval: kline.OneMin,
+	}}
+	assert.True(t, b.matches("BITSTAMP", asset.Spot, currency.NewPairWithDelimiter("BTC", "USDT", "-")))
+	assert.False(t, b.matches(testExchange, asset.Margin, cp))
+	assert.False(t, b.matches("Binance", asset.Spot, cp))
+}
@@ -0,0 +1,85 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// StrategyRunnerManagerName is an exported subsystem name
+const StrategyRunnerManagerName = "strategy_runner"
+
+const (
+	defaultStrategyRunnerCheckInterval = time.Second
+	// candleCloseGracePeriod allows trades timestamped at the end of an
+	// interval to arrive before the candle is closed
+	candleCloseGracePeriod = time.Second
+)
+
+var (
+	errNilStrategyLoader          = errors.New("cannot start with nil strategy loader")
+	errNilHostedStrategy          = errors.New("strategy loader returned nil strategy")
+	errNoCandleSubscriptions      = errors.New("strategy has no candle subscriptions")
+	errNilStrategyRunnerOrderMgr  = errors.New("cannot start with nil order manager")
+	errInvalidCandleSubscription  = errors.New("invalid candle subscription")
+	errStrategyRunnerNoStrategies = errors.New("no strategies configured")
+)
+
+// HostedStrategy is a live strategy task run by the strategy runner. Closed
+// candles built from the websocket trade feed are appended to it
+type HostedStrategy interface {
+	Start() error
+	Stop() error
+	GetCandleSubscriptions() []CandleSubscription
+	AppendCandle(exchangeName string, a asset.Item, cp currency.Pair, c *kline.Candle) error
+}
+
+// StrategyLoader builds hosted strategies from strategy config files. It is
+// injected by the binary hosting the engine so the engine does not depend on
+// the backtester
+type StrategyLoader interface {
+	LoadStrategyPlugin(path string) error
+	LoadStrategy(configPath string, em *ExchangeManager, om *OrderManager, verbose bool) (HostedStrategy, error)
+}
+
+// CandleSubscription defines the candles a hosted strategy receives
+type CandleSubscription struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Interval kline.Interval
+}
+
+// StrategyRunnerManager runs backtester strategies live, building candles
+// from the websocket trade feed and trading through the order manager
+type StrategyRunnerManager struct {
+	started         int32
+	verbose         bool
+	pluginLoaded    bool
+	pluginPath      string
+	strategyConfigs []string
+	loader          StrategyLoader
+	exchangeManager *ExchangeManager
+	orderManager    *OrderManager
+	shutdown        chan struct{}
+	wg              sync.WaitGroup
+	m               sync.Mutex
+	strategies      []*runningStrategy
+}
+
+// runningStrategy holds a hosted strategy and the candles being built for it
+type runningStrategy struct {
+	configPath string
+	strategy   HostedStrategy
+	builders   []*candleBuilder
+}
+
+// candleBuilder aggregates trades into candles for a candle subscription
+type candleBuilder struct {
+	CandleSubscription
+	builder *kline.Builder
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
@@ -12,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
 
@@ -61,6 +63,45 @@ type iOrderManager interface {
 	UpdateExistingOrder(*order.Detail) error
 }
 
+// iAlgoOrderManager limits exposure of accessible order manager functions to
+// the algo execution manager
+type iAlgoOrderManager interface {
+	IsRunning() bool
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	Modify(context.Context, *order.Modify) (*order.ModifyResponse, error)
+	Cancel(context.Context, *order.Cancel) error
+	GetByExchangeAndID(string, string) (*order.Detail, error)
+	LinkChildOrder(uuid.UUID, string, string) error
+	UnlinkChildOrders(uuid.UUID) error
+}
+
+// iMarketMakingOrderManager limits exposure of accessible order manager
+// functions to the market making manager
+type iMarketMakingOrderManager interface {
+	IsRunning() bool
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	Modify(context.Context, *order.Modify) (*order.ModifyResponse, error)
+	Cancel(context.Context, *order.Cancel) error
+	GetByExchangeAndID(string, string) (*order.Detail, error)
+	UpdateExistingOrder(*order.Detail) error
+}
+
+// iEventOrderManager limits exposure of accessible order manager functions to
+// the event manager
+type iEventOrderManager interface {
+	IsRunning() bool
+	Submit(context.Context, *order.Submit) (*OrderSubmitResponse, error)
+	Cancel(context.Context, *order.Cancel) error
+}
+
+// iEventScriptManager limits exposure of accessible gctscript manager
+// functions to the event manager
+type iEventScriptManager interface {
+	IsRunning() bool
+	New() *gctscript.VM
+	RemoveVM(uuid.UUID) error
+}
+
 // iPortfolioManager limits exposure of accessible functions to portfolio manager
 type iPortfolioManager interface {
 	GetPortfolioSummary() portfolio.Summary
@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -27,7 +28,8 @@ func SetupWithdrawManager(em iExchangeManager, pm iPortfolioManager, isDryRun bo
 }
 
 // SubmitWithdrawal performs validation and submits a new withdraw request to
-// exchange
+// exchange. When the withdrawal policy requires approval the request is
+// queued and returned with a pending approval status
 func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Request) (*withdraw.Response, error) {
 	if m == nil {
 		return nil, ErrNilSubsystem
@@ -55,30 +57,34 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	}
 
 	if m.isDryRun {
+		if m.policy != nil {
+			if err = m.checkPolicy(req); err != nil {
+				return nil, err
+			}
+		}
 		log.Warnln(log.Global, "Dry run enabled, no withdrawal request will be submitted or have an event created")
 		resp.ID = withdraw.DryRunID
 		resp.Exchange.Status = "dryrun"
 		resp.Exchange.ID = withdraw.DryRunID.String()
 	} else {
-		var ret *withdraw.ExchangeResponse
-		if req.Type == withdraw.Crypto {
-			if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
-				return nil, withdraw.ErrStrAddressNotWhiteListed
+		if err = m.checkPortfolioAddress(req); err != nil {
+			return nil, err
+		}
+		var usage *withdrawalUsage
+		if m.policy != nil {
+			var queued bool
+			usage, queued, err = m.applyPolicy(resp)
+			if err != nil {
+				return nil, err
 			}
-			if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
-				return nil, withdraw.ErrStrExchangeNotSupportedByAddress
+			if queued {
+				return resp, nil
 			}
 		}
-		switch req.Type {
-		case withdraw.Fiat:
-			ret, err = exch.WithdrawFiatFunds(ctx, req)
-		case withdraw.Crypto:
-			ret, err = exch.WithdrawCryptocurrencyFunds(ctx, req)
-		default:
-			return nil, fmt.Errorf("unsupported withdrawal type: %v", req.Type)
-		}
-
+		var ret *withdraw.ExchangeResponse
+		ret, err = submitToExchange(ctx, exch, req)
 		if err != nil {
+			m.releaseUsage(usage)
 			resp.Exchange.Status = err.Error()
 		} else {
 			resp.Exchange.Status = ret.Status
@@ -92,6 +98,33 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	return resp, err
 }
 
+// checkPortfolioAddress ensures cryptocurrency withdrawals are to a
+// whitelisted portfolio address supported by the exchange
+func (m *WithdrawManager) checkPortfolioAddress(req *withdraw.Request) error {
+	if req.Type != withdraw.Crypto {
+		return nil
+	}
+	if !m.portfolioManager.IsWhiteListed(req.Crypto.Address) {
+		return withdraw.ErrStrAddressNotWhiteListed
+	}
+	if !m.portfolioManager.IsExchangeSupported(req.Exchange, req.Crypto.Address) {
+		return withdraw.ErrStrExchangeNotSupportedByAddress
+	}
+	return nil
+}
+
+// submitToExchange submits a withdrawal request to the exchange
+func submitToExchange(ctx context.Context, exch exchange.IBotExchange, req *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	switch req.Type {
+	case withdraw.Fiat:
+		return exch.WithdrawFiatFunds(ctx, req)
+	case withdraw.Crypto:
+		return exch.WithdrawCryptocurrencyFunds(ctx, req)
+	default:
+		return nil, fmt.Errorf("unsupported withdrawal type: %v", req.Type)
+	}
+}
+
 // WithdrawalEventByID returns a withdrawal request by ID
 func (m *WithdrawManager) WithdrawalEventByID(id string) (*withdraw.Response, error) {
 	if m == nil {
@@ -0,0 +1,480 @@
+package engine
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pquerna/otp/totp"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// setupPolicy applies the withdrawal policy to future withdrawal requests.
+// Allowlisted addresses without an added time are stamped with the current
+// time in cfg, so their cooldown persists when the config is saved. Approval
+// commands are registered with the communications manager when
+// relayer approvals are enabled, and exchangeOTP refreshes the one time
+// password of requests approved after the code they were created with expires
+func (m *WithdrawManager) setupPolicy(cfg *config.WithdrawalPolicy, comms iWithdrawalCommsManager, exchangeOTP func(string) (string, error)) error {
+	if m == nil {
+		return fmt.Errorf("withdraw manager %w", ErrNilSubsystem)
+	}
+	if cfg == nil {
+		return errNilConfig
+	}
+	policy := *cfg
+	policy.Limits = slices.Clone(cfg.Limits)
+	policy.Allowlist = slices.Clone(cfg.Allowlist)
+	policy.Approval.AuthorisedUsers = maps.Clone(cfg.Approval.AuthorisedUsers)
+	for i := range policy.Limits {
+		if policy.Limits[i].Currency.IsEmpty() {
+			return fmt.Errorf("%w: limit %d currency unset", errInvalidWithdrawalPolicy, i)
+		}
+		if policy.Limits[i].MaxAmount < 0 || policy.Limits[i].DailyAmount < 0 {
+			return fmt.Errorf("%w: %s limit cannot be negative", errInvalidWithdrawalPolicy, policy.Limits[i].Currency)
+		}
+	}
+	for i := range policy.Allowlist {
+		if policy.Allowlist[i].Currency.IsEmpty() || policy.Allowlist[i].Address == "" {
+			return fmt.Errorf("%w: allowlist address %d requires a currency and address", errInvalidWithdrawalPolicy, i)
+		}
+	}
+	if policy.AddressCooldown < 0 {
+		return fmt.Errorf("%w: address cooldown cannot be negative", errInvalidWithdrawalPolicy)
+	}
+	if policy.Approval.Timeout < 0 {
+		return fmt.Errorf("%w: approval timeout cannot be negative", errInvalidWithdrawalPolicy)
+	}
+	if policy.Approval.Timeout == 0 {
+		policy.Approval.Timeout = defaultWithdrawalApprovalTimeout
+	}
+	if policy.Approval.TOTPSecret != "" {
+		if _, err := totp.GenerateCode(policy.Approval.TOTPSecret, time.Now()); err != nil {
+			return fmt.Errorf("%w: approval TOTP secret: %w", errInvalidWithdrawalPolicy, err)
+		}
+	}
+	if policy.Approval.Enabled && policy.Approval.Relayers && len(policy.Approval.AuthorisedUsers) == 0 {
+		log.Warnln(log.Global, "Withdraw manager relayer approvals have no authorised users, approvals from the communications relayers will be rejected")
+	}
+	now := time.Now()
+	for i := range policy.Allowlist {
+		if policy.Allowlist[i].AddedAt.IsZero() {
+			policy.Allowlist[i].AddedAt = now
+			cfg.Allowlist[i].AddedAt = now
+		}
+	}
+
+	m.mtx.Lock()
+	m.policy = &policy
+	m.comms = comms
+	m.exchangeOTP = exchangeOTP
+	m.pending = make(map[uuid.UUID]*PendingWithdrawal)
+	m.seedUsage()
+	m.mtx.Unlock()
+
+	if policy.Approval.Enabled && policy.Approval.Relayers && comms != nil {
+		if err := comms.RegisterCommand(&CommsCommand{
+			Name:        approveWithdrawalCommand,
+			Usage:       "<id> [code]",
+			Description: "Approves a withdrawal awaiting approval",
+			Confirm:     true,
+			Handler:     m.handleApproveCommand,
+		}); err != nil {
+			log.Warnf(log.Global, "Withdraw manager unable to register relayer approvals: %s", err)
+		} else if err := comms.RegisterCommand(&CommsCommand{
+			Name:        rejectWithdrawalCommand,
+			Usage:       "<id>",
+			Description: "Rejects a withdrawal awaiting approval",
+			Handler:     m.handleRejectCommand,
+		}); err != nil {
+			log.Warnf(log.Global, "Withdraw manager unable to register relayer rejections: %s", err)
+		}
+	}
+	return nil
+}
+
+// seedUsage counts withdrawals recorded in the last day against the daily
+// limits, so limits persist across restarts
+func (m *WithdrawManager) seedUsage() {
+	now := time.Now()
+	events, err := dbwithdraw.GetEventsByDate("", now.Add(-withdrawalUsageWindow), now, withdrawalUsageSeedLimit)
+	if err != nil {
+		if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+			log.Warnf(log.Global, "Withdraw manager unable to load recent withdrawals for daily limits: %s", err)
+		}
+		return
+	}
+	for _, e := range events {
+		if e.Exchange.Status == "dryrun" ||
+			e.Exchange.Status == withdrawalStatusPendingApproval ||
+			e.Exchange.Status == withdrawalStatusRejected ||
+			e.Exchange.Status == withdrawalStatusExpired ||
+			e.Exchange.Status == withdrawalStatusApprovalFailed ||
+			strings.HasPrefix(e.Exchange.Status, withdrawalStatusPolicyDenied) {
+			continue
+		}
+		m.usage = append(m.usage, &withdrawalUsage{
+			Currency: e.RequestDetails.Currency,
+			Amount:   e.RequestDetails.Amount,
+			Time:     e.CreatedAt,
+		})
+	}
+}
+
+// checkPolicy returns an error if the withdrawal request breaches the policy
+func (m *WithdrawManager) checkPolicy(req *withdraw.Request) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	return m.checkPolicyLocked(req, time.Now())
+}
+
+func (m *WithdrawManager) checkPolicyLocked(req *withdraw.Request, now time.Time) error {
+	for i := range m.policy.Limits {
+		l := &m.policy.Limits[i]
+		if !l.Currency.Equal(req.Currency) {
+			continue
+		}
+		if l.MaxAmount > 0 && req.Amount > l.MaxAmount {
+			return fmt.Errorf("%w: %v %s exceeds %v", errWithdrawalLimitExceeded, req.Amount, req.Currency, l.MaxAmount)
+		}
+		if l.DailyAmount > 0 {
+			if used := m.dailyUsage(req, now); used+req.Amount > l.DailyAmount {
+				return fmt.Errorf("%w: %v %s withdrawn in the last 24 hours, limit %v", errWithdrawalDailyLimitExceeded, used, req.Currency, l.DailyAmount)
+			}
+		}
+	}
+
+	if req.Type != withdraw.Crypto || len(m.policy.Allowlist) == 0 {
+		return nil
+	}
+	var cooldownEnds time.Time
+	for i := range m.policy.Allowlist {
+		a := &m.policy.Allowlist[i]
+		if !a.Currency.Equal(req.Currency) ||
+			a.Address != req.Crypto.Address ||
+			a.AddressTag != req.Crypto.AddressTag ||
+			(a.Chain != "" && !strings.EqualFold(a.Chain, req.Crypto.Chain)) {
+			continue
+		}
+		ends := a.AddedAt.Add(m.policy.AddressCooldown)
+		if !now.Before(ends) {
+			return nil
+		}
+		if cooldownEnds.IsZero() || ends.Before(cooldownEnds) {
+			cooldownEnds = ends
+		}
+	}
+	if !cooldownEnds.IsZero() {
+		return fmt.Errorf("%w: %s available from %s", errAddressCooldown, req.Crypto.Address, cooldownEnds.UTC().Format(time.RFC3339))
+	}
+	return fmt.Errorf("%w: %s %s chain %q", errAddressNotAllowlisted, req.Currency, req.Crypto.Address, req.Crypto.Chain)
+}
+
+// dailyUsage returns the amount of the request currency withdrawn or pending
+// approval over the last day, removing older usage
+func (m *WithdrawManager) dailyUsage(req *withdraw.Request, now time.Time) float64 {
+	cutoff := now.Add(-withdrawalUsageWindow)
+	m.usage = slices.DeleteFunc(m.usage, func(u *withdrawalUsage) bool { return u.Time.Before(cutoff) })
+	var used float64
+	for _, u := range m.usage {
+		if u.Currency.Equal(req.Currency) {
+			used += u.Amount
+		}
+	}
+	return used
+}
+
+// applyPolicy checks the withdrawal against the policy and reserves its
+// amount against the daily limits. Denied withdrawals are recorded with a
+// policy denied status. When approval is required the withdrawal is recorded
+// and queued, and queued is returned as true
+func (m *WithdrawManager) applyPolicy(resp *withdraw.Response) (usage *withdrawalUsage, queued bool, err error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	req := resp.RequestDetails
+	now := time.Now()
+	if err = m.checkPolicyLocked(&req, now); err != nil {
+		resp.Exchange.Status = withdrawalStatusPolicyDenied + ": " + err.Error()
+		dbwithdraw.Event(resp)
+		log.Warnf(log.Global, "Withdrawal of %v %s on %s denied: %s", req.Amount, req.Currency, req.Exchange, err)
+		return nil, false, err
+	}
+	usage = &withdrawalUsage{Currency: req.Currency, Amount: req.Amount, Time: now}
+	m.usage = append(m.usage, usage)
+	if !m.policy.Approval.Enabled {
+		return usage, false, nil
+	}
+
+	resp.Exchange.Status = withdrawalStatusPendingApproval
+	dbwithdraw.Event(resp)
+	if resp.ID.IsNil() {
+		// The database is disabled, so an ID is generated to approve the
+		// request by
+		if resp.ID, err = uuid.NewV4(); err != nil {
+			m.removeUsage(usage)
+			return nil, false, err
+		}
+	}
+	p := &PendingWithdrawal{
+		ID:        resp.ID,
+		Request:   req,
+		CreatedAt: now,
+		ExpiresAt: now.Add(m.policy.Approval.Timeout),
+		usage:     usage,
+	}
+	m.pending[p.ID] = p
+
+	msg := fmt.Sprintf("Withdrawal %s of %v %s on %s awaits approval until %s", p.ID, req.Amount, req.Currency, req.Exchange, p.ExpiresAt.UTC().Format(time.RFC3339))
+	if m.policy.Approval.Relayers {
+		code := ""
+		if m.policy.Approval.TOTPSecret != "" {
+			code = " <code>"
+		}
+		msg += fmt.Sprintf(". Reply %s %s%s to approve or %s %s to reject", approveWithdrawalCommand, p.ID, code, rejectWithdrawalCommand, p.ID)
+	}
+	if m.comms != nil {
+		m.comms.PushEvent(base.Event{Type: "withdrawal", Message: msg})
+	}
+	log.Infoln(log.Global, msg)
+	return usage, true, nil
+}
+
+// releaseUsage removes usage of a withdrawal which was not submitted from the
+// daily limits
+func (m *WithdrawManager) releaseUsage(u *withdrawalUsage) {
+	if u == nil {
+		return
+	}
+	m.mtx.Lock()
+	m.removeUsage(u)
+	m.mtx.Unlock()
+}
+
+func (m *WithdrawManager) removeUsage(u *withdrawalUsage) {
+	m.usage = slices.DeleteFunc(m.usage, func(x *withdrawalUsage) bool { return x == u })
+}
+
+// GetPendingWithdrawals returns the withdrawal requests awaiting approval,
+// oldest first
+func (m *WithdrawManager) GetPendingWithdrawals() ([]PendingWithdrawal, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	now := time.Now()
+	resp := make([]PendingWithdrawal, 0, len(m.pending))
+	for _, p := range m.pending {
+		if now.After(p.ExpiresAt) {
+			m.closePending(p, withdrawalStatusExpired)
+			continue
+		}
+		resp = append(resp, *p)
+	}
+	slices.SortFunc(resp, func(a, b PendingWithdrawal) int { return a.CreatedAt.Compare(b.CreatedAt) })
+	return resp, nil
+}
+
+// ApproveWithdrawal submits a pending withdrawal request to its exchange. A
+// valid TOTP code is required when the policy sets an approval TOTP secret
+func (m *WithdrawManager) ApproveWithdrawal(ctx context.Context, id, code string) (*withdraw.Response, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	p, err := m.takePending(id, code)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &withdraw.Response{
+		ID: p.ID,
+		Exchange: withdraw.ExchangeResponse{
+			Name: p.Request.Exchange,
+		},
+		RequestDetails: p.Request,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      time.Now(),
+	}
+	ret, err := m.submitApproved(ctx, &resp.RequestDetails)
+	if err != nil {
+		m.releaseUsage(p.usage)
+		resp.Exchange.Status = err.Error()
+	} else {
+		resp.Exchange.Status = ret.Status
+		resp.Exchange.ID = ret.ID
+		withdraw.Cache.Add(resp.ID, resp)
+	}
+	updateWithdrawalEvent(p.ID, resp.Exchange.ID, resp.Exchange.Status)
+	return resp, err
+}
+
+// submitApproved submits an approved withdrawal request to its exchange
+func (m *WithdrawManager) submitApproved(ctx context.Context, req *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	exch, err := m.exchangeManager.GetExchangeByName(req.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkPortfolioAddress(req); err != nil {
+		return nil, err
+	}
+	if req.OneTimePassword != 0 && m.exchangeOTP != nil {
+		otp, err := m.exchangeOTP(req.Exchange)
+		if err != nil {
+			return nil, err
+		}
+		if req.OneTimePassword, err = strconv.ParseInt(otp, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	return submitToExchange(ctx, exch, req)
+}
+
+// RejectWithdrawal removes a pending withdrawal request without submitting it
+func (m *WithdrawManager) RejectWithdrawal(id string) error {
+	if m == nil {
+		return ErrNilSubsystem
+	}
+	uid, err := uuid.FromString(id)
+	if err != nil {
+		return fmt.Errorf("%w %v", ErrWithdrawRequestNotFound, id)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	p, ok := m.pending[uid]
+	if !ok {
+		return fmt.Errorf("%w %v", ErrWithdrawRequestNotFound, id)
+	}
+	m.closePending(p, withdrawalStatusRejected)
+	return nil
+}
+
+// takePending removes and returns a pending withdrawal request once its
+// approval code is validated
+func (m *WithdrawManager) takePending(id, code string) (*PendingWithdrawal, error) {
+	uid, err := uuid.FromString(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w %v", ErrWithdrawRequestNotFound, id)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	p, ok := m.pending[uid]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", ErrWithdrawRequestNotFound, id)
+	}
+	if time.Now().After(p.ExpiresAt) {
+		m.closePending(p, withdrawalStatusExpired)
+		return nil, fmt.Errorf("%w %v", errWithdrawalApprovalExpired, id)
+	}
+	if m.policy.Approval.TOTPSecret != "" {
+		step, ok := approvalCodeStep(code, m.policy.Approval.TOTPSecret, time.Now())
+		if !ok {
+			return nil, m.failApproval(p, fmt.Errorf("%w for withdrawal %v", errInvalidApprovalCode, id))
+		}
+		// Each code approves a single withdrawal, and codes older than the
+		// last one used are refused
+		if step <= m.lastApprovalStep {
+			return nil, m.failApproval(p, fmt.Errorf("%w for withdrawal %v", errApprovalCodeReused, id))
+		}
+		m.lastApprovalStep = step
+	}
+	delete(m.pending, uid)
+	return p, nil
+}
+
+// failApproval counts a failed approval of a pending withdrawal request,
+// discarding the request once the attempt limit is reached so its code cannot
+// be guessed. The lock must be held
+func (m *WithdrawManager) failApproval(p *PendingWithdrawal, err error) error {
+	p.failedApprovals++
+	if p.failedApprovals < maxWithdrawalApprovalAttempts {
+		return err
+	}
+	m.closePending(p, withdrawalStatusApprovalFailed)
+	return fmt.Errorf("%w, %w after %d attempts", err, errWithdrawalApprovalAttempts, p.failedApprovals)
+}
+
+// approvalCodeStep returns the TOTP time step the approval code was generated
+// for, allowing one step of clock skew either side as totp.Validate does
+func approvalCodeStep(code, secret string, now time.Time) (int64, bool) {
+	step := now.Unix() / approvalCodePeriod
+	for _, s := range []int64{step, step - 1, step + 1} {
+		expected, err := totp.GenerateCode(secret, time.Unix(s*approvalCodePeriod, 0))
+		if err == nil && subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return s, true
+		}
+	}
+	return 0, false
+}
+
+// closePending removes a pending withdrawal request which will not be
+// submitted and records its status. The lock must be held
+func (m *WithdrawManager) closePending(p *PendingWithdrawal, status string) {
+	delete(m.pending, p.ID)
+	m.removeUsage(p.usage)
+	updateWithdrawalEvent(p.ID, "", status)
+	log.Infof(log.Global, "Withdrawal %s of %v %s on %s %s", p.ID, p.Request.Amount, p.Request.Currency, p.Request.Exchange, status)
+}
+
+// updateWithdrawalEvent records the outcome of a pending withdrawal request
+func updateWithdrawalEvent(id uuid.UUID, exchangeID, status string) {
+	if err := dbwithdraw.UpdateEvent(id, exchangeID, status); err != nil && !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		log.Errorf(log.Global, "Unable to record withdrawal %s status %q: %s", id, status, err)
+	}
+}
+
+// authoriseRelayerUser returns an error if the relayer user is not authorised
+// to approve or reject withdrawals
+func (m *WithdrawManager) authoriseRelayerUser(relayer, user string) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if !base.IsAuthorisedUser(m.policy.Approval.AuthorisedUsers, relayer, user) {
+		log.Warnf(log.Global, "Withdraw manager rejected withdrawal command from unauthorised %s user %q", relayer, user)
+		return fmt.Errorf("%w: %s user %q", errWithdrawalApproverUnauthorised, relayer, user)
+	}
+	return nil
+}
+
+func (m *WithdrawManager) handleApproveCommand(relayer, user string, args []string) (string, error) {
+	if err := m.authoriseRelayerUser(relayer, user); err != nil {
+		return "", err
+	}
+	if len(args) == 0 {
+		return "", fmt.Errorf("%w, usage: %s <id> [code]", errWithdrawalIDRequired, approveWithdrawalCommand)
+	}
+	var code string
+	if len(args) > 1 {
+		code = args[1]
+	}
+	resp, err := m.ApproveWithdrawal(context.Background(), args[0], code)
+	if err != nil {
+		return "", err
+	}
+	log.Infof(log.Global, "Withdrawal %s approved by %s user %s", resp.ID, relayer, user)
+	return fmt.Sprintf("Withdrawal %s submitted with status: %s", resp.ID, resp.Exchange.Status), nil
+}
+
+func (m *WithdrawManager) handleRejectCommand(relayer, user string, args []string) (string, error) {
+	if err := m.authoriseRelayerUser(relayer, user); err != nil {
+		return "", err
+	}
+	if len(args) == 0 {
+		return "", fmt.Errorf("%w, usage: %s <id>", errWithdrawalIDRequired, rejectWithdrawalCommand)
+	}
+	if err := m.RejectWithdrawal(args[0]); err != nil {
+		return "", err
+	}
+	log.Infof(log.Global, "Withdrawal %s rejected by %s user %s", args[0], relayer, user)
+	return fmt.Sprintf("Withdrawal %s rejected", args[0]), nil
+}
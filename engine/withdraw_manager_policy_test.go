@@ -0,0 +1,393 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const (
+	withdrawPolicyTestExchange = "withdrawpolicytest"
+	withdrawPolicyTestAddress  = "0xdeadbeef"
+	withdrawPolicyTestSecret   = "JBSWY3DPEHPK3PXP"
+)
+
+var errWithdrawPolicyTest = errors.New("withdrawal failed")
+
+// fakeWithdrawExchange records the withdrawal requests submitted to it
+type fakeWithdrawExchange struct {
+	exchange.IBotExchange
+	mtx       sync.Mutex
+	submitted []withdraw.Request
+	err       error
+}
+
+func (f *fakeWithdrawExchange) GetName() string {
+	return withdrawPolicyTestExchange
+}
+
+func (f *fakeWithdrawExchange) CanWithdraw(currency.Code, asset.Item) error {
+	return nil
+}
+
+func (f *fakeWithdrawExchange) WithdrawCryptocurrencyFunds(_ context.Context, req *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.submitted = append(f.submitted, *req)
+	return &withdraw.ExchangeResponse{ID: "1337", Status: "submitted"}, nil
+}
+
+func (f *fakeWithdrawExchange) WithdrawFiatFunds(ctx context.Context, req *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return f.WithdrawCryptocurrencyFunds(ctx, req)
+}
+
+// fakeWithdrawPortfolio whitelists all addresses
+type fakeWithdrawPortfolio struct {
+	iPortfolioManager
+}
+
+func (f *fakeWithdrawPortfolio) IsWhiteListed(string) bool {
+	return true
+}
+
+func (f *fakeWithdrawPortfolio) IsExchangeSupported(string, string) bool {
+	return true
+}
+
+// fakeWithdrawComms records pushed events and registered commands
+type fakeWithdrawComms struct {
+	mtx      sync.Mutex
+	events   []base.Event
+	commands map[string]*CommsCommand
+}
+
+func (f *fakeWithdrawComms) PushEvent(evt base.Event) {
+	f.mtx.Lock()
+	f.events = append(f.events, evt)
+	f.mtx.Unlock()
+}
+
+func (f *fakeWithdrawComms) RegisterCommand(cmd *CommsCommand) error {
+	if f.commands == nil {
+		f.commands = make(map[string]*CommsCommand)
+	}
+	f.commands[cmd.Name] = cmd
+	return nil
+}
+
+func setupWithdrawPolicyTestManager(t *testing.T, cfg *config.WithdrawalPolicy, comms iWithdrawalCommsManager) (*WithdrawManager, *fakeWithdrawExchange) {
+	t.Helper()
+	em := NewExchangeManager()
+	exch := &fakeWithdrawExchange{}
+	require.NoError(t, em.Add(exch), "Add must not error")
+	m, err := SetupWithdrawManager(em, &fakeWithdrawPortfolio{}, false)
+	require.NoError(t, err, "SetupWithdrawManager must not error")
+	require.NoError(t, m.setupPolicy(cfg, comms, func(string) (string, error) { return "424242", nil }), "setupPolicy must not error")
+	return m, exch
+}
+
+func newWithdrawPolicyTestRequest(amount float64) *withdraw.Request {
+	return &withdraw.Request{
+		Exchange: withdrawPolicyTestExchange,
+		Currency: currency.BTC,
+		Amount:   amount,
+		Type:     withdraw.Crypto,
+		Crypto: withdraw.CryptoRequest{
+			Address: withdrawPolicyTestAddress,
+			Chain:   "btc",
+		},
+	}
+}
+
+func TestSetupWithdrawalPolicy(t *testing.T) {
+	t.Parallel()
+	err := (*WithdrawManager)(nil).setupPolicy(&config.WithdrawalPolicy{}, nil, nil)
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+
+	m := &WithdrawManager{}
+	assert.ErrorIs(t, m.setupPolicy(nil, nil, nil), errNilConfig)
+
+	for _, cfg := range []*config.WithdrawalPolicy{
+		{Limits: []config.WithdrawalLimit{{MaxAmount: 1}}},
+		{Limits: []config.WithdrawalLimit{{Currency: currency.BTC, DailyAmount: -1}}},
+		{Allowlist: []config.WithdrawalAddress{{Currency: currency.BTC}}},
+		{AddressCooldown: -time.Second},
+		{Approval: config.WithdrawalApproval{Timeout: -time.Second}},
+		{Approval: config.WithdrawalApproval{TOTPSecret: "1"}},
+	} {
+		assert.ErrorIs(t, m.setupPolicy(cfg, nil, nil), errInvalidWithdrawalPolicy)
+	}
+
+	comms := &fakeWithdrawComms{}
+	cfg := &config.WithdrawalPolicy{
+		Limits:   []config.WithdrawalLimit{{Currency: currency.BTC, MaxAmount: 1}},
+		Approval: config.WithdrawalApproval{Enabled: true, Relayers: true},
+	}
+	require.NoError(t, m.setupPolicy(cfg, comms, nil), "setupPolicy must not error")
+	assert.Equal(t, defaultWithdrawalApprovalTimeout, m.policy.Approval.Timeout, "an unset approval timeout should use the default")
+	assert.Contains(t, comms.commands, approveWithdrawalCommand, "relayer approvals should register the approve command")
+	assert.Contains(t, comms.commands, rejectWithdrawalCommand, "relayer approvals should register the reject command")
+
+	cfg.Limits[0].MaxAmount = 2
+	assert.Equal(t, 1.0, m.policy.Limits[0].MaxAmount, "the policy should not change with its config")
+}
+
+func TestWithdrawalPolicyLimits(t *testing.T) {
+	t.Parallel()
+	m, exch := setupWithdrawPolicyTestManager(t, &config.WithdrawalPolicy{
+		Limits: []config.WithdrawalLimit{
+			{Currency: currency.BTC, MaxAmount: 1, DailyAmount: 1.5},
+			{Currency: currency.ETH, MaxAmount: 1},
+		},
+	}, nil)
+
+	_, err := m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1.1))
+	assert.ErrorIs(t, err, errWithdrawalLimitExceeded)
+
+	resp, err := m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, "submitted", resp.Exchange.Status)
+
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(0.6))
+	assert.ErrorIs(t, err, errWithdrawalDailyLimitExceeded)
+
+	ltc := newWithdrawPolicyTestRequest(100)
+	ltc.Currency = currency.LTC
+	_, err = m.SubmitWithdrawal(t.Context(), ltc)
+	assert.NoError(t, err, "currencies without limits should not be limited")
+
+	exch.err = errWithdrawPolicyTest
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(0.5))
+	assert.ErrorIs(t, err, errWithdrawPolicyTest)
+	exch.err = nil
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(0.5))
+	assert.NoError(t, err, "failed withdrawals should not count against the daily limit")
+
+	m.mtx.Lock()
+	for _, u := range m.usage {
+		u.Time = u.Time.Add(-withdrawalUsageWindow)
+	}
+	m.mtx.Unlock()
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	assert.NoError(t, err, "withdrawals older than a day should not count against the daily limit")
+	assert.Len(t, exch.submitted, 4, "only allowed withdrawals should be submitted")
+
+	m.isDryRun = true
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1.1))
+	assert.ErrorIs(t, err, errWithdrawalLimitExceeded, "dry runs should apply the policy")
+}
+
+func TestWithdrawalPolicyAllowlist(t *testing.T) {
+	t.Parallel()
+	cfg := &config.WithdrawalPolicy{
+		Allowlist: []config.WithdrawalAddress{
+			{Currency: currency.BTC, Chain: "btc", Address: withdrawPolicyTestAddress},
+			{Currency: currency.ETH, Address: withdrawPolicyTestAddress, AddedAt: time.Now().Add(-time.Hour)},
+			{Currency: currency.XRP, Address: withdrawPolicyTestAddress, AddressTag: "1337", AddedAt: time.Now()},
+		},
+		AddressCooldown: time.Hour * 2,
+	}
+	m, _ := setupWithdrawPolicyTestManager(t, cfg, nil)
+	assert.False(t, cfg.Allowlist[0].AddedAt.IsZero(), "addresses without an added time should be stamped in the config")
+	assert.Equal(t, cfg.Allowlist[0].AddedAt, m.policy.Allowlist[0].AddedAt, "the policy and config added times should match")
+
+	_, err := m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	assert.ErrorIs(t, err, errAddressCooldown, "addresses without an added time should be in their cooldown")
+
+	req := newWithdrawPolicyTestRequest(1)
+	req.Crypto.Chain = "lightning"
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, errAddressNotAllowlisted, "addresses should only be allowed on their chain")
+
+	req = newWithdrawPolicyTestRequest(1)
+	req.Crypto.Address = "0xbadbeef"
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, errAddressNotAllowlisted)
+
+	req = newWithdrawPolicyTestRequest(1)
+	req.Currency = currency.ETH
+	req.Crypto.Chain = "erc20"
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, errAddressCooldown, "addresses should not be allowed during their cooldown")
+
+	m.policy.AddressCooldown = time.Minute
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.NoError(t, err, "addresses without a chain should be allowed on any chain after their cooldown")
+
+	req = newWithdrawPolicyTestRequest(1)
+	req.Currency = currency.XRP
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.ErrorIs(t, err, errAddressNotAllowlisted, "address tags should match")
+
+	req = newWithdrawPolicyTestRequest(1)
+	req.Type = withdraw.Fiat
+	req.Currency = currency.AUD
+	_, err = m.SubmitWithdrawal(t.Context(), req)
+	assert.NoError(t, err, "the allowlist should not apply to fiat withdrawals")
+}
+
+func TestWithdrawalApproval(t *testing.T) {
+	t.Parallel()
+	comms := &fakeWithdrawComms{}
+	m, exch := setupWithdrawPolicyTestManager(t, &config.WithdrawalPolicy{
+		Limits: []config.WithdrawalLimit{{Currency: currency.BTC, DailyAmount: 2}},
+		Approval: config.WithdrawalApproval{
+			Enabled:    true,
+			TOTPSecret: withdrawPolicyTestSecret,
+			Relayers:   true,
+		},
+	}, comms)
+
+	req := newWithdrawPolicyTestRequest(1)
+	req.OneTimePassword = 1
+	resp, err := m.SubmitWithdrawal(t.Context(), req)
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	assert.Equal(t, withdrawalStatusPendingApproval, resp.Exchange.Status)
+	assert.False(t, resp.ID.IsNil(), "pending withdrawals should have an ID")
+	assert.Empty(t, exch.submitted, "withdrawals awaiting approval should not be submitted")
+	require.Len(t, comms.events, 1, "queued withdrawals must push an event")
+	assert.Contains(t, comms.events[0].Message, resp.ID.String())
+
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending, 1, "GetPendingWithdrawals must return the queued withdrawal")
+	assert.Equal(t, resp.ID, pending[0].ID)
+	assert.Equal(t, withdrawPolicyTestAddress, pending[0].Request.Crypto.Address)
+
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1.5))
+	assert.ErrorIs(t, err, errWithdrawalDailyLimitExceeded, "pending withdrawals should count against the daily limit")
+
+	_, err = m.ApproveWithdrawal(t.Context(), "1337", "")
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "000000")
+	assert.ErrorIs(t, err, errInvalidApprovalCode)
+
+	code, err := totp.GenerateCode(withdrawPolicyTestSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+	approved, err := m.ApproveWithdrawal(t.Context(), resp.ID.String(), code)
+	require.NoError(t, err, "ApproveWithdrawal must not error")
+	assert.Equal(t, "submitted", approved.Exchange.Status)
+	assert.Equal(t, "1337", approved.Exchange.ID)
+	require.Len(t, exch.submitted, 1, "approved withdrawals must be submitted")
+	assert.Equal(t, int64(424242), exch.submitted[0].OneTimePassword, "the exchange one time password should be refreshed on approval")
+
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), code)
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound, "withdrawals should only be approved once")
+
+	resp, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(0.5))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), code)
+	assert.ErrorIs(t, err, errApprovalCodeReused, "approval codes should only be used once")
+	assert.ErrorIs(t, m.RejectWithdrawal("1337"), ErrWithdrawRequestNotFound)
+	require.NoError(t, m.RejectWithdrawal(resp.ID.String()), "RejectWithdrawal must not error")
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	require.NoError(t, err, "rejected withdrawals must not count against the daily limit")
+
+	pending, err = m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	require.Len(t, pending, 1, "rejected withdrawals must not be pending")
+	m.mtx.Lock()
+	m.pending[pending[0].ID].ExpiresAt = time.Now().Add(-time.Second)
+	m.mtx.Unlock()
+	_, err = m.ApproveWithdrawal(t.Context(), pending[0].ID.String(), code)
+	assert.ErrorIs(t, err, errWithdrawalApprovalExpired)
+	pending, err = m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending, "expired withdrawals should not be pending")
+
+	_, err = (*WithdrawManager)(nil).GetPendingWithdrawals()
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	_, err = (*WithdrawManager)(nil).ApproveWithdrawal(t.Context(), "", "")
+	assert.ErrorIs(t, err, ErrNilSubsystem)
+	assert.ErrorIs(t, (*WithdrawManager)(nil).RejectWithdrawal(""), ErrNilSubsystem)
+}
+
+func TestWithdrawalApprovalAttempts(t *testing.T) {
+	t.Parallel()
+	m, exch := setupWithdrawPolicyTestManager(t, &config.WithdrawalPolicy{
+		Limits: []config.WithdrawalLimit{{Currency: currency.BTC, DailyAmount: 1}},
+		Approval: config.WithdrawalApproval{
+			Enabled:    true,
+			TOTPSecret: withdrawPolicyTestSecret,
+		},
+	}, nil)
+
+	resp, err := m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	for range maxWithdrawalApprovalAttempts - 1 {
+		_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "000000")
+		require.ErrorIs(t, err, errInvalidApprovalCode)
+		require.NotErrorIs(t, err, errWithdrawalApprovalAttempts, "withdrawals must not be discarded before the attempt limit")
+	}
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), "000000")
+	assert.ErrorIs(t, err, errInvalidApprovalCode)
+	assert.ErrorIs(t, err, errWithdrawalApprovalAttempts, "withdrawals should be discarded at the attempt limit")
+
+	pending, err := m.GetPendingWithdrawals()
+	require.NoError(t, err, "GetPendingWithdrawals must not error")
+	assert.Empty(t, pending, "discarded withdrawals should not be pending")
+	code, err := totp.GenerateCode(withdrawPolicyTestSecret, time.Now())
+	require.NoError(t, err, "GenerateCode must not error")
+	_, err = m.ApproveWithdrawal(t.Context(), resp.ID.String(), code)
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound, "discarded withdrawals should not be approved with a valid code")
+	assert.Empty(t, exch.submitted, "discarded withdrawals should not be submitted")
+
+	_, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	assert.NoError(t, err, "discarded withdrawals should not count against the daily limit")
+}
+
+func TestWithdrawalApprovalCommands(t *testing.T) {
+	t.Parallel()
+	comms := &fakeWithdrawComms{}
+	m, exch := setupWithdrawPolicyTestManager(t, &config.WithdrawalPolicy{
+		Approval: config.WithdrawalApproval{
+			Enabled:         true,
+			Relayers:        true,
+			AuthorisedUsers: map[string][]string{"Telegram": {"satoshi"}, "slack": {"Satoshi"}},
+		},
+	}, comms)
+	require.NotNil(t, comms.commands[approveWithdrawalCommand], "approve command must be registered")
+	require.NotNil(t, comms.commands[rejectWithdrawalCommand], "reject command must be registered")
+	assert.True(t, comms.commands[approveWithdrawalCommand].Confirm, "approvals should require confirmation")
+	approve, reject := comms.commands[approveWithdrawalCommand].Handler, comms.commands[rejectWithdrawalCommand].Handler
+
+	_, err := approve("telegram", "mallory", []string{"1337"})
+	assert.ErrorIs(t, err, errWithdrawalApproverUnauthorised)
+	_, err = reject("slack", "mallory", []string{"1337"})
+	assert.ErrorIs(t, err, errWithdrawalApproverUnauthorised)
+
+	_, err = approve("telegram", "satoshi", nil)
+	assert.ErrorIs(t, err, errWithdrawalIDRequired)
+	_, err = reject("telegram", "satoshi", nil)
+	assert.ErrorIs(t, err, errWithdrawalIDRequired)
+
+	resp, err := m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	reply, err := approve("telegram", "satoshi", []string{resp.ID.String()})
+	require.NoError(t, err, "approve command must not error")
+	assert.Contains(t, reply, "submitted")
+	assert.Len(t, exch.submitted, 1, "withdrawals approved by relayers should be submitted")
+
+	resp, err = m.SubmitWithdrawal(t.Context(), newWithdrawPolicyTestRequest(1))
+	require.NoError(t, err, "SubmitWithdrawal must not error")
+	reply, err = reject("slack", "satoshi", []string{resp.ID.String()})
+	require.NoError(t, err, "reject command must not error")
+	assert.Contains(t, reply, "rejected")
+	_, err = approve("telegram", "satoshi", []string{resp.ID.String()})
+	assert.ErrorIs(t, err, ErrWithdrawRequestNotFound)
+}
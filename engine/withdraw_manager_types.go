@@ -2,15 +2,96 @@ package engine
 
 import (
 	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 // ErrWithdrawRequestNotFound message to display when no record is found
 var ErrWithdrawRequestNotFound = errors.New("request not found")
 
+var (
+	errWithdrawalLimitExceeded        = errors.New("withdrawal exceeds maximum amount")
+	errWithdrawalDailyLimitExceeded   = errors.New("withdrawal exceeds daily limit")
+	errAddressNotAllowlisted          = errors.New("withdrawal address is not allowlisted")
+	errAddressCooldown                = errors.New("withdrawal address is in its cooldown period")
+	errInvalidWithdrawalPolicy        = errors.New("invalid withdrawal policy")
+	errWithdrawalApprovalExpired      = errors.New("withdrawal approval expired")
+	errInvalidApprovalCode            = errors.New("invalid approval code")
+	errApprovalCodeReused             = errors.New("approval code already used")
+	errWithdrawalApprovalAttempts     = errors.New("withdrawal discarded due to too many failed approvals")
+	errWithdrawalApproverUnauthorised = errors.New("not authorised to approve or reject withdrawals")
+	errWithdrawalIDRequired           = errors.New("withdrawal ID required")
+)
+
+// Withdrawal statuses recorded for requests which have not been submitted to
+// an exchange
+const (
+	withdrawalStatusPendingApproval = "pending approval"
+	withdrawalStatusRejected        = "rejected"
+	withdrawalStatusExpired         = "expired"
+	withdrawalStatusApprovalFailed  = "approval failed"
+	withdrawalStatusPolicyDenied    = "policy denied"
+)
+
+const (
+	defaultWithdrawalApprovalTimeout = time.Hour
+	withdrawalUsageWindow            = time.Hour * 24
+	withdrawalUsageSeedLimit         = 1000
+	// maxWithdrawalApprovalAttempts is the number of failed approvals after
+	// which a pending withdrawal is discarded
+	maxWithdrawalApprovalAttempts = 5
+	// approvalCodePeriod is the TOTP period in seconds of approval codes
+	approvalCodePeriod = 30
+
+	approveWithdrawalCommand = "approvewithdrawal"
+	rejectWithdrawalCommand  = "rejectwithdrawal"
+)
+
 // WithdrawManager is responsible for performing withdrawal requests and
 // saving them to the database
 type WithdrawManager struct {
 	exchangeManager  iExchangeManager
 	portfolioManager iPortfolioManager
 	isDryRun         bool
+
+	policy      *config.WithdrawalPolicy
+	comms       iWithdrawalCommsManager
+	exchangeOTP func(exchange string) (string, error)
+	pending     map[uuid.UUID]*PendingWithdrawal
+	usage       []*withdrawalUsage
+	// lastApprovalStep is the TOTP time step of the last approval code used,
+	// so codes cannot be replayed
+	lastApprovalStep int64
+	mtx              sync.Mutex
+}
+
+// PendingWithdrawal is a withdrawal request awaiting approval
+type PendingWithdrawal struct {
+	ID        uuid.UUID
+	Request   withdraw.Request
+	CreatedAt time.Time
+	ExpiresAt time.Time
+
+	usage           *withdrawalUsage
+	failedApprovals int
+}
+
+// withdrawalUsage is an amount counted against the daily withdrawal limit
+type withdrawalUsage struct {
+	Currency currency.Code
+	Amount   float64
+	Time     time.Time
+}
+
+// iWithdrawalCommsManager limits exposure of the communications manager to
+// the withdraw manager
+type iWithdrawalCommsManager interface {
+	PushEvent(base.Event)
+	RegisterCommand(*CommsCommand) error
 }
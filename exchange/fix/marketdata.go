@@ -0,0 +1,195 @@
+package fix
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// MDEntryType values
+const (
+	MDEntryTypeBid   = "0"
+	MDEntryTypeOffer = "1"
+)
+
+// MDUpdateAction values
+const (
+	MDUpdateActionNew    = "0"
+	MDUpdateActionChange = "1"
+	MDUpdateActionDelete = "2"
+)
+
+var (
+	errNotMarketData   = errors.New("FIX message is not market data")
+	errPairLookupUnset = errors.New("FIX pair lookup unset")
+)
+
+// MarketDataRequest returns a request subscribing to bid and offer updates for
+// the symbols. A depth of 0 requests the full book
+func MarketDataRequest(reqID string, symbols []string, depth int) *Message {
+	m := NewMessage(MsgTypeMarketDataRequest).
+		Set(TagMDReqID, reqID).
+		Set(TagSubscriptionRequestType, "1").
+		SetInt(TagMarketDepth, depth).
+		Set(TagMDUpdateType, "1").
+		SetInt(TagNoMDEntryTypes, 2).
+		Add(TagMDEntryType, MDEntryTypeBid).
+		Add(TagMDEntryType, MDEntryTypeOffer).
+		SetInt(TagNoRelatedSym, len(symbols))
+	for _, s := range symbols {
+		m.Add(TagSymbol, s)
+	}
+	return m
+}
+
+// MarketDataEntry is a single bid or offer from a market data message
+type MarketDataEntry struct {
+	Symbol string
+	// Action is one of the MDUpdateAction values and is empty for snapshots
+	Action string
+	Type   string
+	Price  float64
+	Size   float64
+}
+
+// ParseMarketData returns the bid and offer entries of a
+// MarketDataSnapshotFullRefresh or MarketDataIncrementalRefresh. Entries
+// without a symbol inherit the message symbol
+func ParseMarketData(m *Message) ([]MarketDataEntry, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%w: Message", common.ErrNilPointer)
+	}
+	// Each repeating group entry starts with its first field, which differs
+	// between snapshots and incremental refreshes
+	delimiter := TagMDEntryType
+	switch m.MsgType {
+	case MsgTypeMarketDataSnapshotFullRefresh:
+	case MsgTypeMarketDataIncrementalRefresh:
+		delimiter = TagMDUpdateAction
+	default:
+		return nil, fmt.Errorf("%w: %s", errNotMarketData, m.MsgType)
+	}
+
+	symbol := m.GetString(TagSymbol)
+	var entries []MarketDataEntry
+	var inGroup bool
+	for _, f := range m.Fields {
+		if f.Tag == TagNoMDEntries {
+			inGroup = true
+			continue
+		}
+		if !inGroup {
+			continue
+		}
+		if f.Tag == delimiter {
+			entries = append(entries, MarketDataEntry{Symbol: symbol})
+		}
+		if len(entries) == 0 {
+			continue
+		}
+		e := &entries[len(entries)-1]
+		var err error
+		switch f.Tag {
+		case TagSymbol:
+			e.Symbol = f.Value
+		case TagMDUpdateAction:
+			e.Action = f.Value
+		case TagMDEntryType:
+			e.Type = f.Value
+		case TagMDEntryPx:
+			e.Price, err = strconv.ParseFloat(f.Value, 64)
+		case TagMDEntrySize:
+			e.Size, err = strconv.ParseFloat(f.Value, 64)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %d=%s", errInvalidField, f.Tag, f.Value)
+		}
+	}
+	return entries, nil
+}
+
+// ParseOrderbookSnapshot converts a MarketDataSnapshotFullRefresh to an
+// orderbook which can be loaded with orderbook.Process or a websocket
+// orderbook buffer
+func ParseOrderbookSnapshot(m *Message, exch string, pair currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	entries, err := ParseMarketData(m)
+	if err != nil {
+		return nil, err
+	}
+	if m.MsgType != MsgTypeMarketDataSnapshotFullRefresh {
+		return nil, fmt.Errorf("%w: %s is not a snapshot", errNotMarketData, m.MsgType)
+	}
+	b := &orderbook.Base{
+		Exchange:    exch,
+		Pair:        pair,
+		Asset:       a,
+		LastUpdated: sendingTime(m),
+	}
+	for _, e := range entries {
+		switch e.Type {
+		case MDEntryTypeBid:
+			b.Bids = append(b.Bids, orderbook.Tranche{Price: e.Price, Amount: e.Size})
+		case MDEntryTypeOffer:
+			b.Asks = append(b.Asks, orderbook.Tranche{Price: e.Price, Amount: e.Size})
+		}
+	}
+	b.Bids.SortBids()
+	b.Asks.SortAsks()
+	return b, nil
+}
+
+// ParseOrderbookUpdates converts a MarketDataIncrementalRefresh to price level
+// updates for each symbol, in the order symbols first appear. Deleted levels
+// have a zero amount, matching orderbook.Depth's UpdateBidAskByPrice
+func ParseOrderbookUpdates(m *Message, pairs func(symbol string) (currency.Pair, error), a asset.Item) ([]*orderbook.Update, error) {
+	if pairs == nil {
+		return nil, errPairLookupUnset
+	}
+	entries, err := ParseMarketData(m)
+	if err != nil {
+		return nil, err
+	}
+	if m.MsgType != MsgTypeMarketDataIncrementalRefresh {
+		return nil, fmt.Errorf("%w: %s is not an incremental refresh", errNotMarketData, m.MsgType)
+	}
+	updateTime := sendingTime(m)
+	var updates []*orderbook.Update
+	bySymbol := make(map[string]*orderbook.Update)
+	for _, e := range entries {
+		u, ok := bySymbol[e.Symbol]
+		if !ok {
+			p, err := pairs(e.Symbol)
+			if err != nil {
+				return nil, err
+			}
+			u = &orderbook.Update{Pair: p, Asset: a, UpdateTime: updateTime, UpdatePushedAt: updateTime}
+			bySymbol[e.Symbol] = u
+			updates = append(updates, u)
+		}
+		level := orderbook.Tranche{Price: e.Price, Amount: e.Size}
+		if e.Action == MDUpdateActionDelete {
+			level.Amount = 0
+		}
+		switch e.Type {
+		case MDEntryTypeBid:
+			u.Bids = append(u.Bids, level)
+		case MDEntryTypeOffer:
+			u.Asks = append(u.Asks, level)
+		}
+	}
+	return updates, nil
+}
+
+// sendingTime returns the SendingTime of a message, or now if it is missing
+func sendingTime(m *Message) time.Time {
+	if t, err := m.GetTime(TagSendingTime); err == nil {
+		return t
+	}
+	return time.Now()
+}
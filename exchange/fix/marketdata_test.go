@@ -0,0 +1,92 @@
+package fix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestMarketDataRequest(t *testing.T) {
+	t.Parallel()
+	m := MarketDataRequest("1", []string{"BTC-USD", "ETH-USD"}, 10)
+	assert.Equal(t, MsgTypeMarketDataRequest, m.MsgType, "MsgType should be MarketDataRequest")
+	assert.Equal(t, "10", m.GetString(TagMarketDepth), "MarketDepth should be set")
+	assert.Equal(t, "2", m.GetString(TagNoRelatedSym), "NoRelatedSym should be the number of symbols")
+	assert.Equal(t, []Field{{TagMDEntryType, MDEntryTypeBid}, {TagMDEntryType, MDEntryTypeOffer}, {TagNoRelatedSym, "2"}, {TagSymbol, "BTC-USD"}, {TagSymbol, "ETH-USD"}}, m.Fields[len(m.Fields)-5:], "Repeating groups should be added in order")
+}
+
+func TestParseOrderbookSnapshot(t *testing.T) {
+	t.Parallel()
+	_, err := ParseMarketData(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "ParseMarketData should error for a nil message")
+	_, err = ParseMarketData(NewMessage(MsgTypeHeartbeat))
+	assert.ErrorIs(t, err, errNotMarketData, "ParseMarketData should error for other messages")
+
+	m := NewMessage(MsgTypeMarketDataSnapshotFullRefresh).
+		Set(TagSendingTime, "20240102-03:04:05.678").
+		Set(TagSymbol, "BTC-USD").
+		SetInt(TagNoMDEntries, 4).
+		Add(TagMDEntryType, MDEntryTypeBid).Add(TagMDEntryPx, "99").Add(TagMDEntrySize, "1").
+		Add(TagMDEntryType, MDEntryTypeBid).Add(TagMDEntryPx, "100").Add(TagMDEntrySize, "2").
+		Add(TagMDEntryType, MDEntryTypeOffer).Add(TagMDEntryPx, "102").Add(TagMDEntrySize, "3").
+		Add(TagMDEntryType, MDEntryTypeOffer).Add(TagMDEntryPx, "101").Add(TagMDEntrySize, "4")
+	p := currency.NewBTCUSD()
+	b, err := ParseOrderbookSnapshot(m, "test", p, asset.Spot)
+	require.NoError(t, err, "ParseOrderbookSnapshot must not error")
+	assert.Equal(t, "test", b.Exchange, "Exchange should be set")
+	assert.True(t, b.Pair.Equal(p), "Pair should be set")
+	assert.Equal(t, asset.Spot, b.Asset, "Asset should be set")
+	assert.Equal(t, 2024, b.LastUpdated.Year(), "LastUpdated should be the sending time")
+	assert.Equal(t, orderbook.Tranches{{Price: 100, Amount: 2}, {Price: 99, Amount: 1}}, b.Bids, "Bids should be sorted descending")
+	assert.Equal(t, orderbook.Tranches{{Price: 101, Amount: 4}, {Price: 102, Amount: 3}}, b.Asks, "Asks should be sorted ascending")
+
+	_, err = ParseOrderbookSnapshot(NewMessage(MsgTypeMarketDataIncrementalRefresh), "test", p, asset.Spot)
+	assert.ErrorIs(t, err, errNotMarketData, "ParseOrderbookSnapshot should error for an incremental refresh")
+	m.Add(TagMDEntryType, MDEntryTypeBid).Add(TagMDEntryPx, "bad")
+	_, err = ParseOrderbookSnapshot(m, "test", p, asset.Spot)
+	assert.ErrorIs(t, err, errInvalidField, "ParseOrderbookSnapshot should error for an invalid price")
+}
+
+func TestParseOrderbookUpdates(t *testing.T) {
+	t.Parallel()
+	btc, eth := currency.NewBTCUSD(), currency.NewPair(currency.ETH, currency.USD)
+	pairs := func(symbol string) (currency.Pair, error) {
+		switch symbol {
+		case "BTC-USD":
+			return btc, nil
+		case "ETH-USD":
+			return eth, nil
+		}
+		return currency.EMPTYPAIR, currency.ErrPairNotFound
+	}
+	m := NewMessage(MsgTypeMarketDataIncrementalRefresh).
+		Set(TagSendingTime, "20240102-03:04:05.678").
+		SetInt(TagNoMDEntries, 3).
+		Add(TagMDUpdateAction, MDUpdateActionNew).Add(TagMDEntryType, MDEntryTypeBid).Add(TagSymbol, "BTC-USD").Add(TagMDEntryPx, "100").Add(TagMDEntrySize, "1").
+		Add(TagMDUpdateAction, MDUpdateActionDelete).Add(TagMDEntryType, MDEntryTypeOffer).Add(TagSymbol, "ETH-USD").Add(TagMDEntryPx, "10").Add(TagMDEntrySize, "5").
+		Add(TagMDUpdateAction, MDUpdateActionChange).Add(TagMDEntryType, MDEntryTypeOffer).Add(TagSymbol, "BTC-USD").Add(TagMDEntryPx, "101").Add(TagMDEntrySize, "2")
+
+	_, err := ParseOrderbookUpdates(m, nil, asset.Spot)
+	assert.ErrorIs(t, err, errPairLookupUnset, "ParseOrderbookUpdates should error without a pair lookup")
+	updates, err := ParseOrderbookUpdates(m, pairs, asset.Spot)
+	require.NoError(t, err, "ParseOrderbookUpdates must not error")
+	require.Len(t, updates, 2, "ParseOrderbookUpdates must return an update per symbol")
+	assert.True(t, updates[0].Pair.Equal(btc), "First update should be for the first symbol")
+	assert.Equal(t, asset.Spot, updates[0].Asset, "Asset should be set")
+	assert.False(t, updates[0].UpdateTime.IsZero(), "UpdateTime should be set")
+	assert.Equal(t, []orderbook.Tranche{{Price: 100, Amount: 1}}, updates[0].Bids, "Bids should be updated")
+	assert.Equal(t, []orderbook.Tranche{{Price: 101, Amount: 2}}, updates[0].Asks, "Asks should be updated")
+	assert.True(t, updates[1].Pair.Equal(eth), "Second update should be for the second symbol")
+	assert.Equal(t, []orderbook.Tranche{{Price: 10}}, updates[1].Asks, "Deleted levels should have no amount")
+
+	_, err = ParseOrderbookUpdates(NewMessage(MsgTypeMarketDataSnapshotFullRefresh), pairs, asset.Spot)
+	assert.ErrorIs(t, err, errNotMarketData, "ParseOrderbookUpdates should error for a snapshot")
+	m.Add(TagMDUpdateAction, MDUpdateActionNew).Add(TagSymbol, "LTC-USD")
+	_, err = ParseOrderbookUpdates(m, pairs, asset.Spot)
+	assert.ErrorIs(t, err, currency.ErrPairNotFound, "ParseOrderbookUpdates should return pair lookup errors")
+}
@@ -0,0 +1,362 @@
+package fix
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// BeginString is the protocol version sent in each message
+const BeginString = "FIX.4.4"
+
+// SOH is the field delimiter
+const SOH = '\x01'
+
+// timestampFormat is the UTCTimestamp format used for SendingTime and
+// TransactTime
+const timestampFormat = "20060102-15:04:05.000"
+
+// maxBodyLength limits how much is read for a single message
+const maxBodyLength = 1 << 20
+
+// Session and application tags
+const (
+	TagAvgPx                   = 6
+	TagBeginSeqNo              = 7
+	TagBeginString             = 8
+	TagBodyLength              = 9
+	TagCheckSum                = 10
+	TagClOrdID                 = 11
+	TagCumQty                  = 14
+	TagEndSeqNo                = 16
+	TagExecID                  = 17
+	TagExecInst                = 18
+	TagMsgSeqNum               = 34
+	TagMsgType                 = 35
+	TagNewSeqNo                = 36
+	TagOrderID                 = 37
+	TagOrderQty                = 38
+	TagOrdStatus               = 39
+	TagOrdType                 = 40
+	TagOrigClOrdID             = 41
+	TagPossDupFlag             = 43
+	TagPrice                   = 44
+	TagRefSeqNum               = 45
+	TagSenderCompID            = 49
+	TagSendingTime             = 52
+	TagSide                    = 54
+	TagSymbol                  = 55
+	TagTargetCompID            = 56
+	TagText                    = 58
+	TagTimeInForce             = 59
+	TagTransactTime            = 60
+	TagRawDataLength           = 95
+	TagRawData                 = 96
+	TagEncryptMethod           = 98
+	TagStopPx                  = 99
+	TagHeartBtInt              = 108
+	TagTestReqID               = 112
+	TagOrigSendingTime         = 122
+	TagGapFillFlag             = 123
+	TagResetSeqNumFlag         = 141
+	TagNoRelatedSym            = 146
+	TagExecType                = 150
+	TagLeavesQty               = 151
+	TagMDReqID                 = 262
+	TagSubscriptionRequestType = 263
+	TagMarketDepth             = 264
+	TagMDUpdateType            = 265
+	TagNoMDEntryTypes          = 267
+	TagNoMDEntries             = 268
+	TagMDEntryType             = 269
+	TagMDEntryPx               = 270
+	TagMDEntrySize             = 271
+	TagMDUpdateAction          = 279
+	TagBusinessRejectRefID     = 379
+	TagUsername                = 553
+	TagPassword                = 554
+)
+
+// Message types
+const (
+	MsgTypeHeartbeat                     = "0"
+	MsgTypeTestRequest                   = "1"
+	MsgTypeResendRequest                 = "2"
+	MsgTypeReject                        = "3"
+	MsgTypeSequenceReset                 = "4"
+	MsgTypeLogout                        = "5"
+	MsgTypeExecutionReport               = "8"
+	MsgTypeOrderCancelReject             = "9"
+	MsgTypeLogon                         = "A"
+	MsgTypeNewOrderSingle                = "D"
+	MsgTypeOrderCancelRequest            = "F"
+	MsgTypeOrderCancelReplaceRequest     = "G"
+	MsgTypeBusinessMessageReject         = "j"
+	MsgTypeMarketDataRequest             = "V"
+	MsgTypeMarketDataSnapshotFullRefresh = "W"
+	MsgTypeMarketDataIncrementalRefresh  = "X"
+	MsgTypeMarketDataRequestReject       = "Y"
+)
+
+// headerTags are set by the session and are not copied when a message is
+// resent
+var headerTags = map[int]bool{
+	TagBeginString:     true,
+	TagBodyLength:      true,
+	TagMsgType:         true,
+	TagSenderCompID:    true,
+	TagTargetCompID:    true,
+	TagMsgSeqNum:       true,
+	TagSendingTime:     true,
+	TagPossDupFlag:     true,
+	TagOrigSendingTime: true,
+	TagCheckSum:        true,
+}
+
+var (
+	errInvalidMessage = errors.New("invalid FIX message")
+	errInvalidField   = errors.New("invalid FIX field")
+	errBadChecksum    = errors.New("FIX checksum mismatch")
+	errFieldNotFound  = errors.New("FIX field not found")
+)
+
+// Field is a single tag value pair
+type Field struct {
+	Tag   int
+	Value string
+}
+
+// Message is a FIX message. Fields are held in order so repeating groups are
+// preserved; BeginString, BodyLength and CheckSum are calculated on encode
+type Message struct {
+	MsgType string
+	Fields  []Field
+}
+
+// NewMessage returns a message of the given type
+func NewMessage(msgType string) *Message {
+	return &Message{MsgType: msgType}
+}
+
+// Set replaces the first field with the tag, or appends it if not present
+func (m *Message) Set(tag int, value string) *Message {
+	for i := range m.Fields {
+		if m.Fields[i].Tag == tag {
+			m.Fields[i].Value = value
+			return m
+		}
+	}
+	return m.Add(tag, value)
+}
+
+// Add appends a field, allowing repeated tags in repeating groups
+func (m *Message) Add(tag int, value string) *Message {
+	m.Fields = append(m.Fields, Field{Tag: tag, Value: value})
+	return m
+}
+
+// SetInt sets an integer field
+func (m *Message) SetInt(tag, value int) *Message {
+	return m.Set(tag, strconv.Itoa(value))
+}
+
+// SetFloat sets a decimal field
+func (m *Message) SetFloat(tag int, value float64) *Message {
+	return m.Set(tag, strconv.FormatFloat(value, 'f', -1, 64))
+}
+
+// SetTime sets a UTCTimestamp field
+func (m *Message) SetTime(tag int, t time.Time) *Message {
+	return m.Set(tag, t.UTC().Format(timestampFormat))
+}
+
+// Has returns whether the message contains the tag
+func (m *Message) Has(tag int) bool {
+	_, ok := m.Get(tag)
+	return ok
+}
+
+// Get returns the value of the first field with the tag
+func (m *Message) Get(tag int) (string, bool) {
+	for i := range m.Fields {
+		if m.Fields[i].Tag == tag {
+			return m.Fields[i].Value, true
+		}
+	}
+	return "", false
+}
+
+// GetString returns the value of the first field with the tag or an empty
+// string
+func (m *Message) GetString(tag int) string {
+	v, _ := m.Get(tag)
+	return v
+}
+
+// GetInt returns the integer value of the first field with the tag
+func (m *Message) GetInt(tag int) (int, error) {
+	v, ok := m.Get(tag)
+	if !ok {
+		return 0, fmt.Errorf("%w: %d", errFieldNotFound, tag)
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %d=%s", errInvalidField, tag, v)
+	}
+	return i, nil
+}
+
+// GetFloat returns the decimal value of the first field with the tag
+func (m *Message) GetFloat(tag int) (float64, error) {
+	v, ok := m.Get(tag)
+	if !ok {
+		return 0, fmt.Errorf("%w: %d", errFieldNotFound, tag)
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %d=%s", errInvalidField, tag, v)
+	}
+	return f, nil
+}
+
+// GetBool returns whether the first field with the tag is Y
+func (m *Message) GetBool(tag int) bool {
+	return m.GetString(tag) == "Y"
+}
+
+// GetTime returns the UTCTimestamp value of the first field with the tag.
+// Timestamps with and without milliseconds are accepted
+func (m *Message) GetTime(tag int) (time.Time, error) {
+	v, ok := m.Get(tag)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%w: %d", errFieldNotFound, tag)
+	}
+	t, err := time.Parse("20060102-15:04:05.999999999", v)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %d=%s", errInvalidField, tag, v)
+	}
+	return t, nil
+}
+
+// SeqNum returns the MsgSeqNum of the message
+func (m *Message) SeqNum() (int, error) {
+	return m.GetInt(TagMsgSeqNum)
+}
+
+// Copy returns a deep copy of the message
+func (m *Message) Copy() *Message {
+	c := &Message{MsgType: m.MsgType, Fields: make([]Field, len(m.Fields))}
+	copy(c.Fields, m.Fields)
+	return c
+}
+
+// Bytes encodes the message, calculating BodyLength and CheckSum
+func (m *Message) Bytes() []byte {
+	var body bytes.Buffer
+	writeField(&body, TagMsgType, m.MsgType)
+	for i := range m.Fields {
+		if m.Fields[i].Tag == TagMsgType || m.Fields[i].Tag == TagBeginString || m.Fields[i].Tag == TagBodyLength || m.Fields[i].Tag == TagCheckSum {
+			continue
+		}
+		writeField(&body, m.Fields[i].Tag, m.Fields[i].Value)
+	}
+	var out bytes.Buffer
+	writeField(&out, TagBeginString, BeginString)
+	writeField(&out, TagBodyLength, strconv.Itoa(body.Len()))
+	out.Write(body.Bytes())
+	writeField(&out, TagCheckSum, fmt.Sprintf("%03d", checksum(out.Bytes())))
+	return out.Bytes()
+}
+
+// String returns the encoded message with SOH delimiters replaced for logging
+func (m *Message) String() string {
+	return printable(m.Bytes())
+}
+
+func writeField(b *bytes.Buffer, tag int, value string) {
+	b.WriteString(strconv.Itoa(tag))
+	b.WriteByte('=')
+	b.WriteString(value)
+	b.WriteByte(SOH)
+}
+
+func checksum(b []byte) int {
+	var sum int
+	for _, c := range b {
+		sum += int(c)
+	}
+	return sum % 256
+}
+
+// ReadMessage reads and validates a single message from r
+func ReadMessage(r *bufio.Reader) (*Message, []byte, error) {
+	begin, err := r.ReadBytes(SOH)
+	if err != nil {
+		return nil, nil, err
+	}
+	if string(begin) != "8="+BeginString+string(SOH) {
+		return nil, nil, fmt.Errorf("%w: unexpected BeginString %q", errInvalidMessage, bytes.TrimSuffix(begin, []byte{SOH}))
+	}
+	length, err := r.ReadBytes(SOH)
+	if err != nil {
+		return nil, nil, err
+	}
+	n, err := strconv.Atoi(string(bytes.TrimPrefix(bytes.TrimSuffix(length, []byte{SOH}), []byte("9="))))
+	if err != nil || !bytes.HasPrefix(length, []byte("9=")) || n <= 0 || n > maxBodyLength {
+		return nil, nil, fmt.Errorf("%w: invalid BodyLength %q", errInvalidMessage, bytes.TrimSuffix(length, []byte{SOH}))
+	}
+	// Body followed by "10=" + 3 digit checksum + SOH
+	rest := make([]byte, n+7)
+	if _, err := io.ReadFull(r, rest); err != nil {
+		return nil, nil, err
+	}
+	raw := make([]byte, 0, len(begin)+len(length)+len(rest))
+	raw = append(append(append(raw, begin...), length...), rest...)
+	m, err := ParseMessage(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	return m, raw, nil
+}
+
+// ParseMessage parses and validates a single encoded message
+func ParseMessage(raw []byte) (*Message, error) {
+	trailer := bytes.LastIndex(raw[:max(len(raw)-1, 0)], []byte{SOH})
+	if trailer < 0 || raw[len(raw)-1] != SOH || !bytes.HasPrefix(raw[trailer+1:], []byte("10=")) {
+		return nil, fmt.Errorf("%w: missing CheckSum", errInvalidMessage)
+	}
+	want, err := strconv.Atoi(string(raw[trailer+4 : len(raw)-1]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid CheckSum", errInvalidMessage)
+	}
+	if got := checksum(raw[:trailer+1]); got != want {
+		return nil, fmt.Errorf("%w: calculated %03d, received %03d", errBadChecksum, got, want)
+	}
+
+	m := &Message{}
+	for _, f := range bytes.Split(raw[:trailer], []byte{SOH}) {
+		tag, value, ok := bytes.Cut(f, []byte{'='})
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", errInvalidField, f)
+		}
+		t, err := strconv.Atoi(string(tag))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", errInvalidField, f)
+		}
+		switch t {
+		case TagBeginString, TagBodyLength:
+		case TagMsgType:
+			m.MsgType = string(value)
+		default:
+			m.Fields = append(m.Fields, Field{Tag: t, Value: string(value)})
+		}
+	}
+	if m.MsgType == "" {
+		return nil, fmt.Errorf("%w: missing MsgType", errInvalidMessage)
+	}
+	return m, nil
+}
@@ -0,0 +1,98 @@
+package fix
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageBytes(t *testing.T) {
+	t.Parallel()
+	m := NewMessage(MsgTypeHeartbeat).
+		Set(TagSenderCompID, "GCT").
+		Set(TagTargetCompID, "VENUE").
+		SetInt(TagMsgSeqNum, 1).
+		Set(TagSendingTime, "20240102-03:04:05.678")
+	assert.Equal(t, "8=FIX.4.4|9=51|35=0|49=GCT|56=VENUE|34=1|52=20240102-03:04:05.678|10=047|", m.String(), "String should encode the message")
+
+	parsed, err := ParseMessage(m.Bytes())
+	require.NoError(t, err, "ParseMessage must not error")
+	assert.Equal(t, m, parsed, "ParseMessage should return the encoded message")
+}
+
+func TestMessageFields(t *testing.T) {
+	t.Parallel()
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 678000000, time.UTC)
+	m := NewMessage(MsgTypeExecutionReport).
+		SetInt(TagMsgSeqNum, 7).
+		SetFloat(TagPrice, 1337.5).
+		SetTime(TagTransactTime, ts).
+		Set(TagPossDupFlag, "Y").
+		Add(TagMDEntryType, "0").
+		Add(TagMDEntryType, "1")
+
+	seq, err := m.SeqNum()
+	require.NoError(t, err, "SeqNum must not error")
+	assert.Equal(t, 7, seq, "SeqNum should return the MsgSeqNum")
+	price, err := m.GetFloat(TagPrice)
+	require.NoError(t, err, "GetFloat must not error")
+	assert.Equal(t, 1337.5, price, "GetFloat should return the price")
+	got, err := m.GetTime(TagTransactTime)
+	require.NoError(t, err, "GetTime must not error")
+	assert.Equal(t, ts, got, "GetTime should return the time")
+	assert.True(t, m.GetBool(TagPossDupFlag), "GetBool should return true for Y")
+	assert.Equal(t, "0", m.GetString(TagMDEntryType), "GetString should return the first repeated field")
+
+	m.Set(TagMDEntryType, "2")
+	assert.Equal(t, []Field{{TagMDEntryType, "2"}, {TagMDEntryType, "1"}}, m.Fields[len(m.Fields)-2:], "Set should replace the first field")
+	c := m.Copy()
+	c.Set(TagPrice, "1")
+	assert.Equal(t, "1337.5", m.GetString(TagPrice), "Copy should not share fields")
+
+	_, err = m.GetInt(TagText)
+	assert.ErrorIs(t, err, errFieldNotFound, "GetInt should error for a missing field")
+	_, err = m.GetInt(TagPrice)
+	assert.ErrorIs(t, err, errInvalidField, "GetInt should error for an invalid field")
+	_, err = m.GetFloat(TagText)
+	assert.ErrorIs(t, err, errFieldNotFound, "GetFloat should error for a missing field")
+	_, err = m.GetTime(TagPrice)
+	assert.ErrorIs(t, err, errInvalidField, "GetTime should error for an invalid field")
+}
+
+func TestReadMessage(t *testing.T) {
+	t.Parallel()
+	first := NewMessage(MsgTypeLogon).SetInt(TagMsgSeqNum, 1).SetInt(TagHeartBtInt, 30)
+	second := NewMessage(MsgTypeHeartbeat).SetInt(TagMsgSeqNum, 2)
+	r := bufio.NewReader(bytes.NewReader(append(first.Bytes(), second.Bytes()...)))
+	m, raw, err := ReadMessage(r)
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.Equal(t, first, m, "ReadMessage should return the first message")
+	assert.Equal(t, first.Bytes(), raw, "ReadMessage should return the raw message")
+	m, _, err = ReadMessage(r)
+	require.NoError(t, err, "ReadMessage must not error")
+	assert.Equal(t, second, m, "ReadMessage should return the second message")
+	_, _, err = ReadMessage(r)
+	assert.ErrorIs(t, err, io.EOF, "ReadMessage should return EOF")
+
+	for name, tc := range map[string]struct {
+		raw string
+		err error
+	}{
+		"begin string": {"8=FIX.4.2\x019=5\x0135=0\x0110=000\x01", errInvalidMessage},
+		"body length":  {"8=FIX.4.4\x019=abc\x0135=0\x0110=000\x01", errInvalidMessage},
+		"checksum":     {"8=FIX.4.4\x019=5\x0135=0\x0110=000\x01", errBadChecksum},
+	} {
+		_, _, err := ReadMessage(bufio.NewReader(bytes.NewReader([]byte(tc.raw))))
+		assert.ErrorIsf(t, err, tc.err, "ReadMessage should error for an invalid %s", name)
+	}
+
+	_, err = ParseMessage([]byte("8=FIX.4.4\x019=5\x0135=0\x01"))
+	assert.ErrorIs(t, err, errInvalidMessage, "ParseMessage should error without a checksum")
+	_, err = ParseMessage(NewMessage("").Bytes())
+	assert.ErrorIs(t, err, errInvalidMessage, "ParseMessage should error without a MsgType")
+}
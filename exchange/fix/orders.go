@@ -0,0 +1,346 @@
+package fix
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Public errors
+var (
+	ErrOrderRejected  = errors.New("FIX order rejected")
+	ErrCancelRejected = errors.New("FIX cancel rejected")
+)
+
+var (
+	errSymbolUnset        = errors.New("FIX symbol unset")
+	errUnsupportedSide    = errors.New("unsupported FIX order side")
+	errUnsupportedType    = errors.New("unsupported FIX order type")
+	errUnsupportedStatus  = errors.New("unsupported FIX order status")
+	errNotExecutionReport = errors.New("FIX message is not an execution report")
+)
+
+// Side values
+const (
+	SideBuy  = "1"
+	SideSell = "2"
+)
+
+// OrdType values
+const (
+	OrdTypeMarket    = "1"
+	OrdTypeLimit     = "2"
+	OrdTypeStop      = "3"
+	OrdTypeStopLimit = "4"
+)
+
+// TimeInForce values
+const (
+	TimeInForceGoodTillCancel    = "1"
+	TimeInForceImmediateOrCancel = "3"
+	TimeInForceFillOrKill        = "4"
+)
+
+// ExecInstParticipateDoNotInitiate is the ExecInst value for post only orders
+const ExecInstParticipateDoNotInitiate = "6"
+
+var sides = map[order.Side]string{
+	order.Buy:  SideBuy,
+	order.Bid:  SideBuy,
+	order.Sell: SideSell,
+	order.Ask:  SideSell,
+}
+
+var ordTypes = map[order.Type]string{
+	order.Market:    OrdTypeMarket,
+	order.Limit:     OrdTypeLimit,
+	order.Stop:      OrdTypeStop,
+	order.StopLimit: OrdTypeStopLimit,
+}
+
+var ordStatuses = map[string]order.Status{
+	"0": order.New,
+	"1": order.PartiallyFilled,
+	"2": order.Filled,
+	"4": order.Cancelled,
+	"5": order.Active,
+	"6": order.PendingCancel,
+	"8": order.Rejected,
+	"A": order.Pending,
+	"C": order.Expired,
+	"E": order.Pending,
+}
+
+// NewOrderSingle returns a NewOrderSingle for the order. A client order ID is
+// generated if one is not set
+func NewOrderSingle(s *order.Submit, symbol string) (*Message, error) {
+	if s == nil {
+		return nil, fmt.Errorf("%w: order.Submit", common.ErrNilPointer)
+	}
+	if symbol == "" {
+		return nil, errSymbolUnset
+	}
+	side, ok := sides[s.Side]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedSide, s.Side)
+	}
+	ordType, ok := ordTypes[s.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedType, s.Type)
+	}
+	clOrdID := s.ClientOrderID
+	if clOrdID == "" {
+		clOrdID = uuid.Must(uuid.NewV4()).String()
+	}
+	m := NewMessage(MsgTypeNewOrderSingle).
+		Set(TagClOrdID, clOrdID).
+		Set(TagSymbol, symbol).
+		Set(TagSide, side).
+		SetTime(TagTransactTime, time.Now()).
+		SetFloat(TagOrderQty, s.Amount).
+		Set(TagOrdType, ordType)
+	if s.Type == order.Limit || s.Type == order.StopLimit {
+		m.SetFloat(TagPrice, s.Price)
+	}
+	if s.Type == order.Stop || s.Type == order.StopLimit {
+		m.SetFloat(TagStopPx, s.TriggerPrice)
+	}
+	switch {
+	case s.ImmediateOrCancel:
+		m.Set(TagTimeInForce, TimeInForceImmediateOrCancel)
+	case s.FillOrKill:
+		m.Set(TagTimeInForce, TimeInForceFillOrKill)
+	case s.Type != order.Market:
+		m.Set(TagTimeInForce, TimeInForceGoodTillCancel)
+	}
+	if s.PostOnly {
+		m.Set(TagExecInst, ExecInstParticipateDoNotInitiate)
+	}
+	return m, nil
+}
+
+// OrderCancelRequest returns an OrderCancelRequest for the order with a new
+// client order ID
+func OrderCancelRequest(c *order.Cancel, symbol string) (*Message, error) {
+	if c == nil {
+		return nil, fmt.Errorf("%w: order.Cancel", common.ErrNilPointer)
+	}
+	if symbol == "" {
+		return nil, errSymbolUnset
+	}
+	side, ok := sides[c.Side]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedSide, c.Side)
+	}
+	m := NewMessage(MsgTypeOrderCancelRequest).
+		Set(TagClOrdID, uuid.Must(uuid.NewV4()).String()).
+		Set(TagSymbol, symbol).
+		Set(TagSide, side).
+		SetTime(TagTransactTime, time.Now())
+	if c.ClientOrderID != "" {
+		m.Set(TagOrigClOrdID, c.ClientOrderID)
+	}
+	if c.OrderID != "" {
+		m.Set(TagOrderID, c.OrderID)
+	}
+	return m, nil
+}
+
+// OrderCancelReplaceRequest returns an OrderCancelReplaceRequest for the order
+// with a new client order ID
+func OrderCancelReplaceRequest(mod *order.Modify, symbol string) (*Message, error) {
+	if mod == nil {
+		return nil, fmt.Errorf("%w: order.Modify", common.ErrNilPointer)
+	}
+	if symbol == "" {
+		return nil, errSymbolUnset
+	}
+	side, ok := sides[mod.Side]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedSide, mod.Side)
+	}
+	ordType, ok := ordTypes[mod.Type]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedType, mod.Type)
+	}
+	m := NewMessage(MsgTypeOrderCancelReplaceRequest).
+		Set(TagClOrdID, uuid.Must(uuid.NewV4()).String()).
+		Set(TagSymbol, symbol).
+		Set(TagSide, side).
+		SetTime(TagTransactTime, time.Now()).
+		SetFloat(TagOrderQty, mod.Amount).
+		Set(TagOrdType, ordType)
+	if mod.ClientOrderID != "" {
+		m.Set(TagOrigClOrdID, mod.ClientOrderID)
+	}
+	if mod.OrderID != "" {
+		m.Set(TagOrderID, mod.OrderID)
+	}
+	if mod.Type == order.Limit || mod.Type == order.StopLimit {
+		m.SetFloat(TagPrice, mod.Price)
+	}
+	if mod.Type == order.Stop || mod.Type == order.StopLimit {
+		m.SetFloat(TagStopPx, mod.TriggerPrice)
+	}
+	return m, nil
+}
+
+// ParseExecutionReport converts an ExecutionReport to order details. Exchange,
+// Pair and AssetType are not set as symbols are venue specific
+func ParseExecutionReport(m *Message) (*order.Detail, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%w: Message", common.ErrNilPointer)
+	}
+	if m.MsgType != MsgTypeExecutionReport {
+		return nil, fmt.Errorf("%w: %s", errNotExecutionReport, m.MsgType)
+	}
+	status, ok := ordStatuses[m.GetString(TagOrdStatus)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errUnsupportedStatus, m.GetString(TagOrdStatus))
+	}
+	d := &order.Detail{
+		OrderID:       m.GetString(TagOrderID),
+		ClientOrderID: m.GetString(TagClOrdID),
+		Status:        status,
+	}
+	switch m.GetString(TagSide) {
+	case SideBuy:
+		d.Side = order.Buy
+	case SideSell:
+		d.Side = order.Sell
+	}
+	for t, v := range ordTypes {
+		if v == m.GetString(TagOrdType) {
+			d.Type = t
+		}
+	}
+	switch m.GetString(TagTimeInForce) {
+	case TimeInForceImmediateOrCancel:
+		d.ImmediateOrCancel = true
+	case TimeInForceFillOrKill:
+		d.FillOrKill = true
+	}
+	d.PostOnly = m.GetString(TagExecInst) == ExecInstParticipateDoNotInitiate
+	for _, f := range []struct {
+		tag   int
+		value *float64
+	}{
+		{TagPrice, &d.Price},
+		{TagStopPx, &d.TriggerPrice},
+		{TagOrderQty, &d.Amount},
+		{TagCumQty, &d.ExecutedAmount},
+		{TagLeavesQty, &d.RemainingAmount},
+		{TagAvgPx, &d.AverageExecutedPrice},
+	} {
+		if !m.Has(f.tag) {
+			continue
+		}
+		v, err := m.GetFloat(f.tag)
+		if err != nil {
+			return nil, err
+		}
+		*f.value = v
+	}
+	if m.Has(TagTransactTime) {
+		t, err := m.GetTime(TagTransactTime)
+		if err != nil {
+			return nil, err
+		}
+		d.LastUpdated = t
+	}
+	return d, nil
+}
+
+// SubmitOrder sends a NewOrderSingle and returns once the first execution
+// report for it is received. It can be used by a wrapper's SubmitOrder
+func (s *Session) SubmitOrder(ctx context.Context, sub *order.Submit, symbol string) (*order.SubmitResponse, error) {
+	m, err := NewOrderSingle(sub, symbol)
+	if err != nil {
+		return nil, err
+	}
+	clOrdID := m.GetString(TagClOrdID)
+	resp, err := s.request(ctx, m, clOrdID)
+	if err != nil {
+		return nil, err
+	}
+	d, err := orderResponse(resp, ErrOrderRejected)
+	if err != nil {
+		return nil, err
+	}
+	r, err := sub.DeriveSubmitResponse(d.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	r.ClientOrderID = clOrdID
+	r.Status = d.Status
+	r.AverageExecutedPrice = d.AverageExecutedPrice
+	r.RemainingAmount = d.RemainingAmount
+	r.LastUpdated = d.LastUpdated
+	return r, nil
+}
+
+// ModifyOrder sends an OrderCancelReplaceRequest and returns once it is
+// accepted or rejected. It can be used by a wrapper's ModifyOrder
+func (s *Session) ModifyOrder(ctx context.Context, mod *order.Modify, symbol string) (*order.ModifyResponse, error) {
+	m, err := OrderCancelReplaceRequest(mod, symbol)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.request(ctx, m, m.GetString(TagClOrdID))
+	if err != nil {
+		return nil, err
+	}
+	d, err := orderResponse(resp, ErrOrderRejected)
+	if err != nil {
+		return nil, err
+	}
+	r, err := mod.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	r.OrderID = d.OrderID
+	r.ClientOrderID = d.ClientOrderID
+	r.Status = d.Status
+	r.RemainingAmount = d.RemainingAmount
+	r.LastUpdated = d.LastUpdated
+	return r, nil
+}
+
+// CancelOrder sends an OrderCancelRequest and returns once it is accepted or
+// rejected. It can be used by a wrapper's CancelOrder
+func (s *Session) CancelOrder(ctx context.Context, c *order.Cancel, symbol string) error {
+	m, err := OrderCancelRequest(c, symbol)
+	if err != nil {
+		return err
+	}
+	resp, err := s.request(ctx, m, m.GetString(TagClOrdID))
+	if err != nil {
+		return err
+	}
+	_, err = orderResponse(resp, ErrCancelRejected)
+	return err
+}
+
+// orderResponse converts the response to an order request, returning the
+// rejected error with the counterparty's reason if the request was rejected
+func orderResponse(resp *Message, rejected error) (*order.Detail, error) {
+	switch resp.MsgType {
+	case MsgTypeExecutionReport:
+		d, err := ParseExecutionReport(resp)
+		if err != nil {
+			return nil, err
+		}
+		if d.Status == order.Rejected {
+			return nil, fmt.Errorf("%w: %s", rejected, resp.GetString(TagText))
+		}
+		return d, nil
+	case MsgTypeOrderCancelReject, MsgTypeReject, MsgTypeBusinessMessageReject:
+		return nil, fmt.Errorf("%w: %s", rejected, resp.GetString(TagText))
+	}
+	return nil, fmt.Errorf("%w: %s", errUnexpectedMessage, resp.MsgType)
+}
@@ -0,0 +1,182 @@
+package fix
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestNewOrderSingle(t *testing.T) {
+	t.Parallel()
+	_, err := NewOrderSingle(nil, "BTC-USD")
+	assert.ErrorIs(t, err, common.ErrNilPointer, "NewOrderSingle should error for a nil order")
+	_, err = NewOrderSingle(&order.Submit{Side: order.Buy, Type: order.Limit}, "")
+	assert.ErrorIs(t, err, errSymbolUnset, "NewOrderSingle should error without a symbol")
+	_, err = NewOrderSingle(&order.Submit{Side: order.Long, Type: order.Limit}, "BTC-USD")
+	assert.ErrorIs(t, err, errUnsupportedSide, "NewOrderSingle should error for an unsupported side")
+	_, err = NewOrderSingle(&order.Submit{Side: order.Buy, Type: order.TrailingStop}, "BTC-USD")
+	assert.ErrorIs(t, err, errUnsupportedType, "NewOrderSingle should error for an unsupported type")
+
+	m, err := NewOrderSingle(&order.Submit{Side: order.Sell, Type: order.Limit, Price: 1337, Amount: 0.5, PostOnly: true, ClientOrderID: "abc"}, "BTC-USD")
+	require.NoError(t, err, "NewOrderSingle must not error")
+	assert.Equal(t, MsgTypeNewOrderSingle, m.MsgType, "MsgType should be NewOrderSingle")
+	assert.Equal(t, "abc", m.GetString(TagClOrdID), "ClOrdID should be the client order ID")
+	assert.Equal(t, "BTC-USD", m.GetString(TagSymbol), "Symbol should be set")
+	assert.Equal(t, SideSell, m.GetString(TagSide), "Side should be sell")
+	assert.Equal(t, OrdTypeLimit, m.GetString(TagOrdType), "OrdType should be limit")
+	assert.Equal(t, "1337", m.GetString(TagPrice), "Price should be set for limit orders")
+	assert.Equal(t, "0.5", m.GetString(TagOrderQty), "OrderQty should be the amount")
+	assert.Equal(t, TimeInForceGoodTillCancel, m.GetString(TagTimeInForce), "TimeInForce should default to GTC for limit orders")
+	assert.Equal(t, ExecInstParticipateDoNotInitiate, m.GetString(TagExecInst), "ExecInst should be set for post only orders")
+	assert.True(t, m.Has(TagTransactTime), "TransactTime should be set")
+
+	m, err = NewOrderSingle(&order.Submit{Side: order.Buy, Type: order.Market, Amount: 1, ImmediateOrCancel: true}, "BTC-USD")
+	require.NoError(t, err, "NewOrderSingle must not error")
+	assert.NotEmpty(t, m.GetString(TagClOrdID), "ClOrdID should be generated")
+	assert.False(t, m.Has(TagPrice), "Price should not be set for market orders")
+	assert.Equal(t, TimeInForceImmediateOrCancel, m.GetString(TagTimeInForce), "TimeInForce should be IOC")
+
+	m, err = NewOrderSingle(&order.Submit{Side: order.Buy, Type: order.StopLimit, Price: 2, TriggerPrice: 1, FillOrKill: true}, "BTC-USD")
+	require.NoError(t, err, "NewOrderSingle must not error")
+	assert.Equal(t, "1", m.GetString(TagStopPx), "StopPx should be set for stop orders")
+	assert.Equal(t, TimeInForceFillOrKill, m.GetString(TagTimeInForce), "TimeInForce should be FOK")
+}
+
+func TestOrderCancelRequest(t *testing.T) {
+	t.Parallel()
+	_, err := OrderCancelRequest(nil, "BTC-USD")
+	assert.ErrorIs(t, err, common.ErrNilPointer, "OrderCancelRequest should error for a nil cancel")
+	_, err = OrderCancelRequest(&order.Cancel{Side: order.Buy}, "")
+	assert.ErrorIs(t, err, errSymbolUnset, "OrderCancelRequest should error without a symbol")
+	m, err := OrderCancelRequest(&order.Cancel{OrderID: "1", ClientOrderID: "abc", Side: order.Buy}, "BTC-USD")
+	require.NoError(t, err, "OrderCancelRequest must not error")
+	assert.Equal(t, "1", m.GetString(TagOrderID), "OrderID should be set")
+	assert.Equal(t, "abc", m.GetString(TagOrigClOrdID), "OrigClOrdID should be the client order ID")
+	assert.NotEqual(t, "abc", m.GetString(TagClOrdID), "ClOrdID should be new")
+}
+
+func TestOrderCancelReplaceRequest(t *testing.T) {
+	t.Parallel()
+	_, err := OrderCancelReplaceRequest(nil, "BTC-USD")
+	assert.ErrorIs(t, err, common.ErrNilPointer, "OrderCancelReplaceRequest should error for a nil modify")
+	_, err = OrderCancelReplaceRequest(&order.Modify{Side: order.Buy, Type: order.IOS}, "BTC-USD")
+	assert.ErrorIs(t, err, errUnsupportedType, "OrderCancelReplaceRequest should error for an unsupported type")
+	m, err := OrderCancelReplaceRequest(&order.Modify{OrderID: "1", Side: order.Buy, Type: order.Limit, Price: 10, Amount: 2}, "BTC-USD")
+	require.NoError(t, err, "OrderCancelReplaceRequest must not error")
+	assert.Equal(t, "1", m.GetString(TagOrderID), "OrderID should be set")
+	assert.Equal(t, "10", m.GetString(TagPrice), "Price should be set")
+	assert.Equal(t, "2", m.GetString(TagOrderQty), "OrderQty should be set")
+}
+
+func TestParseExecutionReport(t *testing.T) {
+	t.Parallel()
+	_, err := ParseExecutionReport(nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "ParseExecutionReport should error for a nil message")
+	_, err = ParseExecutionReport(NewMessage(MsgTypeHeartbeat))
+	assert.ErrorIs(t, err, errNotExecutionReport, "ParseExecutionReport should error for other messages")
+	_, err = ParseExecutionReport(NewMessage(MsgTypeExecutionReport).Set(TagOrdStatus, "Z"))
+	assert.ErrorIs(t, err, errUnsupportedStatus, "ParseExecutionReport should error for an unknown status")
+	_, err = ParseExecutionReport(NewMessage(MsgTypeExecutionReport).Set(TagOrdStatus, "0").Set(TagPrice, "bad"))
+	assert.ErrorIs(t, err, errInvalidField, "ParseExecutionReport should error for an invalid price")
+
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	d, err := ParseExecutionReport(NewMessage(MsgTypeExecutionReport).
+		Set(TagOrderID, "1").
+		Set(TagClOrdID, "abc").
+		Set(TagOrdStatus, "1").
+		Set(TagSide, SideBuy).
+		Set(TagOrdType, OrdTypeLimit).
+		Set(TagTimeInForce, TimeInForceImmediateOrCancel).
+		Set(TagPrice, "100").
+		Set(TagOrderQty, "2").
+		Set(TagCumQty, "0.5").
+		Set(TagLeavesQty, "1.5").
+		Set(TagAvgPx, "99.5").
+		SetTime(TagTransactTime, ts))
+	require.NoError(t, err, "ParseExecutionReport must not error")
+	assert.Equal(t, &order.Detail{
+		OrderID:              "1",
+		ClientOrderID:        "abc",
+		Status:               order.PartiallyFilled,
+		Side:                 order.Buy,
+		Type:                 order.Limit,
+		ImmediateOrCancel:    true,
+		Price:                100,
+		Amount:               2,
+		ExecutedAmount:       0.5,
+		RemainingAmount:      1.5,
+		AverageExecutedPrice: 99.5,
+		LastUpdated:          ts,
+	}, d, "ParseExecutionReport should return the order details")
+}
+
+// respondOrders acknowledges new orders, rejects cancels and rejects modifies
+// at the session level. Orders for a quantity of 3 are not answered
+func respondOrders(a *acceptor, m *Message) {
+	switch m.MsgType {
+	case MsgTypeNewOrderSingle:
+		if m.GetString(TagOrderQty) == "3" {
+			return
+		}
+		if m.GetString(TagPrice) == "0" {
+			a.send(NewMessage(MsgTypeExecutionReport).Set(TagClOrdID, m.GetString(TagClOrdID)).Set(TagOrdStatus, "8").Set(TagText, "price required"))
+			return
+		}
+		a.send(NewMessage(MsgTypeExecutionReport).
+			Set(TagOrderID, "42").
+			Set(TagClOrdID, m.GetString(TagClOrdID)).
+			Set(TagOrdStatus, "0").
+			Set(TagSide, m.GetString(TagSide)).
+			Set(TagLeavesQty, m.GetString(TagOrderQty)))
+	case MsgTypeOrderCancelRequest:
+		a.send(NewMessage(MsgTypeOrderCancelReject).Set(TagClOrdID, m.GetString(TagClOrdID)).Set(TagText, "unknown order"))
+	case MsgTypeOrderCancelReplaceRequest:
+		a.send(NewMessage(MsgTypeReject).Set(TagRefSeqNum, m.GetString(TagMsgSeqNum)).Set(TagText, "unsupported"))
+	default:
+		respondDefault(a, m)
+	}
+}
+
+func TestSessionOrderManagement(t *testing.T) {
+	t.Parallel()
+	a := newAcceptor(t, respondOrders)
+	var reports []*Message
+	s := newTestSession(t, a, nil, func(m *Message) { reports = append(reports, m) })
+	_, err := s.SubmitOrder(t.Context(), &order.Submit{Side: order.Buy, Type: order.Limit, Price: 1, Amount: 1}, "BTC-USD")
+	assert.ErrorIs(t, err, ErrNotLoggedOn, "SubmitOrder should error before Connect")
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+
+	p := currency.NewBTCUSD()
+	resp, err := s.SubmitOrder(t.Context(), &order.Submit{Exchange: "test", Pair: p, AssetType: asset.Spot, Side: order.Buy, Type: order.Limit, Price: 1, Amount: 2}, "BTC-USD")
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, "42", resp.OrderID, "OrderID should be set from the execution report")
+	assert.NotEmpty(t, resp.ClientOrderID, "ClientOrderID should be set")
+	assert.Equal(t, order.New, resp.Status, "Status should be set from the execution report")
+	assert.Equal(t, 2.0, resp.RemainingAmount, "RemainingAmount should be set from the execution report")
+	assert.True(t, resp.Pair.Equal(p), "Pair should be set from the order")
+
+	_, err = s.SubmitOrder(t.Context(), &order.Submit{Side: order.Buy, Type: order.Limit, Amount: 1}, "BTC-USD")
+	assert.ErrorIs(t, err, ErrOrderRejected, "SubmitOrder should error when rejected")
+	assert.ErrorContains(t, err, "price required", "SubmitOrder should return the rejection reason")
+
+	err = s.CancelOrder(t.Context(), &order.Cancel{OrderID: "42", Side: order.Buy}, "BTC-USD")
+	assert.ErrorIs(t, err, ErrCancelRejected, "CancelOrder should error when rejected")
+	assert.ErrorContains(t, err, "unknown order", "CancelOrder should return the rejection reason")
+
+	_, err = s.ModifyOrder(t.Context(), &order.Modify{OrderID: "42", Side: order.Buy, Type: order.Limit, Price: 2, Amount: 1}, "BTC-USD")
+	assert.ErrorIs(t, err, ErrOrderRejected, "ModifyOrder should error for a session reject")
+	assert.ErrorContains(t, err, "unsupported", "ModifyOrder should return the rejection reason")
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Millisecond)
+	defer cancel()
+	_, err = s.SubmitOrder(ctx, &order.Submit{Side: order.Buy, Type: order.Limit, Price: 1, Amount: 3}, "BTC-USD")
+	assert.ErrorIs(t, err, context.DeadlineExceeded, "SubmitOrder should error when the context is done")
+	assert.Len(t, reports, 4, "Handler should receive each response")
+}
@@ -0,0 +1,741 @@
+package fix
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Default session settings
+const (
+	DefaultHeartbeatInterval = time.Second * 30
+	DefaultLogonTimeout      = time.Second * 10
+)
+
+// Public errors
+var (
+	ErrNotLoggedOn   = errors.New("FIX session not logged on")
+	ErrLoggedOut     = errors.New("FIX session logged out by counterparty")
+	ErrLogonRejected = errors.New("FIX logon rejected")
+)
+
+var (
+	errAlreadyConnected  = errors.New("FIX session already connected")
+	errAddressUnset      = errors.New("FIX address unset")
+	errCompIDUnset       = errors.New("FIX SenderCompID and TargetCompID must be set")
+	errCompIDMismatch    = errors.New("FIX CompID mismatch")
+	errSeqNumTooLow      = errors.New("FIX MsgSeqNum too low")
+	errHeartbeatTimeout  = errors.New("FIX heartbeat timeout")
+	errUnexpectedMessage = errors.New("unexpected FIX message")
+)
+
+// Config holds the settings for a FIX initiator session
+type Config struct {
+	// ExchangeName is used when logging
+	ExchangeName string
+	// Address is the host:port of the FIX gateway
+	Address string
+	// TLSConfig enables TLS when set
+	TLSConfig    *tls.Config
+	SenderCompID string
+	TargetCompID string
+	// HeartbeatInterval defaults to DefaultHeartbeatInterval
+	HeartbeatInterval time.Duration
+	// LogonTimeout limits how long Connect waits for a Logon response and
+	// Logout waits for a Logout response. Defaults to DefaultLogonTimeout
+	LogonTimeout time.Duration
+	// ResetSeqNumOnLogon resets both sequence numbers and the message store
+	// on each logon, which is required by some venues
+	ResetSeqNumOnLogon bool
+	Username           string
+	Password           string
+	// LogonHook is called with each Logon message after the standard fields
+	// are set, so venue specific authentication such as a RawData signature
+	// can be added
+	LogonHook func(*Message) error
+	// Handler is called in sequence order with each application message,
+	// before any request waiting on the message is released. It is called
+	// from the read loop and must not block
+	Handler func(*Message)
+	Verbose bool
+}
+
+// Session is a FIX 4.4 initiator session. It handles logon, heartbeats, test
+// requests, sequence number gaps, resend requests and logout. Sequence numbers
+// and sent messages are persisted in a Store so sessions can be resumed
+type Session struct {
+	cfg   Config
+	store Store
+
+	// writeMu serialises sequence number allocation with writes
+	writeMu sync.Mutex
+	conn    net.Conn
+
+	mu         sync.Mutex
+	connected  bool
+	loggedOn   bool
+	logoutSent bool
+	done       chan struct{}
+	// readDone is closed when the read loop of the last connection exits
+	readDone      chan struct{}
+	err           error
+	logonResult   chan error
+	logoutResult  chan struct{}
+	testRequestAt time.Time
+	// pending maps client order IDs to waiting requests, and sentRefs maps
+	// sequence numbers to client order IDs so session rejects can be matched
+	pending  map[string]chan *Message
+	sentRefs map[int]string
+
+	// queue holds messages received ahead of a sequence gap, and
+	// resendRequested is set while waiting for the gap to be filled. Both are
+	// only accessed from the read loop, which resets them when it starts, and
+	// Connect waits for the previous read loop to exit before starting another
+	queue           map[int]*Message
+	resendRequested bool
+
+	lastSent     atomic.Int64
+	lastReceived atomic.Int64
+	testReqID    atomic.Int64
+}
+
+// NewSession returns a new FIX session using the message store to persist
+// sequence numbers and sent messages
+func NewSession(cfg *Config, store Store) (*Session, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w: Config", common.ErrNilPointer)
+	}
+	if store == nil {
+		return nil, fmt.Errorf("%w: Store", common.ErrNilPointer)
+	}
+	if cfg.Address == "" {
+		return nil, errAddressUnset
+	}
+	if cfg.SenderCompID == "" || cfg.TargetCompID == "" {
+		return nil, errCompIDUnset
+	}
+	s := &Session{cfg: *cfg, store: store, done: make(chan struct{}), readDone: make(chan struct{})}
+	if s.cfg.HeartbeatInterval <= 0 {
+		s.cfg.HeartbeatInterval = DefaultHeartbeatInterval
+	}
+	if s.cfg.LogonTimeout <= 0 {
+		s.cfg.LogonTimeout = DefaultLogonTimeout
+	}
+	close(s.done)
+	close(s.readDone)
+	return s, nil
+}
+
+// Connect dials the gateway and logs on, returning once the counterparty's
+// Logon has been received
+func (s *Session) Connect(ctx context.Context) error {
+	s.mu.Lock()
+	if s.connected {
+		s.mu.Unlock()
+		return errAlreadyConnected
+	}
+	readDone := s.readDone
+	s.mu.Unlock()
+	select {
+	case <-readDone:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	s.mu.Lock()
+	if s.connected {
+		s.mu.Unlock()
+		return errAlreadyConnected
+	}
+	if s.cfg.ResetSeqNumOnLogon {
+		if err := s.store.Reset(); err != nil {
+			s.mu.Unlock()
+			return err
+		}
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", s.cfg.Address)
+	if err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	if s.cfg.TLSConfig != nil {
+		tlsConn := tls.Client(conn, s.cfg.TLSConfig)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			s.mu.Unlock()
+			return err
+		}
+		conn = tlsConn
+	}
+	s.conn = conn
+	s.connected = true
+	s.loggedOn = false
+	s.logoutSent = false
+	s.err = nil
+	s.done = make(chan struct{})
+	s.logonResult = make(chan error, 1)
+	s.logoutResult = make(chan struct{})
+	s.testRequestAt = time.Time{}
+	s.pending = make(map[string]chan *Message)
+	s.sentRefs = make(map[int]string)
+	s.readDone = make(chan struct{})
+	done, logonResult := s.done, s.logonResult
+	readDone = s.readDone
+	s.mu.Unlock()
+
+	now := time.Now().UnixNano()
+	s.lastSent.Store(now)
+	s.lastReceived.Store(now)
+	go s.readLoop(conn, done, readDone)
+
+	logon := NewMessage(MsgTypeLogon).
+		SetInt(TagEncryptMethod, 0).
+		SetInt(TagHeartBtInt, max(int(s.cfg.HeartbeatInterval/time.Second), 1))
+	if s.cfg.ResetSeqNumOnLogon {
+		logon.Set(TagResetSeqNumFlag, "Y")
+	}
+	if s.cfg.Username != "" {
+		logon.Set(TagUsername, s.cfg.Username)
+	}
+	if s.cfg.Password != "" {
+		logon.Set(TagPassword, s.cfg.Password)
+	}
+	if s.cfg.LogonHook != nil {
+		if err := s.cfg.LogonHook(logon); err != nil {
+			s.disconnect(done, err)
+			return err
+		}
+	}
+	if _, err := s.send(logon, "", false); err != nil {
+		s.disconnect(done, err)
+		return err
+	}
+
+	timer := time.NewTimer(s.cfg.LogonTimeout)
+	defer timer.Stop()
+	select {
+	case err = <-logonResult:
+	case <-done:
+		err = s.Err()
+	case <-timer.C:
+		err = fmt.Errorf("%w: no response after %s", ErrLogonRejected, s.cfg.LogonTimeout)
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	if err != nil {
+		s.disconnect(done, err)
+		return err
+	}
+	go s.heartbeatLoop(done)
+	return nil
+}
+
+// Logout sends a Logout and waits for the counterparty to confirm it before
+// disconnecting
+func (s *Session) Logout(ctx context.Context, reason string) error {
+	s.mu.Lock()
+	if !s.loggedOn {
+		s.mu.Unlock()
+		return ErrNotLoggedOn
+	}
+	s.logoutSent = true
+	logoutResult, done := s.logoutResult, s.done
+	s.mu.Unlock()
+
+	m := NewMessage(MsgTypeLogout)
+	if reason != "" {
+		m.Set(TagText, reason)
+	}
+	if _, err := s.send(m, "", false); err != nil {
+		s.disconnect(done, err)
+		return err
+	}
+	timer := time.NewTimer(s.cfg.LogonTimeout)
+	defer timer.Stop()
+	select {
+	case <-logoutResult:
+	case <-done:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	s.disconnect(done, nil)
+	return nil
+}
+
+// Send sends an application message, assigning its sequence number and
+// persisting it in the store
+func (s *Session) Send(m *Message) error {
+	if m == nil {
+		return fmt.Errorf("%w: Message", common.ErrNilPointer)
+	}
+	_, err := s.send(m, "", true)
+	return err
+}
+
+// IsLoggedOn returns whether the session is logged on
+func (s *Session) IsLoggedOn() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.loggedOn
+}
+
+// Done returns a channel which is closed when the session disconnects
+func (s *Session) Done() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.done
+}
+
+// Err returns the reason the session disconnected, or nil if it was logged
+// out cleanly
+func (s *Session) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// send encodes and writes a message with the next sequence number. ref is
+// the client order ID session rejects should be matched to
+func (s *Session) send(m *Message, ref string, requireLogon bool) (int, error) {
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.mu.Lock()
+	if !s.connected || (requireLogon && !s.loggedOn) {
+		s.mu.Unlock()
+		return 0, ErrNotLoggedOn
+	}
+	conn := s.conn
+	seq := s.store.NextSenderSeqNum()
+	if ref != "" {
+		s.sentRefs[seq] = ref
+	}
+	s.mu.Unlock()
+
+	raw := s.encode(m, seq, time.Now(), "")
+	if err := s.store.SaveMessage(seq, raw); err != nil {
+		return 0, err
+	}
+	return seq, s.write(conn, raw)
+}
+
+// encode adds the standard header to a message. origSendingTime is set when
+// the message is a possible duplicate
+func (s *Session) encode(m *Message, seq int, sendingTime time.Time, origSendingTime string) []byte {
+	out := NewMessage(m.MsgType).
+		Add(TagSenderCompID, s.cfg.SenderCompID).
+		Add(TagTargetCompID, s.cfg.TargetCompID).
+		Add(TagMsgSeqNum, strconv.Itoa(seq)).
+		Add(TagSendingTime, sendingTime.UTC().Format(timestampFormat))
+	if origSendingTime != "" {
+		out.Add(TagPossDupFlag, "Y").Add(TagOrigSendingTime, origSendingTime)
+	}
+	for _, f := range m.Fields {
+		if !headerTags[f.Tag] {
+			out.Fields = append(out.Fields, f)
+		}
+	}
+	return out.Bytes()
+}
+
+func (s *Session) write(conn net.Conn, raw []byte) error {
+	if s.cfg.Verbose {
+		log.Debugf(log.ExchangeSys, "%s FIX sending: %s", s.cfg.ExchangeName, printable(raw))
+	}
+	if _, err := conn.Write(raw); err != nil {
+		return err
+	}
+	s.lastSent.Store(time.Now().UnixNano())
+	return nil
+}
+
+// disconnect closes the connection identified by its done channel once,
+// recording the reason and releasing any waiting requests
+func (s *Session) disconnect(done chan struct{}, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.connected || s.done != done {
+		return
+	}
+	s.connected = false
+	s.loggedOn = false
+	s.err = err
+	s.conn.Close()
+	close(s.done)
+	if err != nil {
+		log.Warnf(log.ExchangeSys, "%s FIX session disconnected: %v", s.cfg.ExchangeName, err)
+	}
+}
+
+func (s *Session) readLoop(conn net.Conn, done, readDone chan struct{}) {
+	defer close(readDone)
+	s.queue = make(map[int]*Message)
+	s.resendRequested = false
+	r := bufio.NewReader(conn)
+	for {
+		m, raw, err := ReadMessage(r)
+		if err != nil {
+			s.disconnect(done, err)
+			return
+		}
+		s.lastReceived.Store(time.Now().UnixNano())
+		s.mu.Lock()
+		s.testRequestAt = time.Time{}
+		s.mu.Unlock()
+		if s.cfg.Verbose {
+			log.Debugf(log.ExchangeSys, "%s FIX received: %s", s.cfg.ExchangeName, printable(raw))
+		}
+		if err := s.process(m); err != nil {
+			s.disconnect(done, err)
+			return
+		}
+	}
+}
+
+// process checks the sequence number of a received message, queueing it and
+// requesting a resend if messages were missed
+func (s *Session) process(m *Message) error {
+	if sender, target := m.GetString(TagSenderCompID), m.GetString(TagTargetCompID); sender != s.cfg.TargetCompID || target != s.cfg.SenderCompID {
+		s.sendLogout(fmt.Sprintf("CompID problem: received %s->%s", sender, target))
+		return fmt.Errorf("%w: received %s->%s", errCompIDMismatch, sender, target)
+	}
+	seq, err := m.SeqNum()
+	if err != nil {
+		return err
+	}
+	if !s.IsLoggedOn() && m.MsgType != MsgTypeLogon && m.MsgType != MsgTypeLogout {
+		return fmt.Errorf("%w: received %s before Logon", errUnexpectedMessage, m.MsgType)
+	}
+
+	// SequenceReset-Reset ignores the sequence number of the message itself
+	if m.MsgType == MsgTypeSequenceReset && !m.GetBool(TagGapFillFlag) {
+		newSeq, err := m.GetInt(TagNewSeqNo)
+		if err != nil {
+			return err
+		}
+		if newSeq >= s.store.NextTargetSeqNum() {
+			return s.store.SetNextTargetSeqNum(newSeq)
+		}
+		return nil
+	}
+	if m.MsgType == MsgTypeLogon && m.GetBool(TagResetSeqNumFlag) && seq == 1 {
+		if err := s.store.SetNextTargetSeqNum(1); err != nil {
+			return err
+		}
+	}
+
+	expected := s.store.NextTargetSeqNum()
+	switch {
+	case seq < expected:
+		if m.GetBool(TagPossDupFlag) {
+			return nil
+		}
+		text := fmt.Sprintf("MsgSeqNum too low, expecting %d but received %d", expected, seq)
+		s.sendLogout(text)
+		return fmt.Errorf("%w: %s", errSeqNumTooLow, text)
+	case seq > expected:
+		// Logon and Logout are handled immediately, while the gap before
+		// them is filled by the counterparty
+		switch m.MsgType {
+		case MsgTypeLogon, MsgTypeLogout:
+			if _, err := s.handle(m); err != nil {
+				return err
+			}
+		default:
+			s.queue[seq] = m
+		}
+		if !s.resendRequested {
+			s.resendRequested = true
+			_, err := s.send(NewMessage(MsgTypeResendRequest).SetInt(TagBeginSeqNo, expected).SetInt(TagEndSeqNo, 0), "", false)
+			return err
+		}
+		return nil
+	}
+
+	if err := s.deliver(m, seq); err != nil {
+		return err
+	}
+	for {
+		next := s.store.NextTargetSeqNum()
+		for queued := range s.queue {
+			if queued < next {
+				delete(s.queue, queued)
+			}
+		}
+		qm, ok := s.queue[next]
+		if !ok {
+			break
+		}
+		delete(s.queue, next)
+		if err := s.deliver(qm, next); err != nil {
+			return err
+		}
+	}
+	if len(s.queue) == 0 {
+		s.resendRequested = false
+	}
+	return nil
+}
+
+// deliver handles an in sequence message and advances the target sequence
+// number past it
+func (s *Session) deliver(m *Message, seq int) error {
+	advanced, err := s.handle(m)
+	if err != nil || advanced {
+		return err
+	}
+	return s.store.SetNextTargetSeqNum(seq + 1)
+}
+
+// handle processes a single message, returning true if it set the next
+// target sequence number itself
+func (s *Session) handle(m *Message) (bool, error) {
+	switch m.MsgType {
+	case MsgTypeLogon:
+		s.mu.Lock()
+		s.loggedOn = true
+		select {
+		case s.logonResult <- nil:
+		default:
+		}
+		s.mu.Unlock()
+	case MsgTypeHeartbeat:
+	case MsgTypeTestRequest:
+		_, err := s.send(NewMessage(MsgTypeHeartbeat).Set(TagTestReqID, m.GetString(TagTestReqID)), "", false)
+		return false, err
+	case MsgTypeResendRequest:
+		return false, s.resend(m)
+	case MsgTypeSequenceReset:
+		newSeq, err := m.GetInt(TagNewSeqNo)
+		if err != nil {
+			return false, err
+		}
+		if newSeq > s.store.NextTargetSeqNum() {
+			return true, s.store.SetNextTargetSeqNum(newSeq)
+		}
+	case MsgTypeLogout:
+		s.mu.Lock()
+		loggedOn, logoutSent := s.loggedOn, s.logoutSent
+		s.mu.Unlock()
+		if logoutSent {
+			close(s.logoutResult)
+			return false, nil
+		}
+		if !loggedOn {
+			return false, fmt.Errorf("%w: %s", ErrLogonRejected, m.GetString(TagText))
+		}
+		s.sendLogout("")
+		return false, fmt.Errorf("%w: %s", ErrLoggedOut, m.GetString(TagText))
+	default:
+		if s.cfg.Handler != nil {
+			s.cfg.Handler(m)
+		}
+		s.dispatch(m)
+	}
+	return false, nil
+}
+
+// dispatch passes responses to requests waiting on them
+func (s *Session) dispatch(m *Message) {
+	var ref string
+	switch m.MsgType {
+	case MsgTypeExecutionReport, MsgTypeOrderCancelReject:
+		ref = m.GetString(TagClOrdID)
+	case MsgTypeBusinessMessageReject:
+		ref = m.GetString(TagBusinessRejectRefID)
+	case MsgTypeReject:
+		if refSeq, err := m.GetInt(TagRefSeqNum); err == nil {
+			s.mu.Lock()
+			ref = s.sentRefs[refSeq]
+			s.mu.Unlock()
+		}
+	}
+	if ref == "" {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if ch, ok := s.pending[ref]; ok {
+		select {
+		case ch <- m:
+		default:
+		}
+	}
+}
+
+// resend answers a ResendRequest. Application messages and Rejects are resent
+// as possible duplicates and other admin messages are replaced with
+// SequenceReset-GapFill
+func (s *Session) resend(m *Message) error {
+	begin, err := m.GetInt(TagBeginSeqNo)
+	if err != nil {
+		return err
+	}
+	end, err := m.GetInt(TagEndSeqNo)
+	if err != nil {
+		return err
+	}
+
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	s.mu.Lock()
+	conn := s.conn
+	s.mu.Unlock()
+	next := s.store.NextSenderSeqNum()
+	if end == 0 || end >= next {
+		end = next - 1
+	}
+	stored, err := s.store.GetMessages(begin, end)
+	if err != nil {
+		return err
+	}
+
+	gapFrom := begin
+	gapFill := func(to int) error {
+		if gapFrom >= to {
+			return nil
+		}
+		reset := NewMessage(MsgTypeSequenceReset).Set(TagGapFillFlag, "Y").SetInt(TagNewSeqNo, to)
+		raw := s.encode(reset, gapFrom, time.Now(), time.Now().UTC().Format(timestampFormat))
+		return s.write(conn, raw)
+	}
+	for _, raw := range stored {
+		msg, err := ParseMessage(raw)
+		if err != nil {
+			return err
+		}
+		seq, err := msg.SeqNum()
+		if err != nil {
+			return err
+		}
+		if isAdmin(msg.MsgType) {
+			continue
+		}
+		if err := gapFill(seq); err != nil {
+			return err
+		}
+		if err := s.write(conn, s.encode(msg, seq, time.Now(), msg.GetString(TagSendingTime))); err != nil {
+			return err
+		}
+		gapFrom = seq + 1
+	}
+	return gapFill(end + 1)
+}
+
+func (s *Session) sendLogout(text string) {
+	m := NewMessage(MsgTypeLogout)
+	if text != "" {
+		m.Set(TagText, text)
+	}
+	if _, err := s.send(m, "", false); err != nil {
+		log.Errorf(log.ExchangeSys, "%s FIX failed to send Logout: %v", s.cfg.ExchangeName, err)
+	}
+}
+
+// heartbeatLoop sends a Heartbeat when nothing has been sent for the
+// heartbeat interval, and a TestRequest when nothing has been received. The
+// session is disconnected if the TestRequest is not answered
+func (s *Session) heartbeatLoop(done chan struct{}) {
+	interval := s.cfg.HeartbeatInterval
+	ticker := time.NewTicker(max(interval/10, time.Millisecond*10))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			if now.Sub(time.Unix(0, s.lastSent.Load())) >= interval {
+				if _, err := s.send(NewMessage(MsgTypeHeartbeat), "", true); err != nil {
+					s.disconnect(done, err)
+					return
+				}
+			}
+			s.mu.Lock()
+			testRequestAt := s.testRequestAt
+			s.mu.Unlock()
+			if !testRequestAt.IsZero() {
+				if now.Sub(testRequestAt) >= interval {
+					s.disconnect(done, errHeartbeatTimeout)
+					return
+				}
+				continue
+			}
+			if now.Sub(time.Unix(0, s.lastReceived.Load())) >= interval+interval/5 {
+				s.mu.Lock()
+				s.testRequestAt = now
+				s.mu.Unlock()
+				id := strconv.FormatInt(s.testReqID.Add(1), 10)
+				if _, err := s.send(NewMessage(MsgTypeTestRequest).Set(TagTestReqID, id), "", true); err != nil {
+					s.disconnect(done, err)
+					return
+				}
+			}
+		}
+	}
+}
+
+// request sends an application message and waits for the first response
+// matched to the client order ID
+func (s *Session) request(ctx context.Context, m *Message, clOrdID string) (*Message, error) {
+	ch := make(chan *Message, 1)
+	s.mu.Lock()
+	if !s.loggedOn {
+		s.mu.Unlock()
+		return nil, ErrNotLoggedOn
+	}
+	s.pending[clOrdID] = ch
+	done := s.done
+	s.mu.Unlock()
+
+	seq, err := s.send(m, clOrdID, true)
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, clOrdID)
+		delete(s.sentRefs, seq)
+		s.mu.Unlock()
+	}()
+	if err != nil {
+		return nil, err
+	}
+	select {
+	case resp := <-ch:
+		return resp, nil
+	case <-done:
+		if err := s.Err(); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrNotLoggedOn, err)
+		}
+		return nil, ErrNotLoggedOn
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// isAdmin returns whether a message is gap filled rather than resent
+func isAdmin(msgType string) bool {
+	switch msgType {
+	case MsgTypeHeartbeat, MsgTypeTestRequest, MsgTypeResendRequest, MsgTypeSequenceReset, MsgTypeLogout, MsgTypeLogon:
+		return true
+	}
+	return false
+}
+
+func printable(raw []byte) string {
+	b := make([]byte, len(raw))
+	for i, c := range raw {
+		if c == SOH {
+			c = '|'
+		}
+		b[i] = c
+	}
+	return string(b)
+}
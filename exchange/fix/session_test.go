@@ -0,0 +1,345 @@
+package fix
+
+import (
+	"bufio"
+	"net"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+const (
+	testSenderCompID = "GCT"
+	testTargetCompID = "VENUE"
+	testTimeout      = time.Second * 5
+)
+
+// acceptor is a FIX acceptor stub which accepts one connection at a time,
+// records received messages and responds using a configurable handler
+type acceptor struct {
+	ln       net.Listener
+	received chan *Message
+	// respond is called with each received message. Logon, Logout and
+	// TestRequest are answered by the default handler
+	respond func(a *acceptor, m *Message)
+
+	mu   sync.Mutex
+	conn net.Conn
+	seq  int
+}
+
+func newAcceptor(t *testing.T, respond func(a *acceptor, m *Message)) *acceptor {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err, "Listen must not error")
+	if respond == nil {
+		respond = respondDefault
+	}
+	a := &acceptor{ln: ln, received: make(chan *Message, 100), respond: respond, seq: 1}
+	t.Cleanup(func() {
+		ln.Close()
+		a.mu.Lock()
+		if a.conn != nil {
+			a.conn.Close()
+		}
+		a.mu.Unlock()
+	})
+	go a.serve()
+	return a
+}
+
+func (a *acceptor) serve() {
+	for {
+		conn, err := a.ln.Accept()
+		if err != nil {
+			return
+		}
+		a.mu.Lock()
+		a.conn = conn
+		a.mu.Unlock()
+		r := bufio.NewReader(conn)
+		for {
+			m, _, err := ReadMessage(r)
+			if err != nil {
+				break
+			}
+			a.received <- m
+			a.respond(a, m)
+		}
+		conn.Close()
+	}
+}
+
+func respondDefault(a *acceptor, m *Message) {
+	switch m.MsgType {
+	case MsgTypeLogon:
+		a.send(NewMessage(MsgTypeLogon).SetInt(TagEncryptMethod, 0).Set(TagHeartBtInt, m.GetString(TagHeartBtInt)))
+	case MsgTypeLogout:
+		a.send(NewMessage(MsgTypeLogout))
+	case MsgTypeTestRequest:
+		a.send(NewMessage(MsgTypeHeartbeat).Set(TagTestReqID, m.GetString(TagTestReqID)))
+	}
+}
+
+// send sends a message with the next sequence number
+func (a *acceptor) send(m *Message) {
+	a.mu.Lock()
+	seq := a.seq
+	a.seq++
+	a.mu.Unlock()
+	a.sendSeq(m, seq, false)
+}
+
+// sendSeq sends a message with a specific sequence number
+func (a *acceptor) sendSeq(m *Message, seq int, possDup bool) {
+	out := NewMessage(m.MsgType).
+		Add(TagSenderCompID, testTargetCompID).
+		Add(TagTargetCompID, testSenderCompID).
+		Add(TagMsgSeqNum, strconv.Itoa(seq)).
+		Add(TagSendingTime, time.Now().UTC().Format(timestampFormat))
+	if possDup {
+		out.Add(TagPossDupFlag, "Y")
+	}
+	out.Fields = append(out.Fields, m.Fields...)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.conn != nil {
+		_, _ = a.conn.Write(out.Bytes())
+	}
+}
+
+// next returns the next received message of the type, skipping others
+func (a *acceptor) next(t *testing.T, msgType string) *Message {
+	t.Helper()
+	timer := time.NewTimer(testTimeout)
+	defer timer.Stop()
+	for {
+		select {
+		case m := <-a.received:
+			if m.MsgType == msgType {
+				return m
+			}
+		case <-timer.C:
+			require.FailNowf(t, "timeout", "acceptor did not receive message type %s", msgType)
+		}
+	}
+}
+
+func newTestSession(t *testing.T, a *acceptor, store Store, handler func(*Message)) *Session {
+	t.Helper()
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	s, err := NewSession(&Config{
+		ExchangeName: "test",
+		Address:      a.ln.Addr().String(),
+		SenderCompID: testSenderCompID,
+		TargetCompID: testTargetCompID,
+		LogonTimeout: testTimeout,
+		Username:     "user",
+		Handler:      handler,
+	}, store)
+	require.NoError(t, err, "NewSession must not error")
+	return s
+}
+
+func TestNewSession(t *testing.T) {
+	t.Parallel()
+	_, err := NewSession(nil, NewMemoryStore())
+	assert.ErrorIs(t, err, common.ErrNilPointer, "NewSession should error without a config")
+	_, err = NewSession(&Config{}, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "NewSession should error without a store")
+	_, err = NewSession(&Config{}, NewMemoryStore())
+	assert.ErrorIs(t, err, errAddressUnset, "NewSession should error without an address")
+	_, err = NewSession(&Config{Address: "127.0.0.1:1337"}, NewMemoryStore())
+	assert.ErrorIs(t, err, errCompIDUnset, "NewSession should error without CompIDs")
+
+	s, err := NewSession(&Config{Address: "127.0.0.1:1337", SenderCompID: "a", TargetCompID: "b"}, NewMemoryStore())
+	require.NoError(t, err, "NewSession must not error")
+	assert.Equal(t, DefaultHeartbeatInterval, s.cfg.HeartbeatInterval, "HeartbeatInterval should default")
+	assert.Equal(t, DefaultLogonTimeout, s.cfg.LogonTimeout, "LogonTimeout should default")
+	assert.False(t, s.IsLoggedOn(), "IsLoggedOn should return false before Connect")
+	assert.ErrorIs(t, s.Send(NewMessage(MsgTypeNewOrderSingle)), ErrNotLoggedOn, "Send should error before Connect")
+	assert.ErrorIs(t, s.Logout(t.Context(), ""), ErrNotLoggedOn, "Logout should error before Connect")
+}
+
+func TestSessionLogonLogout(t *testing.T) {
+	t.Parallel()
+	a := newAcceptor(t, nil)
+	s := newTestSession(t, a, nil, nil)
+	s.cfg.LogonHook = func(m *Message) error {
+		m.Set(TagRawData, "signature")
+		return nil
+	}
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+	assert.ErrorIs(t, s.Connect(t.Context()), errAlreadyConnected, "Connect should error when connected")
+	assert.True(t, s.IsLoggedOn(), "IsLoggedOn should return true")
+
+	logon := a.next(t, MsgTypeLogon)
+	assert.Equal(t, "1", logon.GetString(TagMsgSeqNum), "Logon should be the first message")
+	assert.Equal(t, "30", logon.GetString(TagHeartBtInt), "Logon should set the heartbeat interval")
+	assert.Equal(t, "user", logon.GetString(TagUsername), "Logon should set the username")
+	assert.Equal(t, "signature", logon.GetString(TagRawData), "LogonHook should amend the Logon")
+	assert.Equal(t, 2, s.store.NextTargetSeqNum(), "Logon should advance the target sequence number")
+
+	require.NoError(t, s.Send(NewMessage(MsgTypeNewOrderSingle).Set(TagClOrdID, "1")), "Send must not error")
+	order := a.next(t, MsgTypeNewOrderSingle)
+	assert.Equal(t, "2", order.GetString(TagMsgSeqNum), "Send should use the next sequence number")
+	assert.ErrorIs(t, s.Send(nil), common.ErrNilPointer, "Send should error for a nil message")
+
+	require.NoError(t, s.Logout(t.Context(), "bye"), "Logout must not error")
+	assert.Equal(t, "bye", a.next(t, MsgTypeLogout).GetString(TagText), "Logout should send the reason")
+	<-s.Done()
+	assert.NoError(t, s.Err(), "Err should be nil after a clean logout")
+	assert.False(t, s.IsLoggedOn(), "IsLoggedOn should return false after Logout")
+}
+
+func TestSessionLogonRejected(t *testing.T) {
+	t.Parallel()
+	a := newAcceptor(t, func(a *acceptor, m *Message) {
+		if m.MsgType == MsgTypeLogon {
+			a.send(NewMessage(MsgTypeLogout).Set(TagText, "invalid signature"))
+		}
+	})
+	s := newTestSession(t, a, nil, nil)
+	err := s.Connect(t.Context())
+	assert.ErrorIs(t, err, ErrLogonRejected, "Connect should error when the Logon is rejected")
+	assert.ErrorContains(t, err, "invalid signature", "Connect should return the rejection reason")
+
+	s = newTestSession(t, a, nil, nil)
+	s.cfg.LogonHook = func(*Message) error { return errUnexpectedMessage }
+	assert.ErrorIs(t, s.Connect(t.Context()), errUnexpectedMessage, "Connect should return LogonHook errors")
+}
+
+func TestSessionHeartbeat(t *testing.T) {
+	t.Parallel()
+	a := newAcceptor(t, func(a *acceptor, m *Message) {
+		if m.MsgType == MsgTypeLogon {
+			respondDefault(a, m)
+		}
+	})
+	s := newTestSession(t, a, nil, nil)
+	s.cfg.HeartbeatInterval = time.Millisecond * 200
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+
+	a.send(NewMessage(MsgTypeTestRequest).Set(TagTestReqID, "ping"))
+	assert.Equal(t, "ping", a.next(t, MsgTypeHeartbeat).GetString(TagTestReqID), "TestRequest should be answered with a Heartbeat")
+	assert.Empty(t, a.next(t, MsgTypeHeartbeat).GetString(TagTestReqID), "Heartbeat should be sent when idle")
+	assert.Equal(t, "1", a.next(t, MsgTypeTestRequest).GetString(TagTestReqID), "TestRequest should be sent when nothing is received")
+
+	select {
+	case <-s.Done():
+	case <-time.After(testTimeout):
+		require.FailNow(t, "session should disconnect when the TestRequest is not answered")
+	}
+	assert.ErrorIs(t, s.Err(), errHeartbeatTimeout, "Err should return a heartbeat timeout")
+}
+
+func TestSessionResendRequest(t *testing.T) {
+	t.Parallel()
+	a := newAcceptor(t, nil)
+	s := newTestSession(t, a, nil, nil)
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+	require.NoError(t, s.Send(NewMessage(MsgTypeNewOrderSingle).Set(TagClOrdID, "1")), "Send must not error")
+	a.next(t, MsgTypeNewOrderSingle)
+
+	a.send(NewMessage(MsgTypeResendRequest).SetInt(TagBeginSeqNo, 1).SetInt(TagEndSeqNo, 0))
+	gapFill := a.next(t, MsgTypeSequenceReset)
+	assert.Equal(t, "1", gapFill.GetString(TagMsgSeqNum), "Logon should be gap filled")
+	assert.True(t, gapFill.GetBool(TagGapFillFlag), "SequenceReset should be a gap fill")
+	assert.Equal(t, "2", gapFill.GetString(TagNewSeqNo), "Gap fill should skip to the order")
+	resent := a.next(t, MsgTypeNewOrderSingle)
+	assert.Equal(t, "2", resent.GetString(TagMsgSeqNum), "Order should be resent with its sequence number")
+	assert.True(t, resent.GetBool(TagPossDupFlag), "Resent order should be a possible duplicate")
+	assert.True(t, resent.Has(TagOrigSendingTime), "Resent order should have the original sending time")
+	assert.Equal(t, "1", resent.GetString(TagClOrdID), "Resent order should keep its fields")
+	assert.Equal(t, 3, s.store.NextSenderSeqNum(), "Resending should not advance the sender sequence number")
+}
+
+func TestSessionSequenceGap(t *testing.T) {
+	t.Parallel()
+	received := make(chan string, 10)
+	a := newAcceptor(t, nil)
+	s := newTestSession(t, a, nil, func(m *Message) { received <- m.GetString(TagText) })
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+
+	// Sequence numbers 2 and 3 are missed
+	a.sendSeq(NewMessage(MsgTypeExecutionReport).Set(TagText, "four"), 4, false)
+	resend := a.next(t, MsgTypeResendRequest)
+	assert.Equal(t, "2", resend.GetString(TagBeginSeqNo), "ResendRequest should begin at the expected sequence number")
+	assert.Equal(t, "0", resend.GetString(TagEndSeqNo), "ResendRequest should request all messages")
+	a.sendSeq(NewMessage(MsgTypeExecutionReport).Set(TagText, "five"), 5, false)
+
+	a.sendSeq(NewMessage(MsgTypeExecutionReport).Set(TagText, "two"), 2, true)
+	a.sendSeq(NewMessage(MsgTypeSequenceReset).Set(TagGapFillFlag, "Y").SetInt(TagNewSeqNo, 4), 3, true)
+	for _, want := range []string{"two", "four", "five"} {
+		select {
+		case got := <-received:
+			assert.Equal(t, want, got, "Messages should be handled in sequence")
+		case <-time.After(testTimeout):
+			require.FailNow(t, "timeout waiting for message", want)
+		}
+	}
+	a.sendSeq(NewMessage(MsgTypeExecutionReport).Set(TagText, "duplicate"), 5, true)
+	a.mu.Lock()
+	a.seq = 6
+	a.mu.Unlock()
+	a.send(NewMessage(MsgTypeExecutionReport).Set(TagText, "six"))
+	assert.Equal(t, "six", <-received, "Possible duplicates should be ignored")
+	assert.Equal(t, 7, s.store.NextTargetSeqNum(), "Target sequence number should follow received messages")
+
+	a.sendSeq(NewMessage(MsgTypeSequenceReset).SetInt(TagNewSeqNo, 10), 1, false)
+	a.sendSeq(NewMessage(MsgTypeExecutionReport).Set(TagText, "ten"), 10, false)
+	assert.Equal(t, "ten", <-received, "SequenceReset should reset the target sequence number")
+
+	a.sendSeq(NewMessage(MsgTypeExecutionReport).Set(TagText, "low"), 5, false)
+	assert.Contains(t, a.next(t, MsgTypeLogout).GetString(TagText), "MsgSeqNum too low", "Logout should be sent for a low sequence number")
+	<-s.Done()
+	assert.ErrorIs(t, s.Err(), errSeqNumTooLow, "Err should return a low sequence number")
+}
+
+func TestSessionResume(t *testing.T) {
+	t.Parallel()
+	a := newAcceptor(t, nil)
+	store, err := NewFileStore(filepath.Join(t.TempDir(), "fix"), testSenderCompID, testTargetCompID)
+	require.NoError(t, err, "NewFileStore must not error")
+	defer store.Close()
+	s := newTestSession(t, a, store, nil)
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+	require.NoError(t, s.Logout(t.Context(), ""), "Logout must not error")
+	a.next(t, MsgTypeLogout)
+
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error after Logout")
+	assert.Equal(t, "3", a.next(t, MsgTypeLogon).GetString(TagMsgSeqNum), "Logon should resume the sender sequence number")
+
+	a.sendSeq(NewMessage(MsgTypeLogout).Set(TagText, "maintenance"), 4, false)
+	a.next(t, MsgTypeLogout)
+	<-s.Done()
+	assert.ErrorIs(t, s.Err(), ErrLoggedOut, "Err should return a counterparty logout")
+
+	s.cfg.ResetSeqNumOnLogon = true
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+	logon := a.next(t, MsgTypeLogon)
+	assert.Equal(t, "1", logon.GetString(TagMsgSeqNum), "Logon should reset the sender sequence number")
+	assert.True(t, logon.GetBool(TagResetSeqNumFlag), "Logon should set ResetSeqNumFlag")
+}
+
+func TestSessionCompIDMismatch(t *testing.T) {
+	t.Parallel()
+	a := newAcceptor(t, nil)
+	s := newTestSession(t, a, nil, nil)
+	require.NoError(t, s.Connect(t.Context()), "Connect must not error")
+	a.mu.Lock()
+	_, err := a.conn.Write(NewMessage(MsgTypeHeartbeat).Set(TagSenderCompID, "OTHER").Set(TagTargetCompID, testSenderCompID).SetInt(TagMsgSeqNum, 2).Bytes())
+	a.mu.Unlock()
+	require.NoError(t, err, "Write must not error")
+	a.next(t, MsgTypeLogout)
+	<-s.Done()
+	assert.ErrorIs(t, s.Err(), errCompIDMismatch, "Err should return a CompID mismatch")
+}
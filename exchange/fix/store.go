@@ -0,0 +1,273 @@
+package fix
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+var errInvalidStore = errors.New("invalid FIX message store")
+
+// Store persists sequence numbers and sent messages so a session can resume
+// and answer resend requests after a restart
+type Store interface {
+	// NextSenderSeqNum returns the sequence number of the next sent message
+	NextSenderSeqNum() int
+	// NextTargetSeqNum returns the expected sequence number of the next
+	// received message
+	NextTargetSeqNum() int
+	SetNextSenderSeqNum(seq int) error
+	SetNextTargetSeqNum(seq int) error
+	// SaveMessage stores a sent message and advances the sender sequence
+	// number past it
+	SaveMessage(seq int, raw []byte) error
+	// GetMessages returns stored messages between begin and end inclusive.
+	// An end of 0 returns all messages from begin
+	GetMessages(begin, end int) ([][]byte, error)
+	// Reset resets both sequence numbers to 1 and removes stored messages
+	Reset() error
+}
+
+// MemoryStore is a Store which is lost when the process exits
+type MemoryStore struct {
+	mu       sync.Mutex
+	sender   int
+	target   int
+	messages map[int][]byte
+}
+
+// NewMemoryStore returns a new MemoryStore
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sender: 1, target: 1, messages: make(map[int][]byte)}
+}
+
+// NextSenderSeqNum implements Store
+func (s *MemoryStore) NextSenderSeqNum() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sender
+}
+
+// NextTargetSeqNum implements Store
+func (s *MemoryStore) NextTargetSeqNum() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.target
+}
+
+// SetNextSenderSeqNum implements Store
+func (s *MemoryStore) SetNextSenderSeqNum(seq int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sender = seq
+	return nil
+}
+
+// SetNextTargetSeqNum implements Store
+func (s *MemoryStore) SetNextTargetSeqNum(seq int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.target = seq
+	return nil
+}
+
+// SaveMessage implements Store
+func (s *MemoryStore) SaveMessage(seq int, raw []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.messages[seq] = raw
+	s.sender = max(s.sender, seq+1)
+	return nil
+}
+
+// GetMessages implements Store
+func (s *MemoryStore) GetMessages(begin, end int) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return collectMessages(s.messages, begin, end, s.sender), nil
+}
+
+// Reset implements Store
+func (s *MemoryStore) Reset() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sender, s.target = 1, 1
+	s.messages = make(map[int][]byte)
+	return nil
+}
+
+func collectMessages(messages map[int][]byte, begin, end, next int) [][]byte {
+	if end == 0 || end >= next {
+		end = next - 1
+	}
+	var out [][]byte
+	for seq := begin; seq <= end; seq++ {
+		if raw, ok := messages[seq]; ok {
+			out = append(out, raw)
+		}
+	}
+	return out
+}
+
+// FileStore is a Store persisted to a directory. Sequence numbers are written
+// to <session>.seqnums and sent messages are appended to <session>.body
+type FileStore struct {
+	mu          sync.Mutex
+	seqNumsPath string
+	bodyPath    string
+	body        *os.File
+	sender      int
+	target      int
+	messages    map[int][]byte
+}
+
+// NewFileStore opens or creates a FileStore in dir for the session identified
+// by the sender and target CompIDs
+func NewFileStore(dir, senderCompID, targetCompID string) (*FileStore, error) {
+	if dir == "" || senderCompID == "" || targetCompID == "" {
+		return nil, fmt.Errorf("%w: directory, SenderCompID and TargetCompID must be set", errInvalidStore)
+	}
+	if err := os.MkdirAll(dir, file.DefaultPermissionOctal); err != nil {
+		return nil, err
+	}
+	name := senderCompID + "-" + targetCompID
+	s := &FileStore{
+		seqNumsPath: filepath.Join(dir, name+".seqnums"),
+		bodyPath:    filepath.Join(dir, name+".body"),
+		sender:      1,
+		target:      1,
+		messages:    make(map[int][]byte),
+	}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	var err error
+	if s.body, err = os.OpenFile(s.bodyPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, file.DefaultPermissionOctal); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *FileStore) load() error {
+	if contents, err := os.ReadFile(s.seqNumsPath); err == nil {
+		sender, target, ok := strings.Cut(strings.TrimSpace(string(contents)), ":")
+		if !ok {
+			return fmt.Errorf("%w: %s", errInvalidStore, s.seqNumsPath)
+		}
+		if s.sender, err = strconv.Atoi(sender); err != nil {
+			return fmt.Errorf("%w: %s: %w", errInvalidStore, s.seqNumsPath, err)
+		}
+		if s.target, err = strconv.Atoi(target); err != nil {
+			return fmt.Errorf("%w: %s: %w", errInvalidStore, s.seqNumsPath, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	f, err := os.Open(s.bodyPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer f.Close()
+	// Each record is "<seq> <length>\n" followed by the raw message
+	r := bufio.NewReader(f)
+	for {
+		line, err := r.ReadString('\n')
+		if errors.Is(err, io.EOF) && line == "" {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("%w: %s: %w", errInvalidStore, s.bodyPath, err)
+		}
+		var seq, length int
+		if _, err := fmt.Sscanf(line, "%d %d\n", &seq, &length); err != nil {
+			return fmt.Errorf("%w: %s: %w", errInvalidStore, s.bodyPath, err)
+		}
+		raw := make([]byte, length)
+		if _, err := io.ReadFull(r, raw); err != nil {
+			return fmt.Errorf("%w: %s: %w", errInvalidStore, s.bodyPath, err)
+		}
+		s.messages[seq] = raw
+	}
+}
+
+func (s *FileStore) saveSeqNums() error {
+	return file.Write(s.seqNumsPath, []byte(strconv.Itoa(s.sender)+":"+strconv.Itoa(s.target)))
+}
+
+// NextSenderSeqNum implements Store
+func (s *FileStore) NextSenderSeqNum() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sender
+}
+
+// NextTargetSeqNum implements Store
+func (s *FileStore) NextTargetSeqNum() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.target
+}
+
+// SetNextSenderSeqNum implements Store
+func (s *FileStore) SetNextSenderSeqNum(seq int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sender = seq
+	return s.saveSeqNums()
+}
+
+// SetNextTargetSeqNum implements Store
+func (s *FileStore) SetNextTargetSeqNum(seq int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.target = seq
+	return s.saveSeqNums()
+}
+
+// SaveMessage implements Store
+func (s *FileStore) SaveMessage(seq int, raw []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := fmt.Fprintf(s.body, "%d %d\n%s", seq, len(raw), raw); err != nil {
+		return err
+	}
+	s.messages[seq] = raw
+	s.sender = max(s.sender, seq+1)
+	return s.saveSeqNums()
+}
+
+// GetMessages implements Store
+func (s *FileStore) GetMessages(begin, end int) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return collectMessages(s.messages, begin, end, s.sender), nil
+}
+
+// Reset implements Store
+func (s *FileStore) Reset() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.body.Truncate(0); err != nil {
+		return err
+	}
+	s.sender, s.target = 1, 1
+	s.messages = make(map[int][]byte)
+	return s.saveSeqNums()
+}
+
+// Close closes the message file
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.body.Close()
+}
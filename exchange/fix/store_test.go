@@ -0,0 +1,82 @@
+package fix
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testStore(t *testing.T, s Store) {
+	t.Helper()
+	assert.Equal(t, 1, s.NextSenderSeqNum(), "NextSenderSeqNum should start at 1")
+	assert.Equal(t, 1, s.NextTargetSeqNum(), "NextTargetSeqNum should start at 1")
+
+	require.NoError(t, s.SaveMessage(1, []byte("one")), "SaveMessage must not error")
+	require.NoError(t, s.SaveMessage(2, []byte("two")), "SaveMessage must not error")
+	require.NoError(t, s.SaveMessage(4, []byte("four")), "SaveMessage must not error")
+	assert.Equal(t, 5, s.NextSenderSeqNum(), "SaveMessage should advance the sender sequence number")
+	require.NoError(t, s.SetNextTargetSeqNum(3), "SetNextTargetSeqNum must not error")
+	assert.Equal(t, 3, s.NextTargetSeqNum(), "SetNextTargetSeqNum should set the target sequence number")
+
+	msgs, err := s.GetMessages(2, 0)
+	require.NoError(t, err, "GetMessages must not error")
+	assert.Equal(t, [][]byte{[]byte("two"), []byte("four")}, msgs, "GetMessages should return all messages from begin")
+	msgs, err = s.GetMessages(1, 2)
+	require.NoError(t, err, "GetMessages must not error")
+	assert.Equal(t, [][]byte{[]byte("one"), []byte("two")}, msgs, "GetMessages should return messages up to end")
+}
+
+func TestMemoryStore(t *testing.T) {
+	t.Parallel()
+	s := NewMemoryStore()
+	testStore(t, s)
+	require.NoError(t, s.SetNextSenderSeqNum(10), "SetNextSenderSeqNum must not error")
+	assert.Equal(t, 10, s.NextSenderSeqNum(), "SetNextSenderSeqNum should set the sender sequence number")
+	require.NoError(t, s.Reset(), "Reset must not error")
+	assert.Equal(t, 1, s.NextSenderSeqNum(), "Reset should reset the sender sequence number")
+	msgs, err := s.GetMessages(1, 0)
+	require.NoError(t, err, "GetMessages must not error")
+	assert.Empty(t, msgs, "Reset should remove messages")
+}
+
+func TestFileStore(t *testing.T) {
+	t.Parallel()
+	_, err := NewFileStore("", "GCT", "VENUE")
+	assert.ErrorIs(t, err, errInvalidStore, "NewFileStore should error without a directory")
+
+	dir := filepath.Join(t.TempDir(), "fix")
+	s, err := NewFileStore(dir, "GCT", "VENUE")
+	require.NoError(t, err, "NewFileStore must not error")
+	testStore(t, s)
+	require.NoError(t, s.Close(), "Close must not error")
+
+	s, err = NewFileStore(dir, "GCT", "VENUE")
+	require.NoError(t, err, "NewFileStore must not error")
+	assert.Equal(t, 5, s.NextSenderSeqNum(), "NewFileStore should load the sender sequence number")
+	assert.Equal(t, 3, s.NextTargetSeqNum(), "NewFileStore should load the target sequence number")
+	msgs, err := s.GetMessages(1, 0)
+	require.NoError(t, err, "GetMessages must not error")
+	assert.Equal(t, [][]byte{[]byte("one"), []byte("two"), []byte("four")}, msgs, "NewFileStore should load stored messages")
+
+	require.NoError(t, s.Reset(), "Reset must not error")
+	require.NoError(t, s.SaveMessage(1, []byte("reset")), "SaveMessage must not error")
+	require.NoError(t, s.Close(), "Close must not error")
+	s, err = NewFileStore(dir, "GCT", "VENUE")
+	require.NoError(t, err, "NewFileStore must not error")
+	defer s.Close()
+	assert.Equal(t, 2, s.NextSenderSeqNum(), "Reset should persist the sender sequence number")
+	assert.Equal(t, 1, s.NextTargetSeqNum(), "Reset should persist the target sequence number")
+	msgs, err = s.GetMessages(1, 0)
+	require.NoError(t, err, "GetMessages must not error")
+	assert.Equal(t, [][]byte{[]byte("reset")}, msgs, "Reset should remove stored messages")
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "GCT-BAD.seqnums"), []byte("bad"), 0o600), "WriteFile must not error")
+	_, err = NewFileStore(dir, "GCT", "BAD")
+	assert.ErrorIs(t, err, errInvalidStore, "NewFileStore should error for invalid sequence numbers")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "GCT-BODY.body"), []byte("1 10\nshort"), 0o600), "WriteFile must not error")
+	_, err = NewFileStore(dir, "GCT", "BODY")
+	assert.ErrorIs(t, err, errInvalidStore, "NewFileStore should error for truncated messages")
+}
@@ -128,6 +128,9 @@ type connection struct {
 	Traffic                  chan struct{}
 	readMessageErrors        chan error
 	bespokeGenerateMessageID func(highPrecision bool) int64
+	recorderMtx              sync.RWMutex // recorderMtx protects the recorder and session, which can be set while the connection is in use
+	recorder                 Recorder
+	session                  int
 }
 
 // Dial sets proxy urls and then connects to the websocket
@@ -157,6 +160,11 @@ func (c *connection) DialContext(ctx context.Context, dialer *gws.Dialer, header
 	}
 	_ = conStatus.Body.Close()
 
+	c.recorderMtx.Lock()
+	if c.recorder != nil {
+		c.session = c.recorder.RecordSession(c.URL)
+	}
+	c.recorderMtx.Unlock()
 	if c.Verbose {
 		log.Infof(log.WebsocketMgr, "%v Websocket connected to %s\n", c.ExchangeName, c.URL)
 	}
@@ -171,9 +179,16 @@ func (c *connection) DialContext(ctx context.Context, dialer *gws.Dialer, header
 // SendJSONMessage sends a JSON encoded message over the connection
 func (c *connection) SendJSONMessage(ctx context.Context, epl request.EndpointLimit, data any) error {
 	return c.writeToConn(ctx, epl, func() error {
-		if request.IsVerbose(ctx, c.Verbose) {
+		verbose := request.IsVerbose(ctx, c.Verbose)
+		recorder, session := c.getRecorder()
+		if verbose || recorder != nil {
 			if msg, err := json.Marshal(data); err == nil { // WriteJSON will error for us anyway
-				log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(msg))
+				if verbose {
+					log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(msg))
+				}
+				if recorder != nil {
+					recorder.RecordOutbound(session, msg)
+				}
 			}
 		}
 		return c.Connection.WriteJSON(data)
@@ -182,10 +197,21 @@ func (c *connection) SendJSONMessage(ctx context.Context, epl request.EndpointLi
 
 // SendRawMessage sends a message over the connection without JSON encoding it
 func (c *connection) SendRawMessage(ctx context.Context, epl request.EndpointLimit, messageType int, message []byte) error {
+	return c.sendRawMessage(ctx, epl, messageType, message, true)
+}
+
+// sendRawMessage sends a message over the connection, optionally recording it.
+// Pings are not recorded as they are not replayed
+func (c *connection) sendRawMessage(ctx context.Context, epl request.EndpointLimit, messageType int, message []byte, record bool) error {
 	return c.writeToConn(ctx, epl, func() error {
 		if request.IsVerbose(ctx, c.Verbose) {
 			log.Debugf(log.WebsocketMgr, "%v %v: Sending message: %v", c.ExchangeName, removeURLQueryString(c.URL), string(message))
 		}
+		if record {
+			if recorder, session := c.getRecorder(); recorder != nil {
+				recorder.RecordOutbound(session, message)
+			}
+		}
 		return c.Connection.WriteMessage(messageType, message)
 	})
 }
@@ -247,7 +273,7 @@ func (c *connection) SetupPingHandler(epl request.EndpointLimit, handler PingHan
 				ticker.Stop()
 				return
 			case <-ticker.C:
-				err := c.SendRawMessage(context.TODO(), epl, handler.MessageType, handler.Message)
+				err := c.sendRawMessage(context.TODO(), epl, handler.MessageType, handler.Message, false)
 				if err != nil {
 					log.Errorf(log.WebsocketMgr, "%v websocket connection: ping handler failed to send message [%s]: %v", c.ExchangeName, handler.Message, err)
 					return
@@ -303,6 +329,10 @@ func (c *connection) ReadMessage() Response {
 	default: // Non-Blocking write ensures 1 buffered signal per trafficCheckInterval to avoid flooding
 	}
 
+	if c.Reporter != nil {
+		c.Reporter.MessageReceived(c.ExchangeName, len(resp))
+	}
+
 	var standardMessage []byte
 	switch mType {
 	case gws.TextMessage:
@@ -317,9 +347,26 @@ func (c *connection) ReadMessage() Response {
 	if c.Verbose {
 		log.Debugf(log.WebsocketMgr, "%v %v: Message received: %v", c.ExchangeName, removeURLQueryString(c.URL), string(standardMessage))
 	}
+	if recorder, session := c.getRecorder(); recorder != nil {
+		recorder.RecordInbound(session, standardMessage)
+	}
 	return Response{Raw: standardMessage, Type: mType}
 }
 
+// setRecorder sets the recorder of frames sent and received by the connection
+func (c *connection) setRecorder(r Recorder) {
+	c.recorderMtx.Lock()
+	c.recorder = r
+	c.recorderMtx.Unlock()
+}
+
+// getRecorder returns the recorder of the connection and its recorded session
+func (c *connection) getRecorder() (Recorder, int) {
+	c.recorderMtx.RLock()
+	defer c.recorderMtx.RUnlock()
+	return c.recorder, c.session
+}
+
 // parseBinaryResponse parses a websocket binary response into a usable byte array
 func (c *connection) parseBinaryResponse(resp []byte) ([]byte, error) {
 	var reader io.ReadCloser
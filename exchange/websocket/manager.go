@@ -115,6 +115,9 @@ type Manager struct {
 	ExchangeLevelReporter         Reporter   // Latency reporter
 	MaxSubscriptionsPerConnection int
 
+	recorderMtx sync.RWMutex
+	recorder    Recorder
+
 	// connectionManager stores all *potential* connections for the exchange, organised within connectionWrapper structs.
 	// Each connectionWrapper one connection (will be expanded soon) tailored for specific exchange functionalities or asset types. // TODO: Expand this to support multiple connections per connectionWrapper
 	// For example, separate connections can be used for Spot, Margin, and Futures trading. This structure is especially useful
@@ -402,9 +405,32 @@ func (m *Manager) getConnectionFromSetup(c *ConnectionSetup) *connection {
 		Reporter:                 c.ConnectionLevelReporter,
 		bespokeGenerateMessageID: c.BespokeGenerateMessageID,
 		RateLimitDefinitions:     m.rateLimitDefinitions,
+		recorder:                 m.getRecorder(),
+	}
+}
+
+// SetRecorder records the frames sent and received by connections so
+// sessions can be replayed in tests. It must be set before connecting
+func (m *Manager) SetRecorder(r Recorder) {
+	m.m.Lock()
+	defer m.m.Unlock()
+	m.recorderMtx.Lock()
+	m.recorder = r
+	m.recorderMtx.Unlock()
+	for _, conn := range []Connection{m.Conn, m.AuthConn} {
+		if c, ok := conn.(*connection); ok {
+			c.setRecorder(r)
+		}
 	}
 }
 
+// getRecorder returns the recorder used by new connections
+func (m *Manager) getRecorder() Recorder {
+	m.recorderMtx.RLock()
+	defer m.recorderMtx.RUnlock()
+	return m.recorder
+}
+
 // Connect initiates a websocket connection by using a package defined connection
 // function
 func (m *Manager) Connect() error {
@@ -957,6 +983,7 @@ func (m *Manager) observeConnection(t *time.Timer) (exit bool) {
 		}
 		// Speedier reconnection, instead of waiting for the next cycle.
 		if m.IsEnabled() && (!m.IsConnected() && !m.IsConnecting()) {
+			m.reportReconnect()
 			if connectErr := m.Connect(); connectErr != nil {
 				log.Errorln(log.WebsocketMgr, connectErr)
 			}
@@ -983,6 +1010,7 @@ func (m *Manager) observeConnection(t *time.Timer) (exit bool) {
 			return true
 		}
 		if !m.IsConnecting() && !m.IsConnected() {
+			m.reportReconnect()
 			err := m.Connect()
 			if err != nil {
 				log.Errorln(log.WebsocketMgr, err)
@@ -993,6 +1021,18 @@ func (m *Manager) observeConnection(t *time.Timer) (exit bool) {
 	return false
 }
 
+// reportReconnect reports a reconnect attempt to the exchange level reporter,
+// falling back to the global reporter
+func (m *Manager) reportReconnect() {
+	r := m.ExchangeLevelReporter
+	if r == nil {
+		r = globalReporter
+	}
+	if r != nil {
+		r.Reconnect(m.exchangeName)
+	}
+}
+
 // monitorTraffic monitors to see if there has been traffic within the trafficTimeout time window. If there is no traffic
 // the connection is shutdown and will be reconnected by the connectionMonitor routine.
 func (m *Manager) monitorTraffic() func() bool {
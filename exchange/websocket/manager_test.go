@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -619,9 +620,11 @@ type inspection struct {
 func (i inspection) IsFinal([]byte) bool { return i.breakEarly }
 
 type reporter struct {
-	name string
-	msg  []byte
-	t    time.Duration
+	name       string
+	msg        []byte
+	t          time.Duration
+	received   atomic.Int64
+	reconnects atomic.Int64
 }
 
 func (r *reporter) Latency(name string, message []byte, t time.Duration) {
@@ -630,6 +633,14 @@ func (r *reporter) Latency(name string, message []byte, t time.Duration) {
 	r.t = t
 }
 
+func (r *reporter) MessageReceived(string, int) {
+	r.received.Add(1)
+}
+
+func (r *reporter) Reconnect(string) {
+	r.reconnects.Add(1)
+}
+
 // readMessages helper func
 func readMessages(t *testing.T, wc *connection) {
 	t.Helper()
@@ -1105,6 +1116,85 @@ func TestConnectionShutdown(t *testing.T) {
 	require.NoError(t, err, "Shutdown must not error")
 }
 
+type testRecorder struct {
+	sessions []string
+	inbound  []string
+	outbound []string
+}
+
+func (r *testRecorder) RecordSession(u string) int {
+	r.sessions = append(r.sessions, u)
+	return len(r.sessions) - 1
+}
+
+func (r *testRecorder) RecordInbound(_ int, payload []byte) {
+	r.inbound = append(r.inbound, string(payload))
+}
+
+func (r *testRecorder) RecordOutbound(_ int, payload []byte) {
+	r.outbound = append(r.outbound, string(payload))
+}
+
+func TestSetRecorder(t *testing.T) {
+	t.Parallel()
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	defer mock.Close()
+
+	m := NewManager()
+	m.Conn = &connection{URL: "ws" + mock.URL[len("http"):] + "/ws", shutdown: make(chan struct{})}
+	r := &testRecorder{}
+	m.SetRecorder(r)
+	assert.Equal(t, r, m.recorder, "SetRecorder should set the manager recorder")
+	assert.Equal(t, r, m.getConnectionFromSetup(&ConnectionSetup{}).recorder, "Connections should use the manager recorder")
+
+	conn, ok := m.Conn.(*connection)
+	require.True(t, ok, "Conn must be a *connection")
+	require.NoError(t, conn.Dial(&gws.Dialer{}, http.Header{}), "Dial must not error")
+	defer func() { assert.NoError(t, conn.Shutdown(), "Shutdown should not error") }()
+	assert.Equal(t, []string{conn.URL}, r.sessions, "Dial should record a session")
+
+	require.NoError(t, conn.SendJSONMessage(t.Context(), request.Unset, map[string]string{"op": "subscribe"}), "SendJSONMessage must not error")
+	assert.Equal(t, []byte("{\"op\":\"subscribe\"}\n"), conn.ReadMessage().Raw, "ReadMessage should return the echoed message")
+	require.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("ping")), "SendRawMessage must not error")
+	assert.Equal(t, []byte("ping"), conn.ReadMessage().Raw, "ReadMessage should return the echoed message")
+	require.NoError(t, conn.sendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("pong"), false), "sendRawMessage must not error")
+	conn.ReadMessage()
+
+	assert.Equal(t, []string{`{"op":"subscribe"}`, "ping"}, r.outbound, "Should record outbound messages which are not pings")
+	assert.Equal(t, []string{"{\"op\":\"subscribe\"}\n", "ping", "pong"}, r.inbound, "Should record inbound messages")
+}
+
+func TestSetRecorderWhileConnected(t *testing.T) {
+	t.Parallel()
+	mock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { mockws.WsMockUpgrader(t, w, r, mockws.EchoHandler) }))
+	defer mock.Close()
+
+	m := NewManager()
+	conn := &connection{URL: "ws" + mock.URL[len("http"):] + "/ws", shutdown: make(chan struct{})}
+	m.Conn = conn
+	require.NoError(t, conn.Dial(&gws.Dialer{}, http.Header{}), "Dial must not error")
+	defer func() { assert.NoError(t, conn.Shutdown(), "Shutdown should not error") }()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for range 10 {
+			m.SetRecorder(&testRecorder{})
+			m.SetRecorder(nil)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for range 10 {
+			assert.NoError(t, conn.SendRawMessage(t.Context(), request.Unset, gws.TextMessage, []byte("ping")), "SendRawMessage should not error")
+			conn.ReadMessage()
+			_ = m.getConnectionFromSetup(&ConnectionSetup{})
+		}
+	}()
+	wg.Wait()
+}
+
 // TestLatency logic test
 func TestLatency(t *testing.T) {
 	t.Parallel()
@@ -1142,6 +1232,7 @@ func TestLatency(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEmpty(t, r.t, "Latency must have a duration")
 	require.Equal(t, exch, r.name, "Latency must have the correct exchange name")
+	assert.NotZero(t, r.received.Load(), "MessageReceived should be reported for received messages")
 }
 
 func TestRemoveURLQueryString(t *testing.T) {
@@ -1228,7 +1319,8 @@ func TestMonitorData(t *testing.T) {
 
 func TestMonitorConnection(t *testing.T) {
 	t.Parallel()
-	ws := Manager{verbose: true, ReadMessageErrors: make(chan error, 1), ShutdownC: make(chan struct{})}
+	r := &reporter{}
+	ws := Manager{verbose: true, ReadMessageErrors: make(chan error, 1), ShutdownC: make(chan struct{}), ExchangeLevelReporter: r}
 	// Handle timer expired and websocket disabled, shutdown everything.
 	timer := time.NewTimer(0)
 	ws.setState(connectedState)
@@ -1245,6 +1337,7 @@ func TestMonitorConnection(t *testing.T) {
 	// Handle timer expired and for reason its not connected, so lets happily connect again.
 	ws.setState(disconnectedState)
 	require.False(t, ws.observeConnection(timer)) // Connect is intentionally erroring
+	assert.Equal(t, int64(1), r.reconnects.Load(), "Reconnect should be reported when reconnecting")
 	// Handle error from a connection which will then trigger a reconnect
 	ws.setState(connectedState)
 	ws.DataHandler = make(chan any, 1)
@@ -1255,6 +1348,7 @@ func TestMonitorConnection(t *testing.T) {
 	err, ok := payload.(error)
 	require.True(t, ok)
 	require.ErrorIs(t, err, errConnectionFault)
+	assert.Equal(t, int64(2), r.reconnects.Load(), "Reconnect should be reported when reconnecting after a connection fault")
 	// Handle outta closure shell
 	innerShell := ws.monitorConnection()
 	ws.setState(connectedState)
@@ -50,7 +50,22 @@ type UnhandledMessageWarning struct {
 	Message string
 }
 
-// Reporter interface groups observability functionality over Websocket request latency.
+// Recorder records websocket sessions so they can be replayed in tests
+type Recorder interface {
+	// RecordSession is called each time a connection is established and
+	// returns the ID of the session its frames are recorded against
+	RecordSession(url string) int
+	RecordInbound(session int, payload []byte)
+	RecordOutbound(session int, payload []byte)
+}
+
+// Reporter interface groups observability functionality over Websocket
+// request latency, message throughput and reconnects.
 type Reporter interface {
 	Latency(name string, message []byte, t time.Duration)
+	// MessageReceived is called for each message read from a connection
+	MessageReceived(name string, size int)
+	// Reconnect is called each time the connection monitor attempts to
+	// reconnect a dropped connection
+	Reconnect(name string)
 }
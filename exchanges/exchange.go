@@ -106,6 +106,7 @@ func (b *Base) SetFeatureDefaults() {
 			Supports: config.FeaturesSupportedConfig{
 				Websocket: b.Features.Supports.Websocket,
 				REST:      b.Features.Supports.REST,
+				FIX:       b.Features.Supports.FIX,
 				RESTCapabilities: protocol.Features{
 					AutoPairUpdates: b.Features.Supports.RESTCapabilities.AutoPairUpdates,
 				},
@@ -146,6 +147,10 @@ func (b *Base) SetFeatureDefaults() {
 			b.Config.Features.Supports.Websocket = b.Features.Supports.Websocket
 		}
 
+		if b.Features.Supports.FIX != b.Config.Features.Supports.FIX {
+			b.Config.Features.Supports.FIX = b.Features.Supports.FIX
+		}
+
 		if b.IsSaveTradeDataEnabled() != b.Config.Features.Enabled.SaveTradeData {
 			b.SetSaveTradeDataStatus(b.Config.Features.Enabled.SaveTradeData)
 		}
@@ -1068,6 +1073,11 @@ func (b *Base) SupportsWebsocket() bool {
 	return b.Features.Supports.Websocket
 }
 
+// SupportsFIX returns whether or not the exchange supports a FIX gateway
+func (b *Base) SupportsFIX() bool {
+	return b.Features.Supports.FIX
+}
+
 // IsWebsocketEnabled returns whether or not the exchange has its
 // websocket client enabled
 func (b *Base) IsWebsocketEnabled() bool {
@@ -251,6 +251,7 @@ func TestSetFeatureDefaults(t *testing.T) {
 					TickerBatching: true,
 				},
 				Websocket: true,
+				FIX:       true,
 			},
 		},
 	}
@@ -258,6 +259,7 @@ func TestSetFeatureDefaults(t *testing.T) {
 	if !b.Config.Features.Supports.REST && b.Config.CurrencyPairs.LastUpdated == 0 {
 		t.Error("incorrect values")
 	}
+	assert.True(t, b.Config.Features.Supports.FIX, "SetFeatureDefaults should set FIX support")
 
 	// Test upgrade when SupportsAutoPairUpdates is enabled
 	bptr := func(a bool) *bool { return &a }
@@ -273,7 +275,9 @@ func TestSetFeatureDefaults(t *testing.T) {
 	b.Config.Features.Supports.REST = false
 	b.Config.Features.Supports.RESTCapabilities.TickerBatching = false
 	b.Config.Features.Supports.Websocket = false
+	b.Config.Features.Supports.FIX = false
 	b.SetFeatureDefaults()
+	assert.True(t, b.Config.Features.Supports.FIX, "SetFeatureDefaults should update FIX support")
 
 	if !b.Features.Supports.REST ||
 		!b.Features.Supports.RESTCapabilities.TickerBatching ||
@@ -1171,6 +1175,14 @@ func TestSupportsWebsocket(t *testing.T) {
 	}
 }
 
+func TestSupportsFIX(t *testing.T) {
+	t.Parallel()
+	var b Base
+	assert.False(t, b.SupportsFIX(), "SupportsFIX should return false by default")
+	b.Features.Supports.FIX = true
+	assert.True(t, b.SupportsFIX(), "SupportsFIX should return true when supported")
+}
+
 func TestSupportsREST(t *testing.T) {
 	t.Parallel()
 
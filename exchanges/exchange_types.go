@@ -172,6 +172,8 @@ type FeaturesSupported struct {
 	RESTCapabilities           protocol.Features
 	Websocket                  bool
 	WebsocketCapabilities      protocol.Features
+	FIX                        bool
+	FIXCapabilities            protocol.Features
 	WithdrawPermissions        uint32
 	Kline                      kline.ExchangeCapabilitiesSupported
 	MaximumOrderHistory        time.Duration
@@ -744,6 +744,10 @@ fundingRates:
 	}
 
 	p.fundingRateDetails.FundingRates = append(p.fundingRateDetails.FundingRates, rates...)
+	p.fundingRateDetails.PaymentSum = decimal.Zero
+	for i := range p.fundingRateDetails.FundingRates {
+		p.fundingRateDetails.PaymentSum = p.fundingRateDetails.PaymentSum.Add(p.fundingRateDetails.FundingRates[i].Payment)
+	}
 	p.lastUpdated = time.Now()
 	return nil
 }
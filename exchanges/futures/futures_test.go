@@ -1468,6 +1468,22 @@ func TestPTTrackFundingDetails(t *testing.T) {
 		t.Errorf("received '%v' expected '%v", err, nil)
 	}
 
+	rates.FundingRates = append(rates.FundingRates, fundingrate.Rate{
+		Time:    rates.EndDate,
+		Rate:    decimal.NewFromInt(1),
+		Payment: decimal.NewFromInt(-1),
+	})
+	err = p.TrackFundingDetails(rates)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v", err, nil)
+	}
+	if len(p.fundingRateDetails.FundingRates) != 2 {
+		t.Errorf("received '%v' expected '%v", len(p.fundingRateDetails.FundingRates), 2)
+	}
+	if !p.fundingRateDetails.PaymentSum.Equal(decimal.NewFromInt(1336)) {
+		t.Errorf("received '%v' expected '%v", p.fundingRateDetails.PaymentSum, 1336)
+	}
+
 	rates.Exchange = ""
 	err = p.TrackFundingDetails(rates)
 	if !errors.Is(err, errExchangeNameEmpty) {
@@ -0,0 +1,71 @@
+package kline
+
+import (
+	"fmt"
+	"time"
+)
+
+// NewBuilder returns a Builder which aggregates trades into candles of the
+// provided interval
+func NewBuilder(interval Interval) (*Builder, error) {
+	if interval.Duration() <= 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInterval, interval)
+	}
+	return &Builder{interval: interval}, nil
+}
+
+// AddTrade adds a trade to the open candle, returning any candles closed by
+// the trade. Trades for an already closed candle are ignored
+func (b *Builder) AddTrade(price, amount float64, tradeTime time.Time) []Candle {
+	if price <= 0 {
+		return nil
+	}
+	start := tradeTime.Truncate(b.interval.Duration())
+	var closed []Candle
+	switch {
+	case b.candle == nil:
+		b.candle = &Candle{Time: start}
+	case start.Before(b.candle.Time):
+		return nil
+	case start.After(b.candle.Time):
+		closed = b.Close(start)
+	}
+	if !b.traded {
+		b.candle.Open = price
+		b.candle.High = price
+		b.candle.Low = price
+		b.traded = true
+	}
+	b.candle.High = max(b.candle.High, price)
+	b.candle.Low = min(b.candle.Low, price)
+	b.candle.Close = price
+	b.candle.Volume += amount
+	return closed
+}
+
+// Close closes all candles which ended at or before the provided time.
+// Intervals without trades are closed as flat candles at the previous close
+func (b *Builder) Close(until time.Time) []Candle {
+	if b.candle == nil {
+		return nil
+	}
+	var closed []Candle
+	for !b.candle.Time.Add(b.interval.Duration()).After(until) {
+		closed = append(closed, *b.candle)
+		b.candle = &Candle{
+			Time:  b.candle.Time.Add(b.interval.Duration()),
+			Open:  b.candle.Close,
+			High:  b.candle.Close,
+			Low:   b.candle.Close,
+			Close: b.candle.Close,
+		}
+		b.traded = false
+	}
+	return closed
+}
+
+// Reset discards the open candle, the next trade starting a new candle
+func (b *Builder) Reset() {
+	b.candle = nil
+	b.traded = false
+}
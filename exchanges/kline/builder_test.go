@@ -0,0 +1,48 @@
+package kline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+	_, err := NewBuilder(0)
+	assert.ErrorIs(t, err, ErrInvalidInterval)
+	b, err := NewBuilder(OneMin)
+	require.NoError(t, err, "NewBuilder must not error")
+	assert.Equal(t, OneMin, b.interval)
+}
+
+func TestBuilder(t *testing.T) {
+	t.Parallel()
+	b, err := NewBuilder(OneMin)
+	require.NoError(t, err, "NewBuilder must not error")
+	start := time.Date(2024, 1, 1, 0, 0, 30, 0, time.UTC)
+
+	assert.Empty(t, b.Close(start), "Close should not close candles before a trade")
+	assert.Empty(t, b.AddTrade(0, 1, start), "AddTrade should ignore invalid prices")
+	assert.Nil(t, b.candle)
+	assert.Empty(t, b.AddTrade(10, 1, start))
+	assert.Empty(t, b.AddTrade(11, 1, start.Add(time.Second)))
+	assert.Empty(t, b.AddTrade(9, 1, start.Add(time.Second*2)))
+	assert.Empty(t, b.Close(start.Add(time.Second*29)), "Close should not close an open candle")
+
+	closed := b.AddTrade(12, 2, start.Add(time.Minute*2))
+	require.Len(t, closed, 2, "AddTrade must close the traded candle and the candle without trades")
+	assert.Equal(t, Candle{Time: start.Truncate(time.Minute), Open: 10, High: 11, Low: 9, Close: 9, Volume: 3}, closed[0])
+	assert.Equal(t, Candle{Time: start.Truncate(time.Minute).Add(time.Minute), Open: 9, High: 9, Low: 9, Close: 9}, closed[1], "a candle without trades should be flat at the previous close")
+	assert.Empty(t, b.AddTrade(1, 1, start), "AddTrade should ignore trades for closed candles")
+
+	closed = b.Close(start.Add(time.Minute * 3))
+	require.Len(t, closed, 1, "Close must close an ended candle")
+	assert.Equal(t, Candle{Time: start.Truncate(time.Minute).Add(time.Minute * 2), Open: 12, High: 12, Low: 12, Close: 12, Volume: 2}, closed[0])
+
+	b.Reset()
+	assert.Nil(t, b.candle, "Reset should discard the open candle")
+	assert.Empty(t, b.Close(start.Add(time.Hour)), "Close should not close candles after a reset")
+	assert.Empty(t, b.AddTrade(1, 1, start), "AddTrade should accept earlier trades after a reset")
+}
@@ -211,3 +211,12 @@ type IntervalCapacity struct {
 	Interval Interval
 	Capacity uint64
 }
+
+// Builder aggregates a stream of trades into candles of a single interval.
+// It is not safe for concurrent use
+type Builder struct {
+	interval Interval
+	// candle is the open candle, nil until the first trade is added
+	candle *Candle
+	traded bool
+}
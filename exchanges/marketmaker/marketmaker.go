@@ -0,0 +1,212 @@
+package marketmaker
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Validate checks the config can generate quotes, defaulting the price
+// source to the mid price when unset
+func (c *Config) Validate() error {
+	if c == nil {
+		return fmt.Errorf("%w market maker config", common.ErrNilPointer)
+	}
+	switch c.PriceSource {
+	case "":
+		c.PriceSource = MidPrice
+	case MidPrice, MicroPrice:
+	default:
+		return fmt.Errorf("%w '%s'", errInvalidPriceSource, c.PriceSource)
+	}
+	if c.Levels <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidLevels, c.Levels)
+	}
+	if c.Spread <= 0 || c.Spread >= 1 {
+		return fmt.Errorf("%w: %v", errInvalidSpread, c.Spread)
+	}
+	if c.LevelSpacing < 0 {
+		return fmt.Errorf("%w: %v", errInvalidLevelSpacing, c.LevelSpacing)
+	}
+	if c.OrderAmount <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidOrderAmount, c.OrderAmount)
+	}
+	if c.AmountMultiplier < 0 {
+		return fmt.Errorf("%w: %v", errInvalidMultiplier, c.AmountMultiplier)
+	}
+	if c.MaxInventory <= 0 {
+		return fmt.Errorf("%w: %v", errInvalidMaxInventory, c.MaxInventory)
+	}
+	if c.InventorySkew < 0 || c.InventorySkew >= 1 {
+		return fmt.Errorf("%w: %v", errInvalidSkew, c.InventorySkew)
+	}
+	if c.MaxLoss < 0 {
+		return fmt.Errorf("%w: %v", errInvalidMaxLoss, c.MaxLoss)
+	}
+	if c.RequoteTolerance < 0 {
+		return fmt.Errorf("%w: %v", errInvalidTolerance, c.RequoteTolerance)
+	}
+	return nil
+}
+
+// CheckLoss returns ErrLossLimitReached when the profit or loss has reached
+// the configured maximum loss
+func (c *Config) CheckLoss(pnl float64) error {
+	if c.MaxLoss > 0 && pnl <= -c.MaxLoss {
+		return fmt.Errorf("%w: %v loss exceeds %v", ErrLossLimitReached, -pnl, c.MaxLoss)
+	}
+	return nil
+}
+
+// GetReferencePrice returns the price quotes are placed around from the best
+// bid and ask of an orderbook
+func GetReferencePrice(bid, ask orderbook.Tranche, source PriceSource) (float64, error) {
+	if bid.Price <= 0 || ask.Price <= 0 {
+		return 0, errNoReferencePrice
+	}
+	switch source {
+	case MidPrice, "":
+		return (bid.Price + ask.Price) / 2, nil
+	case MicroPrice:
+		if bid.Amount+ask.Amount <= 0 {
+			return (bid.Price + ask.Price) / 2, nil
+		}
+		return (bid.Price*ask.Amount + ask.Price*bid.Amount) / (bid.Amount + ask.Amount), nil
+	}
+	return 0, fmt.Errorf("%w '%s'", errInvalidPriceSource, source)
+}
+
+// GetDepthReferencePrice returns the price quotes are placed around from an
+// orderbook depth
+func GetDepthReferencePrice(d *orderbook.Depth, source PriceSource) (float64, error) {
+	if d == nil {
+		return 0, fmt.Errorf("%w orderbook depth", common.ErrNilPointer)
+	}
+	asks, bids, err := d.GetTranches(1)
+	if err != nil {
+		return 0, err
+	}
+	if len(asks) == 0 || len(bids) == 0 {
+		return 0, errNoReferencePrice
+	}
+	return GetReferencePrice(bids[0], asks[0], source)
+}
+
+// GetQuotes returns the bid and ask ladders around a reference price for the
+// current base inventory. Quotes are shifted away from the side which would
+// move the inventory further from its target and are limited so filling
+// every quote on a side cannot take the inventory past its maximum or sell
+// more than is held. Prices
+// are rounded away from the reference price to the exchange's price step and
+// amounts down to its amount step, with quotes outside the exchange's limits
+// dropped. Nil limits leave quotes unrestricted
+func GetQuotes(c *Config, reference, inventory float64, limits *order.MinMaxLevel) ([]Quote, error) {
+	if c == nil {
+		return nil, fmt.Errorf("%w market maker config", common.ErrNilPointer)
+	}
+	if reference <= 0 || math.IsNaN(reference) || math.IsInf(reference, 0) {
+		return nil, fmt.Errorf("%w: %v", errInvalidReference, reference)
+	}
+	deviation := (inventory - c.InventoryTarget) / c.MaxInventory
+	deviation = math.Max(-1, math.Min(1, deviation))
+	centre := reference * (1 - deviation*c.InventorySkew)
+
+	bidCapacity := c.InventoryTarget + c.MaxInventory - inventory
+	// inventory cannot be sold short, so asks are also limited to the holding
+	askCapacity := math.Min(inventory, inventory-(c.InventoryTarget-c.MaxInventory))
+	quotes := make([]Quote, 0, c.Levels*2)
+	quotes = appendSide(quotes, c, order.Buy, centre, bidCapacity, limits)
+	quotes = appendSide(quotes, c, order.Sell, centre, askCapacity, limits)
+	return quotes, nil
+}
+
+// appendSide adds the quotes of one side of the ladder until the capacity is
+// used. Levels which round to the price of a closer level are skipped
+func appendSide(quotes []Quote, c *Config, side order.Side, centre, capacity float64, limits *order.MinMaxLevel) []Quote {
+	var lastPrice float64
+	amount := c.OrderAmount
+	for level := range c.Levels {
+		if level > 0 && c.AmountMultiplier > 0 {
+			amount *= c.AmountMultiplier
+		}
+		if capacity <= 0 {
+			break
+		}
+		offset := c.Spread + c.LevelSpacing*float64(level)
+		var price float64
+		if side == order.Buy {
+			price = conformPrice(centre*(1-offset), limits, false)
+		} else {
+			price = conformPrice(centre*(1+offset), limits, true)
+		}
+		if price <= 0 || price == lastPrice || !withinPriceLimits(price, limits) {
+			continue
+		}
+		size := math.Min(amount, capacity)
+		if limits != nil && limits.MaximumBaseAmount > 0 {
+			size = math.Min(size, limits.MaximumBaseAmount)
+		}
+		size = limits.ConformToAmount(size)
+		if size <= 0 || !withinAmountLimits(price, size, limits) {
+			continue
+		}
+		lastPrice = price
+		capacity -= size
+		quotes = append(quotes, Quote{Side: side, Level: level, Price: price, Amount: size})
+	}
+	return quotes
+}
+
+// NeedsRequote returns whether a resting quote has drifted from its target
+// beyond the tolerance or is for a different amount
+func NeedsRequote(resting, target *Quote, tolerance float64) bool {
+	if resting.Side != target.Side || resting.Amount != target.Amount {
+		return true
+	}
+	return math.Abs(resting.Price-target.Price) > target.Price*tolerance
+}
+
+// GetPNL returns the profit or loss of trading since a starting inventory,
+// valuing the change in base holdings at the mark price. Moves in the value
+// of the starting base holding are not included
+func GetPNL(start, current Inventory, mark float64) float64 {
+	return (current.Base-start.Base)*mark + current.Quote - start.Quote
+}
+
+// conformPrice rounds a price to the exchange's price step, up for asks and
+// down for bids so quotes never move closer to the reference price
+func conformPrice(price float64, limits *order.MinMaxLevel, roundUp bool) float64 {
+	if limits == nil || limits.PriceStepIncrementSize <= 0 {
+		return price
+	}
+	step := decimal.NewFromFloat(limits.PriceStepIncrementSize)
+	minPrice := decimal.NewFromFloat(limits.MinPrice)
+	// rounding removes floating point error before moving to a whole step
+	steps := decimal.NewFromFloat(price).Sub(minPrice).Div(step).Round(8)
+	if roundUp {
+		steps = steps.Ceil()
+	} else {
+		steps = steps.Floor()
+	}
+	return steps.Mul(step).Add(minPrice).InexactFloat64()
+}
+
+func withinPriceLimits(price float64, limits *order.MinMaxLevel) bool {
+	if limits == nil {
+		return true
+	}
+	return (limits.MinPrice <= 0 || price >= limits.MinPrice) &&
+		(limits.MaxPrice <= 0 || price <= limits.MaxPrice)
+}
+
+func withinAmountLimits(price, amount float64, limits *order.MinMaxLevel) bool {
+	if limits == nil {
+		return true
+	}
+	return (limits.MinimumBaseAmount <= 0 || amount >= limits.MinimumBaseAmount) &&
+		(limits.MinNotional <= 0 || amount*price >= limits.MinNotional)
+}
@@ -0,0 +1,181 @@
+package marketmaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func testConfig() *Config {
+	return &Config{
+		Levels:           2,
+		Spread:           0.01,
+		LevelSpacing:     0.01,
+		OrderAmount:      1,
+		AmountMultiplier: 2,
+		InventoryTarget:  5,
+		MaxInventory:     4,
+		InventorySkew:    0.1,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	var c *Config
+	assert.ErrorIs(t, c.Validate(), common.ErrNilPointer, "Validate should error for a nil config")
+	c = testConfig()
+	require.NoError(t, c.Validate(), "Validate must not error")
+	assert.Equal(t, MidPrice, c.PriceSource, "Validate should default the price source")
+
+	for name, tc := range map[string]struct {
+		mutate func(*Config)
+		err    error
+	}{
+		"price source":      {func(c *Config) { c.PriceSource = "last" }, errInvalidPriceSource},
+		"levels":            {func(c *Config) { c.Levels = 0 }, errInvalidLevels},
+		"spread":            {func(c *Config) { c.Spread = 1 }, errInvalidSpread},
+		"level spacing":     {func(c *Config) { c.LevelSpacing = -1 }, errInvalidLevelSpacing},
+		"order amount":      {func(c *Config) { c.OrderAmount = 0 }, errInvalidOrderAmount},
+		"amount multiplier": {func(c *Config) { c.AmountMultiplier = -1 }, errInvalidMultiplier},
+		"max inventory":     {func(c *Config) { c.MaxInventory = 0 }, errInvalidMaxInventory},
+		"inventory skew":    {func(c *Config) { c.InventorySkew = 1 }, errInvalidSkew},
+		"max loss":          {func(c *Config) { c.MaxLoss = -1 }, errInvalidMaxLoss},
+		"requote tolerance": {func(c *Config) { c.RequoteTolerance = -1 }, errInvalidTolerance},
+	} {
+		c := testConfig()
+		tc.mutate(c)
+		assert.ErrorIsf(t, c.Validate(), tc.err, "Validate should error for an invalid %s", name)
+	}
+}
+
+func TestCheckLoss(t *testing.T) {
+	t.Parallel()
+	c := testConfig()
+	assert.NoError(t, c.CheckLoss(-1000), "CheckLoss should not error without a max loss")
+	c.MaxLoss = 10
+	assert.NoError(t, c.CheckLoss(-9), "CheckLoss should not error within the max loss")
+	assert.ErrorIs(t, c.CheckLoss(-10), ErrLossLimitReached, "CheckLoss should error at the max loss")
+}
+
+func TestGetReferencePrice(t *testing.T) {
+	t.Parallel()
+	bid, ask := orderbook.Tranche{Price: 99, Amount: 3}, orderbook.Tranche{Price: 101, Amount: 1}
+	p, err := GetReferencePrice(bid, ask, MidPrice)
+	require.NoError(t, err, "GetReferencePrice must not error")
+	assert.Equal(t, 100.0, p, "GetReferencePrice should return the mid price")
+	p, err = GetReferencePrice(bid, ask, MicroPrice)
+	require.NoError(t, err, "GetReferencePrice must not error")
+	assert.Equal(t, 100.5, p, "GetReferencePrice should weight the micro price towards the thinner side")
+	p, err = GetReferencePrice(orderbook.Tranche{Price: 99}, orderbook.Tranche{Price: 101}, MicroPrice)
+	require.NoError(t, err, "GetReferencePrice must not error")
+	assert.Equal(t, 100.0, p, "GetReferencePrice should fall back to the mid price without amounts")
+
+	_, err = GetReferencePrice(orderbook.Tranche{}, ask, MidPrice)
+	assert.ErrorIs(t, err, errNoReferencePrice, "GetReferencePrice should error without a bid")
+	_, err = GetReferencePrice(bid, ask, "last")
+	assert.ErrorIs(t, err, errInvalidPriceSource, "GetReferencePrice should error for an invalid price source")
+}
+
+func TestGetDepthReferencePrice(t *testing.T) {
+	t.Parallel()
+	_, err := GetDepthReferencePrice(nil, MidPrice)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "GetDepthReferencePrice should error for a nil depth")
+
+	d := orderbook.NewDepth(uuid.Must(uuid.NewV4()))
+	d.AssignOptions(&orderbook.Base{Exchange: "test", Pair: currency.NewBTCUSD(), Asset: asset.Spot})
+	_, err = GetDepthReferencePrice(d, MidPrice)
+	assert.ErrorIs(t, err, errNoReferencePrice, "GetDepthReferencePrice should error for an empty book")
+	require.NoError(t, d.LoadSnapshot(orderbook.Tranches{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}}, orderbook.Tranches{{Price: 103, Amount: 1}}, 0, time.Now(), time.Now(), false), "LoadSnapshot must not error")
+	p, err := GetDepthReferencePrice(d, MidPrice)
+	require.NoError(t, err, "GetDepthReferencePrice must not error")
+	assert.Equal(t, 101.0, p, "GetDepthReferencePrice should use the best bid and ask")
+}
+
+func TestGetQuotes(t *testing.T) {
+	t.Parallel()
+	_, err := GetQuotes(nil, 100, 0, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer, "GetQuotes should error for a nil config")
+	c := testConfig()
+	_, err = GetQuotes(c, 0, 5, nil)
+	assert.ErrorIs(t, err, errInvalidReference, "GetQuotes should error without a reference price")
+
+	limits := &order.MinMaxLevel{PriceStepIncrementSize: 0.5, AmountStepIncrementSize: 0.1}
+	quotes, err := GetQuotes(c, 100, 5, limits)
+	require.NoError(t, err, "GetQuotes must not error")
+	assert.Equal(t, []Quote{
+		{Side: order.Buy, Level: 0, Price: 99, Amount: 1},
+		{Side: order.Buy, Level: 1, Price: 98, Amount: 2},
+		{Side: order.Sell, Level: 0, Price: 101, Amount: 1},
+		{Side: order.Sell, Level: 1, Price: 102, Amount: 2},
+	}, quotes, "GetQuotes should ladder both sides around the reference price at the target inventory")
+
+	quotes, err = GetQuotes(c, 100, 7, limits)
+	require.NoError(t, err, "GetQuotes must not error")
+	assert.Equal(t, []Quote{
+		{Side: order.Buy, Level: 0, Price: 94, Amount: 1},
+		{Side: order.Buy, Level: 1, Price: 93, Amount: 1},
+		{Side: order.Sell, Level: 0, Price: 96, Amount: 1},
+		{Side: order.Sell, Level: 1, Price: 97, Amount: 2},
+	}, quotes, "GetQuotes should skew quotes down and limit bids when long of the target")
+
+	quotes, err = GetQuotes(c, 100, 10, limits)
+	require.NoError(t, err, "GetQuotes must not error")
+	for i := range quotes {
+		assert.Equal(t, order.Sell, quotes[i].Side, "GetQuotes should only quote asks at the max inventory")
+	}
+
+	short := testConfig()
+	short.InventoryTarget = 1
+	quotes, err = GetQuotes(short, 100, 1.5, limits)
+	require.NoError(t, err, "GetQuotes must not error")
+	var asks float64
+	for i := range quotes {
+		if quotes[i].Side == order.Sell {
+			asks += quotes[i].Amount
+		}
+	}
+	assert.Equal(t, 1.5, asks, "GetQuotes should not quote asks for more than the inventory held")
+
+	quotes, err = GetQuotes(c, 100, 5, &order.MinMaxLevel{MinimumBaseAmount: 1.5, PriceStepIncrementSize: 0.1})
+	require.NoError(t, err, "GetQuotes must not error")
+	assert.Equal(t, []Quote{
+		{Side: order.Buy, Level: 1, Price: 98, Amount: 2},
+		{Side: order.Sell, Level: 1, Price: 102, Amount: 2},
+	}, quotes, "GetQuotes should drop levels below the minimum amount")
+
+	quotes, err = GetQuotes(c, 100, 5, &order.MinMaxLevel{MaxPrice: 100.5, MinNotional: 150, PriceStepIncrementSize: 0.1})
+	require.NoError(t, err, "GetQuotes must not error")
+	assert.Equal(t, []Quote{{Side: order.Buy, Level: 1, Price: 98, Amount: 2}}, quotes, "GetQuotes should drop quotes outside the price and notional limits")
+
+	c.LevelSpacing = 0.001
+	quotes, err = GetQuotes(c, 100.5, 5, &order.MinMaxLevel{PriceStepIncrementSize: 1})
+	require.NoError(t, err, "GetQuotes must not error")
+	assert.Equal(t, []Quote{
+		{Side: order.Buy, Level: 0, Price: 99, Amount: 1},
+		{Side: order.Sell, Level: 0, Price: 102, Amount: 1},
+	}, quotes, "GetQuotes should drop levels rounding to the price of a closer level")
+}
+
+func TestNeedsRequote(t *testing.T) {
+	t.Parallel()
+	target := &Quote{Side: order.Buy, Price: 100, Amount: 1}
+	assert.False(t, NeedsRequote(&Quote{Side: order.Buy, Price: 100.05, Amount: 1}, target, 0.001), "NeedsRequote should allow drift within the tolerance")
+	assert.True(t, NeedsRequote(&Quote{Side: order.Buy, Price: 100.2, Amount: 1}, target, 0.001), "NeedsRequote should requote drift beyond the tolerance")
+	assert.True(t, NeedsRequote(&Quote{Side: order.Buy, Price: 100, Amount: 2}, target, 0.001), "NeedsRequote should requote a different amount")
+}
+
+func TestGetPNL(t *testing.T) {
+	t.Parallel()
+	start := Inventory{Base: 5, Quote: 1000}
+	assert.Equal(t, 0.0, GetPNL(start, start, 200), "GetPNL should exclude moves in the starting holding")
+	assert.Equal(t, 2.0, GetPNL(start, Inventory{Base: 6, Quote: 901}, 101), "GetPNL should value traded inventory at the mark price")
+	assert.Equal(t, -10.0, GetPNL(start, Inventory{Base: 4, Quote: 1080}, 90), "GetPNL should return losses as negative")
+}
@@ -0,0 +1,89 @@
+package marketmaker
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// PriceSource defines the orderbook price quotes are placed around
+type PriceSource string
+
+// Price sources
+const (
+	// MidPrice is halfway between the best bid and ask
+	MidPrice PriceSource = "mid"
+	// MicroPrice weights the best bid and ask by the amount resting on the
+	// opposite side, moving the reference towards the side more likely to
+	// be traded through
+	MicroPrice PriceSource = "micro"
+)
+
+var (
+	// ErrLossLimitReached is returned when the loss of a market maker has
+	// reached its configured maximum loss
+	ErrLossLimitReached = errors.New("market maker loss limit reached")
+
+	errInvalidPriceSource  = errors.New("invalid price source")
+	errInvalidLevels       = errors.New("levels must be greater than zero")
+	errInvalidSpread       = errors.New("spread must be greater than zero and less than one")
+	errInvalidLevelSpacing = errors.New("level spacing must not be negative")
+	errInvalidOrderAmount  = errors.New("order amount must be greater than zero")
+	errInvalidMultiplier   = errors.New("amount multiplier must not be negative")
+	errInvalidMaxInventory = errors.New("max inventory must be greater than zero")
+	errInvalidSkew         = errors.New("inventory skew must be between zero and one")
+	errInvalidMaxLoss      = errors.New("max loss must not be negative")
+	errInvalidTolerance    = errors.New("requote tolerance must not be negative")
+	errNoReferencePrice    = errors.New("orderbook has no two sided liquidity to derive a reference price")
+	errInvalidReference    = errors.New("reference price must be greater than zero")
+)
+
+// Config defines the ladder of quotes a market maker keeps on both sides of
+// the orderbook and the limits it operates within. Spread, level spacing and
+// skew are fractions of the reference price
+type Config struct {
+	PriceSource PriceSource `json:"priceSource"`
+	// Levels is the number of quotes on each side of the book
+	Levels int `json:"levels"`
+	// Spread is the distance of the first level from the reference price
+	Spread float64 `json:"spread"`
+	// LevelSpacing is the distance between each further level
+	LevelSpacing float64 `json:"levelSpacing"`
+	// OrderAmount is the base amount of the first level on each side
+	OrderAmount float64 `json:"orderAmount"`
+	// AmountMultiplier scales the amount of each further level. Zero keeps
+	// every level the same size
+	AmountMultiplier float64 `json:"amountMultiplier"`
+	// InventoryTarget is the base holding quotes are skewed towards
+	InventoryTarget float64 `json:"inventoryTarget"`
+	// MaxInventory is the furthest the base holding can move from the
+	// target. A side stops quoting once it is reached
+	MaxInventory float64 `json:"maxInventory"`
+	// InventorySkew is how far quotes are shifted away from the reference
+	// price once the holding is at its maximum distance from the target
+	InventorySkew float64 `json:"inventorySkew"`
+	// MaxLoss is the quote currency loss at which quoting stops. Zero
+	// disables the limit
+	MaxLoss float64 `json:"maxLoss"`
+	// RequoteTolerance is how far a resting quote's price can drift from
+	// its target before it is replaced
+	RequoteTolerance float64 `json:"requoteTolerance"`
+	// PostOnly submits quotes as post only orders
+	PostOnly bool `json:"postOnly"`
+}
+
+// Quote is a single level of the ladder
+type Quote struct {
+	Side order.Side
+	// Level is the position of the quote on its side, starting at zero
+	// for the level closest to the reference price
+	Level  int
+	Price  float64
+	Amount float64
+}
+
+// Inventory is a base and quote currency holding
+type Inventory struct {
+	Base  float64
+	Quote float64
+}
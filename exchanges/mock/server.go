@@ -29,6 +29,9 @@ const (
 	textPlain             = "text/plain"
 )
 
+// ErrNoMockResponse is returned when a request has no recorded response
+var ErrNoMockResponse = errors.New("no recorded response for request")
+
 // VCRMock defines the main mock JSON file and attributes
 type VCRMock struct {
 	Routes map[string]map[string][]HTTPResponse `json:"routes"`
@@ -98,127 +101,152 @@ func NewVCRServer(path string) (string, *http.Client, error) {
 	return tlsServer.URL, tlsServer.Client(), nil
 }
 
+// LoadVCRMock loads an HTTP mock file
+func LoadVCRMock(path string) (*VCRMock, error) {
+	if path == "" {
+		return nil, errors.New("no path to json mock file found")
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(contents) {
+		return nil, fmt.Errorf("contents of file %s are not valid JSON", path)
+	}
+	var m VCRMock
+	if err := json.Unmarshal(contents, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// NewLenientVCRServer starts a new VCR server for replaying recorded HTTP
+// responses which responds with 404 Not Found to requests without a recorded
+// response instead of failing. A nil mock serves no responses. onMiss, if set,
+// is called for each unmatched request
+func NewLenientVCRServer(m *VCRMock, onMiss func(*http.Request, error)) (string, *http.Client) {
+	newMux := http.NewServeMux()
+	if m != nil {
+		for pattern, mockResponses := range m.Routes {
+			RegisterLenientHandler(pattern, mockResponses, newMux, onMiss)
+		}
+	}
+	if m == nil || m.Routes["/"] == nil {
+		newMux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			writeMiss(w, r, fmt.Errorf("%w: route %s not present in mock file", ErrNoMockResponse, r.URL.Path), onMiss)
+		})
+	}
+	tlsServer := httptest.NewTLSServer(newMux)
+
+	return tlsServer.URL, tlsServer.Client()
+}
+
 // RegisterHandler registers a generalised mock response logic for specific
 // routes
 func RegisterHandler(pattern string, mock map[string][]HTTPResponse, mux *http.ServeMux) {
 	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
-		httpResponses, ok := mock[r.Method]
-		if !ok {
-			log.Fatalf("Mock Test Failure - Method %s not present in mock file",
-				r.Method)
+		payload, err := MatchRequest(r, mock)
+		if err != nil {
+			log.Fatal("Mock Test Failure - ", err)
 		}
+		MessageWriteJSON(w, http.StatusOK, payload)
+	})
+}
 
-		switch r.Method {
-		case http.MethodGet:
-			vals, err := url.ParseRequestURI(r.RequestURI)
-			if err != nil {
-				log.Fatal("Mock Test Failure - Parse request URI error", err)
-			}
-
-			payload, err := MatchAndGetResponse(httpResponses, vals.Query(), true)
-			if err != nil {
-				log.Fatalf("Mock Test Failure - MatchAndGetResponse error %s for %s",
-					err, r.RequestURI)
-			}
-
-			MessageWriteJSON(w, http.StatusOK, payload)
+// RegisterLenientHandler registers mock response logic for specific routes
+// which responds with 404 Not Found to requests without a recorded response
+// instead of failing. onMiss, if set, is called for each unmatched request
+func RegisterLenientHandler(pattern string, mock map[string][]HTTPResponse, mux *http.ServeMux, onMiss func(*http.Request, error)) {
+	mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		payload, err := MatchRequest(r, mock)
+		if err != nil {
+			writeMiss(w, r, err, onMiss)
 			return
+		}
+		MessageWriteJSON(w, http.StatusOK, payload)
+	})
+}
 
-		case http.MethodPost, http.MethodPut:
-			switch r.Header.Get(contentType) {
-			case applicationURLEncoded:
-				readBody, err := io.ReadAll(r.Body)
-				if err != nil {
-					log.Fatal("Mock Test Failure - ReadAll error", err)
-				}
-
-				vals, err := url.ParseQuery(string(readBody))
-				if err != nil {
-					log.Fatal("Mock Test Failure - parse query error", err)
-				}
-
-				payload, err := MatchAndGetResponse(httpResponses, vals, false)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
-
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
-
-			case "":
-				payload, err := MatchAndGetResponse(httpResponses, r.URL.Query(), true)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
-
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
-
-			case applicationJSON:
-				readBody, err := io.ReadAll(r.Body)
-				if err != nil {
-					log.Fatalf("Mock Test Failure - %v", err)
-				}
+func writeMiss(w http.ResponseWriter, r *http.Request, err error, onMiss func(*http.Request, error)) {
+	if onMiss != nil {
+		onMiss(r, err)
+	}
+	http.Error(w, err.Error(), http.StatusNotFound)
+}
 
-				reqVals, err := DeriveURLValsFromJSONMap(readBody)
-				if err != nil {
-					log.Fatalf("Mock Test Failure - %v", err)
-				}
+// MatchRequest returns the recorded response for a request
+func MatchRequest(r *http.Request, mock map[string][]HTTPResponse) (json.RawMessage, error) {
+	httpResponses, ok := mock[r.Method]
+	if !ok {
+		return nil, fmt.Errorf("%w: method %s not present in mock file", ErrNoMockResponse, r.Method)
+	}
 
-				payload, err := MatchAndGetResponse(httpResponses, reqVals, false)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
+	switch r.Method {
+	case http.MethodGet:
+		vals, err := url.ParseRequestURI(r.RequestURI)
+		if err != nil {
+			return nil, fmt.Errorf("parse request URI error: %w", err)
+		}
+		return MatchAndGetResponse(httpResponses, vals.Query(), true)
 
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
+	case http.MethodPost, http.MethodPut:
+		switch r.Header.Get(contentType) {
+		case applicationURLEncoded:
+			readBody, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
 
-			case textPlain:
-				headerData, ok := r.Header["X-Gemini-Payload"]
-				if !ok {
-					log.Fatal("Mock Test Failure - Cannot find header in request")
-				}
+			vals, err := url.ParseQuery(string(readBody))
+			if err != nil {
+				return nil, fmt.Errorf("parse query error: %w", err)
+			}
+			return MatchAndGetResponse(httpResponses, vals, false)
 
-				base64data := strings.Join(headerData, "")
+		case "":
+			return MatchAndGetResponse(httpResponses, r.URL.Query(), true)
 
-				jsonThings, err := crypto.Base64Decode(base64data)
-				if err != nil {
-					log.Fatal("Mock Test Failure - ", err)
-				}
+		case applicationJSON:
+			readBody, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, err
+			}
 
-				reqVals, err := DeriveURLValsFromJSONMap(jsonThings)
-				if err != nil {
-					log.Fatalf("Mock Test Failure - %v", err)
-				}
+			reqVals, err := DeriveURLValsFromJSONMap(readBody)
+			if err != nil {
+				return nil, err
+			}
+			return MatchAndGetResponse(httpResponses, reqVals, false)
 
-				payload, err := MatchAndGetResponse(httpResponses, reqVals, false)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
+		case textPlain:
+			headerData, ok := r.Header["X-Gemini-Payload"]
+			if !ok {
+				return nil, errors.New("cannot find header in request")
+			}
 
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
+			base64data := strings.Join(headerData, "")
 
-			default:
-				log.Fatalf("Mock Test Failure - Unhandled content type %v",
-					r.Header.Get(contentType))
+			jsonThings, err := crypto.Base64Decode(base64data)
+			if err != nil {
+				return nil, err
 			}
 
-		case http.MethodDelete:
-			payload, err := MatchAndGetResponse(httpResponses, r.URL.Query(), true)
+			reqVals, err := DeriveURLValsFromJSONMap(jsonThings)
 			if err != nil {
-				log.Println(r.URL.Query())
-				log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
+				return nil, err
 			}
-
-			MessageWriteJSON(w, http.StatusOK, payload)
-			return
+			return MatchAndGetResponse(httpResponses, reqVals, false)
 
 		default:
-			log.Fatal("Mock Test Failure - Unhandled HTTP method:",
-				r.Header.Get(contentType))
+			return nil, fmt.Errorf("unhandled content type %v", r.Header.Get(contentType))
 		}
-	})
+
+	case http.MethodDelete:
+		return MatchAndGetResponse(httpResponses, r.URL.Query(), true)
+
+	default:
+		return nil, fmt.Errorf("unhandled HTTP method: %v", r.Method)
+	}
 }
 
 // MessageWriteJSON writes JSON to a connection
@@ -284,5 +312,5 @@ func MatchAndGetResponse(mockData []HTTPResponse, requestVals url.Values, isQuer
 			return mockData[i].Data, nil
 		}
 	}
-	return nil, errors.New("no data could be matched")
+	return nil, fmt.Errorf("%w: no data could be matched", ErrNoMockResponse)
 }
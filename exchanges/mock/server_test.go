@@ -2,11 +2,16 @@ package mock
 
 import (
 	"bytes"
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/encoding/json"
 )
@@ -124,3 +129,61 @@ func TestNewVCRServer(t *testing.T) {
 		t.Fatal("Remove error", err)
 	}
 }
+
+func TestLoadVCRMock(t *testing.T) {
+	t.Parallel()
+	_, err := LoadVCRMock("")
+	assert.ErrorContains(t, err, "no path to json mock file found", "LoadVCRMock should error without a path")
+	_, err = LoadVCRMock(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist, "LoadVCRMock should error for a missing file")
+
+	path := filepath.Join(t.TempDir(), "http.json")
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600), "WriteFile must not error")
+	_, err = LoadVCRMock(path)
+	assert.ErrorContains(t, err, "not valid JSON", "LoadVCRMock should error for invalid JSON")
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"routes":{"/test":{"GET":[{"data":{"price":1},"queryString":"","bodyParams":""}]}}}`), 0o600), "WriteFile must not error")
+	m, err := LoadVCRMock(path)
+	require.NoError(t, err, "LoadVCRMock must not error")
+	require.Len(t, m.Routes["/test"][http.MethodGet], 1, "LoadVCRMock must load routes")
+}
+
+func TestNewLenientVCRServer(t *testing.T) {
+	t.Parallel()
+	var misses []string
+	var mu sync.Mutex
+	serverURL, client := NewLenientVCRServer(&VCRMock{Routes: map[string]map[string][]HTTPResponse{
+		"/test": {http.MethodGet: {{Data: json.RawMessage(`{"price":1}`), QueryString: queryString}}},
+	}}, func(r *http.Request, err error) {
+		assert.ErrorIs(t, err, ErrNoMockResponse, "Misses should wrap ErrNoMockResponse")
+		mu.Lock()
+		misses = append(misses, r.URL.Path)
+		mu.Unlock()
+	})
+
+	get := func(path string) (int, string) {
+		t.Helper()
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, serverURL+path, http.NoBody)
+		require.NoError(t, err, "NewRequestWithContext must not error")
+		resp, err := client.Do(req)
+		require.NoError(t, err, "Do must not error")
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		require.NoError(t, err, "ReadAll must not error")
+		return resp.StatusCode, string(body)
+	}
+
+	status, body := get("/test?" + queryString)
+	assert.Equal(t, http.StatusOK, status, "Recorded requests should succeed")
+	assert.JSONEq(t, `{"price":1}`, body, "Recorded requests should return the recorded response")
+
+	status, _ = get("/test?currency=eth")
+	assert.Equal(t, http.StatusNotFound, status, "Unmatched requests should return not found")
+	status, _ = get("/missing")
+	assert.Equal(t, http.StatusNotFound, status, "Unknown routes should return not found")
+	assert.Equal(t, []string{"/test", "/missing"}, misses, "onMiss should be called for each unmatched request")
+
+	serverURL, client = NewLenientVCRServer(nil, nil)
+	status, _ = get("/test")
+	assert.Equal(t, http.StatusNotFound, status, "A nil mock should serve no responses")
+}
@@ -0,0 +1,441 @@
+package mock
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/encoding/json"
+)
+
+// Websocket frame directions
+const (
+	WebsocketInbound  = "inbound"
+	WebsocketOutbound = "outbound"
+)
+
+var errWebsocketMockPathUnset = errors.New("no path to websocket mock file found")
+
+// websocketCredentialKeys are redacted from recorded outbound frames and
+// ignored when matching them
+var websocketCredentialKeys = []string{
+	"apiKey",
+	"api_key",
+	"key",
+	"sign",
+	"signature",
+	"passphrase",
+	"secret",
+	"token",
+	"password",
+}
+
+// websocketIDKeys are ignored when matching outbound frames as they change
+// between runs. Recorded request IDs are replaced in replayed responses with
+// the IDs sent by the client
+var websocketIDKeys = []string{
+	"id",
+	"reqid",
+	"req_id",
+	"requestid",
+	"request_id",
+	"cid",
+}
+
+// websocketVolatileKeys are ignored when matching outbound frames
+var websocketVolatileKeys = []string{
+	"nonce",
+	"timestamp",
+	"ts",
+	"time",
+	"expires",
+}
+
+// WebsocketVCRMock defines the websocket mock JSON file
+type WebsocketVCRMock struct {
+	Sessions []WebsocketSession `json:"sessions"`
+}
+
+// WebsocketSession holds the frames sent and received over a single
+// websocket connection
+type WebsocketSession struct {
+	URL    string           `json:"url"`
+	Frames []WebsocketFrame `json:"frames"`
+}
+
+// WebsocketFrame defines a single recorded websocket message
+type WebsocketFrame struct {
+	Direction string    `json:"direction"`
+	Timestamp time.Time `json:"timestamp"`
+	Payload   string    `json:"payload"`
+}
+
+// WebsocketRecorder records websocket sessions to a mock file for replay by
+// the websocket VCR server
+type WebsocketRecorder struct {
+	path string
+	mock WebsocketVCRMock
+	m    sync.Mutex
+}
+
+// NewWebsocketRecorder returns a recorder which saves sessions to path
+func NewWebsocketRecorder(path string) *WebsocketRecorder {
+	return &WebsocketRecorder{path: path}
+}
+
+// RecordSession starts recording a new session, returning its ID
+func (r *WebsocketRecorder) RecordSession(u string) int {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if i := strings.IndexByte(u, '?'); i != -1 {
+		u = u[:i] // query strings may contain credentials
+	}
+	r.mock.Sessions = append(r.mock.Sessions, WebsocketSession{URL: u})
+	return len(r.mock.Sessions) - 1
+}
+
+// RecordInbound records a message received from the server
+func (r *WebsocketRecorder) RecordInbound(session int, payload []byte) {
+	r.record(session, WebsocketInbound, payload)
+}
+
+// RecordOutbound records a message sent to the server with any credentials
+// redacted
+func (r *WebsocketRecorder) RecordOutbound(session int, payload []byte) {
+	r.record(session, WebsocketOutbound, redactWebsocketPayload(payload))
+}
+
+func (r *WebsocketRecorder) record(session int, direction string, payload []byte) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if session < 0 || session >= len(r.mock.Sessions) {
+		return
+	}
+	r.mock.Sessions[session].Frames = append(r.mock.Sessions[session].Frames, WebsocketFrame{
+		Direction: direction,
+		Timestamp: time.Now().UTC(),
+		Payload:   string(payload),
+	})
+}
+
+// Sessions returns a copy of the sessions recorded so far
+func (r *WebsocketRecorder) Sessions() []WebsocketSession {
+	r.m.Lock()
+	defer r.m.Unlock()
+	sessions := make([]WebsocketSession, len(r.mock.Sessions))
+	for i := range r.mock.Sessions {
+		sessions[i] = WebsocketSession{
+			URL:    r.mock.Sessions[i].URL,
+			Frames: append([]WebsocketFrame(nil), r.mock.Sessions[i].Frames...),
+		}
+	}
+	return sessions
+}
+
+// Save writes the recorded sessions to the mock file, replacing its contents
+func (r *WebsocketRecorder) Save() error {
+	if r.path == "" {
+		return errWebsocketMockPathUnset
+	}
+	r.m.Lock()
+	data, err := json.MarshalIndent(r.mock, "", " ")
+	r.m.Unlock()
+	if err != nil {
+		return err
+	}
+	if err := common.CreateDir(filepath.Dir(r.path)); err != nil {
+		return err
+	}
+	return file.Write(r.path, data)
+}
+
+// redactWebsocketPayload blanks credential values in JSON payloads. Payloads
+// are only rewritten when something is redacted
+func redactWebsocketPayload(payload []byte) []byte {
+	var v any
+	if err := json.Unmarshal(payload, &v); err != nil {
+		return payload
+	}
+	if !redactWebsocketValue(v) {
+		return payload
+	}
+	redacted, err := json.Marshal(v)
+	if err != nil {
+		return payload
+	}
+	return redacted
+}
+
+func redactWebsocketValue(v any) bool {
+	var redacted bool
+	switch val := v.(type) {
+	case map[string]any:
+		for k, nested := range val {
+			if _, ok := nested.(string); ok && IsExcluded(k, websocketCredentialKeys) {
+				val[k] = ""
+				redacted = true
+				continue
+			}
+			redacted = redactWebsocketValue(nested) || redacted
+		}
+	case []any:
+		for i := range val {
+			redacted = redactWebsocketValue(val[i]) || redacted
+		}
+	}
+	return redacted
+}
+
+// LoadWebsocketMock loads a websocket mock file
+func LoadWebsocketMock(path string) (*WebsocketVCRMock, error) {
+	if path == "" {
+		return nil, errWebsocketMockPathUnset
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(contents) {
+		return nil, fmt.Errorf("contents of file %s are not valid JSON", path)
+	}
+	var m WebsocketVCRMock
+	if err := json.Unmarshal(contents, &m); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+// NewWebsocketVCRServer starts a new server replaying recorded websocket
+// sessions and returns its websocket URL
+func NewWebsocketVCRServer(path string) (string, error) {
+	h, err := NewWebsocketVCRHandler(path)
+	if err != nil {
+		return "", err
+	}
+	s := httptest.NewServer(h)
+	return "ws" + strings.TrimPrefix(s.URL, "http"), nil
+}
+
+// NewWebsocketVCRHandler returns a handler replaying recorded websocket
+// sessions. Each connection replays the next unused session recorded against
+// the same URL path, falling back to the next unused session.
+// Inbound frames are sent in order until a recorded outbound frame is
+// reached, at which point the handler waits for a client message matching it.
+// Messages are matched ignoring request IDs, nonces, timestamps and
+// credentials, and recorded request IDs in later inbound frames are replaced
+// by those sent by the client. Unmatched client messages are ignored
+func NewWebsocketVCRHandler(path string) (http.HandlerFunc, error) {
+	m, err := LoadWebsocketMock(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &websocketReplay{
+		sessions: m.Sessions,
+		claimed:  make([]bool, len(m.Sessions)),
+	}
+	return r.serve, nil
+}
+
+var websocketUpgrader = gws.Upgrader{CheckOrigin: func(*http.Request) bool { return true }}
+
+type websocketReplay struct {
+	sessions []WebsocketSession
+	claimed  []bool
+	m        sync.Mutex
+}
+
+// claim returns the frames of the next unused session for a URL path
+func (w *websocketReplay) claim(path string) []WebsocketFrame {
+	w.m.Lock()
+	defer w.m.Unlock()
+	fallback := -1
+	for i := range w.sessions {
+		if w.claimed[i] {
+			continue
+		}
+		if u, err := url.Parse(w.sessions[i].URL); err == nil && u.Path == path {
+			w.claimed[i] = true
+			return w.sessions[i].Frames
+		}
+		if fallback == -1 {
+			fallback = i
+		}
+	}
+	if fallback == -1 {
+		return nil
+	}
+	w.claimed[fallback] = true
+	return w.sessions[fallback].Frames
+}
+
+func (w *websocketReplay) serve(rw http.ResponseWriter, r *http.Request) {
+	c, err := websocketUpgrader.Upgrade(rw, r, nil)
+	if err != nil {
+		log.Println("Mock Test Failure - websocket upgrade error", err)
+		return
+	}
+	defer c.Close()
+
+	frames := w.claim(r.URL.Path)
+	var ids []idReplacement
+	for next := 0; next < len(frames); {
+		if frames[next].Direction != WebsocketOutbound {
+			if !sendReplayFrame(c, frames[next].Payload, ids) {
+				return
+			}
+			next++
+			continue
+		}
+		_, msg, err := c.ReadMessage()
+		if err != nil {
+			return
+		}
+		matched := -1
+		for i := next; i < len(frames); i++ {
+			if frames[i].Direction != WebsocketOutbound {
+				continue
+			}
+			if replacements, ok := matchWebsocketFrame([]byte(frames[i].Payload), msg); ok {
+				ids = append(ids, replacements...)
+				matched = i
+				break
+			}
+		}
+		if matched == -1 {
+			continue
+		}
+		// Inbound frames recorded before the matched request are still sent
+		// so the client sees them in their recorded order
+		for i := next; i < matched; i++ {
+			if frames[i].Direction != WebsocketOutbound && !sendReplayFrame(c, frames[i].Payload, ids) {
+				return
+			}
+		}
+		next = matched + 1
+	}
+	// Keep the connection open so clients do not attempt to reconnect
+	for {
+		if _, _, err := c.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+func sendReplayFrame(c *gws.Conn, payload string, ids []idReplacement) bool {
+	for i := range ids {
+		payload = ids[i].pattern.ReplaceAllString(payload, `":${1}`+ids[i].live+`${2}`)
+	}
+	if err := c.WriteMessage(gws.TextMessage, []byte(payload)); err != nil {
+		log.Println("Mock Test Failure - websocket write error", err)
+		return false
+	}
+	return true
+}
+
+// idReplacement replaces a recorded request ID with the ID sent by the client
+type idReplacement struct {
+	pattern *regexp.Regexp
+	live    string
+}
+
+// matchWebsocketFrame compares a recorded outbound frame with a client
+// message, returning any request IDs which differ between them
+func matchWebsocketFrame(recorded, live []byte) ([]idReplacement, bool) {
+	var rec, got any
+	if json.Unmarshal(recorded, &rec) != nil || json.Unmarshal(live, &got) != nil {
+		return nil, string(recorded) == string(live)
+	}
+	if !matchWebsocketValue(rec, got) {
+		return nil, false
+	}
+	recMap, ok := rec.(map[string]any)
+	if !ok {
+		return nil, true
+	}
+	gotMap, ok := got.(map[string]any)
+	if !ok {
+		return nil, true
+	}
+	var ids []idReplacement
+	for k, recID := range recMap {
+		if !IsExcluded(k, websocketIDKeys) {
+			continue
+		}
+		gotID, ok := gotMap[k]
+		if !ok || reflect.DeepEqual(recID, gotID) {
+			continue
+		}
+		recRaw, err := json.Marshal(recID)
+		if err != nil {
+			continue
+		}
+		gotRaw, err := json.Marshal(gotID)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, idReplacement{
+			pattern: regexp.MustCompile(`":(\s*)` + regexp.QuoteMeta(string(recRaw)) + `([,}\]\s])`),
+			live:    string(gotRaw),
+		})
+	}
+	return ids, true
+}
+
+// matchWebsocketValue recursively compares decoded JSON values, ignoring keys
+// which change between runs
+func matchWebsocketValue(recorded, live any) bool {
+	switch rec := recorded.(type) {
+	case map[string]any:
+		got, ok := live.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k := range rec {
+			if isVolatileWebsocketKey(k) {
+				continue
+			}
+			if _, ok := got[k]; !ok {
+				return false
+			}
+		}
+		for k, v := range got {
+			if isVolatileWebsocketKey(k) {
+				continue
+			}
+			r, ok := rec[k]
+			if !ok || !matchWebsocketValue(r, v) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		got, ok := live.([]any)
+		if !ok || len(rec) != len(got) {
+			return false
+		}
+		for i := range rec {
+			if !matchWebsocketValue(rec[i], got[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(recorded, live)
+}
+
+func isVolatileWebsocketKey(k string) bool {
+	return IsExcluded(k, websocketIDKeys) || IsExcluded(k, websocketVolatileKeys) || IsExcluded(k, websocketCredentialKeys)
+}
@@ -0,0 +1,115 @@
+package mock
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	gws "github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsocketRecorder(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "testdata", "websocket.json")
+	r := NewWebsocketRecorder(path)
+	session := r.RecordSession("wss://test.exchange/ws?listenKey=secret")
+	assert.Zero(t, session, "RecordSession should return the first session")
+	r.RecordOutbound(session, []byte(`{"op":"auth","args":{"apiKey":"key","sign":"abc","expires":1}}`))
+	r.RecordOutbound(session, []byte(`ping`))
+	r.RecordInbound(session, []byte(`{"success":true}`))
+	r.RecordInbound(1, []byte(`{"unknown":"session"}`))
+
+	sessions := r.Sessions()
+	require.Len(t, sessions, 1, "Sessions must return one session")
+	assert.Equal(t, "wss://test.exchange/ws", sessions[0].URL, "RecordSession should strip the query string")
+	require.Len(t, sessions[0].Frames, 3, "Frames for unknown sessions must not be recorded")
+	assert.Equal(t, WebsocketOutbound, sessions[0].Frames[0].Direction, "Direction should be outbound")
+	assert.JSONEq(t, `{"op":"auth","args":{"apiKey":"","sign":"","expires":1}}`, sessions[0].Frames[0].Payload, "Credentials should be redacted")
+	assert.Equal(t, "ping", sessions[0].Frames[1].Payload, "Non-JSON payloads should be recorded as is")
+	assert.Equal(t, WebsocketInbound, sessions[0].Frames[2].Direction, "Direction should be inbound")
+	assert.Equal(t, `{"success":true}`, sessions[0].Frames[2].Payload, "Inbound payloads should be recorded as is")
+	assert.False(t, sessions[0].Frames[2].Timestamp.IsZero(), "Timestamp should be set")
+
+	require.NoError(t, r.Save(), "Save must not error")
+	m, err := LoadWebsocketMock(path)
+	require.NoError(t, err, "LoadWebsocketMock must not error")
+	assert.Equal(t, sessions, m.Sessions, "LoadWebsocketMock should return the saved sessions")
+
+	assert.ErrorIs(t, NewWebsocketRecorder("").Save(), errWebsocketMockPathUnset, "Save should error without a path")
+	_, err = LoadWebsocketMock("")
+	assert.ErrorIs(t, err, errWebsocketMockPathUnset, "LoadWebsocketMock should error without a path")
+}
+
+func TestMatchWebsocketFrame(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		recorded, live string
+		match          bool
+	}{
+		{`ping`, `ping`, true},
+		{`ping`, `pong`, false},
+		{`{"op":"subscribe","args":["trades"]}`, `{"op":"subscribe","args":["trades"]}`, true},
+		{`{"op":"subscribe","args":["trades"]}`, `{"op":"subscribe","args":["orderbook"]}`, false},
+		{`{"op":"subscribe","args":["trades"]}`, `{"op":"subscribe","args":["trades"],"extra":1}`, false},
+		{`{"op":"subscribe","args":["trades"]}`, `{"op":"subscribe"}`, false},
+		{`{"id":1,"ts":1,"params":{"nonce":2,"signature":""}}`, `{"id":2,"ts":3,"params":{"nonce":4,"signature":"abc"}}`, true},
+		{`["subscribe","trades"]`, `["subscribe","trades"]`, true},
+		{`["subscribe","trades"]`, `{"subscribe":"trades"}`, false},
+	} {
+		_, ok := matchWebsocketFrame([]byte(tc.recorded), []byte(tc.live))
+		assert.Equalf(t, tc.match, ok, "matchWebsocketFrame should return %v for %s and %s", tc.match, tc.recorded, tc.live)
+	}
+	ids, ok := matchWebsocketFrame([]byte(`{"id":1,"req_id":"abc","op":"subscribe"}`), []byte(`{"id":1,"req_id":"def","op":"subscribe"}`))
+	require.True(t, ok, "matchWebsocketFrame must match")
+	require.Len(t, ids, 1, "Only differing IDs must be replaced")
+	assert.Equal(t, `"def"`, ids[0].live, "Replacement should use the live ID")
+}
+
+func TestWebsocketVCRServer(t *testing.T) {
+	t.Parallel()
+	_, err := NewWebsocketVCRServer("")
+	assert.ErrorIs(t, err, errWebsocketMockPathUnset, "NewWebsocketVCRServer should error without a path")
+
+	path := filepath.Join(t.TempDir(), "websocket.json")
+	r := NewWebsocketRecorder(path)
+	public := r.RecordSession("wss://test.exchange/public")
+	private := r.RecordSession("wss://test.exchange/private")
+	r.RecordInbound(private, []byte(`{"event":"authRequired"}`))
+	r.RecordInbound(public, []byte(`{"event":"welcome"}`))
+	r.RecordOutbound(public, []byte(`{"op":"subscribe","id":7,"args":["orderbook"]}`))
+	r.RecordInbound(public, []byte(`{"id":7,"success":false}`))
+	r.RecordInbound(public, []byte(`{"event":"heartbeat"}`))
+	r.RecordOutbound(public, []byte(`{"op":"subscribe","id":8,"args":["trades"]}`))
+	r.RecordInbound(public, []byte(`{"id":8,"success":true}`))
+	r.RecordInbound(public, []byte(`{"channel":"trades","data":[{"id":8,"price":1337}]}`))
+	require.NoError(t, r.Save(), "Save must not error")
+
+	u, err := NewWebsocketVCRServer(path)
+	require.NoError(t, err, "NewWebsocketVCRServer must not error")
+	c, _, err := gws.DefaultDialer.Dial(u+"/public", nil)
+	require.NoError(t, err, "Dial must not error")
+	defer c.Close()
+
+	assert.Equal(t, `{"event":"welcome"}`, readReplayFrame(t, c), "Should replay inbound frames until an outbound frame")
+	require.NoError(t, c.WriteMessage(gws.TextMessage, []byte(`{"op":"unsubscribe","args":["trades"]}`)), "WriteMessage must not error")
+	require.NoError(t, c.WriteMessage(gws.TextMessage, []byte(`{"op":"subscribe","id":42,"args":["trades"]}`)), "WriteMessage must not error")
+	assert.Equal(t, `{"id":7,"success":false}`, readReplayFrame(t, c), "Should replay inbound frames after a skipped outbound frame")
+	assert.Equal(t, `{"event":"heartbeat"}`, readReplayFrame(t, c), "Should replay inbound frames before the matched outbound frame")
+	assert.Equal(t, `{"id":42,"success":true}`, readReplayFrame(t, c), "Should replace recorded request IDs")
+	assert.Equal(t, `{"channel":"trades","data":[{"id":42,"price":1337}]}`, readReplayFrame(t, c), "Should replace recorded request IDs in nested values")
+
+	c2, _, err := gws.DefaultDialer.Dial(u+"/unknown", nil)
+	require.NoError(t, err, "Dial must not error")
+	defer c2.Close()
+	assert.Equal(t, `{"event":"authRequired"}`, readReplayFrame(t, c2), "Should fall back to the next unused session")
+}
+
+func readReplayFrame(t *testing.T, c *gws.Conn) string {
+	t.Helper()
+	require.NoError(t, c.SetReadDeadline(time.Now().Add(time.Second*5)), "SetReadDeadline must not error")
+	_, msg, err := c.ReadMessage()
+	require.NoError(t, err, "ReadMessage must not error")
+	return string(msg)
+}
@@ -40,8 +40,9 @@ type Depth struct {
 
 	alert.Notice
 
-	mux *dispatch.Mux
-	_ID uuid.UUID
+	mux      *dispatch.Mux
+	_ID      uuid.UUID
+	reporter Reporter
 
 	options
 
@@ -53,7 +54,7 @@ type Depth struct {
 
 // NewDepth returns a new orderbook depth
 func NewDepth(id uuid.UUID) *Depth {
-	return &Depth{_ID: id, mux: service.Mux}
+	return &Depth{_ID: id, mux: service.Mux, reporter: globalReporter}
 }
 
 // Publish alerts any subscribed routines using a dispatch mux
@@ -126,6 +127,9 @@ func (d *Depth) invalidate(withReason error) error {
 		d.pair,
 		d.asset,
 		common.AppendError(ErrOrderbookInvalid, withReason))
+	if d.reporter != nil {
+		d.reporter.Invalidated(d.exchange, d.asset)
+	}
 	d.Alert()
 	return d.validationError
 }
@@ -174,12 +174,23 @@ func TestLoadSnapshot(t *testing.T) {
 	assert.Equal(t, 2.0, ob.Bids[0].Amount, "Top bid amount should be correct")
 }
 
+// testReporter records the orderbooks reported as invalidated
+type testReporter struct {
+	invalidated []string
+}
+
+func (r *testReporter) Invalidated(exchange string, a asset.Item) {
+	r.invalidated = append(r.invalidated, exchange+" "+a.String())
+}
+
 func TestInvalidate(t *testing.T) {
 	t.Parallel()
 	d := NewDepth(id)
 	d.exchange = "testexchange"
 	d.pair = currency.NewPair(currency.BTC, currency.WABI)
 	d.asset = asset.Spot
+	r := &testReporter{}
+	d.reporter = r
 
 	err := d.LoadSnapshot(Tranches{{Price: 1337, Amount: 1}}, Tranches{{Price: 1337, Amount: 10}}, 0, time.Now(), time.Now(), false)
 	assert.NoError(t, err, "LoadSnapshot should not error")
@@ -196,6 +207,7 @@ func TestInvalidate(t *testing.T) {
 	_, err = d.Retrieve()
 	assert.ErrorIs(t, err, ErrOrderbookInvalid, "Retrieve should error correctly")
 	assert.ErrorIs(t, err, testReason, "Invalidate should error correctly")
+	assert.Equal(t, []string{"testexchange spot"}, r.invalidated, "Invalidate should report the invalidation")
 
 	d.validationError = nil
 
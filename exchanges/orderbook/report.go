@@ -0,0 +1,17 @@
+package orderbook
+
+import "github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+
+// Reporter interface groups observability functionality over orderbook
+// invalidations, such as when a book desyncs from its exchange
+type Reporter interface {
+	Invalidated(exchange string, a asset.Item)
+}
+
+var globalReporter Reporter
+
+// SetupGlobalReporter sets a reporter interface to be used for all
+// orderbooks created after it is set
+func SetupGlobalReporter(r Reporter) {
+	globalReporter = r
+}
@@ -0,0 +1,850 @@
+package paper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// amountTolerance is the fraction of an order's amount which can remain
+// unfilled due to floating point error
+const amountTolerance = 1e-9
+
+// New returns a paper trading exchange wrapping exch which starts with the
+// supplied balances. A routine matching resting orders against the live
+// orderbook is started, which is stopped by Shutdown
+func New(exch exchange.IBotExchange, balances []config.PaperTradingBalance) (*Exchange, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("%w IBotExchange", common.ErrNilPointer)
+	}
+	e := &Exchange{
+		IBotExchange: exch,
+		interval:     defaultMatchInterval,
+		balances:     make(map[asset.Item]map[*currency.Item]*balance),
+		orders:       make(map[string]*paperOrder),
+		liquidity:    make(map[liquidityKey]map[float64]levelUsage),
+		feeRates:     make(map[feeKey]float64),
+		shutdown:     make(chan struct{}),
+	}
+	now := time.Now()
+	for i := range balances {
+		if !balances[i].Asset.IsValid() || balances[i].Currency.IsEmpty() || balances[i].Amount < 0 {
+			return nil, fmt.Errorf("%w %s %s %v", errInvalidBalance, balances[i].Asset, balances[i].Currency, balances[i].Amount)
+		}
+		if err := checkAsset(balances[i].Asset); err != nil {
+			return nil, err
+		}
+		e.getBalance(balances[i].Asset, balances[i].Currency).adjust(balances[i].Amount, 0, now)
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e, nil
+}
+
+// Shutdown stops matching resting orders and shuts down the wrapped exchange
+func (e *Exchange) Shutdown() error {
+	e.m.Lock()
+	select {
+	case <-e.shutdown:
+	default:
+		close(e.shutdown)
+	}
+	e.m.Unlock()
+	e.wg.Wait()
+	return e.IBotExchange.Shutdown()
+}
+
+// run matches resting orders on an interval until shutdown
+func (e *Exchange) run() {
+	defer e.wg.Done()
+	t := time.NewTicker(e.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-e.shutdown:
+			return
+		case <-t.C:
+			e.matchOrders(context.Background())
+		}
+	}
+}
+
+// SubmitOrder matches an order against the live orderbook. Any amount of a
+// limit order which does not match immediately rests until the orderbook
+// crosses its price, unless it is immediate or cancel
+func (e *Exchange) SubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	if err := s.Validate(e.GetTradingRequirements()); err != nil {
+		return nil, err
+	}
+	if err := checkAsset(s.AssetType); err != nil {
+		return nil, err
+	}
+	if s.Amount == 0 && (s.Type == order.Limit || s.Side.IsShort()) {
+		return nil, fmt.Errorf("%w, limit and sell orders require a base amount", order.ErrAmountIsInvalid)
+	}
+	takerRate, err := e.feeRate(ctx, s.Pair, s.AssetType, false)
+	if err != nil {
+		return nil, err
+	}
+	asks, bids, err := e.getTranches(s.Pair, s.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	levels := asks
+	if s.Side.IsShort() {
+		levels = bids
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	e.m.Lock()
+	o, fills, err := e.submit(s, id.String(), levels, takerRate, time.Now())
+	var detail order.Detail
+	if err == nil {
+		detail = o.Copy()
+	}
+	e.m.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	e.publishFills(fills)
+
+	resp, err := s.DeriveSubmitResponse(detail.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	resp.Amount = detail.Amount
+	resp.Status = detail.Status
+	resp.AverageExecutedPrice = detail.AverageExecutedPrice
+	resp.RemainingAmount = detail.RemainingAmount
+	resp.Trades = detail.Trades
+	resp.Fee = detail.Fee
+	resp.FeeAsset = detail.FeeAsset
+	resp.Cost = detail.Cost
+	resp.Date = detail.Date
+	resp.LastUpdated = detail.LastUpdated
+	return resp, nil
+}
+
+// WebsocketSubmitOrder matches an order the same as SubmitOrder
+func (e *Exchange) WebsocketSubmitOrder(ctx context.Context, s *order.Submit) (*order.SubmitResponse, error) {
+	return e.SubmitOrder(ctx, s)
+}
+
+// submit checks balances, takes liquidity for the marketable amount of an order
+// and reserves balance for any resting amount. The caller must hold the lock
+func (e *Exchange) submit(s *order.Submit, id string, levels orderbook.Tranches, takerRate float64, now time.Time) (*paperOrder, []fill.Data, error) {
+	var limit float64
+	if s.Type == order.Limit {
+		limit = s.Price
+	}
+	var quoteAmount float64
+	if s.Amount == 0 {
+		// fees are paid from the quote amount of a market buy
+		quoteAmount = s.QuoteAmount / (1 + takerRate)
+	}
+	k := newLiquidityKey(s.Pair, s.AssetType, s.Side)
+	available := e.available(k, levels)
+	m := take(available, s.Side, limit, s.Amount, quoteAmount)
+	switch {
+	case s.PostOnly && m.amount > 0:
+		return nil, nil, errPostOnlyCrossed
+	case s.FillOrKill && s.Amount-m.amount > s.Amount*amountTolerance:
+		return nil, nil, errFillOrKill
+	case s.Type == order.Market && m.amount == 0:
+		return nil, nil, fmt.Errorf("%w %s %s", errNoLiquidity, s.Pair, s.AssetType)
+	}
+
+	amount := s.Amount
+	if amount == 0 {
+		amount = m.amount
+	}
+	rests := s.Type == order.Limit && !s.ImmediateOrCancel && !s.FillOrKill
+	var reserve float64
+	if rests {
+		reserve = amount - m.amount
+		if s.Side.IsLong() {
+			reserve *= s.Price * (1 + takerRate)
+		}
+	}
+
+	base := e.getBalance(s.AssetType, s.Pair.Base)
+	quote := e.getBalance(s.AssetType, s.Pair.Quote)
+	fee := m.cost * takerRate
+	if s.Side.IsLong() {
+		if required := m.cost + fee + reserve; required > quote.free() {
+			return nil, nil, fmt.Errorf("%w: %v %s available, %v required", ErrInsufficientBalance, quote.free(), s.Pair.Quote, required)
+		}
+		quote.adjust(-m.cost-fee, reserve, now)
+		base.adjust(m.amount, 0, now)
+	} else {
+		if s.Amount > base.free() {
+			return nil, nil, fmt.Errorf("%w: %v %s available, %v required", ErrInsufficientBalance, base.free(), s.Pair.Base, s.Amount)
+		}
+		base.adjust(-m.amount, reserve, now)
+		quote.adjust(m.cost-fee, 0, now)
+	}
+	e.consume(k, levels, available)
+
+	o := &paperOrder{
+		Detail: order.Detail{
+			ImmediateOrCancel: s.ImmediateOrCancel,
+			FillOrKill:        s.FillOrKill,
+			PostOnly:          s.PostOnly,
+			Price:             s.Price,
+			Amount:            amount,
+			QuoteAmount:       s.QuoteAmount,
+			CostAsset:         s.Pair.Quote,
+			FeeAsset:          s.Pair.Quote,
+			Exchange:          e.GetName(),
+			OrderID:           id,
+			ClientOrderID:     s.ClientOrderID,
+			ClientID:          s.ClientID,
+			AccountID:         AccountID,
+			Type:              s.Type,
+			Side:              s.Side,
+			Status:            order.New,
+			AssetType:         s.AssetType,
+			Date:              now,
+			LastUpdated:       now,
+			Pair:              s.Pair,
+		},
+		reserved: reserve,
+	}
+	var fills []fill.Data
+	if m.amount > 0 {
+		fills = append(fills, o.trade(m.amount, m.cost, fee, false, now))
+	}
+	switch {
+	case o.isFilled():
+		o.close(order.Filled, now)
+	case !rests && o.ExecutedAmount == 0:
+		o.close(order.Cancelled, now)
+	case !rests:
+		o.close(order.PartiallyFilledCancelled, now)
+	case o.ExecutedAmount > 0:
+		o.Status = order.PartiallyFilled
+	}
+	e.orders[id] = o
+	return o, fills, nil
+}
+
+// matchOrders fills resting orders at their limit price against orderbook
+// liquidity which crosses it, oldest orders first
+func (e *Exchange) matchOrders(ctx context.Context) {
+	e.m.Lock()
+	resting := make(map[key.PairAsset][]*paperOrder)
+	for _, o := range e.orders {
+		if !o.IsActive() {
+			continue
+		}
+		k := key.PairAsset{Base: o.Pair.Base.Item, Quote: o.Pair.Quote.Item, Asset: o.AssetType}
+		resting[k] = append(resting[k], o)
+	}
+	e.m.Unlock()
+
+	var fills []fill.Data
+	for k, orders := range resting {
+		pair := k.Pair()
+		makerRate, err := e.feeRate(ctx, pair, k.Asset, true)
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "%s paper trading %s %s: %v", e.GetName(), pair, k.Asset, err)
+			continue
+		}
+		asks, bids, err := e.getTranches(pair, k.Asset)
+		if err != nil {
+			// the orderbook may not be synced yet
+			continue
+		}
+		slices.SortFunc(orders, func(a, b *paperOrder) int {
+			return a.Date.Compare(b.Date)
+		})
+		now := time.Now()
+		e.m.Lock()
+		for _, o := range orders {
+			if !o.IsActive() {
+				// cancelled since collected
+				continue
+			}
+			levels := asks
+			if o.Side.IsShort() {
+				levels = bids
+			}
+			lk := newLiquidityKey(o.Pair, o.AssetType, o.Side)
+			available := e.available(lk, levels)
+			m := take(available, o.Side, o.Price, o.Amount-o.ExecutedAmount, 0)
+			if m.amount > 0 {
+				e.consume(lk, levels, available)
+				fills = append(fills, e.fillResting(o, m.amount, makerRate, now))
+			}
+		}
+		e.m.Unlock()
+	}
+	e.publishFills(fills)
+}
+
+// available returns a copy of the live orderbook levels less the liquidity
+// already taken by paper orders. Taken liquidity is tracked per level and is
+// only returned once the level's amount changes or it leaves the orderbook.
+// The caller must hold the lock
+func (e *Exchange) available(k liquidityKey, levels orderbook.Tranches) orderbook.Tranches {
+	used := e.liquidity[k]
+	available := slices.Clone(levels)
+	for i := range available {
+		if u, ok := used[available[i].Price]; ok && u.amount == available[i].Amount {
+			available[i].Amount -= u.taken
+		}
+	}
+	return available
+}
+
+// consume records the liquidity taken from the live orderbook levels once a
+// match against the available levels has been settled. The caller must hold
+// the lock
+func (e *Exchange) consume(k liquidityKey, levels, available orderbook.Tranches) {
+	taken := make(map[float64]levelUsage)
+	for i := range levels {
+		if t := levels[i].Amount - available[i].Amount; t > 0 {
+			taken[levels[i].Price] = levelUsage{amount: levels[i].Amount, taken: t}
+		}
+	}
+	e.liquidity[k] = taken
+}
+
+// fillResting settles a maker fill of a resting order at its limit price. The
+// caller must hold the lock
+func (e *Exchange) fillResting(o *paperOrder, amount, makerRate float64, now time.Time) fill.Data {
+	cost := amount * o.Price
+	fee := cost * makerRate
+	release := o.reserved * amount / (o.Amount - o.ExecutedAmount)
+	base := e.getBalance(o.AssetType, o.Pair.Base)
+	quote := e.getBalance(o.AssetType, o.Pair.Quote)
+	if o.Side.IsLong() {
+		quote.adjust(-cost-fee, -release, now)
+		base.adjust(amount, 0, now)
+	} else {
+		base.adjust(-amount, -release, now)
+		quote.adjust(cost-fee, 0, now)
+	}
+	o.reserved -= release
+	f := o.trade(amount, cost, fee, true, now)
+	if o.isFilled() {
+		e.release(o, now)
+		o.close(order.Filled, now)
+	} else {
+		o.Status = order.PartiallyFilled
+	}
+	return f
+}
+
+// release returns any balance still reserved by an order. The caller must
+// hold the lock
+func (e *Exchange) release(o *paperOrder, now time.Time) {
+	if o.reserved == 0 {
+		return
+	}
+	c := o.Pair.Quote
+	if o.Side.IsShort() {
+		c = o.Pair.Base
+	}
+	e.getBalance(o.AssetType, c).adjust(0, -o.reserved, now)
+	o.reserved = 0
+}
+
+// CancelOrder cancels a resting order, releasing its reserved balance
+func (e *Exchange) CancelOrder(_ context.Context, c *order.Cancel) error {
+	if err := c.Validate(c.StandardCancel()); err != nil {
+		return err
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.cancel(c.OrderID, time.Now())
+}
+
+// CancelBatchOrders cancels resting orders, returning the status of each
+func (e *Exchange) CancelBatchOrders(_ context.Context, cancels []order.Cancel) (*order.CancelBatchResponse, error) {
+	if len(cancels) == 0 {
+		return nil, order.ErrCancelOrderIsNil
+	}
+	resp := &order.CancelBatchResponse{Status: make(map[string]string, len(cancels))}
+	now := time.Now()
+	e.m.Lock()
+	defer e.m.Unlock()
+	for i := range cancels {
+		if err := cancels[i].Validate(cancels[i].StandardCancel()); err != nil {
+			return nil, err
+		}
+		if err := e.cancel(cancels[i].OrderID, now); err != nil {
+			resp.Status[cancels[i].OrderID] = err.Error()
+			continue
+		}
+		resp.Status[cancels[i].OrderID] = order.Cancelled.String()
+	}
+	return resp, nil
+}
+
+// CancelAllOrders cancels all resting orders, filtered by the pair, asset and
+// side of the request when set
+func (e *Exchange) CancelAllOrders(_ context.Context, c *order.Cancel) (order.CancelAllResponse, error) {
+	if err := c.Validate(); err != nil {
+		return order.CancelAllResponse{}, err
+	}
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	now := time.Now()
+	e.m.Lock()
+	defer e.m.Unlock()
+	for id, o := range e.orders {
+		if !o.IsActive() ||
+			(!c.Pair.IsEmpty() && !c.Pair.Equal(o.Pair)) ||
+			(c.AssetType != asset.Empty && c.AssetType != o.AssetType) ||
+			(c.Side != order.UnknownSide && c.Side != order.AnySide && c.Side != o.Side) {
+			continue
+		}
+		if err := e.cancel(id, now); err != nil {
+			resp.Status[id] = err.Error()
+			continue
+		}
+		resp.Status[id] = order.Cancelled.String()
+		resp.Count++
+	}
+	return resp, nil
+}
+
+// cancel cancels a resting order. The caller must hold the lock
+func (e *Exchange) cancel(id string, now time.Time) error {
+	o, ok := e.orders[id]
+	if !ok {
+		return fmt.Errorf("%w %s", errOrderNotFound, id)
+	}
+	if !o.IsActive() {
+		return fmt.Errorf("%w %s %s", errOrderNotActive, id, o.Status)
+	}
+	e.release(o, now)
+	if o.ExecutedAmount > 0 {
+		o.close(order.PartiallyFilledCancelled, now)
+	} else {
+		o.close(order.Cancelled, now)
+	}
+	return nil
+}
+
+// ModifyOrder changes the price and/or amount of a resting limit order,
+// re-reserving its balance
+func (e *Exchange) ModifyOrder(ctx context.Context, m *order.Modify) (*order.ModifyResponse, error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	if m.OrderID == "" {
+		return nil, order.ErrOrderIDNotSet
+	}
+	if m.Price < 0 || m.Amount < 0 {
+		return nil, errCannotModify
+	}
+	takerRate, err := e.feeRate(ctx, m.Pair, m.AssetType, false)
+	if err != nil {
+		return nil, err
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, ok := e.orders[m.OrderID]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errOrderNotFound, m.OrderID)
+	}
+	if !o.IsActive() {
+		return nil, fmt.Errorf("%w %s %s", errOrderNotActive, m.OrderID, o.Status)
+	}
+	if o.Type != order.Limit {
+		return nil, errCannotModify
+	}
+	price, amount := o.Price, o.Amount
+	if m.Price > 0 {
+		price = m.Price
+	}
+	if m.Amount > 0 {
+		amount = m.Amount
+	}
+	if amount-o.ExecutedAmount <= amount*amountTolerance {
+		return nil, fmt.Errorf("%w, amount must exceed the executed amount %v", order.ErrAmountIsInvalid, o.ExecutedAmount)
+	}
+	reserve := amount - o.ExecutedAmount
+	c := o.Pair.Base
+	if o.Side.IsLong() {
+		reserve *= price * (1 + takerRate)
+		c = o.Pair.Quote
+	}
+	b := e.getBalance(o.AssetType, c)
+	if available := b.free() + o.reserved; reserve > available {
+		return nil, fmt.Errorf("%w: %v %s available, %v required", ErrInsufficientBalance, available, c, reserve)
+	}
+	now := time.Now()
+	b.adjust(0, reserve-o.reserved, now)
+	o.reserved = reserve
+	o.Price = price
+	o.Amount = amount
+	o.RemainingAmount = amount - o.ExecutedAmount
+	o.LastUpdated = now
+
+	resp, err := m.DeriveModifyResponse()
+	if err != nil {
+		return nil, err
+	}
+	resp.Price = price
+	resp.Amount = amount
+	resp.Type = o.Type
+	resp.Side = o.Side
+	return resp, nil
+}
+
+// GetOrderInfo returns a paper trading order
+func (e *Exchange) GetOrderInfo(_ context.Context, orderID string, _ currency.Pair, _ asset.Item) (*order.Detail, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, ok := e.orders[orderID]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", errOrderNotFound, orderID)
+	}
+	return o.CopyToPointer(), nil
+}
+
+// GetActiveOrders returns resting paper trading orders
+func (e *Exchange) GetActiveOrders(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, true)), nil
+}
+
+// GetOrderHistory returns filled and cancelled paper trading orders
+func (e *Exchange) GetOrderHistory(_ context.Context, req *order.MultiOrderRequest) (order.FilteredOrders, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return req.Filter(e.GetName(), e.getOrders(req.AssetType, false)), nil
+}
+
+// getOrders returns copies of active or inactive orders of an asset, oldest
+// first
+func (e *Exchange) getOrders(a asset.Item, active bool) []order.Detail {
+	e.m.Lock()
+	defer e.m.Unlock()
+	orders := make([]order.Detail, 0, len(e.orders))
+	for _, o := range e.orders {
+		if o.AssetType == a && o.IsActive() == active {
+			orders = append(orders, o.Copy())
+		}
+	}
+	slices.SortFunc(orders, func(a, b order.Detail) int {
+		return a.Date.Compare(b.Date)
+	})
+	return orders
+}
+
+// UpdateAccountInfo returns the simulated holdings of an asset. When the
+// wrapped exchange has credentials the holdings are also stored by the account
+// package, so the paper balances are returned to anything querying it
+func (e *Exchange) UpdateAccountInfo(ctx context.Context, a asset.Item) (account.Holdings, error) {
+	if err := checkAsset(a); err != nil {
+		return account.Holdings{}, err
+	}
+	h := e.holdings(a)
+	if creds, err := e.GetCredentials(ctx); err == nil && !creds.IsEmpty() {
+		if err := account.Process(&h, creds); err != nil {
+			return account.Holdings{}, err
+		}
+	}
+	return h, nil
+}
+
+// GetCachedAccountInfo returns the simulated holdings of an asset
+func (e *Exchange) GetCachedAccountInfo(_ context.Context, a asset.Item) (account.Holdings, error) {
+	if err := checkAsset(a); err != nil {
+		return account.Holdings{}, err
+	}
+	return e.holdings(a), nil
+}
+
+// WithdrawCryptocurrencyFunds is not supported in paper trading mode
+func (e *Exchange) WithdrawCryptocurrencyFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// WithdrawFiatFunds is not supported in paper trading mode
+func (e *Exchange) WithdrawFiatFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// WithdrawFiatFundsToInternationalBank is not supported in paper trading mode
+func (e *Exchange) WithdrawFiatFundsToInternationalBank(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// SetCollateralMode is not supported in paper trading mode
+func (e *Exchange) SetCollateralMode(context.Context, asset.Item, collateral.Mode) error {
+	return fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// GetCollateralMode is not supported in paper trading mode
+func (e *Exchange) GetCollateralMode(context.Context, asset.Item) (collateral.Mode, error) {
+	return collateral.UnknownMode, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// SetLeverage is not supported in paper trading mode
+func (e *Exchange) SetLeverage(context.Context, asset.Item, currency.Pair, margin.Type, float64, order.Side) error {
+	return fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// GetLeverage is not supported in paper trading mode
+func (e *Exchange) GetLeverage(context.Context, asset.Item, currency.Pair, margin.Type, order.Side) (float64, error) {
+	return 0, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// SetMarginType is not supported in paper trading mode
+func (e *Exchange) SetMarginType(context.Context, asset.Item, currency.Pair, margin.Type) error {
+	return fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// ChangePositionMargin is not supported in paper trading mode
+func (e *Exchange) ChangePositionMargin(context.Context, *margin.PositionChangeRequest) (*margin.PositionChangeResponse, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// GetFuturesPositions is not supported in paper trading mode
+func (e *Exchange) GetFuturesPositions(context.Context, *futures.PositionsRequest) ([]futures.PositionDetails, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// GetFuturesPositionOrders is not supported in paper trading mode
+func (e *Exchange) GetFuturesPositionOrders(context.Context, *futures.PositionsRequest) ([]futures.PositionResponse, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// GetFuturesPositionSummary is not supported in paper trading mode
+func (e *Exchange) GetFuturesPositionSummary(context.Context, *futures.PositionSummaryRequest) (*futures.PositionSummary, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// GetPositionSummary is not supported in paper trading mode
+func (e *Exchange) GetPositionSummary(context.Context, *futures.PositionSummaryRequest) (*futures.PositionSummary, error) {
+	return nil, fmt.Errorf("%s %w", e.GetName(), errNotSupported)
+}
+
+// holdings returns the simulated balances of an asset
+func (e *Exchange) holdings(a asset.Item) account.Holdings {
+	e.m.Lock()
+	defer e.m.Unlock()
+	balances := make([]account.Balance, 0, len(e.balances[a]))
+	for _, b := range e.balances[a] {
+		balances = append(balances, account.Balance{
+			Currency:               b.code,
+			Total:                  b.total,
+			Hold:                   b.hold,
+			Free:                   b.free(),
+			AvailableWithoutBorrow: b.free(),
+			UpdatedAt:              b.updated,
+		})
+	}
+	slices.SortFunc(balances, func(a, b account.Balance) int {
+		return strings.Compare(a.Currency.String(), b.Currency.String())
+	})
+	return account.Holdings{
+		Exchange: e.GetName(),
+		Accounts: []account.SubAccount{{ID: AccountID, AssetType: a, Currencies: balances}},
+	}
+}
+
+// getBalance returns the balance of a currency, creating it if it does not
+// exist. The caller must hold the lock
+func (e *Exchange) getBalance(a asset.Item, c currency.Code) *balance {
+	balances, ok := e.balances[a]
+	if !ok {
+		balances = make(map[*currency.Item]*balance)
+		e.balances[a] = balances
+	}
+	b, ok := balances[c.Item]
+	if !ok {
+		b = &balance{code: c.Upper()}
+		balances[c.Item] = b
+	}
+	return b
+}
+
+// feeRate returns the maker or taker fee rate of a pair. Fees are priced by the
+// wrapped exchange on a notional of one so the rate can be applied to any fill
+func (e *Exchange) feeRate(ctx context.Context, pair currency.Pair, a asset.Item, isMaker bool) (float64, error) {
+	k := feeKey{PairAsset: key.PairAsset{Base: pair.Base.Item, Quote: pair.Quote.Item, Asset: a}, isMaker: isMaker}
+	e.feeMtx.Lock()
+	rate, ok := e.feeRates[k]
+	e.feeMtx.Unlock()
+	if ok {
+		return rate, nil
+	}
+	rate, err := e.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType:       exchange.CryptocurrencyTradeFee,
+		Pair:          pair,
+		IsMaker:       isMaker,
+		PurchasePrice: 1,
+		Amount:        1,
+	})
+	if err != nil {
+		return 0, err
+	}
+	e.feeMtx.Lock()
+	e.feeRates[k] = rate
+	e.feeMtx.Unlock()
+	return rate, nil
+}
+
+// getTranches returns the live orderbook levels of the wrapped exchange
+func (e *Exchange) getTranches(pair currency.Pair, a asset.Item) (asks, bids orderbook.Tranches, err error) {
+	depth, err := orderbook.GetDepth(e.GetName(), pair, a)
+	if err != nil {
+		return nil, nil, err
+	}
+	return depth.GetTranches(0)
+}
+
+// publishFills sends fills through the wrapped exchange's fill feed
+func (e *Exchange) publishFills(fills []fill.Data) {
+	if len(fills) == 0 {
+		return
+	}
+	ws, err := e.GetWebsocket()
+	if err != nil || ws == nil {
+		return
+	}
+	if err := ws.Fills.Update(fills...); err != nil && !errors.Is(err, fill.ErrFeedDisabled) {
+		log.Errorf(log.ExchangeSys, "%s paper trading fills: %v", e.GetName(), err)
+	}
+}
+
+// checkAsset returns an error for assets which cannot be settled against
+// simulated currency balances
+func checkAsset(a asset.Item) error {
+	if a.IsFutures() || a.IsOptions() {
+		return fmt.Errorf("%w %s", errAssetNotSupported, a)
+	}
+	return nil
+}
+
+// take matches an amount against orderbook levels, best first, stopping at the
+// first level beyond the limit price where a zero limit matches at any price.
+// When quoteAmount is set the match is bound by the quote amount spent instead.
+// Matched liquidity is removed from the levels so it cannot be matched twice
+func take(levels orderbook.Tranches, side order.Side, limit, amount, quoteAmount float64) match {
+	var m match
+	for i := range levels {
+		if limit > 0 && ((side.IsLong() && levels[i].Price > limit) || (side.IsShort() && levels[i].Price < limit)) {
+			break
+		}
+		if levels[i].Amount <= 0 {
+			continue
+		}
+		matched := levels[i].Amount
+		if amount > 0 {
+			matched = min(matched, amount-m.amount)
+		}
+		if quoteAmount > 0 {
+			matched = min(matched, (quoteAmount-m.cost)/levels[i].Price)
+		}
+		if matched <= 0 {
+			break
+		}
+		levels[i].Amount -= matched
+		m.amount += matched
+		m.cost += matched * levels[i].Price
+	}
+	return m
+}
+
+// newLiquidityKey returns the key of the orderbook side an order takes from
+func newLiquidityKey(pair currency.Pair, a asset.Item, side order.Side) liquidityKey {
+	return liquidityKey{PairAsset: key.PairAsset{Base: pair.Base.Item, Quote: pair.Quote.Item, Asset: a}, asks: side.IsLong()}
+}
+
+// trade records a fill against an order, returning it for the fill feed
+func (o *paperOrder) trade(amount, cost, fee float64, isMaker bool, now time.Time) fill.Data {
+	tradeID := fmt.Sprintf("%s-%d", o.OrderID, len(o.Trades)+1)
+	price := cost / amount
+	o.Trades = append(o.Trades, order.TradeHistory{
+		Price:     price,
+		Amount:    amount,
+		Fee:       fee,
+		Exchange:  o.Exchange,
+		TID:       tradeID,
+		Type:      o.Type,
+		Side:      o.Side,
+		Timestamp: now,
+		IsMaker:   isMaker,
+		FeeAsset:  o.FeeAsset.String(),
+		Total:     cost,
+	})
+	o.ExecutedAmount += amount
+	o.RemainingAmount = o.Amount - o.ExecutedAmount
+	o.Cost += cost
+	o.Fee += fee
+	o.AverageExecutedPrice = o.Cost / o.ExecutedAmount
+	o.LastUpdated = now
+	return fill.Data{
+		ID:            tradeID,
+		Timestamp:     now,
+		Exchange:      o.Exchange,
+		AssetType:     o.AssetType,
+		CurrencyPair:  o.Pair,
+		Side:          o.Side,
+		OrderID:       o.OrderID,
+		ClientOrderID: o.ClientOrderID,
+		TradeID:       tradeID,
+		Price:         price,
+		Amount:        amount,
+	}
+}
+
+// isFilled returns whether the order has no amount left to fill
+func (o *paperOrder) isFilled() bool {
+	return o.Amount-o.ExecutedAmount <= o.Amount*amountTolerance
+}
+
+// close sets the final status of an order
+func (o *paperOrder) close(status order.Status, now time.Time) {
+	if status == order.Filled {
+		o.ExecutedAmount = o.Amount
+	}
+	o.Status = status
+	o.RemainingAmount = 0
+	o.CloseTime = now
+	o.LastUpdated = now
+}
+
+// free returns the balance not held by resting orders
+func (b *balance) free() float64 {
+	return b.total - b.hold
+}
+
+// adjust changes the total and held balance
+func (b *balance) adjust(total, hold float64, now time.Time) {
+	b.total += total
+	b.hold += hold
+	b.updated = now
+}
@@ -0,0 +1,519 @@
+package paper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchange/websocket"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+const (
+	testExchange  = "papertest"
+	testTakerRate = 0.002
+	testMakerRate = 0.001
+)
+
+type testExch struct {
+	sharedtestvalues.CustomEx
+	ws        *websocket.Manager
+	withdrawn bool
+}
+
+func (t *testExch) WithdrawCryptocurrencyFunds(context.Context, *withdraw.Request) (*withdraw.ExchangeResponse, error) {
+	t.withdrawn = true
+	return &withdraw.ExchangeResponse{ID: "live"}, nil
+}
+
+func (t *testExch) GetName() string {
+	return testExchange
+}
+
+func (t *testExch) GetFeeByType(_ context.Context, f *exchange.FeeBuilder) (float64, error) {
+	if f.IsMaker {
+		return f.PurchasePrice * f.Amount * testMakerRate, nil
+	}
+	return f.PurchasePrice * f.Amount * testTakerRate, nil
+}
+
+func (t *testExch) GetWebsocket() (*websocket.Manager, error) {
+	return t.ws, nil
+}
+
+func (t *testExch) Shutdown() error {
+	return nil
+}
+
+// paperSetup returns a paper trading exchange with a fill feed, starting with
+// 1000 USDT and the supplied base balance of pair
+func paperSetup(t *testing.T, pair currency.Pair, baseAmount float64) (*Exchange, *websocket.Manager) {
+	t.Helper()
+	ws := websocket.NewManager()
+	ws.Fills.Setup(true, ws.DataHandler)
+	e, err := New(&testExch{ws: ws}, []config.PaperTradingBalance{
+		{Asset: asset.Spot, Currency: currency.USDT, Amount: 1000},
+		{Asset: asset.Spot, Currency: pair.Base, Amount: baseAmount},
+	})
+	require.NoError(t, err, "New must not error")
+	// orders are matched by the test rather than on an interval
+	close(e.shutdown)
+	e.wg.Wait()
+	t.Cleanup(func() {
+		assert.NoError(t, e.Shutdown(), "Shutdown should not error")
+	})
+	return e, ws
+}
+
+func paperPair(base string) currency.Pair {
+	return currency.NewPair(currency.NewCode(base), currency.USDT)
+}
+
+func paperBook(t *testing.T, pair currency.Pair, bids, asks orderbook.Tranches) {
+	t.Helper()
+	b := &orderbook.Base{
+		Exchange:    testExchange,
+		Pair:        pair,
+		Asset:       asset.Spot,
+		Bids:        bids,
+		Asks:        asks,
+		LastUpdated: time.Now(),
+	}
+	require.NoError(t, b.Process(), "orderbook Process must not error")
+}
+
+func paperBalance(t *testing.T, e *Exchange, c currency.Code) account.Balance {
+	t.Helper()
+	h, err := e.GetCachedAccountInfo(context.Background(), asset.Spot)
+	require.NoError(t, err, "GetCachedAccountInfo must not error")
+	require.Len(t, h.Accounts, 1, "holdings must have one account")
+	for _, b := range h.Accounts[0].Currencies {
+		if b.Currency.Equal(c) {
+			return b
+		}
+	}
+	return account.Balance{}
+}
+
+func paperSubmit(pair currency.Pair, side order.Side, orderType order.Type, price, amount float64) *order.Submit {
+	return &order.Submit{
+		Exchange:  testExchange,
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      side,
+		Type:      orderType,
+		Price:     price,
+		Amount:    amount,
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	_, err := New(nil, nil)
+	assert.ErrorIs(t, err, common.ErrNilPointer)
+
+	_, err = New(&testExch{}, []config.PaperTradingBalance{{Asset: asset.Spot, Amount: 1}})
+	assert.ErrorIs(t, err, errInvalidBalance)
+
+	_, err = New(&testExch{}, []config.PaperTradingBalance{{Asset: asset.Spot, Currency: currency.BTC, Amount: -1}})
+	assert.ErrorIs(t, err, errInvalidBalance)
+
+	_, err = New(&testExch{}, []config.PaperTradingBalance{{Asset: asset.Futures, Currency: currency.BTC, Amount: 1}})
+	assert.ErrorIs(t, err, errAssetNotSupported)
+
+	e, err := New(&testExch{}, []config.PaperTradingBalance{
+		{Asset: asset.Spot, Currency: currency.BTC, Amount: 1},
+		{Asset: asset.Spot, Currency: currency.BTC, Amount: 2},
+	})
+	require.NoError(t, err, "New must not error")
+	assert.Equal(t, 3.0, paperBalance(t, e, currency.BTC).Total, "balances of the same currency should be summed")
+	assert.Equal(t, testExchange, e.GetName(), "GetName should pass through to the wrapped exchange")
+	assert.NoError(t, e.Shutdown(), "Shutdown should not error")
+	assert.NoError(t, e.Shutdown(), "Shutdown should not error when already stopped")
+}
+
+func TestSubmitMarketOrder(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTMKT")
+	e, ws := paperSetup(t, pair, 1)
+	paperBook(t, pair,
+		orderbook.Tranches{{Price: 99, Amount: 0.5}, {Price: 98, Amount: 1}},
+		orderbook.Tranches{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}, {Price: 500, Amount: 10}})
+
+	_, err := e.SubmitOrder(context.Background(), nil)
+	assert.ErrorIs(t, err, order.ErrSubmissionIsNil)
+
+	_, err = e.SubmitOrder(context.Background(), paperSubmit(currency.NewPair(currency.NewCode("PTNONE"), currency.USDT), order.Buy, order.Market, 0, 1))
+	assert.ErrorIs(t, err, orderbook.ErrOrderbookNotFound)
+
+	s := paperSubmit(pair, order.Buy, order.Market, 0, 1)
+	s.AssetType = asset.Futures
+	_, err = e.SubmitOrder(context.Background(), s)
+	assert.ErrorIs(t, err, errAssetNotSupported)
+
+	_, err = e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Market, 0, 10))
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+
+	resp, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Market, 0, 2))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "market order should be filled")
+	assert.Equal(t, 100.5, resp.AverageExecutedPrice, "market buy should walk the asks")
+	assert.InDelta(t, 0.402, resp.Fee, 1e-9, "taker fee should be charged")
+	assert.Equal(t, currency.USDT, resp.FeeAsset, "fee should be charged in the quote currency")
+	assert.InDelta(t, 798.598, paperBalance(t, e, currency.USDT).Total, 1e-9, "quote balance should be debited the cost and fee")
+	assert.Equal(t, 3.0, paperBalance(t, e, pair.Base).Total, "base balance should be credited the amount bought")
+
+	data := <-ws.DataHandler
+	fills, ok := data.([]fill.Data)
+	require.True(t, ok, "fill feed must receive fills")
+	require.Len(t, fills, 1, "fill feed must receive one fill")
+	assert.Equal(t, resp.OrderID, fills[0].OrderID, "fill should reference the order")
+	assert.Equal(t, 2.0, fills[0].Amount, "fill should have the executed amount")
+	assert.Equal(t, 100.5, fills[0].Price, "fill should have the average price")
+
+	resp, err = e.SubmitOrder(context.Background(), paperSubmit(pair, order.Sell, order.Market, 0, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, 98.5, resp.Cost, "market sell should walk the bids")
+	assert.InDelta(t, 798.598+98.5-0.197, paperBalance(t, e, currency.USDT).Total, 1e-9, "quote balance should be credited the proceeds less fee")
+	assert.Equal(t, 2.0, paperBalance(t, e, pair.Base).Total, "base balance should be debited the amount sold")
+	<-ws.DataHandler
+
+	_, err = e.SubmitOrder(context.Background(), paperSubmit(pair, order.Sell, order.Market, 0, 3))
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+
+	thinPair := paperPair("PTTHIN")
+	paperBook(t, thinPair, nil, orderbook.Tranches{{Price: 100, Amount: 1}})
+	resp, err = e.SubmitOrder(context.Background(), paperSubmit(thinPair, order.Buy, order.Market, 0, 2))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "market order exhausting the book should be partially filled")
+	detail, err := e.GetOrderInfo(context.Background(), resp.OrderID, thinPair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, 1.0, detail.ExecutedAmount, "market order should fill the whole book")
+	<-ws.DataHandler
+
+	emptyPair := paperPair("PTEMPTY")
+	paperBook(t, emptyPair, nil, nil)
+	_, err = e.SubmitOrder(context.Background(), paperSubmit(emptyPair, order.Buy, order.Market, 0, 1))
+	assert.ErrorIs(t, err, errNoLiquidity)
+}
+
+func TestSubmitQuoteAmount(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTQUOTE")
+	e, ws := paperSetup(t, pair, 0)
+	paperBook(t, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 100, Amount: 5}})
+
+	s := paperSubmit(pair, order.Buy, order.Market, 0, 0)
+	s.QuoteAmount = 100.2
+	resp, err := e.SubmitOrder(context.Background(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.InDelta(t, 1, resp.Amount, 1e-9, "quote amount should be spent including fees")
+	assert.InDelta(t, 1000-100.2, paperBalance(t, e, currency.USDT).Total, 1e-9, "quote balance should be debited the quote amount")
+	<-ws.DataHandler
+
+	s = paperSubmit(pair, order.Sell, order.Market, 0, 0)
+	s.QuoteAmount = 10
+	_, err = e.SubmitOrder(context.Background(), s)
+	assert.ErrorIs(t, err, order.ErrAmountIsInvalid)
+}
+
+func TestSubmitLimitOrder(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTLIMIT")
+	e, ws := paperSetup(t, pair, 0)
+	paperBook(t, pair,
+		orderbook.Tranches{{Price: 99, Amount: 1}},
+		orderbook.Tranches{{Price: 100, Amount: 1}, {Price: 101, Amount: 2}})
+
+	resp, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Limit, 100.5, 2))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilled, resp.Status, "marketable amount should fill immediately")
+	assert.Equal(t, 1.0, resp.RemainingAmount, "amount beyond the limit price should rest")
+	<-ws.DataHandler
+
+	quote := paperBalance(t, e, currency.USDT)
+	assert.InDelta(t, 1000-100.2, quote.Total, 1e-9, "quote balance should be debited the taker fill")
+	assert.InDelta(t, 100.5*1.002, quote.Hold, 1e-9, "resting amount and fee allowance should be held")
+
+	active, err := e.GetActiveOrders(context.Background(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetActiveOrders must not error")
+	require.Len(t, active, 1, "GetActiveOrders must return the resting order")
+	assert.Equal(t, resp.OrderID, active[0].OrderID, "GetActiveOrders should return the resting order")
+
+	paperBook(t, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 101, Amount: 2}})
+	e.matchOrders(context.Background())
+	assert.Equal(t, 1.0, paperBalance(t, e, pair.Base).Total, "resting order should not fill until the book crosses")
+
+	paperBook(t, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 100.4, Amount: 0.4}, {Price: 100.5, Amount: 5}})
+	e.matchOrders(context.Background())
+	data := <-ws.DataHandler
+	fills, ok := data.([]fill.Data)
+	require.True(t, ok, "fill feed must receive fills")
+	require.Len(t, fills, 1, "fill feed must receive one fill")
+	assert.Equal(t, 100.5, fills[0].Price, "resting order should fill at its limit price")
+
+	detail, err := e.GetOrderInfo(context.Background(), resp.OrderID, pair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Filled, detail.Status, "resting order should be filled")
+	require.Len(t, detail.Trades, 2, "order must have a taker and maker trade")
+	assert.True(t, detail.Trades[1].IsMaker, "resting fill should be a maker trade")
+	assert.InDelta(t, 0.1005, detail.Trades[1].Fee, 1e-9, "resting fill should be charged the maker fee")
+
+	quote = paperBalance(t, e, currency.USDT)
+	assert.InDelta(t, 1000-100.2-100.5-0.1005, quote.Total, 1e-9, "quote balance should be debited the maker fill")
+	assert.InDelta(t, 0, quote.Hold, 1e-9, "filled order should release its hold")
+	assert.Equal(t, 2.0, paperBalance(t, e, pair.Base).Total, "base balance should be credited both fills")
+
+	history, err := e.GetOrderHistory(context.Background(), &order.MultiOrderRequest{AssetType: asset.Spot, Side: order.AnySide, Type: order.AnyType})
+	require.NoError(t, err, "GetOrderHistory must not error")
+	require.Len(t, history, 1, "GetOrderHistory must return the filled order")
+
+	_, err = e.GetOrderInfo(context.Background(), "missing", pair, asset.Spot)
+	assert.ErrorIs(t, err, errOrderNotFound)
+	_, err = e.GetActiveOrders(context.Background(), nil)
+	assert.ErrorIs(t, err, order.ErrGetOrdersRequestIsNil)
+}
+
+func TestSubmitTimeInForce(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTTIF")
+	e, ws := paperSetup(t, pair, 0)
+	paperBook(t, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 100, Amount: 1}})
+
+	s := paperSubmit(pair, order.Buy, order.Limit, 100, 1)
+	s.PostOnly = true
+	_, err := e.SubmitOrder(context.Background(), s)
+	assert.ErrorIs(t, err, errPostOnlyCrossed)
+
+	s.Price = 99.5
+	resp, err := e.SubmitOrder(context.Background(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, resp.Status, "post only order should rest")
+
+	s = paperSubmit(pair, order.Buy, order.Limit, 100, 2)
+	s.FillOrKill = true
+	_, err = e.SubmitOrder(context.Background(), s)
+	assert.ErrorIs(t, err, errFillOrKill)
+
+	s = paperSubmit(pair, order.Buy, order.Limit, 100, 2)
+	s.ImmediateOrCancel = true
+	resp, err = e.SubmitOrder(context.Background(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.PartiallyFilledCancelled, resp.Status, "immediate or cancel order should cancel the unfilled amount")
+	<-ws.DataHandler
+
+	s = paperSubmit(pair, order.Buy, order.Limit, 90, 1)
+	s.ImmediateOrCancel = true
+	resp, err = e.SubmitOrder(context.Background(), s)
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Cancelled, resp.Status, "unmatched immediate or cancel order should be cancelled")
+	assert.InDelta(t, 99.5*1.002, paperBalance(t, e, currency.USDT).Hold, 1e-9, "only the post only order should hold balance")
+}
+
+func TestCancelOrder(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTCANCEL")
+	e, _ := paperSetup(t, pair, 2)
+	paperBook(t, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 100, Amount: 1}})
+
+	resp, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Sell, order.Limit, 110, 2))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, 2.0, paperBalance(t, e, pair.Base).Hold, "resting sell should hold the base amount")
+
+	_, err = e.SubmitOrder(context.Background(), paperSubmit(pair, order.Sell, order.Limit, 110, 1))
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+
+	assert.ErrorIs(t, e.CancelOrder(context.Background(), nil), order.ErrCancelOrderIsNil)
+	assert.ErrorIs(t, e.CancelOrder(context.Background(), &order.Cancel{}), order.ErrOrderIDNotSet)
+	assert.ErrorIs(t, e.CancelOrder(context.Background(), &order.Cancel{OrderID: "missing"}), errOrderNotFound)
+	require.NoError(t, e.CancelOrder(context.Background(), &order.Cancel{OrderID: resp.OrderID}), "CancelOrder must not error")
+	assert.Zero(t, paperBalance(t, e, pair.Base).Hold, "cancelled order should release its hold")
+	assert.ErrorIs(t, e.CancelOrder(context.Background(), &order.Cancel{OrderID: resp.OrderID}), errOrderNotActive)
+
+	detail, err := e.GetOrderInfo(context.Background(), resp.OrderID, pair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.Equal(t, order.Cancelled, detail.Status, "order should be cancelled")
+
+	first, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Sell, order.Limit, 110, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	second, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Limit, 90, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	_, err = e.CancelBatchOrders(context.Background(), nil)
+	assert.ErrorIs(t, err, order.ErrCancelOrderIsNil)
+	batch, err := e.CancelBatchOrders(context.Background(), []order.Cancel{{OrderID: first.OrderID}, {OrderID: resp.OrderID}})
+	require.NoError(t, err, "CancelBatchOrders must not error")
+	assert.Equal(t, order.Cancelled.String(), batch.Status[first.OrderID], "active order should be cancelled")
+	assert.Contains(t, batch.Status[resp.OrderID], errOrderNotActive.Error(), "inactive order should report an error")
+
+	all, err := e.CancelAllOrders(context.Background(), &order.Cancel{Pair: paperPair("PTOTHER")})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Zero(t, all.Count, "CancelAllOrders should filter by pair")
+	all, err = e.CancelAllOrders(context.Background(), &order.Cancel{Pair: pair, AssetType: asset.Spot})
+	require.NoError(t, err, "CancelAllOrders must not error")
+	assert.Equal(t, int64(1), all.Count, "CancelAllOrders should cancel the remaining order")
+	assert.Equal(t, order.Cancelled.String(), all.Status[second.OrderID], "remaining order should be cancelled")
+	assert.Zero(t, paperBalance(t, e, currency.USDT).Hold, "cancelled orders should release their hold")
+}
+
+func TestModifyOrder(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTMODIFY")
+	e, ws := paperSetup(t, pair, 0)
+	paperBook(t, pair, orderbook.Tranches{{Price: 89, Amount: 1}}, orderbook.Tranches{{Price: 100, Amount: 1}})
+
+	resp, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Limit, 90, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+
+	_, err = e.ModifyOrder(context.Background(), &order.Modify{Pair: pair, AssetType: asset.Spot})
+	assert.ErrorIs(t, err, order.ErrOrderIDNotSet)
+	_, err = e.ModifyOrder(context.Background(), &order.Modify{OrderID: "missing", Pair: pair, AssetType: asset.Spot})
+	assert.ErrorIs(t, err, errOrderNotFound)
+	_, err = e.ModifyOrder(context.Background(), &order.Modify{OrderID: resp.OrderID, Pair: pair, AssetType: asset.Spot, Amount: 20})
+	assert.ErrorIs(t, err, ErrInsufficientBalance)
+
+	mod, err := e.ModifyOrder(context.Background(), &order.Modify{OrderID: resp.OrderID, Pair: pair, AssetType: asset.Spot, Price: 95, Amount: 2})
+	require.NoError(t, err, "ModifyOrder must not error")
+	assert.Equal(t, 95.0, mod.Price, "ModifyOrder should return the new price")
+	assert.Equal(t, 2.0, mod.Amount, "ModifyOrder should return the new amount")
+	assert.InDelta(t, 2*95*1.002, paperBalance(t, e, currency.USDT).Hold, 1e-9, "modified order should re-reserve its balance")
+
+	market, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Market, 0, 0.5))
+	require.NoError(t, err, "SubmitOrder must not error")
+	<-ws.DataHandler
+	_, err = e.ModifyOrder(context.Background(), &order.Modify{OrderID: market.OrderID, Pair: pair, AssetType: asset.Spot, Price: 1})
+	assert.ErrorIs(t, err, errOrderNotActive)
+}
+
+func TestMatchConsumesLiquidity(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTLIQUIDITY")
+	e, ws := paperSetup(t, pair, 0)
+	paperBook(t, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 100, Amount: 1}})
+
+	resp, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Limit, 100, 0.6))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.Filled, resp.Status, "order should fill against the book")
+	<-ws.DataHandler
+
+	resp, err = e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Limit, 100, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.InDelta(t, 0.6, resp.RemainingAmount, 1e-9, "order should only take liquidity not already taken")
+	<-ws.DataHandler
+
+	second, err := e.SubmitOrder(context.Background(), paperSubmit(pair, order.Buy, order.Limit, 100, 1))
+	require.NoError(t, err, "SubmitOrder must not error")
+	assert.Equal(t, order.New, second.Status, "order should rest when the level has been taken")
+
+	e.matchOrders(context.Background())
+	e.matchOrders(context.Background())
+	detail, err := e.GetOrderInfo(context.Background(), resp.OrderID, pair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.InDelta(t, 0.4, detail.ExecutedAmount, 1e-9, "taken liquidity should not be matched again while the book is unchanged")
+
+	paperBook(t, pair, orderbook.Tranches{{Price: 99, Amount: 1}}, orderbook.Tranches{{Price: 100, Amount: 1.5}})
+	e.matchOrders(context.Background())
+	data := <-ws.DataHandler
+	fills, ok := data.([]fill.Data)
+	require.True(t, ok, "fill feed must receive fills")
+	require.Len(t, fills, 2, "changed level must be shared between resting orders within a tick")
+	assert.Equal(t, resp.OrderID, fills[0].OrderID, "oldest resting order should fill first")
+	assert.InDelta(t, 0.6, fills[0].Amount, 1e-9, "oldest resting order should fill its remaining amount")
+	assert.Equal(t, second.OrderID, fills[1].OrderID, "next resting order should fill from the remaining liquidity")
+	assert.InDelta(t, 0.9, fills[1].Amount, 1e-9, "next resting order should only fill what is left of the level")
+
+	e.matchOrders(context.Background())
+	detail, err = e.GetOrderInfo(context.Background(), second.OrderID, pair, asset.Spot)
+	require.NoError(t, err, "GetOrderInfo must not error")
+	assert.InDelta(t, 0.9, detail.ExecutedAmount, 1e-9, "liquidity taken in a previous tick should not be matched again")
+}
+
+func TestUnsupported(t *testing.T) {
+	t.Parallel()
+	live := &testExch{}
+	e, err := New(live, nil)
+	require.NoError(t, err, "New must not error")
+	t.Cleanup(func() {
+		assert.NoError(t, e.Shutdown(), "Shutdown should not error")
+	})
+	ctx := context.Background()
+	pair := paperPair("PTUNSUPPORTED")
+
+	_, err = e.WithdrawCryptocurrencyFunds(ctx, &withdraw.Request{Exchange: testExchange, Currency: currency.BTC, Amount: 1})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	assert.False(t, live.withdrawn, "paper withdrawals must never reach the wrapped exchange")
+	_, err = e.WithdrawFiatFunds(ctx, &withdraw.Request{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	_, err = e.WithdrawFiatFundsToInternationalBank(ctx, &withdraw.Request{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	assert.ErrorIs(t, e.SetCollateralMode(ctx, asset.Futures, collateral.SingleMode), common.ErrFunctionNotSupported)
+	_, err = e.GetCollateralMode(ctx, asset.Futures)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	assert.ErrorIs(t, e.SetLeverage(ctx, asset.Futures, pair, margin.Isolated, 10, order.Buy), common.ErrFunctionNotSupported)
+	_, err = e.GetLeverage(ctx, asset.Futures, pair, margin.Isolated, order.Buy)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	assert.ErrorIs(t, e.SetMarginType(ctx, asset.Futures, pair, margin.Isolated), common.ErrFunctionNotSupported)
+	_, err = e.ChangePositionMargin(ctx, &margin.PositionChangeRequest{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	_, err = e.GetFuturesPositions(ctx, &futures.PositionsRequest{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	_, err = e.GetFuturesPositionOrders(ctx, &futures.PositionsRequest{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	_, err = e.GetFuturesPositionSummary(ctx, &futures.PositionSummaryRequest{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+	_, err = e.GetPositionSummary(ctx, &futures.PositionSummaryRequest{})
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported)
+}
+
+func TestAccountInfo(t *testing.T) {
+	t.Parallel()
+	pair := paperPair("PTACCOUNT")
+	e, _ := paperSetup(t, pair, 5)
+
+	h, err := e.UpdateAccountInfo(context.Background(), asset.Spot)
+	require.NoError(t, err, "UpdateAccountInfo must not error")
+	assert.Equal(t, testExchange, h.Exchange, "holdings should be of the wrapped exchange")
+	require.Len(t, h.Accounts, 1, "holdings must have one account")
+	assert.Equal(t, AccountID, h.Accounts[0].ID, "holdings should be of the paper account")
+	require.Len(t, h.Accounts[0].Currencies, 2, "holdings must have both balances")
+	assert.True(t, h.Accounts[0].Currencies[0].Currency.Equal(pair.Base), "balances should be sorted by currency")
+	assert.Equal(t, 5.0, h.Accounts[0].Currencies[0].Free, "balance should be free")
+
+	_, err = e.UpdateAccountInfo(context.Background(), asset.Futures)
+	assert.ErrorIs(t, err, errAssetNotSupported)
+	_, err = e.GetCachedAccountInfo(context.Background(), asset.Options)
+	assert.ErrorIs(t, err, errAssetNotSupported)
+
+	h, err = e.GetCachedAccountInfo(context.Background(), asset.Margin)
+	require.NoError(t, err, "GetCachedAccountInfo must not error")
+	assert.Empty(t, h.Accounts[0].Currencies, "assets without balances should be empty")
+}
+
+func TestTake(t *testing.T) {
+	t.Parallel()
+	asks := orderbook.Tranches{{Price: 100, Amount: 1}, {Price: 101, Amount: 1}, {Price: 102, Amount: 1}}
+	m := take(asks, order.Buy, 101, 5, 0)
+	assert.Equal(t, match{amount: 2, cost: 201}, m, "take should stop at the limit price")
+	assert.Zero(t, asks[0].Amount, "take should remove matched liquidity")
+	m = take(asks, order.Buy, 0, 5, 0)
+	assert.Equal(t, match{amount: 1, cost: 102}, m, "take should not match removed liquidity")
+
+	bids := orderbook.Tranches{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}}
+	m = take(bids, order.Sell, 0, 0, 148)
+	assert.Equal(t, match{amount: 1.5, cost: 148}, m, "take should be bound by the quote amount")
+}
@@ -0,0 +1,98 @@
+package paper
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// AccountID is the sub account ID of simulated holdings
+const AccountID = "paper"
+
+const defaultMatchInterval = time.Second
+
+var (
+	// ErrInsufficientBalance is returned when the simulated balance cannot
+	// cover an order
+	ErrInsufficientBalance = errors.New("insufficient paper trading balance")
+
+	errInvalidBalance    = errors.New("invalid paper trading balance")
+	errAssetNotSupported = errors.New("asset is not supported for paper trading")
+	errNoLiquidity       = errors.New("no orderbook liquidity to match order against")
+	errPostOnlyCrossed   = errors.New("post only order would have matched immediately")
+	errFillOrKill        = errors.New("fill or kill order could not be filled in full")
+	errOrderNotFound     = errors.New("paper trading order not found")
+	errOrderNotActive    = errors.New("paper trading order is not active")
+	errCannotModify      = errors.New("only the price and amount of resting limit orders can be modified")
+	errNotSupported      = fmt.Errorf("%w in paper trading mode", common.ErrFunctionNotSupported)
+)
+
+// Exchange wraps an exchange so orders are matched locally against the live
+// orderbook depth of the venue and settled against simulated holdings. All
+// market data is passed through to the wrapped exchange, while withdrawals and
+// futures and margin account functions are rejected so they never reach it
+type Exchange struct {
+	exchange.IBotExchange
+	interval time.Duration
+	m        sync.Mutex
+	balances map[asset.Item]map[*currency.Item]*balance
+	orders   map[string]*paperOrder
+	// liquidity holds the orderbook liquidity already matched by paper
+	// orders, so it is not matched again until the level changes
+	liquidity map[liquidityKey]map[float64]levelUsage
+	feeMtx    sync.Mutex
+	feeRates  map[feeKey]float64
+	shutdown  chan struct{}
+	wg        sync.WaitGroup
+}
+
+// balance is a simulated currency balance, where hold is reserved by resting
+// orders
+type balance struct {
+	code  currency.Code
+	total float64
+	hold  float64
+	// updated is when the balance last changed
+	updated time.Time
+}
+
+// paperOrder is an order matched by the paper trading exchange
+type paperOrder struct {
+	order.Detail
+	// reserved is the balance held for the remaining amount of the order,
+	// which includes an allowance for fees on buy orders
+	reserved float64
+}
+
+// feeKey identifies a cached fee rate
+type feeKey struct {
+	key.PairAsset
+	isMaker bool
+}
+
+// match is the result of matching an order against orderbook levels
+type match struct {
+	amount float64
+	cost   float64
+}
+
+// liquidityKey identifies one side of an orderbook
+type liquidityKey struct {
+	key.PairAsset
+	asks bool
+}
+
+// levelUsage is the amount taken from an orderbook level by paper orders
+type levelUsage struct {
+	// amount is the amount of the level when it was last matched against
+	amount float64
+	taken  float64
+}
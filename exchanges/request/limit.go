@@ -113,10 +113,14 @@ func (r *Requester) InitiateRateLimit(ctx context.Context, e EndpointLimit) erro
 
 	rateLimiter := r.limiter[e]
 
+	start := time.Now()
 	err := RateLimit(ctx, rateLimiter)
 	if err != nil {
 		return fmt.Errorf("cannot rate limit request %w for endpoint %d", err, e)
 	}
+	if r.reporter != nil {
+		r.reporter.RateLimitWait(r.name, time.Since(start))
+	}
 
 	return nil
 }
@@ -5,9 +5,15 @@ import (
 )
 
 // Reporter interface groups observability functionality over
-// HTTP request latency.
+// HTTP request latency, errors and rate limiting.
 type Reporter interface {
 	Latency(name, method, path string, t time.Duration)
+	// Error is called for failed requests. The status code is zero when no
+	// response was received
+	Error(name, method, path string, statusCode int)
+	// RateLimitWait is called with the time a request waited on its rate
+	// limiter
+	RateLimitWait(name string, t time.Duration)
 }
 
 // SetupGlobalReporter sets a reporter interface to be used
@@ -197,8 +197,15 @@ func (r *Requester) doRequest(ctx context.Context, endpoint EndpointLimit, newRe
 
 		resp, err := r._HTTPClient.do(req)
 
-		if r.reporter != nil && err == nil {
-			r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
+		if r.reporter != nil {
+			if err != nil {
+				r.reporter.Error(r.name, p.Method, p.Path, 0)
+			} else {
+				r.reporter.Latency(r.name, p.Method, p.Path, time.Since(start))
+				if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusNoContent {
+					r.reporter.Error(r.name, p.Method, p.Path, resp.StatusCode)
+				}
+			}
 		}
 
 		if retry, checkErr := r.retryPolicy(resp, err); checkErr != nil {
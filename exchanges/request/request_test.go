@@ -13,6 +13,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -637,3 +638,51 @@ func TestGetRateLimiterDefinitions(t *testing.T) {
 	require.NotEmpty(t, r.GetRateLimiterDefinitions())
 	assert.Equal(t, globalshell, r.GetRateLimiterDefinitions())
 }
+
+// testReporter records the requests reported to it
+type testReporter struct {
+	m              sync.Mutex
+	latencies      []string
+	errors         []int
+	rateLimitWaits int
+}
+
+func (r *testReporter) Latency(_, _, path string, _ time.Duration) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.latencies = append(r.latencies, path)
+}
+
+func (r *testReporter) Error(_, _, _ string, statusCode int) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.errors = append(r.errors, statusCode)
+}
+
+func (r *testReporter) RateLimitWait(string, time.Duration) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.rateLimitWaits++
+}
+
+func TestReporter(t *testing.T) {
+	t.Parallel()
+	rep := &testReporter{}
+	r, err := New("test", new(http.Client), WithReporter(rep), WithLimiter(NewBasicRateLimit(time.Millisecond, 10, 1)))
+	require.NoError(t, err, "New must not error")
+
+	for _, path := range []string{"/", "/error"} {
+		_ = r.SendPayload(t.Context(), Unset, func() (*Item, error) {
+			return &Item{Method: http.MethodGet, Path: testURL + path}, nil
+		}, UnauthenticatedRequest)
+	}
+	_ = r.SendPayload(t.Context(), Unset, func() (*Item, error) {
+		return &Item{Method: http.MethodGet, Path: "http://invalid.invalid"}, nil
+	}, UnauthenticatedRequest)
+
+	rep.m.Lock()
+	defer rep.m.Unlock()
+	assert.Equal(t, []string{testURL + "/", testURL + "/error"}, rep.latencies, "Latency should be reported for each response")
+	assert.Equal(t, []int{http.StatusBadRequest, 0}, rep.errors, "Error should be reported for bad statuses and failed requests")
+	assert.Equal(t, 3, rep.rateLimitWaits, "RateLimitWait should be reported for each rate limited request")
+}
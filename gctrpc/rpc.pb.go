@@ -2575,32 +2575,26 @@ func (x *GetPortfolioSummaryResponse) GetCoinsOnlineSummary() map[string]*Online
 	return nil
 }
 
-type AddPortfolioAddressRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	CoinType           string                 `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
-	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Balance            float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
-	SupportedExchanges string                 `protobuf:"bytes,5,opt,name=supported_exchanges,json=supportedExchanges,proto3" json:"supported_exchanges,omitempty"`
-	ColdStorage        bool                   `protobuf:"varint,6,opt,name=cold_storage,json=coldStorage,proto3" json:"cold_storage,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetPortfolioValuationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPortfolioAddressRequest) Reset() {
-	*x = AddPortfolioAddressRequest{}
+func (x *GetPortfolioValuationRequest) Reset() {
+	*x = GetPortfolioValuationRequest{}
 	mi := &file_rpc_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPortfolioAddressRequest) String() string {
+func (x *GetPortfolioValuationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPortfolioAddressRequest) ProtoMessage() {}
+func (*GetPortfolioValuationRequest) ProtoMessage() {}
 
-func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
+func (x *GetPortfolioValuationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2612,76 +2606,36 @@ func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPortfolioAddressRequest.ProtoReflect.Descriptor instead.
-func (*AddPortfolioAddressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPortfolioValuationRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioValuationRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *AddPortfolioAddressRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-func (x *AddPortfolioAddressRequest) GetCoinType() string {
-	if x != nil {
-		return x.CoinType
-	}
-	return ""
-}
-
-func (x *AddPortfolioAddressRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *AddPortfolioAddressRequest) GetBalance() float64 {
-	if x != nil {
-		return x.Balance
-	}
-	return 0
-}
-
-func (x *AddPortfolioAddressRequest) GetSupportedExchanges() string {
-	if x != nil {
-		return x.SupportedExchanges
-	}
-	return ""
-}
-
-func (x *AddPortfolioAddressRequest) GetColdStorage() bool {
-	if x != nil {
-		return x.ColdStorage
-	}
-	return false
-}
-
-type RemovePortfolioAddressRequest struct {
+type PortfolioValuationHolding struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	CoinType      string                 `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance       float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemovePortfolioAddressRequest) Reset() {
-	*x = RemovePortfolioAddressRequest{}
+func (x *PortfolioValuationHolding) Reset() {
+	*x = PortfolioValuationHolding{}
 	mi := &file_rpc_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemovePortfolioAddressRequest) String() string {
+func (x *PortfolioValuationHolding) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemovePortfolioAddressRequest) ProtoMessage() {}
+func (*PortfolioValuationHolding) ProtoMessage() {}
 
-func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
+func (x *PortfolioValuationHolding) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2693,52 +2647,68 @@ func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemovePortfolioAddressRequest.ProtoReflect.Descriptor instead.
-func (*RemovePortfolioAddressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortfolioValuationHolding.ProtoReflect.Descriptor instead.
+func (*PortfolioValuationHolding) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *RemovePortfolioAddressRequest) GetAddress() string {
+func (x *PortfolioValuationHolding) GetExchange() string {
 	if x != nil {
-		return x.Address
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *RemovePortfolioAddressRequest) GetCoinType() string {
+func (x *PortfolioValuationHolding) GetCurrency() string {
 	if x != nil {
-		return x.CoinType
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *RemovePortfolioAddressRequest) GetDescription() string {
+func (x *PortfolioValuationHolding) GetBalance() float64 {
 	if x != nil {
-		return x.Description
+		return x.Balance
 	}
-	return ""
+	return 0
 }
 
-type GetForexProvidersRequest struct {
+func (x *PortfolioValuationHolding) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PortfolioValuationHolding) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type PortfolioExchangeValuation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetForexProvidersRequest) Reset() {
-	*x = GetForexProvidersRequest{}
+func (x *PortfolioExchangeValuation) Reset() {
+	*x = PortfolioExchangeValuation{}
 	mi := &file_rpc_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForexProvidersRequest) String() string {
+func (x *PortfolioExchangeValuation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForexProvidersRequest) ProtoMessage() {}
+func (*PortfolioExchangeValuation) ProtoMessage() {}
 
-func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
+func (x *PortfolioExchangeValuation) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2750,38 +2720,50 @@ func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForexProvidersRequest.ProtoReflect.Descriptor instead.
-func (*GetForexProvidersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortfolioExchangeValuation.ProtoReflect.Descriptor instead.
+func (*PortfolioExchangeValuation) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{50}
 }
 
-type ForexProvider struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Enabled          bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	Verbose          bool                   `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
-	RestPollingDelay string                 `protobuf:"bytes,4,opt,name=rest_polling_delay,json=restPollingDelay,proto3" json:"rest_polling_delay,omitempty"`
-	ApiKey           string                 `protobuf:"bytes,5,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
-	ApiKeyLevel      int64                  `protobuf:"varint,6,opt,name=api_key_level,json=apiKeyLevel,proto3" json:"api_key_level,omitempty"`
-	PrimaryProvider  bool                   `protobuf:"varint,7,opt,name=primary_provider,json=primaryProvider,proto3" json:"primary_provider,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *PortfolioExchangeValuation) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
 }
 
-func (x *ForexProvider) Reset() {
-	*x = ForexProvider{}
+func (x *PortfolioExchangeValuation) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type PortfolioValuation struct {
+	state         protoimpl.MessageState        `protogen:"open.v1"`
+	Timestamp     string                        `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	FiatCurrency  string                        `protobuf:"bytes,2,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	TotalValue    float64                       `protobuf:"fixed64,3,opt,name=total_value,json=totalValue,proto3" json:"total_value,omitempty"`
+	Exchanges     []*PortfolioExchangeValuation `protobuf:"bytes,4,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	Holdings      []*PortfolioValuationHolding  `protobuf:"bytes,5,rep,name=holdings,proto3" json:"holdings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PortfolioValuation) Reset() {
+	*x = PortfolioValuation{}
 	mi := &file_rpc_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForexProvider) String() string {
+func (x *PortfolioValuation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForexProvider) ProtoMessage() {}
+func (*PortfolioValuation) ProtoMessage() {}
 
-func (x *ForexProvider) ProtoReflect() protoreflect.Message {
+func (x *PortfolioValuation) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2793,81 +2775,68 @@ func (x *ForexProvider) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForexProvider.ProtoReflect.Descriptor instead.
-func (*ForexProvider) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortfolioValuation.ProtoReflect.Descriptor instead.
+func (*PortfolioValuation) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ForexProvider) GetName() string {
+func (x *PortfolioValuation) GetTimestamp() string {
 	if x != nil {
-		return x.Name
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *ForexProvider) GetEnabled() bool {
-	if x != nil {
-		return x.Enabled
-	}
-	return false
-}
-
-func (x *ForexProvider) GetVerbose() bool {
-	if x != nil {
-		return x.Verbose
-	}
-	return false
-}
-
-func (x *ForexProvider) GetRestPollingDelay() string {
+func (x *PortfolioValuation) GetFiatCurrency() string {
 	if x != nil {
-		return x.RestPollingDelay
+		return x.FiatCurrency
 	}
 	return ""
 }
 
-func (x *ForexProvider) GetApiKey() string {
+func (x *PortfolioValuation) GetTotalValue() float64 {
 	if x != nil {
-		return x.ApiKey
+		return x.TotalValue
 	}
-	return ""
+	return 0
 }
 
-func (x *ForexProvider) GetApiKeyLevel() int64 {
+func (x *PortfolioValuation) GetExchanges() []*PortfolioExchangeValuation {
 	if x != nil {
-		return x.ApiKeyLevel
+		return x.Exchanges
 	}
-	return 0
+	return nil
 }
 
-func (x *ForexProvider) GetPrimaryProvider() bool {
+func (x *PortfolioValuation) GetHoldings() []*PortfolioValuationHolding {
 	if x != nil {
-		return x.PrimaryProvider
+		return x.Holdings
 	}
-	return false
+	return nil
 }
 
-type GetForexProvidersResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ForexProviders []*ForexProvider       `protobuf:"bytes,1,rep,name=forex_providers,json=forexProviders,proto3" json:"forex_providers,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetPortfolioValuationHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Start         string                 `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetForexProvidersResponse) Reset() {
-	*x = GetForexProvidersResponse{}
+func (x *GetPortfolioValuationHistoryRequest) Reset() {
+	*x = GetPortfolioValuationHistoryRequest{}
 	mi := &file_rpc_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForexProvidersResponse) String() string {
+func (x *GetPortfolioValuationHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForexProvidersResponse) ProtoMessage() {}
+func (*GetPortfolioValuationHistoryRequest) ProtoMessage() {}
 
-func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetPortfolioValuationHistoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2879,38 +2848,49 @@ func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForexProvidersResponse.ProtoReflect.Descriptor instead.
-func (*GetForexProvidersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPortfolioValuationHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetPortfolioValuationHistoryRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GetForexProvidersResponse) GetForexProviders() []*ForexProvider {
+func (x *GetPortfolioValuationHistoryRequest) GetStart() string {
 	if x != nil {
-		return x.ForexProviders
+		return x.Start
 	}
-	return nil
+	return ""
 }
 
-type GetForexRatesRequest struct {
+func (x *GetPortfolioValuationHistoryRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type PortfolioCurrencyValueChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartValue    float64                `protobuf:"fixed64,2,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue      float64                `protobuf:"fixed64,3,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
+	Change        float64                `protobuf:"fixed64,4,opt,name=change,proto3" json:"change,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetForexRatesRequest) Reset() {
-	*x = GetForexRatesRequest{}
+func (x *PortfolioCurrencyValueChange) Reset() {
+	*x = PortfolioCurrencyValueChange{}
 	mi := &file_rpc_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetForexRatesRequest) String() string {
+func (x *PortfolioCurrencyValueChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetForexRatesRequest) ProtoMessage() {}
+func (*PortfolioCurrencyValueChange) ProtoMessage() {}
 
-func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
+func (x *PortfolioCurrencyValueChange) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2922,35 +2902,66 @@ func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetForexRatesRequest.ProtoReflect.Descriptor instead.
-func (*GetForexRatesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PortfolioCurrencyValueChange.ProtoReflect.Descriptor instead.
+func (*PortfolioCurrencyValueChange) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{53}
 }
 
-type ForexRatesConversion struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
-	InverseRate   float64                `protobuf:"fixed64,4,opt,name=inverse_rate,json=inverseRate,proto3" json:"inverse_rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PortfolioCurrencyValueChange) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *ForexRatesConversion) Reset() {
-	*x = ForexRatesConversion{}
+func (x *PortfolioCurrencyValueChange) GetStartValue() float64 {
+	if x != nil {
+		return x.StartValue
+	}
+	return 0
+}
+
+func (x *PortfolioCurrencyValueChange) GetEndValue() float64 {
+	if x != nil {
+		return x.EndValue
+	}
+	return 0
+}
+
+func (x *PortfolioCurrencyValueChange) GetChange() float64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+type GetPortfolioValuationHistoryResponse struct {
+	state                 protoimpl.MessageState          `protogen:"open.v1"`
+	Valuations            []*PortfolioValuation           `protobuf:"bytes,1,rep,name=valuations,proto3" json:"valuations,omitempty"`
+	StartValue            float64                         `protobuf:"fixed64,2,opt,name=start_value,json=startValue,proto3" json:"start_value,omitempty"`
+	EndValue              float64                         `protobuf:"fixed64,3,opt,name=end_value,json=endValue,proto3" json:"end_value,omitempty"`
+	Change                float64                         `protobuf:"fixed64,4,opt,name=change,proto3" json:"change,omitempty"`
+	MaxDrawdown           float64                         `protobuf:"fixed64,5,opt,name=max_drawdown,json=maxDrawdown,proto3" json:"max_drawdown,omitempty"`
+	MaxDrawdownPercentage float64                         `protobuf:"fixed64,6,opt,name=max_drawdown_percentage,json=maxDrawdownPercentage,proto3" json:"max_drawdown_percentage,omitempty"`
+	CurrencyChanges       []*PortfolioCurrencyValueChange `protobuf:"bytes,7,rep,name=currency_changes,json=currencyChanges,proto3" json:"currency_changes,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetPortfolioValuationHistoryResponse) Reset() {
+	*x = GetPortfolioValuationHistoryResponse{}
 	mi := &file_rpc_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForexRatesConversion) String() string {
+func (x *GetPortfolioValuationHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForexRatesConversion) ProtoMessage() {}
+func (*GetPortfolioValuationHistoryResponse) ProtoMessage() {}
 
-func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
+func (x *GetPortfolioValuationHistoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2962,122 +2973,85 @@ func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForexRatesConversion.ProtoReflect.Descriptor instead.
-func (*ForexRatesConversion) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPortfolioValuationHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetPortfolioValuationHistoryResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ForexRatesConversion) GetFrom() string {
+func (x *GetPortfolioValuationHistoryResponse) GetValuations() []*PortfolioValuation {
 	if x != nil {
-		return x.From
+		return x.Valuations
 	}
-	return ""
+	return nil
 }
 
-func (x *ForexRatesConversion) GetTo() string {
+func (x *GetPortfolioValuationHistoryResponse) GetStartValue() float64 {
 	if x != nil {
-		return x.To
+		return x.StartValue
 	}
-	return ""
+	return 0
 }
 
-func (x *ForexRatesConversion) GetRate() float64 {
+func (x *GetPortfolioValuationHistoryResponse) GetEndValue() float64 {
 	if x != nil {
-		return x.Rate
+		return x.EndValue
 	}
 	return 0
 }
 
-func (x *ForexRatesConversion) GetInverseRate() float64 {
+func (x *GetPortfolioValuationHistoryResponse) GetChange() float64 {
 	if x != nil {
-		return x.InverseRate
+		return x.Change
 	}
 	return 0
 }
 
-type GetForexRatesResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	ForexRates    []*ForexRatesConversion `protobuf:"bytes,1,rep,name=forex_rates,json=forexRates,proto3" json:"forex_rates,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetForexRatesResponse) Reset() {
-	*x = GetForexRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[55]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetForexRatesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetForexRatesResponse) ProtoMessage() {}
-
-func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[55]
+func (x *GetPortfolioValuationHistoryResponse) GetMaxDrawdown() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaxDrawdown
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetForexRatesResponse.ProtoReflect.Descriptor instead.
-func (*GetForexRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{55}
+func (x *GetPortfolioValuationHistoryResponse) GetMaxDrawdownPercentage() float64 {
+	if x != nil {
+		return x.MaxDrawdownPercentage
+	}
+	return 0
 }
 
-func (x *GetForexRatesResponse) GetForexRates() []*ForexRatesConversion {
+func (x *GetPortfolioValuationHistoryResponse) GetCurrencyChanges() []*PortfolioCurrencyValueChange {
 	if x != nil {
-		return x.ForexRates
+		return x.CurrencyChanges
 	}
 	return nil
 }
 
-type OrderDetails struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	ClientOrderId  string                 `protobuf:"bytes,3,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
-	BaseCurrency   string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
-	QuoteCurrency  string                 `protobuf:"bytes,5,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
-	AssetType      string                 `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	OrderType      string                 `protobuf:"bytes,8,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
-	CreationTime   string                 `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	UpdateTime     string                 `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
-	Status         string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
-	Price          float64                `protobuf:"fixed64,12,opt,name=price,proto3" json:"price,omitempty"`
-	Amount         float64                `protobuf:"fixed64,13,opt,name=amount,proto3" json:"amount,omitempty"`
-	OpenVolume     float64                `protobuf:"fixed64,14,opt,name=open_volume,json=openVolume,proto3" json:"open_volume,omitempty"`
-	Fee            float64                `protobuf:"fixed64,15,opt,name=fee,proto3" json:"fee,omitempty"`
-	Cost           float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
-	Trades         []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
-	ContractAmount float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetCostBasisReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchanges     []string               `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderDetails) Reset() {
-	*x = OrderDetails{}
-	mi := &file_rpc_proto_msgTypes[56]
+func (x *GetCostBasisReportRequest) Reset() {
+	*x = GetCostBasisReportRequest{}
+	mi := &file_rpc_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderDetails) String() string {
+func (x *GetCostBasisReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderDetails) ProtoMessage() {}
+func (*GetCostBasisReportRequest) ProtoMessage() {}
 
-func (x *OrderDetails) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[56]
+func (x *GetCostBasisReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3088,166 +3062,191 @@ func (x *OrderDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderDetails.ProtoReflect.Descriptor instead.
-func (*OrderDetails) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetCostBasisReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCostBasisReportRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *OrderDetails) GetExchange() string {
+func (x *GetCostBasisReportRequest) GetExchanges() []string {
 	if x != nil {
-		return x.Exchange
+		return x.Exchanges
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderDetails) GetId() string {
+func (x *GetCostBasisReportRequest) GetMethod() string {
 	if x != nil {
-		return x.Id
+		return x.Method
 	}
 	return ""
 }
 
-func (x *OrderDetails) GetClientOrderId() string {
+func (x *GetCostBasisReportRequest) GetStart() string {
 	if x != nil {
-		return x.ClientOrderId
+		return x.Start
 	}
 	return ""
 }
 
-func (x *OrderDetails) GetBaseCurrency() string {
+func (x *GetCostBasisReportRequest) GetEnd() string {
 	if x != nil {
-		return x.BaseCurrency
+		return x.End
 	}
 	return ""
 }
 
-func (x *OrderDetails) GetQuoteCurrency() string {
-	if x != nil {
-		return x.QuoteCurrency
-	}
-	return ""
+type CostBasisGain struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Acquired      string                 `protobuf:"bytes,4,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	Disposed      string                 `protobuf:"bytes,5,opt,name=disposed,proto3" json:"disposed,omitempty"`
+	Proceeds      float64                `protobuf:"fixed64,6,opt,name=proceeds,proto3" json:"proceeds,omitempty"`
+	CostBasis     float64                `protobuf:"fixed64,7,opt,name=cost_basis,json=costBasis,proto3" json:"cost_basis,omitempty"`
+	Gain          float64                `protobuf:"fixed64,8,opt,name=gain,proto3" json:"gain,omitempty"`
+	LotId         int64                  `protobuf:"varint,9,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	Unmatched     bool                   `protobuf:"varint,10,opt,name=unmatched,proto3" json:"unmatched,omitempty"`
+	Reference     string                 `protobuf:"bytes,11,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderDetails) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+func (x *CostBasisGain) Reset() {
+	*x = CostBasisGain{}
+	mi := &file_rpc_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderDetails) GetOrderSide() string {
+func (x *CostBasisGain) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CostBasisGain) ProtoMessage() {}
+
+func (x *CostBasisGain) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[56]
 	if x != nil {
-		return x.OrderSide
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *OrderDetails) GetOrderType() string {
+// Deprecated: Use CostBasisGain.ProtoReflect.Descriptor instead.
+func (*CostBasisGain) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *CostBasisGain) GetExchange() string {
 	if x != nil {
-		return x.OrderType
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *OrderDetails) GetCreationTime() string {
+func (x *CostBasisGain) GetCurrency() string {
 	if x != nil {
-		return x.CreationTime
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *OrderDetails) GetUpdateTime() string {
+func (x *CostBasisGain) GetAmount() float64 {
 	if x != nil {
-		return x.UpdateTime
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *OrderDetails) GetStatus() string {
+func (x *CostBasisGain) GetAcquired() string {
 	if x != nil {
-		return x.Status
+		return x.Acquired
 	}
 	return ""
 }
 
-func (x *OrderDetails) GetPrice() float64 {
+func (x *CostBasisGain) GetDisposed() string {
 	if x != nil {
-		return x.Price
+		return x.Disposed
 	}
-	return 0
+	return ""
 }
 
-func (x *OrderDetails) GetAmount() float64 {
+func (x *CostBasisGain) GetProceeds() float64 {
 	if x != nil {
-		return x.Amount
+		return x.Proceeds
 	}
 	return 0
 }
 
-func (x *OrderDetails) GetOpenVolume() float64 {
+func (x *CostBasisGain) GetCostBasis() float64 {
 	if x != nil {
-		return x.OpenVolume
+		return x.CostBasis
 	}
 	return 0
 }
 
-func (x *OrderDetails) GetFee() float64 {
+func (x *CostBasisGain) GetGain() float64 {
 	if x != nil {
-		return x.Fee
+		return x.Gain
 	}
 	return 0
 }
 
-func (x *OrderDetails) GetCost() float64 {
+func (x *CostBasisGain) GetLotId() int64 {
 	if x != nil {
-		return x.Cost
+		return x.LotId
 	}
 	return 0
 }
 
-func (x *OrderDetails) GetTrades() []*TradeHistory {
+func (x *CostBasisGain) GetUnmatched() bool {
 	if x != nil {
-		return x.Trades
+		return x.Unmatched
 	}
-	return nil
+	return false
 }
 
-func (x *OrderDetails) GetContractAmount() float64 {
+func (x *CostBasisGain) GetReference() string {
 	if x != nil {
-		return x.ContractAmount
+		return x.Reference
 	}
-	return 0
+	return ""
 }
 
-type TradeHistory struct {
+type CostBasisLot struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreationTime  int64                  `protobuf:"varint,1,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Exchange      string                 `protobuf:"bytes,5,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType     string                 `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	OrderSide     string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	Fee           float64                `protobuf:"fixed64,8,opt,name=fee,proto3" json:"fee,omitempty"`
-	Total         float64                `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Acquired      string                 `protobuf:"bytes,4,opt,name=acquired,proto3" json:"acquired,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Remaining     float64                `protobuf:"fixed64,6,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	UnitCost      float64                `protobuf:"fixed64,7,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	Reference     string                 `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TradeHistory) Reset() {
-	*x = TradeHistory{}
+func (x *CostBasisLot) Reset() {
+	*x = CostBasisLot{}
 	mi := &file_rpc_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TradeHistory) String() string {
+func (x *CostBasisLot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TradeHistory) ProtoMessage() {}
+func (*CostBasisLot) ProtoMessage() {}
 
-func (x *TradeHistory) ProtoReflect() protoreflect.Message {
+func (x *CostBasisLot) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3259,99 +3258,94 @@ func (x *TradeHistory) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TradeHistory.ProtoReflect.Descriptor instead.
-func (*TradeHistory) Descriptor() ([]byte, []int) {
+// Deprecated: Use CostBasisLot.ProtoReflect.Descriptor instead.
+func (*CostBasisLot) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *TradeHistory) GetCreationTime() int64 {
+func (x *CostBasisLot) GetId() int64 {
 	if x != nil {
-		return x.CreationTime
+		return x.Id
 	}
 	return 0
 }
 
-func (x *TradeHistory) GetId() string {
+func (x *CostBasisLot) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *TradeHistory) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *TradeHistory) GetAmount() float64 {
+func (x *CostBasisLot) GetCurrency() string {
 	if x != nil {
-		return x.Amount
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *TradeHistory) GetExchange() string {
+func (x *CostBasisLot) GetAcquired() string {
 	if x != nil {
-		return x.Exchange
+		return x.Acquired
 	}
 	return ""
 }
 
-func (x *TradeHistory) GetAssetType() string {
+func (x *CostBasisLot) GetAmount() float64 {
 	if x != nil {
-		return x.AssetType
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *TradeHistory) GetOrderSide() string {
+func (x *CostBasisLot) GetRemaining() float64 {
 	if x != nil {
-		return x.OrderSide
+		return x.Remaining
 	}
-	return ""
+	return 0
 }
 
-func (x *TradeHistory) GetFee() float64 {
+func (x *CostBasisLot) GetUnitCost() float64 {
 	if x != nil {
-		return x.Fee
+		return x.UnitCost
 	}
 	return 0
 }
 
-func (x *TradeHistory) GetTotal() float64 {
+func (x *CostBasisLot) GetReference() string {
 	if x != nil {
-		return x.Total
+		return x.Reference
 	}
-	return 0
+	return ""
 }
 
-type GetOrdersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCostBasisReportResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Method         string                 `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	FiatCurrency   string                 `protobuf:"bytes,2,opt,name=fiat_currency,json=fiatCurrency,proto3" json:"fiat_currency,omitempty"`
+	Gains          []*CostBasisGain       `protobuf:"bytes,3,rep,name=gains,proto3" json:"gains,omitempty"`
+	Lots           []*CostBasisLot        `protobuf:"bytes,4,rep,name=lots,proto3" json:"lots,omitempty"`
+	TotalProceeds  float64                `protobuf:"fixed64,5,opt,name=total_proceeds,json=totalProceeds,proto3" json:"total_proceeds,omitempty"`
+	TotalCostBasis float64                `protobuf:"fixed64,6,opt,name=total_cost_basis,json=totalCostBasis,proto3" json:"total_cost_basis,omitempty"`
+	TotalGain      float64                `protobuf:"fixed64,7,opt,name=total_gain,json=totalGain,proto3" json:"total_gain,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetOrdersRequest) Reset() {
-	*x = GetOrdersRequest{}
+func (x *GetCostBasisReportResponse) Reset() {
+	*x = GetCostBasisReportResponse{}
 	mi := &file_rpc_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersRequest) String() string {
+func (x *GetCostBasisReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersRequest) ProtoMessage() {}
+func (*GetCostBasisReportResponse) ProtoMessage() {}
 
-func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCostBasisReportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3363,67 +3357,86 @@ func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCostBasisReportResponse.ProtoReflect.Descriptor instead.
+func (*GetCostBasisReportResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *GetOrdersRequest) GetExchange() string {
+func (x *GetCostBasisReportResponse) GetMethod() string {
 	if x != nil {
-		return x.Exchange
+		return x.Method
 	}
 	return ""
 }
 
-func (x *GetOrdersRequest) GetAssetType() string {
+func (x *GetCostBasisReportResponse) GetFiatCurrency() string {
 	if x != nil {
-		return x.AssetType
+		return x.FiatCurrency
 	}
 	return ""
 }
 
-func (x *GetOrdersRequest) GetPair() *CurrencyPair {
+func (x *GetCostBasisReportResponse) GetGains() []*CostBasisGain {
 	if x != nil {
-		return x.Pair
+		return x.Gains
 	}
 	return nil
 }
 
-func (x *GetOrdersRequest) GetStartDate() string {
+func (x *GetCostBasisReportResponse) GetLots() []*CostBasisLot {
 	if x != nil {
-		return x.StartDate
+		return x.Lots
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrdersRequest) GetEndDate() string {
+func (x *GetCostBasisReportResponse) GetTotalProceeds() float64 {
 	if x != nil {
-		return x.EndDate
+		return x.TotalProceeds
 	}
-	return ""
+	return 0
 }
 
-type GetOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*OrderDetails        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetCostBasisReportResponse) GetTotalCostBasis() float64 {
+	if x != nil {
+		return x.TotalCostBasis
+	}
+	return 0
 }
 
-func (x *GetOrdersResponse) Reset() {
-	*x = GetOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[59]
+func (x *GetCostBasisReportResponse) GetTotalGain() float64 {
+	if x != nil {
+		return x.TotalGain
+	}
+	return 0
+}
+
+type AddPortfolioAddressRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Address            string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CoinType           string                 `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Description        string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Balance            float64                `protobuf:"fixed64,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	SupportedExchanges string                 `protobuf:"bytes,5,opt,name=supported_exchanges,json=supportedExchanges,proto3" json:"supported_exchanges,omitempty"`
+	ColdStorage        bool                   `protobuf:"varint,6,opt,name=cold_storage,json=coldStorage,proto3" json:"cold_storage,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *AddPortfolioAddressRequest) Reset() {
+	*x = AddPortfolioAddressRequest{}
+	mi := &file_rpc_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersResponse) String() string {
+func (x *AddPortfolioAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersResponse) ProtoMessage() {}
+func (*AddPortfolioAddressRequest) ProtoMessage() {}
 
-func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
+func (x *AddPortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3435,42 +3448,76 @@ func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddPortfolioAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddPortfolioAddressRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GetOrdersResponse) GetOrders() []*OrderDetails {
+func (x *AddPortfolioAddressRequest) GetAddress() string {
 	if x != nil {
-		return x.Orders
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-type GetOrderRequest struct {
+func (x *AddPortfolioAddressRequest) GetCoinType() string {
+	if x != nil {
+		return x.CoinType
+	}
+	return ""
+}
+
+func (x *AddPortfolioAddressRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AddPortfolioAddressRequest) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *AddPortfolioAddressRequest) GetSupportedExchanges() string {
+	if x != nil {
+		return x.SupportedExchanges
+	}
+	return ""
+}
+
+func (x *AddPortfolioAddressRequest) GetColdStorage() bool {
+	if x != nil {
+		return x.ColdStorage
+	}
+	return false
+}
+
+type RemovePortfolioAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	CoinType      string                 `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderRequest) Reset() {
-	*x = GetOrderRequest{}
+func (x *RemovePortfolioAddressRequest) Reset() {
+	*x = RemovePortfolioAddressRequest{}
 	mi := &file_rpc_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderRequest) String() string {
+func (x *RemovePortfolioAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderRequest) ProtoMessage() {}
+func (*RemovePortfolioAddressRequest) ProtoMessage() {}
 
-func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *RemovePortfolioAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3482,68 +3529,52 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemovePortfolioAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemovePortfolioAddressRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GetOrderRequest) GetExchange() string {
+func (x *RemovePortfolioAddressRequest) GetAddress() string {
 	if x != nil {
-		return x.Exchange
+		return x.Address
 	}
 	return ""
 }
 
-func (x *GetOrderRequest) GetOrderId() string {
+func (x *RemovePortfolioAddressRequest) GetCoinType() string {
 	if x != nil {
-		return x.OrderId
+		return x.CoinType
 	}
 	return ""
 }
 
-func (x *GetOrderRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetOrderRequest) GetAsset() string {
+func (x *RemovePortfolioAddressRequest) GetDescription() string {
 	if x != nil {
-		return x.Asset
+		return x.Description
 	}
 	return ""
 }
 
-type SubmitOrderRequest struct {
+type GetForexProvidersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
-	OrderType     string                 `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
-	ClientId      string                 `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	AssetType     string                 `protobuf:"bytes,8,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	MarginType    string                 `protobuf:"bytes,9,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitOrderRequest) Reset() {
-	*x = SubmitOrderRequest{}
+func (x *GetForexProvidersRequest) Reset() {
+	*x = GetForexProvidersRequest{}
 	mi := &file_rpc_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitOrderRequest) String() string {
+func (x *GetForexProvidersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitOrderRequest) ProtoMessage() {}
+func (*GetForexProvidersRequest) ProtoMessage() {}
 
-func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
+func (x *GetForexProvidersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3555,99 +3586,125 @@ func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
-func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetForexProvidersRequest.ProtoReflect.Descriptor instead.
+func (*GetForexProvidersRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SubmitOrderRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+type ForexProvider struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled          bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Verbose          bool                   `protobuf:"varint,3,opt,name=verbose,proto3" json:"verbose,omitempty"`
+	RestPollingDelay string                 `protobuf:"bytes,4,opt,name=rest_polling_delay,json=restPollingDelay,proto3" json:"rest_polling_delay,omitempty"`
+	ApiKey           string                 `protobuf:"bytes,5,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	ApiKeyLevel      int64                  `protobuf:"varint,6,opt,name=api_key_level,json=apiKeyLevel,proto3" json:"api_key_level,omitempty"`
+	PrimaryProvider  bool                   `protobuf:"varint,7,opt,name=primary_provider,json=primaryProvider,proto3" json:"primary_provider,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *SubmitOrderRequest) GetPair() *CurrencyPair {
+func (x *ForexProvider) Reset() {
+	*x = ForexProvider{}
+	mi := &file_rpc_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ForexProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForexProvider) ProtoMessage() {}
+
+func (x *ForexProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[62]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *SubmitOrderRequest) GetSide() string {
+// Deprecated: Use ForexProvider.ProtoReflect.Descriptor instead.
+func (*ForexProvider) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ForexProvider) GetName() string {
 	if x != nil {
-		return x.Side
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SubmitOrderRequest) GetOrderType() string {
+func (x *ForexProvider) GetEnabled() bool {
 	if x != nil {
-		return x.OrderType
+		return x.Enabled
 	}
-	return ""
+	return false
 }
 
-func (x *SubmitOrderRequest) GetAmount() float64 {
+func (x *ForexProvider) GetVerbose() bool {
 	if x != nil {
-		return x.Amount
+		return x.Verbose
 	}
-	return 0
+	return false
 }
 
-func (x *SubmitOrderRequest) GetPrice() float64 {
+func (x *ForexProvider) GetRestPollingDelay() string {
 	if x != nil {
-		return x.Price
+		return x.RestPollingDelay
 	}
-	return 0
+	return ""
 }
 
-func (x *SubmitOrderRequest) GetClientId() string {
+func (x *ForexProvider) GetApiKey() string {
 	if x != nil {
-		return x.ClientId
+		return x.ApiKey
 	}
 	return ""
 }
 
-func (x *SubmitOrderRequest) GetAssetType() string {
+func (x *ForexProvider) GetApiKeyLevel() int64 {
 	if x != nil {
-		return x.AssetType
+		return x.ApiKeyLevel
 	}
-	return ""
+	return 0
 }
 
-func (x *SubmitOrderRequest) GetMarginType() string {
+func (x *ForexProvider) GetPrimaryProvider() bool {
 	if x != nil {
-		return x.MarginType
+		return x.PrimaryProvider
 	}
-	return ""
+	return false
 }
 
-type Trades struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
-	FeeAsset      string                 `protobuf:"bytes,4,opt,name=fee_asset,json=feeAsset,proto3" json:"fee_asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetForexProvidersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ForexProviders []*ForexProvider       `protobuf:"bytes,1,rep,name=forex_providers,json=forexProviders,proto3" json:"forex_providers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Trades) Reset() {
-	*x = Trades{}
-	mi := &file_rpc_proto_msgTypes[62]
+func (x *GetForexProvidersResponse) Reset() {
+	*x = GetForexProvidersResponse{}
+	mi := &file_rpc_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Trades) String() string {
+func (x *GetForexProvidersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Trades) ProtoMessage() {}
+func (*GetForexProvidersResponse) ProtoMessage() {}
 
-func (x *Trades) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[62]
+func (x *GetForexProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3658,63 +3715,39 @@ func (x *Trades) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Trades.ProtoReflect.Descriptor instead.
-func (*Trades) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetForexProvidersResponse.ProtoReflect.Descriptor instead.
+func (*GetForexProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Trades) GetAmount() float64 {
+func (x *GetForexProvidersResponse) GetForexProviders() []*ForexProvider {
 	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *Trades) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-func (x *Trades) GetFee() float64 {
-	if x != nil {
-		return x.Fee
-	}
-	return 0
-}
-
-func (x *Trades) GetFeeAsset() string {
-	if x != nil {
-		return x.FeeAsset
+		return x.ForexProviders
 	}
-	return ""
+	return nil
 }
 
-type SubmitOrderResponse struct {
+type GetForexRatesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderPlaced   bool                   `protobuf:"varint,1,opt,name=order_placed,json=orderPlaced,proto3" json:"order_placed,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Trades        []*Trades              `protobuf:"bytes,3,rep,name=trades,proto3" json:"trades,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubmitOrderResponse) Reset() {
-	*x = SubmitOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[63]
+func (x *GetForexRatesRequest) Reset() {
+	*x = GetForexRatesRequest{}
+	mi := &file_rpc_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubmitOrderResponse) String() string {
+func (x *GetForexRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubmitOrderResponse) ProtoMessage() {}
+func (*GetForexRatesRequest) ProtoMessage() {}
 
-func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[63]
+func (x *GetForexRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3725,58 +3758,36 @@ func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
-func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *SubmitOrderResponse) GetOrderPlaced() bool {
-	if x != nil {
-		return x.OrderPlaced
-	}
-	return false
-}
-
-func (x *SubmitOrderResponse) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
-}
-
-func (x *SubmitOrderResponse) GetTrades() []*Trades {
-	if x != nil {
-		return x.Trades
-	}
-	return nil
+// Deprecated: Use GetForexRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetForexRatesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{64}
 }
 
-type SimulateOrderRequest struct {
+type ForexRatesConversion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Side          string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
-	MarginType    string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Rate          float64                `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	InverseRate   float64                `protobuf:"fixed64,4,opt,name=inverse_rate,json=inverseRate,proto3" json:"inverse_rate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateOrderRequest) Reset() {
-	*x = SimulateOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[64]
+func (x *ForexRatesConversion) Reset() {
+	*x = ForexRatesConversion{}
+	mi := &file_rpc_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateOrderRequest) String() string {
+func (x *ForexRatesConversion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateOrderRequest) ProtoMessage() {}
+func (*ForexRatesConversion) ProtoMessage() {}
 
-func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[64]
+func (x *ForexRatesConversion) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3787,73 +3798,61 @@ func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateOrderRequest.ProtoReflect.Descriptor instead.
-func (*SimulateOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ForexRatesConversion.ProtoReflect.Descriptor instead.
+func (*ForexRatesConversion) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *SimulateOrderRequest) GetExchange() string {
+func (x *ForexRatesConversion) GetFrom() string {
 	if x != nil {
-		return x.Exchange
+		return x.From
 	}
 	return ""
 }
 
-func (x *SimulateOrderRequest) GetPair() *CurrencyPair {
+func (x *ForexRatesConversion) GetTo() string {
 	if x != nil {
-		return x.Pair
+		return x.To
 	}
-	return nil
+	return ""
 }
 
-func (x *SimulateOrderRequest) GetAmount() float64 {
+func (x *ForexRatesConversion) GetRate() float64 {
 	if x != nil {
-		return x.Amount
+		return x.Rate
 	}
 	return 0
 }
 
-func (x *SimulateOrderRequest) GetSide() string {
-	if x != nil {
-		return x.Side
-	}
-	return ""
-}
-
-func (x *SimulateOrderRequest) GetMarginType() string {
+func (x *ForexRatesConversion) GetInverseRate() float64 {
 	if x != nil {
-		return x.MarginType
+		return x.InverseRate
 	}
-	return ""
+	return 0
 }
 
-type SimulateOrderResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Orders             []*OrderbookItem       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Amount             float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	MinimumPrice       float64                `protobuf:"fixed64,3,opt,name=minimum_price,json=minimumPrice,proto3" json:"minimum_price,omitempty"`
-	MaximumPrice       float64                `protobuf:"fixed64,4,opt,name=maximum_price,json=maximumPrice,proto3" json:"maximum_price,omitempty"`
-	PercentageGainLoss float64                `protobuf:"fixed64,5,opt,name=percentage_gain_loss,json=percentageGainLoss,proto3" json:"percentage_gain_loss,omitempty"`
-	Status             string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetForexRatesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ForexRates    []*ForexRatesConversion `protobuf:"bytes,1,rep,name=forex_rates,json=forexRates,proto3" json:"forex_rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SimulateOrderResponse) Reset() {
-	*x = SimulateOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[65]
+func (x *GetForexRatesResponse) Reset() {
+	*x = GetForexRatesResponse{}
+	mi := &file_rpc_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SimulateOrderResponse) String() string {
+func (x *GetForexRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimulateOrderResponse) ProtoMessage() {}
+func (*GetForexRatesResponse) ProtoMessage() {}
 
-func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[65]
+func (x *GetForexRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3864,79 +3863,57 @@ func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimulateOrderResponse.ProtoReflect.Descriptor instead.
-func (*SimulateOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use GetForexRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetForexRatesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *SimulateOrderResponse) GetOrders() []*OrderbookItem {
+func (x *GetForexRatesResponse) GetForexRates() []*ForexRatesConversion {
 	if x != nil {
-		return x.Orders
+		return x.ForexRates
 	}
 	return nil
 }
 
-func (x *SimulateOrderResponse) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *SimulateOrderResponse) GetMinimumPrice() float64 {
-	if x != nil {
-		return x.MinimumPrice
-	}
-	return 0
-}
-
-func (x *SimulateOrderResponse) GetMaximumPrice() float64 {
-	if x != nil {
-		return x.MaximumPrice
-	}
-	return 0
-}
-
-func (x *SimulateOrderResponse) GetPercentageGainLoss() float64 {
-	if x != nil {
-		return x.PercentageGainLoss
-	}
-	return 0
-}
-
-func (x *SimulateOrderResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-type WhaleBombRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	PriceTarget   float64                `protobuf:"fixed64,3,opt,name=price_target,json=priceTarget,proto3" json:"price_target,omitempty"`
-	Side          string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
-	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type OrderDetails struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	ClientOrderId  string                 `protobuf:"bytes,3,opt,name=client_order_id,json=clientOrderId,proto3" json:"client_order_id,omitempty"`
+	BaseCurrency   string                 `protobuf:"bytes,4,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	QuoteCurrency  string                 `protobuf:"bytes,5,opt,name=quote_currency,json=quoteCurrency,proto3" json:"quote_currency,omitempty"`
+	AssetType      string                 `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	OrderType      string                 `protobuf:"bytes,8,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	CreationTime   string                 `protobuf:"bytes,9,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	UpdateTime     string                 `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	Status         string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"`
+	Price          float64                `protobuf:"fixed64,12,opt,name=price,proto3" json:"price,omitempty"`
+	Amount         float64                `protobuf:"fixed64,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	OpenVolume     float64                `protobuf:"fixed64,14,opt,name=open_volume,json=openVolume,proto3" json:"open_volume,omitempty"`
+	Fee            float64                `protobuf:"fixed64,15,opt,name=fee,proto3" json:"fee,omitempty"`
+	Cost           float64                `protobuf:"fixed64,16,opt,name=cost,proto3" json:"cost,omitempty"`
+	Trades         []*TradeHistory        `protobuf:"bytes,17,rep,name=trades,proto3" json:"trades,omitempty"`
+	ContractAmount float64                `protobuf:"fixed64,18,opt,name=contract_amount,json=contractAmount,proto3" json:"contract_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *WhaleBombRequest) Reset() {
-	*x = WhaleBombRequest{}
-	mi := &file_rpc_proto_msgTypes[66]
+func (x *OrderDetails) Reset() {
+	*x = OrderDetails{}
+	mi := &file_rpc_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WhaleBombRequest) String() string {
+func (x *OrderDetails) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WhaleBombRequest) ProtoMessage() {}
+func (*OrderDetails) ProtoMessage() {}
 
-func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[66]
+func (x *OrderDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3947,156 +3924,166 @@ func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WhaleBombRequest.ProtoReflect.Descriptor instead.
-func (*WhaleBombRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use OrderDetails.ProtoReflect.Descriptor instead.
+func (*OrderDetails) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *WhaleBombRequest) GetExchange() string {
+func (x *OrderDetails) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WhaleBombRequest) GetPair() *CurrencyPair {
+func (x *OrderDetails) GetId() string {
 	if x != nil {
-		return x.Pair
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *WhaleBombRequest) GetPriceTarget() float64 {
+func (x *OrderDetails) GetClientOrderId() string {
 	if x != nil {
-		return x.PriceTarget
+		return x.ClientOrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *WhaleBombRequest) GetSide() string {
+func (x *OrderDetails) GetBaseCurrency() string {
 	if x != nil {
-		return x.Side
+		return x.BaseCurrency
 	}
 	return ""
 }
 
-func (x *WhaleBombRequest) GetAssetType() string {
+func (x *OrderDetails) GetQuoteCurrency() string {
+	if x != nil {
+		return x.QuoteCurrency
+	}
+	return ""
+}
+
+func (x *OrderDetails) GetAssetType() string {
 	if x != nil {
 		return x.AssetType
 	}
 	return ""
 }
 
-type CancelOrderRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *OrderDetails) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
 }
 
-func (x *CancelOrderRequest) Reset() {
-	*x = CancelOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[67]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *OrderDetails) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
 }
 
-func (x *CancelOrderRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *OrderDetails) GetCreationTime() string {
+	if x != nil {
+		return x.CreationTime
+	}
+	return ""
 }
 
-func (*CancelOrderRequest) ProtoMessage() {}
+func (x *OrderDetails) GetUpdateTime() string {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return ""
+}
 
-func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[67]
+func (x *OrderDetails) GetStatus() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Status
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
-func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{67}
+func (x *OrderDetails) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *CancelOrderRequest) GetExchange() string {
+func (x *OrderDetails) GetAmount() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *CancelOrderRequest) GetAccountId() string {
+func (x *OrderDetails) GetOpenVolume() float64 {
 	if x != nil {
-		return x.AccountId
+		return x.OpenVolume
 	}
-	return ""
+	return 0
 }
 
-func (x *CancelOrderRequest) GetOrderId() string {
+func (x *OrderDetails) GetFee() float64 {
 	if x != nil {
-		return x.OrderId
+		return x.Fee
 	}
-	return ""
+	return 0
 }
 
-func (x *CancelOrderRequest) GetPair() *CurrencyPair {
+func (x *OrderDetails) GetCost() float64 {
 	if x != nil {
-		return x.Pair
+		return x.Cost
 	}
-	return nil
+	return 0
 }
 
-func (x *CancelOrderRequest) GetAssetType() string {
+func (x *OrderDetails) GetTrades() []*TradeHistory {
 	if x != nil {
-		return x.AssetType
+		return x.Trades
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelOrderRequest) GetSide() string {
+func (x *OrderDetails) GetContractAmount() float64 {
 	if x != nil {
-		return x.Side
+		return x.ContractAmount
 	}
-	return ""
+	return 0
 }
 
-type CancelBatchOrdersRequest struct {
+type TradeHistory struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	OrdersId      string                 `protobuf:"bytes,3,opt,name=orders_id,json=ordersId,proto3" json:"orders_id,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	CreationTime  int64                  `protobuf:"varint,1,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Price         float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Exchange      string                 `protobuf:"bytes,5,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,6,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	OrderSide     string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	Fee           float64                `protobuf:"fixed64,8,opt,name=fee,proto3" json:"fee,omitempty"`
+	Total         float64                `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBatchOrdersRequest) Reset() {
-	*x = CancelBatchOrdersRequest{}
+func (x *TradeHistory) Reset() {
+	*x = TradeHistory{}
 	mi := &file_rpc_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBatchOrdersRequest) String() string {
+func (x *TradeHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBatchOrdersRequest) ProtoMessage() {}
+func (*TradeHistory) ProtoMessage() {}
 
-func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *TradeHistory) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4108,75 +4095,99 @@ func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBatchOrdersRequest.ProtoReflect.Descriptor instead.
-func (*CancelBatchOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TradeHistory.ProtoReflect.Descriptor instead.
+func (*TradeHistory) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *CancelBatchOrdersRequest) GetExchange() string {
+func (x *TradeHistory) GetCreationTime() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.CreationTime
 	}
-	return ""
+	return 0
 }
 
-func (x *CancelBatchOrdersRequest) GetAccountId() string {
+func (x *TradeHistory) GetId() string {
 	if x != nil {
-		return x.AccountId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CancelBatchOrdersRequest) GetOrdersId() string {
+func (x *TradeHistory) GetPrice() float64 {
 	if x != nil {
-		return x.OrdersId
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *CancelBatchOrdersRequest) GetPair() *CurrencyPair {
+func (x *TradeHistory) GetAmount() float64 {
 	if x != nil {
-		return x.Pair
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *CancelBatchOrdersRequest) GetAssetType() string {
+func (x *TradeHistory) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *TradeHistory) GetAssetType() string {
 	if x != nil {
 		return x.AssetType
 	}
 	return ""
 }
 
-func (x *CancelBatchOrdersRequest) GetSide() string {
+func (x *TradeHistory) GetOrderSide() string {
 	if x != nil {
-		return x.Side
+		return x.OrderSide
 	}
 	return ""
 }
 
-type Orders struct {
+func (x *TradeHistory) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *TradeHistory) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	OrderStatus   map[string]string      `protobuf:"bytes,2,rep,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate     string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Orders) Reset() {
-	*x = Orders{}
+func (x *GetOrdersRequest) Reset() {
+	*x = GetOrdersRequest{}
 	mi := &file_rpc_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Orders) String() string {
+func (x *GetOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Orders) ProtoMessage() {}
+func (*GetOrdersRequest) ProtoMessage() {}
 
-func (x *Orders) ProtoReflect() protoreflect.Message {
+func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4188,46 +4199,67 @@ func (x *Orders) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Orders.ProtoReflect.Descriptor instead.
-func (*Orders) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *Orders) GetExchange() string {
+func (x *GetOrdersRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *Orders) GetOrderStatus() map[string]string {
+func (x *GetOrdersRequest) GetAssetType() string {
 	if x != nil {
-		return x.OrderStatus
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type CancelBatchOrdersResponse struct {
+func (x *GetOrdersRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type GetOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Orders              `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Orders        []*OrderDetails        `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelBatchOrdersResponse) Reset() {
-	*x = CancelBatchOrdersResponse{}
+func (x *GetOrdersResponse) Reset() {
+	*x = GetOrdersResponse{}
 	mi := &file_rpc_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelBatchOrdersResponse) String() string {
+func (x *GetOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelBatchOrdersResponse) ProtoMessage() {}
+func (*GetOrdersResponse) ProtoMessage() {}
 
-func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
+func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4239,39 +4271,42 @@ func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelBatchOrdersResponse.ProtoReflect.Descriptor instead.
-func (*CancelBatchOrdersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *CancelBatchOrdersResponse) GetOrders() []*Orders {
+func (x *GetOrdersResponse) GetOrders() []*OrderDetails {
 	if x != nil {
 		return x.Orders
 	}
 	return nil
 }
 
-type CancelAllOrdersRequest struct {
+type GetOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelAllOrdersRequest) Reset() {
-	*x = CancelAllOrdersRequest{}
+func (x *GetOrderRequest) Reset() {
+	*x = GetOrderRequest{}
 	mi := &file_rpc_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelAllOrdersRequest) String() string {
+func (x *GetOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelAllOrdersRequest) ProtoMessage() {}
+func (*GetOrderRequest) ProtoMessage() {}
 
-func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4283,40 +4318,68 @@ func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelAllOrdersRequest.ProtoReflect.Descriptor instead.
-func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *CancelAllOrdersRequest) GetExchange() string {
+func (x *GetOrderRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-type CancelAllOrdersResponse struct {
+func (x *GetOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *GetOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetOrderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type SubmitOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Orders              `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType     string                 `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	ClientId      string                 `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	AssetType     string                 `protobuf:"bytes,8,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	MarginType    string                 `protobuf:"bytes,9,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelAllOrdersResponse) Reset() {
-	*x = CancelAllOrdersResponse{}
+func (x *SubmitOrderRequest) Reset() {
+	*x = SubmitOrderRequest{}
 	mi := &file_rpc_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelAllOrdersResponse) String() string {
+func (x *SubmitOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelAllOrdersResponse) ProtoMessage() {}
+func (*SubmitOrderRequest) ProtoMessage() {}
 
-func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
+func (x *SubmitOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4328,87 +4391,99 @@ func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelAllOrdersResponse.ProtoReflect.Descriptor instead.
-func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubmitOrderRequest.ProtoReflect.Descriptor instead.
+func (*SubmitOrderRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *CancelAllOrdersResponse) GetOrders() []*Orders {
+func (x *SubmitOrderRequest) GetExchange() string {
 	if x != nil {
-		return x.Orders
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SubmitOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *CancelAllOrdersResponse) GetCount() int64 {
+func (x *SubmitOrderRequest) GetSide() string {
 	if x != nil {
-		return x.Count
+		return x.Side
 	}
-	return 0
+	return ""
 }
 
-type GetEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SubmitOrderRequest) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
 }
 
-func (x *GetEventsRequest) Reset() {
-	*x = GetEventsRequest{}
-	mi := &file_rpc_proto_msgTypes[73]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SubmitOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *GetEventsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubmitOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (*GetEventsRequest) ProtoMessage() {}
+func (x *SubmitOrderRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
 
-func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[73]
+func (x *SubmitOrderRequest) GetAssetType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AssetType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
-func (*GetEventsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{73}
+func (x *SubmitOrderRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
 }
 
-type ConditionParams struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Condition       string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
-	Price           float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
-	CheckBids       bool                   `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
-	CheckAsks       bool                   `protobuf:"varint,4,opt,name=check_asks,json=checkAsks,proto3" json:"check_asks,omitempty"`
-	OrderbookAmount float64                `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type Trades struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeAsset      string                 `protobuf:"bytes,4,opt,name=fee_asset,json=feeAsset,proto3" json:"fee_asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConditionParams) Reset() {
-	*x = ConditionParams{}
-	mi := &file_rpc_proto_msgTypes[74]
+func (x *Trades) Reset() {
+	*x = Trades{}
+	mi := &file_rpc_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConditionParams) String() string {
+func (x *Trades) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConditionParams) ProtoMessage() {}
+func (*Trades) ProtoMessage() {}
 
-func (x *ConditionParams) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[74]
+func (x *Trades) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4419,74 +4494,63 @@ func (x *ConditionParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConditionParams.ProtoReflect.Descriptor instead.
-func (*ConditionParams) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use Trades.ProtoReflect.Descriptor instead.
+func (*Trades) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ConditionParams) GetCondition() string {
+func (x *Trades) GetAmount() float64 {
 	if x != nil {
-		return x.Condition
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *ConditionParams) GetPrice() float64 {
+func (x *Trades) GetPrice() float64 {
 	if x != nil {
 		return x.Price
 	}
 	return 0
 }
 
-func (x *ConditionParams) GetCheckBids() bool {
-	if x != nil {
-		return x.CheckBids
-	}
-	return false
-}
-
-func (x *ConditionParams) GetCheckAsks() bool {
+func (x *Trades) GetFee() float64 {
 	if x != nil {
-		return x.CheckAsks
+		return x.Fee
 	}
-	return false
+	return 0
 }
 
-func (x *ConditionParams) GetOrderbookAmount() float64 {
+func (x *Trades) GetFeeAsset() string {
 	if x != nil {
-		return x.OrderbookAmount
+		return x.FeeAsset
 	}
-	return 0
+	return ""
 }
 
-type GetEventsResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item            string                 `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams *ConditionParams       `protobuf:"bytes,4,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	Executed        bool                   `protobuf:"varint,7,opt,name=executed,proto3" json:"executed,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SubmitOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderPlaced   bool                   `protobuf:"varint,1,opt,name=order_placed,json=orderPlaced,proto3" json:"order_placed,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Trades        []*Trades              `protobuf:"bytes,3,rep,name=trades,proto3" json:"trades,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetEventsResponse) Reset() {
-	*x = GetEventsResponse{}
-	mi := &file_rpc_proto_msgTypes[75]
+func (x *SubmitOrderResponse) Reset() {
+	*x = SubmitOrderResponse{}
+	mi := &file_rpc_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetEventsResponse) String() string {
+func (x *SubmitOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetEventsResponse) ProtoMessage() {}
+func (*SubmitOrderResponse) ProtoMessage() {}
 
-func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[75]
+func (x *SubmitOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4497,86 +4561,134 @@ func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
-func (*GetEventsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use SubmitOrderResponse.ProtoReflect.Descriptor instead.
+func (*SubmitOrderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetEventsResponse) GetId() int64 {
+func (x *SubmitOrderResponse) GetOrderPlaced() bool {
 	if x != nil {
-		return x.Id
+		return x.OrderPlaced
 	}
-	return 0
+	return false
 }
 
-func (x *GetEventsResponse) GetExchange() string {
+func (x *SubmitOrderResponse) GetOrderId() string {
 	if x != nil {
-		return x.Exchange
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *GetEventsResponse) GetItem() string {
+func (x *SubmitOrderResponse) GetTrades() []*Trades {
 	if x != nil {
-		return x.Item
+		return x.Trades
 	}
-	return ""
+	return nil
+}
+
+type SimulateOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side          string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	MarginType    string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulateOrderRequest) Reset() {
+	*x = SimulateOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulateOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetEventsResponse) GetConditionParams() *ConditionParams {
+func (*SimulateOrderRequest) ProtoMessage() {}
+
+func (x *SimulateOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[75]
 	if x != nil {
-		return x.ConditionParams
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateOrderRequest.ProtoReflect.Descriptor instead.
+func (*SimulateOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SimulateOrderRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
 }
 
-func (x *GetEventsResponse) GetPair() *CurrencyPair {
+func (x *SimulateOrderRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetEventsResponse) GetAction() string {
+func (x *SimulateOrderRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Action
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SimulateOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
 	}
 	return ""
 }
 
-func (x *GetEventsResponse) GetExecuted() bool {
+func (x *SimulateOrderRequest) GetMarginType() string {
 	if x != nil {
-		return x.Executed
+		return x.MarginType
 	}
-	return false
+	return ""
 }
 
-type AddEventRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Item            string                 `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
-	ConditionParams *ConditionParams       `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType       string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type SimulateOrderResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Orders             []*OrderbookItem       `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Amount             float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	MinimumPrice       float64                `protobuf:"fixed64,3,opt,name=minimum_price,json=minimumPrice,proto3" json:"minimum_price,omitempty"`
+	MaximumPrice       float64                `protobuf:"fixed64,4,opt,name=maximum_price,json=maximumPrice,proto3" json:"maximum_price,omitempty"`
+	PercentageGainLoss float64                `protobuf:"fixed64,5,opt,name=percentage_gain_loss,json=percentageGainLoss,proto3" json:"percentage_gain_loss,omitempty"`
+	Status             string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *AddEventRequest) Reset() {
-	*x = AddEventRequest{}
+func (x *SimulateOrderResponse) Reset() {
+	*x = SimulateOrderResponse{}
 	mi := &file_rpc_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventRequest) String() string {
+func (x *SimulateOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventRequest) ProtoMessage() {}
+func (*SimulateOrderResponse) ProtoMessage() {}
 
-func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
+func (x *SimulateOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4588,74 +4700,79 @@ func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
-func (*AddEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateOrderResponse.ProtoReflect.Descriptor instead.
+func (*SimulateOrderResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AddEventRequest) GetExchange() string {
+func (x *SimulateOrderResponse) GetOrders() []*OrderbookItem {
 	if x != nil {
-		return x.Exchange
+		return x.Orders
 	}
-	return ""
+	return nil
 }
 
-func (x *AddEventRequest) GetItem() string {
+func (x *SimulateOrderResponse) GetAmount() float64 {
 	if x != nil {
-		return x.Item
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *AddEventRequest) GetConditionParams() *ConditionParams {
+func (x *SimulateOrderResponse) GetMinimumPrice() float64 {
 	if x != nil {
-		return x.ConditionParams
+		return x.MinimumPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *AddEventRequest) GetPair() *CurrencyPair {
+func (x *SimulateOrderResponse) GetMaximumPrice() float64 {
 	if x != nil {
-		return x.Pair
+		return x.MaximumPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *AddEventRequest) GetAssetType() string {
+func (x *SimulateOrderResponse) GetPercentageGainLoss() float64 {
 	if x != nil {
-		return x.AssetType
+		return x.PercentageGainLoss
 	}
-	return ""
+	return 0
 }
 
-func (x *AddEventRequest) GetAction() string {
+func (x *SimulateOrderResponse) GetStatus() string {
 	if x != nil {
-		return x.Action
+		return x.Status
 	}
 	return ""
 }
 
-type AddEventResponse struct {
+type SimulateSmartOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType     string                 `protobuf:"bytes,4,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddEventResponse) Reset() {
-	*x = AddEventResponse{}
+func (x *SimulateSmartOrderRequest) Reset() {
+	*x = SimulateSmartOrderRequest{}
 	mi := &file_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddEventResponse) String() string {
+func (x *SimulateSmartOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddEventResponse) ProtoMessage() {}
+func (*SimulateSmartOrderRequest) ProtoMessage() {}
 
-func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
+func (x *SimulateSmartOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4667,39 +4784,79 @@ func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
-func (*AddEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateSmartOrderRequest.ProtoReflect.Descriptor instead.
+func (*SimulateSmartOrderRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *AddEventResponse) GetId() int64 {
+func (x *SimulateSmartOrderRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Id
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SimulateSmartOrderRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *SimulateSmartOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *SimulateSmartOrderRequest) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *SimulateSmartOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-type RemoveEventRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SimulateSmartOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *RemoveEventRequest) Reset() {
-	*x = RemoveEventRequest{}
+type SmartOrderRoute struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Amount                float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AveragePrice          float64                `protobuf:"fixed64,3,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	AverageEffectivePrice float64                `protobuf:"fixed64,4,opt,name=average_effective_price,json=averageEffectivePrice,proto3" json:"average_effective_price,omitempty"`
+	WorstPrice            float64                `protobuf:"fixed64,5,opt,name=worst_price,json=worstPrice,proto3" json:"worst_price,omitempty"`
+	FeeRate               float64                `protobuf:"fixed64,6,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SmartOrderRoute) Reset() {
+	*x = SmartOrderRoute{}
 	mi := &file_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveEventRequest) String() string {
+func (x *SmartOrderRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveEventRequest) ProtoMessage() {}
+func (*SmartOrderRoute) ProtoMessage() {}
 
-func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
+func (x *SmartOrderRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4711,39 +4868,75 @@ func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
-func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SmartOrderRoute.ProtoReflect.Descriptor instead.
+func (*SmartOrderRoute) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *RemoveEventRequest) GetId() int64 {
+func (x *SmartOrderRoute) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SmartOrderRoute) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-type GetCryptocurrencyDepositAddressesRequest struct {
+func (x *SmartOrderRoute) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *SmartOrderRoute) GetAverageEffectivePrice() float64 {
+	if x != nil {
+		return x.AverageEffectivePrice
+	}
+	return 0
+}
+
+func (x *SmartOrderRoute) GetWorstPrice() float64 {
+	if x != nil {
+		return x.WorstPrice
+	}
+	return 0
+}
+
+func (x *SmartOrderRoute) GetFeeRate() float64 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+type SmartOrderRejection struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
-	*x = GetCryptocurrencyDepositAddressesRequest{}
+func (x *SmartOrderRejection) Reset() {
+	*x = SmartOrderRejection{}
 	mi := &file_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
+func (x *SmartOrderRejection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
+func (*SmartOrderRejection) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
+func (x *SmartOrderRejection) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4755,41 +4948,54 @@ func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SmartOrderRejection.ProtoReflect.Descriptor instead.
+func (*SmartOrderRejection) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
+func (x *SmartOrderRejection) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-type DepositAddress struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
-	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SmartOrderRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *DepositAddress) Reset() {
-	*x = DepositAddress{}
+type SimulateSmartOrderResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                  string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Amount                float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	RoutedAmount          float64                `protobuf:"fixed64,5,opt,name=routed_amount,json=routedAmount,proto3" json:"routed_amount,omitempty"`
+	AveragePrice          float64                `protobuf:"fixed64,6,opt,name=average_price,json=averagePrice,proto3" json:"average_price,omitempty"`
+	AverageEffectivePrice float64                `protobuf:"fixed64,7,opt,name=average_effective_price,json=averageEffectivePrice,proto3" json:"average_effective_price,omitempty"`
+	Orders                []*SmartOrderRoute     `protobuf:"bytes,8,rep,name=orders,proto3" json:"orders,omitempty"`
+	Rejections            []*SmartOrderRejection `protobuf:"bytes,9,rep,name=rejections,proto3" json:"rejections,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SimulateSmartOrderResponse) Reset() {
+	*x = SimulateSmartOrderResponse{}
 	mi := &file_rpc_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositAddress) String() string {
+func (x *SimulateSmartOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositAddress) ProtoMessage() {}
+func (*SimulateSmartOrderResponse) ProtoMessage() {}
 
-func (x *DepositAddress) ProtoReflect() protoreflect.Message {
+func (x *SimulateSmartOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4801,53 +5007,99 @@ func (x *DepositAddress) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
-func (*DepositAddress) Descriptor() ([]byte, []int) {
+// Deprecated: Use SimulateSmartOrderResponse.ProtoReflect.Descriptor instead.
+func (*SimulateSmartOrderResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *DepositAddress) GetAddress() string {
+func (x *SimulateSmartOrderResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Address
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *DepositAddress) GetTag() string {
+func (x *SimulateSmartOrderResponse) GetAssetType() string {
 	if x != nil {
-		return x.Tag
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *DepositAddress) GetChain() string {
+func (x *SimulateSmartOrderResponse) GetSide() string {
 	if x != nil {
-		return x.Chain
+		return x.Side
 	}
 	return ""
 }
 
-type DepositAddresses struct {
+func (x *SimulateSmartOrderResponse) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SimulateSmartOrderResponse) GetRoutedAmount() float64 {
+	if x != nil {
+		return x.RoutedAmount
+	}
+	return 0
+}
+
+func (x *SimulateSmartOrderResponse) GetAveragePrice() float64 {
+	if x != nil {
+		return x.AveragePrice
+	}
+	return 0
+}
+
+func (x *SimulateSmartOrderResponse) GetAverageEffectivePrice() float64 {
+	if x != nil {
+		return x.AverageEffectivePrice
+	}
+	return 0
+}
+
+func (x *SimulateSmartOrderResponse) GetOrders() []*SmartOrderRoute {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *SimulateSmartOrderResponse) GetRejections() []*SmartOrderRejection {
+	if x != nil {
+		return x.Rejections
+	}
+	return nil
+}
+
+type WhaleBombRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Addresses     []*DepositAddress      `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	PriceTarget   float64                `protobuf:"fixed64,3,opt,name=price_target,json=priceTarget,proto3" json:"price_target,omitempty"`
+	Side          string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DepositAddresses) Reset() {
-	*x = DepositAddresses{}
+func (x *WhaleBombRequest) Reset() {
+	*x = WhaleBombRequest{}
 	mi := &file_rpc_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DepositAddresses) String() string {
+func (x *WhaleBombRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositAddresses) ProtoMessage() {}
+func (*WhaleBombRequest) ProtoMessage() {}
 
-func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
+func (x *WhaleBombRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4859,39 +5111,72 @@ func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
-func (*DepositAddresses) Descriptor() ([]byte, []int) {
+// Deprecated: Use WhaleBombRequest.ProtoReflect.Descriptor instead.
+func (*WhaleBombRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DepositAddresses) GetAddresses() []*DepositAddress {
+func (x *WhaleBombRequest) GetExchange() string {
 	if x != nil {
-		return x.Addresses
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *WhaleBombRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type GetCryptocurrencyDepositAddressesResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Addresses     map[string]*DepositAddresses `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+func (x *WhaleBombRequest) GetPriceTarget() float64 {
+	if x != nil {
+		return x.PriceTarget
+	}
+	return 0
+}
+
+func (x *WhaleBombRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+func (x *WhaleBombRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+type CancelOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
-	*x = GetCryptocurrencyDepositAddressesResponse{}
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
 	mi := &file_rpc_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
+func (x *CancelOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
+func (*CancelOrderRequest) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4903,42 +5188,79 @@ func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
+func (x *CancelOrderRequest) GetExchange() string {
 	if x != nil {
-		return x.Addresses
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *CancelOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type GetCryptocurrencyDepositAddressRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
-	Chain          string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
-	Bypass         bool                   `protobuf:"varint,4,opt,name=bypass,proto3" json:"bypass,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *CancelOrderRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
-	*x = GetCryptocurrencyDepositAddressRequest{}
+func (x *CancelOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+type CancelBatchOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AccountId     string                 `protobuf:"bytes,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	OrdersId      string                 `protobuf:"bytes,3,opt,name=orders_id,json=ordersId,proto3" json:"orders_id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelBatchOrdersRequest) Reset() {
+	*x = CancelBatchOrdersRequest{}
 	mi := &file_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) String() string {
+func (x *CancelBatchOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
+func (*CancelBatchOrdersRequest) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelBatchOrdersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4950,61 +5272,75 @@ func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelBatchOrdersRequest.ProtoReflect.Descriptor instead.
+func (*CancelBatchOrdersRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
+func (x *CancelBatchOrdersRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
+func (x *CancelBatchOrdersRequest) GetAccountId() string {
 	if x != nil {
-		return x.Cryptocurrency
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetChain() string {
+func (x *CancelBatchOrdersRequest) GetOrdersId() string {
 	if x != nil {
-		return x.Chain
+		return x.OrdersId
 	}
 	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressRequest) GetBypass() bool {
+func (x *CancelBatchOrdersRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Bypass
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-type GetCryptocurrencyDepositAddressResponse struct {
+func (x *CancelBatchOrdersRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *CancelBatchOrdersRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
+}
+
+type Orders struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderStatus   map[string]string      `protobuf:"bytes,2,rep,name=order_status,json=orderStatus,proto3" json:"order_status,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
-	*x = GetCryptocurrencyDepositAddressResponse{}
+func (x *Orders) Reset() {
+	*x = Orders{}
 	mi := &file_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) String() string {
+func (x *Orders) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
+func (*Orders) ProtoMessage() {}
 
-func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
+func (x *Orders) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5016,47 +5352,46 @@ func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
-func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Orders.ProtoReflect.Descriptor instead.
+func (*Orders) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
+func (x *Orders) GetExchange() string {
 	if x != nil {
-		return x.Address
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCryptocurrencyDepositAddressResponse) GetTag() string {
+func (x *Orders) GetOrderStatus() map[string]string {
 	if x != nil {
-		return x.Tag
+		return x.OrderStatus
 	}
-	return ""
+	return nil
 }
 
-type GetAvailableTransferChainsRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type CancelBatchOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Orders              `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableTransferChainsRequest) Reset() {
-	*x = GetAvailableTransferChainsRequest{}
+func (x *CancelBatchOrdersResponse) Reset() {
+	*x = CancelBatchOrdersResponse{}
 	mi := &file_rpc_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableTransferChainsRequest) String() string {
+func (x *CancelBatchOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
+func (*CancelBatchOrdersResponse) ProtoMessage() {}
 
-func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelBatchOrdersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5068,46 +5403,39 @@ func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
-func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelBatchOrdersResponse.ProtoReflect.Descriptor instead.
+func (*CancelBatchOrdersResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetAvailableTransferChainsRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetAvailableTransferChainsRequest) GetCryptocurrency() string {
+func (x *CancelBatchOrdersResponse) GetOrders() []*Orders {
 	if x != nil {
-		return x.Cryptocurrency
+		return x.Orders
 	}
-	return ""
+	return nil
 }
 
-type GetAvailableTransferChainsResponse struct {
+type CancelAllOrdersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Chains        []string               `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAvailableTransferChainsResponse) Reset() {
-	*x = GetAvailableTransferChainsResponse{}
+func (x *CancelAllOrdersRequest) Reset() {
+	*x = CancelAllOrdersRequest{}
 	mi := &file_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAvailableTransferChainsResponse) String() string {
+func (x *CancelAllOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
+func (*CancelAllOrdersRequest) ProtoMessage() {}
 
-func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
+func (x *CancelAllOrdersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5119,43 +5447,40 @@ func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
-func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelAllOrdersRequest.ProtoReflect.Descriptor instead.
+func (*CancelAllOrdersRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetAvailableTransferChainsResponse) GetChains() []string {
+func (x *CancelAllOrdersRequest) GetExchange() string {
 	if x != nil {
-		return x.Chains
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type WithdrawFiatRequest struct {
+type CancelAllOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	BankAccountId string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	Orders        []*Orders              `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawFiatRequest) Reset() {
-	*x = WithdrawFiatRequest{}
+func (x *CancelAllOrdersResponse) Reset() {
+	*x = CancelAllOrdersResponse{}
 	mi := &file_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawFiatRequest) String() string {
+func (x *CancelAllOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawFiatRequest) ProtoMessage() {}
+func (*CancelAllOrdersResponse) ProtoMessage() {}
 
-func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelAllOrdersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5167,75 +5492,95 @@ func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelAllOrdersResponse.ProtoReflect.Descriptor instead.
+func (*CancelAllOrdersResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *WithdrawFiatRequest) GetExchange() string {
+func (x *CancelAllOrdersResponse) GetOrders() []*Orders {
 	if x != nil {
-		return x.Exchange
+		return x.Orders
 	}
-	return ""
+	return nil
 }
 
-func (x *WithdrawFiatRequest) GetCurrency() string {
+func (x *CancelAllOrdersResponse) GetCount() int64 {
 	if x != nil {
-		return x.Currency
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *WithdrawFiatRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
+type GetEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawFiatRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	mi := &file_rpc_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawFiatRequest) GetBankAccountId() string {
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[88]
 	if x != nil {
-		return x.BankAccountId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type WithdrawCryptoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	AddressTag    string                 `protobuf:"bytes,3,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	Chain         string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *WithdrawCryptoRequest) Reset() {
-	*x = WithdrawCryptoRequest{}
-	mi := &file_rpc_proto_msgTypes[88]
+type ConditionParams struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Condition       string                 `protobuf:"bytes,1,opt,name=condition,proto3" json:"condition,omitempty"`
+	Price           float64                `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	CheckBids       bool                   `protobuf:"varint,3,opt,name=check_bids,json=checkBids,proto3" json:"check_bids,omitempty"`
+	CheckAsks       bool                   `protobuf:"varint,4,opt,name=check_asks,json=checkAsks,proto3" json:"check_asks,omitempty"`
+	OrderbookAmount float64                `protobuf:"fixed64,5,opt,name=orderbook_amount,json=orderbookAmount,proto3" json:"orderbook_amount,omitempty"`
+	Value           float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Interval        int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	Period          int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
+	FastPeriod      int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
+	SlowPeriod      int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
+	SignalPeriod    int64                  `protobuf:"varint,11,opt,name=signal_period,json=signalPeriod,proto3" json:"signal_period,omitempty"`
+	StdDev          float64                `protobuf:"fixed64,12,opt,name=std_dev,json=stdDev,proto3" json:"std_dev,omitempty"`
+	Currency        string                 `protobuf:"bytes,13,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ConditionParams) Reset() {
+	*x = ConditionParams{}
+	mi := &file_rpc_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawCryptoRequest) String() string {
+func (x *ConditionParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawCryptoRequest) ProtoMessage() {}
+func (*ConditionParams) ProtoMessage() {}
 
-func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[88]
+func (x *ConditionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5246,140 +5591,126 @@ func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{88}
+// Deprecated: Use ConditionParams.ProtoReflect.Descriptor instead.
+func (*ConditionParams) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *WithdrawCryptoRequest) GetExchange() string {
+func (x *ConditionParams) GetCondition() string {
 	if x != nil {
-		return x.Exchange
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *WithdrawCryptoRequest) GetAddress() string {
+func (x *ConditionParams) GetPrice() float64 {
 	if x != nil {
-		return x.Address
+		return x.Price
 	}
-	return ""
+	return 0
 }
 
-func (x *WithdrawCryptoRequest) GetAddressTag() string {
+func (x *ConditionParams) GetCheckBids() bool {
 	if x != nil {
-		return x.AddressTag
+		return x.CheckBids
 	}
-	return ""
+	return false
 }
 
-func (x *WithdrawCryptoRequest) GetCurrency() string {
+func (x *ConditionParams) GetCheckAsks() bool {
 	if x != nil {
-		return x.Currency
+		return x.CheckAsks
 	}
-	return ""
+	return false
 }
 
-func (x *WithdrawCryptoRequest) GetAmount() float64 {
+func (x *ConditionParams) GetOrderbookAmount() float64 {
 	if x != nil {
-		return x.Amount
+		return x.OrderbookAmount
 	}
 	return 0
 }
 
-func (x *WithdrawCryptoRequest) GetFee() float64 {
+func (x *ConditionParams) GetValue() float64 {
 	if x != nil {
-		return x.Fee
+		return x.Value
 	}
 	return 0
 }
 
-func (x *WithdrawCryptoRequest) GetDescription() string {
+func (x *ConditionParams) GetInterval() int64 {
 	if x != nil {
-		return x.Description
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *WithdrawCryptoRequest) GetChain() string {
+func (x *ConditionParams) GetPeriod() int64 {
 	if x != nil {
-		return x.Chain
+		return x.Period
 	}
-	return ""
+	return 0
 }
 
-type WithdrawResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConditionParams) GetFastPeriod() int64 {
+	if x != nil {
+		return x.FastPeriod
+	}
+	return 0
 }
 
-func (x *WithdrawResponse) Reset() {
-	*x = WithdrawResponse{}
-	mi := &file_rpc_proto_msgTypes[89]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ConditionParams) GetSlowPeriod() int64 {
+	if x != nil {
+		return x.SlowPeriod
+	}
+	return 0
 }
 
-func (x *WithdrawResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ConditionParams) GetSignalPeriod() int64 {
+	if x != nil {
+		return x.SignalPeriod
+	}
+	return 0
 }
 
-func (*WithdrawResponse) ProtoMessage() {}
-
-func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[89]
+func (x *ConditionParams) GetStdDev() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StdDev
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{89}
+func (x *ConditionParams) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *WithdrawResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *WithdrawResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-type WithdrawalEventByIDRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type EventRule struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Item            string                 `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams *ConditionParams       `protobuf:"bytes,2,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Operator        string                 `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	Rules           []*EventRule           `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventByIDRequest) Reset() {
-	*x = WithdrawalEventByIDRequest{}
+func (x *EventRule) Reset() {
+	*x = EventRule{}
 	mi := &file_rpc_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventByIDRequest) String() string {
+func (x *EventRule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventByIDRequest) ProtoMessage() {}
+func (*EventRule) ProtoMessage() {}
 
-func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *EventRule) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5391,88 +5722,69 @@ func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use EventRule.ProtoReflect.Descriptor instead.
+func (*EventRule) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *WithdrawalEventByIDRequest) GetId() string {
+func (x *EventRule) GetItem() string {
 	if x != nil {
-		return x.Id
+		return x.Item
 	}
 	return ""
 }
 
-type WithdrawalEventByIDResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Event         *WithdrawalEventResponse `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WithdrawalEventByIDResponse) Reset() {
-	*x = WithdrawalEventByIDResponse{}
-	mi := &file_rpc_proto_msgTypes[91]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WithdrawalEventByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*WithdrawalEventByIDResponse) ProtoMessage() {}
-
-func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[91]
+func (x *EventRule) GetConditionParams() *ConditionParams {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ConditionParams
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{91}
+func (x *EventRule) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
 }
 
-func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
+func (x *EventRule) GetRules() []*EventRule {
 	if x != nil {
-		return x.Event
+		return x.Rules
 	}
 	return nil
 }
 
-type WithdrawalEventsByExchangeRequest struct {
+type EventAction struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Side          string                 `protobuf:"bytes,2,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType     string                 `protobuf:"bytes,3,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	OrderId       string                 `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Script        string                 `protobuf:"bytes,7,opt,name=script,proto3" json:"script,omitempty"`
+	Relayers      []string               `protobuf:"bytes,8,rep,name=relayers,proto3" json:"relayers,omitempty"`
+	Message       string                 `protobuf:"bytes,9,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventsByExchangeRequest) Reset() {
-	*x = WithdrawalEventsByExchangeRequest{}
-	mi := &file_rpc_proto_msgTypes[92]
+func (x *EventAction) Reset() {
+	*x = EventAction{}
+	mi := &file_rpc_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventsByExchangeRequest) String() string {
+func (x *EventAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
+func (*EventAction) ProtoMessage() {}
 
-func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[92]
+func (x *EventAction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5483,71 +5795,106 @@ func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{92}
+// Deprecated: Use EventAction.ProtoReflect.Descriptor instead.
+func (*EventAction) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
+func (x *EventAction) GetType() string {
 	if x != nil {
-		return x.Exchange
+		return x.Type
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetId() string {
+func (x *EventAction) GetSide() string {
 	if x != nil {
-		return x.Id
+		return x.Side
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetLimit() int32 {
+func (x *EventAction) GetOrderType() string {
 	if x != nil {
-		return x.Limit
+		return x.OrderType
+	}
+	return ""
+}
+
+func (x *EventAction) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetCurrency() string {
+func (x *EventAction) GetPrice() float64 {
 	if x != nil {
-		return x.Currency
+		return x.Price
+	}
+	return 0
+}
+
+func (x *EventAction) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByExchangeRequest) GetAssetType() string {
+func (x *EventAction) GetScript() string {
 	if x != nil {
-		return x.AssetType
+		return x.Script
 	}
 	return ""
 }
 
-type WithdrawalEventsByDateRequest struct {
+func (x *EventAction) GetRelayers() []string {
+	if x != nil {
+		return x.Relayers
+	}
+	return nil
+}
+
+func (x *EventAction) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Event struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Rule          *EventRule             `protobuf:"bytes,3,opt,name=rule,proto3" json:"rule,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Action        string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Actions       []*EventAction         `protobuf:"bytes,7,rep,name=actions,proto3" json:"actions,omitempty"`
+	Repeat        bool                   `protobuf:"varint,8,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Cooldown      int64                  `protobuf:"varint,9,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	TriggerCount  int64                  `protobuf:"varint,10,opt,name=trigger_count,json=triggerCount,proto3" json:"trigger_count,omitempty"`
+	LastTriggered *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_triggered,json=lastTriggered,proto3" json:"last_triggered,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventsByDateRequest) Reset() {
-	*x = WithdrawalEventsByDateRequest{}
-	mi := &file_rpc_proto_msgTypes[93]
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_rpc_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventsByDateRequest) String() string {
+func (x *Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
+func (*Event) ProtoMessage() {}
 
-func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[93]
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5558,109 +5905,110 @@ func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{93}
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *WithdrawalEventsByDateRequest) GetExchange() string {
+func (x *Event) GetId() int64 {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *WithdrawalEventsByDateRequest) GetStart() string {
+func (x *Event) GetExchange() string {
 	if x != nil {
-		return x.Start
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WithdrawalEventsByDateRequest) GetEnd() string {
+func (x *Event) GetRule() *EventRule {
 	if x != nil {
-		return x.End
+		return x.Rule
 	}
-	return ""
+	return nil
 }
 
-func (x *WithdrawalEventsByDateRequest) GetLimit() int32 {
+func (x *Event) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Limit
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-type WithdrawalEventsByExchangeResponse struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Event         []*WithdrawalEventResponse `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Event) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *WithdrawalEventsByExchangeResponse) Reset() {
-	*x = WithdrawalEventsByExchangeResponse{}
-	mi := &file_rpc_proto_msgTypes[94]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Event) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
 }
 
-func (x *WithdrawalEventsByExchangeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Event) GetActions() []*EventAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
 }
 
-func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
+func (x *Event) GetRepeat() bool {
+	if x != nil {
+		return x.Repeat
+	}
+	return false
+}
 
-func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[94]
+func (x *Event) GetCooldown() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Cooldown
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{94}
+func (x *Event) GetTriggerCount() int64 {
+	if x != nil {
+		return x.TriggerCount
+	}
+	return 0
 }
 
-func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
+func (x *Event) GetLastTriggered() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Event
+		return x.LastTriggered
 	}
 	return nil
 }
 
-type WithdrawalEventResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Exchange      *WithdrawlExchangeEvent `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Request       *WithdrawalRequestEvent `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
-	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+type GetEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*Event               `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WithdrawalEventResponse) Reset() {
-	*x = WithdrawalEventResponse{}
-	mi := &file_rpc_proto_msgTypes[95]
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	mi := &file_rpc_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawalEventResponse) String() string {
+func (x *GetEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawalEventResponse) ProtoMessage() {}
+func (*GetEventsResponse) ProtoMessage() {}
 
-func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[95]
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5671,70 +6019,49 @@ func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
-func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{95}
-}
-
-func (x *WithdrawalEventResponse) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *WithdrawalEventResponse) GetExchange() *WithdrawlExchangeEvent {
+func (x *GetEventsResponse) GetEvents() []*Event {
 	if x != nil {
-		return x.Exchange
+		return x.Events
 	}
 	return nil
 }
 
-func (x *WithdrawalEventResponse) GetRequest() *WithdrawalRequestEvent {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-func (x *WithdrawalEventResponse) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *WithdrawalEventResponse) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-type WithdrawlExchangeEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type AddEventRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Item            string                 `protobuf:"bytes,2,opt,name=item,proto3" json:"item,omitempty"`
+	ConditionParams *ConditionParams       `protobuf:"bytes,3,opt,name=condition_params,json=conditionParams,proto3" json:"condition_params,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType       string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Action          string                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	Rule            *EventRule             `protobuf:"bytes,7,opt,name=rule,proto3" json:"rule,omitempty"`
+	Actions         []*EventAction         `protobuf:"bytes,8,rep,name=actions,proto3" json:"actions,omitempty"`
+	Repeat          bool                   `protobuf:"varint,9,opt,name=repeat,proto3" json:"repeat,omitempty"`
+	Cooldown        int64                  `protobuf:"varint,10,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *WithdrawlExchangeEvent) Reset() {
-	*x = WithdrawlExchangeEvent{}
-	mi := &file_rpc_proto_msgTypes[96]
+func (x *AddEventRequest) Reset() {
+	*x = AddEventRequest{}
+	mi := &file_rpc_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WithdrawlExchangeEvent) String() string {
+func (x *AddEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithdrawlExchangeEvent) ProtoMessage() {}
+func (*AddEventRequest) ProtoMessage() {}
 
-func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[96]
+func (x *AddEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5745,143 +6072,103 @@ func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithdrawlExchangeEvent.ProtoReflect.Descriptor instead.
-func (*WithdrawlExchangeEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{96}
+// Deprecated: Use AddEventRequest.ProtoReflect.Descriptor instead.
+func (*AddEventRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *WithdrawlExchangeEvent) GetName() string {
+func (x *AddEventRequest) GetExchange() string {
 	if x != nil {
-		return x.Name
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WithdrawlExchangeEvent) GetId() string {
+func (x *AddEventRequest) GetItem() string {
 	if x != nil {
-		return x.Id
+		return x.Item
 	}
 	return ""
 }
 
-func (x *WithdrawlExchangeEvent) GetStatus() string {
+func (x *AddEventRequest) GetConditionParams() *ConditionParams {
 	if x != nil {
-		return x.Status
+		return x.ConditionParams
 	}
-	return ""
-}
-
-type WithdrawalRequestEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	Type          int64                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
-	Fiat          *FiatWithdrawalEvent   `protobuf:"bytes,6,opt,name=fiat,proto3" json:"fiat,omitempty"`
-	Crypto        *CryptoWithdrawalEvent `protobuf:"bytes,7,opt,name=crypto,proto3" json:"crypto,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WithdrawalRequestEvent) Reset() {
-	*x = WithdrawalRequestEvent{}
-	mi := &file_rpc_proto_msgTypes[97]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *WithdrawalRequestEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*WithdrawalRequestEvent) ProtoMessage() {}
-
-func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[97]
+func (x *AddEventRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pair
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
-func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{97}
+	return nil
 }
 
-func (x *WithdrawalRequestEvent) GetCurrency() string {
+func (x *AddEventRequest) GetAssetType() string {
 	if x != nil {
-		return x.Currency
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *WithdrawalRequestEvent) GetDescription() string {
+func (x *AddEventRequest) GetAction() string {
 	if x != nil {
-		return x.Description
+		return x.Action
 	}
 	return ""
 }
 
-func (x *WithdrawalRequestEvent) GetAmount() float64 {
+func (x *AddEventRequest) GetRule() *EventRule {
 	if x != nil {
-		return x.Amount
+		return x.Rule
 	}
-	return 0
+	return nil
 }
 
-func (x *WithdrawalRequestEvent) GetType() int64 {
+func (x *AddEventRequest) GetActions() []*EventAction {
 	if x != nil {
-		return x.Type
+		return x.Actions
 	}
-	return 0
+	return nil
 }
 
-func (x *WithdrawalRequestEvent) GetFiat() *FiatWithdrawalEvent {
+func (x *AddEventRequest) GetRepeat() bool {
 	if x != nil {
-		return x.Fiat
+		return x.Repeat
 	}
-	return nil
+	return false
 }
 
-func (x *WithdrawalRequestEvent) GetCrypto() *CryptoWithdrawalEvent {
+func (x *AddEventRequest) GetCooldown() int64 {
 	if x != nil {
-		return x.Crypto
+		return x.Cooldown
 	}
-	return nil
+	return 0
 }
 
-type FiatWithdrawalEvent struct {
+type AddEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BankName      string                 `protobuf:"bytes,1,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
-	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
-	AccountNumber string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
-	Bsb           string                 `protobuf:"bytes,4,opt,name=bsb,proto3" json:"bsb,omitempty"`
-	Swift         string                 `protobuf:"bytes,5,opt,name=swift,proto3" json:"swift,omitempty"`
-	Iban          string                 `protobuf:"bytes,6,opt,name=iban,proto3" json:"iban,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FiatWithdrawalEvent) Reset() {
-	*x = FiatWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[98]
+func (x *AddEventResponse) Reset() {
+	*x = AddEventResponse{}
+	mi := &file_rpc_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FiatWithdrawalEvent) String() string {
+func (x *AddEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FiatWithdrawalEvent) ProtoMessage() {}
+func (*AddEventResponse) ProtoMessage() {}
 
-func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[98]
+func (x *AddEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5892,78 +6179,84 @@ func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
-func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{98}
+// Deprecated: Use AddEventResponse.ProtoReflect.Descriptor instead.
+func (*AddEventResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *FiatWithdrawalEvent) GetBankName() string {
+func (x *AddEventResponse) GetId() int64 {
 	if x != nil {
-		return x.BankName
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *FiatWithdrawalEvent) GetAccountName() string {
-	if x != nil {
-		return x.AccountName
-	}
-	return ""
+type RemoveEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FiatWithdrawalEvent) GetAccountNumber() string {
-	if x != nil {
-		return x.AccountNumber
-	}
-	return ""
+func (x *RemoveEventRequest) Reset() {
+	*x = RemoveEventRequest{}
+	mi := &file_rpc_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FiatWithdrawalEvent) GetBsb() string {
-	if x != nil {
-		return x.Bsb
-	}
-	return ""
+func (x *RemoveEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FiatWithdrawalEvent) GetSwift() string {
+func (*RemoveEventRequest) ProtoMessage() {}
+
+func (x *RemoveEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[96]
 	if x != nil {
-		return x.Swift
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FiatWithdrawalEvent) GetIban() string {
+// Deprecated: Use RemoveEventRequest.ProtoReflect.Descriptor instead.
+func (*RemoveEventRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *RemoveEventRequest) GetId() int64 {
 	if x != nil {
-		return x.Iban
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-type CryptoWithdrawalEvent struct {
+type GetCryptocurrencyDepositAddressesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AddressTag    string                 `protobuf:"bytes,2,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
-	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
-	TxId          string                 `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CryptoWithdrawalEvent) Reset() {
-	*x = CryptoWithdrawalEvent{}
-	mi := &file_rpc_proto_msgTypes[99]
+func (x *GetCryptocurrencyDepositAddressesRequest) Reset() {
+	*x = GetCryptocurrencyDepositAddressesRequest{}
+	mi := &file_rpc_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CryptoWithdrawalEvent) String() string {
+func (x *GetCryptocurrencyDepositAddressesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CryptoWithdrawalEvent) ProtoMessage() {}
+func (*GetCryptocurrencyDepositAddressesRequest) ProtoMessage() {}
 
-func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[99]
+func (x *GetCryptocurrencyDepositAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5974,60 +6267,143 @@ func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
-func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{99}
-}
-
-func (x *CryptoWithdrawalEvent) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use GetCryptocurrencyDepositAddressesRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *CryptoWithdrawalEvent) GetAddressTag() string {
+func (x *GetCryptocurrencyDepositAddressesRequest) GetExchange() string {
 	if x != nil {
-		return x.AddressTag
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CryptoWithdrawalEvent) GetFee() float64 {
+type DepositAddress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	Chain         string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DepositAddress) Reset() {
+	*x = DepositAddress{}
+	mi := &file_rpc_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAddress) ProtoMessage() {}
+
+func (x *DepositAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[98]
 	if x != nil {
-		return x.Fee
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *CryptoWithdrawalEvent) GetTxId() string {
+// Deprecated: Use DepositAddress.ProtoReflect.Descriptor instead.
+func (*DepositAddress) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *DepositAddress) GetAddress() string {
 	if x != nil {
-		return x.TxId
+		return x.Address
 	}
 	return ""
 }
 
-type GetLoggerDetailsRequest struct {
+func (x *DepositAddress) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *DepositAddress) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type DepositAddresses struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	Addresses     []*DepositAddress      `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLoggerDetailsRequest) Reset() {
-	*x = GetLoggerDetailsRequest{}
+func (x *DepositAddresses) Reset() {
+	*x = DepositAddresses{}
+	mi := &file_rpc_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DepositAddresses) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DepositAddresses) ProtoMessage() {}
+
+func (x *DepositAddresses) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DepositAddresses.ProtoReflect.Descriptor instead.
+func (*DepositAddresses) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DepositAddresses) GetAddresses() []*DepositAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type GetCryptocurrencyDepositAddressesResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Addresses     map[string]*DepositAddresses `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCryptocurrencyDepositAddressesResponse) Reset() {
+	*x = GetCryptocurrencyDepositAddressesResponse{}
 	mi := &file_rpc_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoggerDetailsRequest) String() string {
+func (x *GetCryptocurrencyDepositAddressesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoggerDetailsRequest) ProtoMessage() {}
+func (*GetCryptocurrencyDepositAddressesResponse) ProtoMessage() {}
 
-func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCryptocurrencyDepositAddressesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6039,42 +6415,42 @@ func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCryptocurrencyDepositAddressesResponse.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressesResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetLoggerDetailsRequest) GetLogger() string {
+func (x *GetCryptocurrencyDepositAddressesResponse) GetAddresses() map[string]*DepositAddresses {
 	if x != nil {
-		return x.Logger
+		return x.Addresses
 	}
-	return ""
+	return nil
 }
 
-type GetLoggerDetailsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Info          bool                   `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
-	Debug         bool                   `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
-	Warn          bool                   `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
-	Error         bool                   `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetCryptocurrencyDepositAddressRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
+	Chain          string                 `protobuf:"bytes,3,opt,name=chain,proto3" json:"chain,omitempty"`
+	Bypass         bool                   `protobuf:"varint,4,opt,name=bypass,proto3" json:"bypass,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetLoggerDetailsResponse) Reset() {
-	*x = GetLoggerDetailsResponse{}
+func (x *GetCryptocurrencyDepositAddressRequest) Reset() {
+	*x = GetCryptocurrencyDepositAddressRequest{}
 	mi := &file_rpc_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLoggerDetailsResponse) String() string {
+func (x *GetCryptocurrencyDepositAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLoggerDetailsResponse) ProtoMessage() {}
+func (*GetCryptocurrencyDepositAddressRequest) ProtoMessage() {}
 
-func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetCryptocurrencyDepositAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6086,61 +6462,61 @@ func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
-func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCryptocurrencyDepositAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *GetLoggerDetailsResponse) GetInfo() bool {
+func (x *GetCryptocurrencyDepositAddressRequest) GetExchange() string {
 	if x != nil {
-		return x.Info
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-func (x *GetLoggerDetailsResponse) GetDebug() bool {
+func (x *GetCryptocurrencyDepositAddressRequest) GetCryptocurrency() string {
 	if x != nil {
-		return x.Debug
+		return x.Cryptocurrency
 	}
-	return false
+	return ""
 }
 
-func (x *GetLoggerDetailsResponse) GetWarn() bool {
+func (x *GetCryptocurrencyDepositAddressRequest) GetChain() string {
 	if x != nil {
-		return x.Warn
+		return x.Chain
 	}
-	return false
+	return ""
 }
 
-func (x *GetLoggerDetailsResponse) GetError() bool {
+func (x *GetCryptocurrencyDepositAddressRequest) GetBypass() bool {
 	if x != nil {
-		return x.Error
+		return x.Bypass
 	}
 	return false
 }
 
-type SetLoggerDetailsRequest struct {
+type GetCryptocurrencyDepositAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
-	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Tag           string                 `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetLoggerDetailsRequest) Reset() {
-	*x = SetLoggerDetailsRequest{}
+func (x *GetCryptocurrencyDepositAddressResponse) Reset() {
+	*x = GetCryptocurrencyDepositAddressResponse{}
 	mi := &file_rpc_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLoggerDetailsRequest) String() string {
+func (x *GetCryptocurrencyDepositAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLoggerDetailsRequest) ProtoMessage() {}
+func (*GetCryptocurrencyDepositAddressResponse) ProtoMessage() {}
 
-func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetCryptocurrencyDepositAddressResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6152,47 +6528,47 @@ func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
-func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetCryptocurrencyDepositAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetCryptocurrencyDepositAddressResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *SetLoggerDetailsRequest) GetLogger() string {
+func (x *GetCryptocurrencyDepositAddressResponse) GetAddress() string {
 	if x != nil {
-		return x.Logger
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SetLoggerDetailsRequest) GetLevel() string {
+func (x *GetCryptocurrencyDepositAddressResponse) GetTag() string {
 	if x != nil {
-		return x.Level
+		return x.Tag
 	}
 	return ""
 }
 
-type GetExchangePairsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetAvailableTransferChainsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Cryptocurrency string                 `protobuf:"bytes,2,opt,name=cryptocurrency,proto3" json:"cryptocurrency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetExchangePairsRequest) Reset() {
-	*x = GetExchangePairsRequest{}
+func (x *GetAvailableTransferChainsRequest) Reset() {
+	*x = GetAvailableTransferChainsRequest{}
 	mi := &file_rpc_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangePairsRequest) String() string {
+func (x *GetAvailableTransferChainsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangePairsRequest) ProtoMessage() {}
+func (*GetAvailableTransferChainsRequest) ProtoMessage() {}
 
-func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAvailableTransferChainsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6204,46 +6580,46 @@ func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailableTransferChainsRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailableTransferChainsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetExchangePairsRequest) GetExchange() string {
+func (x *GetAvailableTransferChainsRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetExchangePairsRequest) GetAsset() string {
+func (x *GetAvailableTransferChainsRequest) GetCryptocurrency() string {
 	if x != nil {
-		return x.Asset
+		return x.Cryptocurrency
 	}
 	return ""
 }
 
-type GetExchangePairsResponse struct {
-	state           protoimpl.MessageState     `protogen:"open.v1"`
-	SupportedAssets map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetAvailableTransferChainsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Chains        []string               `protobuf:"bytes,1,rep,name=chains,proto3" json:"chains,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangePairsResponse) Reset() {
-	*x = GetExchangePairsResponse{}
+func (x *GetAvailableTransferChainsResponse) Reset() {
+	*x = GetAvailableTransferChainsResponse{}
 	mi := &file_rpc_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangePairsResponse) String() string {
+func (x *GetAvailableTransferChainsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangePairsResponse) ProtoMessage() {}
+func (*GetAvailableTransferChainsResponse) ProtoMessage() {}
 
-func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
+func (x *GetAvailableTransferChainsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6255,42 +6631,43 @@ func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
-func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAvailableTransferChainsResponse.ProtoReflect.Descriptor instead.
+func (*GetAvailableTransferChainsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
+func (x *GetAvailableTransferChainsResponse) GetChains() []string {
 	if x != nil {
-		return x.SupportedAssets
+		return x.Chains
 	}
 	return nil
 }
 
-type SetExchangePairRequest struct {
+type WithdrawFiatRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pairs         []*CurrencyPair        `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
-	Enable        bool                   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	BankAccountId string                 `protobuf:"bytes,5,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangePairRequest) Reset() {
-	*x = SetExchangePairRequest{}
+func (x *WithdrawFiatRequest) Reset() {
+	*x = WithdrawFiatRequest{}
 	mi := &file_rpc_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangePairRequest) String() string {
+func (x *WithdrawFiatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangePairRequest) ProtoMessage() {}
+func (*WithdrawFiatRequest) ProtoMessage() {}
 
-func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawFiatRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6302,62 +6679,74 @@ func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawFiatRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawFiatRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *SetExchangePairRequest) GetExchange() string {
+func (x *WithdrawFiatRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetExchangePairRequest) GetAssetType() string {
+func (x *WithdrawFiatRequest) GetCurrency() string {
 	if x != nil {
-		return x.AssetType
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *SetExchangePairRequest) GetPairs() []*CurrencyPair {
+func (x *WithdrawFiatRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Pairs
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *SetExchangePairRequest) GetEnable() bool {
+func (x *WithdrawFiatRequest) GetDescription() string {
 	if x != nil {
-		return x.Enable
+		return x.Description
 	}
-	return false
+	return ""
 }
 
-type GetOrderbookStreamRequest struct {
+func (x *WithdrawFiatRequest) GetBankAccountId() string {
+	if x != nil {
+		return x.BankAccountId
+	}
+	return ""
+}
+
+type WithdrawCryptoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,3,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee           float64                `protobuf:"fixed64,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	Chain         string                 `protobuf:"bytes,8,opt,name=chain,proto3" json:"chain,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookStreamRequest) Reset() {
-	*x = GetOrderbookStreamRequest{}
+func (x *WithdrawCryptoRequest) Reset() {
+	*x = WithdrawCryptoRequest{}
 	mi := &file_rpc_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookStreamRequest) String() string {
+func (x *WithdrawCryptoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookStreamRequest) ProtoMessage() {}
+func (*WithdrawCryptoRequest) ProtoMessage() {}
 
-func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawCryptoRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6369,53 +6758,89 @@ func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawCryptoRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawCryptoRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *GetOrderbookStreamRequest) GetExchange() string {
+func (x *WithdrawCryptoRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
+func (x *WithdrawCryptoRequest) GetAddress() string {
 	if x != nil {
-		return x.Pair
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrderbookStreamRequest) GetAssetType() string {
+func (x *WithdrawCryptoRequest) GetAddressTag() string {
 	if x != nil {
-		return x.AssetType
+		return x.AddressTag
 	}
 	return ""
 }
 
-type GetExchangeOrderbookStreamRequest struct {
+func (x *WithdrawCryptoRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *WithdrawCryptoRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *WithdrawCryptoRequest) GetFee() float64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *WithdrawCryptoRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *WithdrawCryptoRequest) GetChain() string {
+	if x != nil {
+		return x.Chain
+	}
+	return ""
+}
+
+type WithdrawResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeOrderbookStreamRequest) Reset() {
-	*x = GetExchangeOrderbookStreamRequest{}
+func (x *WithdrawResponse) Reset() {
+	*x = WithdrawResponse{}
 	mi := &file_rpc_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeOrderbookStreamRequest) String() string {
+func (x *WithdrawResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
+func (*WithdrawResponse) ProtoMessage() {}
 
-func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6427,41 +6852,46 @@ func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
+func (x *WithdrawResponse) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-type GetTickerStreamRequest struct {
+func (x *WithdrawResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type WithdrawalEventByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTickerStreamRequest) Reset() {
-	*x = GetTickerStreamRequest{}
+func (x *WithdrawalEventByIDRequest) Reset() {
+	*x = WithdrawalEventByIDRequest{}
 	mi := &file_rpc_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTickerStreamRequest) String() string {
+func (x *WithdrawalEventByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTickerStreamRequest) ProtoMessage() {}
+func (*WithdrawalEventByIDRequest) ProtoMessage() {}
 
-func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalEventByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6473,53 +6903,39 @@ func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalEventByIDRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventByIDRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetTickerStreamRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetTickerStreamRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetTickerStreamRequest) GetAssetType() string {
+func (x *WithdrawalEventByIDRequest) GetId() string {
 	if x != nil {
-		return x.AssetType
+		return x.Id
 	}
 	return ""
 }
 
-type GetExchangeTickerStreamRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+type WithdrawalEventByIDResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Event         *WithdrawalEventResponse `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeTickerStreamRequest) Reset() {
-	*x = GetExchangeTickerStreamRequest{}
+func (x *WithdrawalEventByIDResponse) Reset() {
+	*x = WithdrawalEventByIDResponse{}
 	mi := &file_rpc_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeTickerStreamRequest) String() string {
+func (x *WithdrawalEventByIDResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
+func (*WithdrawalEventByIDResponse) ProtoMessage() {}
 
-func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalEventByIDResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6531,43 +6947,43 @@ func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalEventByIDResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventByIDResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *GetExchangeTickerStreamRequest) GetExchange() string {
+func (x *WithdrawalEventByIDResponse) GetEvent() *WithdrawalEventResponse {
 	if x != nil {
-		return x.Exchange
+		return x.Event
 	}
-	return ""
+	return nil
 }
 
-type GetAuditEventRequest struct {
+type WithdrawalEventsByExchangeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	AssetType     string                 `protobuf:"bytes,5,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditEventRequest) Reset() {
-	*x = GetAuditEventRequest{}
+func (x *WithdrawalEventsByExchangeRequest) Reset() {
+	*x = WithdrawalEventsByExchangeRequest{}
 	mi := &file_rpc_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditEventRequest) String() string {
+func (x *WithdrawalEventsByExchangeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditEventRequest) ProtoMessage() {}
+func (*WithdrawalEventsByExchangeRequest) ProtoMessage() {}
 
-func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalEventsByExchangeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6579,67 +6995,70 @@ func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
-func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalEventsByExchangeRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventsByExchangeRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *GetAuditEventRequest) GetStartDate() string {
+func (x *WithdrawalEventsByExchangeRequest) GetExchange() string {
 	if x != nil {
-		return x.StartDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetAuditEventRequest) GetEndDate() string {
+func (x *WithdrawalEventsByExchangeRequest) GetId() string {
 	if x != nil {
-		return x.EndDate
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetAuditEventRequest) GetOrderBy() string {
+func (x *WithdrawalEventsByExchangeRequest) GetLimit() int32 {
 	if x != nil {
-		return x.OrderBy
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-func (x *GetAuditEventRequest) GetLimit() int32 {
+func (x *WithdrawalEventsByExchangeRequest) GetCurrency() string {
 	if x != nil {
-		return x.Limit
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *GetAuditEventRequest) GetOffset() int32 {
+func (x *WithdrawalEventsByExchangeRequest) GetAssetType() string {
 	if x != nil {
-		return x.Offset
+		return x.AssetType
 	}
-	return 0
+	return ""
 }
 
-type GetAuditEventResponse struct {
+type WithdrawalEventsByDateRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Start         string                 `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAuditEventResponse) Reset() {
-	*x = GetAuditEventResponse{}
+func (x *WithdrawalEventsByDateRequest) Reset() {
+	*x = WithdrawalEventsByDateRequest{}
 	mi := &file_rpc_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAuditEventResponse) String() string {
+func (x *WithdrawalEventsByDateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAuditEventResponse) ProtoMessage() {}
+func (*WithdrawalEventsByDateRequest) ProtoMessage() {}
 
-func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalEventsByDateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6651,43 +7070,60 @@ func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
-func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalEventsByDateRequest.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventsByDateRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
+func (x *WithdrawalEventsByDateRequest) GetExchange() string {
 	if x != nil {
-		return x.Events
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type GetSavedTradesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+func (x *WithdrawalEventsByDateRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *WithdrawalEventsByDateRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *WithdrawalEventsByDateRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type WithdrawalEventsByExchangeResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Event         []*WithdrawalEventResponse `protobuf:"bytes,2,rep,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSavedTradesRequest) Reset() {
-	*x = GetSavedTradesRequest{}
+func (x *WithdrawalEventsByExchangeResponse) Reset() {
+	*x = WithdrawalEventsByExchangeResponse{}
 	mi := &file_rpc_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSavedTradesRequest) String() string {
+func (x *WithdrawalEventsByExchangeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSavedTradesRequest) ProtoMessage() {}
+func (*WithdrawalEventsByExchangeResponse) ProtoMessage() {}
 
-func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalEventsByExchangeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6699,71 +7135,43 @@ func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
-func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalEventsByExchangeResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventsByExchangeResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{112}
 }
 
-func (x *GetSavedTradesRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetSavedTradesRequest) GetPair() *CurrencyPair {
+func (x *WithdrawalEventsByExchangeResponse) GetEvent() []*WithdrawalEventResponse {
 	if x != nil {
-		return x.Pair
+		return x.Event
 	}
 	return nil
 }
 
-func (x *GetSavedTradesRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
-}
-
-func (x *GetSavedTradesRequest) GetStart() string {
-	if x != nil {
-		return x.Start
-	}
-	return ""
-}
-
-func (x *GetSavedTradesRequest) GetEnd() string {
-	if x != nil {
-		return x.End
-	}
-	return ""
-}
-
-type SavedTrades struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	TradeId       string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+type WithdrawalEventResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      *WithdrawlExchangeEvent `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Request       *WithdrawalRequestEvent `protobuf:"bytes,4,opt,name=request,proto3" json:"request,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavedTrades) Reset() {
-	*x = SavedTrades{}
+func (x *WithdrawalEventResponse) Reset() {
+	*x = WithdrawalEventResponse{}
 	mi := &file_rpc_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavedTrades) String() string {
+func (x *WithdrawalEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavedTrades) ProtoMessage() {}
+func (*WithdrawalEventResponse) ProtoMessage() {}
 
-func (x *SavedTrades) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6775,70 +7183,69 @@ func (x *SavedTrades) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
-func (*SavedTrades) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalEventResponse.ProtoReflect.Descriptor instead.
+func (*WithdrawalEventResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *SavedTrades) GetPrice() float64 {
+func (x *WithdrawalEventResponse) GetId() string {
 	if x != nil {
-		return x.Price
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *SavedTrades) GetAmount() float64 {
+func (x *WithdrawalEventResponse) GetExchange() *WithdrawlExchangeEvent {
 	if x != nil {
-		return x.Amount
+		return x.Exchange
 	}
-	return 0
+	return nil
 }
 
-func (x *SavedTrades) GetSide() string {
+func (x *WithdrawalEventResponse) GetRequest() *WithdrawalRequestEvent {
 	if x != nil {
-		return x.Side
+		return x.Request
 	}
-	return ""
+	return nil
 }
 
-func (x *SavedTrades) GetTimestamp() string {
+func (x *WithdrawalEventResponse) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *SavedTrades) GetTradeId() string {
+func (x *WithdrawalEventResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TradeId
+		return x.UpdatedAt
 	}
-	return ""
+	return nil
 }
 
-type SavedTradesResponse struct {
+type WithdrawlExchangeEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Trades        []*SavedTrades         `protobuf:"bytes,4,rep,name=trades,proto3" json:"trades,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SavedTradesResponse) Reset() {
-	*x = SavedTradesResponse{}
+func (x *WithdrawlExchangeEvent) Reset() {
+	*x = WithdrawlExchangeEvent{}
 	mi := &file_rpc_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SavedTradesResponse) String() string {
+func (x *WithdrawlExchangeEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SavedTradesResponse) ProtoMessage() {}
+func (*WithdrawlExchangeEvent) ProtoMessage() {}
 
-func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
+func (x *WithdrawlExchangeEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6850,67 +7257,58 @@ func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
-func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawlExchangeEvent.ProtoReflect.Descriptor instead.
+func (*WithdrawlExchangeEvent) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *SavedTradesResponse) GetExchangeName() string {
+func (x *WithdrawlExchangeEvent) GetName() string {
 	if x != nil {
-		return x.ExchangeName
+		return x.Name
 	}
 	return ""
 }
 
-func (x *SavedTradesResponse) GetAsset() string {
+func (x *WithdrawlExchangeEvent) GetId() string {
 	if x != nil {
-		return x.Asset
+		return x.Id
 	}
 	return ""
 }
 
-func (x *SavedTradesResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *SavedTradesResponse) GetTrades() []*SavedTrades {
+func (x *WithdrawlExchangeEvent) GetStatus() string {
 	if x != nil {
-		return x.Trades
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type ConvertTradesToCandlesRequest struct {
+type WithdrawalRequestEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
-	TimeInterval  int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
-	Sync          bool                   `protobuf:"varint,7,opt,name=sync,proto3" json:"sync,omitempty"`
-	Force         bool                   `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type          int64                  `protobuf:"varint,5,opt,name=type,proto3" json:"type,omitempty"`
+	Fiat          *FiatWithdrawalEvent   `protobuf:"bytes,6,opt,name=fiat,proto3" json:"fiat,omitempty"`
+	Crypto        *CryptoWithdrawalEvent `protobuf:"bytes,7,opt,name=crypto,proto3" json:"crypto,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConvertTradesToCandlesRequest) Reset() {
-	*x = ConvertTradesToCandlesRequest{}
+func (x *WithdrawalRequestEvent) Reset() {
+	*x = WithdrawalRequestEvent{}
 	mi := &file_rpc_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConvertTradesToCandlesRequest) String() string {
+func (x *WithdrawalRequestEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
+func (*WithdrawalRequestEvent) ProtoMessage() {}
 
-func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
+func (x *WithdrawalRequestEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6922,98 +7320,79 @@ func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
-func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use WithdrawalRequestEvent.ProtoReflect.Descriptor instead.
+func (*WithdrawalRequestEvent) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ConvertTradesToCandlesRequest) GetExchange() string {
+func (x *WithdrawalRequestEvent) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *ConvertTradesToCandlesRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *ConvertTradesToCandlesRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ConvertTradesToCandlesRequest) GetStart() string {
+func (x *WithdrawalRequestEvent) GetDescription() string {
 	if x != nil {
-		return x.Start
+		return x.Description
 	}
 	return ""
 }
 
-func (x *ConvertTradesToCandlesRequest) GetEnd() string {
+func (x *WithdrawalRequestEvent) GetAmount() float64 {
 	if x != nil {
-		return x.End
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *ConvertTradesToCandlesRequest) GetTimeInterval() int64 {
+func (x *WithdrawalRequestEvent) GetType() int64 {
 	if x != nil {
-		return x.TimeInterval
+		return x.Type
 	}
 	return 0
 }
 
-func (x *ConvertTradesToCandlesRequest) GetSync() bool {
+func (x *WithdrawalRequestEvent) GetFiat() *FiatWithdrawalEvent {
 	if x != nil {
-		return x.Sync
+		return x.Fiat
 	}
-	return false
+	return nil
 }
 
-func (x *ConvertTradesToCandlesRequest) GetForce() bool {
+func (x *WithdrawalRequestEvent) GetCrypto() *CryptoWithdrawalEvent {
 	if x != nil {
-		return x.Force
+		return x.Crypto
 	}
-	return false
+	return nil
 }
 
-type GetHistoricCandlesRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
-	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
-	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
-	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
-	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
-	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
-	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type FiatWithdrawalEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BankName      string                 `protobuf:"bytes,1,opt,name=bank_name,json=bankName,proto3" json:"bank_name,omitempty"`
+	AccountName   string                 `protobuf:"bytes,2,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	Bsb           string                 `protobuf:"bytes,4,opt,name=bsb,proto3" json:"bsb,omitempty"`
+	Swift         string                 `protobuf:"bytes,5,opt,name=swift,proto3" json:"swift,omitempty"`
+	Iban          string                 `protobuf:"bytes,6,opt,name=iban,proto3" json:"iban,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesRequest) Reset() {
-	*x = GetHistoricCandlesRequest{}
+func (x *FiatWithdrawalEvent) Reset() {
+	*x = FiatWithdrawalEvent{}
 	mi := &file_rpc_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesRequest) String() string {
+func (x *FiatWithdrawalEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesRequest) ProtoMessage() {}
+func (*FiatWithdrawalEvent) ProtoMessage() {}
 
-func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
+func (x *FiatWithdrawalEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7025,114 +7404,77 @@ func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FiatWithdrawalEvent.ProtoReflect.Descriptor instead.
+func (*FiatWithdrawalEvent) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *GetHistoricCandlesRequest) GetExchange() string {
+func (x *FiatWithdrawalEvent) GetBankName() string {
 	if x != nil {
-		return x.Exchange
+		return x.BankName
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *GetHistoricCandlesRequest) GetAssetType() string {
+func (x *FiatWithdrawalEvent) GetAccountName() string {
 	if x != nil {
-		return x.AssetType
+		return x.AccountName
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetStart() string {
+func (x *FiatWithdrawalEvent) GetAccountNumber() string {
 	if x != nil {
-		return x.Start
+		return x.AccountNumber
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetEnd() string {
+func (x *FiatWithdrawalEvent) GetBsb() string {
 	if x != nil {
-		return x.End
+		return x.Bsb
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
-	if x != nil {
-		return x.TimeInterval
-	}
-	return 0
-}
-
-func (x *GetHistoricCandlesRequest) GetExRequest() bool {
-	if x != nil {
-		return x.ExRequest
-	}
-	return false
-}
-
-func (x *GetHistoricCandlesRequest) GetSync() bool {
-	if x != nil {
-		return x.Sync
-	}
-	return false
-}
-
-func (x *GetHistoricCandlesRequest) GetUseDb() bool {
-	if x != nil {
-		return x.UseDb
-	}
-	return false
-}
-
-func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
+func (x *FiatWithdrawalEvent) GetSwift() string {
 	if x != nil {
-		return x.FillMissingWithTrades
+		return x.Swift
 	}
-	return false
+	return ""
 }
 
-func (x *GetHistoricCandlesRequest) GetForce() bool {
+func (x *FiatWithdrawalEvent) GetIban() string {
 	if x != nil {
-		return x.Force
+		return x.Iban
 	}
-	return false
+	return ""
 }
 
-type GetHistoricCandlesResponse struct {
+type CryptoWithdrawalEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
-	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
-	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
+	Address       string                 `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,2,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Fee           float64                `protobuf:"fixed64,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	TxId          string                 `protobuf:"bytes,4,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesResponse) Reset() {
-	*x = GetHistoricCandlesResponse{}
+func (x *CryptoWithdrawalEvent) Reset() {
+	*x = CryptoWithdrawalEvent{}
 	mi := &file_rpc_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetHistoricCandlesResponse) String() string {
+func (x *CryptoWithdrawalEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHistoricCandlesResponse) ProtoMessage() {}
+func (*CryptoWithdrawalEvent) ProtoMessage() {}
 
-func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
+func (x *CryptoWithdrawalEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7144,81 +7486,108 @@ func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
-func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CryptoWithdrawalEvent.ProtoReflect.Descriptor instead.
+func (*CryptoWithdrawalEvent) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *GetHistoricCandlesResponse) GetExchange() string {
+func (x *CryptoWithdrawalEvent) GetAddress() string {
 	if x != nil {
-		return x.Exchange
+		return x.Address
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
+func (x *CryptoWithdrawalEvent) GetAddressTag() string {
 	if x != nil {
-		return x.Pair
+		return x.AddressTag
 	}
-	return nil
+	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetStart() string {
+func (x *CryptoWithdrawalEvent) GetFee() float64 {
 	if x != nil {
-		return x.Start
+		return x.Fee
 	}
-	return ""
+	return 0
 }
 
-func (x *GetHistoricCandlesResponse) GetEnd() string {
+func (x *CryptoWithdrawalEvent) GetTxId() string {
 	if x != nil {
-		return x.End
+		return x.TxId
 	}
 	return ""
 }
 
-func (x *GetHistoricCandlesResponse) GetInterval() string {
-	if x != nil {
-		return x.Interval
-	}
-	return ""
+type GetPendingWithdrawalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
+func (x *GetPendingWithdrawalsRequest) Reset() {
+	*x = GetPendingWithdrawalsRequest{}
+	mi := &file_rpc_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPendingWithdrawalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPendingWithdrawalsRequest) ProtoMessage() {}
+
+func (x *GetPendingWithdrawalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[118]
 	if x != nil {
-		return x.Candle
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type Candle struct {
+// Deprecated: Use GetPendingWithdrawalsRequest.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{118}
+}
+
+type PendingWithdrawal struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
-	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
-	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
-	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
-	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
-	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Type          string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	Address       string                 `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag    string                 `protobuf:"bytes,8,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Chain         string                 `protobuf:"bytes,9,opt,name=chain,proto3" json:"chain,omitempty"`
+	BankAccountId string                 `protobuf:"bytes,10,opt,name=bank_account_id,json=bankAccountId,proto3" json:"bank_account_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Candle) Reset() {
-	*x = Candle{}
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *PendingWithdrawal) Reset() {
+	*x = PendingWithdrawal{}
+	mi := &file_rpc_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Candle) String() string {
+func (x *PendingWithdrawal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Candle) ProtoMessage() {}
+func (*PendingWithdrawal) ProtoMessage() {}
 
-func (x *Candle) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[118]
+func (x *PendingWithdrawal) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7229,152 +7598,116 @@ func (x *Candle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
-func (*Candle) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{118}
+// Deprecated: Use PendingWithdrawal.ProtoReflect.Descriptor instead.
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *Candle) GetTime() string {
+func (x *PendingWithdrawal) GetId() string {
 	if x != nil {
-		return x.Time
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Candle) GetLow() float64 {
+func (x *PendingWithdrawal) GetExchange() string {
 	if x != nil {
-		return x.Low
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetHigh() float64 {
+func (x *PendingWithdrawal) GetCurrency() string {
 	if x != nil {
-		return x.High
+		return x.Currency
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetOpen() float64 {
+func (x *PendingWithdrawal) GetAmount() float64 {
 	if x != nil {
-		return x.Open
+		return x.Amount
 	}
 	return 0
 }
 
-func (x *Candle) GetClose() float64 {
+func (x *PendingWithdrawal) GetType() string {
 	if x != nil {
-		return x.Close
+		return x.Type
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetVolume() float64 {
+func (x *PendingWithdrawal) GetDescription() string {
 	if x != nil {
-		return x.Volume
+		return x.Description
 	}
-	return 0
+	return ""
 }
 
-func (x *Candle) GetIsPartial() bool {
+func (x *PendingWithdrawal) GetAddress() string {
 	if x != nil {
-		return x.IsPartial
+		return x.Address
 	}
-	return false
-}
-
-type AuditEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *AuditEvent) Reset() {
-	*x = AuditEvent{}
-	mi := &file_rpc_proto_msgTypes[119]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *AuditEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*AuditEvent) ProtoMessage() {}
-
-func (x *AuditEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[119]
+func (x *PendingWithdrawal) GetAddressTag() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AddressTag
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{119}
+	return ""
 }
 
-func (x *AuditEvent) GetType() string {
+func (x *PendingWithdrawal) GetChain() string {
 	if x != nil {
-		return x.Type
+		return x.Chain
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetIdentifier() string {
+func (x *PendingWithdrawal) GetBankAccountId() string {
 	if x != nil {
-		return x.Identifier
+		return x.BankAccountId
 	}
 	return ""
 }
 
-func (x *AuditEvent) GetMessage() string {
+func (x *PendingWithdrawal) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Message
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *AuditEvent) GetTimestamp() string {
+func (x *PendingWithdrawal) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type GCTScript struct {
+type GetPendingWithdrawalsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	Withdrawals   []*PendingWithdrawal   `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScript) Reset() {
-	*x = GCTScript{}
+func (x *GetPendingWithdrawalsResponse) Reset() {
+	*x = GetPendingWithdrawalsResponse{}
 	mi := &file_rpc_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScript) String() string {
+func (x *GetPendingWithdrawalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScript) ProtoMessage() {}
+func (*GetPendingWithdrawalsResponse) ProtoMessage() {}
 
-func (x *GCTScript) ProtoReflect() protoreflect.Message {
+func (x *GetPendingWithdrawalsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7386,60 +7719,40 @@ func (x *GCTScript) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
-func (*GCTScript) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPendingWithdrawalsResponse.ProtoReflect.Descriptor instead.
+func (*GetPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GCTScript) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
-}
-
-func (x *GCTScript) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GCTScript) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *GCTScript) GetNextRun() string {
+func (x *GetPendingWithdrawalsResponse) GetWithdrawals() []*PendingWithdrawal {
 	if x != nil {
-		return x.NextRun
+		return x.Withdrawals
 	}
-	return ""
+	return nil
 }
 
-type GCTScriptExecuteRequest struct {
+type ApproveWithdrawalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptExecuteRequest) Reset() {
-	*x = GCTScriptExecuteRequest{}
+func (x *ApproveWithdrawalRequest) Reset() {
+	*x = ApproveWithdrawalRequest{}
 	mi := &file_rpc_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptExecuteRequest) String() string {
+func (x *ApproveWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptExecuteRequest) ProtoMessage() {}
+func (*ApproveWithdrawalRequest) ProtoMessage() {}
 
-func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
+func (x *ApproveWithdrawalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7451,39 +7764,46 @@ func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ApproveWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*ApproveWithdrawalRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{121}
 }
 
-func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
+func (x *ApproveWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.Script
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptStopRequest struct {
+func (x *ApproveWithdrawalRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type RejectWithdrawalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStopRequest) Reset() {
-	*x = GCTScriptStopRequest{}
+func (x *RejectWithdrawalRequest) Reset() {
+	*x = RejectWithdrawalRequest{}
 	mi := &file_rpc_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStopRequest) String() string {
+func (x *RejectWithdrawalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStopRequest) ProtoMessage() {}
+func (*RejectWithdrawalRequest) ProtoMessage() {}
 
-func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
+func (x *RejectWithdrawalRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7495,38 +7815,39 @@ func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RejectWithdrawalRequest.ProtoReflect.Descriptor instead.
+func (*RejectWithdrawalRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *GCTScriptStopRequest) GetScript() *GCTScript {
+func (x *RejectWithdrawalRequest) GetId() string {
 	if x != nil {
-		return x.Script
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptStopAllRequest struct {
+type GetLoggerDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStopAllRequest) Reset() {
-	*x = GCTScriptStopAllRequest{}
+func (x *GetLoggerDetailsRequest) Reset() {
+	*x = GetLoggerDetailsRequest{}
 	mi := &file_rpc_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStopAllRequest) String() string {
+func (x *GetLoggerDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStopAllRequest) ProtoMessage() {}
+func (*GetLoggerDetailsRequest) ProtoMessage() {}
 
-func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7538,31 +7859,42 @@ func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{123}
 }
 
-type GCTScriptStatusRequest struct {
+func (x *GetLoggerDetailsRequest) GetLogger() string {
+	if x != nil {
+		return x.Logger
+	}
+	return ""
+}
+
+type GetLoggerDetailsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Info          bool                   `protobuf:"varint,1,opt,name=info,proto3" json:"info,omitempty"`
+	Debug         bool                   `protobuf:"varint,2,opt,name=debug,proto3" json:"debug,omitempty"`
+	Warn          bool                   `protobuf:"varint,3,opt,name=warn,proto3" json:"warn,omitempty"`
+	Error         bool                   `protobuf:"varint,4,opt,name=error,proto3" json:"error,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStatusRequest) Reset() {
-	*x = GCTScriptStatusRequest{}
+func (x *GetLoggerDetailsResponse) Reset() {
+	*x = GetLoggerDetailsResponse{}
 	mi := &file_rpc_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStatusRequest) String() string {
+func (x *GetLoggerDetailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStatusRequest) ProtoMessage() {}
+func (*GetLoggerDetailsResponse) ProtoMessage() {}
 
-func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+func (x *GetLoggerDetailsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7574,73 +7906,62 @@ func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetLoggerDetailsResponse.ProtoReflect.Descriptor instead.
+func (*GetLoggerDetailsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{124}
 }
 
-type GCTScriptListAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GCTScriptListAllRequest) Reset() {
-	*x = GCTScriptListAllRequest{}
-	mi := &file_rpc_proto_msgTypes[125]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetLoggerDetailsResponse) GetInfo() bool {
+	if x != nil {
+		return x.Info
+	}
+	return false
 }
 
-func (x *GCTScriptListAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetLoggerDetailsResponse) GetDebug() bool {
+	if x != nil {
+		return x.Debug
+	}
+	return false
 }
 
-func (*GCTScriptListAllRequest) ProtoMessage() {}
-
-func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[125]
+func (x *GetLoggerDetailsResponse) GetWarn() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Warn
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{125}
+func (x *GetLoggerDetailsResponse) GetError() bool {
+	if x != nil {
+		return x.Error
+	}
+	return false
 }
 
-type GCTScriptUploadRequest struct {
+type SetLoggerDetailsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
-	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	Logger        string                 `protobuf:"bytes,1,opt,name=logger,proto3" json:"logger,omitempty"`
+	Level         string                 `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptUploadRequest) Reset() {
-	*x = GCTScriptUploadRequest{}
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *SetLoggerDetailsRequest) Reset() {
+	*x = SetLoggerDetailsRequest{}
+	mi := &file_rpc_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptUploadRequest) String() string {
+func (x *SetLoggerDetailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptUploadRequest) ProtoMessage() {}
+func (*SetLoggerDetailsRequest) ProtoMessage() {}
 
-func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[126]
+func (x *SetLoggerDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7651,67 +7972,98 @@ func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{126}
+// Deprecated: Use SetLoggerDetailsRequest.ProtoReflect.Descriptor instead.
+func (*SetLoggerDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *GCTScriptUploadRequest) GetScriptName() string {
+func (x *SetLoggerDetailsRequest) GetLogger() string {
 	if x != nil {
-		return x.ScriptName
+		return x.Logger
 	}
 	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetScriptData() string {
+func (x *SetLoggerDetailsRequest) GetLevel() string {
 	if x != nil {
-		return x.ScriptData
+		return x.Level
 	}
 	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetData() []byte {
+type GetExchangePairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExchangePairsRequest) Reset() {
+	*x = GetExchangePairsRequest{}
+	mi := &file_rpc_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExchangePairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExchangePairsRequest) ProtoMessage() {}
+
+func (x *GetExchangePairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[126]
 	if x != nil {
-		return x.Data
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GCTScriptUploadRequest) GetArchived() bool {
+// Deprecated: Use GetExchangePairsRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *GetExchangePairsRequest) GetExchange() string {
 	if x != nil {
-		return x.Archived
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-func (x *GCTScriptUploadRequest) GetOverwrite() bool {
+func (x *GetExchangePairsRequest) GetAsset() string {
 	if x != nil {
-		return x.Overwrite
+		return x.Asset
 	}
-	return false
+	return ""
 }
 
-type GCTScriptReadScriptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetExchangePairsResponse struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	SupportedAssets map[string]*PairsSupported `protobuf:"bytes,1,rep,name=supported_assets,json=supportedAssets,proto3" json:"supported_assets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GCTScriptReadScriptRequest) Reset() {
-	*x = GCTScriptReadScriptRequest{}
+func (x *GetExchangePairsResponse) Reset() {
+	*x = GetExchangePairsResponse{}
 	mi := &file_rpc_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptReadScriptRequest) String() string {
+func (x *GetExchangePairsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptReadScriptRequest) ProtoMessage() {}
+func (*GetExchangePairsResponse) ProtoMessage() {}
 
-func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
+func (x *GetExchangePairsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7723,39 +8075,42 @@ func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExchangePairsResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+func (x *GetExchangePairsResponse) GetSupportedAssets() map[string]*PairsSupported {
 	if x != nil {
-		return x.Script
+		return x.SupportedAssets
 	}
 	return nil
 }
 
-type GCTScriptQueryRequest struct {
+type SetExchangePairRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pairs         []*CurrencyPair        `protobuf:"bytes,3,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	Enable        bool                   `protobuf:"varint,4,opt,name=enable,proto3" json:"enable,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptQueryRequest) Reset() {
-	*x = GCTScriptQueryRequest{}
+func (x *SetExchangePairRequest) Reset() {
+	*x = SetExchangePairRequest{}
 	mi := &file_rpc_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptQueryRequest) String() string {
+func (x *SetExchangePairRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptQueryRequest) ProtoMessage() {}
+func (*SetExchangePairRequest) ProtoMessage() {}
 
-func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *SetExchangePairRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7767,40 +8122,62 @@ func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetExchangePairRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangePairRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
+func (x *SetExchangePairRequest) GetExchange() string {
 	if x != nil {
-		return x.Script
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetExchangePairRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *SetExchangePairRequest) GetPairs() []*CurrencyPair {
+	if x != nil {
+		return x.Pairs
 	}
 	return nil
 }
 
-type GCTScriptAutoLoadRequest struct {
+func (x *SetExchangePairRequest) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+type GetOrderbookStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptAutoLoadRequest) Reset() {
-	*x = GCTScriptAutoLoadRequest{}
+func (x *GetOrderbookStreamRequest) Reset() {
+	*x = GetOrderbookStreamRequest{}
 	mi := &file_rpc_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptAutoLoadRequest) String() string {
+func (x *GetOrderbookStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
+func (*GetOrderbookStreamRequest) ProtoMessage() {}
 
-func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
+func (x *GetOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7812,47 +8189,53 @@ func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
-func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderbookStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *GCTScriptAutoLoadRequest) GetScript() string {
+func (x *GetOrderbookStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Script
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
+func (x *GetOrderbookStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Status
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-type GCTScriptStatusResponse struct {
+func (x *GetOrderbookStreamRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+type GetExchangeOrderbookStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptStatusResponse) Reset() {
-	*x = GCTScriptStatusResponse{}
+func (x *GetExchangeOrderbookStreamRequest) Reset() {
+	*x = GetExchangeOrderbookStreamRequest{}
 	mi := &file_rpc_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptStatusResponse) String() string {
+func (x *GetExchangeOrderbookStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptStatusResponse) ProtoMessage() {}
+func (*GetExchangeOrderbookStreamRequest) ProtoMessage() {}
 
-func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
+func (x *GetExchangeOrderbookStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7864,48 +8247,41 @@ func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExchangeOrderbookStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *GCTScriptStatusResponse) GetStatus() string {
+func (x *GetExchangeOrderbookStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Status
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
-	if x != nil {
-		return x.Scripts
-	}
-	return nil
-}
-
-type GCTScriptQueryResponse struct {
+type GetTickerStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GCTScriptQueryResponse) Reset() {
-	*x = GCTScriptQueryResponse{}
+func (x *GetTickerStreamRequest) Reset() {
+	*x = GetTickerStreamRequest{}
 	mi := &file_rpc_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GCTScriptQueryResponse) String() string {
+func (x *GetTickerStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GCTScriptQueryResponse) ProtoMessage() {}
+func (*GetTickerStreamRequest) ProtoMessage() {}
 
-func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
+func (x *GetTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7917,54 +8293,53 @@ func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
-func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetTickerStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *GCTScriptQueryResponse) GetStatus() string {
+func (x *GetTickerStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Status
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
+func (x *GetTickerStreamRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Script
+		return x.Pair
 	}
 	return nil
 }
 
-func (x *GCTScriptQueryResponse) GetData() string {
+func (x *GetTickerStreamRequest) GetAssetType() string {
 	if x != nil {
-		return x.Data
+		return x.AssetType
 	}
 	return ""
 }
 
-type GenericResponse struct {
+type GetExchangeTickerStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenericResponse) Reset() {
-	*x = GenericResponse{}
+func (x *GetExchangeTickerStreamRequest) Reset() {
+	*x = GetExchangeTickerStreamRequest{}
 	mi := &file_rpc_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenericResponse) String() string {
+func (x *GetExchangeTickerStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenericResponse) ProtoMessage() {}
+func (*GetExchangeTickerStreamRequest) ProtoMessage() {}
 
-func (x *GenericResponse) ProtoReflect() protoreflect.Message {
+func (x *GetExchangeTickerStreamRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7976,48 +8351,43 @@ func (x *GenericResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
-func (*GenericResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetExchangeTickerStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *GenericResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
-
-func (x *GenericResponse) GetData() string {
+func (x *GetExchangeTickerStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Data
+		return x.Exchange
 	}
 	return ""
 }
 
-type SetExchangeAssetRequest struct {
+type GetAuditEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OrderBy       string                 `protobuf:"bytes,3,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeAssetRequest) Reset() {
-	*x = SetExchangeAssetRequest{}
+func (x *GetAuditEventRequest) Reset() {
+	*x = GetAuditEventRequest{}
 	mi := &file_rpc_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeAssetRequest) String() string {
+func (x *GetAuditEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeAssetRequest) ProtoMessage() {}
+func (*GetAuditEventRequest) ProtoMessage() {}
 
-func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAuditEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8029,54 +8399,67 @@ func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuditEventRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *SetExchangeAssetRequest) GetExchange() string {
+func (x *GetAuditEventRequest) GetStartDate() string {
 	if x != nil {
-		return x.Exchange
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *SetExchangeAssetRequest) GetAsset() string {
+func (x *GetAuditEventRequest) GetEndDate() string {
 	if x != nil {
-		return x.Asset
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *SetExchangeAssetRequest) GetEnable() bool {
+func (x *GetAuditEventRequest) GetOrderBy() string {
 	if x != nil {
-		return x.Enable
+		return x.OrderBy
 	}
-	return false
+	return ""
 }
 
-type SetExchangeAllPairsRequest struct {
+func (x *GetAuditEventRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAuditEventRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetAuditEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	Events        []*AuditEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeAllPairsRequest) Reset() {
-	*x = SetExchangeAllPairsRequest{}
+func (x *GetAuditEventResponse) Reset() {
+	*x = GetAuditEventResponse{}
 	mi := &file_rpc_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeAllPairsRequest) String() string {
+func (x *GetAuditEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeAllPairsRequest) ProtoMessage() {}
+func (*GetAuditEventResponse) ProtoMessage() {}
 
-func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAuditEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8088,46 +8471,43 @@ func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAuditEventResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *SetExchangeAllPairsRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *SetExchangeAllPairsRequest) GetEnable() bool {
+func (x *GetAuditEventResponse) GetEvents() []*AuditEvent {
 	if x != nil {
-		return x.Enable
+		return x.Events
 	}
-	return false
+	return nil
 }
 
-type UpdateExchangeSupportedPairsRequest struct {
+type GetSavedTradesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) Reset() {
-	*x = UpdateExchangeSupportedPairsRequest{}
+func (x *GetSavedTradesRequest) Reset() {
+	*x = GetSavedTradesRequest{}
 	mi := &file_rpc_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) String() string {
+func (x *GetSavedTradesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
+func (*GetSavedTradesRequest) ProtoMessage() {}
 
-func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
+func (x *GetSavedTradesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8139,39 +8519,71 @@ func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetSavedTradesRequest.ProtoReflect.Descriptor instead.
+func (*GetSavedTradesRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{135}
 }
 
-func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
+func (x *GetSavedTradesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-type GetExchangeAssetsRequest struct {
+func (x *GetSavedTradesRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetSavedTradesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *GetSavedTradesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *GetSavedTradesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type SavedTrades struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Price         float64                `protobuf:"fixed64,1,opt,name=price,proto3" json:"price,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Side          string                 `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TradeId       string                 `protobuf:"bytes,5,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetExchangeAssetsRequest) Reset() {
-	*x = GetExchangeAssetsRequest{}
+func (x *SavedTrades) Reset() {
+	*x = SavedTrades{}
 	mi := &file_rpc_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetExchangeAssetsRequest) String() string {
+func (x *SavedTrades) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetExchangeAssetsRequest) ProtoMessage() {}
+func (*SavedTrades) ProtoMessage() {}
 
-func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
+func (x *SavedTrades) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8183,84 +8595,71 @@ func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SavedTrades.ProtoReflect.Descriptor instead.
+func (*SavedTrades) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{136}
 }
 
-func (x *GetExchangeAssetsRequest) GetExchange() string {
+func (x *SavedTrades) GetPrice() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.Price
 	}
-	return ""
-}
-
-type GetExchangeAssetsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetExchangeAssetsResponse) Reset() {
-	*x = GetExchangeAssetsResponse{}
-	mi := &file_rpc_proto_msgTypes[137]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *GetExchangeAssetsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SavedTrades) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (*GetExchangeAssetsResponse) ProtoMessage() {}
-
-func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[137]
+func (x *SavedTrades) GetSide() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Side
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
-func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{137}
+func (x *SavedTrades) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
 }
 
-func (x *GetExchangeAssetsResponse) GetAssets() string {
+func (x *SavedTrades) GetTradeId() string {
 	if x != nil {
-		return x.Assets
+		return x.TradeId
 	}
 	return ""
 }
 
-type WebsocketGetInfoRequest struct {
+type SavedTradesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Trades        []*SavedTrades         `protobuf:"bytes,4,rep,name=trades,proto3" json:"trades,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetInfoRequest) Reset() {
-	*x = WebsocketGetInfoRequest{}
-	mi := &file_rpc_proto_msgTypes[138]
+func (x *SavedTradesResponse) Reset() {
+	*x = SavedTradesResponse{}
+	mi := &file_rpc_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetInfoRequest) String() string {
+func (x *SavedTradesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetInfoRequest) ProtoMessage() {}
+func (*SavedTradesResponse) ProtoMessage() {}
 
-func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[138]
+func (x *SavedTradesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8271,46 +8670,68 @@ func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{138}
+// Deprecated: Use SavedTradesResponse.ProtoReflect.Descriptor instead.
+func (*SavedTradesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{137}
 }
 
-func (x *WebsocketGetInfoRequest) GetExchange() string {
+func (x *SavedTradesResponse) GetExchangeName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ExchangeName
 	}
 	return ""
 }
 
-type WebsocketGetInfoResponse struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Supported              bool                   `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
-	Enabled                bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	AuthenticatedSupported bool                   `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
-	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
-	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
-	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *SavedTradesResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *WebsocketGetInfoResponse) Reset() {
-	*x = WebsocketGetInfoResponse{}
-	mi := &file_rpc_proto_msgTypes[139]
+func (x *SavedTradesResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *SavedTradesResponse) GetTrades() []*SavedTrades {
+	if x != nil {
+		return x.Trades
+	}
+	return nil
+}
+
+type ConvertTradesToCandlesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	TimeInterval  int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	Sync          bool                   `protobuf:"varint,7,opt,name=sync,proto3" json:"sync,omitempty"`
+	Force         bool                   `protobuf:"varint,8,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConvertTradesToCandlesRequest) Reset() {
+	*x = ConvertTradesToCandlesRequest{}
+	mi := &file_rpc_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetInfoResponse) String() string {
+func (x *ConvertTradesToCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetInfoResponse) ProtoMessage() {}
+func (*ConvertTradesToCandlesRequest) ProtoMessage() {}
 
-func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[139]
+func (x *ConvertTradesToCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8321,83 +8742,99 @@ func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{139}
+// Deprecated: Use ConvertTradesToCandlesRequest.ProtoReflect.Descriptor instead.
+func (*ConvertTradesToCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *WebsocketGetInfoResponse) GetExchange() string {
+func (x *ConvertTradesToCandlesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetSupported() bool {
+func (x *ConvertTradesToCandlesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Supported
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-func (x *WebsocketGetInfoResponse) GetEnabled() bool {
+func (x *ConvertTradesToCandlesRequest) GetAssetType() string {
 	if x != nil {
-		return x.Enabled
+		return x.AssetType
 	}
-	return false
+	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
+func (x *ConvertTradesToCandlesRequest) GetStart() string {
 	if x != nil {
-		return x.AuthenticatedSupported
+		return x.Start
 	}
-	return false
+	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
+func (x *ConvertTradesToCandlesRequest) GetEnd() string {
 	if x != nil {
-		return x.Authenticated
+		return x.End
 	}
-	return false
+	return ""
 }
 
-func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
+func (x *ConvertTradesToCandlesRequest) GetTimeInterval() int64 {
 	if x != nil {
-		return x.RunningUrl
+		return x.TimeInterval
 	}
-	return ""
+	return 0
 }
 
-func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
+func (x *ConvertTradesToCandlesRequest) GetSync() bool {
 	if x != nil {
-		return x.ProxyAddress
+		return x.Sync
 	}
-	return ""
+	return false
 }
 
-type WebsocketSetEnabledRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ConvertTradesToCandlesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
 }
 
-func (x *WebsocketSetEnabledRequest) Reset() {
-	*x = WebsocketSetEnabledRequest{}
-	mi := &file_rpc_proto_msgTypes[140]
+type GetHistoricCandlesRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Start                 string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End                   string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	TimeInterval          int64                  `protobuf:"varint,6,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
+	ExRequest             bool                   `protobuf:"varint,7,opt,name=ex_request,json=exRequest,proto3" json:"ex_request,omitempty"`
+	Sync                  bool                   `protobuf:"varint,8,opt,name=sync,proto3" json:"sync,omitempty"`
+	UseDb                 bool                   `protobuf:"varint,9,opt,name=use_db,json=useDb,proto3" json:"use_db,omitempty"`
+	FillMissingWithTrades bool                   `protobuf:"varint,10,opt,name=fill_missing_with_trades,json=fillMissingWithTrades,proto3" json:"fill_missing_with_trades,omitempty"`
+	Force                 bool                   `protobuf:"varint,11,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetHistoricCandlesRequest) Reset() {
+	*x = GetHistoricCandlesRequest{}
+	mi := &file_rpc_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetEnabledRequest) String() string {
+func (x *GetHistoricCandlesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetEnabledRequest) ProtoMessage() {}
+func (*GetHistoricCandlesRequest) ProtoMessage() {}
 
-func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[140]
+func (x *GetHistoricCandlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8408,94 +8845,115 @@ func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{140}
+// Deprecated: Use GetHistoricCandlesRequest.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *WebsocketSetEnabledRequest) GetExchange() string {
+func (x *GetHistoricCandlesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WebsocketSetEnabledRequest) GetEnable() bool {
+func (x *GetHistoricCandlesRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Enable
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-type WebsocketGetSubscriptionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetHistoricCandlesRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
 }
 
-func (x *WebsocketGetSubscriptionsRequest) Reset() {
-	*x = WebsocketGetSubscriptionsRequest{}
-	mi := &file_rpc_proto_msgTypes[141]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetHistoricCandlesRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (x *WebsocketGetSubscriptionsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetHistoricCandlesRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
-func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
+func (x *GetHistoricCandlesRequest) GetTimeInterval() int64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
+}
 
-func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[141]
+func (x *GetHistoricCandlesRequest) GetExRequest() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ExRequest
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{141}
+func (x *GetHistoricCandlesRequest) GetSync() bool {
+	if x != nil {
+		return x.Sync
+	}
+	return false
 }
 
-func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
+func (x *GetHistoricCandlesRequest) GetUseDb() bool {
 	if x != nil {
-		return x.Exchange
+		return x.UseDb
 	}
-	return ""
+	return false
 }
 
-type WebsocketSubscription struct {
+func (x *GetHistoricCandlesRequest) GetFillMissingWithTrades() bool {
+	if x != nil {
+		return x.FillMissingWithTrades
+	}
+	return false
+}
+
+func (x *GetHistoricCandlesRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type GetHistoricCandlesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	Interval      string                 `protobuf:"bytes,6,opt,name=interval,proto3" json:"interval,omitempty"`
+	Candle        []*Candle              `protobuf:"bytes,5,rep,name=candle,proto3" json:"candle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSubscription) Reset() {
-	*x = WebsocketSubscription{}
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GetHistoricCandlesResponse) Reset() {
+	*x = GetHistoricCandlesResponse{}
+	mi := &file_rpc_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSubscription) String() string {
+func (x *GetHistoricCandlesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSubscription) ProtoMessage() {}
+func (*GetHistoricCandlesResponse) ProtoMessage() {}
 
-func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[142]
+func (x *GetHistoricCandlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8506,62 +8964,81 @@ func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
-func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{142}
+// Deprecated: Use GetHistoricCandlesResponse.ProtoReflect.Descriptor instead.
+func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *WebsocketSubscription) GetChannel() string {
+func (x *GetHistoricCandlesResponse) GetExchange() string {
 	if x != nil {
-		return x.Channel
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *WebsocketSubscription) GetPairs() string {
+func (x *GetHistoricCandlesResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Pairs
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetHistoricCandlesResponse) GetStart() string {
+	if x != nil {
+		return x.Start
 	}
 	return ""
 }
 
-func (x *WebsocketSubscription) GetAsset() string {
+func (x *GetHistoricCandlesResponse) GetEnd() string {
 	if x != nil {
-		return x.Asset
+		return x.End
 	}
 	return ""
 }
 
-func (x *WebsocketSubscription) GetParams() string {
+func (x *GetHistoricCandlesResponse) GetInterval() string {
 	if x != nil {
-		return x.Params
+		return x.Interval
 	}
 	return ""
 }
 
-type WebsocketGetSubscriptionsResponse struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+func (x *GetHistoricCandlesResponse) GetCandle() []*Candle {
+	if x != nil {
+		return x.Candle
+	}
+	return nil
+}
+
+type Candle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Low           float64                `protobuf:"fixed64,2,opt,name=low,proto3" json:"low,omitempty"`
+	High          float64                `protobuf:"fixed64,3,opt,name=high,proto3" json:"high,omitempty"`
+	Open          float64                `protobuf:"fixed64,4,opt,name=open,proto3" json:"open,omitempty"`
+	Close         float64                `protobuf:"fixed64,5,opt,name=close,proto3" json:"close,omitempty"`
+	Volume        float64                `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	IsPartial     bool                   `protobuf:"varint,7,opt,name=is_partial,json=isPartial,proto3" json:"is_partial,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketGetSubscriptionsResponse) Reset() {
-	*x = WebsocketGetSubscriptionsResponse{}
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *Candle) Reset() {
+	*x = Candle{}
+	mi := &file_rpc_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketGetSubscriptionsResponse) String() string {
+func (x *Candle) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
+func (*Candle) ProtoMessage() {}
 
-func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[143]
+func (x *Candle) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8572,100 +9049,85 @@ func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
-func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{143}
+// Deprecated: Use Candle.ProtoReflect.Descriptor instead.
+func (*Candle) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
+func (x *Candle) GetTime() string {
 	if x != nil {
-		return x.Exchange
+		return x.Time
 	}
 	return ""
 }
 
-func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
+func (x *Candle) GetLow() float64 {
 	if x != nil {
-		return x.Subscriptions
+		return x.Low
 	}
-	return nil
-}
-
-type WebsocketSetProxyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *WebsocketSetProxyRequest) Reset() {
-	*x = WebsocketSetProxyRequest{}
-	mi := &file_rpc_proto_msgTypes[144]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *WebsocketSetProxyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Candle) GetHigh() float64 {
+	if x != nil {
+		return x.High
+	}
+	return 0
 }
 
-func (*WebsocketSetProxyRequest) ProtoMessage() {}
-
-func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[144]
+func (x *Candle) GetOpen() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Open
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{144}
+func (x *Candle) GetClose() float64 {
+	if x != nil {
+		return x.Close
+	}
+	return 0
 }
 
-func (x *WebsocketSetProxyRequest) GetExchange() string {
+func (x *Candle) GetVolume() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.Volume
 	}
-	return ""
+	return 0
 }
 
-func (x *WebsocketSetProxyRequest) GetProxy() string {
+func (x *Candle) GetIsPartial() bool {
 	if x != nil {
-		return x.Proxy
+		return x.IsPartial
 	}
-	return ""
+	return false
 }
 
-type WebsocketSetURLRequest struct {
+type AuditEvent struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier    string                 `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WebsocketSetURLRequest) Reset() {
-	*x = WebsocketSetURLRequest{}
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_rpc_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebsocketSetURLRequest) String() string {
+func (x *AuditEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebsocketSetURLRequest) ProtoMessage() {}
+func (*AuditEvent) ProtoMessage() {}
 
-func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[145]
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8676,52 +9138,64 @@ func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
-func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{145}
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *WebsocketSetURLRequest) GetExchange() string {
+func (x *AuditEvent) GetType() string {
 	if x != nil {
-		return x.Exchange
+		return x.Type
 	}
 	return ""
 }
 
-func (x *WebsocketSetURLRequest) GetUrl() string {
+func (x *AuditEvent) GetIdentifier() string {
 	if x != nil {
-		return x.Url
+		return x.Identifier
 	}
 	return ""
 }
 
-type FindMissingCandlePeriodsRequest struct {
+func (x *AuditEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+type GCTScript struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	Uuid          string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path          string                 `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NextRun       string                 `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingCandlePeriodsRequest) Reset() {
-	*x = FindMissingCandlePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GCTScript) Reset() {
+	*x = GCTScript{}
+	mi := &file_rpc_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingCandlePeriodsRequest) String() string {
+func (x *GCTScript) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
+func (*GCTScript) ProtoMessage() {}
 
-func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[146]
+func (x *GCTScript) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8732,79 +9206,61 @@ func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{146}
+// Deprecated: Use GCTScript.ProtoReflect.Descriptor instead.
+func (*GCTScript) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
+func (x *GCTScript) GetUuid() string {
 	if x != nil {
-		return x.ExchangeName
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
+func (x *GCTScript) GetName() string {
 	if x != nil {
-		return x.AssetType
+		return x.Name
 	}
 	return ""
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *FindMissingCandlePeriodsRequest) GetStart() string {
+func (x *GCTScript) GetPath() string {
 	if x != nil {
-		return x.Start
+		return x.Path
 	}
 	return ""
 }
 
-func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
+func (x *GCTScript) GetNextRun() string {
 	if x != nil {
-		return x.End
+		return x.NextRun
 	}
 	return ""
 }
 
-type FindMissingTradePeriodsRequest struct {
+type GCTScriptExecuteRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
-	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingTradePeriodsRequest) Reset() {
-	*x = FindMissingTradePeriodsRequest{}
-	mi := &file_rpc_proto_msgTypes[147]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GCTScriptExecuteRequest) Reset() {
+	*x = GCTScriptExecuteRequest{}
+	mi := &file_rpc_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingTradePeriodsRequest) String() string {
+func (x *GCTScriptExecuteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
+func (*GCTScriptExecuteRequest) ProtoMessage() {}
 
-func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[147]
+func (x *GCTScriptExecuteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8815,72 +9271,83 @@ func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
-func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{147}
+// Deprecated: Use GCTScriptExecuteRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
+func (x *GCTScriptExecuteRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.ExchangeName
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+type GCTScriptStopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *GCTScriptStopRequest) Reset() {
+	*x = GCTScriptStopRequest{}
+	mi := &file_rpc_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingTradePeriodsRequest) GetStart() string {
+func (x *GCTScriptStopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GCTScriptStopRequest) ProtoMessage() {}
+
+func (x *GCTScriptStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[145]
 	if x != nil {
-		return x.Start
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FindMissingTradePeriodsRequest) GetEnd() string {
+// Deprecated: Use GCTScriptStopRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GCTScriptStopRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.End
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-type FindMissingIntervalsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
-	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
-	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GCTScriptStopAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingIntervalsResponse) Reset() {
-	*x = FindMissingIntervalsResponse{}
-	mi := &file_rpc_proto_msgTypes[148]
+func (x *GCTScriptStopAllRequest) Reset() {
+	*x = GCTScriptStopAllRequest{}
+	mi := &file_rpc_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingIntervalsResponse) String() string {
+func (x *GCTScriptStopAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindMissingIntervalsResponse) ProtoMessage() {}
+func (*GCTScriptStopAllRequest) ProtoMessage() {}
 
-func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[148]
+func (x *GCTScriptStopAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8891,69 +9358,68 @@ func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
-func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{148}
+// Deprecated: Use GCTScriptStopAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{146}
 }
 
-func (x *FindMissingIntervalsResponse) GetExchangeName() string {
-	if x != nil {
-		return x.ExchangeName
-	}
-	return ""
+type GCTScriptStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FindMissingIntervalsResponse) GetAssetType() string {
-	if x != nil {
-		return x.AssetType
-	}
-	return ""
+func (x *GCTScriptStatusRequest) Reset() {
+	*x = GCTScriptStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *GCTScriptStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
+func (*GCTScriptStatusRequest) ProtoMessage() {}
+
+func (x *GCTScriptStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[147]
 	if x != nil {
-		return x.MissingPeriods
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FindMissingIntervalsResponse) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
+// Deprecated: Use GCTScriptStatusRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{147}
 }
 
-type SetExchangeTradeProcessingRequest struct {
+type GCTScriptListAllRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetExchangeTradeProcessingRequest) Reset() {
-	*x = SetExchangeTradeProcessingRequest{}
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GCTScriptListAllRequest) Reset() {
+	*x = GCTScriptListAllRequest{}
+	mi := &file_rpc_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetExchangeTradeProcessingRequest) String() string {
+func (x *GCTScriptListAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
+func (*GCTScriptListAllRequest) ProtoMessage() {}
 
-func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[149]
+func (x *GCTScriptListAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8964,65 +9430,37 @@ func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
-func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{149}
-}
-
-func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
-	if x != nil {
-		return x.Status
-	}
-	return false
+// Deprecated: Use GCTScriptListAllRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{148}
 }
 
-type UpsertDataHistoryJobRequest struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
-	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type GCTScriptUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScriptName    string                 `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptData    string                 `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Archived      bool                   `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Overwrite     bool                   `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) Reset() {
-	*x = UpsertDataHistoryJobRequest{}
-	mi := &file_rpc_proto_msgTypes[150]
+func (x *GCTScriptUploadRequest) Reset() {
+	*x = GCTScriptUploadRequest{}
+	mi := &file_rpc_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) String() string {
+func (x *GCTScriptUploadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
+func (*GCTScriptUploadRequest) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[150]
+func (x *GCTScriptUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9033,165 +9471,111 @@ func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{150}
+// Deprecated: Use GCTScriptUploadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{149}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetNickname() string {
+func (x *GCTScriptUploadRequest) GetScriptName() string {
 	if x != nil {
-		return x.Nickname
+		return x.ScriptName
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetExchange() string {
+func (x *GCTScriptUploadRequest) GetScriptData() string {
 	if x != nil {
-		return x.Exchange
+		return x.ScriptData
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobRequest) GetAsset() string {
+func (x *GCTScriptUploadRequest) GetData() []byte {
 	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
+		return x.Data
 	}
 	return nil
 }
 
-func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
-	if x != nil {
-		return x.EndDate
-	}
-	return ""
-}
-
-func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
-	if x != nil {
-		return x.Interval
-	}
-	return 0
-}
-
-func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
-	if x != nil {
-		return x.RequestSizeLimit
-	}
-	return 0
-}
-
-func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
-	if x != nil {
-		return x.DataType
-	}
-	return 0
-}
-
-func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
-	if x != nil {
-		return x.MaxRetryAttempts
-	}
-	return 0
-}
-
-func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
+func (x *GCTScriptUploadRequest) GetArchived() bool {
 	if x != nil {
-		return x.BatchSize
+		return x.Archived
 	}
-	return 0
+	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
+func (x *GCTScriptUploadRequest) GetOverwrite() bool {
 	if x != nil {
-		return x.InsertOnly
+		return x.Overwrite
 	}
 	return false
 }
 
-func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
-	if x != nil {
-		return x.ConversionInterval
-	}
-	return 0
+type GCTScriptReadScriptRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
-	if x != nil {
-		return x.OverwriteExistingData
-	}
-	return false
+func (x *GCTScriptReadScriptRequest) Reset() {
+	*x = GCTScriptReadScriptRequest{}
+	mi := &file_rpc_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
-	if x != nil {
-		return x.PrerequisiteJobNickname
-	}
-	return ""
+func (x *GCTScriptReadScriptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
-	if x != nil {
-		return x.DecimalPlaceComparison
-	}
-	return 0
-}
+func (*GCTScriptReadScriptRequest) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
+func (x *GCTScriptReadScriptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[150]
 	if x != nil {
-		return x.SecondaryExchangeName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
-	if x != nil {
-		return x.IssueTolerancePercentage
-	}
-	return 0
+// Deprecated: Use GCTScriptReadScriptRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{150}
 }
 
-func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
+func (x *GCTScriptReadScriptRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.ReplaceOnIssue
+		return x.Script
 	}
-	return false
+	return nil
 }
 
-type InsertSequentialJobsRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+type GCTScriptQueryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        *GCTScript             `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsRequest) Reset() {
-	*x = InsertSequentialJobsRequest{}
+func (x *GCTScriptQueryRequest) Reset() {
+	*x = GCTScriptQueryRequest{}
 	mi := &file_rpc_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsRequest) String() string {
+func (x *GCTScriptQueryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsRequest) ProtoMessage() {}
+func (*GCTScriptQueryRequest) ProtoMessage() {}
 
-func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
+func (x *GCTScriptQueryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9203,39 +9587,40 @@ func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCTScriptQueryRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{151}
 }
 
-func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
+func (x *GCTScriptQueryRequest) GetScript() *GCTScript {
 	if x != nil {
-		return x.Jobs
+		return x.Script
 	}
 	return nil
 }
 
-type InsertSequentialJobsResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+type GCTScriptAutoLoadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Script        string                 `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSequentialJobsResponse) Reset() {
-	*x = InsertSequentialJobsResponse{}
+func (x *GCTScriptAutoLoadRequest) Reset() {
+	*x = GCTScriptAutoLoadRequest{}
 	mi := &file_rpc_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSequentialJobsResponse) String() string {
+func (x *GCTScriptAutoLoadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSequentialJobsResponse) ProtoMessage() {}
+func (*GCTScriptAutoLoadRequest) ProtoMessage() {}
 
-func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
+func (x *GCTScriptAutoLoadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9247,40 +9632,47 @@ func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
-func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCTScriptAutoLoadRequest.ProtoReflect.Descriptor instead.
+func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{152}
 }
 
-func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
+func (x *GCTScriptAutoLoadRequest) GetScript() string {
 	if x != nil {
-		return x.Jobs
+		return x.Script
 	}
-	return nil
+	return ""
 }
 
-type UpsertDataHistoryJobResponse struct {
+func (x *GCTScriptAutoLoadRequest) GetStatus() bool {
+	if x != nil {
+		return x.Status
+	}
+	return false
+}
+
+type GCTScriptStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Scripts       []*GCTScript           `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpsertDataHistoryJobResponse) Reset() {
-	*x = UpsertDataHistoryJobResponse{}
+func (x *GCTScriptStatusResponse) Reset() {
+	*x = GCTScriptStatusResponse{}
 	mi := &file_rpc_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpsertDataHistoryJobResponse) String() string {
+func (x *GCTScriptStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
+func (*GCTScriptStatusResponse) ProtoMessage() {}
 
-func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
+func (x *GCTScriptStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9292,48 +9684,48 @@ func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
-func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCTScriptStatusResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{153}
 }
 
-func (x *UpsertDataHistoryJobResponse) GetMessage() string {
+func (x *GCTScriptStatusResponse) GetStatus() string {
 	if x != nil {
-		return x.Message
+		return x.Status
 	}
 	return ""
 }
 
-func (x *UpsertDataHistoryJobResponse) GetJobId() string {
+func (x *GCTScriptStatusResponse) GetScripts() []*GCTScript {
 	if x != nil {
-		return x.JobId
+		return x.Scripts
 	}
-	return ""
+	return nil
 }
 
-type GetDataHistoryJobDetailsRequest struct {
+type GCTScriptQueryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Script        *GCTScript             `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	Data          string                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataHistoryJobDetailsRequest) Reset() {
-	*x = GetDataHistoryJobDetailsRequest{}
+func (x *GCTScriptQueryResponse) Reset() {
+	*x = GCTScriptQueryResponse{}
 	mi := &file_rpc_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobDetailsRequest) String() string {
+func (x *GCTScriptQueryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
+func (*GCTScriptQueryResponse) ProtoMessage() {}
 
-func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
+func (x *GCTScriptQueryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9345,74 +9737,54 @@ func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GCTScriptQueryResponse.ProtoReflect.Descriptor instead.
+func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{154}
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetId() string {
+func (x *GCTScriptQueryResponse) GetStatus() string {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
+func (x *GCTScriptQueryResponse) GetScript() *GCTScript {
 	if x != nil {
-		return x.Nickname
+		return x.Script
 	}
-	return ""
+	return nil
 }
 
-func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
+func (x *GCTScriptQueryResponse) GetData() string {
 	if x != nil {
-		return x.FullDetails
+		return x.Data
 	}
-	return false
+	return ""
 }
 
-type DataHistoryJob struct {
-	state                    protoimpl.MessageState  `protogen:"open.v1"`
-	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
-	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
-	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
-	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
-	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
-	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
-	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
-	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
-	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
-	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
-	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
-	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
-	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
-	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+type GenericResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data          string                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) Reset() {
-	*x = DataHistoryJob{}
+func (x *GenericResponse) Reset() {
+	*x = GenericResponse{}
 	mi := &file_rpc_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) String() string {
+func (x *GenericResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJob) ProtoMessage() {}
+func (*GenericResponse) ProtoMessage() {}
 
-func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
+func (x *GenericResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9424,191 +9796,203 @@ func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
-func (*DataHistoryJob) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenericResponse.ProtoReflect.Descriptor instead.
+func (*GenericResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_proto_rawDescGZIP(), []int{155}
 }
 
-func (x *DataHistoryJob) GetId() string {
+func (x *GenericResponse) GetStatus() string {
 	if x != nil {
-		return x.Id
+		return x.Status
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetNickname() string {
+func (x *GenericResponse) GetData() string {
 	if x != nil {
-		return x.Nickname
+		return x.Data
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+type SetExchangeAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Enable        bool                   `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+func (x *SetExchangeAssetRequest) Reset() {
+	*x = SetExchangeAssetRequest{}
+	mi := &file_rpc_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) GetPair() *CurrencyPair {
+func (x *SetExchangeAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetExchangeAssetRequest) ProtoMessage() {}
+
+func (x *SetExchangeAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[156]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetStartDate() string {
+// Deprecated: Use SetExchangeAssetRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAssetRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *SetExchangeAssetRequest) GetExchange() string {
 	if x != nil {
-		return x.StartDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetEndDate() string {
+func (x *SetExchangeAssetRequest) GetAsset() string {
 	if x != nil {
-		return x.EndDate
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *DataHistoryJob) GetInterval() int64 {
+func (x *SetExchangeAssetRequest) GetEnable() bool {
 	if x != nil {
-		return x.Interval
+		return x.Enable
 	}
-	return 0
+	return false
 }
 
-func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
-	if x != nil {
-		return x.RequestSizeLimit
-	}
-	return 0
+type SetExchangeAllPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
-	if x != nil {
-		return x.MaxRetryAttempts
-	}
-	return 0
+func (x *SetExchangeAllPairsRequest) Reset() {
+	*x = SetExchangeAllPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) GetBatchSize() uint64 {
-	if x != nil {
-		return x.BatchSize
-	}
-	return 0
+func (x *SetExchangeAllPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DataHistoryJob) GetStatus() string {
+func (*SetExchangeAllPairsRequest) ProtoMessage() {}
+
+func (x *SetExchangeAllPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[157]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetDataType() string {
-	if x != nil {
-		return x.DataType
-	}
-	return ""
+// Deprecated: Use SetExchangeAllPairsRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeAllPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *DataHistoryJob) GetConversionInterval() int64 {
+func (x *SetExchangeAllPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.ConversionInterval
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *DataHistoryJob) GetOverwriteExistingData() bool {
+func (x *SetExchangeAllPairsRequest) GetEnable() bool {
 	if x != nil {
-		return x.OverwriteExistingData
+		return x.Enable
 	}
 	return false
 }
 
-func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
-	if x != nil {
-		return x.PrerequisiteJobNickname
-	}
-	return ""
+type UpdateExchangeSupportedPairsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
-	if x != nil {
-		return x.DecimalPlaceComparison
-	}
-	return 0
+func (x *UpdateExchangeSupportedPairsRequest) Reset() {
+	*x = UpdateExchangeSupportedPairsRequest{}
+	mi := &file_rpc_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJob) GetSecondaryExchangeName() string {
-	if x != nil {
-		return x.SecondaryExchangeName
-	}
-	return ""
+func (x *UpdateExchangeSupportedPairsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
-	if x != nil {
-		return x.IssueTolerancePercentage
-	}
-	return 0
-}
+func (*UpdateExchangeSupportedPairsRequest) ProtoMessage() {}
 
-func (x *DataHistoryJob) GetReplaceOnIssue() bool {
+func (x *UpdateExchangeSupportedPairsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[158]
 	if x != nil {
-		return x.ReplaceOnIssue
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
-	if x != nil {
-		return x.JobResults
-	}
-	return nil
+// Deprecated: Use UpdateExchangeSupportedPairsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateExchangeSupportedPairsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *DataHistoryJob) GetResultSummaries() []string {
+func (x *UpdateExchangeSupportedPairsRequest) GetExchange() string {
 	if x != nil {
-		return x.ResultSummaries
+		return x.Exchange
 	}
-	return nil
+	return ""
 }
 
-type DataHistoryJobResult struct {
+type GetExchangeAssetsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobResult) Reset() {
-	*x = DataHistoryJobResult{}
-	mi := &file_rpc_proto_msgTypes[156]
+func (x *GetExchangeAssetsRequest) Reset() {
+	*x = GetExchangeAssetsRequest{}
+	mi := &file_rpc_proto_msgTypes[159]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobResult) String() string {
+func (x *GetExchangeAssetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobResult) ProtoMessage() {}
+func (*GetExchangeAssetsRequest) ProtoMessage() {}
 
-func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[156]
+func (x *GetExchangeAssetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[159]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9619,68 +10003,40 @@ func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{156}
-}
-
-func (x *DataHistoryJobResult) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use GetExchangeAssetsRequest.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{159}
 }
 
-func (x *DataHistoryJobResult) GetEndDate() string {
+func (x *GetExchangeAssetsRequest) GetExchange() string {
 	if x != nil {
-		return x.EndDate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *DataHistoryJobResult) GetHasData() bool {
-	if x != nil {
-		return x.HasData
-	}
-	return false
-}
-
-func (x *DataHistoryJobResult) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-func (x *DataHistoryJobResult) GetRunDate() string {
-	if x != nil {
-		return x.RunDate
-	}
-	return ""
-}
-
-type DataHistoryJobs struct {
+type GetExchangeAssetsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Assets        string                 `protobuf:"bytes,1,opt,name=assets,proto3" json:"assets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataHistoryJobs) Reset() {
-	*x = DataHistoryJobs{}
-	mi := &file_rpc_proto_msgTypes[157]
+func (x *GetExchangeAssetsResponse) Reset() {
+	*x = GetExchangeAssetsResponse{}
+	mi := &file_rpc_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataHistoryJobs) String() string {
+func (x *GetExchangeAssetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataHistoryJobs) ProtoMessage() {}
+func (*GetExchangeAssetsResponse) ProtoMessage() {}
 
-func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[157]
+func (x *GetExchangeAssetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9691,41 +10047,40 @@ func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
-func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{157}
+// Deprecated: Use GetExchangeAssetsResponse.ProtoReflect.Descriptor instead.
+func (*GetExchangeAssetsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
+func (x *GetExchangeAssetsResponse) GetAssets() string {
 	if x != nil {
-		return x.Results
+		return x.Assets
 	}
-	return nil
+	return ""
 }
 
-type GetDataHistoryJobsBetweenRequest struct {
+type WebsocketGetInfoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) Reset() {
-	*x = GetDataHistoryJobsBetweenRequest{}
-	mi := &file_rpc_proto_msgTypes[158]
+func (x *WebsocketGetInfoRequest) Reset() {
+	*x = WebsocketGetInfoRequest{}
+	mi := &file_rpc_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) String() string {
+func (x *WebsocketGetInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+func (*WebsocketGetInfoRequest) ProtoMessage() {}
 
-func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[158]
+func (x *WebsocketGetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9736,49 +10091,46 @@ func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
-func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{158}
-}
-
-func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
+// Deprecated: Use WebsocketGetInfoRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
+func (x *WebsocketGetInfoRequest) GetExchange() string {
 	if x != nil {
-		return x.EndDate
+		return x.Exchange
 	}
 	return ""
 }
 
-type SetDataHistoryJobStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type WebsocketGetInfoResponse struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Supported              bool                   `protobuf:"varint,2,opt,name=supported,proto3" json:"supported,omitempty"`
+	Enabled                bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	AuthenticatedSupported bool                   `protobuf:"varint,4,opt,name=authenticated_supported,json=authenticatedSupported,proto3" json:"authenticated_supported,omitempty"`
+	Authenticated          bool                   `protobuf:"varint,5,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	RunningUrl             string                 `protobuf:"bytes,6,opt,name=running_url,json=runningUrl,proto3" json:"running_url,omitempty"`
+	ProxyAddress           string                 `protobuf:"bytes,7,opt,name=proxy_address,json=proxyAddress,proto3" json:"proxy_address,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *SetDataHistoryJobStatusRequest) Reset() {
-	*x = SetDataHistoryJobStatusRequest{}
-	mi := &file_rpc_proto_msgTypes[159]
+func (x *WebsocketGetInfoResponse) Reset() {
+	*x = WebsocketGetInfoResponse{}
+	mi := &file_rpc_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetDataHistoryJobStatusRequest) String() string {
+func (x *WebsocketGetInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
+func (*WebsocketGetInfoResponse) ProtoMessage() {}
 
-func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[159]
+func (x *WebsocketGetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9789,55 +10141,83 @@ func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
-func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{159}
+// Deprecated: Use WebsocketGetInfoResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetId() string {
+func (x *WebsocketGetInfoResponse) GetExchange() string {
 	if x != nil {
-		return x.Id
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
+func (x *WebsocketGetInfoResponse) GetSupported() bool {
 	if x != nil {
-		return x.Nickname
+		return x.Supported
+	}
+	return false
+}
+
+func (x *WebsocketGetInfoResponse) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *WebsocketGetInfoResponse) GetAuthenticatedSupported() bool {
+	if x != nil {
+		return x.AuthenticatedSupported
+	}
+	return false
+}
+
+func (x *WebsocketGetInfoResponse) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+func (x *WebsocketGetInfoResponse) GetRunningUrl() string {
+	if x != nil {
+		return x.RunningUrl
 	}
 	return ""
 }
 
-func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
+func (x *WebsocketGetInfoResponse) GetProxyAddress() string {
 	if x != nil {
-		return x.Status
+		return x.ProxyAddress
 	}
-	return 0
+	return ""
 }
 
-type UpdateDataHistoryJobPrerequisiteRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type WebsocketSetEnabledRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Enable        bool                   `protobuf:"varint,2,opt,name=enable,proto3" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
-	*x = UpdateDataHistoryJobPrerequisiteRequest{}
-	mi := &file_rpc_proto_msgTypes[160]
+func (x *WebsocketSetEnabledRequest) Reset() {
+	*x = WebsocketSetEnabledRequest{}
+	mi := &file_rpc_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
+func (x *WebsocketSetEnabledRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
+func (*WebsocketSetEnabledRequest) ProtoMessage() {}
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[160]
+func (x *WebsocketSetEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9848,52 +10228,47 @@ func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{160}
+// Deprecated: Use WebsocketSetEnabledRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{163}
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
+func (x *WebsocketSetEnabledRequest) GetExchange() string {
 	if x != nil {
-		return x.Nickname
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
+func (x *WebsocketSetEnabledRequest) GetEnable() bool {
 	if x != nil {
-		return x.PrerequisiteJobNickname
+		return x.Enable
 	}
-	return ""
+	return false
 }
 
-type ModifyOrderRequest struct {
+type WebsocketGetSubscriptionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
-	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModifyOrderRequest) Reset() {
-	*x = ModifyOrderRequest{}
-	mi := &file_rpc_proto_msgTypes[161]
+func (x *WebsocketGetSubscriptionsRequest) Reset() {
+	*x = WebsocketGetSubscriptionsRequest{}
+	mi := &file_rpc_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderRequest) String() string {
+func (x *WebsocketGetSubscriptionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderRequest) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsRequest) ProtoMessage() {}
 
-func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[161]
+func (x *WebsocketGetSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9904,75 +10279,43 @@ func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
-func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{161}
+// Deprecated: Use WebsocketGetSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *ModifyOrderRequest) GetExchange() string {
+func (x *WebsocketGetSubscriptionsRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *ModifyOrderRequest) GetOrderId() string {
-	if x != nil {
-		return x.OrderId
-	}
-	return ""
+type WebsocketSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pairs         string                 `protobuf:"bytes,2,opt,name=pairs,proto3" json:"pairs,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Params        string                 `protobuf:"bytes,4,opt,name=params,proto3" json:"params,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *ModifyOrderRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *ModifyOrderRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *ModifyOrderRequest) GetPrice() float64 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-type ModifyOrderResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *ModifyOrderResponse) Reset() {
-	*x = ModifyOrderResponse{}
-	mi := &file_rpc_proto_msgTypes[162]
+func (x *WebsocketSubscription) Reset() {
+	*x = WebsocketSubscription{}
+	mi := &file_rpc_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ModifyOrderResponse) String() string {
+func (x *WebsocketSubscription) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ModifyOrderResponse) ProtoMessage() {}
+func (*WebsocketSubscription) ProtoMessage() {}
 
-func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[162]
+func (x *WebsocketSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9983,86 +10326,62 @@ func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
-func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{162}
+// Deprecated: Use WebsocketSubscription.ProtoReflect.Descriptor instead.
+func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *ModifyOrderResponse) GetModifiedOrderId() string {
+func (x *WebsocketSubscription) GetChannel() string {
 	if x != nil {
-		return x.ModifiedOrderId
+		return x.Channel
 	}
 	return ""
 }
 
-type CurrencyStateGetAllRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CurrencyStateGetAllRequest) Reset() {
-	*x = CurrencyStateGetAllRequest{}
-	mi := &file_rpc_proto_msgTypes[163]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *CurrencyStateGetAllRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CurrencyStateGetAllRequest) ProtoMessage() {}
-
-func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[163]
+func (x *WebsocketSubscription) GetPairs() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Pairs
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{163}
+func (x *WebsocketSubscription) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *CurrencyStateGetAllRequest) GetExchange() string {
+func (x *WebsocketSubscription) GetParams() string {
 	if x != nil {
-		return x.Exchange
+		return x.Params
 	}
 	return ""
 }
 
-type CurrencyStateTradingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+type WebsocketGetSubscriptionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Exchange      string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Subscriptions []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingRequest) Reset() {
-	*x = CurrencyStateTradingRequest{}
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *WebsocketGetSubscriptionsResponse) Reset() {
+	*x = WebsocketGetSubscriptionsResponse{}
+	mi := &file_rpc_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingRequest) String() string {
+func (x *WebsocketGetSubscriptionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingRequest) ProtoMessage() {}
+func (*WebsocketGetSubscriptionsResponse) ProtoMessage() {}
 
-func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[164]
+func (x *WebsocketGetSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10073,56 +10392,48 @@ func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{164}
+// Deprecated: Use WebsocketGetSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*WebsocketGetSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *CurrencyStateTradingRequest) GetExchange() string {
+func (x *WebsocketGetSubscriptionsResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingRequest) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *CurrencyStateTradingRequest) GetAsset() string {
+func (x *WebsocketGetSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
 	if x != nil {
-		return x.Asset
+		return x.Subscriptions
 	}
-	return ""
+	return nil
 }
 
-type CurrencyStateTradingPairRequest struct {
+type WebsocketSetProxyRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Proxy         string                 `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateTradingPairRequest) Reset() {
-	*x = CurrencyStateTradingPairRequest{}
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *WebsocketSetProxyRequest) Reset() {
+	*x = WebsocketSetProxyRequest{}
+	mi := &file_rpc_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateTradingPairRequest) String() string {
+func (x *WebsocketSetProxyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+func (*WebsocketSetProxyRequest) ProtoMessage() {}
 
-func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[165]
+func (x *WebsocketSetProxyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10133,56 +10444,48 @@ func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{165}
+// Deprecated: Use WebsocketSetProxyRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetProxyRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{167}
 }
 
-func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+func (x *WebsocketSetProxyRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateTradingPairRequest) GetPair() string {
-	if x != nil {
-		return x.Pair
-	}
-	return ""
-}
-
-func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+func (x *WebsocketSetProxyRequest) GetProxy() string {
 	if x != nil {
-		return x.Asset
+		return x.Proxy
 	}
 	return ""
 }
 
-type CurrencyStateWithdrawRequest struct {
+type WebsocketSetURLRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateWithdrawRequest) Reset() {
-	*x = CurrencyStateWithdrawRequest{}
-	mi := &file_rpc_proto_msgTypes[166]
+func (x *WebsocketSetURLRequest) Reset() {
+	*x = WebsocketSetURLRequest{}
+	mi := &file_rpc_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateWithdrawRequest) String() string {
+func (x *WebsocketSetURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+func (*WebsocketSetURLRequest) ProtoMessage() {}
 
-func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[166]
+func (x *WebsocketSetURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10193,56 +10496,52 @@ func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{166}
+// Deprecated: Use WebsocketSetURLRequest.ProtoReflect.Descriptor instead.
+func (*WebsocketSetURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+func (x *WebsocketSetURLRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CurrencyStateWithdrawRequest) GetCode() string {
-	if x != nil {
-		return x.Code
-	}
-	return ""
-}
-
-func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+func (x *WebsocketSetURLRequest) GetUrl() string {
 	if x != nil {
-		return x.Asset
+		return x.Url
 	}
 	return ""
 }
 
-type CurrencyStateDepositRequest struct {
+type FindMissingCandlePeriodsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Interval      int64                  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start         string                 `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CurrencyStateDepositRequest) Reset() {
-	*x = CurrencyStateDepositRequest{}
-	mi := &file_rpc_proto_msgTypes[167]
+func (x *FindMissingCandlePeriodsRequest) Reset() {
+	*x = FindMissingCandlePeriodsRequest{}
+	mi := &file_rpc_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateDepositRequest) String() string {
+func (x *FindMissingCandlePeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateDepositRequest) ProtoMessage() {}
+func (*FindMissingCandlePeriodsRequest) ProtoMessage() {}
 
-func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[167]
+func (x *FindMissingCandlePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10253,54 +10552,79 @@ func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
-func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{167}
+// Deprecated: Use FindMissingCandlePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingCandlePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{169}
 }
 
-func (x *CurrencyStateDepositRequest) GetExchange() string {
+func (x *FindMissingCandlePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.Exchange
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *CurrencyStateDepositRequest) GetCode() string {
+func (x *FindMissingCandlePeriodsRequest) GetAssetType() string {
 	if x != nil {
-		return x.Code
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *CurrencyStateDepositRequest) GetAsset() string {
+func (x *FindMissingCandlePeriodsRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.Asset
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FindMissingCandlePeriodsRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *FindMissingCandlePeriodsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
 	}
 	return ""
 }
 
-type CurrencyStateResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *FindMissingCandlePeriodsRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
 }
 
-func (x *CurrencyStateResponse) Reset() {
-	*x = CurrencyStateResponse{}
-	mi := &file_rpc_proto_msgTypes[168]
+type FindMissingTradePeriodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName  string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType     string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Start         string                 `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           string                 `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindMissingTradePeriodsRequest) Reset() {
+	*x = FindMissingTradePeriodsRequest{}
+	mi := &file_rpc_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyStateResponse) String() string {
+func (x *FindMissingTradePeriodsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyStateResponse) ProtoMessage() {}
+func (*FindMissingTradePeriodsRequest) ProtoMessage() {}
 
-func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[168]
+func (x *FindMissingTradePeriodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10311,44 +10635,72 @@ func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
-func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{168}
+// Deprecated: Use FindMissingTradePeriodsRequest.ProtoReflect.Descriptor instead.
+func (*FindMissingTradePeriodsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+func (x *FindMissingTradePeriodsRequest) GetExchangeName() string {
 	if x != nil {
-		return x.CurrencyStates
+		return x.ExchangeName
+	}
+	return ""
+}
+
+func (x *FindMissingTradePeriodsRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
+	}
+	return ""
+}
+
+func (x *FindMissingTradePeriodsRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type CurrencyState struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
-	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
-	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *FindMissingTradePeriodsRequest) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
 }
 
-func (x *CurrencyState) Reset() {
-	*x = CurrencyState{}
-	mi := &file_rpc_proto_msgTypes[169]
+func (x *FindMissingTradePeriodsRequest) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+type FindMissingIntervalsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ExchangeName   string                 `protobuf:"bytes,1,opt,name=exchange_name,json=exchangeName,proto3" json:"exchange_name,omitempty"`
+	AssetType      string                 `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MissingPeriods []string               `protobuf:"bytes,4,rep,name=missing_periods,json=missingPeriods,proto3" json:"missing_periods,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindMissingIntervalsResponse) Reset() {
+	*x = FindMissingIntervalsResponse{}
+	mi := &file_rpc_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CurrencyState) String() string {
+func (x *FindMissingIntervalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CurrencyState) ProtoMessage() {}
+func (*FindMissingIntervalsResponse) ProtoMessage() {}
 
-func (x *CurrencyState) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[169]
+func (x *FindMissingIntervalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10359,70 +10711,69 @@ func (x *CurrencyState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
-func (*CurrencyState) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{169}
+// Deprecated: Use FindMissingIntervalsResponse.ProtoReflect.Descriptor instead.
+func (*FindMissingIntervalsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{171}
 }
 
-func (x *CurrencyState) GetCurrency() string {
+func (x *FindMissingIntervalsResponse) GetExchangeName() string {
 	if x != nil {
-		return x.Currency
+		return x.ExchangeName
 	}
 	return ""
 }
 
-func (x *CurrencyState) GetAsset() string {
+func (x *FindMissingIntervalsResponse) GetAssetType() string {
 	if x != nil {
-		return x.Asset
+		return x.AssetType
 	}
 	return ""
 }
 
-func (x *CurrencyState) GetWithdrawEnabled() bool {
+func (x *FindMissingIntervalsResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.WithdrawEnabled
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-func (x *CurrencyState) GetDepositEnabled() bool {
+func (x *FindMissingIntervalsResponse) GetMissingPeriods() []string {
 	if x != nil {
-		return x.DepositEnabled
+		return x.MissingPeriods
 	}
-	return false
+	return nil
 }
 
-func (x *CurrencyState) GetTradingEnabled() bool {
+func (x *FindMissingIntervalsResponse) GetStatus() string {
 	if x != nil {
-		return x.TradingEnabled
+		return x.Status
 	}
-	return false
+	return ""
 }
 
-type FundingRate struct {
+type SetExchangeTradeProcessingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
-	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Status        bool                   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FundingRate) Reset() {
-	*x = FundingRate{}
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *SetExchangeTradeProcessingRequest) Reset() {
+	*x = SetExchangeTradeProcessingRequest{}
+	mi := &file_rpc_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingRate) String() string {
+func (x *SetExchangeTradeProcessingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingRate) ProtoMessage() {}
+func (*SetExchangeTradeProcessingRequest) ProtoMessage() {}
 
-func (x *FundingRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[170]
+func (x *SetExchangeTradeProcessingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10433,65 +10784,65 @@ func (x *FundingRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
-func (*FundingRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{170}
-}
-
-func (x *FundingRate) GetDate() string {
-	if x != nil {
-		return x.Date
-	}
-	return ""
+// Deprecated: Use SetExchangeTradeProcessingRequest.ProtoReflect.Descriptor instead.
+func (*SetExchangeTradeProcessingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *FundingRate) GetRate() string {
+func (x *SetExchangeTradeProcessingRequest) GetExchange() string {
 	if x != nil {
-		return x.Rate
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FundingRate) GetPayment() string {
+func (x *SetExchangeTradeProcessingRequest) GetStatus() bool {
 	if x != nil {
-		return x.Payment
+		return x.Status
 	}
-	return ""
+	return false
 }
 
-type FundingData struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
-	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
-	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
-	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
-	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type UpsertDataHistoryJobRequest struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Nickname                 string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                  `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                 `protobuf:"varint,8,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	DataType                 int64                  `protobuf:"varint,9,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	MaxRetryAttempts         uint64                 `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                 `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	InsertOnly               bool                   `protobuf:"varint,12,opt,name=insert_only,json=insertOnly,proto3" json:"insert_only,omitempty"`
+	ConversionInterval       int64                  `protobuf:"varint,13,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                   `protobuf:"varint,14,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                 `protobuf:"bytes,15,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                 `protobuf:"varint,16,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                 `protobuf:"bytes,17,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                `protobuf:"fixed64,18,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                   `protobuf:"varint,19,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
-func (x *FundingData) Reset() {
-	*x = FundingData{}
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *UpsertDataHistoryJobRequest) Reset() {
+	*x = UpsertDataHistoryJobRequest{}
+	mi := &file_rpc_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FundingData) String() string {
+func (x *UpsertDataHistoryJobRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FundingData) ProtoMessage() {}
+func (*UpsertDataHistoryJobRequest) ProtoMessage() {}
 
-func (x *FundingData) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[171]
+func (x *UpsertDataHistoryJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10502,143 +10853,166 @@ func (x *FundingData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
-func (*FundingData) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{171}
-}
+// Deprecated: Use UpsertDataHistoryJobRequest.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{173}
+}
 
-func (x *FundingData) GetExchange() string {
+func (x *UpsertDataHistoryJobRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpsertDataHistoryJobRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FundingData) GetAsset() string {
+func (x *UpsertDataHistoryJobRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *FundingData) GetPair() *CurrencyPair {
+func (x *UpsertDataHistoryJobRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *FundingData) GetPaymentCurrency() string {
+func (x *UpsertDataHistoryJobRequest) GetStartDate() string {
 	if x != nil {
-		return x.PaymentCurrency
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *FundingData) GetStartDate() string {
+func (x *UpsertDataHistoryJobRequest) GetEndDate() string {
 	if x != nil {
-		return x.StartDate
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *FundingData) GetEndDate() string {
+func (x *UpsertDataHistoryJobRequest) GetInterval() int64 {
 	if x != nil {
-		return x.EndDate
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *FundingData) GetRates() []*FundingRate {
+func (x *UpsertDataHistoryJobRequest) GetRequestSizeLimit() uint64 {
 	if x != nil {
-		return x.Rates
+		return x.RequestSizeLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *FundingData) GetLatestRate() *FundingRate {
+func (x *UpsertDataHistoryJobRequest) GetDataType() int64 {
 	if x != nil {
-		return x.LatestRate
+		return x.DataType
 	}
-	return nil
+	return 0
 }
 
-func (x *FundingData) GetUpcomingRate() *FundingRate {
+func (x *UpsertDataHistoryJobRequest) GetMaxRetryAttempts() uint64 {
 	if x != nil {
-		return x.UpcomingRate
+		return x.MaxRetryAttempts
 	}
-	return nil
+	return 0
 }
 
-func (x *FundingData) GetPaymentSum() string {
+func (x *UpsertDataHistoryJobRequest) GetBatchSize() uint64 {
 	if x != nil {
-		return x.PaymentSum
+		return x.BatchSize
 	}
-	return ""
+	return 0
 }
 
-func (x *FundingData) GetPaymentMessage() string {
+func (x *UpsertDataHistoryJobRequest) GetInsertOnly() bool {
 	if x != nil {
-		return x.PaymentMessage
+		return x.InsertOnly
+	}
+	return false
+}
+
+func (x *UpsertDataHistoryJobRequest) GetConversionInterval() int64 {
+	if x != nil {
+		return x.ConversionInterval
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetOverwriteExistingData() bool {
+	if x != nil {
+		return x.OverwriteExistingData
+	}
+	return false
+}
+
+func (x *UpsertDataHistoryJobRequest) GetPrerequisiteJobNickname() string {
+	if x != nil {
+		return x.PrerequisiteJobNickname
 	}
 	return ""
 }
 
-func (x *FundingData) GetTimeOfNextRate() string {
+func (x *UpsertDataHistoryJobRequest) GetDecimalPlaceComparison() uint64 {
 	if x != nil {
-		return x.TimeOfNextRate
+		return x.DecimalPlaceComparison
+	}
+	return 0
+}
+
+func (x *UpsertDataHistoryJobRequest) GetSecondaryExchangeName() string {
+	if x != nil {
+		return x.SecondaryExchangeName
 	}
 	return ""
 }
 
-type FuturesPositionStats struct {
-	state                        protoimpl.MessageState `protogen:"open.v1"`
-	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
-	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
-	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
-	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
-	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
-	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
-	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
-	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
-	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
-	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
-	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
-	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
-	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
-	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
-	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
-	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
-	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
-	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
-	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
-	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
-	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
-	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
-	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
-	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
-	unknownFields                protoimpl.UnknownFields
-	sizeCache                    protoimpl.SizeCache
+func (x *UpsertDataHistoryJobRequest) GetIssueTolerancePercentage() float64 {
+	if x != nil {
+		return x.IssueTolerancePercentage
+	}
+	return 0
 }
 
-func (x *FuturesPositionStats) Reset() {
-	*x = FuturesPositionStats{}
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *UpsertDataHistoryJobRequest) GetReplaceOnIssue() bool {
+	if x != nil {
+		return x.ReplaceOnIssue
+	}
+	return false
+}
+
+type InsertSequentialJobsRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobRequest `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertSequentialJobsRequest) Reset() {
+	*x = InsertSequentialJobsRequest{}
+	mi := &file_rpc_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) String() string {
+func (x *InsertSequentialJobsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuturesPositionStats) ProtoMessage() {}
+func (*InsertSequentialJobsRequest) ProtoMessage() {}
 
-func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[172]
+func (x *InsertSequentialJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10649,240 +11023,2574 @@ func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
-func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{172}
+// Deprecated: Use InsertSequentialJobsRequest.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{174}
 }
 
-func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
+func (x *InsertSequentialJobsRequest) GetJobs() []*UpsertDataHistoryJobRequest {
 	if x != nil {
-		return x.MaintenanceMarginRequirement
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+type InsertSequentialJobsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Jobs          []*UpsertDataHistoryJobResponse `protobuf:"bytes,1,rep,name=jobs,proto3" json:"jobs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertSequentialJobsResponse) Reset() {
+	*x = InsertSequentialJobsResponse{}
+	mi := &file_rpc_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertSequentialJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertSequentialJobsResponse) ProtoMessage() {}
+
+func (x *InsertSequentialJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[175]
 	if x != nil {
-		return x.InitialMarginRequirement
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+// Deprecated: Use InsertSequentialJobsResponse.ProtoReflect.Descriptor instead.
+func (*InsertSequentialJobsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *InsertSequentialJobsResponse) GetJobs() []*UpsertDataHistoryJobResponse {
 	if x != nil {
-		return x.EstimatedLiquidationPrice
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturesPositionStats) GetCollateralUsed() string {
+type UpsertDataHistoryJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpsertDataHistoryJobResponse) Reset() {
+	*x = UpsertDataHistoryJobResponse{}
+	mi := &file_rpc_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpsertDataHistoryJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpsertDataHistoryJobResponse) ProtoMessage() {}
+
+func (x *UpsertDataHistoryJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[176]
 	if x != nil {
-		return x.CollateralUsed
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturesPositionStats) GetMarkPrice() string {
+// Deprecated: Use UpsertDataHistoryJobResponse.ProtoReflect.Descriptor instead.
+func (*UpsertDataHistoryJobResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *UpsertDataHistoryJobResponse) GetMessage() string {
 	if x != nil {
-		return x.MarkPrice
+		return x.Message
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCurrentSize() string {
+func (x *UpsertDataHistoryJobResponse) GetJobId() string {
 	if x != nil {
-		return x.CurrentSize
+		return x.JobId
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetContractSize() string {
+type GetDataHistoryJobDetailsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	FullDetails   bool                   `protobuf:"varint,3,opt,name=full_details,json=fullDetails,proto3" json:"full_details,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataHistoryJobDetailsRequest) Reset() {
+	*x = GetDataHistoryJobDetailsRequest{}
+	mi := &file_rpc_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataHistoryJobDetailsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryJobDetailsRequest) ProtoMessage() {}
+
+func (x *GetDataHistoryJobDetailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryJobDetailsRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobDetailsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *GetDataHistoryJobDetailsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetDataHistoryJobDetailsRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *GetDataHistoryJobDetailsRequest) GetFullDetails() bool {
+	if x != nil {
+		return x.FullDetails
+	}
+	return false
+}
+
+type DataHistoryJob struct {
+	state                    protoimpl.MessageState  `protogen:"open.v1"`
+	Id                       string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname                 string                  `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Exchange                 string                  `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                    string                  `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                     *CurrencyPair           `protobuf:"bytes,5,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate                string                  `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                  string                  `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval                 int64                   `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	RequestSizeLimit         uint64                  `protobuf:"varint,9,opt,name=request_size_limit,json=requestSizeLimit,proto3" json:"request_size_limit,omitempty"`
+	MaxRetryAttempts         uint64                  `protobuf:"varint,10,opt,name=max_retry_attempts,json=maxRetryAttempts,proto3" json:"max_retry_attempts,omitempty"`
+	BatchSize                uint64                  `protobuf:"varint,11,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	Status                   string                  `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	DataType                 string                  `protobuf:"bytes,13,opt,name=data_type,json=dataType,proto3" json:"data_type,omitempty"`
+	ConversionInterval       int64                   `protobuf:"varint,14,opt,name=conversion_interval,json=conversionInterval,proto3" json:"conversion_interval,omitempty"`
+	OverwriteExistingData    bool                    `protobuf:"varint,15,opt,name=overwrite_existing_data,json=overwriteExistingData,proto3" json:"overwrite_existing_data,omitempty"`
+	PrerequisiteJobNickname  string                  `protobuf:"bytes,16,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	DecimalPlaceComparison   uint64                  `protobuf:"varint,17,opt,name=decimal_place_comparison,json=decimalPlaceComparison,proto3" json:"decimal_place_comparison,omitempty"`
+	SecondaryExchangeName    string                  `protobuf:"bytes,18,opt,name=secondary_exchange_name,json=secondaryExchangeName,proto3" json:"secondary_exchange_name,omitempty"`
+	IssueTolerancePercentage float64                 `protobuf:"fixed64,19,opt,name=issue_tolerance_percentage,json=issueTolerancePercentage,proto3" json:"issue_tolerance_percentage,omitempty"`
+	ReplaceOnIssue           bool                    `protobuf:"varint,20,opt,name=replace_on_issue,json=replaceOnIssue,proto3" json:"replace_on_issue,omitempty"`
+	JobResults               []*DataHistoryJobResult `protobuf:"bytes,21,rep,name=job_results,json=jobResults,proto3" json:"job_results,omitempty"`
+	ResultSummaries          []string                `protobuf:"bytes,22,rep,name=result_summaries,json=resultSummaries,proto3" json:"result_summaries,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *DataHistoryJob) Reset() {
+	*x = DataHistoryJob{}
+	mi := &file_rpc_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJob) ProtoMessage() {}
+
+func (x *DataHistoryJob) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJob.ProtoReflect.Descriptor instead.
+func (*DataHistoryJob) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *DataHistoryJob) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *DataHistoryJob) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetRequestSizeLimit() uint64 {
+	if x != nil {
+		return x.RequestSizeLimit
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetMaxRetryAttempts() uint64 {
+	if x != nil {
+		return x.MaxRetryAttempts
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetBatchSize() uint64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetDataType() string {
+	if x != nil {
+		return x.DataType
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetConversionInterval() int64 {
+	if x != nil {
+		return x.ConversionInterval
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetOverwriteExistingData() bool {
+	if x != nil {
+		return x.OverwriteExistingData
+	}
+	return false
+}
+
+func (x *DataHistoryJob) GetPrerequisiteJobNickname() string {
+	if x != nil {
+		return x.PrerequisiteJobNickname
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetDecimalPlaceComparison() uint64 {
+	if x != nil {
+		return x.DecimalPlaceComparison
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetSecondaryExchangeName() string {
+	if x != nil {
+		return x.SecondaryExchangeName
+	}
+	return ""
+}
+
+func (x *DataHistoryJob) GetIssueTolerancePercentage() float64 {
+	if x != nil {
+		return x.IssueTolerancePercentage
+	}
+	return 0
+}
+
+func (x *DataHistoryJob) GetReplaceOnIssue() bool {
+	if x != nil {
+		return x.ReplaceOnIssue
+	}
+	return false
+}
+
+func (x *DataHistoryJob) GetJobResults() []*DataHistoryJobResult {
+	if x != nil {
+		return x.JobResults
+	}
+	return nil
+}
+
+func (x *DataHistoryJob) GetResultSummaries() []string {
+	if x != nil {
+		return x.ResultSummaries
+	}
+	return nil
+}
+
+type DataHistoryJobResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	HasData       bool                   `protobuf:"varint,3,opt,name=has_data,json=hasData,proto3" json:"has_data,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	RunDate       string                 `protobuf:"bytes,5,opt,name=run_date,json=runDate,proto3" json:"run_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataHistoryJobResult) Reset() {
+	*x = DataHistoryJobResult{}
+	mi := &file_rpc_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJobResult) ProtoMessage() {}
+
+func (x *DataHistoryJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJobResult.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobResult) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *DataHistoryJobResult) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetHasData() bool {
+	if x != nil {
+		return x.HasData
+	}
+	return false
+}
+
+func (x *DataHistoryJobResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *DataHistoryJobResult) GetRunDate() string {
+	if x != nil {
+		return x.RunDate
+	}
+	return ""
+}
+
+type DataHistoryJobs struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*DataHistoryJob      `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataHistoryJobs) Reset() {
+	*x = DataHistoryJobs{}
+	mi := &file_rpc_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataHistoryJobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataHistoryJobs) ProtoMessage() {}
+
+func (x *DataHistoryJobs) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataHistoryJobs.ProtoReflect.Descriptor instead.
+func (*DataHistoryJobs) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *DataHistoryJobs) GetResults() []*DataHistoryJob {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetDataHistoryJobsBetweenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StartDate     string                 `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       string                 `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) Reset() {
+	*x = GetDataHistoryJobsBetweenRequest{}
+	mi := &file_rpc_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDataHistoryJobsBetweenRequest) ProtoMessage() {}
+
+func (x *GetDataHistoryJobsBetweenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDataHistoryJobsBetweenRequest.ProtoReflect.Descriptor instead.
+func (*GetDataHistoryJobsBetweenRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetDataHistoryJobsBetweenRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+type SetDataHistoryJobStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Status        int64                  `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDataHistoryJobStatusRequest) Reset() {
+	*x = SetDataHistoryJobStatusRequest{}
+	mi := &file_rpc_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDataHistoryJobStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDataHistoryJobStatusRequest) ProtoMessage() {}
+
+func (x *SetDataHistoryJobStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDataHistoryJobStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetDataHistoryJobStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *SetDataHistoryJobStatusRequest) GetStatus() int64 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type UpdateDataHistoryJobPrerequisiteRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Nickname                string                 `protobuf:"bytes,1,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	PrerequisiteJobNickname string                 `protobuf:"bytes,2,opt,name=prerequisite_job_nickname,json=prerequisiteJobNickname,proto3" json:"prerequisite_job_nickname,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) Reset() {
+	*x = UpdateDataHistoryJobPrerequisiteRequest{}
+	mi := &file_rpc_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDataHistoryJobPrerequisiteRequest) ProtoMessage() {}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDataHistoryJobPrerequisiteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDataHistoryJobPrerequisiteRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *UpdateDataHistoryJobPrerequisiteRequest) GetPrerequisiteJobNickname() string {
+	if x != nil {
+		return x.PrerequisiteJobNickname
+	}
+	return ""
+}
+
+type ModifyOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId       string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Amount        float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyOrderRequest) Reset() {
+	*x = ModifyOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderRequest) ProtoMessage() {}
+
+func (x *ModifyOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderRequest.ProtoReflect.Descriptor instead.
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *ModifyOrderRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *ModifyOrderRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *ModifyOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ModifyOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type ModifyOrderResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ModifiedOrderId string                 `protobuf:"bytes,1,opt,name=modified_order_id,json=modifiedOrderId,proto3" json:"modified_order_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ModifyOrderResponse) Reset() {
+	*x = ModifyOrderResponse{}
+	mi := &file_rpc_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyOrderResponse) ProtoMessage() {}
+
+func (x *ModifyOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyOrderResponse.ProtoReflect.Descriptor instead.
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *ModifyOrderResponse) GetModifiedOrderId() string {
+	if x != nil {
+		return x.ModifiedOrderId
+	}
+	return ""
+}
+
+type CurrencyStateGetAllRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateGetAllRequest) Reset() {
+	*x = CurrencyStateGetAllRequest{}
+	mi := &file_rpc_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateGetAllRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateGetAllRequest) ProtoMessage() {}
+
+func (x *CurrencyStateGetAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateGetAllRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateGetAllRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *CurrencyStateGetAllRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+type CurrencyStateTradingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateTradingRequest) Reset() {
+	*x = CurrencyStateTradingRequest{}
+	mi := &file_rpc_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateTradingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateTradingRequest) ProtoMessage() {}
+
+func (x *CurrencyStateTradingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateTradingRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *CurrencyStateTradingRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateTradingPairRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          string                 `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateTradingPairRequest) Reset() {
+	*x = CurrencyStateTradingPairRequest{}
+	mi := &file_rpc_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateTradingPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateTradingPairRequest) ProtoMessage() {}
+
+func (x *CurrencyStateTradingPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateTradingPairRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateTradingPairRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *CurrencyStateTradingPairRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetPair() string {
+	if x != nil {
+		return x.Pair
+	}
+	return ""
+}
+
+func (x *CurrencyStateTradingPairRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateWithdrawRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateWithdrawRequest) Reset() {
+	*x = CurrencyStateWithdrawRequest{}
+	mi := &file_rpc_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateWithdrawRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateWithdrawRequest) ProtoMessage() {}
+
+func (x *CurrencyStateWithdrawRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateWithdrawRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateWithdrawRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *CurrencyStateWithdrawRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateWithdrawRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateDepositRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CurrencyStateDepositRequest) Reset() {
+	*x = CurrencyStateDepositRequest{}
+	mi := &file_rpc_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateDepositRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateDepositRequest) ProtoMessage() {}
+
+func (x *CurrencyStateDepositRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateDepositRequest.ProtoReflect.Descriptor instead.
+func (*CurrencyStateDepositRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *CurrencyStateDepositRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CurrencyStateDepositRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type CurrencyStateResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CurrencyStates []*CurrencyState       `protobuf:"bytes,1,rep,name=currency_states,json=currencyStates,proto3" json:"currency_states,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CurrencyStateResponse) Reset() {
+	*x = CurrencyStateResponse{}
+	mi := &file_rpc_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStateResponse) ProtoMessage() {}
+
+func (x *CurrencyStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStateResponse.ProtoReflect.Descriptor instead.
+func (*CurrencyStateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *CurrencyStateResponse) GetCurrencyStates() []*CurrencyState {
+	if x != nil {
+		return x.CurrencyStates
+	}
+	return nil
+}
+
+type CurrencyState struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	WithdrawEnabled bool                   `protobuf:"varint,3,opt,name=withdraw_enabled,json=withdrawEnabled,proto3" json:"withdraw_enabled,omitempty"`
+	DepositEnabled  bool                   `protobuf:"varint,4,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
+	TradingEnabled  bool                   `protobuf:"varint,5,opt,name=trading_enabled,json=tradingEnabled,proto3" json:"trading_enabled,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CurrencyState) Reset() {
+	*x = CurrencyState{}
+	mi := &file_rpc_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyState) ProtoMessage() {}
+
+func (x *CurrencyState) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyState.ProtoReflect.Descriptor instead.
+func (*CurrencyState) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *CurrencyState) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *CurrencyState) GetWithdrawEnabled() bool {
+	if x != nil {
+		return x.WithdrawEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetDepositEnabled() bool {
+	if x != nil {
+		return x.DepositEnabled
+	}
+	return false
+}
+
+func (x *CurrencyState) GetTradingEnabled() bool {
+	if x != nil {
+		return x.TradingEnabled
+	}
+	return false
+}
+
+type FundingRate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Rate          string                 `protobuf:"bytes,2,opt,name=rate,proto3" json:"rate,omitempty"`
+	Payment       string                 `protobuf:"bytes,3,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FundingRate) Reset() {
+	*x = FundingRate{}
+	mi := &file_rpc_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingRate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingRate) ProtoMessage() {}
+
+func (x *FundingRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingRate.ProtoReflect.Descriptor instead.
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *FundingRate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FundingRate) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *FundingRate) GetPayment() string {
+	if x != nil {
+		return x.Payment
+	}
+	return ""
+}
+
+type FundingData struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset           string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PaymentCurrency string                 `protobuf:"bytes,4,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	StartDate       string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate         string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Rates           []*FundingRate         `protobuf:"bytes,7,rep,name=rates,proto3" json:"rates,omitempty"`
+	LatestRate      *FundingRate           `protobuf:"bytes,8,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	UpcomingRate    *FundingRate           `protobuf:"bytes,9,opt,name=upcoming_rate,json=upcomingRate,proto3" json:"upcoming_rate,omitempty"`
+	PaymentSum      string                 `protobuf:"bytes,10,opt,name=payment_sum,json=paymentSum,proto3" json:"payment_sum,omitempty"`
+	PaymentMessage  string                 `protobuf:"bytes,11,opt,name=payment_message,json=paymentMessage,proto3" json:"payment_message,omitempty"`
+	TimeOfNextRate  string                 `protobuf:"bytes,12,opt,name=time_of_next_rate,json=timeOfNextRate,proto3" json:"time_of_next_rate,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FundingData) Reset() {
+	*x = FundingData{}
+	mi := &file_rpc_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FundingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FundingData) ProtoMessage() {}
+
+func (x *FundingData) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FundingData.ProtoReflect.Descriptor instead.
+func (*FundingData) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *FundingData) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FundingData) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FundingData) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentCurrency() string {
+	if x != nil {
+		return x.PaymentCurrency
+	}
+	return ""
+}
+
+func (x *FundingData) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *FundingData) GetRates() []*FundingRate {
+	if x != nil {
+		return x.Rates
+	}
+	return nil
+}
+
+func (x *FundingData) GetLatestRate() *FundingRate {
+	if x != nil {
+		return x.LatestRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetUpcomingRate() *FundingRate {
+	if x != nil {
+		return x.UpcomingRate
+	}
+	return nil
+}
+
+func (x *FundingData) GetPaymentSum() string {
+	if x != nil {
+		return x.PaymentSum
+	}
+	return ""
+}
+
+func (x *FundingData) GetPaymentMessage() string {
+	if x != nil {
+		return x.PaymentMessage
+	}
+	return ""
+}
+
+func (x *FundingData) GetTimeOfNextRate() string {
+	if x != nil {
+		return x.TimeOfNextRate
+	}
+	return ""
+}
+
+type FuturesPositionStats struct {
+	state                        protoimpl.MessageState `protogen:"open.v1"`
+	MaintenanceMarginRequirement string                 `protobuf:"bytes,1,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3" json:"maintenance_margin_requirement,omitempty"`
+	InitialMarginRequirement     string                 `protobuf:"bytes,2,opt,name=initial_margin_requirement,json=initialMarginRequirement,proto3" json:"initial_margin_requirement,omitempty"`
+	EstimatedLiquidationPrice    string                 `protobuf:"bytes,3,opt,name=estimated_liquidation_price,json=estimatedLiquidationPrice,proto3" json:"estimated_liquidation_price,omitempty"`
+	CollateralUsed               string                 `protobuf:"bytes,4,opt,name=collateral_used,json=collateralUsed,proto3" json:"collateral_used,omitempty"`
+	MarkPrice                    string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	CurrentSize                  string                 `protobuf:"bytes,6,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	ContractSize                 string                 `protobuf:"bytes,7,opt,name=contract_size,json=contractSize,proto3" json:"contract_size,omitempty"`
+	ContractMultiplier           string                 `protobuf:"bytes,8,opt,name=contract_multiplier,json=contractMultiplier,proto3" json:"contract_multiplier,omitempty"`
+	ContractSettlementType       string                 `protobuf:"bytes,9,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	BreakEvenPrice               string                 `protobuf:"bytes,10,opt,name=break_even_price,json=breakEvenPrice,proto3" json:"break_even_price,omitempty"`
+	AverageOpenPrice             string                 `protobuf:"bytes,11,opt,name=average_open_price,json=averageOpenPrice,proto3" json:"average_open_price,omitempty"`
+	RecentPnl                    string                 `protobuf:"bytes,12,opt,name=recent_pnl,json=recentPnl,proto3" json:"recent_pnl,omitempty"`
+	MarginFraction               string                 `protobuf:"bytes,13,opt,name=margin_fraction,json=marginFraction,proto3" json:"margin_fraction,omitempty"`
+	FreeCollateral               string                 `protobuf:"bytes,14,opt,name=free_collateral,json=freeCollateral,proto3" json:"free_collateral,omitempty"`
+	TotalCollateral              string                 `protobuf:"bytes,15,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	FrozenBalance                string                 `protobuf:"bytes,16,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`
+	EquityOfCurrency             string                 `protobuf:"bytes,17,opt,name=equity_of_currency,json=equityOfCurrency,proto3" json:"equity_of_currency,omitempty"`
+	AvailableEquity              string                 `protobuf:"bytes,18,opt,name=available_equity,json=availableEquity,proto3" json:"available_equity,omitempty"`
+	CashBalance                  string                 `protobuf:"bytes,19,opt,name=cash_balance,json=cashBalance,proto3" json:"cash_balance,omitempty"`
+	DiscountEquity               string                 `protobuf:"bytes,20,opt,name=discount_equity,json=discountEquity,proto3" json:"discount_equity,omitempty"`
+	EquityUsd                    string                 `protobuf:"bytes,21,opt,name=equity_usd,json=equityUsd,proto3" json:"equity_usd,omitempty"`
+	IsolatedEquity               string                 `protobuf:"bytes,22,opt,name=isolated_equity,json=isolatedEquity,proto3" json:"isolated_equity,omitempty"`
+	IsolatedLiabilities          string                 `protobuf:"bytes,23,opt,name=isolated_liabilities,json=isolatedLiabilities,proto3" json:"isolated_liabilities,omitempty"`
+	IsolatedUpl                  string                 `protobuf:"bytes,24,opt,name=isolated_upl,json=isolatedUpl,proto3" json:"isolated_upl,omitempty"`
+	NotionalLeverage             string                 `protobuf:"bytes,25,opt,name=notional_leverage,json=notionalLeverage,proto3" json:"notional_leverage,omitempty"`
+	TotalEquity                  string                 `protobuf:"bytes,26,opt,name=total_equity,json=totalEquity,proto3" json:"total_equity,omitempty"`
+	StrategyEquity               string                 `protobuf:"bytes,27,opt,name=strategy_equity,json=strategyEquity,proto3" json:"strategy_equity,omitempty"`
+	unknownFields                protoimpl.UnknownFields
+	sizeCache                    protoimpl.SizeCache
+}
+
+func (x *FuturesPositionStats) Reset() {
+	*x = FuturesPositionStats{}
+	mi := &file_rpc_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturesPositionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturesPositionStats) ProtoMessage() {}
+
+func (x *FuturesPositionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturesPositionStats.ProtoReflect.Descriptor instead.
+func (*FuturesPositionStats) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *FuturesPositionStats) GetMaintenanceMarginRequirement() string {
+	if x != nil {
+		return x.MaintenanceMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetInitialMarginRequirement() string {
+	if x != nil {
+		return x.InitialMarginRequirement
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEstimatedLiquidationPrice() string {
+	if x != nil {
+		return x.EstimatedLiquidationPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCollateralUsed() string {
+	if x != nil {
+		return x.CollateralUsed
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarkPrice() string {
+	if x != nil {
+		return x.MarkPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSize() string {
+	if x != nil {
+		return x.ContractSize
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractMultiplier() string {
+	if x != nil {
+		return x.ContractMultiplier
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+	if x != nil {
+		return x.BreakEvenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+	if x != nil {
+		return x.AverageOpenPrice
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetRecentPnl() string {
+	if x != nil {
+		return x.RecentPnl
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetMarginFraction() string {
+	if x != nil {
+		return x.MarginFraction
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFreeCollateral() string {
+	if x != nil {
+		return x.FreeCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalCollateral() string {
+	if x != nil {
+		return x.TotalCollateral
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetFrozenBalance() string {
+	if x != nil {
+		return x.FrozenBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+	if x != nil {
+		return x.EquityOfCurrency
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetAvailableEquity() string {
+	if x != nil {
+		return x.AvailableEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetCashBalance() string {
+	if x != nil {
+		return x.CashBalance
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetDiscountEquity() string {
+	if x != nil {
+		return x.DiscountEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetEquityUsd() string {
+	if x != nil {
+		return x.EquityUsd
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedEquity() string {
+	if x != nil {
+		return x.IsolatedEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
+	if x != nil {
+		return x.IsolatedLiabilities
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetIsolatedUpl() string {
+	if x != nil {
+		return x.IsolatedUpl
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetNotionalLeverage() string {
+	if x != nil {
+		return x.NotionalLeverage
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetTotalEquity() string {
+	if x != nil {
+		return x.TotalEquity
+	}
+	return ""
+}
+
+func (x *FuturesPositionStats) GetStrategyEquity() string {
+	if x != nil {
+		return x.StrategyEquity
+	}
+	return ""
+}
+
+type FuturePosition struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
+	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
+	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
+	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
+	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
+	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
+	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
+	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
+	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
+	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
+	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *FuturePosition) Reset() {
+	*x = FuturePosition{}
+	mi := &file_rpc_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FuturePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuturePosition) ProtoMessage() {}
+
+func (x *FuturePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
+func (*FuturePosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *FuturePosition) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDate() string {
+	if x != nil {
+		return x.OpeningDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningDirection() string {
+	if x != nil {
+		return x.OpeningDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningPrice() string {
+	if x != nil {
+		return x.OpeningPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOpeningSize() string {
+	if x != nil {
+		return x.OpeningSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentDirection() string {
+	if x != nil {
+		return x.CurrentDirection
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentPrice() string {
+	if x != nil {
+		return x.CurrentPrice
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetCurrentSize() string {
+	if x != nil {
+		return x.CurrentSize
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetRealisedPnl() string {
+	if x != nil {
+		return x.RealisedPnl
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetClosingDate() string {
+	if x != nil {
+		return x.ClosingDate
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrderCount() int64 {
+	if x != nil {
+		return x.OrderCount
+	}
+	return 0
+}
+
+func (x *FuturePosition) GetContractSettlementType() string {
+	if x != nil {
+		return x.ContractSettlementType
+	}
+	return ""
+}
+
+func (x *FuturePosition) GetOrders() []*OrderDetails {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
+}
+
+func (x *FuturePosition) GetFundingData() *FundingData {
+	if x != nil {
+		return x.FundingData
+	}
+	return nil
+}
+
+type GetManagedPositionRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionRequest) Reset() {
+	*x = GetManagedPositionRequest{}
+	mi := &file_rpc_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionRequest) ProtoMessage() {}
+
+func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *GetManagedPositionRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetAllManagedPositionsRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
+	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
+	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
+	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *GetAllManagedPositionsRequest) Reset() {
+	*x = GetAllManagedPositionsRequest{}
+	mi := &file_rpc_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAllManagedPositionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+
+func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
+	if x != nil {
+		return x.IncludeFullOrderData
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
+	if x != nil {
+		return x.GetFundingPayments
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+	if x != nil {
+		return x.IncludeFullFundingRates
+	}
+	return false
+}
+
+func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+	if x != nil {
+		return x.IncludePredictedRate
+	}
+	return false
+}
+
+type GetManagedPositionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetManagedPositionsResponse) Reset() {
+	*x = GetManagedPositionsResponse{}
+	mi := &file_rpc_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManagedPositionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManagedPositionsResponse) ProtoMessage() {}
+
+func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
+func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryRequest) Reset() {
+	*x = GetFuturesPositionsSummaryRequest{}
+	mi := &file_rpc_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+type GetFuturesPositionsSummaryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsSummaryResponse) Reset() {
+	*x = GetFuturesPositionsSummaryResponse{}
+	mi := &file_rpc_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsSummaryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
+	if x != nil {
+		return x.PositionStats
+	}
+	return nil
+}
+
+type GetFuturesPositionsOrdersRequest struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                  string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                     string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                      *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair            *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	StartDate                 string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate                   string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	RespectOrderHistoryLimits bool                   `protobuf:"varint,7,opt,name=respect_order_history_limits,json=respectOrderHistoryLimits,proto3" json:"respect_order_history_limits,omitempty"`
+	SyncWithOrderManager      bool                   `protobuf:"varint,8,opt,name=sync_with_order_manager,json=syncWithOrderManager,proto3" json:"sync_with_order_manager,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsOrdersRequest) Reset() {
+	*x = GetFuturesPositionsOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+	if x != nil {
+		return x.UnderlyingPair
+	}
+	return nil
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+	if x != nil {
+		return x.RespectOrderHistoryLimits
+	}
+	return false
+}
+
+func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+	if x != nil {
+		return x.SyncWithOrderManager
+	}
+	return false
+}
+
+type GetFuturesPositionsOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFuturesPositionsOrdersResponse) Reset() {
+	*x = GetFuturesPositionsOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFuturesPositionsOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
+
+func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+	if x != nil {
+		return x.Positions
+	}
+	return nil
+}
+
+type GetCollateralModeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeRequest) Reset() {
+	*x = GetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeRequest) ProtoMessage() {}
+
+func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *GetCollateralModeRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCollateralModeRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+type GetCollateralModeResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCollateralModeResponse) Reset() {
+	*x = GetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollateralModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollateralModeResponse) ProtoMessage() {}
+
+func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[205]
 	if x != nil {
-		return x.ContractSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturesPositionStats) GetContractMultiplier() string {
-	if x != nil {
-		return x.ContractMultiplier
-	}
-	return ""
+// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *FuturesPositionStats) GetContractSettlementType() string {
+func (x *GetCollateralModeResponse) GetExchange() string {
 	if x != nil {
-		return x.ContractSettlementType
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetBreakEvenPrice() string {
+func (x *GetCollateralModeResponse) GetAsset() string {
 	if x != nil {
-		return x.BreakEvenPrice
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetAverageOpenPrice() string {
+func (x *GetCollateralModeResponse) GetCollateralMode() string {
 	if x != nil {
-		return x.AverageOpenPrice
+		return x.CollateralMode
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetRecentPnl() string {
-	if x != nil {
-		return x.RecentPnl
-	}
-	return ""
+type SetCollateralModeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *FuturesPositionStats) GetMarginFraction() string {
-	if x != nil {
-		return x.MarginFraction
-	}
-	return ""
+func (x *SetCollateralModeRequest) Reset() {
+	*x = SetCollateralModeRequest{}
+	mi := &file_rpc_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) GetFreeCollateral() string {
-	if x != nil {
-		return x.FreeCollateral
-	}
-	return ""
+func (x *SetCollateralModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturesPositionStats) GetTotalCollateral() string {
+func (*SetCollateralModeRequest) ProtoMessage() {}
+
+func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[206]
 	if x != nil {
-		return x.TotalCollateral
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturesPositionStats) GetFrozenBalance() string {
-	if x != nil {
-		return x.FrozenBalance
-	}
-	return ""
+// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{206}
 }
 
-func (x *FuturesPositionStats) GetEquityOfCurrency() string {
+func (x *SetCollateralModeRequest) GetExchange() string {
 	if x != nil {
-		return x.EquityOfCurrency
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetAvailableEquity() string {
+func (x *SetCollateralModeRequest) GetAsset() string {
 	if x != nil {
-		return x.AvailableEquity
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetCashBalance() string {
+func (x *SetCollateralModeRequest) GetCollateralMode() string {
 	if x != nil {
-		return x.CashBalance
+		return x.CollateralMode
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetDiscountEquity() string {
-	if x != nil {
-		return x.DiscountEquity
-	}
-	return ""
+type SetCollateralModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuturesPositionStats) GetEquityUsd() string {
-	if x != nil {
-		return x.EquityUsd
-	}
-	return ""
+func (x *SetCollateralModeResponse) Reset() {
+	*x = SetCollateralModeResponse{}
+	mi := &file_rpc_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturesPositionStats) GetIsolatedEquity() string {
-	if x != nil {
-		return x.IsolatedEquity
-	}
-	return ""
+func (x *SetCollateralModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturesPositionStats) GetIsolatedLiabilities() string {
+func (*SetCollateralModeResponse) ProtoMessage() {}
+
+func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[207]
 	if x != nil {
-		return x.IsolatedLiabilities
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturesPositionStats) GetIsolatedUpl() string {
-	if x != nil {
-		return x.IsolatedUpl
-	}
-	return ""
+// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
+func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *FuturesPositionStats) GetNotionalLeverage() string {
+func (x *SetCollateralModeResponse) GetExchange() string {
 	if x != nil {
-		return x.NotionalLeverage
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetTotalEquity() string {
+func (x *SetCollateralModeResponse) GetAsset() string {
 	if x != nil {
-		return x.TotalEquity
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturesPositionStats) GetStrategyEquity() string {
+func (x *SetCollateralModeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.StrategyEquity
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type FuturePosition struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	Exchange               string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                  string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                   *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	OpeningDate            string                 `protobuf:"bytes,5,opt,name=opening_date,json=openingDate,proto3" json:"opening_date,omitempty"`
-	OpeningDirection       string                 `protobuf:"bytes,6,opt,name=opening_direction,json=openingDirection,proto3" json:"opening_direction,omitempty"`
-	OpeningPrice           string                 `protobuf:"bytes,7,opt,name=opening_price,json=openingPrice,proto3" json:"opening_price,omitempty"`
-	OpeningSize            string                 `protobuf:"bytes,8,opt,name=opening_size,json=openingSize,proto3" json:"opening_size,omitempty"`
-	CurrentDirection       string                 `protobuf:"bytes,9,opt,name=current_direction,json=currentDirection,proto3" json:"current_direction,omitempty"`
-	CurrentPrice           string                 `protobuf:"bytes,10,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
-	CurrentSize            string                 `protobuf:"bytes,11,opt,name=current_size,json=currentSize,proto3" json:"current_size,omitempty"`
-	UnrealisedPnl          string                 `protobuf:"bytes,12,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	RealisedPnl            string                 `protobuf:"bytes,13,opt,name=realised_pnl,json=realisedPnl,proto3" json:"realised_pnl,omitempty"`
-	ClosingDate            string                 `protobuf:"bytes,14,opt,name=closing_date,json=closingDate,proto3" json:"closing_date,omitempty"`
-	OrderCount             int64                  `protobuf:"varint,15,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
-	ContractSettlementType string                 `protobuf:"bytes,16,opt,name=contract_settlement_type,json=contractSettlementType,proto3" json:"contract_settlement_type,omitempty"`
-	Orders                 []*OrderDetails        `protobuf:"bytes,17,rep,name=orders,proto3" json:"orders,omitempty"`
-	PositionStats          *FuturesPositionStats  `protobuf:"bytes,18,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
-	FundingData            *FundingData           `protobuf:"bytes,19,opt,name=funding_data,json=fundingData,proto3" json:"funding_data,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type GetMarginTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FuturePosition) Reset() {
-	*x = FuturePosition{}
-	mi := &file_rpc_proto_msgTypes[173]
+func (x *GetMarginTypeRequest) Reset() {
+	*x = GetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) String() string {
+func (x *GetMarginTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuturePosition) ProtoMessage() {}
+func (*GetMarginTypeRequest) ProtoMessage() {}
 
-func (x *FuturePosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[173]
+func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10893,172 +13601,219 @@ func (x *FuturePosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuturePosition.ProtoReflect.Descriptor instead.
-func (*FuturePosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{173}
+// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *FuturePosition) GetExchange() string {
+func (x *GetMarginTypeRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetAsset() string {
+func (x *GetMarginTypeRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetPair() *CurrencyPair {
+func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *FuturePosition) GetStatus() string {
+type GetMarginTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMarginTypeResponse) Reset() {
+	*x = GetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMarginTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMarginTypeResponse) ProtoMessage() {}
+
+func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *GetMarginTypeResponse) GetExchange() string {
 	if x != nil {
-		return x.Status
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningDate() string {
+func (x *GetMarginTypeResponse) GetAsset() string {
 	if x != nil {
-		return x.OpeningDate
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningDirection() string {
+func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.OpeningDirection
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *FuturePosition) GetOpeningPrice() string {
+func (x *GetMarginTypeResponse) GetMarginType() string {
 	if x != nil {
-		return x.OpeningPrice
+		return x.MarginType
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOpeningSize() string {
-	if x != nil {
-		return x.OpeningSize
-	}
-	return ""
+type ChangePositionMarginRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
+	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *FuturePosition) GetCurrentDirection() string {
-	if x != nil {
-		return x.CurrentDirection
-	}
-	return ""
+func (x *ChangePositionMarginRequest) Reset() {
+	*x = ChangePositionMarginRequest{}
+	mi := &file_rpc_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *FuturePosition) GetCurrentPrice() string {
-	if x != nil {
-		return x.CurrentPrice
-	}
-	return ""
+func (x *ChangePositionMarginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FuturePosition) GetCurrentSize() string {
+func (*ChangePositionMarginRequest) ProtoMessage() {}
+
+func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[210]
 	if x != nil {
-		return x.CurrentSize
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *FuturePosition) GetUnrealisedPnl() string {
-	if x != nil {
-		return x.UnrealisedPnl
-	}
-	return ""
+// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *FuturePosition) GetRealisedPnl() string {
+func (x *ChangePositionMarginRequest) GetExchange() string {
 	if x != nil {
-		return x.RealisedPnl
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetClosingDate() string {
+func (x *ChangePositionMarginRequest) GetAsset() string {
 	if x != nil {
-		return x.ClosingDate
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOrderCount() int64 {
+func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.OrderCount
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *FuturePosition) GetContractSettlementType() string {
+func (x *ChangePositionMarginRequest) GetMarginType() string {
 	if x != nil {
-		return x.ContractSettlementType
+		return x.MarginType
 	}
 	return ""
 }
 
-func (x *FuturePosition) GetOrders() []*OrderDetails {
+func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
 	if x != nil {
-		return x.Orders
+		return x.OriginalAllocatedMargin
 	}
-	return nil
+	return 0
 }
 
-func (x *FuturePosition) GetPositionStats() *FuturesPositionStats {
+func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
 	if x != nil {
-		return x.PositionStats
+		return x.NewAllocatedMargin
 	}
-	return nil
+	return 0
 }
 
-func (x *FuturePosition) GetFundingData() *FundingData {
+func (x *ChangePositionMarginRequest) GetMarginSide() string {
 	if x != nil {
-		return x.FundingData
+		return x.MarginSide
 	}
-	return nil
+	return ""
 }
 
-type GetManagedPositionRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,4,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,5,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,6,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,7,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type ChangePositionMarginResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
+	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionRequest) Reset() {
-	*x = GetManagedPositionRequest{}
-	mi := &file_rpc_proto_msgTypes[174]
+func (x *ChangePositionMarginResponse) Reset() {
+	*x = ChangePositionMarginResponse{}
+	mi := &file_rpc_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionRequest) String() string {
+func (x *ChangePositionMarginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionRequest) ProtoMessage() {}
+func (*ChangePositionMarginResponse) ProtoMessage() {}
 
-func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[174]
+func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11069,85 +13824,78 @@ func (x *GetManagedPositionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionRequest.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{174}
+// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
+func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *GetManagedPositionRequest) GetExchange() string {
+func (x *ChangePositionMarginResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetAsset() string {
+func (x *ChangePositionMarginResponse) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetManagedPositionRequest) GetPair() *CurrencyPair {
+func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetManagedPositionRequest) GetIncludeFullOrderData() bool {
-	if x != nil {
-		return x.IncludeFullOrderData
-	}
-	return false
-}
-
-func (x *GetManagedPositionRequest) GetGetFundingPayments() bool {
+func (x *ChangePositionMarginResponse) GetMarginType() string {
 	if x != nil {
-		return x.GetFundingPayments
+		return x.MarginType
 	}
-	return false
+	return ""
 }
 
-func (x *GetManagedPositionRequest) GetIncludeFullFundingRates() bool {
+func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
 	if x != nil {
-		return x.IncludeFullFundingRates
+		return x.NewAllocatedMargin
 	}
-	return false
+	return 0
 }
 
-func (x *GetManagedPositionRequest) GetIncludePredictedRate() bool {
+func (x *ChangePositionMarginResponse) GetMarginSide() string {
 	if x != nil {
-		return x.IncludePredictedRate
+		return x.MarginSide
 	}
-	return false
+	return ""
 }
 
-type GetAllManagedPositionsRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	IncludeFullOrderData    bool                   `protobuf:"varint,1,opt,name=include_full_order_data,json=includeFullOrderData,proto3" json:"include_full_order_data,omitempty"`
-	GetFundingPayments      bool                   `protobuf:"varint,2,opt,name=get_funding_payments,json=getFundingPayments,proto3" json:"get_funding_payments,omitempty"`
-	IncludeFullFundingRates bool                   `protobuf:"varint,3,opt,name=include_full_funding_rates,json=includeFullFundingRates,proto3" json:"include_full_funding_rates,omitempty"`
-	IncludePredictedRate    bool                   `protobuf:"varint,4,opt,name=include_predicted_rate,json=includePredictedRate,proto3" json:"include_predicted_rate,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type SetMarginTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAllManagedPositionsRequest) Reset() {
-	*x = GetAllManagedPositionsRequest{}
-	mi := &file_rpc_proto_msgTypes[175]
+func (x *SetMarginTypeRequest) Reset() {
+	*x = SetMarginTypeRequest{}
+	mi := &file_rpc_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAllManagedPositionsRequest) String() string {
+func (x *SetMarginTypeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllManagedPositionsRequest) ProtoMessage() {}
+func (*SetMarginTypeRequest) ProtoMessage() {}
 
-func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[175]
+func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11158,61 +13906,64 @@ func (x *GetAllManagedPositionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllManagedPositionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllManagedPositionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{175}
+// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludeFullOrderData() bool {
+func (x *SetMarginTypeRequest) GetExchange() string {
 	if x != nil {
-		return x.IncludeFullOrderData
+		return x.Exchange
 	}
-	return false
+	return ""
 }
 
-func (x *GetAllManagedPositionsRequest) GetGetFundingPayments() bool {
+func (x *SetMarginTypeRequest) GetAsset() string {
 	if x != nil {
-		return x.GetFundingPayments
+		return x.Asset
 	}
-	return false
+	return ""
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludeFullFundingRates() bool {
+func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.IncludeFullFundingRates
+		return x.Pair
 	}
-	return false
+	return nil
 }
 
-func (x *GetAllManagedPositionsRequest) GetIncludePredictedRate() bool {
+func (x *SetMarginTypeRequest) GetMarginType() string {
 	if x != nil {
-		return x.IncludePredictedRate
+		return x.MarginType
 	}
-	return false
+	return ""
 }
 
-type GetManagedPositionsResponse struct {
+type SetMarginTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetManagedPositionsResponse) Reset() {
-	*x = GetManagedPositionsResponse{}
-	mi := &file_rpc_proto_msgTypes[176]
+func (x *SetMarginTypeResponse) Reset() {
+	*x = SetMarginTypeResponse{}
+	mi := &file_rpc_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetManagedPositionsResponse) String() string {
+func (x *SetMarginTypeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetManagedPositionsResponse) ProtoMessage() {}
+func (*SetMarginTypeResponse) ProtoMessage() {}
 
-func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[176]
+func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11223,43 +13974,66 @@ func (x *GetManagedPositionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetManagedPositionsResponse.ProtoReflect.Descriptor instead.
-func (*GetManagedPositionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{176}
+// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
+func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *GetManagedPositionsResponse) GetPositions() []*FuturePosition {
+func (x *SetMarginTypeResponse) GetExchange() string {
 	if x != nil {
-		return x.Positions
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SetMarginTypeResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
 	}
 	return nil
 }
 
-type GetFuturesPositionsSummaryRequest struct {
+func (x *SetMarginTypeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetLeverageRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
 	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
 	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsSummaryRequest) Reset() {
-	*x = GetFuturesPositionsSummaryRequest{}
-	mi := &file_rpc_proto_msgTypes[177]
+func (x *GetLeverageRequest) Reset() {
+	*x = GetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryRequest) String() string {
+func (x *GetLeverageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryRequest) ProtoMessage() {}
+func (*GetLeverageRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[177]
+func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11270,64 +14044,81 @@ func (x *GetFuturesPositionsSummaryRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{177}
+// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{214}
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetExchange() string {
+func (x *GetLeverageRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetAsset() string {
+func (x *GetLeverageRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetPair() *CurrencyPair {
+func (x *GetLeverageRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsSummaryRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
 		return x.UnderlyingPair
 	}
 	return nil
 }
 
-type GetFuturesPositionsSummaryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	PositionStats *FuturesPositionStats  `protobuf:"bytes,4,opt,name=position_stats,json=positionStats,proto3" json:"position_stats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetLeverageRequest) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) Reset() {
-	*x = GetFuturesPositionsSummaryResponse{}
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *GetLeverageRequest) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type GetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetLeverageResponse) Reset() {
+	*x = GetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsSummaryResponse) String() string {
+func (x *GetLeverageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsSummaryResponse) ProtoMessage() {}
+func (*GetLeverageResponse) ProtoMessage() {}
 
-func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[178]
+func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11338,68 +14129,88 @@ func (x *GetFuturesPositionsSummaryResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{178}
+// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetExchange() string {
+func (x *GetLeverageResponse) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetAsset() string {
+func (x *GetLeverageResponse) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetPair() *CurrencyPair {
+func (x *GetLeverageResponse) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsSummaryResponse) GetPositionStats() *FuturesPositionStats {
+func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
-		return x.PositionStats
+		return x.UnderlyingPair
 	}
 	return nil
 }
 
-type GetFuturesPositionsOrdersRequest struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                  string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                     string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                      *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair            *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	StartDate                 string                 `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate                   string                 `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	RespectOrderHistoryLimits bool                   `protobuf:"varint,7,opt,name=respect_order_history_limits,json=respectOrderHistoryLimits,proto3" json:"respect_order_history_limits,omitempty"`
-	SyncWithOrderManager      bool                   `protobuf:"varint,8,opt,name=sync_with_order_manager,json=syncWithOrderManager,proto3" json:"sync_with_order_manager,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *GetLeverageResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) Reset() {
-	*x = GetFuturesPositionsOrdersRequest{}
-	mi := &file_rpc_proto_msgTypes[179]
+func (x *GetLeverageResponse) GetLeverage() float64 {
+	if x != nil {
+		return x.Leverage
+	}
+	return 0
+}
+
+func (x *GetLeverageResponse) GetOrderSide() string {
+	if x != nil {
+		return x.OrderSide
+	}
+	return ""
+}
+
+type SetLeverageRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetLeverageRequest) Reset() {
+	*x = SetLeverageRequest{}
+	mi := &file_rpc_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsOrdersRequest) String() string {
+func (x *SetLeverageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsOrdersRequest) ProtoMessage() {}
+func (*SetLeverageRequest) ProtoMessage() {}
 
-func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[179]
+func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11410,89 +14221,88 @@ func (x *GetFuturesPositionsOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{179}
+// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
+func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{216}
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetExchange() string {
+func (x *SetLeverageRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetAsset() string {
+func (x *SetLeverageRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetPair() *CurrencyPair {
+func (x *SetLeverageRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
 		return x.UnderlyingPair
 	}
 	return nil
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetStartDate() string {
-	if x != nil {
-		return x.StartDate
-	}
-	return ""
-}
-
-func (x *GetFuturesPositionsOrdersRequest) GetEndDate() string {
+func (x *SetLeverageRequest) GetMarginType() string {
 	if x != nil {
-		return x.EndDate
+		return x.MarginType
 	}
 	return ""
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetRespectOrderHistoryLimits() bool {
+func (x *SetLeverageRequest) GetLeverage() float64 {
 	if x != nil {
-		return x.RespectOrderHistoryLimits
+		return x.Leverage
 	}
-	return false
+	return 0
 }
 
-func (x *GetFuturesPositionsOrdersRequest) GetSyncWithOrderManager() bool {
+func (x *SetLeverageRequest) GetOrderSide() string {
 	if x != nil {
-		return x.SyncWithOrderManager
+		return x.OrderSide
 	}
-	return false
+	return ""
 }
 
-type GetFuturesPositionsOrdersResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Positions     []*FuturePosition      `protobuf:"bytes,6,rep,name=positions,proto3" json:"positions,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SetLeverageResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
+	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
+	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
+	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetFuturesPositionsOrdersResponse) Reset() {
-	*x = GetFuturesPositionsOrdersResponse{}
-	mi := &file_rpc_proto_msgTypes[180]
+func (x *SetLeverageResponse) Reset() {
+	*x = SetLeverageResponse{}
+	mi := &file_rpc_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFuturesPositionsOrdersResponse) String() string {
+func (x *SetLeverageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFuturesPositionsOrdersResponse) ProtoMessage() {}
-
-func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[180]
+func (*SetLeverageResponse) ProtoMessage() {}
+
+func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11503,94 +14313,86 @@ func (x *GetFuturesPositionsOrdersResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFuturesPositionsOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetFuturesPositionsOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{180}
+// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
+func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *GetFuturesPositionsOrdersResponse) GetPositions() []*FuturePosition {
+func (x *SetLeverageResponse) GetExchange() string {
 	if x != nil {
-		return x.Positions
+		return x.Exchange
 	}
-	return nil
-}
-
-type GetCollateralModeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetCollateralModeRequest) Reset() {
-	*x = GetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[181]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SetLeverageResponse) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *GetCollateralModeRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SetLeverageResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*GetCollateralModeRequest) ProtoMessage() {}
-
-func (x *GetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[181]
+func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UnderlyingPair
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use GetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{181}
+func (x *SetLeverageResponse) GetMarginType() string {
+	if x != nil {
+		return x.MarginType
+	}
+	return ""
 }
 
-func (x *GetCollateralModeRequest) GetExchange() string {
+func (x *SetLeverageResponse) GetOrderSide() string {
 	if x != nil {
-		return x.Exchange
+		return x.OrderSide
 	}
 	return ""
 }
 
-func (x *GetCollateralModeRequest) GetAsset() string {
+func (x *SetLeverageResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Asset
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-type GetCollateralModeResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetCollateralRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
+	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *GetCollateralModeResponse) Reset() {
-	*x = GetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *GetCollateralRequest) Reset() {
+	*x = GetCollateralRequest{}
+	mi := &file_rpc_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralModeResponse) String() string {
+func (x *GetCollateralRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralModeResponse) ProtoMessage() {}
+func (*GetCollateralRequest) ProtoMessage() {}
 
-func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[182]
+func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11601,56 +14403,78 @@ func (x *GetCollateralModeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{182}
+// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
+func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{218}
 }
 
-func (x *GetCollateralModeResponse) GetExchange() string {
+func (x *GetCollateralRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetAsset() string {
+func (x *GetCollateralRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetCollateralModeResponse) GetCollateralMode() string {
+func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
 	if x != nil {
-		return x.CollateralMode
+		return x.IncludeBreakdown
 	}
-	return ""
+	return false
 }
 
-type SetCollateralModeRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	CollateralMode string                 `protobuf:"bytes,3,opt,name=collateral_mode,json=collateralMode,proto3" json:"collateral_mode,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *GetCollateralRequest) GetCalculateOffline() bool {
+	if x != nil {
+		return x.CalculateOffline
+	}
+	return false
 }
 
-func (x *SetCollateralModeRequest) Reset() {
-	*x = SetCollateralModeRequest{}
-	mi := &file_rpc_proto_msgTypes[183]
+func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
+	if x != nil {
+		return x.IncludeZeroValues
+	}
+	return false
+}
+
+type GetCollateralResponse struct {
+	state                                       protoimpl.MessageState   `protogen:"open.v1"`
+	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
+	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
+	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
+	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
+	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
+	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
+	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
+	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
+	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
+	unknownFields                               protoimpl.UnknownFields
+	sizeCache                                   protoimpl.SizeCache
+}
+
+func (x *GetCollateralResponse) Reset() {
+	*x = GetCollateralResponse{}
+	mi := &file_rpc_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetCollateralModeRequest) String() string {
+func (x *GetCollateralResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetCollateralModeRequest) ProtoMessage() {}
+func (*GetCollateralResponse) ProtoMessage() {}
 
-func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[183]
+func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11661,116 +14485,122 @@ func (x *SetCollateralModeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetCollateralModeRequest.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{183}
+// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
+func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *SetCollateralModeRequest) GetExchange() string {
+func (x *GetCollateralResponse) GetSubAccount() string {
 	if x != nil {
-		return x.Exchange
+		return x.SubAccount
 	}
 	return ""
 }
 
-func (x *SetCollateralModeRequest) GetAsset() string {
+func (x *GetCollateralResponse) GetCollateralCurrency() string {
 	if x != nil {
-		return x.Asset
+		return x.CollateralCurrency
 	}
 	return ""
 }
 
-func (x *SetCollateralModeRequest) GetCollateralMode() string {
+func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
 	if x != nil {
-		return x.CollateralMode
+		return x.TotalValueOfPositiveSpotBalances
 	}
 	return ""
 }
 
-type SetCollateralModeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetCollateralModeResponse) Reset() {
-	*x = SetCollateralModeResponse{}
-	mi := &file_rpc_proto_msgTypes[184]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
+	if x != nil {
+		return x.CollateralContributedByPositiveSpotBalances
+	}
+	return ""
 }
 
-func (x *SetCollateralModeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetCollateralResponse) GetUsedCollateral() string {
+	if x != nil {
+		return x.UsedCollateral
+	}
+	return ""
 }
 
-func (*SetCollateralModeResponse) ProtoMessage() {}
-
-func (x *SetCollateralModeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[184]
+func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UsedBreakdown
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SetCollateralModeResponse.ProtoReflect.Descriptor instead.
-func (*SetCollateralModeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{184}
+func (x *GetCollateralResponse) GetAvailableCollateral() string {
+	if x != nil {
+		return x.AvailableCollateral
+	}
+	return ""
 }
 
-func (x *SetCollateralModeResponse) GetExchange() string {
+func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
 	if x != nil {
-		return x.Exchange
+		return x.MaintenanceCollateral
 	}
 	return ""
 }
 
-func (x *SetCollateralModeResponse) GetAsset() string {
+func (x *GetCollateralResponse) GetUnrealisedPnl() string {
 	if x != nil {
-		return x.Asset
+		return x.UnrealisedPnl
 	}
 	return ""
 }
 
-func (x *SetCollateralModeResponse) GetSuccess() bool {
+func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
 	if x != nil {
-		return x.Success
+		return x.CurrencyBreakdown
 	}
-	return false
+	return nil
 }
 
-type GetMarginTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
+	if x != nil {
+		return x.PositionBreakdown
+	}
+	return nil
 }
 
-func (x *GetMarginTypeRequest) Reset() {
-	*x = GetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[185]
+type CollateralForCurrency struct {
+	state                       protoimpl.MessageState   `protogen:"open.v1"`
+	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
+	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
+	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
+	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
+	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
+	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
+	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
+	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
+	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
+	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
+	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
+	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *CollateralForCurrency) Reset() {
+	*x = CollateralForCurrency{}
+	mi := &file_rpc_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginTypeRequest) String() string {
+func (x *CollateralForCurrency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginTypeRequest) ProtoMessage() {}
+func (*CollateralForCurrency) ProtoMessage() {}
 
-func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[185]
+func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11781,128 +14611,130 @@ func (x *GetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{185}
+// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
+func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *GetMarginTypeRequest) GetExchange() string {
+func (x *CollateralForCurrency) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *GetMarginTypeRequest) GetAsset() string {
+func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
 	if x != nil {
-		return x.Asset
+		return x.ExcludedFromCollateral
 	}
-	return ""
+	return false
 }
 
-func (x *GetMarginTypeRequest) GetPair() *CurrencyPair {
+func (x *CollateralForCurrency) GetTotalFunds() string {
 	if x != nil {
-		return x.Pair
+		return x.TotalFunds
 	}
-	return nil
+	return ""
 }
 
-type GetMarginTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
+	if x != nil {
+		return x.AvailableForUseAsCollateral
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) Reset() {
-	*x = GetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[186]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
+	if x != nil {
+		return x.ApproxFairMarketValue
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *CollateralForCurrency) GetWeighting() string {
+	if x != nil {
+		return x.Weighting
+	}
+	return ""
 }
 
-func (*GetMarginTypeResponse) ProtoMessage() {}
+func (x *CollateralForCurrency) GetCollateralContribution() string {
+	if x != nil {
+		return x.CollateralContribution
+	}
+	return ""
+}
 
-func (x *GetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[186]
+func (x *CollateralForCurrency) GetScaledToCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ScaledToCurrency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{186}
+func (x *CollateralForCurrency) GetUnrealisedPnl() string {
+	if x != nil {
+		return x.UnrealisedPnl
+	}
+	return ""
 }
 
-func (x *GetMarginTypeResponse) GetExchange() string {
+func (x *CollateralForCurrency) GetFundsInUse() string {
 	if x != nil {
-		return x.Exchange
+		return x.FundsInUse
 	}
 	return ""
 }
 
-func (x *GetMarginTypeResponse) GetAsset() string {
+func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
 	if x != nil {
-		return x.Asset
+		return x.AdditionalCollateralUsed
 	}
 	return ""
 }
 
-func (x *GetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
 	if x != nil {
-		return x.Pair
+		return x.UsedBreakdown
 	}
 	return nil
 }
 
-func (x *GetMarginTypeResponse) GetMarginType() string {
+func (x *CollateralForCurrency) GetError() string {
 	if x != nil {
-		return x.MarginType
+		return x.Error
 	}
 	return ""
 }
 
-type ChangePositionMarginRequest struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Exchange                string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                   string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                    *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType              string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OriginalAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=original_allocated_margin,json=originalAllocatedMargin,proto3" json:"original_allocated_margin,omitempty"`
-	NewAllocatedMargin      float64                `protobuf:"fixed64,6,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide              string                 `protobuf:"bytes,7,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type CollateralByPosition struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
+	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
+	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
+	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangePositionMarginRequest) Reset() {
-	*x = ChangePositionMarginRequest{}
-	mi := &file_rpc_proto_msgTypes[187]
+func (x *CollateralByPosition) Reset() {
+	*x = CollateralByPosition{}
+	mi := &file_rpc_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePositionMarginRequest) String() string {
+func (x *CollateralByPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePositionMarginRequest) ProtoMessage() {}
+func (*CollateralByPosition) ProtoMessage() {}
 
-func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[187]
+func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11913,87 +14745,89 @@ func (x *ChangePositionMarginRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePositionMarginRequest.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{187}
+// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
+func (*CollateralByPosition) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{221}
 }
 
-func (x *ChangePositionMarginRequest) GetExchange() string {
+func (x *CollateralByPosition) GetCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetAsset() string {
+func (x *CollateralByPosition) GetSize() string {
 	if x != nil {
-		return x.Asset
+		return x.Size
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetPair() *CurrencyPair {
+func (x *CollateralByPosition) GetOpenOrderSize() string {
 	if x != nil {
-		return x.Pair
+		return x.OpenOrderSize
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetMarginType() string {
+func (x *CollateralByPosition) GetPositionSize() string {
 	if x != nil {
-		return x.MarginType
+		return x.PositionSize
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetOriginalAllocatedMargin() float64 {
+func (x *CollateralByPosition) GetMarkPrice() string {
 	if x != nil {
-		return x.OriginalAllocatedMargin
+		return x.MarkPrice
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetNewAllocatedMargin() float64 {
+func (x *CollateralByPosition) GetRequiredMargin() string {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.RequiredMargin
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangePositionMarginRequest) GetMarginSide() string {
+func (x *CollateralByPosition) GetTotalCollateralUsed() string {
 	if x != nil {
-		return x.MarginSide
+		return x.TotalCollateralUsed
 	}
 	return ""
 }
 
-type ChangePositionMarginResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair               *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType         string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	NewAllocatedMargin float64                `protobuf:"fixed64,5,opt,name=new_allocated_margin,json=newAllocatedMargin,proto3" json:"new_allocated_margin,omitempty"`
-	MarginSide         string                 `protobuf:"bytes,6,opt,name=margin_side,json=marginSide,proto3" json:"margin_side,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CollateralUsedBreakdown struct {
+	state                           protoimpl.MessageState `protogen:"open.v1"`
+	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
+	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
+	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
+	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
+	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
+	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
+	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
+	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
-func (x *ChangePositionMarginResponse) Reset() {
-	*x = ChangePositionMarginResponse{}
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *CollateralUsedBreakdown) Reset() {
+	*x = CollateralUsedBreakdown{}
+	mi := &file_rpc_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePositionMarginResponse) String() string {
+func (x *CollateralUsedBreakdown) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePositionMarginResponse) ProtoMessage() {}
+func (*CollateralUsedBreakdown) ProtoMessage() {}
 
-func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[188]
+func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12004,78 +14838,97 @@ func (x *ChangePositionMarginResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePositionMarginResponse.ProtoReflect.Descriptor instead.
-func (*ChangePositionMarginResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{188}
+// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
+func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
+	if x != nil {
+		return x.LockedInStakes
+	}
+	return ""
+}
+
+func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
+	if x != nil {
+		return x.LockedInNftBids
+	}
+	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetExchange() string {
+func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
 	if x != nil {
-		return x.Exchange
+		return x.LockedInFeeVoucher
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetAsset() string {
+func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
 	if x != nil {
-		return x.Asset
+		return x.LockedInSpotMarginFundingOffers
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetPair() *CurrencyPair {
+func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
 	if x != nil {
-		return x.Pair
+		return x.LockedInSpotOrders
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetMarginType() string {
+func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
 	if x != nil {
-		return x.MarginType
+		return x.LockedAsCollateral
 	}
 	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetNewAllocatedMargin() float64 {
+func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
 	if x != nil {
-		return x.NewAllocatedMargin
+		return x.UsedInFutures
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangePositionMarginResponse) GetMarginSide() string {
+func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
 	if x != nil {
-		return x.MarginSide
+		return x.UsedInSpotMargin
 	}
 	return ""
 }
 
-type SetMarginTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	MarginType    string                 `protobuf:"bytes,4,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetFundingRatesRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
+	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
+	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *SetMarginTypeRequest) Reset() {
-	*x = SetMarginTypeRequest{}
-	mi := &file_rpc_proto_msgTypes[189]
+func (x *GetFundingRatesRequest) Reset() {
+	*x = GetFundingRatesRequest{}
+	mi := &file_rpc_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetMarginTypeRequest) String() string {
+func (x *GetFundingRatesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetMarginTypeRequest) ProtoMessage() {}
+func (*GetFundingRatesRequest) ProtoMessage() {}
 
-func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[189]
+func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12086,134 +14939,96 @@ func (x *SetMarginTypeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetMarginTypeRequest.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{189}
+// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *SetMarginTypeRequest) GetExchange() string {
+func (x *GetFundingRatesRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetMarginTypeRequest) GetAsset() string {
+func (x *GetFundingRatesRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *SetMarginTypeRequest) GetPair() *CurrencyPair {
+func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *SetMarginTypeRequest) GetMarginType() string {
+func (x *GetFundingRatesRequest) GetStartDate() string {
 	if x != nil {
-		return x.MarginType
+		return x.StartDate
 	}
 	return ""
 }
 
-type SetMarginTypeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *SetMarginTypeResponse) Reset() {
-	*x = SetMarginTypeResponse{}
-	mi := &file_rpc_proto_msgTypes[190]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *SetMarginTypeResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*SetMarginTypeResponse) ProtoMessage() {}
-
-func (x *SetMarginTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[190]
+func (x *GetFundingRatesRequest) GetEndDate() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.EndDate
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use SetMarginTypeResponse.ProtoReflect.Descriptor instead.
-func (*SetMarginTypeResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{190}
+	return ""
 }
 
-func (x *SetMarginTypeResponse) GetExchange() string {
+func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
 	if x != nil {
-		return x.Exchange
+		return x.PaymentCurrency
 	}
 	return ""
 }
 
-func (x *SetMarginTypeResponse) GetAsset() string {
+func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
 	if x != nil {
-		return x.Asset
+		return x.IncludePredicted
 	}
-	return ""
+	return false
 }
 
-func (x *SetMarginTypeResponse) GetPair() *CurrencyPair {
+func (x *GetFundingRatesRequest) GetIncludePayments() bool {
 	if x != nil {
-		return x.Pair
+		return x.IncludePayments
 	}
-	return nil
+	return false
 }
 
-func (x *SetMarginTypeResponse) GetSuccess() bool {
+func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
 	if x != nil {
-		return x.Success
+		return x.RespectHistoryLimits
 	}
 	return false
 }
 
-type GetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetFundingRatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeverageRequest) Reset() {
-	*x = GetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[191]
+func (x *GetFundingRatesResponse) Reset() {
+	*x = GetFundingRatesResponse{}
+	mi := &file_rpc_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageRequest) String() string {
+func (x *GetFundingRatesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageRequest) ProtoMessage() {}
+func (*GetFundingRatesResponse) ProtoMessage() {}
 
-func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[191]
+func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12224,81 +15039,43 @@ func (x *GetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*GetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{191}
-}
-
-func (x *GetLeverageRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
-}
-
-func (x *GetLeverageRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *GetLeverageRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
+func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *GetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
+func (x *GetFundingRatesResponse) GetRates() *FundingData {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.Rates
 	}
 	return nil
 }
 
-func (x *GetLeverageRequest) GetMarginType() string {
-	if x != nil {
-		return x.MarginType
-	}
-	return ""
-}
-
-func (x *GetLeverageRequest) GetOrderSide() string {
-	if x != nil {
-		return x.OrderSide
-	}
-	return ""
-}
-
-type GetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetLatestFundingRateRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *GetLeverageResponse) Reset() {
-	*x = GetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *GetLatestFundingRateRequest) Reset() {
+	*x = GetLatestFundingRateRequest{}
+	mi := &file_rpc_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLeverageResponse) String() string {
+func (x *GetLatestFundingRateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLeverageResponse) ProtoMessage() {}
+func (*GetLatestFundingRateRequest) ProtoMessage() {}
 
-func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[192]
+func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12309,88 +15086,104 @@ func (x *GetLeverageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*GetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{192}
+// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *GetLeverageResponse) GetExchange() string {
+func (x *GetLatestFundingRateRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetAsset() string {
+func (x *GetLatestFundingRateRequest) GetAsset() string {
 	if x != nil {
 		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetLeverageResponse) GetPair() *CurrencyPair {
+func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.IncludePredicted
 	}
-	return nil
+	return false
 }
 
-func (x *GetLeverageResponse) GetMarginType() string {
-	if x != nil {
-		return x.MarginType
-	}
-	return ""
+type GetLatestFundingRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetLeverageResponse) GetLeverage() float64 {
+func (x *GetLatestFundingRateResponse) Reset() {
+	*x = GetLatestFundingRateResponse{}
+	mi := &file_rpc_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestFundingRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestFundingRateResponse) ProtoMessage() {}
+
+func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[226]
 	if x != nil {
-		return x.Leverage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetLeverageResponse) GetOrderSide() string {
+// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
+func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
 	if x != nil {
-		return x.OrderSide
+		return x.Rate
 	}
-	return ""
+	return nil
 }
 
-type SetLeverageRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	Leverage       float64                `protobuf:"fixed64,6,opt,name=leverage,proto3" json:"leverage,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,7,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type ShutdownRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetLeverageRequest) Reset() {
-	*x = SetLeverageRequest{}
-	mi := &file_rpc_proto_msgTypes[193]
+func (x *ShutdownRequest) Reset() {
+	*x = ShutdownRequest{}
+	mi := &file_rpc_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageRequest) String() string {
+func (x *ShutdownRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLeverageRequest) ProtoMessage() {}
+func (*ShutdownRequest) ProtoMessage() {}
 
-func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[193]
+func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12401,88 +15194,84 @@ func (x *SetLeverageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLeverageRequest.ProtoReflect.Descriptor instead.
-func (*SetLeverageRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{193}
-}
-
-func (x *SetLeverageRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
+func (*ShutdownRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{227}
 }
 
-func (x *SetLeverageRequest) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
+type ShutdownResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetLeverageRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
+func (x *ShutdownResponse) Reset() {
+	*x = ShutdownResponse{}
+	mi := &file_rpc_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageRequest) GetUnderlyingPair() *CurrencyPair {
-	if x != nil {
-		return x.UnderlyingPair
-	}
-	return nil
+func (x *ShutdownResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *SetLeverageRequest) GetMarginType() string {
-	if x != nil {
-		return x.MarginType
-	}
-	return ""
-}
+func (*ShutdownResponse) ProtoMessage() {}
 
-func (x *SetLeverageRequest) GetLeverage() float64 {
+func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[228]
 	if x != nil {
-		return x.Leverage
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *SetLeverageRequest) GetOrderSide() string {
-	if x != nil {
-		return x.OrderSide
-	}
-	return ""
+// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
+func (*ShutdownResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{228}
 }
 
-type SetLeverageResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Exchange       string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset          string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair           *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	UnderlyingPair *CurrencyPair          `protobuf:"bytes,4,opt,name=underlying_pair,json=underlyingPair,proto3" json:"underlying_pair,omitempty"`
-	MarginType     string                 `protobuf:"bytes,5,opt,name=margin_type,json=marginType,proto3" json:"margin_type,omitempty"`
-	OrderSide      string                 `protobuf:"bytes,6,opt,name=order_side,json=orderSide,proto3" json:"order_side,omitempty"`
-	Success        bool                   `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type GetTechnicalAnalysisRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
+	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
+	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
+	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
+	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
+	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
+	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
+	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
+	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
+	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
+	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
+	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *SetLeverageResponse) Reset() {
-	*x = SetLeverageResponse{}
-	mi := &file_rpc_proto_msgTypes[194]
+func (x *GetTechnicalAnalysisRequest) Reset() {
+	*x = GetTechnicalAnalysisRequest{}
+	mi := &file_rpc_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetLeverageResponse) String() string {
+func (x *GetTechnicalAnalysisRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetLeverageResponse) ProtoMessage() {}
+func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
 
-func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[194]
+func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12493,168 +15282,145 @@ func (x *SetLeverageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetLeverageResponse.ProtoReflect.Descriptor instead.
-func (*SetLeverageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{194}
+// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{229}
 }
 
-func (x *SetLeverageResponse) GetExchange() string {
+func (x *GetTechnicalAnalysisRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *SetLeverageResponse) GetPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *SetLeverageResponse) GetUnderlyingPair() *CurrencyPair {
+func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
 	if x != nil {
-		return x.UnderlyingPair
+		return x.AssetType
 	}
-	return nil
+	return ""
 }
 
-func (x *SetLeverageResponse) GetMarginType() string {
+func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
 	if x != nil {
-		return x.MarginType
+		return x.AlgorithmType
 	}
 	return ""
 }
 
-func (x *SetLeverageResponse) GetOrderSide() string {
+func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
 	if x != nil {
-		return x.OrderSide
+		return x.Interval
 	}
-	return ""
+	return 0
 }
 
-func (x *SetLeverageResponse) GetSuccess() bool {
+func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Success
+		return x.Start
 	}
-	return false
+	return nil
 }
 
-type GetCollateralRequest struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Exchange          string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset             string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	IncludeBreakdown  bool                   `protobuf:"varint,3,opt,name=include_breakdown,json=includeBreakdown,proto3" json:"include_breakdown,omitempty"`
-	CalculateOffline  bool                   `protobuf:"varint,4,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	IncludeZeroValues bool                   `protobuf:"varint,5,opt,name=include_zero_values,json=includeZeroValues,proto3" json:"include_zero_values,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
 }
 
-func (x *GetCollateralRequest) Reset() {
-	*x = GetCollateralRequest{}
-	mi := &file_rpc_proto_msgTypes[195]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+	if x != nil {
+		return x.Period
+	}
+	return 0
 }
 
-func (x *GetCollateralRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
+	if x != nil {
+		return x.FastPeriod
+	}
+	return 0
 }
 
-func (*GetCollateralRequest) ProtoMessage() {}
-
-func (x *GetCollateralRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[195]
+func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.SlowPeriod
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCollateralRequest.ProtoReflect.Descriptor instead.
-func (*GetCollateralRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{195}
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
+	if x != nil {
+		return x.StandardDeviationUp
+	}
+	return 0
 }
 
-func (x *GetCollateralRequest) GetExchange() string {
+func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.StandardDeviationDown
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCollateralRequest) GetAsset() string {
+func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
 	if x != nil {
-		return x.Asset
+		return x.MovingAverageType
 	}
-	return ""
+	return 0
 }
 
-func (x *GetCollateralRequest) GetIncludeBreakdown() bool {
+func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
 	if x != nil {
-		return x.IncludeBreakdown
+		return x.OtherExchange
 	}
-	return false
+	return ""
 }
 
-func (x *GetCollateralRequest) GetCalculateOffline() bool {
+func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
 	if x != nil {
-		return x.CalculateOffline
+		return x.OtherPair
 	}
-	return false
+	return nil
 }
 
-func (x *GetCollateralRequest) GetIncludeZeroValues() bool {
+func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
 	if x != nil {
-		return x.IncludeZeroValues
+		return x.OtherAssetType
 	}
-	return false
+	return ""
 }
 
-type GetCollateralResponse struct {
-	state                                       protoimpl.MessageState   `protogen:"open.v1"`
-	SubAccount                                  string                   `protobuf:"bytes,1,opt,name=sub_account,json=subAccount,proto3" json:"sub_account,omitempty"`
-	CollateralCurrency                          string                   `protobuf:"bytes,2,opt,name=collateral_currency,json=collateralCurrency,proto3" json:"collateral_currency,omitempty"`
-	TotalValueOfPositiveSpotBalances            string                   `protobuf:"bytes,3,opt,name=total_value_of_positive_spot_balances,json=totalValueOfPositiveSpotBalances,proto3" json:"total_value_of_positive_spot_balances,omitempty"`
-	CollateralContributedByPositiveSpotBalances string                   `protobuf:"bytes,4,opt,name=collateral_contributed_by_positive_spot_balances,json=collateralContributedByPositiveSpotBalances,proto3" json:"collateral_contributed_by_positive_spot_balances,omitempty"`
-	UsedCollateral                              string                   `protobuf:"bytes,5,opt,name=used_collateral,json=usedCollateral,proto3" json:"used_collateral,omitempty"`
-	UsedBreakdown                               *CollateralUsedBreakdown `protobuf:"bytes,6,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	AvailableCollateral                         string                   `protobuf:"bytes,7,opt,name=available_collateral,json=availableCollateral,proto3" json:"available_collateral,omitempty"`
-	MaintenanceCollateral                       string                   `protobuf:"bytes,8,opt,name=maintenance_collateral,json=maintenanceCollateral,proto3" json:"maintenance_collateral,omitempty"`
-	UnrealisedPnl                               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	CurrencyBreakdown                           []*CollateralForCurrency `protobuf:"bytes,10,rep,name=currency_breakdown,json=currencyBreakdown,proto3" json:"currency_breakdown,omitempty"`
-	PositionBreakdown                           []*CollateralByPosition  `protobuf:"bytes,11,rep,name=position_breakdown,json=positionBreakdown,proto3" json:"position_breakdown,omitempty"`
-	unknownFields                               protoimpl.UnknownFields
-	sizeCache                                   protoimpl.SizeCache
+type ListOfSignals struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralResponse) Reset() {
-	*x = GetCollateralResponse{}
-	mi := &file_rpc_proto_msgTypes[196]
+func (x *ListOfSignals) Reset() {
+	*x = ListOfSignals{}
+	mi := &file_rpc_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) String() string {
+func (x *ListOfSignals) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollateralResponse) ProtoMessage() {}
+func (*ListOfSignals) ProtoMessage() {}
 
-func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[196]
+func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12665,122 +15431,96 @@ func (x *GetCollateralResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollateralResponse.ProtoReflect.Descriptor instead.
-func (*GetCollateralResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{196}
-}
-
-func (x *GetCollateralResponse) GetSubAccount() string {
-	if x != nil {
-		return x.SubAccount
-	}
-	return ""
-}
-
-func (x *GetCollateralResponse) GetCollateralCurrency() string {
-	if x != nil {
-		return x.CollateralCurrency
-	}
-	return ""
-}
-
-func (x *GetCollateralResponse) GetTotalValueOfPositiveSpotBalances() string {
-	if x != nil {
-		return x.TotalValueOfPositiveSpotBalances
-	}
-	return ""
+// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
+func (*ListOfSignals) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *GetCollateralResponse) GetCollateralContributedByPositiveSpotBalances() string {
+func (x *ListOfSignals) GetSignals() []float64 {
 	if x != nil {
-		return x.CollateralContributedByPositiveSpotBalances
+		return x.Signals
 	}
-	return ""
+	return nil
 }
 
-func (x *GetCollateralResponse) GetUsedCollateral() string {
-	if x != nil {
-		return x.UsedCollateral
-	}
-	return ""
+type GetTechnicalAnalysisResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollateralResponse) GetUsedBreakdown() *CollateralUsedBreakdown {
-	if x != nil {
-		return x.UsedBreakdown
-	}
-	return nil
+func (x *GetTechnicalAnalysisResponse) Reset() {
+	*x = GetTechnicalAnalysisResponse{}
+	mi := &file_rpc_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollateralResponse) GetAvailableCollateral() string {
-	if x != nil {
-		return x.AvailableCollateral
-	}
-	return ""
+func (x *GetTechnicalAnalysisResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetCollateralResponse) GetMaintenanceCollateral() string {
-	if x != nil {
-		return x.MaintenanceCollateral
-	}
-	return ""
-}
+func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
 
-func (x *GetCollateralResponse) GetUnrealisedPnl() string {
+func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[231]
 	if x != nil {
-		return x.UnrealisedPnl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetCollateralResponse) GetCurrencyBreakdown() []*CollateralForCurrency {
-	if x != nil {
-		return x.CurrencyBreakdown
-	}
-	return nil
+// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
+func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{231}
 }
 
-func (x *GetCollateralResponse) GetPositionBreakdown() []*CollateralByPosition {
+func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
 	if x != nil {
-		return x.PositionBreakdown
+		return x.Signals
 	}
 	return nil
 }
 
-type CollateralForCurrency struct {
-	state                       protoimpl.MessageState   `protogen:"open.v1"`
-	Currency                    string                   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	ExcludedFromCollateral      bool                     `protobuf:"varint,2,opt,name=excluded_from_collateral,json=excludedFromCollateral,proto3" json:"excluded_from_collateral,omitempty"`
-	TotalFunds                  string                   `protobuf:"bytes,3,opt,name=total_funds,json=totalFunds,proto3" json:"total_funds,omitempty"`
-	AvailableForUseAsCollateral string                   `protobuf:"bytes,4,opt,name=available_for_use_as_collateral,json=availableForUseAsCollateral,proto3" json:"available_for_use_as_collateral,omitempty"`
-	ApproxFairMarketValue       string                   `protobuf:"bytes,5,opt,name=approx_fair_market_value,json=approxFairMarketValue,proto3" json:"approx_fair_market_value,omitempty"`
-	Weighting                   string                   `protobuf:"bytes,6,opt,name=weighting,proto3" json:"weighting,omitempty"`
-	CollateralContribution      string                   `protobuf:"bytes,7,opt,name=collateral_contribution,json=collateralContribution,proto3" json:"collateral_contribution,omitempty"`
-	ScaledToCurrency            string                   `protobuf:"bytes,8,opt,name=scaled_to_currency,json=scaledToCurrency,proto3" json:"scaled_to_currency,omitempty"`
-	UnrealisedPnl               string                   `protobuf:"bytes,9,opt,name=unrealised_pnl,json=unrealisedPnl,proto3" json:"unrealised_pnl,omitempty"`
-	FundsInUse                  string                   `protobuf:"bytes,10,opt,name=funds_in_use,json=fundsInUse,proto3" json:"funds_in_use,omitempty"`
-	AdditionalCollateralUsed    string                   `protobuf:"bytes,11,opt,name=additional_collateral_used,json=additionalCollateralUsed,proto3" json:"additional_collateral_used,omitempty"`
-	UsedBreakdown               *CollateralUsedBreakdown `protobuf:"bytes,12,opt,name=used_breakdown,json=usedBreakdown,proto3" json:"used_breakdown,omitempty"`
-	Error                       string                   `protobuf:"bytes,13,opt,name=error,proto3" json:"error,omitempty"`
-	unknownFields               protoimpl.UnknownFields
-	sizeCache                   protoimpl.SizeCache
+type GetMarginRatesHistoryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
+	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
+	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
+	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
+	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
+	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *CollateralForCurrency) Reset() {
-	*x = CollateralForCurrency{}
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *GetMarginRatesHistoryRequest) Reset() {
+	*x = GetMarginRatesHistoryRequest{}
+	mi := &file_rpc_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralForCurrency) String() string {
+func (x *GetMarginRatesHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralForCurrency) ProtoMessage() {}
+func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
 
-func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[197]
+func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12791,130 +15531,125 @@ func (x *CollateralForCurrency) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralForCurrency.ProtoReflect.Descriptor instead.
-func (*CollateralForCurrency) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{197}
+// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *CollateralForCurrency) GetCurrency() string {
+func (x *GetMarginRatesHistoryRequest) GetExchange() string {
 	if x != nil {
-		return x.Currency
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetExcludedFromCollateral() bool {
+func (x *GetMarginRatesHistoryRequest) GetAsset() string {
 	if x != nil {
-		return x.ExcludedFromCollateral
+		return x.Asset
 	}
-	return false
+	return ""
 }
 
-func (x *CollateralForCurrency) GetTotalFunds() string {
+func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
 	if x != nil {
-		return x.TotalFunds
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetAvailableForUseAsCollateral() string {
+func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
 	if x != nil {
-		return x.AvailableForUseAsCollateral
+		return x.StartDate
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetApproxFairMarketValue() string {
+func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
 	if x != nil {
-		return x.ApproxFairMarketValue
+		return x.EndDate
 	}
 	return ""
 }
 
-func (x *CollateralForCurrency) GetWeighting() string {
+func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
 	if x != nil {
-		return x.Weighting
+		return x.GetPredictedRate
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetCollateralContribution() string {
+func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
 	if x != nil {
-		return x.CollateralContribution
+		return x.GetLendingPayments
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetScaledToCurrency() string {
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
 	if x != nil {
-		return x.ScaledToCurrency
+		return x.GetBorrowRates
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetUnrealisedPnl() string {
+func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
 	if x != nil {
-		return x.UnrealisedPnl
+		return x.GetBorrowCosts
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetFundsInUse() string {
+func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
 	if x != nil {
-		return x.FundsInUse
+		return x.IncludeAllRates
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetAdditionalCollateralUsed() string {
+func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
 	if x != nil {
-		return x.AdditionalCollateralUsed
+		return x.CalculateOffline
 	}
-	return ""
+	return false
 }
 
-func (x *CollateralForCurrency) GetUsedBreakdown() *CollateralUsedBreakdown {
+func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
 	if x != nil {
-		return x.UsedBreakdown
+		return x.TakerFeeRate
 	}
-	return nil
+	return ""
 }
 
-func (x *CollateralForCurrency) GetError() string {
+func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
 	if x != nil {
-		return x.Error
+		return x.Rates
 	}
-	return ""
+	return nil
 }
 
-type CollateralByPosition struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	Currency            string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
-	Size                string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
-	OpenOrderSize       string                 `protobuf:"bytes,3,opt,name=open_order_size,json=openOrderSize,proto3" json:"open_order_size,omitempty"`
-	PositionSize        string                 `protobuf:"bytes,4,opt,name=position_size,json=positionSize,proto3" json:"position_size,omitempty"`
-	MarkPrice           string                 `protobuf:"bytes,5,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
-	RequiredMargin      string                 `protobuf:"bytes,6,opt,name=required_margin,json=requiredMargin,proto3" json:"required_margin,omitempty"`
-	TotalCollateralUsed string                 `protobuf:"bytes,7,opt,name=total_collateral_used,json=totalCollateralUsed,proto3" json:"total_collateral_used,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type LendingPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollateralByPosition) Reset() {
-	*x = CollateralByPosition{}
-	mi := &file_rpc_proto_msgTypes[198]
+func (x *LendingPayment) Reset() {
+	*x = LendingPayment{}
+	mi := &file_rpc_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralByPosition) String() string {
+func (x *LendingPayment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralByPosition) ProtoMessage() {}
+func (*LendingPayment) ProtoMessage() {}
 
-func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[198]
+func (x *LendingPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12925,89 +15660,106 @@ func (x *CollateralByPosition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralByPosition.ProtoReflect.Descriptor instead.
-func (*CollateralByPosition) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{198}
+// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
+func (*LendingPayment) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{233}
 }
 
-func (x *CollateralByPosition) GetCurrency() string {
+func (x *LendingPayment) GetPayment() string {
 	if x != nil {
-		return x.Currency
+		return x.Payment
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetSize() string {
+func (x *LendingPayment) GetSize() string {
 	if x != nil {
 		return x.Size
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetOpenOrderSize() string {
-	if x != nil {
-		return x.OpenOrderSize
-	}
-	return ""
+type BorrowCost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CollateralByPosition) GetPositionSize() string {
-	if x != nil {
-		return x.PositionSize
-	}
-	return ""
+func (x *BorrowCost) Reset() {
+	*x = BorrowCost{}
+	mi := &file_rpc_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralByPosition) GetMarkPrice() string {
+func (x *BorrowCost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BorrowCost) ProtoMessage() {}
+
+func (x *BorrowCost) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[234]
 	if x != nil {
-		return x.MarkPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CollateralByPosition) GetRequiredMargin() string {
+// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
+func (*BorrowCost) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *BorrowCost) GetCost() string {
 	if x != nil {
-		return x.RequiredMargin
+		return x.Cost
 	}
 	return ""
 }
 
-func (x *CollateralByPosition) GetTotalCollateralUsed() string {
+func (x *BorrowCost) GetSize() string {
 	if x != nil {
-		return x.TotalCollateralUsed
+		return x.Size
 	}
 	return ""
 }
 
-type CollateralUsedBreakdown struct {
-	state                           protoimpl.MessageState `protogen:"open.v1"`
-	LockedInStakes                  string                 `protobuf:"bytes,1,opt,name=locked_in_stakes,json=lockedInStakes,proto3" json:"locked_in_stakes,omitempty"`
-	LockedInNftBids                 string                 `protobuf:"bytes,2,opt,name=locked_in_nft_bids,json=lockedInNftBids,proto3" json:"locked_in_nft_bids,omitempty"`
-	LockedInFeeVoucher              string                 `protobuf:"bytes,3,opt,name=locked_in_fee_voucher,json=lockedInFeeVoucher,proto3" json:"locked_in_fee_voucher,omitempty"`
-	LockedInSpotMarginFundingOffers string                 `protobuf:"bytes,4,opt,name=locked_in_spot_margin_funding_offers,json=lockedInSpotMarginFundingOffers,proto3" json:"locked_in_spot_margin_funding_offers,omitempty"`
-	LockedInSpotOrders              string                 `protobuf:"bytes,5,opt,name=locked_in_spot_orders,json=lockedInSpotOrders,proto3" json:"locked_in_spot_orders,omitempty"`
-	LockedAsCollateral              string                 `protobuf:"bytes,6,opt,name=locked_as_collateral,json=lockedAsCollateral,proto3" json:"locked_as_collateral,omitempty"`
-	UsedInFutures                   string                 `protobuf:"bytes,7,opt,name=used_in_futures,json=usedInFutures,proto3" json:"used_in_futures,omitempty"`
-	UsedInSpotMargin                string                 `protobuf:"bytes,8,opt,name=used_in_spot_margin,json=usedInSpotMargin,proto3" json:"used_in_spot_margin,omitempty"`
-	unknownFields                   protoimpl.UnknownFields
-	sizeCache                       protoimpl.SizeCache
+type MarginRate struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
+	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
+	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
+	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
+	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
+	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
+	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *CollateralUsedBreakdown) Reset() {
-	*x = CollateralUsedBreakdown{}
-	mi := &file_rpc_proto_msgTypes[199]
+func (x *MarginRate) Reset() {
+	*x = MarginRate{}
+	mi := &file_rpc_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CollateralUsedBreakdown) String() string {
+func (x *MarginRate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CollateralUsedBreakdown) ProtoMessage() {}
+func (*MarginRate) ProtoMessage() {}
 
-func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[199]
+func (x *MarginRate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13018,97 +15770,97 @@ func (x *CollateralUsedBreakdown) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CollateralUsedBreakdown.ProtoReflect.Descriptor instead.
-func (*CollateralUsedBreakdown) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{199}
+// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
+func (*MarginRate) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{235}
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInStakes() string {
+func (x *MarginRate) GetTime() string {
 	if x != nil {
-		return x.LockedInStakes
+		return x.Time
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInNftBids() string {
+func (x *MarginRate) GetMarketBorrowSize() string {
 	if x != nil {
-		return x.LockedInNftBids
+		return x.MarketBorrowSize
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInFeeVoucher() string {
+func (x *MarginRate) GetHourlyRate() string {
 	if x != nil {
-		return x.LockedInFeeVoucher
+		return x.HourlyRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotMarginFundingOffers() string {
+func (x *MarginRate) GetYearlyRate() string {
 	if x != nil {
-		return x.LockedInSpotMarginFundingOffers
+		return x.YearlyRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedInSpotOrders() string {
+func (x *MarginRate) GetHourlyBorrowRate() string {
 	if x != nil {
-		return x.LockedInSpotOrders
+		return x.HourlyBorrowRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetLockedAsCollateral() string {
+func (x *MarginRate) GetYearlyBorrowRate() string {
 	if x != nil {
-		return x.LockedAsCollateral
+		return x.YearlyBorrowRate
 	}
 	return ""
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInFutures() string {
+func (x *MarginRate) GetLendingPayment() *LendingPayment {
 	if x != nil {
-		return x.UsedInFutures
+		return x.LendingPayment
 	}
-	return ""
+	return nil
 }
 
-func (x *CollateralUsedBreakdown) GetUsedInSpotMargin() string {
+func (x *MarginRate) GetBorrowCost() *BorrowCost {
 	if x != nil {
-		return x.UsedInSpotMargin
+		return x.BorrowCost
 	}
-	return ""
+	return nil
 }
 
-type GetFundingRatesRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                 *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	StartDate            string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate              string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	PaymentCurrency      string                 `protobuf:"bytes,6,opt,name=payment_currency,json=paymentCurrency,proto3" json:"payment_currency,omitempty"`
-	IncludePredicted     bool                   `protobuf:"varint,7,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	IncludePayments      bool                   `protobuf:"varint,8,opt,name=include_payments,json=includePayments,proto3" json:"include_payments,omitempty"`
-	RespectHistoryLimits bool                   `protobuf:"varint,9,opt,name=respect_history_limits,json=respectHistoryLimits,proto3" json:"respect_history_limits,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type GetMarginRatesHistoryResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
+	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
+	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
+	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
+	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
+	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
+	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
+	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetFundingRatesRequest) Reset() {
-	*x = GetFundingRatesRequest{}
-	mi := &file_rpc_proto_msgTypes[200]
+func (x *GetMarginRatesHistoryResponse) Reset() {
+	*x = GetMarginRatesHistoryResponse{}
+	mi := &file_rpc_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFundingRatesRequest) String() string {
+func (x *GetMarginRatesHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFundingRatesRequest) ProtoMessage() {}
+func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
 
-func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[200]
+func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13119,96 +15871,102 @@ func (x *GetFundingRatesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFundingRatesRequest.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{200}
+// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{236}
 }
 
-func (x *GetFundingRatesRequest) GetExchange() string {
+func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
 	if x != nil {
-		return x.Exchange
+		return x.Rates
 	}
-	return ""
+	return nil
 }
 
-func (x *GetFundingRatesRequest) GetAsset() string {
+func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
 	if x != nil {
-		return x.Asset
+		return x.TotalRates
 	}
-	return ""
+	return 0
 }
 
-func (x *GetFundingRatesRequest) GetPair() *CurrencyPair {
+func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
 	if x != nil {
-		return x.Pair
+		return x.SumBorrowCosts
 	}
-	return nil
+	return ""
 }
 
-func (x *GetFundingRatesRequest) GetStartDate() string {
+func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
 	if x != nil {
-		return x.StartDate
+		return x.AvgBorrowSize
 	}
 	return ""
 }
 
-func (x *GetFundingRatesRequest) GetEndDate() string {
+func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
 	if x != nil {
-		return x.EndDate
+		return x.SumLendingPayments
 	}
 	return ""
 }
 
-func (x *GetFundingRatesRequest) GetPaymentCurrency() string {
+func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
 	if x != nil {
-		return x.PaymentCurrency
+		return x.AvgLendingSize
 	}
 	return ""
 }
 
-func (x *GetFundingRatesRequest) GetIncludePredicted() bool {
+func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
 	if x != nil {
-		return x.IncludePredicted
+		return x.LatestRate
 	}
-	return false
+	return nil
 }
 
-func (x *GetFundingRatesRequest) GetIncludePayments() bool {
+func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
 	if x != nil {
-		return x.IncludePayments
+		return x.PredictedRate
 	}
-	return false
+	return nil
 }
 
-func (x *GetFundingRatesRequest) GetRespectHistoryLimits() bool {
+func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
 	if x != nil {
-		return x.RespectHistoryLimits
+		return x.TakerFeeRate
 	}
-	return false
+	return ""
 }
 
-type GetFundingRatesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rates         *FundingData           `protobuf:"bytes,1,opt,name=rates,proto3" json:"rates,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrderbookMovementRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetFundingRatesResponse) Reset() {
-	*x = GetFundingRatesResponse{}
-	mi := &file_rpc_proto_msgTypes[201]
+func (x *GetOrderbookMovementRequest) Reset() {
+	*x = GetOrderbookMovementRequest{}
+	mi := &file_rpc_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFundingRatesResponse) String() string {
+func (x *GetOrderbookMovementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFundingRatesResponse) ProtoMessage() {}
+func (*GetOrderbookMovementRequest) ProtoMessage() {}
 
-func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[201]
+func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13219,108 +15977,95 @@ func (x *GetFundingRatesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFundingRatesResponse.ProtoReflect.Descriptor instead.
-func (*GetFundingRatesResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{201}
+// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{237}
 }
 
-func (x *GetFundingRatesResponse) GetRates() *FundingData {
+func (x *GetOrderbookMovementRequest) GetExchange() string {
 	if x != nil {
-		return x.Rates
+		return x.Exchange
 	}
-	return nil
-}
-
-type GetLatestFundingRateRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Exchange         string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset            string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair             *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	IncludePredicted bool                   `protobuf:"varint,4,opt,name=include_predicted,json=includePredicted,proto3" json:"include_predicted,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *GetLatestFundingRateRequest) Reset() {
-	*x = GetLatestFundingRateRequest{}
-	mi := &file_rpc_proto_msgTypes[202]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetLatestFundingRateRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*GetLatestFundingRateRequest) ProtoMessage() {}
-
-func (x *GetLatestFundingRateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[202]
+func (x *GetOrderbookMovementRequest) GetAsset() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Asset
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetLatestFundingRateRequest.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{202}
+func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *GetLatestFundingRateRequest) GetExchange() string {
+func (x *GetOrderbookMovementRequest) GetAmount() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetLatestFundingRateRequest) GetAsset() string {
+func (x *GetOrderbookMovementRequest) GetSell() bool {
 	if x != nil {
-		return x.Asset
+		return x.Sell
 	}
-	return ""
+	return false
 }
 
-func (x *GetLatestFundingRateRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.Pair
+		return x.RequiresRestOrderbook
 	}
-	return nil
+	return false
 }
 
-func (x *GetLatestFundingRateRequest) GetIncludePredicted() bool {
+func (x *GetOrderbookMovementRequest) GetPurchase() bool {
 	if x != nil {
-		return x.IncludePredicted
+		return x.Purchase
 	}
 	return false
 }
 
-type GetLatestFundingRateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rate          *FundingData           `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetOrderbookMovementResponse struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
+	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
+	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
+	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
+	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
+	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
+	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *GetLatestFundingRateResponse) Reset() {
-	*x = GetLatestFundingRateResponse{}
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *GetOrderbookMovementResponse) Reset() {
+	*x = GetOrderbookMovementResponse{}
+	mi := &file_rpc_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetLatestFundingRateResponse) String() string {
+func (x *GetOrderbookMovementResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLatestFundingRateResponse) ProtoMessage() {}
+func (*GetOrderbookMovementResponse) ProtoMessage() {}
 
-func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[203]
+func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13331,127 +16076,136 @@ func (x *GetLatestFundingRateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLatestFundingRateResponse.ProtoReflect.Descriptor instead.
-func (*GetLatestFundingRateResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{203}
+// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{238}
 }
 
-func (x *GetLatestFundingRateResponse) GetRate() *FundingData {
+func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
 	if x != nil {
-		return x.Rate
+		return x.NominalPercentage
 	}
-	return nil
+	return 0
 }
 
-type ShutdownRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
+	if x != nil {
+		return x.ImpactPercentage
+	}
+	return 0
 }
 
-func (x *ShutdownRequest) Reset() {
-	*x = ShutdownRequest{}
-	mi := &file_rpc_proto_msgTypes[204]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
+	if x != nil {
+		return x.SlippageCost
+	}
+	return 0
 }
 
-func (x *ShutdownRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
+	if x != nil {
+		return x.CurrencyBought
+	}
+	return ""
 }
 
-func (*ShutdownRequest) ProtoMessage() {}
+func (x *GetOrderbookMovementResponse) GetBought() float64 {
+	if x != nil {
+		return x.Bought
+	}
+	return 0
+}
 
-func (x *ShutdownRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[204]
+func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CurrencySold
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ShutdownRequest.ProtoReflect.Descriptor instead.
-func (*ShutdownRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{204}
+func (x *GetOrderbookMovementResponse) GetSold() float64 {
+	if x != nil {
+		return x.Sold
+	}
+	return 0
 }
 
-type ShutdownResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrderbookMovementResponse) GetSideAffected() string {
+	if x != nil {
+		return x.SideAffected
+	}
+	return ""
 }
 
-func (x *ShutdownResponse) Reset() {
-	*x = ShutdownResponse{}
-	mi := &file_rpc_proto_msgTypes[205]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
+	if x != nil {
+		return x.UpdateProtocol
+	}
+	return ""
+}
+
+func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
+	if x != nil {
+		return x.FullOrderbookSideConsumed
+	}
+	return false
 }
 
-func (x *ShutdownResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
+	if x != nil {
+		return x.StartPrice
+	}
+	return 0
 }
 
-func (*ShutdownResponse) ProtoMessage() {}
+func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
+	if x != nil {
+		return x.EndPrice
+	}
+	return 0
+}
 
-func (x *ShutdownResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[205]
+func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NoSlippageOccurred
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use ShutdownResponse.ProtoReflect.Descriptor instead.
-func (*ShutdownResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{205}
+func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
+	if x != nil {
+		return x.AverageOrderCost
+	}
+	return 0
 }
 
-type GetTechnicalAnalysisRequest struct {
+type GetOrderbookAmountByNominalRequest struct {
 	state                 protoimpl.MessageState `protogen:"open.v1"`
 	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	AssetType             string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
-	AlgorithmType         string                 `protobuf:"bytes,4,opt,name=algorithm_type,json=algorithmType,proto3" json:"algorithm_type,omitempty"`
-	Interval              int64                  `protobuf:"varint,5,opt,name=interval,proto3" json:"interval,omitempty"`
-	Start                 *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start,proto3" json:"start,omitempty"`
-	End                   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=end,proto3" json:"end,omitempty"`
-	Period                int64                  `protobuf:"varint,8,opt,name=period,proto3" json:"period,omitempty"`
-	FastPeriod            int64                  `protobuf:"varint,9,opt,name=fast_period,json=fastPeriod,proto3" json:"fast_period,omitempty"`
-	SlowPeriod            int64                  `protobuf:"varint,10,opt,name=slow_period,json=slowPeriod,proto3" json:"slow_period,omitempty"`
-	StandardDeviationUp   float64                `protobuf:"fixed64,11,opt,name=standard_deviation_up,json=standardDeviationUp,proto3" json:"standard_deviation_up,omitempty"`
-	StandardDeviationDown float64                `protobuf:"fixed64,12,opt,name=standard_deviation_down,json=standardDeviationDown,proto3" json:"standard_deviation_down,omitempty"`
-	MovingAverageType     int64                  `protobuf:"varint,13,opt,name=moving_average_type,json=movingAverageType,proto3" json:"moving_average_type,omitempty"`
-	OtherExchange         string                 `protobuf:"bytes,14,opt,name=other_exchange,json=otherExchange,proto3" json:"other_exchange,omitempty"`
-	OtherPair             *CurrencyPair          `protobuf:"bytes,15,opt,name=other_pair,json=otherPair,proto3" json:"other_pair,omitempty"`
-	OtherAssetType        string                 `protobuf:"bytes,16,opt,name=other_asset_type,json=otherAssetType,proto3" json:"other_asset_type,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
 
-func (x *GetTechnicalAnalysisRequest) Reset() {
-	*x = GetTechnicalAnalysisRequest{}
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *GetOrderbookAmountByNominalRequest) Reset() {
+	*x = GetOrderbookAmountByNominalRequest{}
+	mi := &file_rpc_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTechnicalAnalysisRequest) String() string {
+func (x *GetOrderbookAmountByNominalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTechnicalAnalysisRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
 
-func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[206]
+func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13462,145 +16216,204 @@ func (x *GetTechnicalAnalysisRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTechnicalAnalysisRequest.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{206}
+// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{239}
 }
 
-func (x *GetTechnicalAnalysisRequest) GetExchange() string {
+func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
+}
+
+func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetTechnicalAnalysisRequest) GetAssetType() string {
+func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
 	if x != nil {
-		return x.AssetType
+		return x.NominalPercentage
 	}
-	return ""
+	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetAlgorithmType() string {
+func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
 	if x != nil {
-		return x.AlgorithmType
+		return x.Sell
 	}
-	return ""
+	return false
 }
 
-func (x *GetTechnicalAnalysisRequest) GetInterval() int64 {
+func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.Interval
+		return x.RequiresRestOrderbook
 	}
-	return 0
+	return false
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStart() *timestamppb.Timestamp {
+type GetOrderbookAmountByNominalResponse struct {
+	state                                protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
+	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                        protoimpl.UnknownFields
+	sizeCache                            protoimpl.SizeCache
+}
+
+func (x *GetOrderbookAmountByNominalResponse) Reset() {
+	*x = GetOrderbookAmountByNominalResponse{}
+	mi := &file_rpc_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderbookAmountByNominalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
+
+func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[240]
 	if x != nil {
-		return x.Start
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetTechnicalAnalysisRequest) GetEnd() *timestamppb.Timestamp {
+// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.End
+		return x.AmountRequired
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
 	if x != nil {
-		return x.Period
+		return x.CurrencySelling
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetFastPeriod() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.FastPeriod
+		return x.AmountReceived
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetSlowPeriod() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
 	if x != nil {
-		return x.SlowPeriod
+		return x.CurrencyBuying
 	}
-	return 0
+	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationUp() float64 {
+func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.StandardDeviationUp
+		return x.StartPrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetStandardDeviationDown() float64 {
+func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.StandardDeviationDown
+		return x.EndPrice
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetMovingAverageType() int64 {
+func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.MovingAverageType
+		return x.AverageOrderCost
 	}
 	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherExchange() string {
+func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
 	if x != nil {
-		return x.OtherExchange
+		return x.SideAffected
 	}
 	return ""
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherPair() *CurrencyPair {
+func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
 	if x != nil {
-		return x.OtherPair
+		return x.ApproximateNominalSlippagePercentage
 	}
-	return nil
+	return 0
 }
 
-func (x *GetTechnicalAnalysisRequest) GetOtherAssetType() string {
+func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
 	if x != nil {
-		return x.OtherAssetType
+		return x.UpdateProtocol
 	}
 	return ""
 }
 
-type ListOfSignals struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Signals       []float64              `protobuf:"fixed64,1,rep,packed,name=signals,proto3" json:"signals,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
+	if x != nil {
+		return x.FullOrderbookSideConsumed
+	}
+	return false
 }
 
-func (x *ListOfSignals) Reset() {
-	*x = ListOfSignals{}
-	mi := &file_rpc_proto_msgTypes[207]
+type GetOrderbookAmountByImpactRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
+	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
+	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetOrderbookAmountByImpactRequest) Reset() {
+	*x = GetOrderbookAmountByImpactRequest{}
+	mi := &file_rpc_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListOfSignals) String() string {
+func (x *GetOrderbookAmountByImpactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListOfSignals) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
 
-func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[207]
+func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13611,96 +16424,85 @@ func (x *ListOfSignals) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListOfSignals.ProtoReflect.Descriptor instead.
-func (*ListOfSignals) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{207}
+// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{241}
 }
 
-func (x *ListOfSignals) GetSignals() []float64 {
+func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
 	if x != nil {
-		return x.Signals
+		return x.Exchange
 	}
-	return nil
-}
-
-type GetTechnicalAnalysisResponse struct {
-	state         protoimpl.MessageState    `protogen:"open.v1"`
-	Signals       map[string]*ListOfSignals `protobuf:"bytes,1,rep,name=signals,proto3" json:"signals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetTechnicalAnalysisResponse) Reset() {
-	*x = GetTechnicalAnalysisResponse{}
-	mi := &file_rpc_proto_msgTypes[208]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
+	if x != nil {
+		return x.Asset
+	}
+	return ""
 }
 
-func (x *GetTechnicalAnalysisResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (*GetTechnicalAnalysisResponse) ProtoMessage() {}
-
-func (x *GetTechnicalAnalysisResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[208]
+func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ImpactPercentage
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetTechnicalAnalysisResponse.ProtoReflect.Descriptor instead.
-func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{208}
+func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
+	if x != nil {
+		return x.Sell
+	}
+	return false
 }
 
-func (x *GetTechnicalAnalysisResponse) GetSignals() map[string]*ListOfSignals {
+func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
 	if x != nil {
-		return x.Signals
+		return x.RequiresRestOrderbook
 	}
-	return nil
+	return false
 }
 
-type GetMarginRatesHistoryRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Exchange           string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset              string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Currency           string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	StartDate          string                 `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate            string                 `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
-	GetPredictedRate   bool                   `protobuf:"varint,6,opt,name=get_predicted_rate,json=getPredictedRate,proto3" json:"get_predicted_rate,omitempty"`
-	GetLendingPayments bool                   `protobuf:"varint,7,opt,name=get_lending_payments,json=getLendingPayments,proto3" json:"get_lending_payments,omitempty"`
-	GetBorrowRates     bool                   `protobuf:"varint,8,opt,name=get_borrow_rates,json=getBorrowRates,proto3" json:"get_borrow_rates,omitempty"`
-	GetBorrowCosts     bool                   `protobuf:"varint,9,opt,name=get_borrow_costs,json=getBorrowCosts,proto3" json:"get_borrow_costs,omitempty"`
-	IncludeAllRates    bool                   `protobuf:"varint,10,opt,name=include_all_rates,json=includeAllRates,proto3" json:"include_all_rates,omitempty"`
-	CalculateOffline   bool                   `protobuf:"varint,11,opt,name=calculate_offline,json=calculateOffline,proto3" json:"calculate_offline,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,12,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	Rates              []*MarginRate          `protobuf:"bytes,13,rep,name=rates,proto3" json:"rates,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type GetOrderbookAmountByImpactResponse struct {
+	state                               protoimpl.MessageState `protogen:"open.v1"`
+	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
+	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
+	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
+	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
+	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
+	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
+	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
+	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
+	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
+	unknownFields                       protoimpl.UnknownFields
+	sizeCache                           protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryRequest) Reset() {
-	*x = GetMarginRatesHistoryRequest{}
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *GetOrderbookAmountByImpactResponse) Reset() {
+	*x = GetOrderbookAmountByImpactResponse{}
+	mi := &file_rpc_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryRequest) String() string {
+func (x *GetOrderbookAmountByImpactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginRatesHistoryRequest) ProtoMessage() {}
+func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
 
-func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[209]
+func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13711,125 +16513,163 @@ func (x *GetMarginRatesHistoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginRatesHistoryRequest.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{209}
+// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{242}
 }
 
-func (x *GetMarginRatesHistoryRequest) GetExchange() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
 	if x != nil {
-		return x.Exchange
+		return x.AmountRequired
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetAsset() string {
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
 	if x != nil {
-		return x.Asset
+		return x.CurrencySelling
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCurrency() string {
+func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
 	if x != nil {
-		return x.Currency
+		return x.AmountReceived
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetStartDate() string {
+func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
 	if x != nil {
-		return x.StartDate
+		return x.CurrencyBuying
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetEndDate() string {
+func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
 	if x != nil {
-		return x.EndDate
+		return x.StartPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetPredictedRate() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
 	if x != nil {
-		return x.GetPredictedRate
+		return x.EndPrice
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetLendingPayments() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
 	if x != nil {
-		return x.GetLendingPayments
+		return x.AverageOrderCost
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowRates() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
 	if x != nil {
-		return x.GetBorrowRates
+		return x.SideAffected
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetGetBorrowCosts() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
 	if x != nil {
-		return x.GetBorrowCosts
+		return x.ApproximateImpactSlippagePercentage
 	}
-	return false
+	return 0
 }
 
-func (x *GetMarginRatesHistoryRequest) GetIncludeAllRates() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
 	if x != nil {
-		return x.IncludeAllRates
+		return x.UpdateProtocol
 	}
-	return false
+	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetCalculateOffline() bool {
+func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
 	if x != nil {
-		return x.CalculateOffline
+		return x.FullOrderbookSideConsumed
 	}
 	return false
 }
 
-func (x *GetMarginRatesHistoryRequest) GetTakerFeeRate() string {
+type GetOpenInterestRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpenInterestRequest) Reset() {
+	*x = GetOpenInterestRequest{}
+	mi := &file_rpc_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenInterestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestRequest) ProtoMessage() {}
+
+func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[243]
 	if x != nil {
-		return x.TakerFeeRate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *GetOpenInterestRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryRequest) GetRates() []*MarginRate {
+func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
 	if x != nil {
-		return x.Rates
+		return x.Data
 	}
 	return nil
 }
 
-type LendingPayment struct {
+type OpenInterestDataRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Payment       string                 `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LendingPayment) Reset() {
-	*x = LendingPayment{}
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *OpenInterestDataRequest) Reset() {
+	*x = OpenInterestDataRequest{}
+	mi := &file_rpc_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LendingPayment) String() string {
+func (x *OpenInterestDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LendingPayment) ProtoMessage() {}
+func (*OpenInterestDataRequest) ProtoMessage() {}
 
-func (x *LendingPayment) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[210]
+func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13840,48 +16680,94 @@ func (x *LendingPayment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LendingPayment.ProtoReflect.Descriptor instead.
-func (*LendingPayment) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{210}
+// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{244}
 }
 
-func (x *LendingPayment) GetPayment() string {
+func (x *OpenInterestDataRequest) GetAsset() string {
 	if x != nil {
-		return x.Payment
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *LendingPayment) GetSize() string {
+func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+type GetOpenInterestResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOpenInterestResponse) Reset() {
+	*x = GetOpenInterestResponse{}
+	mi := &file_rpc_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOpenInterestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOpenInterestResponse) ProtoMessage() {}
+
+func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
+func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
 	if x != nil {
-		return x.Size
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type BorrowCost struct {
+type OpenInterestDataResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Cost          string                 `protobuf:"bytes,1,opt,name=cost,proto3" json:"cost,omitempty"`
-	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BorrowCost) Reset() {
-	*x = BorrowCost{}
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *OpenInterestDataResponse) Reset() {
+	*x = OpenInterestDataResponse{}
+	mi := &file_rpc_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BorrowCost) String() string {
+func (x *OpenInterestDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BorrowCost) ProtoMessage() {}
+func (*OpenInterestDataResponse) ProtoMessage() {}
 
-func (x *BorrowCost) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[211]
+func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13892,54 +16778,63 @@ func (x *BorrowCost) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BorrowCost.ProtoReflect.Descriptor instead.
-func (*BorrowCost) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{211}
+// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
+func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{246}
 }
 
-func (x *BorrowCost) GetCost() string {
+func (x *OpenInterestDataResponse) GetExchange() string {
 	if x != nil {
-		return x.Cost
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *BorrowCost) GetSize() string {
+func (x *OpenInterestDataResponse) GetAsset() string {
 	if x != nil {
-		return x.Size
+		return x.Asset
 	}
 	return ""
 }
 
-type MarginRate struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Time             string                 `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	MarketBorrowSize string                 `protobuf:"bytes,2,opt,name=market_borrow_size,json=marketBorrowSize,proto3" json:"market_borrow_size,omitempty"`
-	HourlyRate       string                 `protobuf:"bytes,3,opt,name=hourly_rate,json=hourlyRate,proto3" json:"hourly_rate,omitempty"`
-	YearlyRate       string                 `protobuf:"bytes,4,opt,name=yearly_rate,json=yearlyRate,proto3" json:"yearly_rate,omitempty"`
-	HourlyBorrowRate string                 `protobuf:"bytes,5,opt,name=hourly_borrow_rate,json=hourlyBorrowRate,proto3" json:"hourly_borrow_rate,omitempty"`
-	YearlyBorrowRate string                 `protobuf:"bytes,6,opt,name=yearly_borrow_rate,json=yearlyBorrowRate,proto3" json:"yearly_borrow_rate,omitempty"`
-	LendingPayment   *LendingPayment        `protobuf:"bytes,7,opt,name=lending_payment,json=lendingPayment,proto3" json:"lending_payment,omitempty"`
-	BorrowCost       *BorrowCost            `protobuf:"bytes,8,opt,name=borrow_cost,json=borrowCost,proto3" json:"borrow_cost,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
 }
 
-func (x *MarginRate) Reset() {
-	*x = MarginRate{}
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+	if x != nil {
+		return x.OpenInterest
+	}
+	return 0
+}
+
+type GetCurrencyTradeURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCurrencyTradeURLRequest) Reset() {
+	*x = GetCurrencyTradeURLRequest{}
+	mi := &file_rpc_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarginRate) String() string {
+func (x *GetCurrencyTradeURLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarginRate) ProtoMessage() {}
+func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
 
-func (x *MarginRate) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[212]
+func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13950,97 +16845,115 @@ func (x *MarginRate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarginRate.ProtoReflect.Descriptor instead.
-func (*MarginRate) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{212}
+// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{247}
 }
 
-func (x *MarginRate) GetTime() string {
+func (x *GetCurrencyTradeURLRequest) GetExchange() string {
 	if x != nil {
-		return x.Time
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *MarginRate) GetMarketBorrowSize() string {
+func (x *GetCurrencyTradeURLRequest) GetAsset() string {
 	if x != nil {
-		return x.MarketBorrowSize
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *MarginRate) GetHourlyRate() string {
+func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.HourlyRate
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *MarginRate) GetYearlyRate() string {
-	if x != nil {
-		return x.YearlyRate
-	}
-	return ""
+type GetCurrencyTradeURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MarginRate) GetHourlyBorrowRate() string {
-	if x != nil {
-		return x.HourlyBorrowRate
-	}
-	return ""
+func (x *GetCurrencyTradeURLResponse) Reset() {
+	*x = GetCurrencyTradeURLResponse{}
+	mi := &file_rpc_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MarginRate) GetYearlyBorrowRate() string {
-	if x != nil {
-		return x.YearlyBorrowRate
-	}
-	return ""
+func (x *GetCurrencyTradeURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MarginRate) GetLendingPayment() *LendingPayment {
+func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+
+func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[248]
 	if x != nil {
-		return x.LendingPayment
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MarginRate) GetBorrowCost() *BorrowCost {
+// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
+func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *GetCurrencyTradeURLResponse) GetUrl() string {
 	if x != nil {
-		return x.BorrowCost
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-type GetMarginRatesHistoryResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Rates              []*MarginRate          `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
-	TotalRates         int64                  `protobuf:"varint,2,opt,name=total_rates,json=totalRates,proto3" json:"total_rates,omitempty"`
-	SumBorrowCosts     string                 `protobuf:"bytes,3,opt,name=sum_borrow_costs,json=sumBorrowCosts,proto3" json:"sum_borrow_costs,omitempty"`
-	AvgBorrowSize      string                 `protobuf:"bytes,4,opt,name=avg_borrow_size,json=avgBorrowSize,proto3" json:"avg_borrow_size,omitempty"`
-	SumLendingPayments string                 `protobuf:"bytes,5,opt,name=sum_lending_payments,json=sumLendingPayments,proto3" json:"sum_lending_payments,omitempty"`
-	AvgLendingSize     string                 `protobuf:"bytes,6,opt,name=avg_lending_size,json=avgLendingSize,proto3" json:"avg_lending_size,omitempty"`
-	LatestRate         *MarginRate            `protobuf:"bytes,7,opt,name=latest_rate,json=latestRate,proto3" json:"latest_rate,omitempty"`
-	PredictedRate      *MarginRate            `protobuf:"bytes,8,opt,name=predicted_rate,json=predictedRate,proto3" json:"predicted_rate,omitempty"`
-	TakerFeeRate       string                 `protobuf:"bytes,9,opt,name=taker_fee_rate,json=takerFeeRate,proto3" json:"taker_fee_rate,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type ConditionalOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	LinkedId      string                 `protobuf:"bytes,2,opt,name=linked_id,json=linkedId,proto3" json:"linked_id,omitempty"`
+	Exchange      string                 `protobuf:"bytes,3,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,4,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset,omitempty"`
+	Side          string                 `protobuf:"bytes,6,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType     string                 `protobuf:"bytes,7,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	TriggerPrice  float64                `protobuf:"fixed64,9,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	LimitPrice    float64                `protobuf:"fixed64,10,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	TrackingMode  string                 `protobuf:"bytes,11,opt,name=tracking_mode,json=trackingMode,proto3" json:"tracking_mode,omitempty"`
+	TrackingValue float64                `protobuf:"fixed64,12,opt,name=tracking_value,json=trackingValue,proto3" json:"tracking_value,omitempty"`
+	Status        string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	OrderId       string                 `protobuf:"bytes,14,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Error         string                 `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	TriggeredAt   *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMarginRatesHistoryResponse) Reset() {
-	*x = GetMarginRatesHistoryResponse{}
-	mi := &file_rpc_proto_msgTypes[213]
+func (x *ConditionalOrder) Reset() {
+	*x = ConditionalOrder{}
+	mi := &file_rpc_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMarginRatesHistoryResponse) String() string {
+func (x *ConditionalOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMarginRatesHistoryResponse) ProtoMessage() {}
+func (*ConditionalOrder) ProtoMessage() {}
 
-func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[213]
+func (x *ConditionalOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14051,201 +16964,173 @@ func (x *GetMarginRatesHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMarginRatesHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetMarginRatesHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{213}
+// Deprecated: Use ConditionalOrder.ProtoReflect.Descriptor instead.
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{249}
 }
 
-func (x *GetMarginRatesHistoryResponse) GetRates() []*MarginRate {
+func (x *ConditionalOrder) GetId() string {
 	if x != nil {
-		return x.Rates
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTotalRates() int64 {
+func (x *ConditionalOrder) GetLinkedId() string {
 	if x != nil {
-		return x.TotalRates
+		return x.LinkedId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumBorrowCosts() string {
+func (x *ConditionalOrder) GetExchange() string {
 	if x != nil {
-		return x.SumBorrowCosts
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgBorrowSize() string {
+func (x *ConditionalOrder) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.AvgBorrowSize
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *GetMarginRatesHistoryResponse) GetSumLendingPayments() string {
+func (x *ConditionalOrder) GetAsset() string {
 	if x != nil {
-		return x.SumLendingPayments
+		return x.Asset
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetAvgLendingSize() string {
+func (x *ConditionalOrder) GetSide() string {
 	if x != nil {
-		return x.AvgLendingSize
+		return x.Side
 	}
 	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetLatestRate() *MarginRate {
+func (x *ConditionalOrder) GetOrderType() string {
 	if x != nil {
-		return x.LatestRate
+		return x.OrderType
 	}
-	return nil
+	return ""
 }
 
-func (x *GetMarginRatesHistoryResponse) GetPredictedRate() *MarginRate {
+func (x *ConditionalOrder) GetAmount() float64 {
 	if x != nil {
-		return x.PredictedRate
+		return x.Amount
 	}
-	return nil
+	return 0
 }
 
-func (x *GetMarginRatesHistoryResponse) GetTakerFeeRate() string {
+func (x *ConditionalOrder) GetTriggerPrice() float64 {
 	if x != nil {
-		return x.TakerFeeRate
+		return x.TriggerPrice
 	}
-	return ""
-}
-
-type GetOrderbookMovementRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	Amount                float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	Purchase              bool                   `protobuf:"varint,9,opt,name=purchase,proto3" json:"purchase,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
-}
-
-func (x *GetOrderbookMovementRequest) Reset() {
-	*x = GetOrderbookMovementRequest{}
-	mi := &file_rpc_proto_msgTypes[214]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetOrderbookMovementRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*GetOrderbookMovementRequest) ProtoMessage() {}
-
-func (x *GetOrderbookMovementRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[214]
+func (x *ConditionalOrder) GetLimitPrice() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetOrderbookMovementRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{214}
+		return x.LimitPrice
+	}
+	return 0
 }
 
-func (x *GetOrderbookMovementRequest) GetExchange() string {
+func (x *ConditionalOrder) GetTrackingMode() string {
 	if x != nil {
-		return x.Exchange
+		return x.TrackingMode
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetAsset() string {
+func (x *ConditionalOrder) GetTrackingValue() float64 {
 	if x != nil {
-		return x.Asset
+		return x.TrackingValue
+	}
+	return 0
+}
+
+func (x *ConditionalOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetPair() *CurrencyPair {
+func (x *ConditionalOrder) GetOrderId() string {
 	if x != nil {
-		return x.Pair
+		return x.OrderId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetAmount() float64 {
+func (x *ConditionalOrder) GetError() string {
 	if x != nil {
-		return x.Amount
+		return x.Error
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementRequest) GetSell() bool {
+func (x *ConditionalOrder) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Sell
+		return x.CreatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *GetOrderbookMovementRequest) GetRequiresRestOrderbook() bool {
+func (x *ConditionalOrder) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-func (x *GetOrderbookMovementRequest) GetPurchase() bool {
+func (x *ConditionalOrder) GetTriggeredAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Purchase
+		return x.TriggeredAt
 	}
-	return false
+	return nil
 }
 
-type GetOrderbookMovementResponse struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	NominalPercentage         float64                `protobuf:"fixed64,1,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	ImpactPercentage          float64                `protobuf:"fixed64,2,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	SlippageCost              float64                `protobuf:"fixed64,3,opt,name=slippage_cost,json=slippageCost,proto3" json:"slippage_cost,omitempty"`
-	CurrencyBought            string                 `protobuf:"bytes,4,opt,name=currency_bought,json=currencyBought,proto3" json:"currency_bought,omitempty"`
-	Bought                    float64                `protobuf:"fixed64,5,opt,name=bought,proto3" json:"bought,omitempty"`
-	CurrencySold              string                 `protobuf:"bytes,6,opt,name=currency_sold,json=currencySold,proto3" json:"currency_sold,omitempty"`
-	Sold                      float64                `protobuf:"fixed64,7,opt,name=sold,proto3" json:"sold,omitempty"`
-	SideAffected              string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	UpdateProtocol            string                 `protobuf:"bytes,9,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed bool                   `protobuf:"varint,10,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	StartPrice                float64                `protobuf:"fixed64,11,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                  float64                `protobuf:"fixed64,12,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	NoSlippageOccurred        bool                   `protobuf:"varint,13,opt,name=no_slippage_occurred,json=noSlippageOccurred,proto3" json:"no_slippage_occurred,omitempty"`
-	AverageOrderCost          float64                `protobuf:"fixed64,14,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type SubmitConditionalOrderRequest struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Exchange             string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset                string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Side                 string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	OrderType            string                 `protobuf:"bytes,5,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount               float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	TriggerPrice         float64                `protobuf:"fixed64,7,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	LimitPrice           float64                `protobuf:"fixed64,8,opt,name=limit_price,json=limitPrice,proto3" json:"limit_price,omitempty"`
+	TrackingMode         string                 `protobuf:"bytes,9,opt,name=tracking_mode,json=trackingMode,proto3" json:"tracking_mode,omitempty"`
+	TrackingValue        float64                `protobuf:"fixed64,10,opt,name=tracking_value,json=trackingValue,proto3" json:"tracking_value,omitempty"`
+	TakeProfitPrice      float64                `protobuf:"fixed64,11,opt,name=take_profit_price,json=takeProfitPrice,proto3" json:"take_profit_price,omitempty"`
+	TakeProfitLimitPrice float64                `protobuf:"fixed64,12,opt,name=take_profit_limit_price,json=takeProfitLimitPrice,proto3" json:"take_profit_limit_price,omitempty"`
+	StopLossPrice        float64                `protobuf:"fixed64,13,opt,name=stop_loss_price,json=stopLossPrice,proto3" json:"stop_loss_price,omitempty"`
+	StopLossLimitPrice   float64                `protobuf:"fixed64,14,opt,name=stop_loss_limit_price,json=stopLossLimitPrice,proto3" json:"stop_loss_limit_price,omitempty"`
+	ClientId             string                 `protobuf:"bytes,15,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *GetOrderbookMovementResponse) Reset() {
-	*x = GetOrderbookMovementResponse{}
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *SubmitConditionalOrderRequest) Reset() {
+	*x = SubmitConditionalOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookMovementResponse) String() string {
+func (x *SubmitConditionalOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookMovementResponse) ProtoMessage() {}
+func (*SubmitConditionalOrderRequest) ProtoMessage() {}
 
-func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[215]
+func (x *SubmitConditionalOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14256,136 +17141,138 @@ func (x *GetOrderbookMovementResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookMovementResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookMovementResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{215}
+// Deprecated: Use SubmitConditionalOrderRequest.ProtoReflect.Descriptor instead.
+func (*SubmitConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{250}
 }
 
-func (x *GetOrderbookMovementResponse) GetNominalPercentage() float64 {
+func (x *SubmitConditionalOrderRequest) GetExchange() string {
 	if x != nil {
-		return x.NominalPercentage
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetImpactPercentage() float64 {
+func (x *SubmitConditionalOrderRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.ImpactPercentage
+		return x.Pair
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookMovementResponse) GetSlippageCost() float64 {
+func (x *SubmitConditionalOrderRequest) GetAsset() string {
 	if x != nil {
-		return x.SlippageCost
+		return x.Asset
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencyBought() string {
+func (x *SubmitConditionalOrderRequest) GetSide() string {
 	if x != nil {
-		return x.CurrencyBought
+		return x.Side
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetBought() float64 {
+func (x *SubmitConditionalOrderRequest) GetOrderType() string {
 	if x != nil {
-		return x.Bought
+		return x.OrderType
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetCurrencySold() string {
+func (x *SubmitConditionalOrderRequest) GetAmount() float64 {
 	if x != nil {
-		return x.CurrencySold
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetSold() float64 {
+func (x *SubmitConditionalOrderRequest) GetTriggerPrice() float64 {
 	if x != nil {
-		return x.Sold
+		return x.TriggerPrice
 	}
 	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetSideAffected() string {
+func (x *SubmitConditionalOrderRequest) GetLimitPrice() float64 {
 	if x != nil {
-		return x.SideAffected
+		return x.LimitPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetUpdateProtocol() string {
+func (x *SubmitConditionalOrderRequest) GetTrackingMode() string {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.TrackingMode
 	}
 	return ""
 }
 
-func (x *GetOrderbookMovementResponse) GetFullOrderbookSideConsumed() bool {
+func (x *SubmitConditionalOrderRequest) GetTrackingValue() float64 {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.TrackingValue
 	}
-	return false
+	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetStartPrice() float64 {
+func (x *SubmitConditionalOrderRequest) GetTakeProfitPrice() float64 {
 	if x != nil {
-		return x.StartPrice
+		return x.TakeProfitPrice
 	}
 	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetEndPrice() float64 {
+func (x *SubmitConditionalOrderRequest) GetTakeProfitLimitPrice() float64 {
 	if x != nil {
-		return x.EndPrice
+		return x.TakeProfitLimitPrice
 	}
 	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetNoSlippageOccurred() bool {
+func (x *SubmitConditionalOrderRequest) GetStopLossPrice() float64 {
 	if x != nil {
-		return x.NoSlippageOccurred
+		return x.StopLossPrice
 	}
-	return false
+	return 0
 }
 
-func (x *GetOrderbookMovementResponse) GetAverageOrderCost() float64 {
+func (x *SubmitConditionalOrderRequest) GetStopLossLimitPrice() float64 {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.StopLossLimitPrice
 	}
 	return 0
 }
 
-type GetOrderbookAmountByNominalRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	NominalPercentage     float64                `protobuf:"fixed64,6,opt,name=nominal_percentage,json=nominalPercentage,proto3" json:"nominal_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *SubmitConditionalOrderRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) Reset() {
-	*x = GetOrderbookAmountByNominalRequest{}
-	mi := &file_rpc_proto_msgTypes[216]
+type ConditionalOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*ConditionalOrder    `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConditionalOrdersResponse) Reset() {
+	*x = ConditionalOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[251]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalRequest) String() string {
+func (x *ConditionalOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalRequest) ProtoMessage() {}
+func (*ConditionalOrdersResponse) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[216]
+func (x *ConditionalOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[251]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14396,85 +17283,183 @@ func (x *GetOrderbookAmountByNominalRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{216}
+// Deprecated: Use ConditionalOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ConditionalOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{251}
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetExchange() string {
+func (x *ConditionalOrdersResponse) GetOrders() []*ConditionalOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type GetConditionalOrdersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetConditionalOrdersRequest) Reset() {
+	*x = GetConditionalOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConditionalOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConditionalOrdersRequest) ProtoMessage() {}
+
+func (x *GetConditionalOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConditionalOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetConditionalOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *GetConditionalOrdersRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetAsset() string {
+func (x *GetConditionalOrdersRequest) GetIncludeInactive() bool {
 	if x != nil {
-		return x.Asset
+		return x.IncludeInactive
 	}
-	return ""
+	return false
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetPair() *CurrencyPair {
+type CancelConditionalOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelConditionalOrderRequest) Reset() {
+	*x = CancelConditionalOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelConditionalOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelConditionalOrderRequest) ProtoMessage() {}
+
+func (x *CancelConditionalOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[253]
 	if x != nil {
-		return x.Pair
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetNominalPercentage() float64 {
+// Deprecated: Use CancelConditionalOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *CancelConditionalOrderRequest) GetId() string {
 	if x != nil {
-		return x.NominalPercentage
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetSell() bool {
+type SetRiskKillSwitchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Engaged       bool                   `protobuf:"varint,1,opt,name=engaged,proto3" json:"engaged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRiskKillSwitchRequest) Reset() {
+	*x = SetRiskKillSwitchRequest{}
+	mi := &file_rpc_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRiskKillSwitchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRiskKillSwitchRequest) ProtoMessage() {}
+
+func (x *SetRiskKillSwitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[254]
 	if x != nil {
-		return x.Sell
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByNominalRequest) GetRequiresRestOrderbook() bool {
+// Deprecated: Use SetRiskKillSwitchRequest.ProtoReflect.Descriptor instead.
+func (*SetRiskKillSwitchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *SetRiskKillSwitchRequest) GetEngaged() bool {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.Engaged
 	}
 	return false
 }
-
-type GetOrderbookAmountByNominalResponse struct {
-	state                                protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                       float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                      string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                       float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                       string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                           float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                             float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                     float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                         string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateNominalSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_nominal_slippage_percentage,json=approximateNominalSlippagePercentage,proto3" json:"approximate_nominal_slippage_percentage,omitempty"`
-	UpdateProtocol                       string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed            bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                        protoimpl.UnknownFields
-	sizeCache                            protoimpl.SizeCache
+
+type GetRecordedOrderbookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Timestamp     string                 `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalResponse) Reset() {
-	*x = GetOrderbookAmountByNominalResponse{}
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *GetRecordedOrderbookRequest) Reset() {
+	*x = GetRecordedOrderbookRequest{}
+	mi := &file_rpc_proto_msgTypes[255]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) String() string {
+func (x *GetRecordedOrderbookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByNominalResponse) ProtoMessage() {}
+func (*GetRecordedOrderbookRequest) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[217]
+func (x *GetRecordedOrderbookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[255]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14485,115 +17470,157 @@ func (x *GetOrderbookAmountByNominalResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByNominalResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByNominalResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{217}
+// Deprecated: Use GetRecordedOrderbookRequest.ProtoReflect.Descriptor instead.
+func (*GetRecordedOrderbookRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{255}
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAmountRequired() float64 {
+func (x *GetRecordedOrderbookRequest) GetExchange() string {
 	if x != nil {
-		return x.AmountRequired
+		return x.Exchange
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencySelling() string {
+func (x *GetRecordedOrderbookRequest) GetPair() *CurrencyPair {
 	if x != nil {
-		return x.CurrencySelling
+		return x.Pair
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAmountReceived() float64 {
+func (x *GetRecordedOrderbookRequest) GetAssetType() string {
 	if x != nil {
-		return x.AmountReceived
+		return x.AssetType
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetCurrencyBuying() string {
+func (x *GetRecordedOrderbookRequest) GetTimestamp() string {
 	if x != nil {
-		return x.CurrencyBuying
+		return x.Timestamp
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetStartPrice() float64 {
-	if x != nil {
-		return x.StartPrice
-	}
-	return 0
+type AlgoChildOrder struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Price          float64                `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	ExecutedAmount float64                `protobuf:"fixed64,5,opt,name=executed_amount,json=executedAmount,proto3" json:"executed_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetEndPrice() float64 {
+func (x *AlgoChildOrder) Reset() {
+	*x = AlgoChildOrder{}
+	mi := &file_rpc_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AlgoChildOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlgoChildOrder) ProtoMessage() {}
+
+func (x *AlgoChildOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[256]
 	if x != nil {
-		return x.EndPrice
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetAverageOrderCost() float64 {
+// Deprecated: Use AlgoChildOrder.ProtoReflect.Descriptor instead.
+func (*AlgoChildOrder) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{256}
+}
+
+func (x *AlgoChildOrder) GetOrderId() string {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetSideAffected() string {
+func (x *AlgoChildOrder) GetStatus() string {
 	if x != nil {
-		return x.SideAffected
+		return x.Status
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetApproximateNominalSlippagePercentage() float64 {
+func (x *AlgoChildOrder) GetPrice() float64 {
 	if x != nil {
-		return x.ApproximateNominalSlippagePercentage
+		return x.Price
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetUpdateProtocol() string {
+func (x *AlgoChildOrder) GetAmount() float64 {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookAmountByNominalResponse) GetFullOrderbookSideConsumed() bool {
+func (x *AlgoChildOrder) GetExecutedAmount() float64 {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.ExecutedAmount
 	}
-	return false
+	return 0
 }
 
-type GetOrderbookAmountByImpactRequest struct {
-	state                 protoimpl.MessageState `protogen:"open.v1"`
-	Exchange              string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset                 string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair                  *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	ImpactPercentage      float64                `protobuf:"fixed64,6,opt,name=impact_percentage,json=impactPercentage,proto3" json:"impact_percentage,omitempty"`
-	Sell                  bool                   `protobuf:"varint,7,opt,name=sell,proto3" json:"sell,omitempty"`
-	RequiresRestOrderbook bool                   `protobuf:"varint,8,opt,name=requires_rest_orderbook,json=requiresRestOrderbook,proto3" json:"requires_rest_orderbook,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+type AlgoOrder struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange        string                 `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair            *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset           string                 `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset,omitempty"`
+	Side            string                 `protobuf:"bytes,5,opt,name=side,proto3" json:"side,omitempty"`
+	AlgoType        string                 `protobuf:"bytes,6,opt,name=algo_type,json=algoType,proto3" json:"algo_type,omitempty"`
+	OrderType       string                 `protobuf:"bytes,7,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Price           float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Amount          float64                `protobuf:"fixed64,9,opt,name=amount,proto3" json:"amount,omitempty"`
+	SubmittedAmount float64                `protobuf:"fixed64,10,opt,name=submitted_amount,json=submittedAmount,proto3" json:"submitted_amount,omitempty"`
+	FilledAmount    float64                `protobuf:"fixed64,11,opt,name=filled_amount,json=filledAmount,proto3" json:"filled_amount,omitempty"`
+	Progress        float64                `protobuf:"fixed64,12,opt,name=progress,proto3" json:"progress,omitempty"`
+	Status          string                 `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	Error           string                 `protobuf:"bytes,14,opt,name=error,proto3" json:"error,omitempty"`
+	Duration        int64                  `protobuf:"varint,15,opt,name=duration,proto3" json:"duration,omitempty"`
+	Slices          int64                  `protobuf:"varint,16,opt,name=slices,proto3" json:"slices,omitempty"`
+	Interval        int64                  `protobuf:"varint,17,opt,name=interval,proto3" json:"interval,omitempty"`
+	VisibleAmount   float64                `protobuf:"fixed64,18,opt,name=visible_amount,json=visibleAmount,proto3" json:"visible_amount,omitempty"`
+	ChildOrders     []*AlgoChildOrder      `protobuf:"bytes,19,rep,name=child_orders,json=childOrders,proto3" json:"child_orders,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetOrderbookAmountByImpactRequest) Reset() {
-	*x = GetOrderbookAmountByImpactRequest{}
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *AlgoOrder) Reset() {
+	*x = AlgoOrder{}
+	mi := &file_rpc_proto_msgTypes[257]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrderbookAmountByImpactRequest) String() string {
+func (x *AlgoOrder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrderbookAmountByImpactRequest) ProtoMessage() {}
+func (*AlgoOrder) ProtoMessage() {}
 
-func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[218]
+func (x *AlgoOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[257]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14604,303 +17631,320 @@ func (x *GetOrderbookAmountByImpactRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactRequest.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{218}
+// Deprecated: Use AlgoOrder.ProtoReflect.Descriptor instead.
+func (*AlgoOrder) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{257}
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetExchange() string {
+func (x *AlgoOrder) GetId() string {
 	if x != nil {
-		return x.Exchange
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetAsset() string {
+func (x *AlgoOrder) GetExchange() string {
 	if x != nil {
-		return x.Asset
+		return x.Exchange
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetPair() *CurrencyPair {
+func (x *AlgoOrder) GetPair() *CurrencyPair {
 	if x != nil {
 		return x.Pair
 	}
 	return nil
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetImpactPercentage() float64 {
+func (x *AlgoOrder) GetAsset() string {
 	if x != nil {
-		return x.ImpactPercentage
+		return x.Asset
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetSell() bool {
+func (x *AlgoOrder) GetSide() string {
 	if x != nil {
-		return x.Sell
+		return x.Side
 	}
-	return false
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactRequest) GetRequiresRestOrderbook() bool {
+func (x *AlgoOrder) GetAlgoType() string {
 	if x != nil {
-		return x.RequiresRestOrderbook
+		return x.AlgoType
 	}
-	return false
-}
-
-type GetOrderbookAmountByImpactResponse struct {
-	state                               protoimpl.MessageState `protogen:"open.v1"`
-	AmountRequired                      float64                `protobuf:"fixed64,1,opt,name=amount_required,json=amountRequired,proto3" json:"amount_required,omitempty"`
-	CurrencySelling                     string                 `protobuf:"bytes,2,opt,name=currency_selling,json=currencySelling,proto3" json:"currency_selling,omitempty"`
-	AmountReceived                      float64                `protobuf:"fixed64,3,opt,name=amount_received,json=amountReceived,proto3" json:"amount_received,omitempty"`
-	CurrencyBuying                      string                 `protobuf:"bytes,4,opt,name=currency_buying,json=currencyBuying,proto3" json:"currency_buying,omitempty"`
-	StartPrice                          float64                `protobuf:"fixed64,5,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
-	EndPrice                            float64                `protobuf:"fixed64,6,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
-	AverageOrderCost                    float64                `protobuf:"fixed64,7,opt,name=average_order_cost,json=averageOrderCost,proto3" json:"average_order_cost,omitempty"`
-	SideAffected                        string                 `protobuf:"bytes,8,opt,name=side_affected,json=sideAffected,proto3" json:"side_affected,omitempty"`
-	ApproximateImpactSlippagePercentage float64                `protobuf:"fixed64,9,opt,name=approximate_impact_slippage_percentage,json=approximateImpactSlippagePercentage,proto3" json:"approximate_impact_slippage_percentage,omitempty"`
-	UpdateProtocol                      string                 `protobuf:"bytes,10,opt,name=update_protocol,json=updateProtocol,proto3" json:"update_protocol,omitempty"`
-	FullOrderbookSideConsumed           bool                   `protobuf:"varint,11,opt,name=full_orderbook_side_consumed,json=fullOrderbookSideConsumed,proto3" json:"full_orderbook_side_consumed,omitempty"`
-	unknownFields                       protoimpl.UnknownFields
-	sizeCache                           protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) Reset() {
-	*x = GetOrderbookAmountByImpactResponse{}
-	mi := &file_rpc_proto_msgTypes[219]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AlgoOrder) GetOrderType() string {
+	if x != nil {
+		return x.OrderType
+	}
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AlgoOrder) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (*GetOrderbookAmountByImpactResponse) ProtoMessage() {}
-
-func (x *GetOrderbookAmountByImpactResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[219]
+func (x *AlgoOrder) GetAmount() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Amount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetOrderbookAmountByImpactResponse.ProtoReflect.Descriptor instead.
-func (*GetOrderbookAmountByImpactResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{219}
+func (x *AlgoOrder) GetSubmittedAmount() float64 {
+	if x != nil {
+		return x.SubmittedAmount
+	}
+	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountRequired() float64 {
+func (x *AlgoOrder) GetFilledAmount() float64 {
 	if x != nil {
-		return x.AmountRequired
+		return x.FilledAmount
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencySelling() string {
+func (x *AlgoOrder) GetProgress() float64 {
 	if x != nil {
-		return x.CurrencySelling
+		return x.Progress
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAmountReceived() float64 {
+func (x *AlgoOrder) GetStatus() string {
 	if x != nil {
-		return x.AmountReceived
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetCurrencyBuying() string {
+func (x *AlgoOrder) GetError() string {
 	if x != nil {
-		return x.CurrencyBuying
+		return x.Error
 	}
 	return ""
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetStartPrice() float64 {
+func (x *AlgoOrder) GetDuration() int64 {
 	if x != nil {
-		return x.StartPrice
+		return x.Duration
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetEndPrice() float64 {
+func (x *AlgoOrder) GetSlices() int64 {
 	if x != nil {
-		return x.EndPrice
+		return x.Slices
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetAverageOrderCost() float64 {
+func (x *AlgoOrder) GetInterval() int64 {
 	if x != nil {
-		return x.AverageOrderCost
+		return x.Interval
 	}
 	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetSideAffected() string {
+func (x *AlgoOrder) GetVisibleAmount() float64 {
 	if x != nil {
-		return x.SideAffected
+		return x.VisibleAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetApproximateImpactSlippagePercentage() float64 {
+func (x *AlgoOrder) GetChildOrders() []*AlgoChildOrder {
 	if x != nil {
-		return x.ApproximateImpactSlippagePercentage
+		return x.ChildOrders
 	}
-	return 0
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetUpdateProtocol() string {
+func (x *AlgoOrder) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdateProtocol
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrderbookAmountByImpactResponse) GetFullOrderbookSideConsumed() bool {
+func (x *AlgoOrder) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.FullOrderbookSideConsumed
+		return x.UpdatedAt
 	}
-	return false
+	return nil
 }
 
-type GetOpenInterestRequest struct {
-	state         protoimpl.MessageState     `protogen:"open.v1"`
-	Exchange      string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Data          []*OpenInterestDataRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+type SubmitAlgoOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Asset         string                 `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset,omitempty"`
+	Side          string                 `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	AlgoType      string                 `protobuf:"bytes,5,opt,name=algo_type,json=algoType,proto3" json:"algo_type,omitempty"`
+	OrderType     string                 `protobuf:"bytes,6,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	Amount        float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Price         float64                `protobuf:"fixed64,8,opt,name=price,proto3" json:"price,omitempty"`
+	Duration      int64                  `protobuf:"varint,9,opt,name=duration,proto3" json:"duration,omitempty"`
+	Slices        int64                  `protobuf:"varint,10,opt,name=slices,proto3" json:"slices,omitempty"`
+	Interval      int64                  `protobuf:"varint,11,opt,name=interval,proto3" json:"interval,omitempty"`
+	VisibleAmount float64                `protobuf:"fixed64,12,opt,name=visible_amount,json=visibleAmount,proto3" json:"visible_amount,omitempty"`
+	ClientId      string                 `protobuf:"bytes,13,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestRequest) Reset() {
-	*x = GetOpenInterestRequest{}
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *SubmitAlgoOrderRequest) Reset() {
+	*x = SubmitAlgoOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[258]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestRequest) String() string {
+func (x *SubmitAlgoOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestRequest) ProtoMessage() {}
+func (*SubmitAlgoOrderRequest) ProtoMessage() {}
+
+func (x *SubmitAlgoOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitAlgoOrderRequest.ProtoReflect.Descriptor instead.
+func (*SubmitAlgoOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *SubmitAlgoOrderRequest) GetExchange() string {
+	if x != nil {
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *SubmitAlgoOrderRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
 
-func (x *GetOpenInterestRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[220]
+func (x *SubmitAlgoOrderRequest) GetAsset() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Asset
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetOpenInterestRequest.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{220}
+func (x *SubmitAlgoOrderRequest) GetSide() string {
+	if x != nil {
+		return x.Side
+	}
+	return ""
 }
 
-func (x *GetOpenInterestRequest) GetExchange() string {
+func (x *SubmitAlgoOrderRequest) GetAlgoType() string {
 	if x != nil {
-		return x.Exchange
+		return x.AlgoType
 	}
 	return ""
 }
 
-func (x *GetOpenInterestRequest) GetData() []*OpenInterestDataRequest {
+func (x *SubmitAlgoOrderRequest) GetOrderType() string {
 	if x != nil {
-		return x.Data
+		return x.OrderType
 	}
-	return nil
+	return ""
 }
 
-type OpenInterestDataRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Asset         string                 `protobuf:"bytes,1,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SubmitAlgoOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *OpenInterestDataRequest) Reset() {
-	*x = OpenInterestDataRequest{}
-	mi := &file_rpc_proto_msgTypes[221]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SubmitAlgoOrderRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
 }
 
-func (x *OpenInterestDataRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubmitAlgoOrderRequest) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
 }
 
-func (*OpenInterestDataRequest) ProtoMessage() {}
-
-func (x *OpenInterestDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[221]
+func (x *SubmitAlgoOrderRequest) GetSlices() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Slices
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use OpenInterestDataRequest.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{221}
+func (x *SubmitAlgoOrderRequest) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
 }
 
-func (x *OpenInterestDataRequest) GetAsset() string {
+func (x *SubmitAlgoOrderRequest) GetVisibleAmount() float64 {
 	if x != nil {
-		return x.Asset
+		return x.VisibleAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *OpenInterestDataRequest) GetPair() *CurrencyPair {
+func (x *SubmitAlgoOrderRequest) GetClientId() string {
 	if x != nil {
-		return x.Pair
+		return x.ClientId
 	}
-	return nil
+	return ""
 }
 
-type GetOpenInterestResponse struct {
-	state         protoimpl.MessageState      `protogen:"open.v1"`
-	Data          []*OpenInterestDataResponse `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+type AlgoOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*AlgoOrder           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOpenInterestResponse) Reset() {
-	*x = GetOpenInterestResponse{}
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *AlgoOrdersResponse) Reset() {
+	*x = AlgoOrdersResponse{}
+	mi := &file_rpc_proto_msgTypes[259]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOpenInterestResponse) String() string {
+func (x *AlgoOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOpenInterestResponse) ProtoMessage() {}
+func (*AlgoOrdersResponse) ProtoMessage() {}
 
-func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[222]
+func (x *AlgoOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[259]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14911,43 +17955,41 @@ func (x *GetOpenInterestResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOpenInterestResponse.ProtoReflect.Descriptor instead.
-func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{222}
+// Deprecated: Use AlgoOrdersResponse.ProtoReflect.Descriptor instead.
+func (*AlgoOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{259}
 }
 
-func (x *GetOpenInterestResponse) GetData() []*OpenInterestDataResponse {
+func (x *AlgoOrdersResponse) GetOrders() []*AlgoOrder {
 	if x != nil {
-		return x.Data
+		return x.Orders
 	}
 	return nil
 }
 
-type OpenInterestDataResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
-	OpenInterest  float64                `protobuf:"fixed64,4,opt,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetAlgoOrdersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Exchange        string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *OpenInterestDataResponse) Reset() {
-	*x = OpenInterestDataResponse{}
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *GetAlgoOrdersRequest) Reset() {
+	*x = GetAlgoOrdersRequest{}
+	mi := &file_rpc_proto_msgTypes[260]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OpenInterestDataResponse) String() string {
+func (x *GetAlgoOrdersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenInterestDataResponse) ProtoMessage() {}
+func (*GetAlgoOrdersRequest) ProtoMessage() {}
 
-func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[223]
+func (x *GetAlgoOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[260]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14958,63 +18000,47 @@ func (x *OpenInterestDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenInterestDataResponse.ProtoReflect.Descriptor instead.
-func (*OpenInterestDataResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{223}
+// Deprecated: Use GetAlgoOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetAlgoOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{260}
 }
 
-func (x *OpenInterestDataResponse) GetExchange() string {
+func (x *GetAlgoOrdersRequest) GetExchange() string {
 	if x != nil {
 		return x.Exchange
 	}
 	return ""
 }
 
-func (x *OpenInterestDataResponse) GetAsset() string {
-	if x != nil {
-		return x.Asset
-	}
-	return ""
-}
-
-func (x *OpenInterestDataResponse) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-func (x *OpenInterestDataResponse) GetOpenInterest() float64 {
+func (x *GetAlgoOrdersRequest) GetIncludeInactive() bool {
 	if x != nil {
-		return x.OpenInterest
+		return x.IncludeInactive
 	}
-	return 0
+	return false
 }
 
-type GetCurrencyTradeURLRequest struct {
+type CancelAlgoOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
-	Asset         string                 `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
-	Pair          *CurrencyPair          `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLRequest) Reset() {
-	*x = GetCurrencyTradeURLRequest{}
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *CancelAlgoOrderRequest) Reset() {
+	*x = CancelAlgoOrderRequest{}
+	mi := &file_rpc_proto_msgTypes[261]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLRequest) String() string {
+func (x *CancelAlgoOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLRequest) ProtoMessage() {}
+func (*CancelAlgoOrderRequest) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[224]
+func (x *CancelAlgoOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[261]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15025,54 +18051,43 @@ func (x *GetCurrencyTradeURLRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLRequest.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{224}
-}
-
-func (x *GetCurrencyTradeURLRequest) GetExchange() string {
-	if x != nil {
-		return x.Exchange
-	}
-	return ""
+// Deprecated: Use CancelAlgoOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelAlgoOrderRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{261}
 }
 
-func (x *GetCurrencyTradeURLRequest) GetAsset() string {
+func (x *CancelAlgoOrderRequest) GetId() string {
 	if x != nil {
-		return x.Asset
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetCurrencyTradeURLRequest) GetPair() *CurrencyPair {
-	if x != nil {
-		return x.Pair
-	}
-	return nil
-}
-
-type GetCurrencyTradeURLResponse struct {
+type GetCandleStreamRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Exchange      string                 `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair          *CurrencyPair          `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType     string                 `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	TimeInterval  int64                  `protobuf:"varint,4,opt,name=time_interval,json=timeInterval,proto3" json:"time_interval,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCurrencyTradeURLResponse) Reset() {
-	*x = GetCurrencyTradeURLResponse{}
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *GetCandleStreamRequest) Reset() {
+	*x = GetCandleStreamRequest{}
+	mi := &file_rpc_proto_msgTypes[262]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCurrencyTradeURLResponse) String() string {
+func (x *GetCandleStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCurrencyTradeURLResponse) ProtoMessage() {}
+func (*GetCandleStreamRequest) ProtoMessage() {}
 
-func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_proto_msgTypes[225]
+func (x *GetCandleStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_proto_msgTypes[262]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15083,18 +18098,39 @@ func (x *GetCurrencyTradeURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCurrencyTradeURLResponse.ProtoReflect.Descriptor instead.
-func (*GetCurrencyTradeURLResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_proto_rawDescGZIP(), []int{225}
+// Deprecated: Use GetCandleStreamRequest.ProtoReflect.Descriptor instead.
+func (*GetCandleStreamRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_proto_rawDescGZIP(), []int{262}
 }
 
-func (x *GetCurrencyTradeURLResponse) GetUrl() string {
+func (x *GetCandleStreamRequest) GetExchange() string {
 	if x != nil {
-		return x.Url
+		return x.Exchange
+	}
+	return ""
+}
+
+func (x *GetCandleStreamRequest) GetPair() *CurrencyPair {
+	if x != nil {
+		return x.Pair
+	}
+	return nil
+}
+
+func (x *GetCandleStreamRequest) GetAssetType() string {
+	if x != nil {
+		return x.AssetType
 	}
 	return ""
 }
 
+func (x *GetCandleStreamRequest) GetTimeInterval() int64 {
+	if x != nil {
+		return x.TimeInterval
+	}
+	return 0
+}
+
 var File_rpc_proto protoreflect.FileDescriptor
 
 const file_rpc_proto_rawDesc = "" +
@@ -15304,7 +18340,81 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\v2\x14.gctrpc.OfflineCoinsR\x05value:\x028\x01\x1aZ\n" +
 	"\x17CoinsOnlineSummaryEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\xe3\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.gctrpc.OnlineCoinsR\x05value:\x028\x01\"\x1e\n" +
+	"\x1cGetPortfolioValuationRequest\"\x99\x01\n" +
+	"\x19PortfolioValuationHolding\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x01R\abalance\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\"N\n" +
+	"\x1aPortfolioExchangeValuation\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xf9\x01\n" +
+	"\x12PortfolioValuation\x12\x1c\n" +
+	"\ttimestamp\x18\x01 \x01(\tR\ttimestamp\x12#\n" +
+	"\rfiat_currency\x18\x02 \x01(\tR\ffiatCurrency\x12\x1f\n" +
+	"\vtotal_value\x18\x03 \x01(\x01R\n" +
+	"totalValue\x12@\n" +
+	"\texchanges\x18\x04 \x03(\v2\".gctrpc.PortfolioExchangeValuationR\texchanges\x12=\n" +
+	"\bholdings\x18\x05 \x03(\v2!.gctrpc.PortfolioValuationHoldingR\bholdings\"M\n" +
+	"#GetPortfolioValuationHistoryRequest\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x02 \x01(\tR\x03end\"\x90\x01\n" +
+	"\x1cPortfolioCurrencyValueChange\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vstart_value\x18\x02 \x01(\x01R\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x03 \x01(\x01R\bendValue\x12\x16\n" +
+	"\x06change\x18\x04 \x01(\x01R\x06change\"\xe4\x02\n" +
+	"$GetPortfolioValuationHistoryResponse\x12:\n" +
+	"\n" +
+	"valuations\x18\x01 \x03(\v2\x1a.gctrpc.PortfolioValuationR\n" +
+	"valuations\x12\x1f\n" +
+	"\vstart_value\x18\x02 \x01(\x01R\n" +
+	"startValue\x12\x1b\n" +
+	"\tend_value\x18\x03 \x01(\x01R\bendValue\x12\x16\n" +
+	"\x06change\x18\x04 \x01(\x01R\x06change\x12!\n" +
+	"\fmax_drawdown\x18\x05 \x01(\x01R\vmaxDrawdown\x126\n" +
+	"\x17max_drawdown_percentage\x18\x06 \x01(\x01R\x15maxDrawdownPercentage\x12O\n" +
+	"\x10currency_changes\x18\a \x03(\v2$.gctrpc.PortfolioCurrencyValueChangeR\x0fcurrencyChanges\"y\n" +
+	"\x19GetCostBasisReportRequest\x12\x1c\n" +
+	"\texchanges\x18\x01 \x03(\tR\texchanges\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\tR\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\tR\x03end\"\xb9\x02\n" +
+	"\rCostBasisGain\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bacquired\x18\x04 \x01(\tR\bacquired\x12\x1a\n" +
+	"\bdisposed\x18\x05 \x01(\tR\bdisposed\x12\x1a\n" +
+	"\bproceeds\x18\x06 \x01(\x01R\bproceeds\x12\x1d\n" +
+	"\n" +
+	"cost_basis\x18\a \x01(\x01R\tcostBasis\x12\x12\n" +
+	"\x04gain\x18\b \x01(\x01R\x04gain\x12\x15\n" +
+	"\x06lot_id\x18\t \x01(\x03R\x05lotId\x12\x1c\n" +
+	"\tunmatched\x18\n" +
+	" \x01(\bR\tunmatched\x12\x1c\n" +
+	"\treference\x18\v \x01(\tR\treference\"\xe3\x01\n" +
+	"\fCostBasisLot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bacquired\x18\x04 \x01(\tR\bacquired\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\tremaining\x18\x06 \x01(\x01R\tremaining\x12\x1b\n" +
+	"\tunit_cost\x18\a \x01(\x01R\bunitCost\x12\x1c\n" +
+	"\treference\x18\b \x01(\tR\treference\"\xa0\x02\n" +
+	"\x1aGetCostBasisReportResponse\x12\x16\n" +
+	"\x06method\x18\x01 \x01(\tR\x06method\x12#\n" +
+	"\rfiat_currency\x18\x02 \x01(\tR\ffiatCurrency\x12+\n" +
+	"\x05gains\x18\x03 \x03(\v2\x15.gctrpc.CostBasisGainR\x05gains\x12(\n" +
+	"\x04lots\x18\x04 \x03(\v2\x14.gctrpc.CostBasisLotR\x04lots\x12%\n" +
+	"\x0etotal_proceeds\x18\x05 \x01(\x01R\rtotalProceeds\x12(\n" +
+	"\x10total_cost_basis\x18\x06 \x01(\x01R\x0etotalCostBasis\x12\x1d\n" +
+	"\n" +
+	"total_gain\x18\a \x01(\x01R\ttotalGain\"\xe3\x01\n" +
 	"\x1aAddPortfolioAddressRequest\x12\x18\n" +
 	"\aaddress\x18\x01 \x01(\tR\aaddress\x12\x1b\n" +
 	"\tcoin_type\x18\x02 \x01(\tR\bcoinType\x12 \n" +
@@ -15423,7 +18533,40 @@ const file_rpc_proto_rawDesc = "" +
 	"\rminimum_price\x18\x03 \x01(\x01R\fminimumPrice\x12#\n" +
 	"\rmaximum_price\x18\x04 \x01(\x01R\fmaximumPrice\x120\n" +
 	"\x14percentage_gain_loss\x18\x05 \x01(\x01R\x12percentageGainLoss\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\"\xae\x01\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\"\xc5\x01\n" +
+	"\x19SimulateSmartOrderRequest\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x04 \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\"\xde\x01\n" +
+	"\x0fSmartOrderRoute\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12#\n" +
+	"\raverage_price\x18\x03 \x01(\x01R\faveragePrice\x126\n" +
+	"\x17average_effective_price\x18\x04 \x01(\x01R\x15averageEffectivePrice\x12\x1f\n" +
+	"\vworst_price\x18\x05 \x01(\x01R\n" +
+	"worstPrice\x12\x19\n" +
+	"\bfee_rate\x18\x06 \x01(\x01R\afeeRate\"I\n" +
+	"\x13SmartOrderRejection\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x81\x03\n" +
+	"\x1aSimulateSmartOrderResponse\x12(\n" +
+	"\x04pair\x18\x01 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x02 \x01(\tR\tassetType\x12\x12\n" +
+	"\x04side\x18\x03 \x01(\tR\x04side\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12#\n" +
+	"\rrouted_amount\x18\x05 \x01(\x01R\froutedAmount\x12#\n" +
+	"\raverage_price\x18\x06 \x01(\x01R\faveragePrice\x126\n" +
+	"\x17average_effective_price\x18\a \x01(\x01R\x15averageEffectivePrice\x12/\n" +
+	"\x06orders\x18\b \x03(\v2\x17.gctrpc.SmartOrderRouteR\x06orders\x12;\n" +
+	"\n" +
+	"rejections\x18\t \x03(\v2\x1b.gctrpc.SmartOrderRejectionR\n" +
+	"rejections\"\xae\x01\n" +
 	"\x10WhaleBombRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
 	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12!\n" +
@@ -15462,7 +18605,7 @@ const file_rpc_proto_rawDesc = "" +
 	"\x17CancelAllOrdersResponse\x12&\n" +
 	"\x06orders\x18\x01 \x03(\v2\x0e.gctrpc.OrdersR\x06orders\x12\x14\n" +
 	"\x05count\x18\x02 \x01(\x03R\x05count\"\x12\n" +
-	"\x10GetEventsRequest\"\xae\x01\n" +
+	"\x10GetEventsRequest\"\x94\x03\n" +
 	"\x0fConditionParams\x12\x1c\n" +
 	"\tcondition\x18\x01 \x01(\tR\tcondition\x12\x14\n" +
 	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1d\n" +
@@ -15470,15 +18613,50 @@ const file_rpc_proto_rawDesc = "" +
 	"check_bids\x18\x03 \x01(\bR\tcheckBids\x12\x1d\n" +
 	"\n" +
 	"check_asks\x18\x04 \x01(\bR\tcheckAsks\x12)\n" +
-	"\x10orderbook_amount\x18\x05 \x01(\x01R\x0forderbookAmount\"\xf5\x01\n" +
-	"\x11GetEventsResponse\x12\x0e\n" +
+	"\x10orderbook_amount\x18\x05 \x01(\x01R\x0forderbookAmount\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x1a\n" +
+	"\binterval\x18\a \x01(\x03R\binterval\x12\x16\n" +
+	"\x06period\x18\b \x01(\x03R\x06period\x12\x1f\n" +
+	"\vfast_period\x18\t \x01(\x03R\n" +
+	"fastPeriod\x12\x1f\n" +
+	"\vslow_period\x18\n" +
+	" \x01(\x03R\n" +
+	"slowPeriod\x12#\n" +
+	"\rsignal_period\x18\v \x01(\x03R\fsignalPeriod\x12\x17\n" +
+	"\astd_dev\x18\f \x01(\x01R\x06stdDev\x12\x1a\n" +
+	"\bcurrency\x18\r \x01(\tR\bcurrency\"\xa8\x01\n" +
+	"\tEventRule\x12\x12\n" +
+	"\x04item\x18\x01 \x01(\tR\x04item\x12B\n" +
+	"\x10condition_params\x18\x02 \x01(\v2\x17.gctrpc.ConditionParamsR\x0fconditionParams\x12\x1a\n" +
+	"\boperator\x18\x03 \x01(\tR\boperator\x12'\n" +
+	"\x05rules\x18\x04 \x03(\v2\x11.gctrpc.EventRuleR\x05rules\"\xeb\x01\n" +
+	"\vEventAction\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x12\n" +
+	"\x04side\x18\x02 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x03 \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x19\n" +
+	"\border_id\x18\x06 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06script\x18\a \x01(\tR\x06script\x12\x1a\n" +
+	"\brelayers\x18\b \x03(\tR\brelayers\x12\x18\n" +
+	"\amessage\x18\t \x01(\tR\amessage\"\x86\x03\n" +
+	"\x05Event\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
-	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x12\n" +
-	"\x04item\x18\x03 \x01(\tR\x04item\x12B\n" +
-	"\x10condition_params\x18\x04 \x01(\v2\x17.gctrpc.ConditionParamsR\x0fconditionParams\x12(\n" +
-	"\x04pair\x18\x05 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x16\n" +
-	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1a\n" +
-	"\bexecuted\x18\a \x01(\bR\bexecuted\"\xe6\x01\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12%\n" +
+	"\x04rule\x18\x03 \x01(\v2\x11.gctrpc.EventRuleR\x04rule\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x05 \x01(\tR\tassetType\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12-\n" +
+	"\aactions\x18\a \x03(\v2\x13.gctrpc.EventActionR\aactions\x12\x16\n" +
+	"\x06repeat\x18\b \x01(\bR\x06repeat\x12\x1a\n" +
+	"\bcooldown\x18\t \x01(\x03R\bcooldown\x12#\n" +
+	"\rtrigger_count\x18\n" +
+	" \x01(\x03R\ftriggerCount\x12A\n" +
+	"\x0elast_triggered\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\rlastTriggered\":\n" +
+	"\x11GetEventsResponse\x12%\n" +
+	"\x06events\x18\x01 \x03(\v2\r.gctrpc.EventR\x06events\"\xf0\x02\n" +
 	"\x0fAddEventRequest\x12\x1a\n" +
 	"\bexchange\x18\x01 \x01(\tR\bexchange\x12\x12\n" +
 	"\x04item\x18\x02 \x01(\tR\x04item\x12B\n" +
@@ -15486,7 +18664,12 @@ const file_rpc_proto_rawDesc = "" +
 	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
 	"\n" +
 	"asset_type\x18\x05 \x01(\tR\tassetType\x12\x16\n" +
-	"\x06action\x18\x06 \x01(\tR\x06action\"\"\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12%\n" +
+	"\x04rule\x18\a \x01(\v2\x11.gctrpc.EventRuleR\x04rule\x12-\n" +
+	"\aactions\x18\b \x03(\v2\x13.gctrpc.EventActionR\aactions\x12\x16\n" +
+	"\x06repeat\x18\t \x01(\bR\x06repeat\x12\x1a\n" +
+	"\bcooldown\x18\n" +
+	" \x01(\x03R\bcooldown\"\"\n" +
 	"\x10AddEventResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"$\n" +
 	"\x12RemoveEventRequest\x12\x0e\n" +
@@ -15585,7 +18768,32 @@ const file_rpc_proto_rawDesc = "" +
 	"\vaddress_tag\x18\x02 \x01(\tR\n" +
 	"addressTag\x12\x10\n" +
 	"\x03fee\x18\x03 \x01(\x01R\x03fee\x12\x13\n" +
-	"\x05tx_id\x18\x04 \x01(\tR\x04txId\"1\n" +
+	"\x05tx_id\x18\x04 \x01(\tR\x04txId\"\x1e\n" +
+	"\x1cGetPendingWithdrawalsRequest\"\x98\x03\n" +
+	"\x11PendingWithdrawal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x18\n" +
+	"\aaddress\x18\a \x01(\tR\aaddress\x12\x1f\n" +
+	"\vaddress_tag\x18\b \x01(\tR\n" +
+	"addressTag\x12\x14\n" +
+	"\x05chain\x18\t \x01(\tR\x05chain\x12&\n" +
+	"\x0fbank_account_id\x18\n" +
+	" \x01(\tR\rbankAccountId\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\\\n" +
+	"\x1dGetPendingWithdrawalsResponse\x12;\n" +
+	"\vwithdrawals\x18\x01 \x03(\v2\x19.gctrpc.PendingWithdrawalR\vwithdrawals\">\n" +
+	"\x18ApproveWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\")\n" +
+	"\x17RejectWithdrawalRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x17GetLoggerDetailsRequest\x12\x16\n" +
 	"\x06logger\x18\x01 \x01(\tR\x06logger\"n\n" +
 	"\x18GetLoggerDetailsResponse\x12\x12\n" +
@@ -16367,7 +19575,126 @@ const file_rpc_proto_rawDesc = "" +
 	"\x05asset\x18\x02 \x01(\tR\x05asset\x12(\n" +
 	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\"/\n" +
 	"\x1bGetCurrencyTradeURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2\x9al\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"\xf6\x04\n" +
+	"\x10ConditionalOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tlinked_id\x18\x02 \x01(\tR\blinkedId\x12\x1a\n" +
+	"\bexchange\x18\x03 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x04 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x05 \x01(\tR\x05asset\x12\x12\n" +
+	"\x04side\x18\x06 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\a \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12#\n" +
+	"\rtrigger_price\x18\t \x01(\x01R\ftriggerPrice\x12\x1f\n" +
+	"\vlimit_price\x18\n" +
+	" \x01(\x01R\n" +
+	"limitPrice\x12#\n" +
+	"\rtracking_mode\x18\v \x01(\tR\ftrackingMode\x12%\n" +
+	"\x0etracking_value\x18\f \x01(\x01R\rtrackingValue\x12\x16\n" +
+	"\x06status\x18\r \x01(\tR\x06status\x12\x19\n" +
+	"\border_id\x18\x0e \x01(\tR\aorderId\x12\x14\n" +
+	"\x05error\x18\x0f \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
+	"\ftriggered_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\vtriggeredAt\"\xb3\x04\n" +
+	"\x1dSubmitConditionalOrderRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x12\n" +
+	"\x04side\x18\x04 \x01(\tR\x04side\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x05 \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12#\n" +
+	"\rtrigger_price\x18\a \x01(\x01R\ftriggerPrice\x12\x1f\n" +
+	"\vlimit_price\x18\b \x01(\x01R\n" +
+	"limitPrice\x12#\n" +
+	"\rtracking_mode\x18\t \x01(\tR\ftrackingMode\x12%\n" +
+	"\x0etracking_value\x18\n" +
+	" \x01(\x01R\rtrackingValue\x12*\n" +
+	"\x11take_profit_price\x18\v \x01(\x01R\x0ftakeProfitPrice\x125\n" +
+	"\x17take_profit_limit_price\x18\f \x01(\x01R\x14takeProfitLimitPrice\x12&\n" +
+	"\x0fstop_loss_price\x18\r \x01(\x01R\rstopLossPrice\x121\n" +
+	"\x15stop_loss_limit_price\x18\x0e \x01(\x01R\x12stopLossLimitPrice\x12\x1b\n" +
+	"\tclient_id\x18\x0f \x01(\tR\bclientId\"M\n" +
+	"\x19ConditionalOrdersResponse\x120\n" +
+	"\x06orders\x18\x01 \x03(\v2\x18.gctrpc.ConditionalOrderR\x06orders\"d\n" +
+	"\x1bGetConditionalOrdersRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"/\n" +
+	"\x1dCancelConditionalOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x18SetRiskKillSwitchRequest\x12\x18\n" +
+	"\aengaged\x18\x01 \x01(\bR\aengaged\"\xa0\x01\n" +
+	"\x1bGetRecordedOrderbookRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\tR\ttimestamp\"\x9a\x01\n" +
+	"\x0eAlgoChildOrder\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12'\n" +
+	"\x0fexecuted_amount\x18\x05 \x01(\x01R\x0eexecutedAmount\"\xb7\x05\n" +
+	"\tAlgoOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bexchange\x18\x02 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x03 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x04 \x01(\tR\x05asset\x12\x12\n" +
+	"\x04side\x18\x05 \x01(\tR\x04side\x12\x1b\n" +
+	"\talgo_type\x18\x06 \x01(\tR\balgoType\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\a \x01(\tR\torderType\x12\x14\n" +
+	"\x05price\x18\b \x01(\x01R\x05price\x12\x16\n" +
+	"\x06amount\x18\t \x01(\x01R\x06amount\x12)\n" +
+	"\x10submitted_amount\x18\n" +
+	" \x01(\x01R\x0fsubmittedAmount\x12#\n" +
+	"\rfilled_amount\x18\v \x01(\x01R\ffilledAmount\x12\x1a\n" +
+	"\bprogress\x18\f \x01(\x01R\bprogress\x12\x16\n" +
+	"\x06status\x18\r \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x0e \x01(\tR\x05error\x12\x1a\n" +
+	"\bduration\x18\x0f \x01(\x03R\bduration\x12\x16\n" +
+	"\x06slices\x18\x10 \x01(\x03R\x06slices\x12\x1a\n" +
+	"\binterval\x18\x11 \x01(\x03R\binterval\x12%\n" +
+	"\x0evisible_amount\x18\x12 \x01(\x01R\rvisibleAmount\x129\n" +
+	"\fchild_orders\x18\x13 \x03(\v2\x16.gctrpc.AlgoChildOrderR\vchildOrders\x129\n" +
+	"\n" +
+	"created_at\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x86\x03\n" +
+	"\x16SubmitAlgoOrderRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x14\n" +
+	"\x05asset\x18\x03 \x01(\tR\x05asset\x12\x12\n" +
+	"\x04side\x18\x04 \x01(\tR\x04side\x12\x1b\n" +
+	"\talgo_type\x18\x05 \x01(\tR\balgoType\x12\x1d\n" +
+	"\n" +
+	"order_type\x18\x06 \x01(\tR\torderType\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05price\x18\b \x01(\x01R\x05price\x12\x1a\n" +
+	"\bduration\x18\t \x01(\x03R\bduration\x12\x16\n" +
+	"\x06slices\x18\n" +
+	" \x01(\x03R\x06slices\x12\x1a\n" +
+	"\binterval\x18\v \x01(\x03R\binterval\x12%\n" +
+	"\x0evisible_amount\x18\f \x01(\x01R\rvisibleAmount\x12\x1b\n" +
+	"\tclient_id\x18\r \x01(\tR\bclientId\"?\n" +
+	"\x12AlgoOrdersResponse\x12)\n" +
+	"\x06orders\x18\x01 \x03(\v2\x11.gctrpc.AlgoOrderR\x06orders\"]\n" +
+	"\x14GetAlgoOrdersRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\"(\n" +
+	"\x16CancelAlgoOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xa2\x01\n" +
+	"\x16GetCandleStreamRequest\x12\x1a\n" +
+	"\bexchange\x18\x01 \x01(\tR\bexchange\x12(\n" +
+	"\x04pair\x18\x02 \x01(\v2\x14.gctrpc.CurrencyPairR\x04pair\x12\x1d\n" +
+	"\n" +
+	"asset_type\x18\x03 \x01(\tR\tassetType\x12#\n" +
+	"\rtime_interval\x18\x04 \x01(\x03R\ftimeInterval2\xda{\n" +
 	"\x15GoCryptoTraderService\x12O\n" +
 	"\aGetInfo\x12\x16.gctrpc.GetInfoRequest\x1a\x17.gctrpc.GetInfoResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/getinfo\x12g\n" +
 	"\rGetSubsystems\x12\x1c.gctrpc.GetSubsystemsRequest\x1a\x1d.gctrpc.GetSusbsytemsResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getsubsystems\x12h\n" +
@@ -16399,7 +19726,8 @@ const file_rpc_proto_rawDesc = "" +
 	"\tGetOrders\x12\x18.gctrpc.GetOrdersRequest\x1a\x19.gctrpc.GetOrdersResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/getorders\x12R\n" +
 	"\bGetOrder\x12\x17.gctrpc.GetOrderRequest\x1a\x14.gctrpc.OrderDetails\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/getorder\x12b\n" +
 	"\vSubmitOrder\x12\x1a.gctrpc.SubmitOrderRequest\x1a\x1b.gctrpc.SubmitOrderResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/submitorder\x12j\n" +
-	"\rSimulateOrder\x12\x1c.gctrpc.SimulateOrderRequest\x1a\x1d.gctrpc.SimulateOrderResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/simulateorder\x12^\n" +
+	"\rSimulateOrder\x12\x1c.gctrpc.SimulateOrderRequest\x1a\x1d.gctrpc.SimulateOrderResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/simulateorder\x12~\n" +
+	"\x12SimulateSmartOrder\x12!.gctrpc.SimulateSmartOrderRequest\x1a\".gctrpc.SimulateSmartOrderResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/simulatesmartorder\x12^\n" +
 	"\tWhaleBomb\x12\x18.gctrpc.WhaleBombRequest\x1a\x1d.gctrpc.SimulateOrderResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/whalebomb\x12^\n" +
 	"\vCancelOrder\x12\x1a.gctrpc.CancelOrderRequest\x1a\x17.gctrpc.GenericResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/cancelorder\x12z\n" +
 	"\x11CancelBatchOrders\x12 .gctrpc.CancelBatchOrdersRequest\x1a!.gctrpc.CancelBatchOrdersResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/cancelbatchorders\x12r\n" +
@@ -16484,7 +19812,22 @@ const file_rpc_proto_rawDesc = "" +
 	"\vSetLeverage\x12\x1a.gctrpc.SetLeverageRequest\x1a\x1b.gctrpc.SetLeverageResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/getleverage\x12\x86\x01\n" +
 	"\x14ChangePositionMargin\x12#.gctrpc.ChangePositionMarginRequest\x1a$.gctrpc.ChangePositionMarginResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/changepositionmargin\x12o\n" +
 	"\x0fGetOpenInterest\x12\x1e.gctrpc.GetOpenInterestRequest\x1a\x1f.gctrpc.GetOpenInterestResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getopeninterest\x12\x7f\n" +
-	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurlB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
+	"\x13GetCurrencyTradeURL\x12\".gctrpc.GetCurrencyTradeURLRequest\x1a#.gctrpc.GetCurrencyTradeURLResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/getcurrencytradeurl\x12\x89\x01\n" +
+	"\x16SubmitConditionalOrder\x12%.gctrpc.SubmitConditionalOrderRequest\x1a!.gctrpc.ConditionalOrdersResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/submitconditionalorder\x12\x80\x01\n" +
+	"\x14GetConditionalOrders\x12#.gctrpc.GetConditionalOrdersRequest\x1a!.gctrpc.ConditionalOrdersResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/getconditionalorders\x12\x7f\n" +
+	"\x16CancelConditionalOrder\x12%.gctrpc.CancelConditionalOrderRequest\x1a\x17.gctrpc.GenericResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/cancelconditionalorder\x12p\n" +
+	"\x11SetRiskKillSwitch\x12 .gctrpc.SetRiskKillSwitchRequest\x1a\x17.gctrpc.GenericResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/setriskkillswitch\x12x\n" +
+	"\x14GetRecordedOrderbook\x12#.gctrpc.GetRecordedOrderbookRequest\x1a\x19.gctrpc.OrderbookResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/getrecordedorderbook\x12m\n" +
+	"\x0fSubmitAlgoOrder\x12\x1e.gctrpc.SubmitAlgoOrderRequest\x1a\x1a.gctrpc.AlgoOrdersResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/submitalgoorder\x12d\n" +
+	"\rGetAlgoOrders\x12\x1c.gctrpc.GetAlgoOrdersRequest\x1a\x1a.gctrpc.AlgoOrdersResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/getalgoorders\x12j\n" +
+	"\x0fCancelAlgoOrder\x12\x1e.gctrpc.CancelAlgoOrderRequest\x1a\x17.gctrpc.GenericResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/cancelalgoorder\x12t\n" +
+	"\x0fGetCandleStream\x12\x1e.gctrpc.GetCandleStreamRequest\x1a\".gctrpc.GetHistoricCandlesResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/getcandlestream0\x01\x12|\n" +
+	"\x15GetPortfolioValuation\x12$.gctrpc.GetPortfolioValuationRequest\x1a\x1a.gctrpc.PortfolioValuation\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getportfoliovaluation\x12\xa3\x01\n" +
+	"\x1cGetPortfolioValuationHistory\x12+.gctrpc.GetPortfolioValuationHistoryRequest\x1a,.gctrpc.GetPortfolioValuationHistoryResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/getportfoliovaluationhistory\x12{\n" +
+	"\x12GetCostBasisReport\x12!.gctrpc.GetCostBasisReportRequest\x1a\".gctrpc.GetCostBasisReportResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/getcostbasisreport\x12\x87\x01\n" +
+	"\x15GetPendingWithdrawals\x12$.gctrpc.GetPendingWithdrawalsRequest\x1a%.gctrpc.GetPendingWithdrawalsResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/v1/getpendingwithdrawals\x12q\n" +
+	"\x11ApproveWithdrawal\x12 .gctrpc.ApproveWithdrawalRequest\x1a\x18.gctrpc.WithdrawResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/approvewithdrawal\x12m\n" +
+	"\x10RejectWithdrawal\x12\x1f.gctrpc.RejectWithdrawalRequest\x1a\x17.gctrpc.GenericResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/rejectwithdrawalB0Z.github.com/thrasher-corp/gocryptotrader/gctrpcb\x06proto3"
 
 var (
 	file_rpc_proto_rawDescOnce sync.Once
@@ -16498,7 +19841,7 @@ func file_rpc_proto_rawDescGZIP() []byte {
 	return file_rpc_proto_rawDescData
 }
 
-var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 240)
+var file_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 277)
 var file_rpc_proto_goTypes = []any{
 	(*GetInfoRequest)(nil),                            // 0: gctrpc.GetInfoRequest
 	(*GetInfoResponse)(nil),                           // 1: gctrpc.GetInfoResponse
@@ -16548,208 +19891,245 @@ var file_rpc_proto_goTypes = []any{
 	(*OfflineCoins)(nil),                              // 45: gctrpc.OfflineCoins
 	(*OnlineCoins)(nil),                               // 46: gctrpc.OnlineCoins
 	(*GetPortfolioSummaryResponse)(nil),               // 47: gctrpc.GetPortfolioSummaryResponse
-	(*AddPortfolioAddressRequest)(nil),                // 48: gctrpc.AddPortfolioAddressRequest
-	(*RemovePortfolioAddressRequest)(nil),             // 49: gctrpc.RemovePortfolioAddressRequest
-	(*GetForexProvidersRequest)(nil),                  // 50: gctrpc.GetForexProvidersRequest
-	(*ForexProvider)(nil),                             // 51: gctrpc.ForexProvider
-	(*GetForexProvidersResponse)(nil),                 // 52: gctrpc.GetForexProvidersResponse
-	(*GetForexRatesRequest)(nil),                      // 53: gctrpc.GetForexRatesRequest
-	(*ForexRatesConversion)(nil),                      // 54: gctrpc.ForexRatesConversion
-	(*GetForexRatesResponse)(nil),                     // 55: gctrpc.GetForexRatesResponse
-	(*OrderDetails)(nil),                              // 56: gctrpc.OrderDetails
-	(*TradeHistory)(nil),                              // 57: gctrpc.TradeHistory
-	(*GetOrdersRequest)(nil),                          // 58: gctrpc.GetOrdersRequest
-	(*GetOrdersResponse)(nil),                         // 59: gctrpc.GetOrdersResponse
-	(*GetOrderRequest)(nil),                           // 60: gctrpc.GetOrderRequest
-	(*SubmitOrderRequest)(nil),                        // 61: gctrpc.SubmitOrderRequest
-	(*Trades)(nil),                                    // 62: gctrpc.Trades
-	(*SubmitOrderResponse)(nil),                       // 63: gctrpc.SubmitOrderResponse
-	(*SimulateOrderRequest)(nil),                      // 64: gctrpc.SimulateOrderRequest
-	(*SimulateOrderResponse)(nil),                     // 65: gctrpc.SimulateOrderResponse
-	(*WhaleBombRequest)(nil),                          // 66: gctrpc.WhaleBombRequest
-	(*CancelOrderRequest)(nil),                        // 67: gctrpc.CancelOrderRequest
-	(*CancelBatchOrdersRequest)(nil),                  // 68: gctrpc.CancelBatchOrdersRequest
-	(*Orders)(nil),                                    // 69: gctrpc.Orders
-	(*CancelBatchOrdersResponse)(nil),                 // 70: gctrpc.CancelBatchOrdersResponse
-	(*CancelAllOrdersRequest)(nil),                    // 71: gctrpc.CancelAllOrdersRequest
-	(*CancelAllOrdersResponse)(nil),                   // 72: gctrpc.CancelAllOrdersResponse
-	(*GetEventsRequest)(nil),                          // 73: gctrpc.GetEventsRequest
-	(*ConditionParams)(nil),                           // 74: gctrpc.ConditionParams
-	(*GetEventsResponse)(nil),                         // 75: gctrpc.GetEventsResponse
-	(*AddEventRequest)(nil),                           // 76: gctrpc.AddEventRequest
-	(*AddEventResponse)(nil),                          // 77: gctrpc.AddEventResponse
-	(*RemoveEventRequest)(nil),                        // 78: gctrpc.RemoveEventRequest
-	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 79: gctrpc.GetCryptocurrencyDepositAddressesRequest
-	(*DepositAddress)(nil),                            // 80: gctrpc.DepositAddress
-	(*DepositAddresses)(nil),                          // 81: gctrpc.DepositAddresses
-	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 82: gctrpc.GetCryptocurrencyDepositAddressesResponse
-	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 83: gctrpc.GetCryptocurrencyDepositAddressRequest
-	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 84: gctrpc.GetCryptocurrencyDepositAddressResponse
-	(*GetAvailableTransferChainsRequest)(nil),         // 85: gctrpc.GetAvailableTransferChainsRequest
-	(*GetAvailableTransferChainsResponse)(nil),        // 86: gctrpc.GetAvailableTransferChainsResponse
-	(*WithdrawFiatRequest)(nil),                       // 87: gctrpc.WithdrawFiatRequest
-	(*WithdrawCryptoRequest)(nil),                     // 88: gctrpc.WithdrawCryptoRequest
-	(*WithdrawResponse)(nil),                          // 89: gctrpc.WithdrawResponse
-	(*WithdrawalEventByIDRequest)(nil),                // 90: gctrpc.WithdrawalEventByIDRequest
-	(*WithdrawalEventByIDResponse)(nil),               // 91: gctrpc.WithdrawalEventByIDResponse
-	(*WithdrawalEventsByExchangeRequest)(nil),         // 92: gctrpc.WithdrawalEventsByExchangeRequest
-	(*WithdrawalEventsByDateRequest)(nil),             // 93: gctrpc.WithdrawalEventsByDateRequest
-	(*WithdrawalEventsByExchangeResponse)(nil),        // 94: gctrpc.WithdrawalEventsByExchangeResponse
-	(*WithdrawalEventResponse)(nil),                   // 95: gctrpc.WithdrawalEventResponse
-	(*WithdrawlExchangeEvent)(nil),                    // 96: gctrpc.WithdrawlExchangeEvent
-	(*WithdrawalRequestEvent)(nil),                    // 97: gctrpc.WithdrawalRequestEvent
-	(*FiatWithdrawalEvent)(nil),                       // 98: gctrpc.FiatWithdrawalEvent
-	(*CryptoWithdrawalEvent)(nil),                     // 99: gctrpc.CryptoWithdrawalEvent
-	(*GetLoggerDetailsRequest)(nil),                   // 100: gctrpc.GetLoggerDetailsRequest
-	(*GetLoggerDetailsResponse)(nil),                  // 101: gctrpc.GetLoggerDetailsResponse
-	(*SetLoggerDetailsRequest)(nil),                   // 102: gctrpc.SetLoggerDetailsRequest
-	(*GetExchangePairsRequest)(nil),                   // 103: gctrpc.GetExchangePairsRequest
-	(*GetExchangePairsResponse)(nil),                  // 104: gctrpc.GetExchangePairsResponse
-	(*SetExchangePairRequest)(nil),                    // 105: gctrpc.SetExchangePairRequest
-	(*GetOrderbookStreamRequest)(nil),                 // 106: gctrpc.GetOrderbookStreamRequest
-	(*GetExchangeOrderbookStreamRequest)(nil),         // 107: gctrpc.GetExchangeOrderbookStreamRequest
-	(*GetTickerStreamRequest)(nil),                    // 108: gctrpc.GetTickerStreamRequest
-	(*GetExchangeTickerStreamRequest)(nil),            // 109: gctrpc.GetExchangeTickerStreamRequest
-	(*GetAuditEventRequest)(nil),                      // 110: gctrpc.GetAuditEventRequest
-	(*GetAuditEventResponse)(nil),                     // 111: gctrpc.GetAuditEventResponse
-	(*GetSavedTradesRequest)(nil),                     // 112: gctrpc.GetSavedTradesRequest
-	(*SavedTrades)(nil),                               // 113: gctrpc.SavedTrades
-	(*SavedTradesResponse)(nil),                       // 114: gctrpc.SavedTradesResponse
-	(*ConvertTradesToCandlesRequest)(nil),             // 115: gctrpc.ConvertTradesToCandlesRequest
-	(*GetHistoricCandlesRequest)(nil),                 // 116: gctrpc.GetHistoricCandlesRequest
-	(*GetHistoricCandlesResponse)(nil),                // 117: gctrpc.GetHistoricCandlesResponse
-	(*Candle)(nil),                                    // 118: gctrpc.Candle
-	(*AuditEvent)(nil),                                // 119: gctrpc.AuditEvent
-	(*GCTScript)(nil),                                 // 120: gctrpc.GCTScript
-	(*GCTScriptExecuteRequest)(nil),                   // 121: gctrpc.GCTScriptExecuteRequest
-	(*GCTScriptStopRequest)(nil),                      // 122: gctrpc.GCTScriptStopRequest
-	(*GCTScriptStopAllRequest)(nil),                   // 123: gctrpc.GCTScriptStopAllRequest
-	(*GCTScriptStatusRequest)(nil),                    // 124: gctrpc.GCTScriptStatusRequest
-	(*GCTScriptListAllRequest)(nil),                   // 125: gctrpc.GCTScriptListAllRequest
-	(*GCTScriptUploadRequest)(nil),                    // 126: gctrpc.GCTScriptUploadRequest
-	(*GCTScriptReadScriptRequest)(nil),                // 127: gctrpc.GCTScriptReadScriptRequest
-	(*GCTScriptQueryRequest)(nil),                     // 128: gctrpc.GCTScriptQueryRequest
-	(*GCTScriptAutoLoadRequest)(nil),                  // 129: gctrpc.GCTScriptAutoLoadRequest
-	(*GCTScriptStatusResponse)(nil),                   // 130: gctrpc.GCTScriptStatusResponse
-	(*GCTScriptQueryResponse)(nil),                    // 131: gctrpc.GCTScriptQueryResponse
-	(*GenericResponse)(nil),                           // 132: gctrpc.GenericResponse
-	(*SetExchangeAssetRequest)(nil),                   // 133: gctrpc.SetExchangeAssetRequest
-	(*SetExchangeAllPairsRequest)(nil),                // 134: gctrpc.SetExchangeAllPairsRequest
-	(*UpdateExchangeSupportedPairsRequest)(nil),       // 135: gctrpc.UpdateExchangeSupportedPairsRequest
-	(*GetExchangeAssetsRequest)(nil),                  // 136: gctrpc.GetExchangeAssetsRequest
-	(*GetExchangeAssetsResponse)(nil),                 // 137: gctrpc.GetExchangeAssetsResponse
-	(*WebsocketGetInfoRequest)(nil),                   // 138: gctrpc.WebsocketGetInfoRequest
-	(*WebsocketGetInfoResponse)(nil),                  // 139: gctrpc.WebsocketGetInfoResponse
-	(*WebsocketSetEnabledRequest)(nil),                // 140: gctrpc.WebsocketSetEnabledRequest
-	(*WebsocketGetSubscriptionsRequest)(nil),          // 141: gctrpc.WebsocketGetSubscriptionsRequest
-	(*WebsocketSubscription)(nil),                     // 142: gctrpc.WebsocketSubscription
-	(*WebsocketGetSubscriptionsResponse)(nil),         // 143: gctrpc.WebsocketGetSubscriptionsResponse
-	(*WebsocketSetProxyRequest)(nil),                  // 144: gctrpc.WebsocketSetProxyRequest
-	(*WebsocketSetURLRequest)(nil),                    // 145: gctrpc.WebsocketSetURLRequest
-	(*FindMissingCandlePeriodsRequest)(nil),           // 146: gctrpc.FindMissingCandlePeriodsRequest
-	(*FindMissingTradePeriodsRequest)(nil),            // 147: gctrpc.FindMissingTradePeriodsRequest
-	(*FindMissingIntervalsResponse)(nil),              // 148: gctrpc.FindMissingIntervalsResponse
-	(*SetExchangeTradeProcessingRequest)(nil),         // 149: gctrpc.SetExchangeTradeProcessingRequest
-	(*UpsertDataHistoryJobRequest)(nil),               // 150: gctrpc.UpsertDataHistoryJobRequest
-	(*InsertSequentialJobsRequest)(nil),               // 151: gctrpc.InsertSequentialJobsRequest
-	(*InsertSequentialJobsResponse)(nil),              // 152: gctrpc.InsertSequentialJobsResponse
-	(*UpsertDataHistoryJobResponse)(nil),              // 153: gctrpc.UpsertDataHistoryJobResponse
-	(*GetDataHistoryJobDetailsRequest)(nil),           // 154: gctrpc.GetDataHistoryJobDetailsRequest
-	(*DataHistoryJob)(nil),                            // 155: gctrpc.DataHistoryJob
-	(*DataHistoryJobResult)(nil),                      // 156: gctrpc.DataHistoryJobResult
-	(*DataHistoryJobs)(nil),                           // 157: gctrpc.DataHistoryJobs
-	(*GetDataHistoryJobsBetweenRequest)(nil),          // 158: gctrpc.GetDataHistoryJobsBetweenRequest
-	(*SetDataHistoryJobStatusRequest)(nil),            // 159: gctrpc.SetDataHistoryJobStatusRequest
-	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 160: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	(*ModifyOrderRequest)(nil),                        // 161: gctrpc.ModifyOrderRequest
-	(*ModifyOrderResponse)(nil),                       // 162: gctrpc.ModifyOrderResponse
-	(*CurrencyStateGetAllRequest)(nil),                // 163: gctrpc.CurrencyStateGetAllRequest
-	(*CurrencyStateTradingRequest)(nil),               // 164: gctrpc.CurrencyStateTradingRequest
-	(*CurrencyStateTradingPairRequest)(nil),           // 165: gctrpc.CurrencyStateTradingPairRequest
-	(*CurrencyStateWithdrawRequest)(nil),              // 166: gctrpc.CurrencyStateWithdrawRequest
-	(*CurrencyStateDepositRequest)(nil),               // 167: gctrpc.CurrencyStateDepositRequest
-	(*CurrencyStateResponse)(nil),                     // 168: gctrpc.CurrencyStateResponse
-	(*CurrencyState)(nil),                             // 169: gctrpc.CurrencyState
-	(*FundingRate)(nil),                               // 170: gctrpc.FundingRate
-	(*FundingData)(nil),                               // 171: gctrpc.FundingData
-	(*FuturesPositionStats)(nil),                      // 172: gctrpc.FuturesPositionStats
-	(*FuturePosition)(nil),                            // 173: gctrpc.FuturePosition
-	(*GetManagedPositionRequest)(nil),                 // 174: gctrpc.GetManagedPositionRequest
-	(*GetAllManagedPositionsRequest)(nil),             // 175: gctrpc.GetAllManagedPositionsRequest
-	(*GetManagedPositionsResponse)(nil),               // 176: gctrpc.GetManagedPositionsResponse
-	(*GetFuturesPositionsSummaryRequest)(nil),         // 177: gctrpc.GetFuturesPositionsSummaryRequest
-	(*GetFuturesPositionsSummaryResponse)(nil),        // 178: gctrpc.GetFuturesPositionsSummaryResponse
-	(*GetFuturesPositionsOrdersRequest)(nil),          // 179: gctrpc.GetFuturesPositionsOrdersRequest
-	(*GetFuturesPositionsOrdersResponse)(nil),         // 180: gctrpc.GetFuturesPositionsOrdersResponse
-	(*GetCollateralModeRequest)(nil),                  // 181: gctrpc.GetCollateralModeRequest
-	(*GetCollateralModeResponse)(nil),                 // 182: gctrpc.GetCollateralModeResponse
-	(*SetCollateralModeRequest)(nil),                  // 183: gctrpc.SetCollateralModeRequest
-	(*SetCollateralModeResponse)(nil),                 // 184: gctrpc.SetCollateralModeResponse
-	(*GetMarginTypeRequest)(nil),                      // 185: gctrpc.GetMarginTypeRequest
-	(*GetMarginTypeResponse)(nil),                     // 186: gctrpc.GetMarginTypeResponse
-	(*ChangePositionMarginRequest)(nil),               // 187: gctrpc.ChangePositionMarginRequest
-	(*ChangePositionMarginResponse)(nil),              // 188: gctrpc.ChangePositionMarginResponse
-	(*SetMarginTypeRequest)(nil),                      // 189: gctrpc.SetMarginTypeRequest
-	(*SetMarginTypeResponse)(nil),                     // 190: gctrpc.SetMarginTypeResponse
-	(*GetLeverageRequest)(nil),                        // 191: gctrpc.GetLeverageRequest
-	(*GetLeverageResponse)(nil),                       // 192: gctrpc.GetLeverageResponse
-	(*SetLeverageRequest)(nil),                        // 193: gctrpc.SetLeverageRequest
-	(*SetLeverageResponse)(nil),                       // 194: gctrpc.SetLeverageResponse
-	(*GetCollateralRequest)(nil),                      // 195: gctrpc.GetCollateralRequest
-	(*GetCollateralResponse)(nil),                     // 196: gctrpc.GetCollateralResponse
-	(*CollateralForCurrency)(nil),                     // 197: gctrpc.CollateralForCurrency
-	(*CollateralByPosition)(nil),                      // 198: gctrpc.CollateralByPosition
-	(*CollateralUsedBreakdown)(nil),                   // 199: gctrpc.CollateralUsedBreakdown
-	(*GetFundingRatesRequest)(nil),                    // 200: gctrpc.GetFundingRatesRequest
-	(*GetFundingRatesResponse)(nil),                   // 201: gctrpc.GetFundingRatesResponse
-	(*GetLatestFundingRateRequest)(nil),               // 202: gctrpc.GetLatestFundingRateRequest
-	(*GetLatestFundingRateResponse)(nil),              // 203: gctrpc.GetLatestFundingRateResponse
-	(*ShutdownRequest)(nil),                           // 204: gctrpc.ShutdownRequest
-	(*ShutdownResponse)(nil),                          // 205: gctrpc.ShutdownResponse
-	(*GetTechnicalAnalysisRequest)(nil),               // 206: gctrpc.GetTechnicalAnalysisRequest
-	(*ListOfSignals)(nil),                             // 207: gctrpc.ListOfSignals
-	(*GetTechnicalAnalysisResponse)(nil),              // 208: gctrpc.GetTechnicalAnalysisResponse
-	(*GetMarginRatesHistoryRequest)(nil),              // 209: gctrpc.GetMarginRatesHistoryRequest
-	(*LendingPayment)(nil),                            // 210: gctrpc.LendingPayment
-	(*BorrowCost)(nil),                                // 211: gctrpc.BorrowCost
-	(*MarginRate)(nil),                                // 212: gctrpc.MarginRate
-	(*GetMarginRatesHistoryResponse)(nil),             // 213: gctrpc.GetMarginRatesHistoryResponse
-	(*GetOrderbookMovementRequest)(nil),               // 214: gctrpc.GetOrderbookMovementRequest
-	(*GetOrderbookMovementResponse)(nil),              // 215: gctrpc.GetOrderbookMovementResponse
-	(*GetOrderbookAmountByNominalRequest)(nil),        // 216: gctrpc.GetOrderbookAmountByNominalRequest
-	(*GetOrderbookAmountByNominalResponse)(nil),       // 217: gctrpc.GetOrderbookAmountByNominalResponse
-	(*GetOrderbookAmountByImpactRequest)(nil),         // 218: gctrpc.GetOrderbookAmountByImpactRequest
-	(*GetOrderbookAmountByImpactResponse)(nil),        // 219: gctrpc.GetOrderbookAmountByImpactResponse
-	(*GetOpenInterestRequest)(nil),                    // 220: gctrpc.GetOpenInterestRequest
-	(*OpenInterestDataRequest)(nil),                   // 221: gctrpc.OpenInterestDataRequest
-	(*GetOpenInterestResponse)(nil),                   // 222: gctrpc.GetOpenInterestResponse
-	(*OpenInterestDataResponse)(nil),                  // 223: gctrpc.OpenInterestDataResponse
-	(*GetCurrencyTradeURLRequest)(nil),                // 224: gctrpc.GetCurrencyTradeURLRequest
-	(*GetCurrencyTradeURLResponse)(nil),               // 225: gctrpc.GetCurrencyTradeURLResponse
-	nil,                                               // 226: gctrpc.GetInfoResponse.SubsystemStatusEntry
-	nil,                                               // 227: gctrpc.GetInfoResponse.RpcEndpointsEntry
-	nil,                                               // 228: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	nil,                                               // 229: gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
-	nil,                                               // 230: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	nil,                                               // 231: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	nil,                                               // 232: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
-	nil,                                               // 233: gctrpc.OnlineCoins.CoinsEntry
-	nil,                                               // 234: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
-	nil,                                               // 235: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	nil,                                               // 236: gctrpc.Orders.OrderStatusEntry
-	nil,                                               // 237: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	nil,                                               // 238: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	nil,                                               // 239: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	(*timestamppb.Timestamp)(nil),                     // 240: google.protobuf.Timestamp
+	(*GetPortfolioValuationRequest)(nil),              // 48: gctrpc.GetPortfolioValuationRequest
+	(*PortfolioValuationHolding)(nil),                 // 49: gctrpc.PortfolioValuationHolding
+	(*PortfolioExchangeValuation)(nil),                // 50: gctrpc.PortfolioExchangeValuation
+	(*PortfolioValuation)(nil),                        // 51: gctrpc.PortfolioValuation
+	(*GetPortfolioValuationHistoryRequest)(nil),       // 52: gctrpc.GetPortfolioValuationHistoryRequest
+	(*PortfolioCurrencyValueChange)(nil),              // 53: gctrpc.PortfolioCurrencyValueChange
+	(*GetPortfolioValuationHistoryResponse)(nil),      // 54: gctrpc.GetPortfolioValuationHistoryResponse
+	(*GetCostBasisReportRequest)(nil),                 // 55: gctrpc.GetCostBasisReportRequest
+	(*CostBasisGain)(nil),                             // 56: gctrpc.CostBasisGain
+	(*CostBasisLot)(nil),                              // 57: gctrpc.CostBasisLot
+	(*GetCostBasisReportResponse)(nil),                // 58: gctrpc.GetCostBasisReportResponse
+	(*AddPortfolioAddressRequest)(nil),                // 59: gctrpc.AddPortfolioAddressRequest
+	(*RemovePortfolioAddressRequest)(nil),             // 60: gctrpc.RemovePortfolioAddressRequest
+	(*GetForexProvidersRequest)(nil),                  // 61: gctrpc.GetForexProvidersRequest
+	(*ForexProvider)(nil),                             // 62: gctrpc.ForexProvider
+	(*GetForexProvidersResponse)(nil),                 // 63: gctrpc.GetForexProvidersResponse
+	(*GetForexRatesRequest)(nil),                      // 64: gctrpc.GetForexRatesRequest
+	(*ForexRatesConversion)(nil),                      // 65: gctrpc.ForexRatesConversion
+	(*GetForexRatesResponse)(nil),                     // 66: gctrpc.GetForexRatesResponse
+	(*OrderDetails)(nil),                              // 67: gctrpc.OrderDetails
+	(*TradeHistory)(nil),                              // 68: gctrpc.TradeHistory
+	(*GetOrdersRequest)(nil),                          // 69: gctrpc.GetOrdersRequest
+	(*GetOrdersResponse)(nil),                         // 70: gctrpc.GetOrdersResponse
+	(*GetOrderRequest)(nil),                           // 71: gctrpc.GetOrderRequest
+	(*SubmitOrderRequest)(nil),                        // 72: gctrpc.SubmitOrderRequest
+	(*Trades)(nil),                                    // 73: gctrpc.Trades
+	(*SubmitOrderResponse)(nil),                       // 74: gctrpc.SubmitOrderResponse
+	(*SimulateOrderRequest)(nil),                      // 75: gctrpc.SimulateOrderRequest
+	(*SimulateOrderResponse)(nil),                     // 76: gctrpc.SimulateOrderResponse
+	(*SimulateSmartOrderRequest)(nil),                 // 77: gctrpc.SimulateSmartOrderRequest
+	(*SmartOrderRoute)(nil),                           // 78: gctrpc.SmartOrderRoute
+	(*SmartOrderRejection)(nil),                       // 79: gctrpc.SmartOrderRejection
+	(*SimulateSmartOrderResponse)(nil),                // 80: gctrpc.SimulateSmartOrderResponse
+	(*WhaleBombRequest)(nil),                          // 81: gctrpc.WhaleBombRequest
+	(*CancelOrderRequest)(nil),                        // 82: gctrpc.CancelOrderRequest
+	(*CancelBatchOrdersRequest)(nil),                  // 83: gctrpc.CancelBatchOrdersRequest
+	(*Orders)(nil),                                    // 84: gctrpc.Orders
+	(*CancelBatchOrdersResponse)(nil),                 // 85: gctrpc.CancelBatchOrdersResponse
+	(*CancelAllOrdersRequest)(nil),                    // 86: gctrpc.CancelAllOrdersRequest
+	(*CancelAllOrdersResponse)(nil),                   // 87: gctrpc.CancelAllOrdersResponse
+	(*GetEventsRequest)(nil),                          // 88: gctrpc.GetEventsRequest
+	(*ConditionParams)(nil),                           // 89: gctrpc.ConditionParams
+	(*EventRule)(nil),                                 // 90: gctrpc.EventRule
+	(*EventAction)(nil),                               // 91: gctrpc.EventAction
+	(*Event)(nil),                                     // 92: gctrpc.Event
+	(*GetEventsResponse)(nil),                         // 93: gctrpc.GetEventsResponse
+	(*AddEventRequest)(nil),                           // 94: gctrpc.AddEventRequest
+	(*AddEventResponse)(nil),                          // 95: gctrpc.AddEventResponse
+	(*RemoveEventRequest)(nil),                        // 96: gctrpc.RemoveEventRequest
+	(*GetCryptocurrencyDepositAddressesRequest)(nil),  // 97: gctrpc.GetCryptocurrencyDepositAddressesRequest
+	(*DepositAddress)(nil),                            // 98: gctrpc.DepositAddress
+	(*DepositAddresses)(nil),                          // 99: gctrpc.DepositAddresses
+	(*GetCryptocurrencyDepositAddressesResponse)(nil), // 100: gctrpc.GetCryptocurrencyDepositAddressesResponse
+	(*GetCryptocurrencyDepositAddressRequest)(nil),    // 101: gctrpc.GetCryptocurrencyDepositAddressRequest
+	(*GetCryptocurrencyDepositAddressResponse)(nil),   // 102: gctrpc.GetCryptocurrencyDepositAddressResponse
+	(*GetAvailableTransferChainsRequest)(nil),         // 103: gctrpc.GetAvailableTransferChainsRequest
+	(*GetAvailableTransferChainsResponse)(nil),        // 104: gctrpc.GetAvailableTransferChainsResponse
+	(*WithdrawFiatRequest)(nil),                       // 105: gctrpc.WithdrawFiatRequest
+	(*WithdrawCryptoRequest)(nil),                     // 106: gctrpc.WithdrawCryptoRequest
+	(*WithdrawResponse)(nil),                          // 107: gctrpc.WithdrawResponse
+	(*WithdrawalEventByIDRequest)(nil),                // 108: gctrpc.WithdrawalEventByIDRequest
+	(*WithdrawalEventByIDResponse)(nil),               // 109: gctrpc.WithdrawalEventByIDResponse
+	(*WithdrawalEventsByExchangeRequest)(nil),         // 110: gctrpc.WithdrawalEventsByExchangeRequest
+	(*WithdrawalEventsByDateRequest)(nil),             // 111: gctrpc.WithdrawalEventsByDateRequest
+	(*WithdrawalEventsByExchangeResponse)(nil),        // 112: gctrpc.WithdrawalEventsByExchangeResponse
+	(*WithdrawalEventResponse)(nil),                   // 113: gctrpc.WithdrawalEventResponse
+	(*WithdrawlExchangeEvent)(nil),                    // 114: gctrpc.WithdrawlExchangeEvent
+	(*WithdrawalRequestEvent)(nil),                    // 115: gctrpc.WithdrawalRequestEvent
+	(*FiatWithdrawalEvent)(nil),                       // 116: gctrpc.FiatWithdrawalEvent
+	(*CryptoWithdrawalEvent)(nil),                     // 117: gctrpc.CryptoWithdrawalEvent
+	(*GetPendingWithdrawalsRequest)(nil),              // 118: gctrpc.GetPendingWithdrawalsRequest
+	(*PendingWithdrawal)(nil),                         // 119: gctrpc.PendingWithdrawal
+	(*GetPendingWithdrawalsResponse)(nil),             // 120: gctrpc.GetPendingWithdrawalsResponse
+	(*ApproveWithdrawalRequest)(nil),                  // 121: gctrpc.ApproveWithdrawalRequest
+	(*RejectWithdrawalRequest)(nil),                   // 122: gctrpc.RejectWithdrawalRequest
+	(*GetLoggerDetailsRequest)(nil),                   // 123: gctrpc.GetLoggerDetailsRequest
+	(*GetLoggerDetailsResponse)(nil),                  // 124: gctrpc.GetLoggerDetailsResponse
+	(*SetLoggerDetailsRequest)(nil),                   // 125: gctrpc.SetLoggerDetailsRequest
+	(*GetExchangePairsRequest)(nil),                   // 126: gctrpc.GetExchangePairsRequest
+	(*GetExchangePairsResponse)(nil),                  // 127: gctrpc.GetExchangePairsResponse
+	(*SetExchangePairRequest)(nil),                    // 128: gctrpc.SetExchangePairRequest
+	(*GetOrderbookStreamRequest)(nil),                 // 129: gctrpc.GetOrderbookStreamRequest
+	(*GetExchangeOrderbookStreamRequest)(nil),         // 130: gctrpc.GetExchangeOrderbookStreamRequest
+	(*GetTickerStreamRequest)(nil),                    // 131: gctrpc.GetTickerStreamRequest
+	(*GetExchangeTickerStreamRequest)(nil),            // 132: gctrpc.GetExchangeTickerStreamRequest
+	(*GetAuditEventRequest)(nil),                      // 133: gctrpc.GetAuditEventRequest
+	(*GetAuditEventResponse)(nil),                     // 134: gctrpc.GetAuditEventResponse
+	(*GetSavedTradesRequest)(nil),                     // 135: gctrpc.GetSavedTradesRequest
+	(*SavedTrades)(nil),                               // 136: gctrpc.SavedTrades
+	(*SavedTradesResponse)(nil),                       // 137: gctrpc.SavedTradesResponse
+	(*ConvertTradesToCandlesRequest)(nil),             // 138: gctrpc.ConvertTradesToCandlesRequest
+	(*GetHistoricCandlesRequest)(nil),                 // 139: gctrpc.GetHistoricCandlesRequest
+	(*GetHistoricCandlesResponse)(nil),                // 140: gctrpc.GetHistoricCandlesResponse
+	(*Candle)(nil),                                    // 141: gctrpc.Candle
+	(*AuditEvent)(nil),                                // 142: gctrpc.AuditEvent
+	(*GCTScript)(nil),                                 // 143: gctrpc.GCTScript
+	(*GCTScriptExecuteRequest)(nil),                   // 144: gctrpc.GCTScriptExecuteRequest
+	(*GCTScriptStopRequest)(nil),                      // 145: gctrpc.GCTScriptStopRequest
+	(*GCTScriptStopAllRequest)(nil),                   // 146: gctrpc.GCTScriptStopAllRequest
+	(*GCTScriptStatusRequest)(nil),                    // 147: gctrpc.GCTScriptStatusRequest
+	(*GCTScriptListAllRequest)(nil),                   // 148: gctrpc.GCTScriptListAllRequest
+	(*GCTScriptUploadRequest)(nil),                    // 149: gctrpc.GCTScriptUploadRequest
+	(*GCTScriptReadScriptRequest)(nil),                // 150: gctrpc.GCTScriptReadScriptRequest
+	(*GCTScriptQueryRequest)(nil),                     // 151: gctrpc.GCTScriptQueryRequest
+	(*GCTScriptAutoLoadRequest)(nil),                  // 152: gctrpc.GCTScriptAutoLoadRequest
+	(*GCTScriptStatusResponse)(nil),                   // 153: gctrpc.GCTScriptStatusResponse
+	(*GCTScriptQueryResponse)(nil),                    // 154: gctrpc.GCTScriptQueryResponse
+	(*GenericResponse)(nil),                           // 155: gctrpc.GenericResponse
+	(*SetExchangeAssetRequest)(nil),                   // 156: gctrpc.SetExchangeAssetRequest
+	(*SetExchangeAllPairsRequest)(nil),                // 157: gctrpc.SetExchangeAllPairsRequest
+	(*UpdateExchangeSupportedPairsRequest)(nil),       // 158: gctrpc.UpdateExchangeSupportedPairsRequest
+	(*GetExchangeAssetsRequest)(nil),                  // 159: gctrpc.GetExchangeAssetsRequest
+	(*GetExchangeAssetsResponse)(nil),                 // 160: gctrpc.GetExchangeAssetsResponse
+	(*WebsocketGetInfoRequest)(nil),                   // 161: gctrpc.WebsocketGetInfoRequest
+	(*WebsocketGetInfoResponse)(nil),                  // 162: gctrpc.WebsocketGetInfoResponse
+	(*WebsocketSetEnabledRequest)(nil),                // 163: gctrpc.WebsocketSetEnabledRequest
+	(*WebsocketGetSubscriptionsRequest)(nil),          // 164: gctrpc.WebsocketGetSubscriptionsRequest
+	(*WebsocketSubscription)(nil),                     // 165: gctrpc.WebsocketSubscription
+	(*WebsocketGetSubscriptionsResponse)(nil),         // 166: gctrpc.WebsocketGetSubscriptionsResponse
+	(*WebsocketSetProxyRequest)(nil),                  // 167: gctrpc.WebsocketSetProxyRequest
+	(*WebsocketSetURLRequest)(nil),                    // 168: gctrpc.WebsocketSetURLRequest
+	(*FindMissingCandlePeriodsRequest)(nil),           // 169: gctrpc.FindMissingCandlePeriodsRequest
+	(*FindMissingTradePeriodsRequest)(nil),            // 170: gctrpc.FindMissingTradePeriodsRequest
+	(*FindMissingIntervalsResponse)(nil),              // 171: gctrpc.FindMissingIntervalsResponse
+	(*SetExchangeTradeProcessingRequest)(nil),         // 172: gctrpc.SetExchangeTradeProcessingRequest
+	(*UpsertDataHistoryJobRequest)(nil),               // 173: gctrpc.UpsertDataHistoryJobRequest
+	(*InsertSequentialJobsRequest)(nil),               // 174: gctrpc.InsertSequentialJobsRequest
+	(*InsertSequentialJobsResponse)(nil),              // 175: gctrpc.InsertSequentialJobsResponse
+	(*UpsertDataHistoryJobResponse)(nil),              // 176: gctrpc.UpsertDataHistoryJobResponse
+	(*GetDataHistoryJobDetailsRequest)(nil),           // 177: gctrpc.GetDataHistoryJobDetailsRequest
+	(*DataHistoryJob)(nil),                            // 178: gctrpc.DataHistoryJob
+	(*DataHistoryJobResult)(nil),                      // 179: gctrpc.DataHistoryJobResult
+	(*DataHistoryJobs)(nil),                           // 180: gctrpc.DataHistoryJobs
+	(*GetDataHistoryJobsBetweenRequest)(nil),          // 181: gctrpc.GetDataHistoryJobsBetweenRequest
+	(*SetDataHistoryJobStatusRequest)(nil),            // 182: gctrpc.SetDataHistoryJobStatusRequest
+	(*UpdateDataHistoryJobPrerequisiteRequest)(nil),   // 183: gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	(*ModifyOrderRequest)(nil),                        // 184: gctrpc.ModifyOrderRequest
+	(*ModifyOrderResponse)(nil),                       // 185: gctrpc.ModifyOrderResponse
+	(*CurrencyStateGetAllRequest)(nil),                // 186: gctrpc.CurrencyStateGetAllRequest
+	(*CurrencyStateTradingRequest)(nil),               // 187: gctrpc.CurrencyStateTradingRequest
+	(*CurrencyStateTradingPairRequest)(nil),           // 188: gctrpc.CurrencyStateTradingPairRequest
+	(*CurrencyStateWithdrawRequest)(nil),              // 189: gctrpc.CurrencyStateWithdrawRequest
+	(*CurrencyStateDepositRequest)(nil),               // 190: gctrpc.CurrencyStateDepositRequest
+	(*CurrencyStateResponse)(nil),                     // 191: gctrpc.CurrencyStateResponse
+	(*CurrencyState)(nil),                             // 192: gctrpc.CurrencyState
+	(*FundingRate)(nil),                               // 193: gctrpc.FundingRate
+	(*FundingData)(nil),                               // 194: gctrpc.FundingData
+	(*FuturesPositionStats)(nil),                      // 195: gctrpc.FuturesPositionStats
+	(*FuturePosition)(nil),                            // 196: gctrpc.FuturePosition
+	(*GetManagedPositionRequest)(nil),                 // 197: gctrpc.GetManagedPositionRequest
+	(*GetAllManagedPositionsRequest)(nil),             // 198: gctrpc.GetAllManagedPositionsRequest
+	(*GetManagedPositionsResponse)(nil),               // 199: gctrpc.GetManagedPositionsResponse
+	(*GetFuturesPositionsSummaryRequest)(nil),         // 200: gctrpc.GetFuturesPositionsSummaryRequest
+	(*GetFuturesPositionsSummaryResponse)(nil),        // 201: gctrpc.GetFuturesPositionsSummaryResponse
+	(*GetFuturesPositionsOrdersRequest)(nil),          // 202: gctrpc.GetFuturesPositionsOrdersRequest
+	(*GetFuturesPositionsOrdersResponse)(nil),         // 203: gctrpc.GetFuturesPositionsOrdersResponse
+	(*GetCollateralModeRequest)(nil),                  // 204: gctrpc.GetCollateralModeRequest
+	(*GetCollateralModeResponse)(nil),                 // 205: gctrpc.GetCollateralModeResponse
+	(*SetCollateralModeRequest)(nil),                  // 206: gctrpc.SetCollateralModeRequest
+	(*SetCollateralModeResponse)(nil),                 // 207: gctrpc.SetCollateralModeResponse
+	(*GetMarginTypeRequest)(nil),                      // 208: gctrpc.GetMarginTypeRequest
+	(*GetMarginTypeResponse)(nil),                     // 209: gctrpc.GetMarginTypeResponse
+	(*ChangePositionMarginRequest)(nil),               // 210: gctrpc.ChangePositionMarginRequest
+	(*ChangePositionMarginResponse)(nil),              // 211: gctrpc.ChangePositionMarginResponse
+	(*SetMarginTypeRequest)(nil),                      // 212: gctrpc.SetMarginTypeRequest
+	(*SetMarginTypeResponse)(nil),                     // 213: gctrpc.SetMarginTypeResponse
+	(*GetLeverageRequest)(nil),                        // 214: gctrpc.GetLeverageRequest
+	(*GetLeverageResponse)(nil),                       // 215: gctrpc.GetLeverageResponse
+	(*SetLeverageRequest)(nil),                        // 216: gctrpc.SetLeverageRequest
+	(*SetLeverageResponse)(nil),                       // 217: gctrpc.SetLeverageResponse
+	(*GetCollateralRequest)(nil),                      // 218: gctrpc.GetCollateralRequest
+	(*GetCollateralResponse)(nil),                     // 219: gctrpc.GetCollateralResponse
+	(*CollateralForCurrency)(nil),                     // 220: gctrpc.CollateralForCurrency
+	(*CollateralByPosition)(nil),                      // 221: gctrpc.CollateralByPosition
+	(*CollateralUsedBreakdown)(nil),                   // 222: gctrpc.CollateralUsedBreakdown
+	(*GetFundingRatesRequest)(nil),                    // 223: gctrpc.GetFundingRatesRequest
+	(*GetFundingRatesResponse)(nil),                   // 224: gctrpc.GetFundingRatesResponse
+	(*GetLatestFundingRateRequest)(nil),               // 225: gctrpc.GetLatestFundingRateRequest
+	(*GetLatestFundingRateResponse)(nil),              // 226: gctrpc.GetLatestFundingRateResponse
+	(*ShutdownRequest)(nil),                           // 227: gctrpc.ShutdownRequest
+	(*ShutdownResponse)(nil),                          // 228: gctrpc.ShutdownResponse
+	(*GetTechnicalAnalysisRequest)(nil),               // 229: gctrpc.GetTechnicalAnalysisRequest
+	(*ListOfSignals)(nil),                             // 230: gctrpc.ListOfSignals
+	(*GetTechnicalAnalysisResponse)(nil),              // 231: gctrpc.GetTechnicalAnalysisResponse
+	(*GetMarginRatesHistoryRequest)(nil),              // 232: gctrpc.GetMarginRatesHistoryRequest
+	(*LendingPayment)(nil),                            // 233: gctrpc.LendingPayment
+	(*BorrowCost)(nil),                                // 234: gctrpc.BorrowCost
+	(*MarginRate)(nil),                                // 235: gctrpc.MarginRate
+	(*GetMarginRatesHistoryResponse)(nil),             // 236: gctrpc.GetMarginRatesHistoryResponse
+	(*GetOrderbookMovementRequest)(nil),               // 237: gctrpc.GetOrderbookMovementRequest
+	(*GetOrderbookMovementResponse)(nil),              // 238: gctrpc.GetOrderbookMovementResponse
+	(*GetOrderbookAmountByNominalRequest)(nil),        // 239: gctrpc.GetOrderbookAmountByNominalRequest
+	(*GetOrderbookAmountByNominalResponse)(nil),       // 240: gctrpc.GetOrderbookAmountByNominalResponse
+	(*GetOrderbookAmountByImpactRequest)(nil),         // 241: gctrpc.GetOrderbookAmountByImpactRequest
+	(*GetOrderbookAmountByImpactResponse)(nil),        // 242: gctrpc.GetOrderbookAmountByImpactResponse
+	(*GetOpenInterestRequest)(nil),                    // 243: gctrpc.GetOpenInterestRequest
+	(*OpenInterestDataRequest)(nil),                   // 244: gctrpc.OpenInterestDataRequest
+	(*GetOpenInterestResponse)(nil),                   // 245: gctrpc.GetOpenInterestResponse
+	(*OpenInterestDataResponse)(nil),                  // 246: gctrpc.OpenInterestDataResponse
+	(*GetCurrencyTradeURLRequest)(nil),                // 247: gctrpc.GetCurrencyTradeURLRequest
+	(*GetCurrencyTradeURLResponse)(nil),               // 248: gctrpc.GetCurrencyTradeURLResponse
+	(*ConditionalOrder)(nil),                          // 249: gctrpc.ConditionalOrder
+	(*SubmitConditionalOrderRequest)(nil),             // 250: gctrpc.SubmitConditionalOrderRequest
+	(*ConditionalOrdersResponse)(nil),                 // 251: gctrpc.ConditionalOrdersResponse
+	(*GetConditionalOrdersRequest)(nil),               // 252: gctrpc.GetConditionalOrdersRequest
+	(*CancelConditionalOrderRequest)(nil),             // 253: gctrpc.CancelConditionalOrderRequest
+	(*SetRiskKillSwitchRequest)(nil),                  // 254: gctrpc.SetRiskKillSwitchRequest
+	(*GetRecordedOrderbookRequest)(nil),               // 255: gctrpc.GetRecordedOrderbookRequest
+	(*AlgoChildOrder)(nil),                            // 256: gctrpc.AlgoChildOrder
+	(*AlgoOrder)(nil),                                 // 257: gctrpc.AlgoOrder
+	(*SubmitAlgoOrderRequest)(nil),                    // 258: gctrpc.SubmitAlgoOrderRequest
+	(*AlgoOrdersResponse)(nil),                        // 259: gctrpc.AlgoOrdersResponse
+	(*GetAlgoOrdersRequest)(nil),                      // 260: gctrpc.GetAlgoOrdersRequest
+	(*CancelAlgoOrderRequest)(nil),                    // 261: gctrpc.CancelAlgoOrderRequest
+	(*GetCandleStreamRequest)(nil),                    // 262: gctrpc.GetCandleStreamRequest
+	nil,                                               // 263: gctrpc.GetInfoResponse.SubsystemStatusEntry
+	nil,                                               // 264: gctrpc.GetInfoResponse.RpcEndpointsEntry
+	nil,                                               // 265: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	nil,                                               // 266: gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
+	nil,                                               // 267: gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	nil,                                               // 268: gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	nil,                                               // 269: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	nil,                                               // 270: gctrpc.OnlineCoins.CoinsEntry
+	nil,                                               // 271: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	nil,                                               // 272: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	nil,                                               // 273: gctrpc.Orders.OrderStatusEntry
+	nil,                                               // 274: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	nil,                                               // 275: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	nil,                                               // 276: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	(*timestamppb.Timestamp)(nil),                     // 277: google.protobuf.Timestamp
 }
 var file_rpc_proto_depIdxs = []int32{
-	226, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
-	227, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
-	228, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
-	229, // 3: gctrpc.GetSusbsytemsResponse.subsystems_status:type_name -> gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
-	230, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
-	231, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
-	232, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
+	263, // 0: gctrpc.GetInfoResponse.subsystem_status:type_name -> gctrpc.GetInfoResponse.SubsystemStatusEntry
+	264, // 1: gctrpc.GetInfoResponse.rpc_endpoints:type_name -> gctrpc.GetInfoResponse.RpcEndpointsEntry
+	265, // 2: gctrpc.GetCommunicationRelayersResponse.communication_relayers:type_name -> gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry
+	266, // 3: gctrpc.GetSusbsytemsResponse.subsystems_status:type_name -> gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry
+	267, // 4: gctrpc.GetRPCEndpointsResponse.endpoints:type_name -> gctrpc.GetRPCEndpointsResponse.EndpointsEntry
+	268, // 5: gctrpc.GetExchangeOTPsResponse.otp_codes:type_name -> gctrpc.GetExchangeOTPsResponse.OtpCodesEntry
+	269, // 6: gctrpc.GetExchangeInfoResponse.supported_assets:type_name -> gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry
 	21,  // 7: gctrpc.GetTickerRequest.pair:type_name -> gctrpc.CurrencyPair
 	21,  // 8: gctrpc.TickerResponse.pair:type_name -> gctrpc.CurrencyPair
 	22,  // 9: gctrpc.Tickers.tickers:type_name -> gctrpc.TickerResponse
@@ -16761,379 +20141,445 @@ var file_rpc_proto_depIdxs = []int32{
 	28,  // 15: gctrpc.Orderbooks.orderbooks:type_name -> gctrpc.OrderbookResponse
 	30,  // 16: gctrpc.GetOrderbooksResponse.orderbooks:type_name -> gctrpc.Orderbooks
 	34,  // 17: gctrpc.Account.currencies:type_name -> gctrpc.AccountCurrencyInfo
-	240, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
+	277, // 18: gctrpc.AccountCurrencyInfo.updated_at:type_name -> google.protobuf.Timestamp
 	33,  // 19: gctrpc.GetAccountInfoResponse.accounts:type_name -> gctrpc.Account
 	38,  // 20: gctrpc.GetPortfolioResponse.portfolio:type_name -> gctrpc.PortfolioAddress
 	43,  // 21: gctrpc.OfflineCoins.addresses:type_name -> gctrpc.OfflineCoinSummary
-	233, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
+	270, // 22: gctrpc.OnlineCoins.coins:type_name -> gctrpc.OnlineCoins.CoinsEntry
 	42,  // 23: gctrpc.GetPortfolioSummaryResponse.coin_totals:type_name -> gctrpc.Coin
 	42,  // 24: gctrpc.GetPortfolioSummaryResponse.coins_offline:type_name -> gctrpc.Coin
-	234, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
+	271, // 25: gctrpc.GetPortfolioSummaryResponse.coins_offline_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry
 	42,  // 26: gctrpc.GetPortfolioSummaryResponse.coins_online:type_name -> gctrpc.Coin
-	235, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
-	51,  // 28: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
-	54,  // 29: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
-	57,  // 30: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
-	21,  // 31: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 32: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
-	21,  // 33: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 34: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	62,  // 35: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
-	21,  // 36: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	27,  // 37: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
-	21,  // 38: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 39: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 40: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	236, // 41: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
-	69,  // 42: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
-	69,  // 43: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
-	74,  // 44: gctrpc.GetEventsResponse.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 45: gctrpc.GetEventsResponse.pair:type_name -> gctrpc.CurrencyPair
-	74,  // 46: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
-	21,  // 47: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
-	80,  // 48: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
-	237, // 49: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
-	95,  // 50: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	95,  // 51: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
-	96,  // 52: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawlExchangeEvent
-	97,  // 53: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
-	240, // 54: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
-	240, // 55: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
-	98,  // 56: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
-	99,  // 57: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
-	238, // 58: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
-	21,  // 59: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
-	21,  // 60: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 61: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
-	119, // 62: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
-	21,  // 63: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 64: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
-	113, // 65: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
-	21,  // 66: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 67: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 68: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
-	118, // 69: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
-	120, // 70: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
-	120, // 71: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
-	120, // 72: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
-	120, // 73: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
-	120, // 74: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
-	120, // 75: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
-	142, // 76: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
-	21,  // 77: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 78: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 79: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 80: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
-	150, // 81: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
-	153, // 82: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
-	21,  // 83: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
-	156, // 84: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
-	155, // 85: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
-	21,  // 86: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
-	169, // 87: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
-	21,  // 88: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
-	170, // 89: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
-	170, // 90: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
-	170, // 91: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
-	21,  // 92: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
-	56,  // 93: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
-	172, // 94: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
-	171, // 95: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
-	21,  // 96: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
-	173, // 97: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 98: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 99: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 100: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
-	172, // 101: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
-	21,  // 102: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 103: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	173, // 104: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
-	21,  // 105: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 106: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 107: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 108: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 109: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 110: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 111: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 112: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 113: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 114: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 115: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 116: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
-	21,  // 117: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 118: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
-	199, // 119: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	197, // 120: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
-	198, // 121: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
-	199, // 122: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
-	21,  // 123: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 124: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
-	21,  // 125: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
-	171, // 126: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
-	21,  // 127: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
-	240, // 128: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
-	240, // 129: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
-	21,  // 130: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
-	239, // 131: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
-	212, // 132: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
-	210, // 133: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
-	211, // 134: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
-	212, // 135: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
-	212, // 136: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
-	212, // 137: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
-	21,  // 138: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 139: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 140: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
-	221, // 141: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
-	21,  // 142: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
-	223, // 143: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
-	21,  // 144: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
-	21,  // 145: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
-	9,   // 146: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	3,   // 147: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
-	9,   // 148: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
-	18,  // 149: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	44,  // 150: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
-	45,  // 151: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
-	46,  // 152: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
-	81,  // 153: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
-	18,  // 154: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
-	207, // 155: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
-	0,   // 156: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
-	6,   // 157: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
-	5,   // 158: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	5,   // 159: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
-	8,   // 160: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
-	2,   // 161: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
-	12,  // 162: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
-	11,  // 163: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 164: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
-	11,  // 165: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
-	15,  // 166: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
-	11,  // 167: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
-	20,  // 168: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
-	23,  // 169: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
-	26,  // 170: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
-	29,  // 171: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
-	32,  // 172: gctrpc.GoCryptoTraderService.GetAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
-	32,  // 173: gctrpc.GoCryptoTraderService.UpdateAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
-	32,  // 174: gctrpc.GoCryptoTraderService.GetAccountInfoStream:input_type -> gctrpc.GetAccountInfoRequest
-	36,  // 175: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
-	39,  // 176: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
-	41,  // 177: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
-	48,  // 178: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
-	49,  // 179: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
-	50,  // 180: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
-	53,  // 181: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
-	58,  // 182: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
-	60,  // 183: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
-	61,  // 184: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
-	64,  // 185: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
-	66,  // 186: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
-	67,  // 187: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
-	68,  // 188: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
-	71,  // 189: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
-	73,  // 190: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
-	76,  // 191: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
-	78,  // 192: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
-	79,  // 193: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
-	83,  // 194: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
-	85,  // 195: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
-	87,  // 196: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
-	88,  // 197: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
-	90,  // 198: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
-	92,  // 199: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
-	93,  // 200: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
-	100, // 201: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
-	102, // 202: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
-	103, // 203: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
-	105, // 204: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
-	106, // 205: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
-	107, // 206: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
-	108, // 207: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
-	109, // 208: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
-	110, // 209: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
-	121, // 210: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
-	126, // 211: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
-	127, // 212: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
-	124, // 213: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
-	128, // 214: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
-	122, // 215: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
-	123, // 216: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
-	125, // 217: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
-	129, // 218: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
-	116, // 219: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
-	133, // 220: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
-	134, // 221: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
-	135, // 222: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
-	136, // 223: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
-	138, // 224: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
-	140, // 225: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
-	141, // 226: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
-	144, // 227: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
-	145, // 228: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
-	112, // 229: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 230: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
-	112, // 231: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
-	115, // 232: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
-	146, // 233: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
-	147, // 234: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
-	149, // 235: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
-	150, // 236: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
-	154, // 237: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	0,   // 238: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
-	158, // 239: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
-	154, // 240: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
-	159, // 241: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
-	160, // 242: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
-	58,  // 243: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
-	161, // 244: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
-	163, // 245: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
-	164, // 246: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
-	167, // 247: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
-	166, // 248: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
-	165, // 249: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
-	177, // 250: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
-	179, // 251: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
-	195, // 252: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
-	204, // 253: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
-	206, // 254: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
-	209, // 255: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
-	174, // 256: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
-	175, // 257: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
-	200, // 258: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
-	202, // 259: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
-	214, // 260: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
-	216, // 261: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
-	218, // 262: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
-	181, // 263: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
-	191, // 264: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
-	183, // 265: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
-	189, // 266: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
-	193, // 267: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
-	187, // 268: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
-	220, // 269: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
-	224, // 270: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
-	1,   // 271: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
-	7,   // 272: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSusbsytemsResponse
-	132, // 273: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
-	132, // 274: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
-	10,  // 275: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
-	4,   // 276: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
-	13,  // 277: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
-	132, // 278: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
-	19,  // 279: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
-	14,  // 280: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
-	16,  // 281: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
-	132, // 282: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
-	22,  // 283: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
-	25,  // 284: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
-	28,  // 285: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
-	31,  // 286: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
-	35,  // 287: gctrpc.GoCryptoTraderService.GetAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
-	35,  // 288: gctrpc.GoCryptoTraderService.UpdateAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
-	35,  // 289: gctrpc.GoCryptoTraderService.GetAccountInfoStream:output_type -> gctrpc.GetAccountInfoResponse
-	37,  // 290: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
-	40,  // 291: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
-	47,  // 292: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
-	132, // 293: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
-	132, // 294: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
-	52,  // 295: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
-	55,  // 296: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
-	59,  // 297: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
-	56,  // 298: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
-	63,  // 299: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
-	65,  // 300: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
-	65,  // 301: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
-	132, // 302: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
-	70,  // 303: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
-	72,  // 304: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
-	75,  // 305: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
-	77,  // 306: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
-	132, // 307: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
-	82,  // 308: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
-	84,  // 309: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
-	86,  // 310: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
-	89,  // 311: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
-	89,  // 312: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
-	91,  // 313: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
-	94,  // 314: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	94,  // 315: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
-	101, // 316: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	101, // 317: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
-	104, // 318: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
-	132, // 319: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
-	28,  // 320: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	28,  // 321: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
-	22,  // 322: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
-	22,  // 323: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
-	111, // 324: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
-	132, // 325: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
-	132, // 326: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
-	131, // 327: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
-	130, // 328: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
-	131, // 329: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
-	132, // 330: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
-	132, // 331: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
-	130, // 332: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
-	132, // 333: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
-	117, // 334: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	132, // 335: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
-	132, // 336: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
-	132, // 337: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
-	137, // 338: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
-	139, // 339: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
-	132, // 340: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
-	143, // 341: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
-	132, // 342: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
-	132, // 343: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
-	114, // 344: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 345: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
-	114, // 346: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
-	117, // 347: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
-	148, // 348: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	148, // 349: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
-	132, // 350: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
-	153, // 351: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
-	155, // 352: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
-	157, // 353: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
-	157, // 354: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
-	155, // 355: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
-	132, // 356: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
-	132, // 357: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
-	59,  // 358: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
-	162, // 359: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
-	168, // 360: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
-	132, // 361: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
-	132, // 362: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
-	132, // 363: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
-	132, // 364: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
-	178, // 365: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
-	180, // 366: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
-	196, // 367: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
-	205, // 368: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
-	208, // 369: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
-	213, // 370: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
-	176, // 371: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
-	176, // 372: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
-	201, // 373: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
-	203, // 374: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
-	215, // 375: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
-	217, // 376: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
-	219, // 377: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
-	182, // 378: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
-	192, // 379: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
-	184, // 380: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
-	190, // 381: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
-	194, // 382: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
-	188, // 383: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
-	222, // 384: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
-	225, // 385: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
-	271, // [271:386] is the sub-list for method output_type
-	156, // [156:271] is the sub-list for method input_type
-	156, // [156:156] is the sub-list for extension type_name
-	156, // [156:156] is the sub-list for extension extendee
-	0,   // [0:156] is the sub-list for field type_name
+	272, // 27: gctrpc.GetPortfolioSummaryResponse.coins_online_summary:type_name -> gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry
+	50,  // 28: gctrpc.PortfolioValuation.exchanges:type_name -> gctrpc.PortfolioExchangeValuation
+	49,  // 29: gctrpc.PortfolioValuation.holdings:type_name -> gctrpc.PortfolioValuationHolding
+	51,  // 30: gctrpc.GetPortfolioValuationHistoryResponse.valuations:type_name -> gctrpc.PortfolioValuation
+	53,  // 31: gctrpc.GetPortfolioValuationHistoryResponse.currency_changes:type_name -> gctrpc.PortfolioCurrencyValueChange
+	56,  // 32: gctrpc.GetCostBasisReportResponse.gains:type_name -> gctrpc.CostBasisGain
+	57,  // 33: gctrpc.GetCostBasisReportResponse.lots:type_name -> gctrpc.CostBasisLot
+	62,  // 34: gctrpc.GetForexProvidersResponse.forex_providers:type_name -> gctrpc.ForexProvider
+	65,  // 35: gctrpc.GetForexRatesResponse.forex_rates:type_name -> gctrpc.ForexRatesConversion
+	68,  // 36: gctrpc.OrderDetails.trades:type_name -> gctrpc.TradeHistory
+	21,  // 37: gctrpc.GetOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	67,  // 38: gctrpc.GetOrdersResponse.orders:type_name -> gctrpc.OrderDetails
+	21,  // 39: gctrpc.GetOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 40: gctrpc.SubmitOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	73,  // 41: gctrpc.SubmitOrderResponse.trades:type_name -> gctrpc.Trades
+	21,  // 42: gctrpc.SimulateOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	27,  // 43: gctrpc.SimulateOrderResponse.orders:type_name -> gctrpc.OrderbookItem
+	21,  // 44: gctrpc.SimulateSmartOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 45: gctrpc.SimulateSmartOrderResponse.pair:type_name -> gctrpc.CurrencyPair
+	78,  // 46: gctrpc.SimulateSmartOrderResponse.orders:type_name -> gctrpc.SmartOrderRoute
+	79,  // 47: gctrpc.SimulateSmartOrderResponse.rejections:type_name -> gctrpc.SmartOrderRejection
+	21,  // 48: gctrpc.WhaleBombRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 49: gctrpc.CancelOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 50: gctrpc.CancelBatchOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	273, // 51: gctrpc.Orders.order_status:type_name -> gctrpc.Orders.OrderStatusEntry
+	84,  // 52: gctrpc.CancelBatchOrdersResponse.orders:type_name -> gctrpc.Orders
+	84,  // 53: gctrpc.CancelAllOrdersResponse.orders:type_name -> gctrpc.Orders
+	89,  // 54: gctrpc.EventRule.condition_params:type_name -> gctrpc.ConditionParams
+	90,  // 55: gctrpc.EventRule.rules:type_name -> gctrpc.EventRule
+	90,  // 56: gctrpc.Event.rule:type_name -> gctrpc.EventRule
+	21,  // 57: gctrpc.Event.pair:type_name -> gctrpc.CurrencyPair
+	91,  // 58: gctrpc.Event.actions:type_name -> gctrpc.EventAction
+	277, // 59: gctrpc.Event.last_triggered:type_name -> google.protobuf.Timestamp
+	92,  // 60: gctrpc.GetEventsResponse.events:type_name -> gctrpc.Event
+	89,  // 61: gctrpc.AddEventRequest.condition_params:type_name -> gctrpc.ConditionParams
+	21,  // 62: gctrpc.AddEventRequest.pair:type_name -> gctrpc.CurrencyPair
+	90,  // 63: gctrpc.AddEventRequest.rule:type_name -> gctrpc.EventRule
+	91,  // 64: gctrpc.AddEventRequest.actions:type_name -> gctrpc.EventAction
+	98,  // 65: gctrpc.DepositAddresses.addresses:type_name -> gctrpc.DepositAddress
+	274, // 66: gctrpc.GetCryptocurrencyDepositAddressesResponse.addresses:type_name -> gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry
+	113, // 67: gctrpc.WithdrawalEventByIDResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	113, // 68: gctrpc.WithdrawalEventsByExchangeResponse.event:type_name -> gctrpc.WithdrawalEventResponse
+	114, // 69: gctrpc.WithdrawalEventResponse.exchange:type_name -> gctrpc.WithdrawlExchangeEvent
+	115, // 70: gctrpc.WithdrawalEventResponse.request:type_name -> gctrpc.WithdrawalRequestEvent
+	277, // 71: gctrpc.WithdrawalEventResponse.created_at:type_name -> google.protobuf.Timestamp
+	277, // 72: gctrpc.WithdrawalEventResponse.updated_at:type_name -> google.protobuf.Timestamp
+	116, // 73: gctrpc.WithdrawalRequestEvent.fiat:type_name -> gctrpc.FiatWithdrawalEvent
+	117, // 74: gctrpc.WithdrawalRequestEvent.crypto:type_name -> gctrpc.CryptoWithdrawalEvent
+	277, // 75: gctrpc.PendingWithdrawal.created_at:type_name -> google.protobuf.Timestamp
+	277, // 76: gctrpc.PendingWithdrawal.expires_at:type_name -> google.protobuf.Timestamp
+	119, // 77: gctrpc.GetPendingWithdrawalsResponse.withdrawals:type_name -> gctrpc.PendingWithdrawal
+	275, // 78: gctrpc.GetExchangePairsResponse.supported_assets:type_name -> gctrpc.GetExchangePairsResponse.SupportedAssetsEntry
+	21,  // 79: gctrpc.SetExchangePairRequest.pairs:type_name -> gctrpc.CurrencyPair
+	21,  // 80: gctrpc.GetOrderbookStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 81: gctrpc.GetTickerStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	142, // 82: gctrpc.GetAuditEventResponse.events:type_name -> gctrpc.AuditEvent
+	21,  // 83: gctrpc.GetSavedTradesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 84: gctrpc.SavedTradesResponse.pair:type_name -> gctrpc.CurrencyPair
+	136, // 85: gctrpc.SavedTradesResponse.trades:type_name -> gctrpc.SavedTrades
+	21,  // 86: gctrpc.ConvertTradesToCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 87: gctrpc.GetHistoricCandlesRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 88: gctrpc.GetHistoricCandlesResponse.pair:type_name -> gctrpc.CurrencyPair
+	141, // 89: gctrpc.GetHistoricCandlesResponse.candle:type_name -> gctrpc.Candle
+	143, // 90: gctrpc.GCTScriptExecuteRequest.script:type_name -> gctrpc.GCTScript
+	143, // 91: gctrpc.GCTScriptStopRequest.script:type_name -> gctrpc.GCTScript
+	143, // 92: gctrpc.GCTScriptReadScriptRequest.script:type_name -> gctrpc.GCTScript
+	143, // 93: gctrpc.GCTScriptQueryRequest.script:type_name -> gctrpc.GCTScript
+	143, // 94: gctrpc.GCTScriptStatusResponse.scripts:type_name -> gctrpc.GCTScript
+	143, // 95: gctrpc.GCTScriptQueryResponse.script:type_name -> gctrpc.GCTScript
+	165, // 96: gctrpc.WebsocketGetSubscriptionsResponse.subscriptions:type_name -> gctrpc.WebsocketSubscription
+	21,  // 97: gctrpc.FindMissingCandlePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 98: gctrpc.FindMissingTradePeriodsRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 99: gctrpc.FindMissingIntervalsResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 100: gctrpc.UpsertDataHistoryJobRequest.pair:type_name -> gctrpc.CurrencyPair
+	173, // 101: gctrpc.InsertSequentialJobsRequest.jobs:type_name -> gctrpc.UpsertDataHistoryJobRequest
+	176, // 102: gctrpc.InsertSequentialJobsResponse.jobs:type_name -> gctrpc.UpsertDataHistoryJobResponse
+	21,  // 103: gctrpc.DataHistoryJob.pair:type_name -> gctrpc.CurrencyPair
+	179, // 104: gctrpc.DataHistoryJob.job_results:type_name -> gctrpc.DataHistoryJobResult
+	178, // 105: gctrpc.DataHistoryJobs.results:type_name -> gctrpc.DataHistoryJob
+	21,  // 106: gctrpc.ModifyOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	192, // 107: gctrpc.CurrencyStateResponse.currency_states:type_name -> gctrpc.CurrencyState
+	21,  // 108: gctrpc.FundingData.pair:type_name -> gctrpc.CurrencyPair
+	193, // 109: gctrpc.FundingData.rates:type_name -> gctrpc.FundingRate
+	193, // 110: gctrpc.FundingData.latest_rate:type_name -> gctrpc.FundingRate
+	193, // 111: gctrpc.FundingData.upcoming_rate:type_name -> gctrpc.FundingRate
+	21,  // 112: gctrpc.FuturePosition.pair:type_name -> gctrpc.CurrencyPair
+	67,  // 113: gctrpc.FuturePosition.orders:type_name -> gctrpc.OrderDetails
+	195, // 114: gctrpc.FuturePosition.position_stats:type_name -> gctrpc.FuturesPositionStats
+	194, // 115: gctrpc.FuturePosition.funding_data:type_name -> gctrpc.FundingData
+	21,  // 116: gctrpc.GetManagedPositionRequest.pair:type_name -> gctrpc.CurrencyPair
+	196, // 117: gctrpc.GetManagedPositionsResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 118: gctrpc.GetFuturesPositionsSummaryRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 119: gctrpc.GetFuturesPositionsSummaryRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 120: gctrpc.GetFuturesPositionsSummaryResponse.pair:type_name -> gctrpc.CurrencyPair
+	195, // 121: gctrpc.GetFuturesPositionsSummaryResponse.position_stats:type_name -> gctrpc.FuturesPositionStats
+	21,  // 122: gctrpc.GetFuturesPositionsOrdersRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 123: gctrpc.GetFuturesPositionsOrdersRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	196, // 124: gctrpc.GetFuturesPositionsOrdersResponse.positions:type_name -> gctrpc.FuturePosition
+	21,  // 125: gctrpc.GetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 126: gctrpc.GetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 127: gctrpc.ChangePositionMarginRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 128: gctrpc.ChangePositionMarginResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 129: gctrpc.SetMarginTypeRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 130: gctrpc.SetMarginTypeResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 131: gctrpc.GetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 132: gctrpc.GetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 133: gctrpc.GetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 134: gctrpc.GetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 135: gctrpc.SetLeverageRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 136: gctrpc.SetLeverageRequest.underlying_pair:type_name -> gctrpc.CurrencyPair
+	21,  // 137: gctrpc.SetLeverageResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 138: gctrpc.SetLeverageResponse.underlying_pair:type_name -> gctrpc.CurrencyPair
+	222, // 139: gctrpc.GetCollateralResponse.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	220, // 140: gctrpc.GetCollateralResponse.currency_breakdown:type_name -> gctrpc.CollateralForCurrency
+	221, // 141: gctrpc.GetCollateralResponse.position_breakdown:type_name -> gctrpc.CollateralByPosition
+	222, // 142: gctrpc.CollateralForCurrency.used_breakdown:type_name -> gctrpc.CollateralUsedBreakdown
+	21,  // 143: gctrpc.GetFundingRatesRequest.pair:type_name -> gctrpc.CurrencyPair
+	194, // 144: gctrpc.GetFundingRatesResponse.rates:type_name -> gctrpc.FundingData
+	21,  // 145: gctrpc.GetLatestFundingRateRequest.pair:type_name -> gctrpc.CurrencyPair
+	194, // 146: gctrpc.GetLatestFundingRateResponse.rate:type_name -> gctrpc.FundingData
+	21,  // 147: gctrpc.GetTechnicalAnalysisRequest.pair:type_name -> gctrpc.CurrencyPair
+	277, // 148: gctrpc.GetTechnicalAnalysisRequest.start:type_name -> google.protobuf.Timestamp
+	277, // 149: gctrpc.GetTechnicalAnalysisRequest.end:type_name -> google.protobuf.Timestamp
+	21,  // 150: gctrpc.GetTechnicalAnalysisRequest.other_pair:type_name -> gctrpc.CurrencyPair
+	276, // 151: gctrpc.GetTechnicalAnalysisResponse.signals:type_name -> gctrpc.GetTechnicalAnalysisResponse.SignalsEntry
+	235, // 152: gctrpc.GetMarginRatesHistoryRequest.rates:type_name -> gctrpc.MarginRate
+	233, // 153: gctrpc.MarginRate.lending_payment:type_name -> gctrpc.LendingPayment
+	234, // 154: gctrpc.MarginRate.borrow_cost:type_name -> gctrpc.BorrowCost
+	235, // 155: gctrpc.GetMarginRatesHistoryResponse.rates:type_name -> gctrpc.MarginRate
+	235, // 156: gctrpc.GetMarginRatesHistoryResponse.latest_rate:type_name -> gctrpc.MarginRate
+	235, // 157: gctrpc.GetMarginRatesHistoryResponse.predicted_rate:type_name -> gctrpc.MarginRate
+	21,  // 158: gctrpc.GetOrderbookMovementRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 159: gctrpc.GetOrderbookAmountByNominalRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 160: gctrpc.GetOrderbookAmountByImpactRequest.pair:type_name -> gctrpc.CurrencyPair
+	244, // 161: gctrpc.GetOpenInterestRequest.data:type_name -> gctrpc.OpenInterestDataRequest
+	21,  // 162: gctrpc.OpenInterestDataRequest.pair:type_name -> gctrpc.CurrencyPair
+	246, // 163: gctrpc.GetOpenInterestResponse.data:type_name -> gctrpc.OpenInterestDataResponse
+	21,  // 164: gctrpc.OpenInterestDataResponse.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 165: gctrpc.GetCurrencyTradeURLRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 166: gctrpc.ConditionalOrder.pair:type_name -> gctrpc.CurrencyPair
+	277, // 167: gctrpc.ConditionalOrder.created_at:type_name -> google.protobuf.Timestamp
+	277, // 168: gctrpc.ConditionalOrder.updated_at:type_name -> google.protobuf.Timestamp
+	277, // 169: gctrpc.ConditionalOrder.triggered_at:type_name -> google.protobuf.Timestamp
+	21,  // 170: gctrpc.SubmitConditionalOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	249, // 171: gctrpc.ConditionalOrdersResponse.orders:type_name -> gctrpc.ConditionalOrder
+	21,  // 172: gctrpc.GetRecordedOrderbookRequest.pair:type_name -> gctrpc.CurrencyPair
+	21,  // 173: gctrpc.AlgoOrder.pair:type_name -> gctrpc.CurrencyPair
+	256, // 174: gctrpc.AlgoOrder.child_orders:type_name -> gctrpc.AlgoChildOrder
+	277, // 175: gctrpc.AlgoOrder.created_at:type_name -> google.protobuf.Timestamp
+	277, // 176: gctrpc.AlgoOrder.updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 177: gctrpc.SubmitAlgoOrderRequest.pair:type_name -> gctrpc.CurrencyPair
+	257, // 178: gctrpc.AlgoOrdersResponse.orders:type_name -> gctrpc.AlgoOrder
+	21,  // 179: gctrpc.GetCandleStreamRequest.pair:type_name -> gctrpc.CurrencyPair
+	9,   // 180: gctrpc.GetInfoResponse.RpcEndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	3,   // 181: gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry.value:type_name -> gctrpc.CommunicationRelayer
+	9,   // 182: gctrpc.GetRPCEndpointsResponse.EndpointsEntry.value:type_name -> gctrpc.RPCEndpoint
+	18,  // 183: gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	44,  // 184: gctrpc.OnlineCoins.CoinsEntry.value:type_name -> gctrpc.OnlineCoinSummary
+	45,  // 185: gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry.value:type_name -> gctrpc.OfflineCoins
+	46,  // 186: gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry.value:type_name -> gctrpc.OnlineCoins
+	99,  // 187: gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry.value:type_name -> gctrpc.DepositAddresses
+	18,  // 188: gctrpc.GetExchangePairsResponse.SupportedAssetsEntry.value:type_name -> gctrpc.PairsSupported
+	230, // 189: gctrpc.GetTechnicalAnalysisResponse.SignalsEntry.value:type_name -> gctrpc.ListOfSignals
+	0,   // 190: gctrpc.GoCryptoTraderService.GetInfo:input_type -> gctrpc.GetInfoRequest
+	6,   // 191: gctrpc.GoCryptoTraderService.GetSubsystems:input_type -> gctrpc.GetSubsystemsRequest
+	5,   // 192: gctrpc.GoCryptoTraderService.EnableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	5,   // 193: gctrpc.GoCryptoTraderService.DisableSubsystem:input_type -> gctrpc.GenericSubsystemRequest
+	8,   // 194: gctrpc.GoCryptoTraderService.GetRPCEndpoints:input_type -> gctrpc.GetRPCEndpointsRequest
+	2,   // 195: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:input_type -> gctrpc.GetCommunicationRelayersRequest
+	12,  // 196: gctrpc.GoCryptoTraderService.GetExchanges:input_type -> gctrpc.GetExchangesRequest
+	11,  // 197: gctrpc.GoCryptoTraderService.DisableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 198: gctrpc.GoCryptoTraderService.GetExchangeInfo:input_type -> gctrpc.GenericExchangeNameRequest
+	11,  // 199: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:input_type -> gctrpc.GenericExchangeNameRequest
+	15,  // 200: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:input_type -> gctrpc.GetExchangeOTPsRequest
+	11,  // 201: gctrpc.GoCryptoTraderService.EnableExchange:input_type -> gctrpc.GenericExchangeNameRequest
+	20,  // 202: gctrpc.GoCryptoTraderService.GetTicker:input_type -> gctrpc.GetTickerRequest
+	23,  // 203: gctrpc.GoCryptoTraderService.GetTickers:input_type -> gctrpc.GetTickersRequest
+	26,  // 204: gctrpc.GoCryptoTraderService.GetOrderbook:input_type -> gctrpc.GetOrderbookRequest
+	29,  // 205: gctrpc.GoCryptoTraderService.GetOrderbooks:input_type -> gctrpc.GetOrderbooksRequest
+	32,  // 206: gctrpc.GoCryptoTraderService.GetAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
+	32,  // 207: gctrpc.GoCryptoTraderService.UpdateAccountInfo:input_type -> gctrpc.GetAccountInfoRequest
+	32,  // 208: gctrpc.GoCryptoTraderService.GetAccountInfoStream:input_type -> gctrpc.GetAccountInfoRequest
+	36,  // 209: gctrpc.GoCryptoTraderService.GetConfig:input_type -> gctrpc.GetConfigRequest
+	39,  // 210: gctrpc.GoCryptoTraderService.GetPortfolio:input_type -> gctrpc.GetPortfolioRequest
+	41,  // 211: gctrpc.GoCryptoTraderService.GetPortfolioSummary:input_type -> gctrpc.GetPortfolioSummaryRequest
+	59,  // 212: gctrpc.GoCryptoTraderService.AddPortfolioAddress:input_type -> gctrpc.AddPortfolioAddressRequest
+	60,  // 213: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:input_type -> gctrpc.RemovePortfolioAddressRequest
+	61,  // 214: gctrpc.GoCryptoTraderService.GetForexProviders:input_type -> gctrpc.GetForexProvidersRequest
+	64,  // 215: gctrpc.GoCryptoTraderService.GetForexRates:input_type -> gctrpc.GetForexRatesRequest
+	69,  // 216: gctrpc.GoCryptoTraderService.GetOrders:input_type -> gctrpc.GetOrdersRequest
+	71,  // 217: gctrpc.GoCryptoTraderService.GetOrder:input_type -> gctrpc.GetOrderRequest
+	72,  // 218: gctrpc.GoCryptoTraderService.SubmitOrder:input_type -> gctrpc.SubmitOrderRequest
+	75,  // 219: gctrpc.GoCryptoTraderService.SimulateOrder:input_type -> gctrpc.SimulateOrderRequest
+	77,  // 220: gctrpc.GoCryptoTraderService.SimulateSmartOrder:input_type -> gctrpc.SimulateSmartOrderRequest
+	81,  // 221: gctrpc.GoCryptoTraderService.WhaleBomb:input_type -> gctrpc.WhaleBombRequest
+	82,  // 222: gctrpc.GoCryptoTraderService.CancelOrder:input_type -> gctrpc.CancelOrderRequest
+	83,  // 223: gctrpc.GoCryptoTraderService.CancelBatchOrders:input_type -> gctrpc.CancelBatchOrdersRequest
+	86,  // 224: gctrpc.GoCryptoTraderService.CancelAllOrders:input_type -> gctrpc.CancelAllOrdersRequest
+	88,  // 225: gctrpc.GoCryptoTraderService.GetEvents:input_type -> gctrpc.GetEventsRequest
+	94,  // 226: gctrpc.GoCryptoTraderService.AddEvent:input_type -> gctrpc.AddEventRequest
+	96,  // 227: gctrpc.GoCryptoTraderService.RemoveEvent:input_type -> gctrpc.RemoveEventRequest
+	97,  // 228: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:input_type -> gctrpc.GetCryptocurrencyDepositAddressesRequest
+	101, // 229: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:input_type -> gctrpc.GetCryptocurrencyDepositAddressRequest
+	103, // 230: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:input_type -> gctrpc.GetAvailableTransferChainsRequest
+	105, // 231: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:input_type -> gctrpc.WithdrawFiatRequest
+	106, // 232: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:input_type -> gctrpc.WithdrawCryptoRequest
+	108, // 233: gctrpc.GoCryptoTraderService.WithdrawalEventByID:input_type -> gctrpc.WithdrawalEventByIDRequest
+	110, // 234: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:input_type -> gctrpc.WithdrawalEventsByExchangeRequest
+	111, // 235: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:input_type -> gctrpc.WithdrawalEventsByDateRequest
+	123, // 236: gctrpc.GoCryptoTraderService.GetLoggerDetails:input_type -> gctrpc.GetLoggerDetailsRequest
+	125, // 237: gctrpc.GoCryptoTraderService.SetLoggerDetails:input_type -> gctrpc.SetLoggerDetailsRequest
+	126, // 238: gctrpc.GoCryptoTraderService.GetExchangePairs:input_type -> gctrpc.GetExchangePairsRequest
+	128, // 239: gctrpc.GoCryptoTraderService.SetExchangePair:input_type -> gctrpc.SetExchangePairRequest
+	129, // 240: gctrpc.GoCryptoTraderService.GetOrderbookStream:input_type -> gctrpc.GetOrderbookStreamRequest
+	130, // 241: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:input_type -> gctrpc.GetExchangeOrderbookStreamRequest
+	131, // 242: gctrpc.GoCryptoTraderService.GetTickerStream:input_type -> gctrpc.GetTickerStreamRequest
+	132, // 243: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:input_type -> gctrpc.GetExchangeTickerStreamRequest
+	133, // 244: gctrpc.GoCryptoTraderService.GetAuditEvent:input_type -> gctrpc.GetAuditEventRequest
+	144, // 245: gctrpc.GoCryptoTraderService.GCTScriptExecute:input_type -> gctrpc.GCTScriptExecuteRequest
+	149, // 246: gctrpc.GoCryptoTraderService.GCTScriptUpload:input_type -> gctrpc.GCTScriptUploadRequest
+	150, // 247: gctrpc.GoCryptoTraderService.GCTScriptReadScript:input_type -> gctrpc.GCTScriptReadScriptRequest
+	147, // 248: gctrpc.GoCryptoTraderService.GCTScriptStatus:input_type -> gctrpc.GCTScriptStatusRequest
+	151, // 249: gctrpc.GoCryptoTraderService.GCTScriptQuery:input_type -> gctrpc.GCTScriptQueryRequest
+	145, // 250: gctrpc.GoCryptoTraderService.GCTScriptStop:input_type -> gctrpc.GCTScriptStopRequest
+	146, // 251: gctrpc.GoCryptoTraderService.GCTScriptStopAll:input_type -> gctrpc.GCTScriptStopAllRequest
+	148, // 252: gctrpc.GoCryptoTraderService.GCTScriptListAll:input_type -> gctrpc.GCTScriptListAllRequest
+	152, // 253: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:input_type -> gctrpc.GCTScriptAutoLoadRequest
+	139, // 254: gctrpc.GoCryptoTraderService.GetHistoricCandles:input_type -> gctrpc.GetHistoricCandlesRequest
+	156, // 255: gctrpc.GoCryptoTraderService.SetExchangeAsset:input_type -> gctrpc.SetExchangeAssetRequest
+	157, // 256: gctrpc.GoCryptoTraderService.SetAllExchangePairs:input_type -> gctrpc.SetExchangeAllPairsRequest
+	158, // 257: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:input_type -> gctrpc.UpdateExchangeSupportedPairsRequest
+	159, // 258: gctrpc.GoCryptoTraderService.GetExchangeAssets:input_type -> gctrpc.GetExchangeAssetsRequest
+	161, // 259: gctrpc.GoCryptoTraderService.WebsocketGetInfo:input_type -> gctrpc.WebsocketGetInfoRequest
+	163, // 260: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:input_type -> gctrpc.WebsocketSetEnabledRequest
+	164, // 261: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:input_type -> gctrpc.WebsocketGetSubscriptionsRequest
+	167, // 262: gctrpc.GoCryptoTraderService.WebsocketSetProxy:input_type -> gctrpc.WebsocketSetProxyRequest
+	168, // 263: gctrpc.GoCryptoTraderService.WebsocketSetURL:input_type -> gctrpc.WebsocketSetURLRequest
+	135, // 264: gctrpc.GoCryptoTraderService.GetRecentTrades:input_type -> gctrpc.GetSavedTradesRequest
+	135, // 265: gctrpc.GoCryptoTraderService.GetHistoricTrades:input_type -> gctrpc.GetSavedTradesRequest
+	135, // 266: gctrpc.GoCryptoTraderService.GetSavedTrades:input_type -> gctrpc.GetSavedTradesRequest
+	138, // 267: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:input_type -> gctrpc.ConvertTradesToCandlesRequest
+	169, // 268: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:input_type -> gctrpc.FindMissingCandlePeriodsRequest
+	170, // 269: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:input_type -> gctrpc.FindMissingTradePeriodsRequest
+	172, // 270: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:input_type -> gctrpc.SetExchangeTradeProcessingRequest
+	173, // 271: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:input_type -> gctrpc.UpsertDataHistoryJobRequest
+	177, // 272: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	0,   // 273: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:input_type -> gctrpc.GetInfoRequest
+	181, // 274: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:input_type -> gctrpc.GetDataHistoryJobsBetweenRequest
+	177, // 275: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:input_type -> gctrpc.GetDataHistoryJobDetailsRequest
+	182, // 276: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:input_type -> gctrpc.SetDataHistoryJobStatusRequest
+	183, // 277: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:input_type -> gctrpc.UpdateDataHistoryJobPrerequisiteRequest
+	69,  // 278: gctrpc.GoCryptoTraderService.GetManagedOrders:input_type -> gctrpc.GetOrdersRequest
+	184, // 279: gctrpc.GoCryptoTraderService.ModifyOrder:input_type -> gctrpc.ModifyOrderRequest
+	186, // 280: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:input_type -> gctrpc.CurrencyStateGetAllRequest
+	187, // 281: gctrpc.GoCryptoTraderService.CurrencyStateTrading:input_type -> gctrpc.CurrencyStateTradingRequest
+	190, // 282: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:input_type -> gctrpc.CurrencyStateDepositRequest
+	189, // 283: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:input_type -> gctrpc.CurrencyStateWithdrawRequest
+	188, // 284: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:input_type -> gctrpc.CurrencyStateTradingPairRequest
+	200, // 285: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:input_type -> gctrpc.GetFuturesPositionsSummaryRequest
+	202, // 286: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:input_type -> gctrpc.GetFuturesPositionsOrdersRequest
+	218, // 287: gctrpc.GoCryptoTraderService.GetCollateral:input_type -> gctrpc.GetCollateralRequest
+	227, // 288: gctrpc.GoCryptoTraderService.Shutdown:input_type -> gctrpc.ShutdownRequest
+	229, // 289: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:input_type -> gctrpc.GetTechnicalAnalysisRequest
+	232, // 290: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:input_type -> gctrpc.GetMarginRatesHistoryRequest
+	197, // 291: gctrpc.GoCryptoTraderService.GetManagedPosition:input_type -> gctrpc.GetManagedPositionRequest
+	198, // 292: gctrpc.GoCryptoTraderService.GetAllManagedPositions:input_type -> gctrpc.GetAllManagedPositionsRequest
+	223, // 293: gctrpc.GoCryptoTraderService.GetFundingRates:input_type -> gctrpc.GetFundingRatesRequest
+	225, // 294: gctrpc.GoCryptoTraderService.GetLatestFundingRate:input_type -> gctrpc.GetLatestFundingRateRequest
+	237, // 295: gctrpc.GoCryptoTraderService.GetOrderbookMovement:input_type -> gctrpc.GetOrderbookMovementRequest
+	239, // 296: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:input_type -> gctrpc.GetOrderbookAmountByNominalRequest
+	241, // 297: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:input_type -> gctrpc.GetOrderbookAmountByImpactRequest
+	204, // 298: gctrpc.GoCryptoTraderService.GetCollateralMode:input_type -> gctrpc.GetCollateralModeRequest
+	214, // 299: gctrpc.GoCryptoTraderService.GetLeverage:input_type -> gctrpc.GetLeverageRequest
+	206, // 300: gctrpc.GoCryptoTraderService.SetCollateralMode:input_type -> gctrpc.SetCollateralModeRequest
+	212, // 301: gctrpc.GoCryptoTraderService.SetMarginType:input_type -> gctrpc.SetMarginTypeRequest
+	216, // 302: gctrpc.GoCryptoTraderService.SetLeverage:input_type -> gctrpc.SetLeverageRequest
+	210, // 303: gctrpc.GoCryptoTraderService.ChangePositionMargin:input_type -> gctrpc.ChangePositionMarginRequest
+	243, // 304: gctrpc.GoCryptoTraderService.GetOpenInterest:input_type -> gctrpc.GetOpenInterestRequest
+	247, // 305: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:input_type -> gctrpc.GetCurrencyTradeURLRequest
+	250, // 306: gctrpc.GoCryptoTraderService.SubmitConditionalOrder:input_type -> gctrpc.SubmitConditionalOrderRequest
+	252, // 307: gctrpc.GoCryptoTraderService.GetConditionalOrders:input_type -> gctrpc.GetConditionalOrdersRequest
+	253, // 308: gctrpc.GoCryptoTraderService.CancelConditionalOrder:input_type -> gctrpc.CancelConditionalOrderRequest
+	254, // 309: gctrpc.GoCryptoTraderService.SetRiskKillSwitch:input_type -> gctrpc.SetRiskKillSwitchRequest
+	255, // 310: gctrpc.GoCryptoTraderService.GetRecordedOrderbook:input_type -> gctrpc.GetRecordedOrderbookRequest
+	258, // 311: gctrpc.GoCryptoTraderService.SubmitAlgoOrder:input_type -> gctrpc.SubmitAlgoOrderRequest
+	260, // 312: gctrpc.GoCryptoTraderService.GetAlgoOrders:input_type -> gctrpc.GetAlgoOrdersRequest
+	261, // 313: gctrpc.GoCryptoTraderService.CancelAlgoOrder:input_type -> gctrpc.CancelAlgoOrderRequest
+	262, // 314: gctrpc.GoCryptoTraderService.GetCandleStream:input_type -> gctrpc.GetCandleStreamRequest
+	48,  // 315: gctrpc.GoCryptoTraderService.GetPortfolioValuation:input_type -> gctrpc.GetPortfolioValuationRequest
+	52,  // 316: gctrpc.GoCryptoTraderService.GetPortfolioValuationHistory:input_type -> gctrpc.GetPortfolioValuationHistoryRequest
+	55,  // 317: gctrpc.GoCryptoTraderService.GetCostBasisReport:input_type -> gctrpc.GetCostBasisReportRequest
+	118, // 318: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:input_type -> gctrpc.GetPendingWithdrawalsRequest
+	121, // 319: gctrpc.GoCryptoTraderService.ApproveWithdrawal:input_type -> gctrpc.ApproveWithdrawalRequest
+	122, // 320: gctrpc.GoCryptoTraderService.RejectWithdrawal:input_type -> gctrpc.RejectWithdrawalRequest
+	1,   // 321: gctrpc.GoCryptoTraderService.GetInfo:output_type -> gctrpc.GetInfoResponse
+	7,   // 322: gctrpc.GoCryptoTraderService.GetSubsystems:output_type -> gctrpc.GetSusbsytemsResponse
+	155, // 323: gctrpc.GoCryptoTraderService.EnableSubsystem:output_type -> gctrpc.GenericResponse
+	155, // 324: gctrpc.GoCryptoTraderService.DisableSubsystem:output_type -> gctrpc.GenericResponse
+	10,  // 325: gctrpc.GoCryptoTraderService.GetRPCEndpoints:output_type -> gctrpc.GetRPCEndpointsResponse
+	4,   // 326: gctrpc.GoCryptoTraderService.GetCommunicationRelayers:output_type -> gctrpc.GetCommunicationRelayersResponse
+	13,  // 327: gctrpc.GoCryptoTraderService.GetExchanges:output_type -> gctrpc.GetExchangesResponse
+	155, // 328: gctrpc.GoCryptoTraderService.DisableExchange:output_type -> gctrpc.GenericResponse
+	19,  // 329: gctrpc.GoCryptoTraderService.GetExchangeInfo:output_type -> gctrpc.GetExchangeInfoResponse
+	14,  // 330: gctrpc.GoCryptoTraderService.GetExchangeOTPCode:output_type -> gctrpc.GetExchangeOTPResponse
+	16,  // 331: gctrpc.GoCryptoTraderService.GetExchangeOTPCodes:output_type -> gctrpc.GetExchangeOTPsResponse
+	155, // 332: gctrpc.GoCryptoTraderService.EnableExchange:output_type -> gctrpc.GenericResponse
+	22,  // 333: gctrpc.GoCryptoTraderService.GetTicker:output_type -> gctrpc.TickerResponse
+	25,  // 334: gctrpc.GoCryptoTraderService.GetTickers:output_type -> gctrpc.GetTickersResponse
+	28,  // 335: gctrpc.GoCryptoTraderService.GetOrderbook:output_type -> gctrpc.OrderbookResponse
+	31,  // 336: gctrpc.GoCryptoTraderService.GetOrderbooks:output_type -> gctrpc.GetOrderbooksResponse
+	35,  // 337: gctrpc.GoCryptoTraderService.GetAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
+	35,  // 338: gctrpc.GoCryptoTraderService.UpdateAccountInfo:output_type -> gctrpc.GetAccountInfoResponse
+	35,  // 339: gctrpc.GoCryptoTraderService.GetAccountInfoStream:output_type -> gctrpc.GetAccountInfoResponse
+	37,  // 340: gctrpc.GoCryptoTraderService.GetConfig:output_type -> gctrpc.GetConfigResponse
+	40,  // 341: gctrpc.GoCryptoTraderService.GetPortfolio:output_type -> gctrpc.GetPortfolioResponse
+	47,  // 342: gctrpc.GoCryptoTraderService.GetPortfolioSummary:output_type -> gctrpc.GetPortfolioSummaryResponse
+	155, // 343: gctrpc.GoCryptoTraderService.AddPortfolioAddress:output_type -> gctrpc.GenericResponse
+	155, // 344: gctrpc.GoCryptoTraderService.RemovePortfolioAddress:output_type -> gctrpc.GenericResponse
+	63,  // 345: gctrpc.GoCryptoTraderService.GetForexProviders:output_type -> gctrpc.GetForexProvidersResponse
+	66,  // 346: gctrpc.GoCryptoTraderService.GetForexRates:output_type -> gctrpc.GetForexRatesResponse
+	70,  // 347: gctrpc.GoCryptoTraderService.GetOrders:output_type -> gctrpc.GetOrdersResponse
+	67,  // 348: gctrpc.GoCryptoTraderService.GetOrder:output_type -> gctrpc.OrderDetails
+	74,  // 349: gctrpc.GoCryptoTraderService.SubmitOrder:output_type -> gctrpc.SubmitOrderResponse
+	76,  // 350: gctrpc.GoCryptoTraderService.SimulateOrder:output_type -> gctrpc.SimulateOrderResponse
+	80,  // 351: gctrpc.GoCryptoTraderService.SimulateSmartOrder:output_type -> gctrpc.SimulateSmartOrderResponse
+	76,  // 352: gctrpc.GoCryptoTraderService.WhaleBomb:output_type -> gctrpc.SimulateOrderResponse
+	155, // 353: gctrpc.GoCryptoTraderService.CancelOrder:output_type -> gctrpc.GenericResponse
+	85,  // 354: gctrpc.GoCryptoTraderService.CancelBatchOrders:output_type -> gctrpc.CancelBatchOrdersResponse
+	87,  // 355: gctrpc.GoCryptoTraderService.CancelAllOrders:output_type -> gctrpc.CancelAllOrdersResponse
+	93,  // 356: gctrpc.GoCryptoTraderService.GetEvents:output_type -> gctrpc.GetEventsResponse
+	95,  // 357: gctrpc.GoCryptoTraderService.AddEvent:output_type -> gctrpc.AddEventResponse
+	155, // 358: gctrpc.GoCryptoTraderService.RemoveEvent:output_type -> gctrpc.GenericResponse
+	100, // 359: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddresses:output_type -> gctrpc.GetCryptocurrencyDepositAddressesResponse
+	102, // 360: gctrpc.GoCryptoTraderService.GetCryptocurrencyDepositAddress:output_type -> gctrpc.GetCryptocurrencyDepositAddressResponse
+	104, // 361: gctrpc.GoCryptoTraderService.GetAvailableTransferChains:output_type -> gctrpc.GetAvailableTransferChainsResponse
+	107, // 362: gctrpc.GoCryptoTraderService.WithdrawFiatFunds:output_type -> gctrpc.WithdrawResponse
+	107, // 363: gctrpc.GoCryptoTraderService.WithdrawCryptocurrencyFunds:output_type -> gctrpc.WithdrawResponse
+	109, // 364: gctrpc.GoCryptoTraderService.WithdrawalEventByID:output_type -> gctrpc.WithdrawalEventByIDResponse
+	112, // 365: gctrpc.GoCryptoTraderService.WithdrawalEventsByExchange:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	112, // 366: gctrpc.GoCryptoTraderService.WithdrawalEventsByDate:output_type -> gctrpc.WithdrawalEventsByExchangeResponse
+	124, // 367: gctrpc.GoCryptoTraderService.GetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	124, // 368: gctrpc.GoCryptoTraderService.SetLoggerDetails:output_type -> gctrpc.GetLoggerDetailsResponse
+	127, // 369: gctrpc.GoCryptoTraderService.GetExchangePairs:output_type -> gctrpc.GetExchangePairsResponse
+	155, // 370: gctrpc.GoCryptoTraderService.SetExchangePair:output_type -> gctrpc.GenericResponse
+	28,  // 371: gctrpc.GoCryptoTraderService.GetOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	28,  // 372: gctrpc.GoCryptoTraderService.GetExchangeOrderbookStream:output_type -> gctrpc.OrderbookResponse
+	22,  // 373: gctrpc.GoCryptoTraderService.GetTickerStream:output_type -> gctrpc.TickerResponse
+	22,  // 374: gctrpc.GoCryptoTraderService.GetExchangeTickerStream:output_type -> gctrpc.TickerResponse
+	134, // 375: gctrpc.GoCryptoTraderService.GetAuditEvent:output_type -> gctrpc.GetAuditEventResponse
+	155, // 376: gctrpc.GoCryptoTraderService.GCTScriptExecute:output_type -> gctrpc.GenericResponse
+	155, // 377: gctrpc.GoCryptoTraderService.GCTScriptUpload:output_type -> gctrpc.GenericResponse
+	154, // 378: gctrpc.GoCryptoTraderService.GCTScriptReadScript:output_type -> gctrpc.GCTScriptQueryResponse
+	153, // 379: gctrpc.GoCryptoTraderService.GCTScriptStatus:output_type -> gctrpc.GCTScriptStatusResponse
+	154, // 380: gctrpc.GoCryptoTraderService.GCTScriptQuery:output_type -> gctrpc.GCTScriptQueryResponse
+	155, // 381: gctrpc.GoCryptoTraderService.GCTScriptStop:output_type -> gctrpc.GenericResponse
+	155, // 382: gctrpc.GoCryptoTraderService.GCTScriptStopAll:output_type -> gctrpc.GenericResponse
+	153, // 383: gctrpc.GoCryptoTraderService.GCTScriptListAll:output_type -> gctrpc.GCTScriptStatusResponse
+	155, // 384: gctrpc.GoCryptoTraderService.GCTScriptAutoLoadToggle:output_type -> gctrpc.GenericResponse
+	140, // 385: gctrpc.GoCryptoTraderService.GetHistoricCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	155, // 386: gctrpc.GoCryptoTraderService.SetExchangeAsset:output_type -> gctrpc.GenericResponse
+	155, // 387: gctrpc.GoCryptoTraderService.SetAllExchangePairs:output_type -> gctrpc.GenericResponse
+	155, // 388: gctrpc.GoCryptoTraderService.UpdateExchangeSupportedPairs:output_type -> gctrpc.GenericResponse
+	160, // 389: gctrpc.GoCryptoTraderService.GetExchangeAssets:output_type -> gctrpc.GetExchangeAssetsResponse
+	162, // 390: gctrpc.GoCryptoTraderService.WebsocketGetInfo:output_type -> gctrpc.WebsocketGetInfoResponse
+	155, // 391: gctrpc.GoCryptoTraderService.WebsocketSetEnabled:output_type -> gctrpc.GenericResponse
+	166, // 392: gctrpc.GoCryptoTraderService.WebsocketGetSubscriptions:output_type -> gctrpc.WebsocketGetSubscriptionsResponse
+	155, // 393: gctrpc.GoCryptoTraderService.WebsocketSetProxy:output_type -> gctrpc.GenericResponse
+	155, // 394: gctrpc.GoCryptoTraderService.WebsocketSetURL:output_type -> gctrpc.GenericResponse
+	137, // 395: gctrpc.GoCryptoTraderService.GetRecentTrades:output_type -> gctrpc.SavedTradesResponse
+	137, // 396: gctrpc.GoCryptoTraderService.GetHistoricTrades:output_type -> gctrpc.SavedTradesResponse
+	137, // 397: gctrpc.GoCryptoTraderService.GetSavedTrades:output_type -> gctrpc.SavedTradesResponse
+	140, // 398: gctrpc.GoCryptoTraderService.ConvertTradesToCandles:output_type -> gctrpc.GetHistoricCandlesResponse
+	171, // 399: gctrpc.GoCryptoTraderService.FindMissingSavedCandleIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	171, // 400: gctrpc.GoCryptoTraderService.FindMissingSavedTradeIntervals:output_type -> gctrpc.FindMissingIntervalsResponse
+	155, // 401: gctrpc.GoCryptoTraderService.SetExchangeTradeProcessing:output_type -> gctrpc.GenericResponse
+	176, // 402: gctrpc.GoCryptoTraderService.UpsertDataHistoryJob:output_type -> gctrpc.UpsertDataHistoryJobResponse
+	178, // 403: gctrpc.GoCryptoTraderService.GetDataHistoryJobDetails:output_type -> gctrpc.DataHistoryJob
+	180, // 404: gctrpc.GoCryptoTraderService.GetActiveDataHistoryJobs:output_type -> gctrpc.DataHistoryJobs
+	180, // 405: gctrpc.GoCryptoTraderService.GetDataHistoryJobsBetween:output_type -> gctrpc.DataHistoryJobs
+	178, // 406: gctrpc.GoCryptoTraderService.GetDataHistoryJobSummary:output_type -> gctrpc.DataHistoryJob
+	155, // 407: gctrpc.GoCryptoTraderService.SetDataHistoryJobStatus:output_type -> gctrpc.GenericResponse
+	155, // 408: gctrpc.GoCryptoTraderService.UpdateDataHistoryJobPrerequisite:output_type -> gctrpc.GenericResponse
+	70,  // 409: gctrpc.GoCryptoTraderService.GetManagedOrders:output_type -> gctrpc.GetOrdersResponse
+	185, // 410: gctrpc.GoCryptoTraderService.ModifyOrder:output_type -> gctrpc.ModifyOrderResponse
+	191, // 411: gctrpc.GoCryptoTraderService.CurrencyStateGetAll:output_type -> gctrpc.CurrencyStateResponse
+	155, // 412: gctrpc.GoCryptoTraderService.CurrencyStateTrading:output_type -> gctrpc.GenericResponse
+	155, // 413: gctrpc.GoCryptoTraderService.CurrencyStateDeposit:output_type -> gctrpc.GenericResponse
+	155, // 414: gctrpc.GoCryptoTraderService.CurrencyStateWithdraw:output_type -> gctrpc.GenericResponse
+	155, // 415: gctrpc.GoCryptoTraderService.CurrencyStateTradingPair:output_type -> gctrpc.GenericResponse
+	201, // 416: gctrpc.GoCryptoTraderService.GetFuturesPositionsSummary:output_type -> gctrpc.GetFuturesPositionsSummaryResponse
+	203, // 417: gctrpc.GoCryptoTraderService.GetFuturesPositionsOrders:output_type -> gctrpc.GetFuturesPositionsOrdersResponse
+	219, // 418: gctrpc.GoCryptoTraderService.GetCollateral:output_type -> gctrpc.GetCollateralResponse
+	228, // 419: gctrpc.GoCryptoTraderService.Shutdown:output_type -> gctrpc.ShutdownResponse
+	231, // 420: gctrpc.GoCryptoTraderService.GetTechnicalAnalysis:output_type -> gctrpc.GetTechnicalAnalysisResponse
+	236, // 421: gctrpc.GoCryptoTraderService.GetMarginRatesHistory:output_type -> gctrpc.GetMarginRatesHistoryResponse
+	199, // 422: gctrpc.GoCryptoTraderService.GetManagedPosition:output_type -> gctrpc.GetManagedPositionsResponse
+	199, // 423: gctrpc.GoCryptoTraderService.GetAllManagedPositions:output_type -> gctrpc.GetManagedPositionsResponse
+	224, // 424: gctrpc.GoCryptoTraderService.GetFundingRates:output_type -> gctrpc.GetFundingRatesResponse
+	226, // 425: gctrpc.GoCryptoTraderService.GetLatestFundingRate:output_type -> gctrpc.GetLatestFundingRateResponse
+	238, // 426: gctrpc.GoCryptoTraderService.GetOrderbookMovement:output_type -> gctrpc.GetOrderbookMovementResponse
+	240, // 427: gctrpc.GoCryptoTraderService.GetOrderbookAmountByNominal:output_type -> gctrpc.GetOrderbookAmountByNominalResponse
+	242, // 428: gctrpc.GoCryptoTraderService.GetOrderbookAmountByImpact:output_type -> gctrpc.GetOrderbookAmountByImpactResponse
+	205, // 429: gctrpc.GoCryptoTraderService.GetCollateralMode:output_type -> gctrpc.GetCollateralModeResponse
+	215, // 430: gctrpc.GoCryptoTraderService.GetLeverage:output_type -> gctrpc.GetLeverageResponse
+	207, // 431: gctrpc.GoCryptoTraderService.SetCollateralMode:output_type -> gctrpc.SetCollateralModeResponse
+	213, // 432: gctrpc.GoCryptoTraderService.SetMarginType:output_type -> gctrpc.SetMarginTypeResponse
+	217, // 433: gctrpc.GoCryptoTraderService.SetLeverage:output_type -> gctrpc.SetLeverageResponse
+	211, // 434: gctrpc.GoCryptoTraderService.ChangePositionMargin:output_type -> gctrpc.ChangePositionMarginResponse
+	245, // 435: gctrpc.GoCryptoTraderService.GetOpenInterest:output_type -> gctrpc.GetOpenInterestResponse
+	248, // 436: gctrpc.GoCryptoTraderService.GetCurrencyTradeURL:output_type -> gctrpc.GetCurrencyTradeURLResponse
+	251, // 437: gctrpc.GoCryptoTraderService.SubmitConditionalOrder:output_type -> gctrpc.ConditionalOrdersResponse
+	251, // 438: gctrpc.GoCryptoTraderService.GetConditionalOrders:output_type -> gctrpc.ConditionalOrdersResponse
+	155, // 439: gctrpc.GoCryptoTraderService.CancelConditionalOrder:output_type -> gctrpc.GenericResponse
+	155, // 440: gctrpc.GoCryptoTraderService.SetRiskKillSwitch:output_type -> gctrpc.GenericResponse
+	28,  // 441: gctrpc.GoCryptoTraderService.GetRecordedOrderbook:output_type -> gctrpc.OrderbookResponse
+	259, // 442: gctrpc.GoCryptoTraderService.SubmitAlgoOrder:output_type -> gctrpc.AlgoOrdersResponse
+	259, // 443: gctrpc.GoCryptoTraderService.GetAlgoOrders:output_type -> gctrpc.AlgoOrdersResponse
+	155, // 444: gctrpc.GoCryptoTraderService.CancelAlgoOrder:output_type -> gctrpc.GenericResponse
+	140, // 445: gctrpc.GoCryptoTraderService.GetCandleStream:output_type -> gctrpc.GetHistoricCandlesResponse
+	51,  // 446: gctrpc.GoCryptoTraderService.GetPortfolioValuation:output_type -> gctrpc.PortfolioValuation
+	54,  // 447: gctrpc.GoCryptoTraderService.GetPortfolioValuationHistory:output_type -> gctrpc.GetPortfolioValuationHistoryResponse
+	58,  // 448: gctrpc.GoCryptoTraderService.GetCostBasisReport:output_type -> gctrpc.GetCostBasisReportResponse
+	120, // 449: gctrpc.GoCryptoTraderService.GetPendingWithdrawals:output_type -> gctrpc.GetPendingWithdrawalsResponse
+	107, // 450: gctrpc.GoCryptoTraderService.ApproveWithdrawal:output_type -> gctrpc.WithdrawResponse
+	155, // 451: gctrpc.GoCryptoTraderService.RejectWithdrawal:output_type -> gctrpc.GenericResponse
+	321, // [321:452] is the sub-list for method output_type
+	190, // [190:321] is the sub-list for method input_type
+	190, // [190:190] is the sub-list for extension type_name
+	190, // [190:190] is the sub-list for extension extendee
+	0,   // [0:190] is the sub-list for field type_name
 }
 
 func init() { file_rpc_proto_init() }
@@ -17147,7 +20593,7 @@ func file_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_proto_rawDesc), len(file_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   240,
+			NumMessages:   277,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -49,6 +49,7 @@ const (
 	GoCryptoTraderService_GetOrder_FullMethodName                          = "/gctrpc.GoCryptoTraderService/GetOrder"
 	GoCryptoTraderService_SubmitOrder_FullMethodName                       = "/gctrpc.GoCryptoTraderService/SubmitOrder"
 	GoCryptoTraderService_SimulateOrder_FullMethodName                     = "/gctrpc.GoCryptoTraderService/SimulateOrder"
+	GoCryptoTraderService_SimulateSmartOrder_FullMethodName                = "/gctrpc.GoCryptoTraderService/SimulateSmartOrder"
 	GoCryptoTraderService_WhaleBomb_FullMethodName                         = "/gctrpc.GoCryptoTraderService/WhaleBomb"
 	GoCryptoTraderService_CancelOrder_FullMethodName                       = "/gctrpc.GoCryptoTraderService/CancelOrder"
 	GoCryptoTraderService_CancelBatchOrders_FullMethodName                 = "/gctrpc.GoCryptoTraderService/CancelBatchOrders"
@@ -134,6 +135,21 @@ const (
 	GoCryptoTraderService_ChangePositionMargin_FullMethodName              = "/gctrpc.GoCryptoTraderService/ChangePositionMargin"
 	GoCryptoTraderService_GetOpenInterest_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetOpenInterest"
 	GoCryptoTraderService_GetCurrencyTradeURL_FullMethodName               = "/gctrpc.GoCryptoTraderService/GetCurrencyTradeURL"
+	GoCryptoTraderService_SubmitConditionalOrder_FullMethodName            = "/gctrpc.GoCryptoTraderService/SubmitConditionalOrder"
+	GoCryptoTraderService_GetConditionalOrders_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetConditionalOrders"
+	GoCryptoTraderService_CancelConditionalOrder_FullMethodName            = "/gctrpc.GoCryptoTraderService/CancelConditionalOrder"
+	GoCryptoTraderService_SetRiskKillSwitch_FullMethodName                 = "/gctrpc.GoCryptoTraderService/SetRiskKillSwitch"
+	GoCryptoTraderService_GetRecordedOrderbook_FullMethodName              = "/gctrpc.GoCryptoTraderService/GetRecordedOrderbook"
+	GoCryptoTraderService_SubmitAlgoOrder_FullMethodName                   = "/gctrpc.GoCryptoTraderService/SubmitAlgoOrder"
+	GoCryptoTraderService_GetAlgoOrders_FullMethodName                     = "/gctrpc.GoCryptoTraderService/GetAlgoOrders"
+	GoCryptoTraderService_CancelAlgoOrder_FullMethodName                   = "/gctrpc.GoCryptoTraderService/CancelAlgoOrder"
+	GoCryptoTraderService_GetCandleStream_FullMethodName                   = "/gctrpc.GoCryptoTraderService/GetCandleStream"
+	GoCryptoTraderService_GetPortfolioValuation_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPortfolioValuation"
+	GoCryptoTraderService_GetPortfolioValuationHistory_FullMethodName      = "/gctrpc.GoCryptoTraderService/GetPortfolioValuationHistory"
+	GoCryptoTraderService_GetCostBasisReport_FullMethodName                = "/gctrpc.GoCryptoTraderService/GetCostBasisReport"
+	GoCryptoTraderService_GetPendingWithdrawals_FullMethodName             = "/gctrpc.GoCryptoTraderService/GetPendingWithdrawals"
+	GoCryptoTraderService_ApproveWithdrawal_FullMethodName                 = "/gctrpc.GoCryptoTraderService/ApproveWithdrawal"
+	GoCryptoTraderService_RejectWithdrawal_FullMethodName                  = "/gctrpc.GoCryptoTraderService/RejectWithdrawal"
 )
 
 // GoCryptoTraderServiceClient is the client API for GoCryptoTraderService service.
@@ -170,6 +186,7 @@ type GoCryptoTraderServiceClient interface {
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
 	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
 	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
+	SimulateSmartOrder(ctx context.Context, in *SimulateSmartOrderRequest, opts ...grpc.CallOption) (*SimulateSmartOrderResponse, error)
 	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CancelBatchOrders(ctx context.Context, in *CancelBatchOrdersRequest, opts ...grpc.CallOption) (*CancelBatchOrdersResponse, error)
@@ -255,6 +272,21 @@ type GoCryptoTraderServiceClient interface {
 	ChangePositionMargin(ctx context.Context, in *ChangePositionMarginRequest, opts ...grpc.CallOption) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(ctx context.Context, in *GetCurrencyTradeURLRequest, opts ...grpc.CallOption) (*GetCurrencyTradeURLResponse, error)
+	SubmitConditionalOrder(ctx context.Context, in *SubmitConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error)
+	GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error)
+	CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	SetRiskKillSwitch(ctx context.Context, in *SetRiskKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetRecordedOrderbook(ctx context.Context, in *GetRecordedOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
+	SubmitAlgoOrder(ctx context.Context, in *SubmitAlgoOrderRequest, opts ...grpc.CallOption) (*AlgoOrdersResponse, error)
+	GetAlgoOrders(ctx context.Context, in *GetAlgoOrdersRequest, opts ...grpc.CallOption) (*AlgoOrdersResponse, error)
+	CancelAlgoOrder(ctx context.Context, in *CancelAlgoOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetCandleStream(ctx context.Context, in *GetCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetCandleStreamClient, error)
+	GetPortfolioValuation(ctx context.Context, in *GetPortfolioValuationRequest, opts ...grpc.CallOption) (*PortfolioValuation, error)
+	GetPortfolioValuationHistory(ctx context.Context, in *GetPortfolioValuationHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioValuationHistoryResponse, error)
+	GetCostBasisReport(ctx context.Context, in *GetCostBasisReportRequest, opts ...grpc.CallOption) (*GetCostBasisReportResponse, error)
+	GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type goCryptoTraderServiceClient struct {
@@ -558,6 +590,15 @@ func (c *goCryptoTraderServiceClient) SimulateOrder(ctx context.Context, in *Sim
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) SimulateSmartOrder(ctx context.Context, in *SimulateSmartOrderRequest, opts ...grpc.CallOption) (*SimulateSmartOrderResponse, error) {
+	out := new(SimulateSmartOrderResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SimulateSmartOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *goCryptoTraderServiceClient) WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
 	out := new(SimulateOrderResponse)
 	err := c.cc.Invoke(ctx, GoCryptoTraderService_WhaleBomb_FullMethodName, in, out, opts...)
@@ -1438,6 +1479,164 @@ func (c *goCryptoTraderServiceClient) GetCurrencyTradeURL(ctx context.Context, i
 	return out, nil
 }
 
+func (c *goCryptoTraderServiceClient) SubmitConditionalOrder(ctx context.Context, in *SubmitConditionalOrderRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error) {
+	out := new(ConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SubmitConditionalOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetConditionalOrders(ctx context.Context, in *GetConditionalOrdersRequest, opts ...grpc.CallOption) (*ConditionalOrdersResponse, error) {
+	out := new(ConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetConditionalOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) CancelConditionalOrder(ctx context.Context, in *CancelConditionalOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CancelConditionalOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) SetRiskKillSwitch(ctx context.Context, in *SetRiskKillSwitchRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SetRiskKillSwitch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetRecordedOrderbook(ctx context.Context, in *GetRecordedOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
+	out := new(OrderbookResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetRecordedOrderbook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) SubmitAlgoOrder(ctx context.Context, in *SubmitAlgoOrderRequest, opts ...grpc.CallOption) (*AlgoOrdersResponse, error) {
+	out := new(AlgoOrdersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_SubmitAlgoOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetAlgoOrders(ctx context.Context, in *GetAlgoOrdersRequest, opts ...grpc.CallOption) (*AlgoOrdersResponse, error) {
+	out := new(AlgoOrdersResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetAlgoOrders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) CancelAlgoOrder(ctx context.Context, in *CancelAlgoOrderRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_CancelAlgoOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetCandleStream(ctx context.Context, in *GetCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTraderService_GetCandleStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTraderService_ServiceDesc.Streams[6], GoCryptoTraderService_GetCandleStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderServiceGetCandleStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTraderService_GetCandleStreamClient interface {
+	Recv() (*GetHistoricCandlesResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderServiceGetCandleStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderServiceGetCandleStreamClient) Recv() (*GetHistoricCandlesResponse, error) {
+	m := new(GetHistoricCandlesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPortfolioValuation(ctx context.Context, in *GetPortfolioValuationRequest, opts ...grpc.CallOption) (*PortfolioValuation, error) {
+	out := new(PortfolioValuation)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioValuation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPortfolioValuationHistory(ctx context.Context, in *GetPortfolioValuationHistoryRequest, opts ...grpc.CallOption) (*GetPortfolioValuationHistoryResponse, error) {
+	out := new(GetPortfolioValuationHistoryResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPortfolioValuationHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetCostBasisReport(ctx context.Context, in *GetCostBasisReportRequest, opts ...grpc.CallOption) (*GetCostBasisReportResponse, error) {
+	out := new(GetCostBasisReportResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetCostBasisReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) GetPendingWithdrawals(ctx context.Context, in *GetPendingWithdrawalsRequest, opts ...grpc.CallOption) (*GetPendingWithdrawalsResponse, error) {
+	out := new(GetPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_GetPendingWithdrawals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) ApproveWithdrawal(ctx context.Context, in *ApproveWithdrawalRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_ApproveWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderServiceClient) RejectWithdrawal(ctx context.Context, in *RejectWithdrawalRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, GoCryptoTraderService_RejectWithdrawal_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GoCryptoTraderServiceServer is the server API for GoCryptoTraderService service.
 // All implementations must embed UnimplementedGoCryptoTraderServiceServer
 // for forward compatibility
@@ -1472,6 +1671,7 @@ type GoCryptoTraderServiceServer interface {
 	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
 	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
 	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
+	SimulateSmartOrder(context.Context, *SimulateSmartOrderRequest) (*SimulateSmartOrderResponse, error)
 	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
 	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
@@ -1557,6 +1757,21 @@ type GoCryptoTraderServiceServer interface {
 	ChangePositionMargin(context.Context, *ChangePositionMarginRequest) (*ChangePositionMarginResponse, error)
 	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
 	GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error)
+	SubmitConditionalOrder(context.Context, *SubmitConditionalOrderRequest) (*ConditionalOrdersResponse, error)
+	GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*ConditionalOrdersResponse, error)
+	CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*GenericResponse, error)
+	SetRiskKillSwitch(context.Context, *SetRiskKillSwitchRequest) (*GenericResponse, error)
+	GetRecordedOrderbook(context.Context, *GetRecordedOrderbookRequest) (*OrderbookResponse, error)
+	SubmitAlgoOrder(context.Context, *SubmitAlgoOrderRequest) (*AlgoOrdersResponse, error)
+	GetAlgoOrders(context.Context, *GetAlgoOrdersRequest) (*AlgoOrdersResponse, error)
+	CancelAlgoOrder(context.Context, *CancelAlgoOrderRequest) (*GenericResponse, error)
+	GetCandleStream(*GetCandleStreamRequest, GoCryptoTraderService_GetCandleStreamServer) error
+	GetPortfolioValuation(context.Context, *GetPortfolioValuationRequest) (*PortfolioValuation, error)
+	GetPortfolioValuationHistory(context.Context, *GetPortfolioValuationHistoryRequest) (*GetPortfolioValuationHistoryResponse, error)
+	GetCostBasisReport(context.Context, *GetCostBasisReportRequest) (*GetCostBasisReportResponse, error)
+	GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error)
+	ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error)
+	RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error)
 	mustEmbedUnimplementedGoCryptoTraderServiceServer()
 }
 
@@ -1654,6 +1869,9 @@ func (UnimplementedGoCryptoTraderServiceServer) SubmitOrder(context.Context, *Su
 func (UnimplementedGoCryptoTraderServiceServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) SimulateSmartOrder(context.Context, *SimulateSmartOrderRequest) (*SimulateSmartOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSmartOrder not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
 }
@@ -1909,6 +2127,51 @@ func (UnimplementedGoCryptoTraderServiceServer) GetOpenInterest(context.Context,
 func (UnimplementedGoCryptoTraderServiceServer) GetCurrencyTradeURL(context.Context, *GetCurrencyTradeURLRequest) (*GetCurrencyTradeURLResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyTradeURL not implemented")
 }
+func (UnimplementedGoCryptoTraderServiceServer) SubmitConditionalOrder(context.Context, *SubmitConditionalOrderRequest) (*ConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetConditionalOrders(context.Context, *GetConditionalOrdersRequest) (*ConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CancelConditionalOrder(context.Context, *CancelConditionalOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConditionalOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SetRiskKillSwitch(context.Context, *SetRiskKillSwitchRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRiskKillSwitch not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetRecordedOrderbook(context.Context, *GetRecordedOrderbookRequest) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecordedOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) SubmitAlgoOrder(context.Context, *SubmitAlgoOrderRequest) (*AlgoOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAlgoOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetAlgoOrders(context.Context, *GetAlgoOrdersRequest) (*AlgoOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlgoOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) CancelAlgoOrder(context.Context, *CancelAlgoOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAlgoOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCandleStream(*GetCandleStreamRequest, GoCryptoTraderService_GetCandleStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetCandleStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioValuation(context.Context, *GetPortfolioValuationRequest) (*PortfolioValuation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioValuation not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPortfolioValuationHistory(context.Context, *GetPortfolioValuationHistoryRequest) (*GetPortfolioValuationHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioValuationHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetCostBasisReport(context.Context, *GetCostBasisReportRequest) (*GetCostBasisReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCostBasisReport not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) GetPendingWithdrawals(context.Context, *GetPendingWithdrawalsRequest) (*GetPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingWithdrawals not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) ApproveWithdrawal(context.Context, *ApproveWithdrawalRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveWithdrawal not implemented")
+}
+func (UnimplementedGoCryptoTraderServiceServer) RejectWithdrawal(context.Context, *RejectWithdrawalRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectWithdrawal not implemented")
+}
 func (UnimplementedGoCryptoTraderServiceServer) mustEmbedUnimplementedGoCryptoTraderServiceServer() {}
 
 // UnsafeGoCryptoTraderServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2465,6 +2728,24 @@ func _GoCryptoTraderService_SimulateOrder_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_SimulateSmartOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateSmartOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SimulateSmartOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SimulateSmartOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SimulateSmartOrder(ctx, req.(*SimulateSmartOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GoCryptoTraderService_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(WhaleBombRequest)
 	if err := dec(in); err != nil {
@@ -4010,6 +4291,279 @@ func _GoCryptoTraderService_GetCurrencyTradeURL_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GoCryptoTraderService_SubmitConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SubmitConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SubmitConditionalOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SubmitConditionalOrder(ctx, req.(*SubmitConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetConditionalOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConditionalOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetConditionalOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetConditionalOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetConditionalOrders(ctx, req.(*GetConditionalOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_CancelConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelConditionalOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelConditionalOrder(ctx, req.(*CancelConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_SetRiskKillSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRiskKillSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SetRiskKillSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SetRiskKillSwitch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SetRiskKillSwitch(ctx, req.(*SetRiskKillSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetRecordedOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecordedOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetRecordedOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetRecordedOrderbook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetRecordedOrderbook(ctx, req.(*GetRecordedOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_SubmitAlgoOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitAlgoOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).SubmitAlgoOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_SubmitAlgoOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).SubmitAlgoOrder(ctx, req.(*SubmitAlgoOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetAlgoOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAlgoOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetAlgoOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetAlgoOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetAlgoOrders(ctx, req.(*GetAlgoOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_CancelAlgoOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAlgoOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).CancelAlgoOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_CancelAlgoOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).CancelAlgoOrder(ctx, req.(*CancelAlgoOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetCandleStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetCandleStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServiceServer).GetCandleStream(m, &goCryptoTraderServiceGetCandleStreamServer{stream})
+}
+
+type GoCryptoTraderService_GetCandleStreamServer interface {
+	Send(*GetHistoricCandlesResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderServiceGetCandleStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderServiceGetCandleStreamServer) Send(m *GetHistoricCandlesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTraderService_GetPortfolioValuation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioValuationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioValuation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioValuation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioValuation(ctx, req.(*GetPortfolioValuationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPortfolioValuationHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioValuationHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioValuationHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPortfolioValuationHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPortfolioValuationHistory(ctx, req.(*GetPortfolioValuationHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetCostBasisReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCostBasisReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetCostBasisReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetCostBasisReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetCostBasisReport(ctx, req.(*GetCostBasisReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_GetPendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_GetPendingWithdrawals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).GetPendingWithdrawals(ctx, req.(*GetPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_ApproveWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_ApproveWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).ApproveWithdrawal(ctx, req.(*ApproveWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTraderService_RejectWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectWithdrawalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GoCryptoTraderService_RejectWithdrawal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServiceServer).RejectWithdrawal(ctx, req.(*RejectWithdrawalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GoCryptoTraderService_ServiceDesc is the grpc.ServiceDesc for GoCryptoTraderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -4133,6 +4687,10 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SimulateOrder",
 			Handler:    _GoCryptoTraderService_SimulateOrder_Handler,
 		},
+		{
+			MethodName: "SimulateSmartOrder",
+			Handler:    _GoCryptoTraderService_SimulateSmartOrder_Handler,
+		},
 		{
 			MethodName: "WhaleBomb",
 			Handler:    _GoCryptoTraderService_WhaleBomb_Handler,
@@ -4453,6 +5011,62 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrencyTradeURL",
 			Handler:    _GoCryptoTraderService_GetCurrencyTradeURL_Handler,
 		},
+		{
+			MethodName: "SubmitConditionalOrder",
+			Handler:    _GoCryptoTraderService_SubmitConditionalOrder_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrders",
+			Handler:    _GoCryptoTraderService_GetConditionalOrders_Handler,
+		},
+		{
+			MethodName: "CancelConditionalOrder",
+			Handler:    _GoCryptoTraderService_CancelConditionalOrder_Handler,
+		},
+		{
+			MethodName: "SetRiskKillSwitch",
+			Handler:    _GoCryptoTraderService_SetRiskKillSwitch_Handler,
+		},
+		{
+			MethodName: "GetRecordedOrderbook",
+			Handler:    _GoCryptoTraderService_GetRecordedOrderbook_Handler,
+		},
+		{
+			MethodName: "SubmitAlgoOrder",
+			Handler:    _GoCryptoTraderService_SubmitAlgoOrder_Handler,
+		},
+		{
+			MethodName: "GetAlgoOrders",
+			Handler:    _GoCryptoTraderService_GetAlgoOrders_Handler,
+		},
+		{
+			MethodName: "CancelAlgoOrder",
+			Handler:    _GoCryptoTraderService_CancelAlgoOrder_Handler,
+		},
+		{
+			MethodName: "GetPortfolioValuation",
+			Handler:    _GoCryptoTraderService_GetPortfolioValuation_Handler,
+		},
+		{
+			MethodName: "GetPortfolioValuationHistory",
+			Handler:    _GoCryptoTraderService_GetPortfolioValuationHistory_Handler,
+		},
+		{
+			MethodName: "GetCostBasisReport",
+			Handler:    _GoCryptoTraderService_GetCostBasisReport_Handler,
+		},
+		{
+			MethodName: "GetPendingWithdrawals",
+			Handler:    _GoCryptoTraderService_GetPendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "ApproveWithdrawal",
+			Handler:    _GoCryptoTraderService_ApproveWithdrawal_Handler,
+		},
+		{
+			MethodName: "RejectWithdrawal",
+			Handler:    _GoCryptoTraderService_RejectWithdrawal_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -4485,6 +5099,11 @@ var GoCryptoTraderService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTraderService_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetCandleStream",
+			Handler:       _GoCryptoTraderService_GetCandleStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
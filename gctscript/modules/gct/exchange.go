@@ -43,6 +43,18 @@ var exchangeModule = map[string]objects.Object{
 	withdrawCryptoFunc: &objects.UserFunction{Name: withdrawCryptoFunc, Value: ExchangeWithdrawCrypto},
 	withdrawFiatFunc:   &objects.UserFunction{Name: withdrawFiatFunc, Value: ExchangeWithdrawFiat},
 	ohlcvFunc:          &objects.UserFunction{Name: ohlcvFunc, Value: exchangeOHLCV},
+
+	positionsFunc:          &objects.UserFunction{Name: positionsFunc, Value: ExchangeFuturesPositions},
+	positionSummaryFunc:    &objects.UserFunction{Name: positionSummaryFunc, Value: ExchangeFuturesPositionSummary},
+	leverageFunc:           &objects.UserFunction{Name: leverageFunc, Value: ExchangeLeverage},
+	setLeverageFunc:        &objects.UserFunction{Name: setLeverageFunc, Value: ExchangeSetLeverage},
+	setMarginTypeFunc:      &objects.UserFunction{Name: setMarginTypeFunc, Value: ExchangeSetMarginType},
+	collateralModeFunc:     &objects.UserFunction{Name: collateralModeFunc, Value: ExchangeCollateralMode},
+	setCollateralModeFunc:  &objects.UserFunction{Name: setCollateralModeFunc, Value: ExchangeSetCollateralMode},
+	fundingRatesFunc:       &objects.UserFunction{Name: fundingRatesFunc, Value: ExchangeFundingRates},
+	fundingRateHistoryFunc: &objects.UserFunction{Name: fundingRateHistoryFunc, Value: ExchangeFundingRateHistory},
+	openInterestFunc:       &objects.UserFunction{Name: openInterestFunc, Value: ExchangeOpenInterest},
+	contractsFunc:          &objects.UserFunction{Name: contractsFunc, Value: ExchangeFuturesContracts},
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
@@ -88,30 +100,7 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	asks := objects.Array{Value: make([]objects.Object, len(ob.Asks))}
-	for x := range ob.Asks {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Asks[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Asks[x].Price}
-		asks.Value[x] = &objects.Map{Value: temp}
-	}
-
-	bids := objects.Array{Value: make([]objects.Object, len(ob.Bids))}
-	for x := range ob.Bids {
-		temp := make(map[string]objects.Object, 2)
-		temp["amount"] = &objects.Float{Value: ob.Bids[x].Amount}
-		temp["price"] = &objects.Float{Value: ob.Bids[x].Price}
-		bids.Value[x] = &objects.Map{Value: temp}
-	}
-
-	data := make(map[string]objects.Object, 5)
-	data["exchange"] = &objects.String{Value: ob.Exchange}
-	data["pair"] = &objects.String{Value: ob.Pair.String()}
-	data["asks"] = &asks
-	data["bids"] = &bids
-	data["asset"] = &objects.String{Value: ob.Asset.String()}
-
-	return &objects.Map{Value: data}, nil
+	return OrderbookObject(ob), nil
 }
 
 // ExchangeTicker returns ticker data for requested exchange and currency pair
@@ -158,25 +147,7 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: tx.ExchangeName}
-	data["last"] = &objects.Float{Value: tx.Last}
-	data["High"] = &objects.Float{Value: tx.High}
-	data["Low"] = &objects.Float{Value: tx.Low}
-	data["bid"] = &objects.Float{Value: tx.Bid}
-	data["ask"] = &objects.Float{Value: tx.Ask}
-	data["volume"] = &objects.Float{Value: tx.Volume}
-	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
-	data["priceath"] = &objects.Float{Value: tx.PriceATH}
-	data["open"] = &objects.Float{Value: tx.Open}
-	data["close"] = &objects.Float{Value: tx.Close}
-	data["pair"] = &objects.String{Value: tx.Pair.String()}
-	data["asset"] = &objects.String{Value: tx.AssetType.String()}
-	data["updated"] = &objects.Time{Value: tx.LastUpdated}
-
-	return &objects.Map{
-		Value: data,
-	}, nil
+	return TickerObject(tx), nil
 }
 
 // ExchangeExchanges returns list of exchanges either enabled or all
@@ -340,37 +311,7 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
-	var tradeHistory objects.Array
-	tradeHistory.Value = make([]objects.Object, len(orderDetails.Trades))
-	for x := range orderDetails.Trades {
-		temp := make(map[string]objects.Object, 7)
-		temp["timestamp"] = &objects.Time{Value: orderDetails.Trades[x].Timestamp}
-		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price}
-		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee}
-		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount}
-		temp["type"] = &objects.String{Value: orderDetails.Trades[x].Type.String()}
-		temp["side"] = &objects.String{Value: orderDetails.Trades[x].Side.String()}
-		temp["description"] = &objects.String{Value: orderDetails.Trades[x].Description}
-		tradeHistory.Value[x] = &objects.Map{Value: temp}
-	}
-
-	data := make(map[string]objects.Object, 14)
-	data["exchange"] = &objects.String{Value: orderDetails.Exchange}
-	data["id"] = &objects.String{Value: orderDetails.OrderID}
-	data["accountid"] = &objects.String{Value: orderDetails.AccountID}
-	data["currencypair"] = &objects.String{Value: orderDetails.Pair.String()}
-	data["price"] = &objects.Float{Value: orderDetails.Price}
-	data["amount"] = &objects.Float{Value: orderDetails.Amount}
-	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount}
-	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount}
-	data["fee"] = &objects.Float{Value: orderDetails.Fee}
-	data["side"] = &objects.String{Value: orderDetails.Side.String()}
-	data["type"] = &objects.String{Value: orderDetails.Type.String()}
-	data["date"] = &objects.String{Value: orderDetails.Date.String()}
-	data["status"] = &objects.String{Value: orderDetails.Status.String()}
-	data["trades"] = &tradeHistory
-
-	return &objects.Map{Value: data}, nil
+	return OrderObject(orderDetails), nil
 }
 
 // ExchangeOrderCancel cancels order on requested exchange
@@ -0,0 +1,502 @@
+package gct
+
+import (
+	"context"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+const (
+	positionsFunc          = "positions"
+	positionSummaryFunc    = "positionsummary"
+	leverageFunc           = "leverage"
+	setLeverageFunc        = "setleverage"
+	setMarginTypeFunc      = "setmargintype"
+	collateralModeFunc     = "collateralmode"
+	setCollateralModeFunc  = "setcollateralmode"
+	fundingRatesFunc       = "fundingrates"
+	fundingRateHistoryFunc = "fundingratehistory"
+	openInterestFunc       = "openinterest"
+	contractsFunc          = "contracts"
+)
+
+// pairAssetArgs are the script context, exchange, currency pair and asset
+// arguments which lead most futures functions
+type pairAssetArgs struct {
+	ctx      context.Context
+	exchange string
+	pair     currency.Pair
+	asset    asset.Item
+}
+
+// parsePairAssetArgs parses the script context, exchange, currency pair,
+// delimiter and asset arguments. An empty currency pair is allowed for
+// functions which apply to all pairs. A non nil object is an error response
+// to return to the script
+func parsePairAssetArgs(funcName string, allowEmptyPair bool, args []objects.Object) (*pairAssetArgs, objects.Object, error) {
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, nil, constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, nil, constructRuntimeError(2, funcName, "string", args[1])
+	}
+	currencyPair, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, nil, constructRuntimeError(3, funcName, "string", args[2])
+	}
+	delimiter, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, nil, constructRuntimeError(4, funcName, "string", args[3])
+	}
+	assetTypeParam, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, nil, constructRuntimeError(5, funcName, "string", args[4])
+	}
+
+	var pair currency.Pair
+	if currencyPair != "" || !allowEmptyPair {
+		var err error
+		pair, err = currency.NewPairDelimiter(currencyPair, delimiter)
+		if err != nil {
+			resp, err := errorResponsef(standardFormatting, err)
+			return nil, resp, err
+		}
+	}
+
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		resp, err := errorResponsef(standardFormatting, err)
+		return nil, resp, err
+	}
+
+	return &pairAssetArgs{
+		ctx:      processScriptContext(scriptCtx),
+		exchange: exchangeName,
+		pair:     pair,
+		asset:    assetType,
+	}, nil, nil
+}
+
+// parseAssetArgs parses the script context, exchange and asset arguments of
+// functions which apply to a whole asset. A non nil object is an error
+// response to return to the script
+func parseAssetArgs(funcName string, args []objects.Object) (*pairAssetArgs, objects.Object, error) {
+	scriptCtx, ok := objects.ToInterface(args[0]).(*Context)
+	if !ok {
+		return nil, nil, constructRuntimeError(1, funcName, "*gct.Context", args[0])
+	}
+	exchangeName, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, nil, constructRuntimeError(2, funcName, "string", args[1])
+	}
+	assetTypeParam, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, nil, constructRuntimeError(3, funcName, "string", args[2])
+	}
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		resp, err := errorResponsef(standardFormatting, err)
+		return nil, resp, err
+	}
+	return &pairAssetArgs{
+		ctx:      processScriptContext(scriptCtx),
+		exchange: exchangeName,
+		asset:    assetType,
+	}, nil, nil
+}
+
+// parseSide parses an optional order side argument, defaulting to an unknown
+// side which exchanges without hedge mode ignore
+func parseSide(funcName string, args []objects.Object, position int) (order.Side, objects.Object, error) {
+	if len(args) <= position {
+		return order.UnknownSide, nil, nil
+	}
+	sideParam, ok := objects.ToString(args[position])
+	if !ok {
+		return order.UnknownSide, nil, constructRuntimeError(position+1, funcName, "string", args[position])
+	}
+	side, err := order.StringToOrderSide(sideParam)
+	if err != nil {
+		resp, err := errorResponsef(standardFormatting, err)
+		return order.UnknownSide, resp, err
+	}
+	return side, nil, nil
+}
+
+// parseMarginType parses a margin type argument
+func parseMarginType(funcName string, args []objects.Object, position int) (margin.Type, objects.Object, error) {
+	marginTypeParam, ok := objects.ToString(args[position])
+	if !ok {
+		return margin.Unset, nil, constructRuntimeError(position+1, funcName, "string", args[position])
+	}
+	marginType, err := margin.StringToMarginType(marginTypeParam)
+	if err != nil {
+		resp, err := errorResponsef(standardFormatting, err)
+		return margin.Unset, resp, err
+	}
+	return marginType, nil, nil
+}
+
+// ExchangeFuturesPositions returns the orders which make up the futures
+// positions of a pair since a start time
+func ExchangeFuturesPositions(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(positionsFunc, false, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	start, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, positionsFunc, "time.Time", args[5])
+	}
+
+	positions, err := wrappers.GetWrapper().FuturesPositions(a.ctx, a.exchange, a.asset, currency.Pairs{a.pair}, start)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	result := &objects.Array{Value: make([]objects.Object, len(positions))}
+	for x := range positions {
+		orders := &objects.Array{Value: make([]objects.Object, len(positions[x].Orders))}
+		for y := range positions[x].Orders {
+			orders.Value[y] = OrderObject(&positions[x].Orders[y])
+		}
+		data := make(map[string]objects.Object, 4)
+		data["exchange"] = &objects.String{Value: positions[x].Exchange}
+		data["pair"] = &objects.String{Value: positions[x].Pair.String()}
+		data["asset"] = &objects.String{Value: positions[x].Asset.String()}
+		data["orders"] = orders
+		result.Value[x] = &objects.Map{Value: data}
+	}
+	return result, nil
+}
+
+// ExchangeFuturesPositionSummary returns a summary of the open futures
+// position of a pair
+func ExchangeFuturesPositionSummary(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(positionSummaryFunc, false, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	summary, err := wrappers.GetWrapper().FuturesPositionSummary(a.ctx, a.exchange, a.pair, a.asset)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	data := make(map[string]objects.Object, 18)
+	data["exchange"] = &objects.String{Value: a.exchange}
+	data["pair"] = &objects.String{Value: summary.Pair.String()}
+	data["asset"] = &objects.String{Value: summary.Asset.String()}
+	data["margintype"] = &objects.String{Value: summary.MarginType.String()}
+	data["collateralmode"] = &objects.String{Value: summary.CollateralMode.String()}
+	data["currency"] = &objects.String{Value: summary.Currency.String()}
+	data["size"] = decimalObject(summary.CurrentSize)
+	data["notionalsize"] = decimalObject(summary.NotionalSize)
+	data["leverage"] = decimalObject(summary.Leverage)
+	data["averageopenprice"] = decimalObject(summary.AverageOpenPrice)
+	data["markprice"] = decimalObject(summary.MarkPrice)
+	data["liquidationprice"] = decimalObject(summary.EstimatedLiquidationPrice)
+	data["unrealisedpnl"] = decimalObject(summary.UnrealisedPNL)
+	data["realisedpnl"] = decimalObject(summary.RealisedPNL)
+	data["initialmargin"] = decimalObject(summary.InitialMarginRequirement)
+	data["maintenancemargin"] = decimalObject(summary.MaintenanceMarginRequirement)
+	data["collateralused"] = decimalObject(summary.CollateralUsed)
+	data["freecollateral"] = decimalObject(summary.FreeCollateral)
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeLeverage returns the leverage of a pair
+func ExchangeLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 && len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(leverageFunc, false, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	marginType, resp, err := parseMarginType(leverageFunc, args, 5)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	side, resp, err := parseSide(leverageFunc, args, 6)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	leverage, err := wrappers.GetWrapper().Leverage(a.ctx, a.exchange, a.pair, a.asset, marginType, side)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return &objects.Float{Value: leverage}, nil
+}
+
+// ExchangeSetLeverage sets the leverage of a pair
+func ExchangeSetLeverage(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 && len(args) != 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(setLeverageFunc, false, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	marginType, resp, err := parseMarginType(setLeverageFunc, args, 5)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	amount, ok := objects.ToFloat64(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, setLeverageFunc, "float64", args[6])
+	}
+	side, resp, err := parseSide(setLeverageFunc, args, 7)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	err = wrappers.GetWrapper().SetLeverage(a.ctx, a.exchange, a.pair, a.asset, marginType, amount, side)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// ExchangeSetMarginType sets the margin type of a pair
+func ExchangeSetMarginType(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(setMarginTypeFunc, false, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	marginType, resp, err := parseMarginType(setMarginTypeFunc, args, 5)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	err = wrappers.GetWrapper().SetMarginType(a.ctx, a.exchange, a.pair, a.asset, marginType)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// ExchangeCollateralMode returns the collateral mode of an asset
+func ExchangeCollateralMode(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parseAssetArgs(collateralModeFunc, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	mode, err := wrappers.GetWrapper().CollateralMode(a.ctx, a.exchange, a.asset)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return &objects.String{Value: mode.String()}, nil
+}
+
+// ExchangeSetCollateralMode sets the collateral mode of an asset
+func ExchangeSetCollateralMode(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parseAssetArgs(setCollateralModeFunc, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	modeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return nil, constructRuntimeError(4, setCollateralModeFunc, "string", args[3])
+	}
+	mode, err := collateral.StringToMode(modeParam)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	err = wrappers.GetWrapper().SetCollateralMode(a.ctx, a.exchange, a.asset, mode)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// ExchangeFundingRates returns the latest and predicted funding rates of a
+// pair, or of all pairs when the pair is empty
+func ExchangeFundingRates(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(fundingRatesFunc, true, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	rates, err := wrappers.GetWrapper().LatestFundingRates(a.ctx, a.exchange, a.pair, a.asset, true)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	result := &objects.Array{Value: make([]objects.Object, len(rates))}
+	for x := range rates {
+		data := make(map[string]objects.Object, 7)
+		data["exchange"] = &objects.String{Value: rates[x].Exchange}
+		data["pair"] = &objects.String{Value: rates[x].Pair.String()}
+		data["asset"] = &objects.String{Value: rates[x].Asset.String()}
+		data["rate"] = rateObject(&rates[x].LatestRate)
+		data["predicted"] = rateObject(&rates[x].PredictedUpcomingRate)
+		data["nextfunding"] = &objects.Time{Value: rates[x].TimeOfNextRate}
+		data["checked"] = &objects.Time{Value: rates[x].TimeChecked}
+		result.Value[x] = &objects.Map{Value: data}
+	}
+	return result, nil
+}
+
+// ExchangeFundingRateHistory returns the funding rates of a pair between a
+// start and end time, optionally including account payments
+func ExchangeFundingRateHistory(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 && len(args) != 8 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(fundingRateHistoryFunc, false, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+	start, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, fundingRateHistoryFunc, "time.Time", args[5])
+	}
+	end, ok := objects.ToTime(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, fundingRateHistoryFunc, "time.Time", args[6])
+	}
+	var includePayments bool
+	if len(args) == 8 {
+		includePayments, ok = objects.ToBool(args[7])
+		if !ok {
+			return nil, constructRuntimeError(8, fundingRateHistoryFunc, "bool", args[7])
+		}
+	}
+
+	history, err := wrappers.GetWrapper().HistoricalFundingRates(a.ctx, a.exchange, a.pair, a.asset, start, end, includePayments)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	rates := &objects.Array{Value: make([]objects.Object, len(history.FundingRates))}
+	for x := range history.FundingRates {
+		rates.Value[x] = rateObject(&history.FundingRates[x])
+	}
+	data := make(map[string]objects.Object, 9)
+	data["exchange"] = &objects.String{Value: history.Exchange}
+	data["pair"] = &objects.String{Value: history.Pair.String()}
+	data["asset"] = &objects.String{Value: history.Asset.String()}
+	data["rates"] = rates
+	data["latest"] = rateObject(&history.LatestRate)
+	data["predicted"] = rateObject(&history.PredictedUpcomingRate)
+	data["paymentsum"] = decimalObject(history.PaymentSum)
+	data["paymentcurrency"] = &objects.String{Value: history.PaymentCurrency.String()}
+	data["nextfunding"] = &objects.Time{Value: history.TimeOfNextRate}
+	return &objects.Map{Value: data}, nil
+}
+
+// ExchangeOpenInterest returns the open interest of a pair, or of all pairs
+// when the pair is empty
+func ExchangeOpenInterest(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parsePairAssetArgs(openInterestFunc, true, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	interest, err := wrappers.GetWrapper().OpenInterest(a.ctx, a.exchange, a.pair, a.asset)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	result := &objects.Array{Value: make([]objects.Object, len(interest))}
+	for x := range interest {
+		data := make(map[string]objects.Object, 4)
+		data["exchange"] = &objects.String{Value: interest[x].Key.Exchange}
+		data["pair"] = &objects.String{Value: interest[x].Key.Pair().String()}
+		data["asset"] = &objects.String{Value: interest[x].Key.Asset.String()}
+		data["openinterest"] = &objects.Float{Value: interest[x].OpenInterest}
+		result.Value[x] = &objects.Map{Value: data}
+	}
+	return result, nil
+}
+
+// ExchangeFuturesContracts returns the contract details of an asset
+func ExchangeFuturesContracts(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	a, resp, err := parseAssetArgs(contractsFunc, args)
+	if resp != nil || err != nil {
+		return resp, err
+	}
+
+	contracts, err := wrappers.GetWrapper().FuturesContracts(a.ctx, a.exchange, a.asset)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	result := &objects.Array{Value: make([]objects.Object, len(contracts))}
+	for x := range contracts {
+		data := make(map[string]objects.Object, 14)
+		data["exchange"] = &objects.String{Value: contracts[x].Exchange}
+		data["name"] = &objects.String{Value: contracts[x].Name.String()}
+		data["underlying"] = &objects.String{Value: contracts[x].Underlying.String()}
+		data["asset"] = &objects.String{Value: contracts[x].Asset.String()}
+		data["start"] = &objects.Time{Value: contracts[x].StartDate}
+		data["end"] = &objects.Time{Value: contracts[x].EndDate}
+		data["active"] = boolObject(contracts[x].IsActive)
+		data["status"] = &objects.String{Value: contracts[x].Status}
+		data["type"] = &objects.String{Value: contracts[x].Type.String()}
+		data["settlement"] = &objects.String{Value: contracts[x].SettlementType.String()}
+		data["margincurrency"] = &objects.String{Value: contracts[x].MarginCurrency.String()}
+		data["multiplier"] = &objects.Float{Value: contracts[x].Multiplier}
+		data["maxleverage"] = &objects.Float{Value: contracts[x].MaxLeverage}
+		data["fundingrate"] = rateObject(&contracts[x].LatestRate)
+		result.Value[x] = &objects.Map{Value: data}
+	}
+	return result, nil
+}
+
+func rateObject(r *fundingrate.Rate) objects.Object {
+	data := make(map[string]objects.Object, 3)
+	data["time"] = &objects.Time{Value: r.Time}
+	data["rate"] = decimalObject(r.Rate)
+	data["payment"] = decimalObject(r.Payment)
+	return &objects.Map{Value: data}
+}
+
+func decimalObject(d decimal.Decimal) objects.Object {
+	return &objects.Float{Value: d.InexactFloat64()}
+}
+
+func boolObject(b bool) objects.Object {
+	if b {
+		return objects.TrueValue
+	}
+	return objects.FalseValue
+}
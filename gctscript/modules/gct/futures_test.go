@@ -0,0 +1,209 @@
+package gct
+
+import (
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	futuresAsset = &objects.String{Value: "usdtmarginedfutures"}
+	isolated     = &objects.String{Value: "isolated"}
+)
+
+type moduleFunc func(...objects.Object) (objects.Object, error)
+
+// requireMap calls a module function which must return a map and returns its
+// values
+func requireMap(t *testing.T, fn moduleFunc, args ...objects.Object) map[string]objects.Object {
+	t.Helper()
+	resp, err := fn(args...)
+	require.NoError(t, err, "function must not error")
+	return objectMap(t, resp)
+}
+
+// requireArray calls a module function which must return an array of maps and
+// returns the values of the first
+func requireArray(t *testing.T, fn moduleFunc, args ...objects.Object) map[string]objects.Object {
+	t.Helper()
+	resp, err := fn(args...)
+	require.NoError(t, err, "function must not error")
+	a, ok := resp.(*objects.Array)
+	require.Truef(t, ok, "function must return an array, received %T %v", resp, resp)
+	require.NotEmpty(t, a.Value, "function must return values")
+	return objectMap(t, a.Value[0])
+}
+
+// requireErrorResponse calls a module function which must return an error
+// object rather than a runtime error
+func requireErrorResponse(t *testing.T, fn moduleFunc, args ...objects.Object) {
+	t.Helper()
+	resp, err := fn(args...)
+	require.NoError(t, err, "function must not return a runtime error")
+	_, ok := resp.(*objects.Error)
+	require.Truef(t, ok, "function must return an error object, received %T %v", resp, resp)
+}
+
+func objectMap(t *testing.T, o objects.Object) map[string]objects.Object {
+	t.Helper()
+	m, ok := o.(*objects.Map)
+	require.Truef(t, ok, "object must be a map, received %T %v", o, o)
+	return m.Value
+}
+
+func TestExchangeFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFuturesPositions()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	start := &objects.Time{Value: time.Now().Add(-time.Hour)}
+	position := requireArray(t, ExchangeFuturesPositions, ctx, exch, currencyPair, delimiter, futuresAsset, start)
+	assert.Equal(t, &objects.String{Value: "BTC-AUD"}, position["pair"], "pair should be set")
+	orders, ok := position["orders"].(*objects.Array)
+	require.True(t, ok, "orders must be an array")
+	assert.Len(t, orders.Value, 1, "orders should be returned")
+
+	_, err = ExchangeFuturesPositions(ctx, exch, currencyPair, delimiter, futuresAsset, exch)
+	assert.Error(t, err, "ExchangeFuturesPositions should error on an invalid start time")
+	requireErrorResponse(t, ExchangeFuturesPositions, ctx, exch, blank, delimiter, futuresAsset, start)
+}
+
+func TestExchangeFuturesPositionSummary(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFuturesPositionSummary()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	summary := requireMap(t, ExchangeFuturesPositionSummary, ctx, exch, currencyPair, delimiter, futuresAsset)
+	assert.Equal(t, &objects.Float{Value: 10}, summary["leverage"], "leverage should be set")
+	assert.Equal(t, &objects.String{Value: "isolated"}, summary["margintype"], "margintype should be set")
+
+	_, err = ExchangeFuturesPositionSummary(exch, exch, currencyPair, delimiter, futuresAsset)
+	assert.Error(t, err, "ExchangeFuturesPositionSummary should error on an invalid context")
+	requireErrorResponse(t, ExchangeFuturesPositionSummary, ctx, exch, currencyPair, delimiter, blank)
+}
+
+func TestExchangeLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeLeverage(ctx, exch, currencyPair, delimiter, futuresAsset)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, isolated)
+	require.NoError(t, err)
+	assert.Equal(t, &objects.Float{Value: 10}, resp, "leverage should be returned")
+
+	resp, err = ExchangeLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, isolated, &objects.String{Value: "long"})
+	require.NoError(t, err)
+	assert.Equal(t, &objects.Float{Value: 10}, resp, "leverage should be returned for a side")
+
+	requireErrorResponse(t, ExchangeLeverage, ctx, exch, currencyPair, delimiter, futuresAsset, &objects.String{Value: "bad"})
+	requireErrorResponse(t, ExchangeLeverage, ctx, exch, currencyPair, delimiter, futuresAsset, isolated, &objects.String{Value: "bad"})
+	_, err = ExchangeLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, objects.UndefinedValue)
+	assert.Error(t, err, "ExchangeLeverage should error on an invalid margin type")
+}
+
+func TestExchangeSetLeverage(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, isolated)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, isolated, &objects.Float{Value: 5})
+	require.NoError(t, err)
+	assert.Equal(t, tv, resp, "true should be returned")
+
+	requireErrorResponse(t, ExchangeSetLeverage, ctx, exch, currencyPair, delimiter, futuresAsset, isolated, &objects.Float{Value: 0})
+	_, err = ExchangeSetLeverage(ctx, exch, currencyPair, delimiter, futuresAsset, isolated, exch)
+	assert.Error(t, err, "ExchangeSetLeverage should error on an invalid amount")
+}
+
+func TestExchangeSetMarginType(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetMarginType()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeSetMarginType(ctx, exch, currencyPair, delimiter, futuresAsset, &objects.String{Value: "cross"})
+	require.NoError(t, err)
+	assert.Equal(t, tv, resp, "true should be returned")
+}
+
+func TestExchangeCollateralMode(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeCollateralMode()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeCollateralMode(ctx, exch, futuresAsset)
+	require.NoError(t, err)
+	assert.Equal(t, &objects.String{Value: "single"}, resp, "collateral mode should be returned")
+
+	requireErrorResponse(t, ExchangeCollateralMode, ctx, exch, blank)
+	_, err = ExchangeCollateralMode(ctx, objects.UndefinedValue, futuresAsset)
+	assert.Error(t, err, "ExchangeCollateralMode should error on an invalid exchange")
+}
+
+func TestExchangeSetCollateralMode(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSetCollateralMode()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	resp, err := ExchangeSetCollateralMode(ctx, exch, futuresAsset, &objects.String{Value: "multi"})
+	require.NoError(t, err)
+	assert.Equal(t, tv, resp, "true should be returned")
+
+	requireErrorResponse(t, ExchangeSetCollateralMode, ctx, exch, futuresAsset, &objects.String{Value: "bad"})
+}
+
+func TestExchangeFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingRates()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	rate := requireArray(t, ExchangeFundingRates, ctx, exch, currencyPair, delimiter, futuresAsset)
+	latest := objectMap(t, rate["rate"])
+	assert.Equal(t, &objects.Float{Value: 0.0001}, latest["rate"], "rate should be returned")
+	predicted := objectMap(t, rate["predicted"])
+	assert.Equal(t, &objects.Float{Value: 0.0001}, predicted["rate"], "predicted rate should be returned")
+
+	requireArray(t, ExchangeFundingRates, ctx, exch, blank, delimiter, futuresAsset)
+}
+
+func TestExchangeFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFundingRateHistory()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	end := time.Now()
+	start, finish := &objects.Time{Value: end.Add(-time.Hour * 24)}, &objects.Time{Value: end}
+	history := requireMap(t, ExchangeFundingRateHistory, ctx, exch, currencyPair, delimiter, futuresAsset, start, finish)
+	rates, ok := history["rates"].(*objects.Array)
+	require.True(t, ok, "rates must be an array")
+	assert.Len(t, rates.Value, 3, "rates should be returned")
+	assert.Equal(t, &objects.Float{Value: 0}, history["paymentsum"], "payments should not be included by default")
+
+	history = requireMap(t, ExchangeFundingRateHistory, ctx, exch, currencyPair, delimiter, futuresAsset, start, finish, tv)
+	assert.NotEqual(t, &objects.Float{Value: 0}, history["paymentsum"], "payments should be included")
+
+	requireErrorResponse(t, ExchangeFundingRateHistory, ctx, exch, currencyPair, delimiter, futuresAsset, finish, start)
+	_, err = ExchangeFundingRateHistory(ctx, exch, currencyPair, delimiter, futuresAsset, start, exch)
+	assert.Error(t, err, "ExchangeFundingRateHistory should error on an invalid end time")
+}
+
+func TestExchangeOpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOpenInterest()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	oi := requireArray(t, ExchangeOpenInterest, ctx, exch, currencyPair, delimiter, futuresAsset)
+	assert.Equal(t, &objects.Float{Value: 10}, oi["openinterest"], "open interest should be returned")
+}
+
+func TestExchangeFuturesContracts(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeFuturesContracts()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+
+	contract := requireArray(t, ExchangeFuturesContracts, ctx, exch, futuresAsset)
+	assert.Equal(t, tv, contract["active"], "active should be set")
+	assert.Equal(t, &objects.Float{Value: 100}, contract["maxleverage"], "max leverage should be set")
+}
@@ -18,6 +18,8 @@ var Modules = map[string]map[string]objects.Object{
 	"exchange": exchangeModule,
 	"common":   commonModule,
 	"global":   globalModules,
+	"store":    storeModule,
+	"pubsub":   pubsubModule,
 }
 
 // Context defines a juncture for script context to go context awareness
@@ -0,0 +1,129 @@
+package gct
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// TickerObject converts a ticker to the map returned by exchange.ticker
+func TickerObject(tx *ticker.Price) objects.Object {
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: tx.ExchangeName}
+	data["last"] = &objects.Float{Value: tx.Last}
+	data["High"] = &objects.Float{Value: tx.High}
+	data["Low"] = &objects.Float{Value: tx.Low}
+	data["bid"] = &objects.Float{Value: tx.Bid}
+	data["ask"] = &objects.Float{Value: tx.Ask}
+	data["volume"] = &objects.Float{Value: tx.Volume}
+	data["quotevolume"] = &objects.Float{Value: tx.QuoteVolume}
+	data["priceath"] = &objects.Float{Value: tx.PriceATH}
+	data["open"] = &objects.Float{Value: tx.Open}
+	data["close"] = &objects.Float{Value: tx.Close}
+	data["pair"] = &objects.String{Value: tx.Pair.String()}
+	data["asset"] = &objects.String{Value: tx.AssetType.String()}
+	data["updated"] = &objects.Time{Value: tx.LastUpdated}
+	return &objects.Map{Value: data}
+}
+
+// OrderbookObject converts an orderbook to the map returned by
+// exchange.orderbook
+func OrderbookObject(ob *orderbook.Base) objects.Object {
+	data := make(map[string]objects.Object, 5)
+	data["exchange"] = &objects.String{Value: ob.Exchange}
+	data["pair"] = &objects.String{Value: ob.Pair.String()}
+	data["asks"] = tranchesObject(ob.Asks)
+	data["bids"] = tranchesObject(ob.Bids)
+	data["asset"] = &objects.String{Value: ob.Asset.String()}
+	return &objects.Map{Value: data}
+}
+
+func tranchesObject(tranches []orderbook.Tranche) *objects.Array {
+	levels := &objects.Array{Value: make([]objects.Object, len(tranches))}
+	for x := range tranches {
+		temp := make(map[string]objects.Object, 2)
+		temp["amount"] = &objects.Float{Value: tranches[x].Amount}
+		temp["price"] = &objects.Float{Value: tranches[x].Price}
+		levels.Value[x] = &objects.Map{Value: temp}
+	}
+	return levels
+}
+
+// OrderObject converts order details to the map returned by
+// exchange.orderquery
+func OrderObject(d *order.Detail) objects.Object {
+	tradeHistory := &objects.Array{Value: make([]objects.Object, len(d.Trades))}
+	for x := range d.Trades {
+		temp := make(map[string]objects.Object, 7)
+		temp["timestamp"] = &objects.Time{Value: d.Trades[x].Timestamp}
+		temp["price"] = &objects.Float{Value: d.Trades[x].Price}
+		temp["fee"] = &objects.Float{Value: d.Trades[x].Fee}
+		temp["amount"] = &objects.Float{Value: d.Trades[x].Amount}
+		temp["type"] = &objects.String{Value: d.Trades[x].Type.String()}
+		temp["side"] = &objects.String{Value: d.Trades[x].Side.String()}
+		temp["description"] = &objects.String{Value: d.Trades[x].Description}
+		tradeHistory.Value[x] = &objects.Map{Value: temp}
+	}
+
+	data := make(map[string]objects.Object, 14)
+	data["exchange"] = &objects.String{Value: d.Exchange}
+	data["id"] = &objects.String{Value: d.OrderID}
+	data["accountid"] = &objects.String{Value: d.AccountID}
+	data["currencypair"] = &objects.String{Value: d.Pair.String()}
+	data["price"] = &objects.Float{Value: d.Price}
+	data["amount"] = &objects.Float{Value: d.Amount}
+	data["amountexecuted"] = &objects.Float{Value: d.ExecutedAmount}
+	data["amountremaining"] = &objects.Float{Value: d.RemainingAmount}
+	data["fee"] = &objects.Float{Value: d.Fee}
+	data["side"] = &objects.String{Value: d.Side.String()}
+	data["type"] = &objects.String{Value: d.Type.String()}
+	data["date"] = &objects.String{Value: d.Date.String()}
+	data["status"] = &objects.String{Value: d.Status.String()}
+	data["trades"] = tradeHistory
+	return &objects.Map{Value: data}
+}
+
+// TradeObject converts a public trade to a map
+func TradeObject(t *trade.Data) objects.Object {
+	data := make(map[string]objects.Object, 8)
+	data["exchange"] = &objects.String{Value: t.Exchange}
+	data["id"] = &objects.String{Value: t.TID}
+	data["pair"] = &objects.String{Value: t.CurrencyPair.String()}
+	data["asset"] = &objects.String{Value: t.AssetType.String()}
+	data["side"] = &objects.String{Value: t.Side.String()}
+	data["price"] = &objects.Float{Value: t.Price}
+	data["amount"] = &objects.Float{Value: t.Amount}
+	data["timestamp"] = &objects.Time{Value: t.Timestamp}
+	return &objects.Map{Value: data}
+}
+
+// FillObject converts an account fill to a map
+func FillObject(f *fill.Data) objects.Object {
+	data := make(map[string]objects.Object, 11)
+	data["exchange"] = &objects.String{Value: f.Exchange}
+	data["id"] = &objects.String{Value: f.ID}
+	data["pair"] = &objects.String{Value: f.CurrencyPair.String()}
+	data["asset"] = &objects.String{Value: f.AssetType.String()}
+	data["side"] = &objects.String{Value: f.Side.String()}
+	data["orderid"] = &objects.String{Value: f.OrderID}
+	data["clientorderid"] = &objects.String{Value: f.ClientOrderID}
+	data["tradeid"] = &objects.String{Value: f.TradeID}
+	data["price"] = &objects.Float{Value: f.Price}
+	data["amount"] = &objects.Float{Value: f.Amount}
+	data["timestamp"] = &objects.Time{Value: f.Timestamp}
+	return &objects.Map{Value: data}
+}
+
+// MessageObject converts a published message to the map passed to
+// on_message handlers and returned by pubsub.receive
+func MessageObject(m *Message) objects.Object {
+	data := make(map[string]objects.Object, 4)
+	data["channel"] = &objects.String{Value: m.Channel}
+	data["publisher"] = &objects.String{Value: m.Publisher}
+	data["data"] = m.Data
+	data["timestamp"] = &objects.Time{Value: m.Timestamp}
+	return &objects.Map{Value: data}
+}
@@ -0,0 +1,218 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+)
+
+const (
+	publishFunc     = "publish"
+	subscribeFunc   = "subscribe"
+	unsubscribeFunc = "unsubscribe"
+	receiveFunc     = "receive"
+
+	// messageQueueSize is the number of undelivered messages held for each
+	// script, the oldest are dropped once exceeded
+	messageQueueSize = 256
+)
+
+var (
+	errScriptIDUnset = errors.New("script ID is not set in context")
+	errChannelUnset  = errors.New("channel must be set")
+)
+
+var pubsubModule = map[string]objects.Object{
+	publishFunc:     &objects.UserFunction{Name: publishFunc, Value: PubSubPublish},
+	subscribeFunc:   &objects.UserFunction{Name: subscribeFunc, Value: PubSubSubscribe},
+	unsubscribeFunc: &objects.UserFunction{Name: unsubscribeFunc, Value: PubSubUnsubscribe},
+	receiveFunc:     &objects.UserFunction{Name: receiveFunc, Value: PubSubReceive},
+}
+
+// Message is a value published by a script to a channel
+type Message struct {
+	Channel   string
+	Publisher string
+	Data      objects.Object
+	Timestamp time.Time
+}
+
+// MessageHandler delivers a message to a running script, returning false
+// when the script does not handle messages so it is queued for receive
+type MessageHandler func(script string, msg *Message) bool
+
+// broker routes published messages to subscribed scripts
+type broker struct {
+	m             sync.Mutex
+	subscriptions map[string]map[string]bool
+	queues        map[string][]*Message
+	handler       MessageHandler
+}
+
+var messages = broker{
+	subscriptions: make(map[string]map[string]bool),
+	queues:        make(map[string][]*Message),
+}
+
+// SetMessageHandler sets the handler used to deliver messages to scripts as
+// they are published
+func SetMessageHandler(h MessageHandler) {
+	messages.m.Lock()
+	messages.handler = h
+	messages.m.Unlock()
+}
+
+// RemoveSubscriber removes all subscriptions and undelivered messages of a
+// script once it has stopped
+func RemoveSubscriber(script string) {
+	messages.m.Lock()
+	delete(messages.subscriptions, script)
+	delete(messages.queues, script)
+	messages.m.Unlock()
+}
+
+// PubSubPublish publishes a value to all scripts subscribed to a channel and
+// returns the number of subscribers
+// Params: scriptCTX, channel string, value
+func PubSubPublish(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	publisher, err := scriptName(publishFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	channel, err := channelName(publishFunc, args[1])
+	if err != nil {
+		return nil, err
+	}
+	if validator.IsTestExecution.Load() == true {
+		// validated scripts must not signal running scripts
+		return &objects.Int{Value: 0}, nil
+	}
+	return &objects.Int{Value: int64(messages.publish(&Message{
+		Channel:   channel,
+		Publisher: publisher,
+		Data:      args[2],
+		Timestamp: time.Now(),
+	}))}, nil
+}
+
+// PubSubSubscribe subscribes the script to a channel
+// Params: scriptCTX, channel string
+func PubSubSubscribe(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := scriptID(subscribeFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	channel, err := channelName(subscribeFunc, args[1])
+	if err != nil {
+		return nil, err
+	}
+	messages.m.Lock()
+	defer messages.m.Unlock()
+	if messages.subscriptions[script] == nil {
+		messages.subscriptions[script] = make(map[string]bool)
+	}
+	messages.subscriptions[script][channel] = true
+	return objects.TrueValue, nil
+}
+
+// PubSubUnsubscribe unsubscribes the script from a channel
+// Params: scriptCTX, channel string
+func PubSubUnsubscribe(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := scriptID(unsubscribeFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	channel, err := channelName(unsubscribeFunc, args[1])
+	if err != nil {
+		return nil, err
+	}
+	messages.m.Lock()
+	defer messages.m.Unlock()
+	delete(messages.subscriptions[script], channel)
+	return objects.TrueValue, nil
+}
+
+// PubSubReceive returns and clears the messages queued for the script since
+// it last received. Scripts which define an on_message handler are delivered
+// messages directly instead
+// Params: scriptCTX
+func PubSubReceive(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := scriptID(receiveFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	messages.m.Lock()
+	queue := messages.queues[script]
+	delete(messages.queues, script)
+	messages.m.Unlock()
+	r := &objects.Array{Value: make([]objects.Object, len(queue))}
+	for x := range queue {
+		r.Value[x] = MessageObject(queue[x])
+	}
+	return r, nil
+}
+
+// publish delivers a copy of the message to each subscribed script,
+// returning the number of subscribers
+func (b *broker) publish(msg *Message) int {
+	b.m.Lock()
+	defer b.m.Unlock()
+	var count int
+	for script, channels := range b.subscriptions {
+		if !channels[msg.Channel] {
+			continue
+		}
+		count++
+		m := *msg
+		m.Data = msg.Data.Copy()
+		if b.handler != nil && b.handler(script, &m) {
+			continue
+		}
+		queue := b.queues[script]
+		if len(queue) == messageQueueSize {
+			queue = queue[1:]
+		}
+		b.queues[script] = append(queue, &m)
+	}
+	return count
+}
+
+// scriptID returns the identifier of the running script instance from its
+// context, subscriptions are removed when the instance stops
+func scriptID(funcName string, arg objects.Object) (string, error) {
+	scriptCtx, ok := objects.ToInterface(arg).(*Context)
+	if !ok {
+		return "", constructRuntimeError(1, funcName, "*gct.Context", arg)
+	}
+	if id, ok := scriptCtx.Value["script"].(*objects.String); ok && id.Value != "" {
+		return id.Value, nil
+	}
+	return "", fmt.Errorf("function [%s] - %w", funcName, errScriptIDUnset)
+}
+
+func channelName(funcName string, arg objects.Object) (string, error) {
+	channel, ok := objects.ToString(arg)
+	if !ok {
+		return "", constructRuntimeError(2, funcName, "string", arg)
+	}
+	if channel == "" {
+		return "", fmt.Errorf("function [%s] - %w", funcName, errChannelUnset)
+	}
+	return channel, nil
+}
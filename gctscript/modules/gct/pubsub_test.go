@@ -0,0 +1,105 @@
+package gct
+
+import (
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubSub(t *testing.T) {
+	channel := &objects.String{Value: "signals"}
+	publisher, subscriber := namedContext("publisher.gct"), namedContext("subscriber.gct")
+	t.Cleanup(func() { RemoveSubscriber("subscriber.gct-id") })
+
+	_, err := PubSubPublish(publisher, channel)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = PubSubSubscribe(subscriber)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = PubSubUnsubscribe(subscriber)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = PubSubReceive()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = PubSubSubscribe(ctx, channel)
+	assert.ErrorIs(t, err, errScriptIDUnset, "PubSubSubscribe should error without a script ID")
+	_, err = PubSubSubscribe(subscriber, blank)
+	assert.ErrorIs(t, err, errChannelUnset, "PubSubSubscribe should error without a channel")
+	_, err = PubSubPublish(ctx, channel, tv)
+	assert.ErrorIs(t, err, errScriptNameUnset, "PubSubPublish should error without a script name")
+
+	resp, err := PubSubPublish(publisher, channel, tv)
+	require.NoError(t, err, "PubSubPublish must not error")
+	assert.Equal(t, &objects.Int{Value: 0}, resp, "PubSubPublish should return no subscribers")
+
+	resp, err = PubSubSubscribe(subscriber, channel)
+	require.NoError(t, err, "PubSubSubscribe must not error")
+	assert.Equal(t, tv, resp, "PubSubSubscribe should return true")
+
+	data := &objects.Map{Value: map[string]objects.Object{"price": &objects.Float{Value: 1337}}}
+	resp, err = PubSubPublish(publisher, channel, data)
+	require.NoError(t, err, "PubSubPublish must not error")
+	assert.Equal(t, &objects.Int{Value: 1}, resp, "PubSubPublish should return the number of subscribers")
+	_, err = PubSubPublish(publisher, &objects.String{Value: "other"}, tv)
+	require.NoError(t, err, "PubSubPublish must not error")
+	data.Value["price"] = &objects.Float{Value: 0}
+
+	resp, err = PubSubReceive(subscriber)
+	require.NoError(t, err, "PubSubReceive must not error")
+	received, ok := resp.(*objects.Array)
+	require.True(t, ok, "PubSubReceive must return an array")
+	require.Len(t, received.Value, 1, "PubSubReceive must only return messages for subscribed channels")
+	msg := objectMap(t, received.Value[0])
+	assert.Equal(t, channel, msg["channel"], "channel should be set")
+	assert.Equal(t, &objects.String{Value: "publisher.gct"}, msg["publisher"], "publisher should be set")
+	assert.Equal(t, &objects.Float{Value: 1337}, objectMap(t, msg["data"])["price"], "data should be copied when published")
+
+	resp, err = PubSubReceive(subscriber)
+	require.NoError(t, err, "PubSubReceive must not error")
+	assert.Empty(t, resp.(*objects.Array).Value, "PubSubReceive should clear received messages") //nolint:forcetypeassert // checked above
+
+	for range messageQueueSize + 1 {
+		_, err = PubSubPublish(publisher, channel, tv)
+		require.NoError(t, err, "PubSubPublish must not error")
+	}
+	resp, err = PubSubReceive(subscriber)
+	require.NoError(t, err, "PubSubReceive must not error")
+	assert.Len(t, resp.(*objects.Array).Value, messageQueueSize, "PubSubReceive should drop the oldest messages once the queue is full") //nolint:forcetypeassert // checked above
+
+	resp, err = PubSubUnsubscribe(subscriber, channel)
+	require.NoError(t, err, "PubSubUnsubscribe must not error")
+	assert.Equal(t, tv, resp, "PubSubUnsubscribe should return true")
+	resp, err = PubSubPublish(publisher, channel, tv)
+	require.NoError(t, err, "PubSubPublish must not error")
+	assert.Equal(t, &objects.Int{Value: 0}, resp, "PubSubUnsubscribe should remove the subscription")
+}
+
+func TestMessageHandler(t *testing.T) {
+	channel := &objects.String{Value: "handled"}
+	subscriber := namedContext("handler.gct")
+	t.Cleanup(func() {
+		SetMessageHandler(nil)
+		RemoveSubscriber("handler.gct-id")
+	})
+	_, err := PubSubSubscribe(subscriber, channel)
+	require.NoError(t, err, "PubSubSubscribe must not error")
+
+	var delivered []*Message
+	SetMessageHandler(func(script string, msg *Message) bool {
+		assert.Equal(t, "handler.gct-id", script, "handler should be called with the subscribed script")
+		delivered = append(delivered, msg)
+		return true
+	})
+	_, err = PubSubPublish(subscriber, channel, tv)
+	require.NoError(t, err, "PubSubPublish must not error")
+	require.Len(t, delivered, 1, "handler must be called for each message")
+	assert.Equal(t, tv, delivered[0].Data, "handler should be called with the message data")
+	resp, err := PubSubReceive(subscriber)
+	require.NoError(t, err, "PubSubReceive must not error")
+	assert.Empty(t, resp.(*objects.Array).Value, "Handled messages should not be queued") //nolint:forcetypeassert // PubSubReceive returns an array
+
+	RemoveSubscriber("handler.gct-id")
+	_, err = PubSubPublish(subscriber, channel, tv)
+	require.NoError(t, err, "PubSubPublish must not error")
+	assert.Len(t, delivered, 1, "RemoveSubscriber should remove subscriptions")
+}
@@ -0,0 +1,164 @@
+package gct
+
+import (
+	stdjson "encoding/json"
+	"errors"
+	"fmt"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/stdlib/json"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstore"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+const (
+	storeGetFunc    = "get"
+	storeSetFunc    = "set"
+	storeDeleteFunc = "delete"
+	storeKeysFunc   = "keys"
+)
+
+var (
+	errScriptNameUnset  = errors.New("script name is not set in context")
+	errStoreUnavailable = errors.New("script store is unavailable, a database connection is required")
+	errUnsupportedValue = errors.New("value cannot be stored")
+)
+
+var storeModule = map[string]objects.Object{
+	storeGetFunc:    &objects.UserFunction{Name: storeGetFunc, Value: StoreGet},
+	storeSetFunc:    &objects.UserFunction{Name: storeSetFunc, Value: StoreSet},
+	storeDeleteFunc: &objects.UserFunction{Name: storeDeleteFunc, Value: StoreDelete},
+	storeKeysFunc:   &objects.UserFunction{Name: storeKeysFunc, Value: StoreKeys},
+}
+
+// StoreGet returns a value persisted by the script, or the default value
+// when nothing is stored for the key
+// Params: scriptCTX, key string, default (optional)
+func StoreGet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 && len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := scriptName(storeGetFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, storeGetFunc, "string", args[1])
+	}
+	store := wrappers.GetStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStoreUnavailable)
+	}
+	r, err := store.Get(script, key)
+	if err != nil {
+		if !errors.Is(err, scriptstore.ErrKeyNotFound) {
+			return errorResponsef(standardFormatting, err)
+		}
+		if len(args) == 3 {
+			return args[2], nil
+		}
+		return objects.UndefinedValue, nil
+	}
+	value, err := json.Decode([]byte(r.Value))
+	if err != nil {
+		return errorResponsef("cannot decode stored value %q: %v", key, err)
+	}
+	return value, nil
+}
+
+// StoreSet persists a value for the script, replacing any existing value for
+// the key. Values are stored as JSON so functions cannot be stored
+// Params: scriptCTX, key string, value
+func StoreSet(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 3 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := scriptName(storeSetFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, storeSetFunc, "string", args[1])
+	}
+	value, err := json.Encode(args[2])
+	if err != nil {
+		return nil, fmt.Errorf("function [%s] argument position [3] - %w", storeSetFunc, err)
+	}
+	if !stdjson.Valid(value) {
+		// unsupported types such as functions are omitted by the encoder
+		return nil, fmt.Errorf("function [%s] argument position [3] - %w: %s", storeSetFunc, errUnsupportedValue, args[2].TypeName())
+	}
+	store := wrappers.GetStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStoreUnavailable)
+	}
+	if err := store.Set(&scriptstore.Record{Script: script, Key: key, Value: string(value)}); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// StoreDelete removes a value persisted by the script
+// Params: scriptCTX, key string
+func StoreDelete(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := scriptName(storeDeleteFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	key, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, storeDeleteFunc, "string", args[1])
+	}
+	store := wrappers.GetStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStoreUnavailable)
+	}
+	if err := store.Delete(script, key); err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return objects.TrueValue, nil
+}
+
+// StoreKeys returns the keys of all values persisted by the script
+// Params: scriptCTX
+func StoreKeys(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+	script, err := scriptName(storeKeysFunc, args[0])
+	if err != nil {
+		return nil, err
+	}
+	store := wrappers.GetStore()
+	if store == nil {
+		return errorResponsef(standardFormatting, errStoreUnavailable)
+	}
+	records, err := store.GetAll(script)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	keys := &objects.Array{Value: make([]objects.Object, len(records))}
+	for x := range records {
+		keys.Value[x] = &objects.String{Value: records[x].Key}
+	}
+	return keys, nil
+}
+
+// scriptName returns the name of the script from its context. Stored values
+// and published messages are namespaced by the script file name so they are
+// retained when a script is reloaded or restarted
+func scriptName(funcName string, arg objects.Object) (string, error) {
+	scriptCtx, ok := objects.ToInterface(arg).(*Context)
+	if !ok {
+		return "", constructRuntimeError(1, funcName, "*gct.Context", arg)
+	}
+	if name, ok := scriptCtx.Value["name"].(*objects.String); ok && name.Value != "" {
+		return name.Value, nil
+	}
+	return "", fmt.Errorf("function [%s] - %w", funcName, errScriptNameUnset)
+}
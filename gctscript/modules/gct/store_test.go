@@ -0,0 +1,131 @@
+package gct
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstore"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+// testStore is an in memory script store
+type testStore struct {
+	m      sync.Mutex
+	values map[string]map[string]string
+}
+
+func (s *testStore) Get(script, key string) (*scriptstore.Record, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	v, ok := s.values[script][key]
+	if !ok {
+		return nil, fmt.Errorf("%s %q: %w", script, key, scriptstore.ErrKeyNotFound)
+	}
+	return &scriptstore.Record{Script: script, Key: key, Value: v}, nil
+}
+
+func (s *testStore) Set(r *scriptstore.Record) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	if s.values[r.Script] == nil {
+		s.values[r.Script] = make(map[string]string)
+	}
+	s.values[r.Script][r.Key] = r.Value
+	return nil
+}
+
+func (s *testStore) Delete(script, key string) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+	delete(s.values[script], key)
+	return nil
+}
+
+func (s *testStore) GetAll(script string) ([]scriptstore.Record, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	records := make([]scriptstore.Record, 0, len(s.values[script]))
+	for k, v := range s.values[script] {
+		records = append(records, scriptstore.Record{Script: script, Key: k, Value: v})
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Key < records[j].Key })
+	return records, nil
+}
+
+func namedContext(name string) *Context {
+	return &Context{Map: objects.Map{Value: map[string]objects.Object{
+		"script": &objects.String{Value: name + "-id"},
+		"name":   &objects.String{Value: name},
+	}}}
+}
+
+func TestStore(t *testing.T) {
+	key := &objects.String{Value: "position"}
+	strategy := namedContext("strategy.gct")
+
+	modules.SetModuleStore(nil)
+	requireErrorResponse(t, StoreGet, strategy, key)
+	requireErrorResponse(t, StoreSet, strategy, key, tv)
+	requireErrorResponse(t, StoreDelete, strategy, key)
+	requireErrorResponse(t, StoreKeys, strategy)
+
+	modules.SetModuleStore(&testStore{values: make(map[string]map[string]string)})
+	t.Cleanup(func() { modules.SetModuleStore(nil) })
+
+	_, err := StoreGet(strategy)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StoreSet(strategy, key)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StoreDelete(strategy)
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StoreKeys()
+	assert.ErrorIs(t, err, objects.ErrWrongNumArguments)
+	_, err = StoreGet(ctx, key)
+	assert.ErrorIs(t, err, errScriptNameUnset, "StoreGet should error without a script name")
+	_, err = StoreGet(exch, key)
+	assert.Error(t, err, "StoreGet should error with an invalid context")
+	_, err = StoreSet(strategy, objects.UndefinedValue, tv)
+	assert.Error(t, err, "StoreSet should error with an invalid key")
+	_, err = StoreSet(strategy, key, &objects.UserFunction{Value: StoreGet})
+	assert.ErrorIs(t, err, errUnsupportedValue, "StoreSet should error with a value which cannot be stored")
+
+	resp, err := StoreGet(strategy, key)
+	require.NoError(t, err, "StoreGet must not error")
+	assert.Equal(t, objects.UndefinedValue, resp, "StoreGet should return undefined for a missing key")
+	resp, err = StoreGet(strategy, key, &objects.Float{Value: 0.5})
+	require.NoError(t, err, "StoreGet must not error")
+	assert.Equal(t, &objects.Float{Value: 0.5}, resp, "StoreGet should return the default value for a missing key")
+
+	value := &objects.Map{Value: map[string]objects.Object{
+		"amount": &objects.Float{Value: 1.5},
+		"pair":   currencyPair,
+	}}
+	resp, err = StoreSet(strategy, key, value)
+	require.NoError(t, err, "StoreSet must not error")
+	assert.Equal(t, tv, resp, "StoreSet should return true")
+	_, err = StoreSet(namedContext("other.gct"), key, tv)
+	require.NoError(t, err, "StoreSet must not error")
+
+	resp, err = StoreGet(strategy, key)
+	require.NoError(t, err, "StoreGet must not error")
+	assert.Equal(t, value, resp, "StoreGet should return the stored value")
+
+	resp, err = StoreKeys(strategy)
+	require.NoError(t, err, "StoreKeys must not error")
+	assert.Equal(t, &objects.Array{Value: []objects.Object{key}}, resp, "StoreKeys should only return keys stored by the script")
+
+	resp, err = StoreDelete(strategy, key)
+	require.NoError(t, err, "StoreDelete must not error")
+	assert.Equal(t, tv, resp, "StoreDelete should return true")
+	resp, err = StoreGet(strategy, key)
+	require.NoError(t, err, "StoreGet must not error")
+	assert.Equal(t, objects.UndefinedValue, resp, "StoreDelete should remove the value")
+	resp, err = StoreGet(namedContext("other.gct"), key)
+	require.NoError(t, err, "StoreGet must not error")
+	assert.Equal(t, tv, resp, "StoreDelete should not remove values stored by other scripts")
+}
@@ -0,0 +1,12 @@
+package modules
+
+import "github.com/thrasher-corp/gocryptotrader/database/repository/scriptstore"
+
+// Store instance of the persistent key-value store to use for modules. It
+// is nil when no database is connected
+var Store scriptstore.IDBService
+
+// SetModuleStore links the persistent key-value store to use for modules
+func SetModuleStore(store scriptstore.IDBService) {
+	Store = store
+}
@@ -7,8 +7,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -40,6 +44,17 @@ type GCTExchange interface {
 	WithdrawalFiatFunds(ctx context.Context, bankAccountID string, request *withdraw.Request) (out string, err error)
 	WithdrawalCryptoFunds(ctx context.Context, request *withdraw.Request) (out string, err error)
 	OHLCV(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error)
+	FuturesPositions(ctx context.Context, exch string, item asset.Item, pairs currency.Pairs, start time.Time) ([]futures.PositionDetails, error)
+	FuturesPositionSummary(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*futures.PositionSummary, error)
+	Leverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, side order.Side) (float64, error)
+	SetLeverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, amount float64, side order.Side) error
+	SetMarginType(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type) error
+	CollateralMode(ctx context.Context, exch string, item asset.Item) (collateral.Mode, error)
+	SetCollateralMode(ctx context.Context, exch string, item asset.Item, mode collateral.Mode) error
+	LatestFundingRates(ctx context.Context, exch string, pair currency.Pair, item asset.Item, includePredicted bool) ([]fundingrate.LatestRateResponse, error)
+	HistoricalFundingRates(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, includePayments bool) (*fundingrate.HistoricalRates, error)
+	OpenInterest(ctx context.Context, exch string, pair currency.Pair, item asset.Item) ([]futures.OpenInterest, error)
+	FuturesContracts(ctx context.Context, exch string, item asset.Item) ([]futures.Contract, error)
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -76,10 +78,21 @@ func (g *GctScriptManager) run(wg *sync.WaitGroup) {
 	log.Debugf(log.Global, "%s starting", caseName)
 
 	SetDefaultScriptOutput()
+	gct.SetMessageHandler(deliverMessage)
 	g.autoLoad()
 	defer wg.Done()
 
-	<-g.shutdown
+	tickerFeeds, orderbookFeeds := make(map[string]bool), make(map[string]bool)
+	t := time.NewTicker(eventFeedInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-g.shutdown:
+			return
+		case <-t.C:
+			g.subscribeFeeds(wg, tickerFeeds, orderbookFeeds)
+		}
+	}
 }
 
 // GetMaxVirtualMachines returns the max number of VMs to create
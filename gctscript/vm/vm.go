@@ -84,11 +84,16 @@ func (vm *VM) Load(file string) error {
 
 	vm.File = file
 	vm.Path = filepath.Dir(file)
+	vm.handlers = scriptHandlers(code)
+	if len(vm.handlers) > 0 {
+		code = append(code, eventLoop(vm.handlers)...)
+	}
 	vm.Script = tengo.NewScript(code)
 
 	scriptCtx := &gct.Context{}
 	scriptCtx.Value = map[string]tengo.Object{
-		"script": &tengo.String{Value: vm.ShortName() + "-" + vm.ID.String()},
+		"script": &tengo.String{Value: vm.scriptID()},
+		"name":   &tengo.String{Value: vm.ShortName()},
 	}
 
 	err = vm.Script.Add("ctx", scriptCtx)
@@ -96,6 +101,14 @@ func (vm *VM) Load(file string) error {
 		return err
 	}
 
+	if len(vm.handlers) > 0 {
+		vm.events = make(chan scriptEvent, eventQueueSize)
+		err = vm.Script.Add(nextEventFunc, &tengo.UserFunction{Name: nextEventFunc, Value: vm.nextEvent})
+		if err != nil {
+			return err
+		}
+	}
+
 	vm.Script.SetImports(loader.GetModuleMap())
 	vm.Hash = vm.getHash()
 
@@ -151,6 +164,11 @@ func (vm *VM) CompileAndRun() {
 		return
 	}
 
+	if len(vm.handlers) > 0 {
+		vm.listen()
+		return
+	}
+
 	err = vm.RunCtx()
 	if err != nil {
 		log.Errorln(log.GCTScriptMgr, err)
@@ -193,6 +211,7 @@ func (vm *VM) Shutdown() error {
 	if vm.S != nil {
 		close(vm.S)
 	}
+	gct.RemoveSubscriber(vm.scriptID())
 	if vm.config.Verbose {
 		log.Debugf(log.GCTScriptMgr, "Shutting down script: %s ID: %v", vm.ShortName(), vm.ID)
 	}
@@ -221,6 +240,12 @@ func (vm *VM) ShortName() string {
 	return filepath.Base(vm.File)
 }
 
+// scriptID returns the identifier of the running script instance set in its
+// context
+func (vm *VM) scriptID() string {
+	return vm.ShortName() + "-" + vm.ID.String()
+}
+
 func (vm *VM) event(status, executionType string) {
 	if validator.IsTestExecution.Load() == true {
 		return
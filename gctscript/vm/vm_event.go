@@ -0,0 +1,282 @@
+package vm
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+	"github.com/d5/tengo/v2/token"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// scriptHandlers returns the event handlers defined at the top level of a
+// script, in the order they are dispatched. Scripts which cannot be parsed
+// have no handlers so compilation can report the error
+func scriptHandlers(code []byte) []string {
+	fileSet := parser.NewFileSet()
+	srcFile := fileSet.AddFile("(main)", -1, len(code))
+	file, err := parser.NewParser(srcFile, code, nil).ParseFile()
+	if err != nil {
+		return nil
+	}
+	defined := make(map[string]bool)
+	for _, stmt := range file.Stmts {
+		assign, ok := stmt.(*parser.AssignStmt)
+		if !ok || assign.Token != token.Define || len(assign.LHS) != 1 || len(assign.RHS) != 1 {
+			continue
+		}
+		ident, ok := assign.LHS[0].(*parser.Ident)
+		if !ok {
+			continue
+		}
+		if _, ok := assign.RHS[0].(*parser.FuncLit); ok {
+			defined[ident.Name] = true
+		}
+	}
+	var found []string
+	for _, handler := range Handlers {
+		if defined[handler] {
+			found = append(found, handler)
+		}
+	}
+	return found
+}
+
+// eventLoop generates the script appended to scripts which define handlers,
+// calling the matching handler for each event until the VM is shut down
+func eventLoop(handlers []string) string {
+	var sb strings.Builder
+	sb.WriteString("\nfor " + eventVar + " := " + nextEventFunc + "(); " + eventVar + " != undefined; " + eventVar + " = " + nextEventFunc + "() {\n")
+	for _, handler := range handlers {
+		sb.WriteString("\tif " + eventVar + ".handler == \"" + handler + "\" { " + handler + "(" + eventVar + ".data) }\n")
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// HasHandler returns whether the loaded script defines an event handler
+func (vm *VM) HasHandler(handler string) bool {
+	if vm == nil {
+		return false
+	}
+	for x := range vm.handlers {
+		if vm.handlers[x] == handler {
+			return true
+		}
+	}
+	return false
+}
+
+// listen runs a script which defines event handlers until it is shut down.
+// The script timeout is not applied as the event loop blocks between events
+func (vm *VM) listen() {
+	vm.S = make(chan struct{}, 1)
+	if vm.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "Running script: %s ID: %v with handlers: %v", vm.ShortName(), vm.ID, vm.handlers)
+	}
+	if err := vm.Compiled.RunContext(context.Background()); err != nil {
+		vm.event(StatusFailure, TypeExecute)
+		log.Errorln(log.GCTScriptMgr, Error{Script: vm.File, Action: "Listen", Cause: err})
+		if err := vm.Shutdown(); err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+		return
+	}
+	vm.event(StatusSuccess, TypeExecute)
+}
+
+// nextEvent blocks until an event is queued for the script, returning
+// undefined to end the event loop once the VM is shut down. A VM which is not
+// listening, such as during validation, returns immediately
+func (vm *VM) nextEvent(...tengo.Object) (tengo.Object, error) {
+	if vm.S == nil {
+		return tengo.UndefinedValue, nil
+	}
+	select {
+	case <-vm.S:
+		return tengo.UndefinedValue, nil
+	case e := <-vm.events:
+		return &tengo.Map{Value: map[string]tengo.Object{
+			"handler": &tengo.String{Value: e.handler},
+			"data":    e.object(),
+		}}, nil
+	}
+}
+
+// push queues an event for the script without blocking the publisher,
+// dropping it when the script is too far behind
+func (vm *VM) push(e scriptEvent) {
+	select {
+	case vm.events <- e:
+	default:
+		log.Warnf(log.GCTScriptMgr, "Script %s ID: %v event queue full, dropping %s event", vm.ShortName(), vm.ID, e.handler)
+	}
+}
+
+// object converts event data to the value passed to a script handler
+func (e *scriptEvent) object() tengo.Object {
+	switch d := e.data.(type) {
+	case *ticker.Price:
+		return gct.TickerObject(d)
+	case *orderbook.Base:
+		return gct.OrderbookObject(d)
+	case *trade.Data:
+		return gct.TradeObject(d)
+	case *fill.Data:
+		return gct.FillObject(d)
+	case *order.Detail:
+		return gct.OrderObject(d)
+	case *gct.Message:
+		return gct.MessageObject(d)
+	}
+	return tengo.UndefinedValue
+}
+
+// hasHandler returns whether any running script defines an event handler
+func (g *GctScriptManager) hasHandler(handler string) bool {
+	var found bool
+	AllVMSync.Range(func(_, v any) bool {
+		vm, ok := v.(*VM)
+		found = ok && vm.HasHandler(handler)
+		return !found
+	})
+	return found
+}
+
+// dispatch queues an event for every running script with a handler for it
+func (g *GctScriptManager) dispatch(handler string, data any) {
+	AllVMSync.Range(func(_, v any) bool {
+		if vm, ok := v.(*VM); ok && vm.HasHandler(handler) {
+			vm.push(scriptEvent{handler: handler, data: data})
+		}
+		return true
+	})
+}
+
+// deliverMessage queues a published message for a running script with an
+// on_message handler, returning false so the message is held for
+// pubsub.receive when the script does not handle messages
+func deliverMessage(script string, msg *gct.Message) bool {
+	var delivered bool
+	AllVMSync.Range(func(_, v any) bool {
+		vm, ok := v.(*VM)
+		if !ok || vm.scriptID() != script {
+			return true
+		}
+		if vm.HasHandler(HandlerMessage) {
+			vm.push(scriptEvent{handler: HandlerMessage, data: msg})
+			delivered = true
+		}
+		return false
+	})
+	return delivered
+}
+
+// WebsocketDataHandler forwards trades, fills and order updates received by
+// the websocket routine manager to scripts with handlers for them
+func (g *GctScriptManager) WebsocketDataHandler(_ string, data any) error {
+	if !g.IsRunning() {
+		return nil
+	}
+	switch d := data.(type) {
+	case trade.Data:
+		g.dispatch(HandlerTrade, &d)
+	case []trade.Data:
+		for x := range d {
+			g.dispatch(HandlerTrade, &d[x])
+		}
+	case []fill.Data:
+		for x := range d {
+			g.dispatch(HandlerFill, &d[x])
+		}
+	case *order.Detail:
+		g.dispatch(HandlerOrderUpdate, d.CopyToPointer())
+	case []order.Detail:
+		for x := range d {
+			g.dispatch(HandlerOrderUpdate, d[x].CopyToPointer())
+		}
+	}
+	return nil
+}
+
+// subscribeFeeds attaches to the ticker and orderbook feeds of enabled
+// exchanges once a running script handles them. A feed is only available
+// once an exchange has loaded its first ticker or orderbook, so subscription
+// is retried until successful
+func (g *GctScriptManager) subscribeFeeds(wg *sync.WaitGroup, tickerFeeds, orderbookFeeds map[string]bool) {
+	handleTickers, handleOrderbooks := g.hasHandler(HandlerTicker), g.hasHandler(HandlerOrderbook)
+	if !handleTickers && !handleOrderbooks {
+		return
+	}
+	for _, exch := range wrappers.GetWrapper().Exchanges(true) {
+		if handleTickers && !tickerFeeds[exch] {
+			if pipe, err := ticker.SubscribeToExchangeTickers(exch); err == nil {
+				tickerFeeds[exch] = true
+				wg.Add(1)
+				go g.consumeFeed(wg, pipe, HandlerTicker)
+			}
+		}
+		if handleOrderbooks && !orderbookFeeds[exch] {
+			pipe, err := orderbook.SubscribeToExchangeOrderbooks(exch)
+			if err != nil {
+				if !errors.Is(err, orderbook.ErrOrderbookNotFound) {
+					log.Errorf(log.GCTScriptMgr, "Unable to subscribe to %s orderbooks: %v", exch, err)
+				}
+				continue
+			}
+			orderbookFeeds[exch] = true
+			wg.Add(1)
+			go g.consumeFeed(wg, pipe, HandlerOrderbook)
+		}
+	}
+}
+
+// consumeFeed dispatches ticker or orderbook updates from an exchange feed
+// until shutdown
+func (g *GctScriptManager) consumeFeed(wg *sync.WaitGroup, pipe dispatch.Pipe, handler string) {
+	defer wg.Done()
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Errorln(log.GCTScriptMgr, err)
+		}
+	}()
+	for {
+		select {
+		case <-g.shutdown:
+			return
+		case data, ok := <-pipe.Channel():
+			if !ok {
+				return
+			}
+			if !g.hasHandler(handler) {
+				continue
+			}
+			switch d := data.(type) {
+			case *ticker.Price:
+				tx := *d
+				g.dispatch(handler, &tx)
+			case orderbook.Outbound:
+				ob, err := d.Retrieve()
+				if err != nil {
+					// invalid orderbooks are dispatched again once resynced
+					continue
+				}
+				g.dispatch(handler, ob)
+			default:
+				log.Errorln(log.GCTScriptMgr, common.GetTypeAssertError("*ticker.Price or orderbook.Outbound", data))
+			}
+		}
+	}
+}
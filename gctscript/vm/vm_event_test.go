@@ -0,0 +1,214 @@
+package vm
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+)
+
+const testEventScript = `pubsub := import("pubsub")
+pubsub.subscribe(ctx, "signals")
+count := 0
+on_ticker := func(t) {
+	count += 1
+	record("ticker", t.last, count)
+}
+on_orderbook := func(ob) { record("orderbook", ob.bids[0].price, count) }
+on_trade := func(t) { record("trade", t.price, count) }
+on_fill := func(f) { record("fill", f.orderid, count) }
+on_order_update := func(o) { record("order", o.status, count) }
+on_message := func(m) { record("message", m.data, count) }
+`
+
+type recorded struct {
+	handler string
+	value   any
+	count   int64
+}
+
+func TestScriptHandlers(t *testing.T) {
+	t.Parallel()
+	assert.Empty(t, scriptHandlers([]byte(`fmt := import("fmt")`)), "Should find no handlers")
+	assert.Empty(t, scriptHandlers([]byte(`on_ticker := func(`)), "Should find no handlers in an invalid script")
+	assert.Empty(t, scriptHandlers([]byte(`on_ticker := 5`)), "Should ignore handlers which are not functions")
+	assert.Empty(t, scriptHandlers([]byte("f := func() {\n\ton_ticker := func(t) {}\n}")), "Should ignore handlers which are not top level")
+	assert.Equal(t, []string{HandlerTicker, HandlerFill}, scriptHandlers([]byte("on_fill := func(f) {}\non_ticker := func(t) {}\non_unknown := func(t) {}")), "Should find handlers in dispatch order")
+	assert.Equal(t, Handlers, scriptHandlers([]byte(testEventScript)), "Should find all handlers")
+}
+
+func TestEventCallbacks(t *testing.T) {
+	manager := &GctScriptManager{
+		config:   configHelper(true, false, maxTestVirtualMachines),
+		started:  1,
+		shutdown: make(chan struct{}),
+	}
+	testVM, records := loadEventVM(t, manager, testEventScript)
+	for _, handler := range Handlers {
+		assert.Truef(t, testVM.HasHandler(handler), "HasHandler should return true for %s", handler)
+		assert.Truef(t, manager.hasHandler(handler), "hasHandler should return true for %s", handler)
+	}
+	assert.False(t, testVM.HasHandler("on_unknown"), "HasHandler should return false for an unknown handler")
+
+	done := make(chan struct{})
+	go func() {
+		testVM.CompileAndRun()
+		close(done)
+	}()
+
+	manager.dispatch(HandlerTicker, &ticker.Price{Last: 1337})
+	assert.Equal(t, recorded{"ticker", 1337.0, 1}, receive(t, records), "Should call on_ticker")
+
+	manager.dispatch(HandlerOrderbook, &orderbook.Base{Bids: orderbook.Tranches{{Price: 42, Amount: 1}}})
+	assert.Equal(t, recorded{"orderbook", 42.0, 1}, receive(t, records), "Should call on_orderbook with script state preserved")
+
+	require.NoError(t, manager.WebsocketDataHandler("test", []trade.Data{{Price: 1}, {Price: 2}}))
+	assert.Equal(t, recorded{"trade", 1.0, 1}, receive(t, records), "Should call on_trade for the first trade")
+	assert.Equal(t, recorded{"trade", 2.0, 1}, receive(t, records), "Should call on_trade for the second trade")
+
+	require.NoError(t, manager.WebsocketDataHandler("test", trade.Data{Price: 3}))
+	assert.Equal(t, recorded{"trade", 3.0, 1}, receive(t, records), "Should call on_trade for a single trade")
+
+	require.NoError(t, manager.WebsocketDataHandler("test", []fill.Data{{OrderID: "1337"}}))
+	assert.Equal(t, recorded{"fill", "1337", 1}, receive(t, records), "Should call on_fill")
+
+	require.NoError(t, manager.WebsocketDataHandler("test", &order.Detail{Status: order.Filled}))
+	assert.Equal(t, recorded{"order", order.Filled.String(), 1}, receive(t, records), "Should call on_order_update")
+
+	require.NoError(t, manager.WebsocketDataHandler("test", []order.Detail{{Status: order.Cancelled}}))
+	assert.Equal(t, recorded{"order", order.Cancelled.String(), 1}, receive(t, records), "Should call on_order_update for each order")
+
+	require.NoError(t, manager.WebsocketDataHandler("test", "unhandled"), "WebsocketDataHandler should ignore unhandled data")
+
+	gct.SetMessageHandler(deliverMessage)
+	defer gct.SetMessageHandler(nil)
+	publisher := &gct.Context{}
+	publisher.Value = map[string]tengo.Object{"name": &tengo.String{Value: "publisher.gct"}}
+	_, err := gct.PubSubPublish(publisher, &tengo.String{Value: "signals"}, &tengo.String{Value: "buy"})
+	require.NoError(t, err, "PubSubPublish must not error")
+	assert.Equal(t, recorded{"message", "buy", 1}, receive(t, records), "Should call on_message for subscribed channels")
+
+	require.NoError(t, testVM.Shutdown())
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "Script should stop running after shutdown")
+	}
+	_, ok := AllVMSync.Load(testVM.ID)
+	assert.False(t, ok, "VM should be removed after shutdown")
+	resp, err := gct.PubSubPublish(publisher, &tengo.String{Value: "signals"}, &tengo.String{Value: "sell"})
+	require.NoError(t, err, "PubSubPublish must not error")
+	assert.Equal(t, &tengo.Int{Value: 0}, resp, "Subscriptions should be removed after shutdown")
+
+	manager.started = 0
+	require.NoError(t, manager.WebsocketDataHandler("test", trade.Data{Price: 4}), "WebsocketDataHandler should not error when not running")
+}
+
+func TestEventCallbackError(t *testing.T) {
+	manager := &GctScriptManager{
+		config:  configHelper(true, false, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM, _ := loadEventVM(t, manager, "on_trade := func(t) {\n\tzero := 0\n\treturn 1 / zero\n}")
+
+	done := make(chan struct{})
+	go func() {
+		testVM.CompileAndRun()
+		close(done)
+	}()
+	manager.dispatch(HandlerTrade, &trade.Data{Price: 1})
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "Script should stop running when a handler errors")
+	}
+	_, ok := AllVMSync.Load(testVM.ID)
+	assert.False(t, ok, "VM should be removed when a handler errors")
+}
+
+func TestValidateEventScript(t *testing.T) {
+	manager := GctScriptManager{
+		config:  configHelper(true, false, maxTestVirtualMachines),
+		started: 1,
+	}
+	path := filepath.Join(t.TempDir(), "events.gct")
+	require.NoError(t, os.WriteFile(path, []byte("fmt := import(\"fmt\")\non_ticker := func(t) { fmt.println(t) }"), 0o600))
+	assert.NoError(t, manager.Validate(path), "Validate should not block waiting for events")
+}
+
+func TestConsumeFeed(t *testing.T) {
+	if !dispatch.IsRunning() {
+		require.NoError(t, dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit), "dispatch.Start must not error")
+	}
+	manager := &GctScriptManager{
+		config:   configHelper(true, false, maxTestVirtualMachines),
+		started:  1,
+		shutdown: make(chan struct{}),
+	}
+	testVM, records := loadEventVM(t, manager, testEventScript)
+	go testVM.CompileAndRun()
+
+	const exch = "gctscriptfeedtest"
+	tx := &ticker.Price{ExchangeName: exch, Pair: currency.NewPair(currency.BTC, currency.USD), AssetType: asset.Spot, Last: 1}
+	require.NoError(t, ticker.ProcessTicker(tx), "ProcessTicker must not error")
+	pipe, err := ticker.SubscribeToExchangeTickers(exch)
+	require.NoError(t, err, "SubscribeToExchangeTickers must not error")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go manager.consumeFeed(&wg, pipe, HandlerTicker)
+
+	tx.Last = 2
+	require.NoError(t, ticker.ProcessTicker(tx), "ProcessTicker must not error")
+	assert.Equal(t, recorded{"ticker", 2.0, 1}, receive(t, records), "Should call on_ticker from the ticker feed")
+
+	close(manager.shutdown)
+	wg.Wait()
+	require.NoError(t, testVM.Shutdown())
+}
+
+// loadEventVM loads a script into a new VM, adding a record function which
+// sends handler calls to the returned channel
+func loadEventVM(t *testing.T, manager *GctScriptManager, script string) (*VM, chan recorded) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "events.gct")
+	require.NoError(t, os.WriteFile(path, []byte(script), 0o600))
+	testVM := manager.New()
+	require.NotNil(t, testVM, "New must return a VM")
+	require.NoError(t, testVM.Load(path), "Load must not error")
+
+	records := make(chan recorded, 10)
+	err := testVM.Script.Add("record", &tengo.UserFunction{Value: func(args ...tengo.Object) (tengo.Object, error) {
+		handler, _ := tengo.ToString(args[0])
+		count, _ := tengo.ToInt64(args[2])
+		records <- recorded{handler: handler, value: tengo.ToInterface(args[1]), count: count}
+		return tengo.UndefinedValue, nil
+	}})
+	require.NoError(t, err, "Add must not error")
+	return testVM, records
+}
+
+func receive(t *testing.T, records <-chan recorded) recorded {
+	t.Helper()
+	select {
+	case r := <-records:
+		return r
+	case <-time.After(time.Second * 5):
+		require.FailNow(t, "Timed out waiting for script handler")
+	}
+	return recorded{}
+}
@@ -29,6 +29,30 @@ const (
 	StatusSuccess = "success"
 	// StatusFailure text to display in script_event table when script execution fails
 	StatusFailure = "failure"
+
+	// HandlerTicker is called with each ticker update
+	HandlerTicker = "on_ticker"
+	// HandlerOrderbook is called with each orderbook update
+	HandlerOrderbook = "on_orderbook"
+	// HandlerTrade is called with each public trade received by websocket
+	HandlerTrade = "on_trade"
+	// HandlerFill is called with each account fill received by websocket
+	HandlerFill = "on_fill"
+	// HandlerOrderUpdate is called with each order update received by
+	// websocket
+	HandlerOrderUpdate = "on_order_update"
+	// HandlerMessage is called with each message published to a channel
+	// the script has subscribed to
+	HandlerMessage = "on_message"
+
+	// eventQueueSize is the number of events queued for a script before
+	// further events are dropped
+	eventQueueSize = 1024
+	// eventFeedInterval is how often ticker and orderbook feeds are
+	// subscribed to for scripts which handle them
+	eventFeedInterval = time.Second * 5
+	nextEventFunc     = "__gct_next_event"
+	eventVar          = "__gct_event"
 )
 
 type vmscount uint64
@@ -39,6 +63,9 @@ var (
 	AllVMSync = &sync.Map{}
 	// VMSCount running total count of Virtual Machines
 	VMSCount vmscount
+	// Handlers are the event handlers a script can define, in the order
+	// they are checked
+	Handlers = []string{HandlerTicker, HandlerOrderbook, HandlerTrade, HandlerFill, HandlerOrderUpdate, HandlerMessage}
 )
 
 // VM contains a pointer to "script" (precompiled source) and "compiled" (compiled byte code) instances
@@ -54,4 +81,14 @@ type VM struct {
 	S          chan struct{}
 	config     *Config
 	unregister func() error
+	// handlers are the event handlers defined by the script, which keep the
+	// script running to receive events until shut down
+	handlers []string
+	events   chan scriptEvent
+}
+
+// scriptEvent is market or account data queued for a script handler
+type scriptEvent struct {
+	handler string
+	data    any
 }
@@ -7,13 +7,18 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -236,3 +241,138 @@ func (e Exchange) OHLCV(ctx context.Context, exch string, pair currency.Pair, it
 	ret.FormatDates()
 	return ret, nil
 }
+
+// FuturesPositions returns the orders which make up futures positions for the
+// requested exchange, asset and pairs since the start date
+func (e Exchange) FuturesPositions(ctx context.Context, exch string, item asset.Item, pairs currency.Pairs, start time.Time) ([]futures.PositionDetails, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesPositions(ctx, &futures.PositionsRequest{
+		Asset:     item,
+		Pairs:     pairs,
+		StartDate: start,
+		EndDate:   time.Now(),
+	})
+}
+
+// FuturesPositionSummary returns a summary of the open position for the
+// requested exchange, pair and asset
+func (e Exchange) FuturesPositionSummary(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*futures.PositionSummary, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesPositionSummary(ctx, &futures.PositionSummaryRequest{
+		Asset: item,
+		Pair:  pair,
+	})
+}
+
+// Leverage returns the leverage of the requested exchange, pair and asset
+func (e Exchange) Leverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, side order.Side) (float64, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return 0, err
+	}
+	return ex.GetLeverage(ctx, item, pair, marginType, side)
+}
+
+// SetLeverage sets the leverage of the requested exchange, pair and asset
+func (e Exchange) SetLeverage(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type, amount float64, side order.Side) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetLeverage(ctx, item, pair, marginType, amount, side)
+}
+
+// SetMarginType sets the margin type of the requested exchange, pair and
+// asset
+func (e Exchange) SetMarginType(ctx context.Context, exch string, pair currency.Pair, item asset.Item, marginType margin.Type) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetMarginType(ctx, item, pair, marginType)
+}
+
+// CollateralMode returns the collateral mode of the requested exchange and
+// asset
+func (e Exchange) CollateralMode(ctx context.Context, exch string, item asset.Item) (collateral.Mode, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return collateral.UnsetMode, err
+	}
+	return ex.GetCollateralMode(ctx, item)
+}
+
+// SetCollateralMode sets the collateral mode of the requested exchange and
+// asset
+func (e Exchange) SetCollateralMode(ctx context.Context, exch string, item asset.Item, mode collateral.Mode) error {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return err
+	}
+	return ex.SetCollateralMode(ctx, item, mode)
+}
+
+// LatestFundingRates returns the latest funding rates of the requested
+// exchange and asset, for all pairs when the pair is empty
+func (e Exchange) LatestFundingRates(ctx context.Context, exch string, pair currency.Pair, item asset.Item, includePredicted bool) ([]fundingrate.LatestRateResponse, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetLatestFundingRates(ctx, &fundingrate.LatestRateRequest{
+		Asset:                item,
+		Pair:                 pair,
+		IncludePredictedRate: includePredicted,
+	})
+}
+
+// HistoricalFundingRates returns the funding rates of the requested exchange,
+// pair and asset between the start and end dates
+func (e Exchange) HistoricalFundingRates(ctx context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, includePayments bool) (*fundingrate.HistoricalRates, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetHistoricalFundingRates(ctx, &fundingrate.HistoricalRatesRequest{
+		Asset:                item,
+		Pair:                 pair,
+		StartDate:            start,
+		EndDate:              end,
+		IncludePayments:      includePayments,
+		IncludePredictedRate: true,
+		RespectHistoryLimits: true,
+	})
+}
+
+// OpenInterest returns the open interest of the requested exchange, pair and
+// asset, for all pairs when the pair is empty
+func (e Exchange) OpenInterest(ctx context.Context, exch string, pair currency.Pair, item asset.Item) ([]futures.OpenInterest, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	if pair.IsEmpty() {
+		return ex.GetOpenInterest(ctx)
+	}
+	return ex.GetOpenInterest(ctx, key.PairAsset{
+		Base:  pair.Base.Item,
+		Quote: pair.Quote.Item,
+		Asset: item,
+	})
+}
+
+// FuturesContracts returns the contract details of the requested exchange and
+// asset
+func (e Exchange) FuturesContracts(ctx context.Context, exch string, item asset.Item) ([]futures.Contract, error) {
+	ex, err := e.GetExchange(exch)
+	if err != nil {
+		return nil, err
+	}
+	return ex.GetFuturesContractDetails(ctx, item)
+}
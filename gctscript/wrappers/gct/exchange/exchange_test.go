@@ -8,10 +8,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -195,6 +199,99 @@ func TestOHLCV(t *testing.T) {
 	}
 }
 
+func TestFuturesPositions(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := exchangeTest.FuturesPositions(t.Context(), "fake_exchange", asset.Futures, currency.Pairs{cp}, time.Now().Add(-time.Hour))
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	_, err = exchangeTest.FuturesPositions(t.Context(), exchName, asset.Futures, currency.Pairs{cp}, time.Now().Add(-time.Hour))
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "FuturesPositions should call the exchange")
+}
+
+func TestFuturesPositionSummary(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := exchangeTest.FuturesPositionSummary(t.Context(), "fake_exchange", cp, asset.Futures)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	_, err = exchangeTest.FuturesPositionSummary(t.Context(), exchName, cp, asset.Futures)
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "FuturesPositionSummary should call the exchange")
+}
+
+func TestLeverage(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := exchangeTest.Leverage(t.Context(), "fake_exchange", cp, asset.Futures, margin.Isolated, order.Buy)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	_, err = exchangeTest.Leverage(t.Context(), exchName, cp, asset.Futures, margin.Isolated, order.Buy)
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "Leverage should call the exchange")
+
+	err = exchangeTest.SetLeverage(t.Context(), "fake_exchange", cp, asset.Futures, margin.Isolated, 2, order.Buy)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	err = exchangeTest.SetLeverage(t.Context(), exchName, cp, asset.Futures, margin.Isolated, 2, order.Buy)
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "SetLeverage should call the exchange")
+}
+
+func TestSetMarginType(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	err := exchangeTest.SetMarginType(t.Context(), "fake_exchange", cp, asset.Futures, margin.Isolated)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	err = exchangeTest.SetMarginType(t.Context(), exchName, cp, asset.Futures, margin.Isolated)
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "SetMarginType should call the exchange")
+}
+
+func TestCollateralMode(t *testing.T) {
+	t.Parallel()
+	mode, err := exchangeTest.CollateralMode(t.Context(), "fake_exchange", asset.Futures)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	assert.Equal(t, collateral.UnsetMode, mode, "CollateralMode should return an unset mode on error")
+	_, err = exchangeTest.CollateralMode(t.Context(), exchName, asset.Futures)
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "CollateralMode should call the exchange")
+
+	err = exchangeTest.SetCollateralMode(t.Context(), "fake_exchange", asset.Futures, collateral.SingleMode)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	err = exchangeTest.SetCollateralMode(t.Context(), exchName, asset.Futures, collateral.SingleMode)
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "SetCollateralMode should call the exchange")
+}
+
+func TestLatestFundingRates(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := exchangeTest.LatestFundingRates(t.Context(), "fake_exchange", cp, asset.PerpetualSwap, true)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	_, err = exchangeTest.LatestFundingRates(t.Context(), exchName, cp, asset.PerpetualSwap, true)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "LatestFundingRates should call the exchange")
+}
+
+func TestHistoricalFundingRates(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	end := time.Now()
+	_, err := exchangeTest.HistoricalFundingRates(t.Context(), "fake_exchange", cp, asset.PerpetualSwap, end.Add(-time.Hour*24), end, true)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	_, err = exchangeTest.HistoricalFundingRates(t.Context(), exchName, cp, asset.PerpetualSwap, end.Add(-time.Hour*24), end, true)
+	assert.ErrorIs(t, err, common.ErrNotYetImplemented, "HistoricalFundingRates should call the exchange")
+}
+
+func TestOpenInterest(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := exchangeTest.OpenInterest(t.Context(), "fake_exchange", cp, asset.Futures)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	_, err = exchangeTest.OpenInterest(t.Context(), exchName, cp, asset.Futures)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "OpenInterest should call the exchange")
+	_, err = exchangeTest.OpenInterest(t.Context(), exchName, currency.EMPTYPAIR, asset.Futures)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "OpenInterest should call the exchange for all pairs")
+}
+
+func TestFuturesContracts(t *testing.T) {
+	t.Parallel()
+	_, err := exchangeTest.FuturesContracts(t.Context(), "fake_exchange", asset.Futures)
+	assert.ErrorIs(t, err, engine.ErrExchangeNotFound)
+	_, err = exchangeTest.FuturesContracts(t.Context(), exchName, asset.Futures)
+	assert.ErrorIs(t, err, common.ErrFunctionNotSupported, "FuturesContracts should call the exchange")
+}
+
 func setupEngine() (err error) {
 	engine.Bot, err = engine.NewFromSettings(&settings, nil)
 	if err != nil {
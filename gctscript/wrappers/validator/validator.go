@@ -2,15 +2,23 @@ package validator
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"time"
 
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common/key"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstore"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -23,6 +31,8 @@ const (
 	validatorLow   float64 = 5500
 	validatorClose float64 = 5700
 	validatorVol   float64 = 10
+
+	validatorFundingRate float64 = 0.0001
 )
 
 // Exchanges validator for test execution/scripts
@@ -285,3 +295,200 @@ func (w Wrapper) OHLCV(_ context.Context, exch string, p currency.Pair, a asset.
 		Candles:  candles,
 	}, nil
 }
+
+// FuturesPositions validator for test execution/scripts
+func (w Wrapper) FuturesPositions(_ context.Context, exch string, item asset.Item, pairs currency.Pairs, start time.Time) ([]futures.PositionDetails, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	resp := make([]futures.PositionDetails, len(pairs))
+	for x := range pairs {
+		resp[x] = futures.PositionDetails{
+			Exchange: exch,
+			Asset:    item,
+			Pair:     pairs[x],
+			Orders: []order.Detail{
+				{
+					Exchange:       exch,
+					OrderID:        "1337",
+					Pair:           pairs[x],
+					AssetType:      item,
+					Side:           order.Long,
+					Type:           order.Market,
+					Status:         order.Filled,
+					Date:           start,
+					Price:          validatorClose,
+					Amount:         1,
+					ExecutedAmount: 1,
+				},
+			},
+		}
+	}
+	return resp, nil
+}
+
+// FuturesPositionSummary validator for test execution/scripts
+func (w Wrapper) FuturesPositionSummary(_ context.Context, exch string, pair currency.Pair, item asset.Item) (*futures.PositionSummary, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return &futures.PositionSummary{
+		Pair:                      pair,
+		Asset:                     item,
+		MarginType:                margin.Isolated,
+		CollateralMode:            collateral.SingleMode,
+		Currency:                  pair.Quote,
+		Leverage:                  decimal.NewFromInt(10),
+		CurrentSize:               decimal.NewFromInt(1),
+		AverageOpenPrice:          decimal.NewFromFloat(validatorOpen),
+		MarkPrice:                 decimal.NewFromFloat(validatorClose),
+		EstimatedLiquidationPrice: decimal.NewFromFloat(validatorLow),
+		UnrealisedPNL:             decimal.NewFromFloat(validatorClose - validatorOpen),
+	}, nil
+}
+
+// Leverage validator for test execution/scripts
+func (w Wrapper) Leverage(_ context.Context, exch string, _ currency.Pair, _ asset.Item, _ margin.Type, _ order.Side) (float64, error) {
+	if exch == exchError.String() {
+		return 0, errTestFailed
+	}
+	return 10, nil
+}
+
+// SetLeverage validator for test execution/scripts
+func (w Wrapper) SetLeverage(_ context.Context, exch string, _ currency.Pair, _ asset.Item, _ margin.Type, amount float64, _ order.Side) error {
+	if exch == exchError.String() || amount <= 0 {
+		return errTestFailed
+	}
+	return nil
+}
+
+// SetMarginType validator for test execution/scripts
+func (w Wrapper) SetMarginType(_ context.Context, exch string, _ currency.Pair, _ asset.Item, _ margin.Type) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// CollateralMode validator for test execution/scripts
+func (w Wrapper) CollateralMode(_ context.Context, exch string, _ asset.Item) (collateral.Mode, error) {
+	if exch == exchError.String() {
+		return collateral.UnsetMode, errTestFailed
+	}
+	return collateral.SingleMode, nil
+}
+
+// SetCollateralMode validator for test execution/scripts
+func (w Wrapper) SetCollateralMode(_ context.Context, exch string, _ asset.Item, _ collateral.Mode) error {
+	if exch == exchError.String() {
+		return errTestFailed
+	}
+	return nil
+}
+
+// LatestFundingRates validator for test execution/scripts
+func (w Wrapper) LatestFundingRates(_ context.Context, exch string, pair currency.Pair, item asset.Item, includePredicted bool) ([]fundingrate.LatestRateResponse, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	now := time.Now()
+	resp := fundingrate.LatestRateResponse{
+		Exchange:       exch,
+		Asset:          item,
+		Pair:           pair,
+		LatestRate:     fundingrate.Rate{Time: now.Add(-time.Hour * 8), Rate: decimal.NewFromFloat(validatorFundingRate)},
+		TimeOfNextRate: now.Add(time.Hour * 8),
+		TimeChecked:    now,
+	}
+	if includePredicted {
+		resp.PredictedUpcomingRate = fundingrate.Rate{Time: resp.TimeOfNextRate, Rate: decimal.NewFromFloat(validatorFundingRate)}
+	}
+	return []fundingrate.LatestRateResponse{resp}, nil
+}
+
+// HistoricalFundingRates validator for test execution/scripts
+func (w Wrapper) HistoricalFundingRates(_ context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, includePayments bool) (*fundingrate.HistoricalRates, error) {
+	if exch == exchError.String() || !end.After(start) {
+		return nil, errTestFailed
+	}
+	resp := &fundingrate.HistoricalRates{
+		Exchange:  exch,
+		Asset:     item,
+		Pair:      pair,
+		StartDate: start,
+		EndDate:   end,
+	}
+	for t := start; t.Before(end); t = t.Add(time.Hour * 8) {
+		rate := fundingrate.Rate{Time: t, Rate: decimal.NewFromFloat(validatorFundingRate)}
+		if includePayments {
+			rate.Payment = decimal.NewFromFloat(validatorFundingRate)
+			resp.PaymentSum = resp.PaymentSum.Add(rate.Payment)
+		}
+		resp.FundingRates = append(resp.FundingRates, rate)
+	}
+	if len(resp.FundingRates) > 0 {
+		resp.LatestRate = resp.FundingRates[len(resp.FundingRates)-1]
+	}
+	return resp, nil
+}
+
+// OpenInterest validator for test execution/scripts
+func (w Wrapper) OpenInterest(_ context.Context, exch string, pair currency.Pair, item asset.Item) ([]futures.OpenInterest, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []futures.OpenInterest{
+		{
+			Key: key.ExchangePairAsset{
+				Exchange: exch,
+				Base:     pair.Base.Item,
+				Quote:    pair.Quote.Item,
+				Asset:    item,
+			},
+			OpenInterest: validatorVol,
+		},
+	}, nil
+}
+
+// FuturesContracts validator for test execution/scripts
+func (w Wrapper) FuturesContracts(_ context.Context, exch string, item asset.Item) ([]futures.Contract, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []futures.Contract{
+		{
+			Exchange:       exch,
+			Name:           currency.NewPair(currency.BTC, currency.PERP),
+			Underlying:     currency.NewPair(currency.BTC, currency.USDT),
+			Asset:          item,
+			IsActive:       true,
+			Type:           futures.Perpetual,
+			SettlementType: futures.Linear,
+			MarginCurrency: currency.USDT,
+			Multiplier:     1,
+			MaxLeverage:    100,
+			LatestRate:     fundingrate.Rate{Rate: decimal.NewFromFloat(validatorFundingRate)},
+		},
+	}, nil
+}
+
+// Get validator for test execution/scripts, no values are stored
+func (s Store) Get(script, key string) (*scriptstore.Record, error) {
+	return nil, fmt.Errorf("%s %q: %w", script, key, scriptstore.ErrKeyNotFound)
+}
+
+// Set validator for test execution/scripts
+func (s Store) Set(*scriptstore.Record) error {
+	return nil
+}
+
+// Delete validator for test execution/scripts
+func (s Store) Delete(_, _ string) error {
+	return nil
+}
+
+// GetAll validator for test execution/scripts
+func (s Store) GetAll(string) ([]scriptstore.Record, error) {
+	return nil, nil
+}
@@ -4,9 +4,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstore"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/collateral"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -264,3 +269,105 @@ func TestWrapper_OHLCV(t *testing.T) {
 		t.Fatal("expected OHLCV to return error with invalid name")
 	}
 }
+
+func TestWrapper_FuturesPositions(t *testing.T) {
+	t.Parallel()
+	p, err := testWrapper.FuturesPositions(t.Context(), exchName, asset.USDTMarginedFutures, currency.Pairs{currencyPair}, time.Now())
+	require.NoError(t, err, "FuturesPositions must not error")
+	require.Len(t, p, 1, "FuturesPositions must return a position per pair")
+	assert.Len(t, p[0].Orders, 1, "FuturesPositions should return position orders")
+	_, err = testWrapper.FuturesPositions(t.Context(), exchError.String(), asset.USDTMarginedFutures, currency.Pairs{currencyPair}, time.Now())
+	assert.ErrorIs(t, err, errTestFailed, "FuturesPositions should error with invalid name")
+}
+
+func TestWrapper_FuturesPositionSummary(t *testing.T) {
+	t.Parallel()
+	s, err := testWrapper.FuturesPositionSummary(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures)
+	require.NoError(t, err, "FuturesPositionSummary must not error")
+	assert.Equal(t, currencyPair, s.Pair, "FuturesPositionSummary should return the requested pair")
+	_, err = testWrapper.FuturesPositionSummary(t.Context(), exchError.String(), currencyPair, asset.USDTMarginedFutures)
+	assert.ErrorIs(t, err, errTestFailed, "FuturesPositionSummary should error with invalid name")
+}
+
+func TestWrapper_Leverage(t *testing.T) {
+	t.Parallel()
+	l, err := testWrapper.Leverage(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures, margin.Isolated, order.UnknownSide)
+	require.NoError(t, err, "Leverage must not error")
+	assert.Positive(t, l, "Leverage should return leverage")
+	_, err = testWrapper.Leverage(t.Context(), exchError.String(), currencyPair, asset.USDTMarginedFutures, margin.Isolated, order.UnknownSide)
+	assert.ErrorIs(t, err, errTestFailed, "Leverage should error with invalid name")
+}
+
+func TestWrapper_SetLeverage(t *testing.T) {
+	t.Parallel()
+	err := testWrapper.SetLeverage(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures, margin.Isolated, 5, order.UnknownSide)
+	assert.NoError(t, err, "SetLeverage should not error")
+	err = testWrapper.SetLeverage(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures, margin.Isolated, 0, order.UnknownSide)
+	assert.ErrorIs(t, err, errTestFailed, "SetLeverage should error with invalid leverage")
+}
+
+func TestWrapper_SetMarginType(t *testing.T) {
+	t.Parallel()
+	assert.NoError(t, testWrapper.SetMarginType(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures, margin.Multi), "SetMarginType should not error")
+	assert.ErrorIs(t, testWrapper.SetMarginType(t.Context(), exchError.String(), currencyPair, asset.USDTMarginedFutures, margin.Multi), errTestFailed, "SetMarginType should error with invalid name")
+}
+
+func TestWrapper_CollateralMode(t *testing.T) {
+	t.Parallel()
+	m, err := testWrapper.CollateralMode(t.Context(), exchName, asset.USDTMarginedFutures)
+	require.NoError(t, err, "CollateralMode must not error")
+	assert.Equal(t, collateral.SingleMode, m, "CollateralMode should return the correct mode")
+	assert.NoError(t, testWrapper.SetCollateralMode(t.Context(), exchName, asset.USDTMarginedFutures, collateral.MultiMode), "SetCollateralMode should not error")
+	assert.ErrorIs(t, testWrapper.SetCollateralMode(t.Context(), exchError.String(), asset.USDTMarginedFutures, collateral.MultiMode), errTestFailed, "SetCollateralMode should error with invalid name")
+}
+
+func TestWrapper_LatestFundingRates(t *testing.T) {
+	t.Parallel()
+	r, err := testWrapper.LatestFundingRates(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures, true)
+	require.NoError(t, err, "LatestFundingRates must not error")
+	require.Len(t, r, 1, "LatestFundingRates must return a rate")
+	assert.False(t, r[0].PredictedUpcomingRate.Rate.IsZero(), "LatestFundingRates should return a predicted rate")
+	_, err = testWrapper.LatestFundingRates(t.Context(), exchError.String(), currencyPair, asset.USDTMarginedFutures, true)
+	assert.ErrorIs(t, err, errTestFailed, "LatestFundingRates should error with invalid name")
+}
+
+func TestWrapper_HistoricalFundingRates(t *testing.T) {
+	t.Parallel()
+	end := time.Now()
+	r, err := testWrapper.HistoricalFundingRates(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures, end.Add(-time.Hour*24), end, true)
+	require.NoError(t, err, "HistoricalFundingRates must not error")
+	assert.Len(t, r.FundingRates, 3, "HistoricalFundingRates should return a rate every 8 hours")
+	assert.False(t, r.PaymentSum.IsZero(), "HistoricalFundingRates should sum payments")
+	_, err = testWrapper.HistoricalFundingRates(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures, end, end, true)
+	assert.ErrorIs(t, err, errTestFailed, "HistoricalFundingRates should error with an invalid range")
+}
+
+func TestWrapper_OpenInterest(t *testing.T) {
+	t.Parallel()
+	oi, err := testWrapper.OpenInterest(t.Context(), exchName, currencyPair, asset.USDTMarginedFutures)
+	require.NoError(t, err, "OpenInterest must not error")
+	require.Len(t, oi, 1, "OpenInterest must return open interest")
+	_, err = testWrapper.OpenInterest(t.Context(), exchError.String(), currencyPair, asset.USDTMarginedFutures)
+	assert.ErrorIs(t, err, errTestFailed, "OpenInterest should error with invalid name")
+}
+
+func TestWrapper_FuturesContracts(t *testing.T) {
+	t.Parallel()
+	c, err := testWrapper.FuturesContracts(t.Context(), exchName, asset.USDTMarginedFutures)
+	require.NoError(t, err, "FuturesContracts must not error")
+	require.Len(t, c, 1, "FuturesContracts must return a contract")
+	_, err = testWrapper.FuturesContracts(t.Context(), exchError.String(), asset.USDTMarginedFutures)
+	assert.ErrorIs(t, err, errTestFailed, "FuturesContracts should error with invalid name")
+}
+
+func TestStore(t *testing.T) {
+	t.Parallel()
+	var s Store
+	require.NoError(t, s.Set(&scriptstore.Record{Script: "test.gct", Key: "position", Value: "1"}), "Set must not error")
+	_, err := s.Get("test.gct", "position")
+	assert.ErrorIs(t, err, scriptstore.ErrKeyNotFound, "Get should not return discarded values")
+	assert.NoError(t, s.Delete("test.gct", "position"), "Delete should not error")
+	r, err := s.GetAll("test.gct")
+	assert.NoError(t, err, "GetAll should not error")
+	assert.Empty(t, r, "GetAll should return no values")
+}
@@ -19,3 +19,6 @@ var (
 
 // Wrapper for validator interface
 type Wrapper struct{}
+
+// Store for validator key-value store interface, stored values are discarded
+type Store struct{}
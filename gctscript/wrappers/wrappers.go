@@ -1,6 +1,7 @@
 package wrappers
 
 import (
+	"github.com/thrasher-corp/gocryptotrader/database/repository/scriptstore"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 )
@@ -12,3 +13,12 @@ func GetWrapper() modules.GCTExchange {
 	}
 	return modules.Wrapper
 }
+
+// GetStore returns the instance of the persistent key-value store to use so
+// script validation does not modify stored values
+func GetStore() scriptstore.IDBService {
+	if validator.IsTestExecution.Load() == true {
+		return validator.Store{}
+	}
+	return modules.Store
+}
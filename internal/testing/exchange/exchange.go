@@ -117,6 +117,35 @@ func MockWsInstance[T any, PT interface {
 	return e
 }
 
+// wsMockFile is a consistent path under each exchange to find recorded websocket sessions
+const wsMockFile = "testdata/websocket.json"
+
+// MockWsReplayInstance creates a new Exchange instance connected to a server replaying the websocket sessions recorded
+// in testdata/websocket.json by RecordWs
+// Replayed messages are processed by the exchange's websocket handlers as if they came from the exchange
+func MockWsReplayInstance[T any, PT interface {
+	*T
+	exchange.IBotExchange
+}](tb testing.TB) *T {
+	tb.Helper()
+	h, err := mock.NewWebsocketVCRHandler(wsMockFile)
+	require.NoError(tb, err, "NewWebsocketVCRHandler must not error")
+	return MockWsInstance[T, PT](tb, h)
+}
+
+// RecordWs records the websocket sessions of an exchange to testdata/websocket.json when the test completes
+// It must be called before the websocket connects; Run live tests with -tags=mock_test_off to record sessions
+func RecordWs(tb testing.TB, e exchange.IBotExchange) {
+	tb.Helper()
+	w, err := e.GetBase().GetWebsocket()
+	require.NoError(tb, err, "GetWebsocket must not error")
+	r := mock.NewWebsocketRecorder(wsMockFile)
+	w.SetRecorder(r)
+	tb.Cleanup(func() {
+		assert.NoError(tb, r.Save(), "Saving recorded websocket sessions should not error")
+	})
+}
+
 // FixtureError contains an error and the message that caused it
 type FixtureError struct {
 	Err error
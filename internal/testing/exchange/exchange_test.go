@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	mockws "github.com/thrasher-corp/gocryptotrader/internal/testing/websocket"
 )
@@ -34,3 +35,23 @@ func TestMockWsInstance(t *testing.T) {
 	b := MockWsInstance[binance.Binance](t, mockws.CurryWsMockUpgrader(t, func(_ testing.TB, _ []byte, _ *gws.Conn) error { return nil }))
 	require.NotNil(t, b, "MockWsInstance must not be nil")
 }
+
+// TestMockWsReplayInstance exercises MockWsReplayInstance
+func TestMockWsReplayInstance(t *testing.T) {
+	b := MockWsReplayInstance[binance.Binance](t)
+	require.NotNil(t, b, "MockWsReplayInstance must not be nil")
+	assert.True(t, b.Websocket.IsConnected(), "Websocket should be connected to the replay server")
+}
+
+// TestRecordWs exercises RecordWs
+func TestRecordWs(t *testing.T) {
+	b := new(binance.Binance)
+	require.NoError(t, Setup(b), "Test exchange Setup must not error")
+	t.Chdir(t.TempDir())
+	t.Run("record", func(t *testing.T) {
+		RecordWs(t, b)
+	})
+	m, err := mock.LoadWebsocketMock(wsMockFile)
+	require.NoError(t, err, "LoadWebsocketMock must not error")
+	assert.Empty(t, m.Sessions, "Should save no sessions when the websocket has not connected")
+}
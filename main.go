@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"time"
 
+	backtest "github.com/thrasher-corp/gocryptotrader/backtester/engine"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
@@ -34,10 +35,17 @@ func main() {
 	flag.StringVar(&settings.DataDir, "datadir", common.GetDefaultDataDir(runtime.GOOS), "default data directory for GoCryptoTrader files")
 	flag.IntVar(&settings.GoMaxProcs, "gomaxprocs", runtime.GOMAXPROCS(-1), "sets the runtime GOMAXPROCS value")
 	flag.BoolVar(&settings.EnableDryRun, "dryrun", false, "dry runs bot, doesn't save config file")
+	flag.BoolVar(&settings.EnablePaperTrading, "papertrading", false, "matches orders locally against live orderbooks using simulated balances instead of trading on exchanges")
 	flag.BoolVar(&settings.EnableAllExchanges, "enableallexchanges", false, "enables all exchanges")
 	flag.BoolVar(&settings.EnableAllPairs, "enableallpairs", false, "enables all pairs for enabled exchanges")
 	flag.BoolVar(&settings.EnablePortfolioManager, "portfoliomanager", true, "enables the portfolio manager")
 	flag.BoolVar(&settings.EnableDataHistoryManager, "datahistorymanager", false, "enables the data history manager")
+	flag.BoolVar(&settings.EnableOrderbookRecorder, "orderbookrecorder", false, "enables the orderbook recorder")
+	flag.BoolVar(&settings.EnableAlgoExecutionManager, "algoexecutionmanager", false, "enables the algo execution manager for TWAP, VWAP, iceberg and chase orders")
+	flag.BoolVar(&settings.EnableMarketMakingManager, "marketmakingmanager", false, "enables the market making manager to quote configured exchange pairs")
+	flag.BoolVar(&settings.EnableStrategyRunner, "strategyrunner", false, "enables the strategy runner to run backtester strategies live")
+	flag.BoolVar(&settings.EnableCandleAggregator, "candleaggregator", false, "enables the candle aggregator to build candles from websocket trades")
+	flag.BoolVar(&settings.EnableMetricsManager, "metricsmanager", false, "enables the metrics manager to serve Prometheus metrics")
 	flag.DurationVar(&settings.PortfolioManagerDelay, "portfoliomanagerdelay", 0, "sets the portfolio managers sleep delay between updates")
 	flag.BoolVar(&settings.EnableGRPC, "grpc", true, "enables the grpc server")
 	flag.BoolVar(&settings.EnableGRPCProxy, "grpcproxy", false, "enables the grpc proxy server")
@@ -140,6 +148,9 @@ func main() {
 	config.SetConfig(engine.Bot.Config)
 
 	gctscript.Setup()
+	if err = engine.Bot.SetStrategyLoader(backtest.StrategyHost{}); err != nil {
+		log.Fatalf("Unable to set strategy loader. Error: %s\n", err)
+	}
 
 	gctlog.Infof(gctlog.Global, "JSON encoding is set to package `%s`", json.Implementation)
 
@@ -0,0 +1,247 @@
+package costbasis
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// String returns the method name
+func (m Method) String() string {
+	switch m {
+	case FIFO:
+		return "FIFO"
+	case LIFO:
+		return "LIFO"
+	case HIFO:
+		return "HIFO"
+	case AverageCost:
+		return "AVERAGE"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+// ParseMethod returns the cost basis method from its name
+func ParseMethod(s string) (Method, error) {
+	switch strings.ToUpper(s) {
+	case "FIFO":
+		return FIFO, nil
+	case "LIFO":
+		return LIFO, nil
+	case "HIFO":
+		return HIFO, nil
+	case "AVERAGE", "AVG", "AVERAGECOST":
+		return AverageCost, nil
+	default:
+		return UnknownMethod, fmt.Errorf("%w %q", ErrInvalidMethod, s)
+	}
+}
+
+// Calculate matches the disposals of each currency against its acquisition
+// lots using the method, returning the realised gains and the lots which
+// remain open. Lots are pooled across exchanges so transfers between them do
+// not realise gains
+func Calculate(method Method, events []Event) (*Report, error) {
+	if method == UnknownMethod || method > AverageCost {
+		return nil, fmt.Errorf("%w %d", ErrInvalidMethod, method)
+	}
+	sorted := slices.Clone(events)
+	for i := range sorted {
+		if err := sorted[i].validate(); err != nil {
+			return nil, fmt.Errorf("event %d %s %w", i, sorted[i].Reference, err)
+		}
+	}
+	slices.SortStableFunc(sorted, func(a, b Event) int { return a.Time.Compare(b.Time) })
+
+	r := &Report{Method: method}
+	ledgers := make(map[*currency.Item]*ledger)
+	var lotID int64
+	for i := range sorted {
+		e := &sorted[i]
+		l, ok := ledgers[e.Currency.Item]
+		if !ok {
+			l = &ledger{}
+			ledgers[e.Currency.Item] = l
+		}
+		switch e.Type {
+		case Acquisition:
+			lotID++
+			l.open(lotID, e, e.Amount)
+		case Disposal:
+			r.Gains = append(r.Gains, l.dispose(method, e)...)
+		case TransferOut:
+			l.inTransit += e.Amount
+		case TransferIn:
+			unmatched := e.Amount - l.inTransit
+			l.inTransit = max(l.inTransit-e.Amount, 0)
+			if unmatched > dustAmount {
+				lotID++
+				l.open(lotID, e, unmatched)
+			}
+		}
+	}
+	for _, l := range ledgers {
+		for _, lot := range l.lots {
+			r.Lots = append(r.Lots, *lot)
+		}
+	}
+	slices.SortFunc(r.Lots, func(a, b Lot) int { return cmp.Compare(a.ID, b.ID) })
+	return r, nil
+}
+
+// GainRecords returns the gains as CSV records with a header row
+func GainRecords(gains []Gain) [][]string {
+	records := make([][]string, 0, len(gains)+1)
+	records = append(records, []string{"exchange", "currency", "amount", "acquired", "disposed", "proceeds", "cost_basis", "gain", "lot_id", "unmatched", "reference"})
+	for i := range gains {
+		var acquired string
+		if !gains[i].Acquired.IsZero() {
+			acquired = gains[i].Acquired.UTC().Format(time.RFC3339)
+		}
+		records = append(records, []string{
+			gains[i].Exchange,
+			gains[i].Currency.String(),
+			strconv.FormatFloat(gains[i].Amount, 'f', -1, 64),
+			acquired,
+			gains[i].Disposed.UTC().Format(time.RFC3339),
+			strconv.FormatFloat(gains[i].Proceeds, 'f', -1, 64),
+			strconv.FormatFloat(gains[i].CostBasis, 'f', -1, 64),
+			strconv.FormatFloat(gains[i].Gain, 'f', -1, 64),
+			strconv.FormatInt(gains[i].LotID, 10),
+			strconv.FormatBool(gains[i].Unmatched),
+			gains[i].Reference,
+		})
+	}
+	return records
+}
+
+func (e *Event) validate() error {
+	if e.Time.IsZero() {
+		return errTimestampUnset
+	}
+	if e.Currency.IsEmpty() {
+		return errCurrencyUnset
+	}
+	if e.Type == UnknownEventType || e.Type > TransferIn {
+		return fmt.Errorf("%w %d", errInvalidEventType, e.Type)
+	}
+	if e.Amount <= 0 {
+		return errInvalidAmount
+	}
+	if e.Price < 0 {
+		return errInvalidPrice
+	}
+	if e.Fee < 0 {
+		return errInvalidFee
+	}
+	return nil
+}
+
+// open adds a lot for the amount of the event, apportioning its fee
+func (l *ledger) open(id int64, e *Event, amount float64) {
+	l.lots = append(l.lots, &Lot{
+		ID:        id,
+		Exchange:  e.Exchange,
+		Currency:  e.Currency,
+		Acquired:  e.Time,
+		Amount:    amount,
+		Remaining: amount,
+		UnitCost:  e.Price + e.Fee/e.Amount,
+		Reference: e.Reference,
+	})
+}
+
+// dispose closes lots for the amount of the event. Any amount which cannot be
+// matched against open lots is realised with a zero cost basis
+func (l *ledger) dispose(method Method, e *Event) []Gain {
+	var gains []Gain
+	remaining := e.Amount
+	if method == AverageCost {
+		var held, cost float64
+		for _, lot := range l.lots {
+			held += lot.Remaining
+			cost += lot.Remaining * lot.UnitCost
+		}
+		if held > dustAmount {
+			matched := min(remaining, held)
+			g := newGain(e, matched, matched*cost/held)
+			g.Acquired = l.lots[0].Acquired
+			gains = append(gains, g)
+			ratio := 1 - matched/held
+			for _, lot := range l.lots {
+				lot.Remaining *= ratio
+			}
+			remaining -= matched
+			l.prune()
+		}
+	} else {
+		for remaining > dustAmount {
+			lot := l.next(method)
+			if lot == nil {
+				break
+			}
+			matched := min(remaining, lot.Remaining)
+			g := newGain(e, matched, matched*lot.UnitCost)
+			g.Acquired = lot.Acquired
+			g.LotID = lot.ID
+			gains = append(gains, g)
+			lot.Remaining -= matched
+			remaining -= matched
+			l.prune()
+		}
+	}
+	if remaining > dustAmount {
+		g := newGain(e, remaining, 0)
+		g.Unmatched = true
+		gains = append(gains, g)
+	}
+	return gains
+}
+
+// next returns the open lot to match a disposal against
+func (l *ledger) next(method Method) *Lot {
+	if len(l.lots) == 0 {
+		return nil
+	}
+	switch method {
+	case LIFO:
+		return l.lots[len(l.lots)-1]
+	case HIFO:
+		highest := l.lots[0]
+		for _, lot := range l.lots[1:] {
+			if lot.UnitCost > highest.UnitCost {
+				highest = lot
+			}
+		}
+		return highest
+	default:
+		return l.lots[0]
+	}
+}
+
+// prune removes closed lots
+func (l *ledger) prune() {
+	l.lots = slices.DeleteFunc(l.lots, func(lot *Lot) bool { return lot.Remaining <= dustAmount })
+}
+
+// newGain returns the gain from disposing of an amount of the event,
+// apportioning its fee
+func newGain(e *Event, amount, costBasis float64) Gain {
+	proceeds := amount*e.Price - e.Fee*amount/e.Amount
+	return Gain{
+		Exchange:  e.Exchange,
+		Currency:  e.Currency,
+		Amount:    amount,
+		Disposed:  e.Time,
+		Proceeds:  proceeds,
+		CostBasis: costBasis,
+		Gain:      proceeds - costBasis,
+		Reference: e.Reference,
+	}
+}
@@ -0,0 +1,133 @@
+package costbasis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var tt = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestParseMethod(t *testing.T) {
+	t.Parallel()
+	for s, expected := range map[string]Method{"fifo": FIFO, "LIFO": LIFO, "hifo": HIFO, "avg": AverageCost, "Average": AverageCost} {
+		m, err := ParseMethod(s)
+		require.NoErrorf(t, err, "ParseMethod must not error for %s", s)
+		assert.Equalf(t, expected, m, "ParseMethod should return the correct method for %s", s)
+	}
+	_, err := ParseMethod("lol")
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+	assert.Equal(t, "HIFO", HIFO.String())
+	assert.Equal(t, "UNKNOWN", UnknownMethod.String())
+}
+
+func TestCalculateValidation(t *testing.T) {
+	t.Parallel()
+	_, err := Calculate(UnknownMethod, nil)
+	assert.ErrorIs(t, err, ErrInvalidMethod)
+
+	for _, tc := range []struct {
+		event Event
+		err   error
+	}{
+		{Event{}, errTimestampUnset},
+		{Event{Time: tt}, errCurrencyUnset},
+		{Event{Time: tt, Currency: currency.BTC}, errInvalidEventType},
+		{Event{Time: tt, Currency: currency.BTC, Type: Acquisition}, errInvalidAmount},
+		{Event{Time: tt, Currency: currency.BTC, Type: Acquisition, Amount: 1, Price: -1}, errInvalidPrice},
+		{Event{Time: tt, Currency: currency.BTC, Type: Acquisition, Amount: 1, Fee: -1}, errInvalidFee},
+	} {
+		_, err = Calculate(FIFO, []Event{tc.event})
+		assert.ErrorIs(t, err, tc.err)
+	}
+
+	r, err := Calculate(FIFO, nil)
+	require.NoError(t, err, "Calculate must not error")
+	assert.Empty(t, r.Gains)
+	assert.Empty(t, r.Lots)
+}
+
+func TestCalculate(t *testing.T) {
+	t.Parallel()
+	// Events are given out of order to ensure they are sorted by time
+	events := []Event{
+		{Time: tt.Add(time.Hour * 3), Exchange: "binance", Currency: currency.BTC, Type: Disposal, Amount: 1.5, Price: 300, Fee: 15, Reference: "d"},
+		{Time: tt, Exchange: "binance", Currency: currency.BTC, Type: Acquisition, Amount: 1, Price: 100, Fee: 10, Reference: "a"},
+		{Time: tt.Add(time.Hour), Exchange: "kraken", Currency: currency.BTC, Type: Acquisition, Amount: 1, Price: 200, Reference: "b"},
+		{Time: tt.Add(time.Hour * 2), Exchange: "binance", Currency: currency.BTC, Type: Acquisition, Amount: 1, Price: 150, Reference: "c"},
+		{Time: tt.Add(time.Hour), Exchange: "binance", Currency: currency.ETH, Type: Acquisition, Amount: 1, Price: 10, Reference: "e"},
+	}
+
+	type gain struct {
+		lotID               int64
+		amount, cost, value float64
+	}
+	type lot struct {
+		id        int64
+		remaining float64
+	}
+	for _, tc := range []struct {
+		method Method
+		gains  []gain
+		lots   []lot
+	}{
+		{FIFO, []gain{{1, 1, 110, 180}, {2, 0.5, 100, 45}}, []lot{{2, 0.5}, {3, 1}, {4, 1}}},
+		{LIFO, []gain{{4, 1, 150, 140}, {2, 0.5, 100, 45}}, []lot{{1, 1}, {2, 0.5}, {3, 1}}},
+		{HIFO, []gain{{2, 1, 200, 90}, {4, 0.5, 75, 70}}, []lot{{1, 1}, {3, 1}, {4, 0.5}}},
+		{AverageCost, []gain{{0, 1.5, 230, 205}}, []lot{{1, 0.5}, {2, 0.5}, {3, 1}, {4, 0.5}}},
+	} {
+		t.Run(tc.method.String(), func(t *testing.T) {
+			t.Parallel()
+			r, err := Calculate(tc.method, events)
+			require.NoError(t, err, "Calculate must not error")
+			assert.Equal(t, tc.method, r.Method)
+			require.Len(t, r.Gains, len(tc.gains), "Calculate must return the correct number of gains")
+			for i, g := range tc.gains {
+				assert.Equal(t, g.lotID, r.Gains[i].LotID, "gain should match the correct lot")
+				assert.InDelta(t, g.amount, r.Gains[i].Amount, 1e-9, "gain amount should be correct")
+				assert.InDelta(t, g.cost, r.Gains[i].CostBasis, 1e-9, "gain cost basis should include acquisition fees")
+				assert.InDelta(t, g.value, r.Gains[i].Gain, 1e-9, "gain should deduct disposal fees")
+				assert.Equal(t, "d", r.Gains[i].Reference)
+				assert.Equal(t, tt.Add(time.Hour*3), r.Gains[i].Disposed)
+				assert.False(t, r.Gains[i].Unmatched)
+			}
+			require.Len(t, r.Lots, len(tc.lots), "Calculate must return the open lots")
+			for i, l := range tc.lots {
+				assert.Equal(t, l.id, r.Lots[i].ID, "open lots should be ordered by ID")
+				assert.InDelta(t, l.remaining, r.Lots[i].Remaining, 1e-9, "open lot remaining amount should be correct")
+			}
+		})
+	}
+}
+
+func TestCalculateTransfers(t *testing.T) {
+	t.Parallel()
+	r, err := Calculate(FIFO, []Event{
+		{Time: tt, Exchange: "binance", Currency: currency.ETH, Type: Acquisition, Amount: 2, Price: 10},
+		{Time: tt.Add(time.Hour), Exchange: "binance", Currency: currency.ETH, Type: TransferOut, Amount: 1},
+		{Time: tt.Add(time.Hour * 2), Exchange: "kraken", Currency: currency.ETH, Type: TransferIn, Amount: 1.5, Price: 50},
+		{Time: tt.Add(time.Hour * 3), Exchange: "kraken", Currency: currency.ETH, Type: Disposal, Amount: 3, Price: 100},
+	})
+	require.NoError(t, err, "Calculate must not error")
+	assert.Empty(t, r.Lots, "all lots should be closed")
+	require.Len(t, r.Gains, 3, "Calculate must return the correct number of gains")
+	assert.Equal(t, Gain{Exchange: "kraken", Currency: currency.ETH, Amount: 2, Acquired: tt, Disposed: tt.Add(time.Hour * 3), Proceeds: 200, CostBasis: 20, Gain: 180, LotID: 1}, r.Gains[0], "transfers should not open or close lots")
+	assert.Equal(t, Gain{Exchange: "kraken", Currency: currency.ETH, Amount: 0.5, Acquired: tt.Add(time.Hour * 2), Disposed: tt.Add(time.Hour * 3), Proceeds: 50, CostBasis: 25, Gain: 25, LotID: 2}, r.Gains[1], "deposits exceeding transfers should open a lot at the deposit price")
+	assert.Equal(t, Gain{Exchange: "kraken", Currency: currency.ETH, Amount: 0.5, Disposed: tt.Add(time.Hour * 3), Proceeds: 50, Gain: 50, Unmatched: true}, r.Gains[2], "disposals without open lots should be realised with a zero cost basis")
+}
+
+func TestGainRecords(t *testing.T) {
+	t.Parallel()
+	records := GainRecords([]Gain{
+		{Exchange: "binance", Currency: currency.BTC, Amount: 0.5, Acquired: tt, Disposed: tt.Add(time.Hour), Proceeds: 150, CostBasis: 100, Gain: 50, LotID: 1, Reference: "1337"},
+		{Exchange: "binance", Currency: currency.BTC, Amount: 0.1, Disposed: tt.Add(time.Hour), Proceeds: 30, Gain: 30, Unmatched: true},
+	})
+	assert.Equal(t, [][]string{
+		{"exchange", "currency", "amount", "acquired", "disposed", "proceeds", "cost_basis", "gain", "lot_id", "unmatched", "reference"},
+		{"binance", "BTC", "0.5", "2024-01-01T00:00:00Z", "2024-01-01T01:00:00Z", "150", "100", "50", "1", "false", "1337"},
+		{"binance", "BTC", "0.1", "", "2024-01-01T01:00:00Z", "30", "0", "30", "0", "true", ""},
+	}, records)
+}
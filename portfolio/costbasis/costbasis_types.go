@@ -0,0 +1,125 @@
+package costbasis
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Method defines how disposals are matched against acquisition lots
+type Method uint8
+
+// Supported cost basis methods
+const (
+	UnknownMethod Method = iota
+	// FIFO matches disposals against the earliest acquired lots first
+	FIFO
+	// LIFO matches disposals against the latest acquired lots first
+	LIFO
+	// HIFO matches disposals against the lots with the highest unit cost
+	// first
+	HIFO
+	// AverageCost values disposals at the weighted average unit cost of all
+	// open lots
+	AverageCost
+)
+
+// EventType defines how an event affects the lots of a currency
+type EventType uint8
+
+// Supported event types
+const (
+	UnknownEventType EventType = iota
+	// Acquisition opens a new lot
+	Acquisition
+	// Disposal closes lots and realises a gain or loss
+	Disposal
+	// TransferOut moves holdings off an exchange without closing lots
+	TransferOut
+	// TransferIn moves holdings onto an exchange. Any amount not matched by
+	// a previous TransferOut opens a new lot at the event price
+	TransferIn
+)
+
+// dustAmount is the remaining lot amount below which a lot is considered
+// closed, avoiding lots left open by floating point error
+const dustAmount = 1e-12
+
+// ErrInvalidMethod is returned when a cost basis method is not supported
+var ErrInvalidMethod = errors.New("invalid cost basis method")
+
+var (
+	errInvalidEventType = errors.New("invalid event type")
+	errInvalidAmount    = errors.New("amount must be greater than zero")
+	errInvalidPrice     = errors.New("price cannot be negative")
+	errInvalidFee       = errors.New("fee cannot be negative")
+	errCurrencyUnset    = errors.New("currency must be set")
+	errTimestampUnset   = errors.New("timestamp must be set")
+)
+
+// Event is a change in holdings of a currency. Prices and fees are in the
+// fiat currency of the report
+type Event struct {
+	Time     time.Time
+	Exchange string
+	Currency currency.Code
+	Type     EventType
+	Amount   float64
+	// Price is the fiat price of one unit of the currency at the time of the
+	// event
+	Price float64
+	// Fee is the fiat value of fees paid. It is added to the cost of
+	// acquisitions and deducted from the proceeds of disposals
+	Fee float64
+	// Reference identifies the order, fill or transfer of the event
+	Reference string
+}
+
+// Lot is an acquisition of a currency
+type Lot struct {
+	ID        int64
+	Exchange  string
+	Currency  currency.Code
+	Acquired  time.Time
+	Amount    float64
+	Remaining float64
+	// UnitCost is the fiat cost of one unit of the currency including fees
+	UnitCost  float64
+	Reference string
+}
+
+// Gain is a realised gain or loss from disposing of a currency
+type Gain struct {
+	Exchange string
+	Currency currency.Code
+	Amount   float64
+	// Acquired is the acquisition time of the matched lot. When using the
+	// average cost method it is the time of the earliest open lot
+	Acquired  time.Time
+	Disposed  time.Time
+	Proceeds  float64
+	CostBasis float64
+	Gain      float64
+	// LotID is the matched lot. It is zero when using the average cost
+	// method or when the disposal is unmatched
+	LotID int64
+	// Unmatched is set when there are no open lots to match the disposal
+	// against, which is then realised with a zero cost basis
+	Unmatched bool
+	Reference string
+}
+
+// Report is the result of matching events against lots
+type Report struct {
+	Method Method
+	Gains  []Gain
+	// Lots are the lots which remain open
+	Lots []Lot
+}
+
+// ledger tracks the lots of a single currency
+type ledger struct {
+	lots      []*Lot
+	inTransit float64
+}